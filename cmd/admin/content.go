@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+)
+
+// fetchTimeout bounds a run-fetch, which reads every enabled source at once
+const fetchTimeout = 5 * time.Minute
+
+// rebuildSearchIndex rebuilds the search index now instead of waiting for
+// the next refresh
+func rebuildSearchIndex(cfg *config.Config, args []string) int {
+	if len(args) != 0 {
+		fmt.Fprintln(os.Stderr, "Usage: admin rebuild-search-index")
+		return 2
+	}
+
+	start := time.Now()
+	if err := services.NewSearchService(database.DB).Refresh(); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to rebuild the search index:", err)
+		return 1
+	}
+
+	fmt.Printf("Rebuilt the search index in %s\n", time.Since(start).Round(time.Millisecond))
+	return 0
+}
+
+// runFetch fetches news now, from the NewsAPI and from every enabled RSS
+// source whether or not it is due, recording manual ingestion runs. Webhooks
+// aren't notified about the fetched articles.
+func runFetch(cfg *config.Config, args []string) int {
+	flags := flag.NewFlagSet("run-fetch", flag.ContinueOnError)
+	source := flags.String("source", "all", "Where to fetch from: newsapi, rss or all")
+	limit := flags.Int("limit", cfg.NewsAPI.DefaultLimit, "Most articles to fetch from each source")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 0 || (*source != "all" && *source != models.IngestionSourceNewsAPI && *source != models.IngestionSourceRSS) {
+		flags.Usage()
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	taxonomy, err := services.NewNewsCategoryService(database.DB).Taxonomy()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load news categories:", err)
+		return 1
+	}
+	ingestion := services.NewIngestionService(database.DB, cfg.NewsIngestion, nil)
+
+	code := 0
+	if *source == "all" || *source == models.IngestionSourceNewsAPI {
+		newsService, err := services.NewNewsService(cfg.NewsAPI, taxonomy)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Skipping the NewsAPI:", err)
+			if *source == models.IngestionSourceNewsAPI {
+				return 1
+			}
+		} else {
+			run := ingestion.Ingest(models.IngestionSourceNewsAPI, models.IngestionTriggerManual, func() ([]models.News, []models.IngestionSourceError) {
+				return newsService.FetchNews(ctx, nil, *limit)
+			})
+			if !printRun(run) {
+				code = 1
+			}
+		}
+	}
+
+	if *source == "all" || *source == models.IngestionSourceRSS {
+		sourceService := services.NewNewsSourceService(database.DB)
+		feeds, err := sourceService.Enabled()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to load news sources:", err)
+			return 1
+		}
+		if len(feeds) == 0 {
+			fmt.Fprintln(os.Stderr, "Skipping RSS:", services.ErrNoNewsSources)
+			if *source == models.IngestionSourceRSS {
+				return 1
+			}
+		} else {
+			rssService := services.NewRSSService(sourceService, taxonomy, nil)
+			run := ingestion.Ingest(models.IngestionSourceRSS, models.IngestionTriggerManual, func() ([]models.News, []models.IngestionSourceError) {
+				return rssService.FetchNews(ctx, feeds, *limit*len(feeds))
+			})
+			rssService.FinishFetchRuns(run)
+			if !printRun(run) {
+				code = 1
+			}
+		}
+	}
+
+	return code
+}
+
+// printRun reports the outcome of an ingestion run and whether it succeeded
+func printRun(run models.IngestionRun) bool {
+	if run.Status == models.IngestionFailed {
+		fmt.Fprintf(os.Stderr, "%s fetch failed (run %d): %s\n", run.Source, run.ID, run.Error)
+		return false
+	}
+
+	fmt.Printf("%s: %d articles seen, %d saved, %d already stored, %d in other languages, %d failed (run %d)\n",
+		run.Source, run.ItemsSeen, run.ItemsSaved, run.ItemsDeduped, run.ItemsFiltered, run.ItemsFailed, run.ID)
+	for _, sourceErr := range run.SourceErrors {
+		fmt.Fprintf(os.Stderr, "  %s: %s\n", sourceErr.Source, sourceErr.Error)
+	}
+	return true
+}
+
+// purgeTrash permanently removes posts and news articles deleted longer ago
+// than -older-than
+func purgeTrash(cfg *config.Config, args []string) int {
+	flags := flag.NewFlagSet("purge-trash", flag.ContinueOnError)
+	olderThan := flags.Duration("older-than", 30*24*time.Hour, "Only purge content deleted at least this long ago")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 0 || *olderThan < 0 {
+		flags.Usage()
+		return 2
+	}
+
+	result, err := services.NewTrashService(database.DB).Purge(time.Now().Add(-*olderThan))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Purged %d posts and %d news articles before failing: %v\n", result.Posts, result.News, err)
+		return 1
+	}
+
+	fmt.Printf("Purged %d posts and %d news articles\n", result.Posts, result.News)
+	return 0
+}
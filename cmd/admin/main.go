@@ -0,0 +1,68 @@
+// Command admin runs operational tasks against the API's database, for the
+// things that aren't exposed over HTTP or are needed before anyone can log in.
+// It reads the same configuration as the API server.
+//
+//	go run ./cmd/admin create-user -username jane -email jane@example.com -role editor
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/logger"
+)
+
+const usage = `Usage: admin <command> [flags]
+
+Commands:
+  create-user           Create a user, reading the password from stdin unless -password is set
+  set-role USER ROLE    Change a user's role and sign them out
+  revoke-tokens USER    Revoke every refresh token of a user
+  rebuild-search-index  Rebuild the search index from the current content
+  run-fetch             Fetch news from the NewsAPI and every enabled RSS source now
+  purge-trash           Permanently remove posts and news deleted more than -older-than ago
+  encrypt-secrets       Encrypt the secrets stored before ENCRYPTION_KEY was set
+
+USER is a user ID, email or username. Run "admin <command> -h" for a command's flags.`
+
+// command runs a subcommand with its arguments and returns the exit code
+type command func(cfg *config.Config, args []string) int
+
+var commands = map[string]command{
+	"create-user":          createUser,
+	"set-role":             setRole,
+	"revoke-tokens":        revokeTokens,
+	"rebuild-search-index": rebuildSearchIndex,
+	"run-fetch":            runFetch,
+	"purge-trash":          purgeTrash,
+	"encrypt-secrets":      encryptSecrets,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load("")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load configuration:", err)
+		os.Exit(1)
+	}
+	logger.Setup(cfg)
+
+	if err := database.Connect(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	code := run(cfg, os.Args[2:])
+	database.Close()
+	os.Exit(code)
+}
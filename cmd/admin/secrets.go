@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+)
+
+// encryptSecrets encrypts the secrets stored before ENCRYPTION_KEY was set
+func encryptSecrets(cfg *config.Config, args []string) int {
+	if len(args) != 0 {
+		fmt.Fprintln(os.Stderr, "Usage: admin encrypt-secrets")
+		return 2
+	}
+
+	count, err := database.EncryptSecrets(database.DB)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to encrypt secrets:", err)
+		return 1
+	}
+
+	fmt.Printf("Encrypted %d stored secrets\n", count)
+	return 0
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// cliActorRole marks audit log entries for changes made with this tool
+const cliActorRole = "cli"
+
+// minPasswordLength matches the minimum length registration accepts
+const minPasswordLength = 8
+
+// createUser creates a user with any role, e.g. the first admin of a site
+func createUser(cfg *config.Config, args []string) int {
+	flags := flag.NewFlagSet("create-user", flag.ContinueOnError)
+	username := flags.String("username", "", "Username (required)")
+	email := flags.String("email", "", "Email address (required)")
+	password := flags.String("password", "", "Password; read from stdin when empty, so it stays out of the shell history")
+	role := flags.String("role", policy.RoleUser, "Role of the new user")
+	firstName := flags.String("first-name", "", "First name")
+	lastName := flags.String("last-name", "", "Last name")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *username == "" || *email == "" || flags.NArg() > 0 {
+		flags.Usage()
+		return 2
+	}
+
+	if *password == "" {
+		fmt.Fprint(os.Stderr, "Password: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			fmt.Fprintln(os.Stderr, "Failed to read password:", err)
+			return 1
+		}
+		*password = strings.TrimRight(line, "\r\n")
+	}
+	if len(*password) < minPasswordLength {
+		fmt.Fprintf(os.Stderr, "Password must be at least %d characters\n", minPasswordLength)
+		return 1
+	}
+
+	if exists, err := services.NewRoleService(database.DB).Exists(*role); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	} else if !exists {
+		fmt.Fprintln(os.Stderr, "Unknown role:", *role)
+		return 1
+	}
+
+	users := repository.NewUserRepository(database.DB)
+	if exists, err := users.Exists(*email, *username); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to check for existing users:", err)
+		return 1
+	} else if exists {
+		fmt.Fprintln(os.Stderr, "A user with that email or username already exists")
+		return 1
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*password), bcrypt.DefaultCost)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to hash password:", err)
+		return 1
+	}
+
+	user := models.User{
+		Username:  *username,
+		Email:     *email,
+		Password:  string(hashedPassword),
+		FirstName: *firstName,
+		LastName:  *lastName,
+		Role:      *role,
+	}
+	if err := users.Create(&user); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create user:", err)
+		return 1
+	}
+
+	fmt.Printf("Created %s %s (ID %d)\n", user.Role, user.Username, user.ID)
+	return 0
+}
+
+// setRole changes a user's role and revokes their refresh tokens, so the new
+// role applies from their next login, as it does when an admin changes it
+func setRole(cfg *config.Config, args []string) int {
+	if len(args) != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: admin set-role USER ROLE")
+		return 2
+	}
+	role := args[1]
+
+	user, err := findUser(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if exists, err := services.NewRoleService(database.DB).Exists(role); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	} else if !exists {
+		fmt.Fprintln(os.Stderr, "Unknown role:", role)
+		return 1
+	}
+
+	previous := user.Role
+	if previous == role {
+		fmt.Printf("%s already has the %s role\n", user.Username, role)
+		return 0
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(user).Update("role", role).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked = ?", user.ID, false).
+			Update("revoked", true).Error
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to change role:", err)
+		return 1
+	}
+
+	if err := services.NewAuditService(database.DB).Record(&models.AuditLog{
+		ActorRole:    cliActorRole,
+		Action:       models.AuditActionUserRoleChanged,
+		ResourceType: "user",
+		ResourceID:   strconv.FormatUint(uint64(user.ID), 10),
+		Before:       map[string]string{"role": previous},
+		After:        map[string]string{"role": role},
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to record the change in the audit log:", err)
+	}
+
+	fmt.Printf("Changed %s from %s to %s\n", user.Username, previous, role)
+	return 0
+}
+
+// revokeTokens signs a user out everywhere by revoking their refresh tokens.
+// Access tokens they already hold stay valid until they expire.
+func revokeTokens(cfg *config.Config, args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: admin revoke-tokens USER")
+		return 2
+	}
+
+	user, err := findUser(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if err := middleware.RevokeAllUserRefreshTokens(user.ID); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	fmt.Printf("Revoked the refresh tokens of %s; access tokens expire within %s\n", user.Username, cfg.JWT.AccessExpiry)
+	return 0
+}
+
+// findUser looks up a user by ID, email or username
+func findUser(ref string) (*models.User, error) {
+	users := repository.NewUserRepository(database.DB)
+
+	var user *models.User
+	var err error
+	if id, parseErr := strconv.ParseUint(ref, 10, 32); parseErr == nil {
+		user, err = users.FindByID(uint(id))
+	} else if strings.Contains(ref, "@") {
+		user, err = users.FindByEmail(ref)
+	} else {
+		user, err = users.FindByUsername(ref)
+	}
+
+	if err == gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("user %q not found", ref)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %q: %w", ref, err)
+	}
+	return user, nil
+}
@@ -18,7 +18,7 @@ import (
 // @license.url   https://opensource.org/licenses/MIT
 
 // @host           ${API_HOST}
-// @BasePath       /api
+// @BasePath       /api/v1
 
 // @securityDefinitions.apikey BearerAuth
 // @in header
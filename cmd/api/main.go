@@ -10,7 +10,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/phanvantai/taiphanvan_backend/docs"
@@ -19,7 +18,10 @@ import (
 	"github.com/phanvantai/taiphanvan_backend/internal/handlers"
 	"github.com/phanvantai/taiphanvan_backend/internal/logger"
 	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
+	"github.com/phanvantai/taiphanvan_backend/internal/routes"
 	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"github.com/phanvantai/taiphanvan_backend/pkg/utils"
 	"github.com/rs/zerolog/log"
 	swaggerFiles "github.com/swaggo/files"
@@ -39,13 +41,18 @@ import (
 // @license.url   https://opensource.org/licenses/MIT
 
 // @host      localhost:9876
-// @BasePath  /api
+// @BasePath  /api/v1
 
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
 // @description Type "Bearer" followed by a space and the JWT token.
 
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key created under /profile/api-keys, accepted by user endpoints but not admin ones.
+
 func main() {
 	// Initialize barebones logger for startup errors
 	initStartupLogger()
@@ -59,6 +66,14 @@ func main() {
 	// Initialize logger with proper configuration
 	logger.Setup(cfg)
 
+	// Manage the database schema instead of serving
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(cfg, os.Args[2:]))
+	}
+
+	// Export OpenTelemetry traces when a collector is configured
+	tracing.Setup(cfg.Tracing)
+
 	// Set the Gin mode
 	gin.SetMode(cfg.Server.GinMode)
 
@@ -75,52 +90,134 @@ func main() {
 	// Set the JWT config for middleware
 	middleware.SetConfig(cfg)
 
+	// Load the JWT signing keys, registering keys added to the configuration
+	keyRing := services.NewJWTKeyRing(database.DB, cfg.JWT)
+	if err := keyRing.Sync(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to load JWT signing keys")
+	}
+	middleware.SetKeyRing(keyRing)
+
+	// Track the background job loops for the readiness probe
+	jobMonitor := services.NewJobMonitor()
+	utils.SetJobMonitor(jobMonitor)
+
+	// Build the API handlers on the database, configuration, key ring and job monitor
+	h := handlers.New(database.DB, cfg, keyRing, jobMonitor, repository.New(database.DB))
+
+	// Configure heartbeat pings for background jobs
+	heartbeatService := services.NewHeartbeatService(cfg.Heartbeat)
+	utils.SetHeartbeatService(heartbeatService)
+	if jobs := heartbeatService.MonitoredJobs(); len(jobs) > 0 {
+		log.Info().Strs("jobs", jobs).Msg("Heartbeat monitoring enabled")
+	}
+
+	// Let background jobs notify webhooks about content changes
+	utils.SetWebhookService(services.NewWebhookService(database.DB, cfg.Webhooks))
+
+	// Generate share images for posts the scheduler publishes
+	utils.SetOGImageService(services.NewOGImageService(database.DB, cfg))
+
+	// Cross-post the posts the scheduler publishes to social networks
+	utils.SetCrossPostService(services.NewCrossPostService(database.DB, cfg))
+
+	// Load custom roles and keep them in step with changes from other instances
+	utils.StartRoleRefresh()
+
 	// Start the token cleanup routine in the background
 	utils.StartTokenCleanup()
 
+	// Start publishing scheduled posts and taking down expired ones (paused during content freeze windows)
+	utils.StartPostScheduler(cfg.Scheduler)
+
+	// Start expiring fetched news older than the retention period
+	utils.StartNewsRetention(cfg.Retention)
+
+	// Start rebuilding the search index so new and changed content shows up in search
+	utils.StartSearchIndexRefresh(cfg.Search)
+
+	// Start emailing users about comments on their posts and replies to their comments
+	utils.StartCommentEmails(cfg)
+
+	// Start summing reader events into daily post analytics
+	utils.StartAnalyticsRollup(cfg.Analytics)
+
+	// Start sharing published posts on the configured social networks
+	utils.StartCrossPosting(cfg.Social)
+
+	// Start checking the outbound links in published content
+	utils.StartLinkCheck(cfg)
+
 	// Initialize Swagger documentation
 	initSwagger()
 
 	// Initialize the router
 	r := gin.New()
 
+	// Only believe X-Forwarded-For and X-Real-IP from the proxies in front of the API
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatal().Err(err).Msg("Invalid trusted proxies")
+	}
+	if len(cfg.Server.TrustedProxies) == 0 {
+		log.Info().Msg("No trusted proxies configured, client IPs are taken from the connection")
+	}
+
 	// Add recovery middleware
 	r.Use(gin.Recovery())
 
 	// Add request ID middleware
 	r.Use(requestIDMiddleware())
 
+	// Record a span for each request, continuing the caller's trace if any
+	r.Use(middleware.Tracing())
+
 	// Add structured logger middleware
 	r.Use(logger.GinMiddleware())
 
-	// Configure CORS
-	corsConfig := cors.DefaultConfig()
+	// Report which build served each response
+	r.Use(middleware.BuildInfo(cfg.Server.Canary))
 
-	// Add your custom domain to allowed origins
-	customDomains := []string{"https://api.taiphanvan.dev", "https://taiphanvan.dev"}
+	// Add browser security headers to every response
+	if cfg.Security.Enabled {
+		r.Use(middleware.SecurityHeaders(cfg.Security))
+	}
 
-	// Combine with existing allowed origins from config
-	corsConfig.AllowOrigins = append(customDomains, cfg.CORS.AllowedOrigins...)
+	// Gzip large text responses such as post and news content
+	r.Use(middleware.Compress(cfg.Compression))
 
-	// If in development mode, also allow localhost
-	if cfg.Server.GinMode != "release" {
-		corsConfig.AllowOrigins = append(corsConfig.AllowOrigins, "http://localhost:*")
-	}
+	// Serve Cloudinary images in responses through the image CDN
+	r.Use(middleware.ImageURLs(services.NewImageCDN(cfg.ImageCDN, cfg.Cloudinary.CloudName)))
 
-	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"}
-	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID"}
-	corsConfig.ExposeHeaders = []string{"Content-Length", "X-Request-ID"}
-	corsConfig.AllowCredentials = true
-	corsConfig.MaxAge = 12 * time.Hour
+	// Serve the site configured for the request's host
+	r.Use(middleware.Site(services.NewSiteService(database.DB)))
 
-	r.Use(cors.New(corsConfig))
+	// Negotiate the language used for error messages
+	r.Use(middleware.Localization())
 
-	// Initialize and apply rate limiter
-	rateLimiter := middleware.NewRateLimiter(100, time.Minute) // 100 requests per minute per IP
-	rateLimiter.CleanupTask()                                  // Start the cleanup task
+	// Render errors reported by handlers as structured JSON
+	r.Use(middleware.ErrorHandler())
+
+	// Allow cross-origin requests from the configured origins
+	corsPolicy, err := middleware.NewCORSPolicy(cfg.CORS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load CORS origins")
+	}
+	r.Use(middleware.CORS(corsPolicy))
+
+	// Require a CSRF token on requests authenticated by the refresh cookie
+	if cfg.AuthCookie.Enabled {
+		r.Use(middleware.CSRF(cfg.AuthCookie))
+	}
+
+	// Initialize the rate limit store (in-memory or Redis for multi-instance deployments)
+	rateLimitStore, err := middleware.NewRateLimitStore(cfg.RateLimit)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize rate limit store")
+	}
+	rateLimiter := middleware.NewRateLimiter(rateLimitStore, "api", cfg.RateLimit.Requests, cfg.RateLimit.Window)
+	authLimiter := middleware.NewRateLimiter(rateLimitStore, "auth", cfg.RateLimit.AuthRequests, cfg.RateLimit.AuthWindow)
 
 	// Start the news fetcher in background if enabled
-	newsConfig := services.NewNewsConfig(cfg.NewsAPI, cfg.RSS)
+	newsConfig := services.NewNewsConfig(cfg.NewsAPI, cfg.RSS, cfg.NewsIngestion)
 	log.Info().
 		Bool("api_auto_fetch_enabled", newsConfig.EnableAutoFetch).
 		Bool("rss_auto_fetch_enabled", newsConfig.RSSConfig.EnableAutoFetch).
@@ -134,7 +231,7 @@ func main() {
 	utils.StartNewsFetcher(newsConfig)
 
 	// Define API routes with rate limiting
-	setupRoutes(r, rateLimiter)
+	setupRoutes(r, h, cfg, rateLimiter, authLimiter)
 
 	// Create server with graceful shutdown
 	srv := &http.Server{
@@ -174,6 +271,19 @@ func main() {
 	swaggerURL := fmt.Sprintf("%s://%s/swagger/index.html", protocol, host)
 	log.Info().Str("url", swaggerURL).Msg("Swagger documentation available at")
 
+	// Re-read the CORS origins file on SIGHUP
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	go func() {
+		for range reload {
+			if err := corsPolicy.Reload(); err != nil {
+				log.Error().Err(err).Msg("Failed to reload CORS origins, keeping the current ones")
+				continue
+			}
+			log.Info().Msg("Reloaded CORS origins")
+		}
+	}()
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	// Kill (no param) default sends syscall.SIGTERM
@@ -192,6 +302,11 @@ func main() {
 		log.Fatal().Err(err).Msg("Server forced to shutdown")
 	}
 
+	// Export the spans of the last requests
+	if err := tracing.Shutdown(ctx); err != nil {
+		log.Warn().Err(err).Msg("Failed to flush traces")
+	}
+
 	log.Info().Msg("Server exited gracefully")
 }
 
@@ -218,6 +333,10 @@ func requestIDMiddleware() gin.HandlerFunc {
 		c.Set("requestID", requestID)
 		c.Writer.Header().Set("X-Request-ID", requestID)
 
+		// Outbound calls made with the request context carry the ID and are
+		// summarized in the request log
+		c.Request = c.Request.WithContext(tracing.WithRequestID(c.Request.Context(), requestID))
+
 		c.Next()
 	}
 }
@@ -247,7 +366,7 @@ func initSwagger() {
 	swaggerInfo.Description = "A RESTful API for the TaiPhanVan personal blog platform"
 	swaggerInfo.Version = "1.0"
 	swaggerInfo.Host = host
-	swaggerInfo.BasePath = "/api"
+	swaggerInfo.BasePath = routes.BasePath
 
 	// Set the scheme based on environment
 	isProduction := os.Getenv("RAILWAY_SERVICE_ID") != "" || os.Getenv("PRODUCTION") == "true"
@@ -259,7 +378,7 @@ func initSwagger() {
 
 	// Ensure the template variables are properly replaced in the Swagger JSON
 	docs.SwaggerInfo.Host = host
-	docs.SwaggerInfo.BasePath = "/api"
+	docs.SwaggerInfo.BasePath = routes.BasePath
 
 	log.Info().
 		Str("title", swaggerInfo.Title).
@@ -271,95 +390,29 @@ func initSwagger() {
 }
 
 // setupRoutes configures all the routes for the API
-func setupRoutes(r *gin.Engine, rateLimiter *middleware.RateLimiter) {
-	// Define API routes
-	api := r.Group("/api")
-	{
-		// Health check endpoint
-		api.GET("/health", handlers.HealthCheck)
-
-		// Apply rate limiting to all other API routes
-		api.Use(rateLimiter.RateLimitMiddleware())
-
-		// Public routes
-		api.GET("/posts", handlers.GetPosts)
-		api.GET("/posts/slug/:slug", handlers.GetPostBySlug)
-		api.GET("/posts/:id/comments", handlers.GetCommentsByPostID)
-		api.GET("/tags", handlers.GetAllTags)
-		api.GET("/tags/popular", handlers.GetPopularTags)
-
-		// News routes
-		api.GET("/news", handlers.GetNews)
-		api.GET("/news/slug/:slug", handlers.GetNewsBySlug)
-		api.GET("/news/:id", handlers.GetNewsByID)
-		api.GET("/news/:id/full-content", handlers.GetNewsFullContent)
-		api.GET("/news/categories", handlers.GetNewsCategories)
-
-		// Auth routes - stricter rate limiting for sensitive endpoints
-		auth := api.Group("/auth")
-		{
-			// Create more restrictive rate limiter for auth endpoints
-			authLimiter := middleware.NewRateLimiter(20, time.Minute) // 20 requests per minute per IP
-			auth.Use(authLimiter.RateLimitMiddleware())
-
-			auth.POST("/register", handlers.Register)
-			auth.POST("/login", handlers.Login)
-			auth.POST("/refresh", handlers.RefreshToken)
-			auth.POST("/revoke", middleware.AuthMiddleware(), handlers.RevokeToken)
-			auth.POST("/logout", middleware.AuthMiddleware(), handlers.Logout)
-		}
-
-		// Protected routes
-		protected := api.Group("/")
-		protected.Use(middleware.AuthMiddleware())
-		{
-			// User routes
-			protected.GET("/profile", handlers.GetProfile)
-			protected.PUT("/profile", handlers.UpdateProfile)
-			protected.POST("/profile/avatar", handlers.UploadAvatar)
-
-			// File routes for editor
-			protected.POST("/files/upload", handlers.UploadFile)
-			protected.POST("/files/delete", handlers.DeleteFile)
-
-			// Post routes
-			protected.POST("/posts", handlers.CreatePost)
-			protected.PUT("/posts/:id", handlers.UpdatePost)
-			protected.DELETE("/posts/:id", handlers.DeletePost)
-			protected.GET("/posts/me", handlers.GetMyPosts) // New endpoint for dashboard
-			protected.POST("/posts/:id/cover", handlers.UploadPostCover)
-			protected.DELETE("/posts/:id/cover", handlers.DeletePostCover)
-			protected.POST("/posts/:id/publish", handlers.PublishPost)
-			protected.POST("/posts/:id/unpublish", handlers.UnpublishPost)
-			protected.POST("/posts/:id/status", handlers.SetPostStatus)
-
-			// Comment routes
-			protected.POST("/posts/:id/comments", handlers.CreateComment)
-			protected.PUT("/comments/:commentID", handlers.UpdateComment)
-			protected.DELETE("/comments/:commentID", handlers.DeleteComment)
-		}
-
-		// Admin routes
-		admin := protected.Group("/admin")
-		admin.Use(middleware.AdminMiddleware())
-		{
-			// Admin-specific routes can be added here
-
-			// News management routes
-			admin.POST("/news", handlers.CreateNews)
-			admin.PUT("/news/:id", handlers.UpdateNews)
-			admin.DELETE("/news/:id", handlers.DeleteNews)
-			admin.POST("/news/:id/status", handlers.SetNewsStatus)
-			admin.POST("/news/fetch", handlers.FetchExternalNews)
-			admin.POST("/news/fetch-rss", handlers.FetchRSSNews)
-		}
+func setupRoutes(r *gin.Engine, h *handlers.Handler, cfg *config.Config, rateLimiter, authLimiter *middleware.RateLimiter) {
+	// Register the API routes from the route table under /api/v1, and under the
+	// unversioned /api prefix for clients written before versioning, marked as
+	// deprecated
+	registrar := routes.NewRegistrar(r.Group(routes.BasePath, middleware.APIVersion(routes.Version)), map[routes.RateLimit][]gin.HandlerFunc{
+		routes.RateLimitAPI:  {rateLimiter.RateLimitMiddleware()},
+		routes.RateLimitAuth: {rateLimiter.RateLimitMiddleware(), authLimiter.RateLimitMiddleware()},
+	})
+	registrar.Alias(r.Group(routes.LegacyBasePath,
+		middleware.Deprecated(routes.LegacyBasePath, routes.BasePath, cfg.Server.LegacyDeprecatedAt, cfg.Server.LegacySunset),
+		middleware.APIVersion(routes.Version)))
+	registrar.Register(apiRoutes(h))
+
+	// Serve locally stored uploads unless a separate server or CDN hosts them
+	if storage := middleware.AppConfig.Storage; storage.Backend == config.StorageBackendLocal && strings.HasPrefix(storage.Local.PublicURL, "/") {
+		r.Static(storage.Local.PublicURL, storage.Local.Dir)
 	}
 
 	// Add Swagger documentation endpoint with environment-aware configuration
 	r.GET("/swagger/*any", func(c *gin.Context) {
 		// Handle doc.json with the custom handler
 		if c.Param("any") == "/doc.json" {
-			handlers.SwaggerDocHandler(c)
+			h.SwaggerDocHandler(c)
 			return
 		}
 
@@ -394,7 +447,7 @@ func setupRoutes(r *gin.Engine, rateLimiter *middleware.RateLimiter) {
 
 		// Update Swagger info again to ensure it's properly set
 		docs.SwaggerInfo.Host = host
-		docs.SwaggerInfo.BasePath = "/api"
+		docs.SwaggerInfo.BasePath = routes.BasePath
 
 		ginSwagger.WrapHandler(swaggerFiles.Handler,
 			ginSwagger.URL(swaggerURL),
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+)
+
+const migrateUsage = `Usage: api migrate <command>
+
+Commands:
+  up             Apply every pending migration
+  down           Revert the latest applied migration
+  status         List migrations and whether they are applied
+  force VERSION  Record VERSION as the current migration without running any, clearing a failed one
+  create NAME    Create empty up and down files for a new migration`
+
+// runMigrate runs the migrate subcommand with args and returns the exit code
+func runMigrate(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	// Creating a migration only writes files
+	if args[0] == "create" {
+		if len(args) != 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		paths, err := database.CreateMigration(database.MigrationsDir, args[1])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to create migration:", err)
+			return 1
+		}
+		for _, path := range paths {
+			fmt.Println("Created", path)
+		}
+		return 0
+	}
+
+	migrator, err := database.NewMigrator(cfg.Database.DSN)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer migrator.Close()
+
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		applied, err := migrator.Up()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if !applied {
+			fmt.Println("Schema is up to date")
+		}
+	case args[0] == "down" && len(args) == 1:
+		reverted, err := migrator.Down()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if !reverted {
+			fmt.Println("No migration to revert")
+		}
+	case args[0] == "status" && len(args) == 1:
+		status, err := migrator.Status()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		for _, migration := range status {
+			applied := "pending"
+			if migration.Applied {
+				applied = "applied"
+			}
+			fmt.Printf("%04d_%-40s %s\n", migration.Version, migration.Name, applied)
+		}
+	case args[0] == "force" && len(args) == 2:
+		version, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Invalid version:", args[1])
+			return 2
+		}
+		if err := migrator.Force(uint(version)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Printf("Recorded migration %d as the current version\n", version)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+	return 0
+}
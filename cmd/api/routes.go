@@ -0,0 +1,269 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/handlers"
+	"github.com/phanvantai/taiphanvan_backend/internal/routes"
+)
+
+// apiRoutes is the table of every endpoint under /api. Each route declares the
+// access it needs, the rate limit it counts against and, where it matters, its
+// Cache-Control header; the registrar attaches the matching middleware.
+func apiRoutes(h *handlers.Handler) []routes.Route {
+	return []routes.Route{
+		// Health check and build information, exempt from rate limiting for probes
+		{Method: http.MethodGet, Path: "/health", Handler: h.HealthCheck, Access: routes.AccessPublic, RateLimit: routes.RateLimitNone},
+		{Method: http.MethodGet, Path: "/health/live", Handler: h.LivenessCheck, Access: routes.AccessPublic, RateLimit: routes.RateLimitNone},
+		{Method: http.MethodGet, Path: "/health/ready", Handler: h.ReadinessCheck, Access: routes.AccessPublic, RateLimit: routes.RateLimitNone},
+		{Method: http.MethodGet, Path: "/version", Handler: h.GetVersion, Access: routes.AccessPublic, RateLimit: routes.RateLimitNone},
+		{Method: http.MethodGet, Path: "/capabilities", Handler: h.GetCapabilities, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/meta", Handler: h.GetMeta, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/client.ts", Handler: h.GetClient, Access: routes.AccessPublic, Conditional: true},
+
+		// Public routes
+		{Method: http.MethodGet, Path: "/posts", Handler: h.GetPosts, Access: routes.AccessOptional, Conditional: true},
+		{Method: http.MethodGet, Path: "/posts/slug/:slug", Handler: h.GetPostBySlug, Access: routes.AccessOptional, Conditional: true},
+		{Method: http.MethodGet, Path: "/posts/preview/:token", Handler: h.GetPostPreview, Access: routes.AccessPublic, Cache: routes.CacheNoStore},
+		{Method: http.MethodGet, Path: "/posts/:id/comments", Handler: h.GetCommentsByPostID, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/tags", Handler: h.GetAllTags, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/tags/popular", Handler: h.GetPopularTags, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/categories", Handler: h.GetCategories, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/series", Handler: h.GetSeriesList, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/series/:slug", Handler: h.GetSeries, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/stats/public", Handler: h.GetPublicStats, Access: routes.AccessPublic},
+
+		// Author pages
+		{Method: http.MethodGet, Path: "/users/:username", Handler: h.GetPublicProfile, Access: routes.AccessPublic, Conditional: true},
+		{Method: http.MethodGet, Path: "/users/:username/posts", Handler: h.GetAuthorPosts, Access: routes.AccessPublic, Conditional: true},
+
+		// News routes
+		{Method: http.MethodGet, Path: "/news", Handler: h.GetNews, Access: routes.AccessPublic, Conditional: true},
+		{Method: http.MethodGet, Path: "/news/slug/:slug", Handler: h.GetNewsBySlug, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/news/:id", Handler: h.GetNewsByID, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/news/:id/full-content", Handler: h.GetNewsFullContent, Access: routes.AccessPublic},
+		{Method: http.MethodGet, Path: "/news/categories", Handler: h.GetNewsCategories, Access: routes.AccessPublic},
+
+		// Static pages, where editors and admins also see drafts
+		{Method: http.MethodGet, Path: "/pages", Handler: h.GetPages, Access: routes.AccessOptional},
+		{Method: http.MethodGet, Path: "/pages/:slug", Handler: h.GetPageBySlug, Access: routes.AccessOptional, Conditional: true},
+
+		// Search across posts, news and tags
+		{Method: http.MethodGet, Path: "/search", Handler: h.Search, Access: routes.AccessPublic},
+
+		// Homepage feed
+		{Method: http.MethodGet, Path: "/home/feed", Handler: h.GetHomeFeed, Access: routes.AccessPublic},
+
+		// GraphQL, which authenticates the caller when credentials are sent
+		{Method: http.MethodGet, Path: "/graphql", Handler: h.GraphQLQuery, Access: routes.AccessOptional},
+		{Method: http.MethodPost, Path: "/graphql", Handler: h.GraphQL, Access: routes.AccessOptional},
+
+		// Newsletter, subscribing counts against the auth limit since it sends email
+		{Method: http.MethodPost, Path: "/newsletter/subscribe", Handler: h.SubscribeNewsletter, Access: routes.AccessPublic, RateLimit: routes.RateLimitAuth},
+		{Method: http.MethodGet, Path: "/newsletter/confirm", Handler: h.ConfirmNewsletter, Access: routes.AccessPublic, Cache: routes.CacheNoStore},
+		{Method: http.MethodGet, Path: "/newsletter/unsubscribe", Handler: h.UnsubscribeNewsletter, Access: routes.AccessPublic, Cache: routes.CacheNoStore},
+		{Method: http.MethodPost, Path: "/newsletter/unsubscribe", Handler: h.UnsubscribeNewsletter, Access: routes.AccessPublic, Cache: routes.CacheNoStore},
+		{Method: http.MethodGet, Path: "/notifications/email/unsubscribe", Handler: h.UnsubscribeCommentEmails, Access: routes.AccessPublic, Cache: routes.CacheNoStore},
+		{Method: http.MethodPost, Path: "/notifications/email/unsubscribe", Handler: h.UnsubscribeCommentEmails, Access: routes.AccessPublic, Cache: routes.CacheNoStore},
+
+		// Reader analytics, which remembers the signed-in user when they may be tracked
+		{Method: http.MethodPost, Path: "/analytics/events", Handler: h.RecordAnalyticsEvents, Access: routes.AccessOptional},
+
+		// Contact form, which counts against the auth limit since it sends email
+		{Method: http.MethodPost, Path: "/contact", Handler: h.SendContactMessage, Access: routes.AccessPublic, RateLimit: routes.RateLimitAuth},
+
+		// Auth routes - stricter rate limiting for sensitive endpoints
+		{Method: http.MethodPost, Path: "/auth/register", Handler: h.Register, Access: routes.AccessPublic, RateLimit: routes.RateLimitAuth},
+		{Method: http.MethodPost, Path: "/auth/login", Handler: h.Login, Access: routes.AccessPublic, RateLimit: routes.RateLimitAuth},
+		{Method: http.MethodPost, Path: "/auth/refresh", Handler: h.RefreshToken, Access: routes.AccessPublic, RateLimit: routes.RateLimitAuth},
+		{Method: http.MethodPost, Path: "/auth/revoke", Handler: h.RevokeToken, Access: routes.AccessUser, RateLimit: routes.RateLimitAuth, SessionOnly: true},
+		{Method: http.MethodPost, Path: "/auth/logout", Handler: h.Logout, Access: routes.AccessUser, RateLimit: routes.RateLimitAuth, SessionOnly: true},
+
+		// User routes
+		{Method: http.MethodGet, Path: "/profile", Handler: h.GetProfile, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/profile", Handler: h.UpdateProfile, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/profile", Handler: h.DeleteAccount, Access: routes.AccessUser, SessionOnly: true},
+		{Method: http.MethodGet, Path: "/profile/export", Handler: h.ExportAccountData, Access: routes.AccessUser, SessionOnly: true},
+		{Method: http.MethodPost, Path: "/profile/avatar", Handler: h.UploadAvatar, Access: routes.AccessUser, Upload: &handlers.AvatarUpload},
+		{Method: http.MethodGet, Path: "/profile/sessions", Handler: h.GetSessions, Access: routes.AccessUser, SessionOnly: true},
+		{Method: http.MethodDelete, Path: "/profile/sessions/:id", Handler: h.RevokeSession, Access: routes.AccessUser, SessionOnly: true},
+		{Method: http.MethodGet, Path: "/profile/api-keys", Handler: h.GetAPIKeys, Access: routes.AccessUser, SessionOnly: true},
+		{Method: http.MethodPost, Path: "/profile/api-keys", Handler: h.CreateAPIKey, Access: routes.AccessUser, SessionOnly: true},
+		{Method: http.MethodDelete, Path: "/profile/api-keys/:id", Handler: h.RevokeAPIKey, Access: routes.AccessUser, SessionOnly: true},
+
+		// File routes for editor
+		{Method: http.MethodPost, Path: "/files/upload", Handler: h.UploadFile, Access: routes.AccessUser, Upload: &handlers.EditorFileUpload},
+		{Method: http.MethodPost, Path: "/files/delete", Handler: h.DeleteFile, Access: routes.AccessUser},
+
+		// Post routes
+		{Method: http.MethodPost, Path: "/posts", Handler: h.CreatePost, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/posts/:id", Handler: h.UpdatePost, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/posts/:id/autosave", Handler: h.AutosavePost, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/posts/:id/autosave", Handler: h.GetAutosave, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/posts/:id", Handler: h.DeletePost, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/posts/me", Handler: h.GetMyPosts, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/cover", Handler: h.UploadPostCover, Access: routes.AccessUser, Upload: &handlers.CoverUpload},
+		{Method: http.MethodDelete, Path: "/posts/:id/cover", Handler: h.DeletePostCover, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/publish", Handler: h.PublishPost, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/unpublish", Handler: h.UnpublishPost, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/status", Handler: h.SetPostStatus, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/preview-token", Handler: h.CreatePostPreviewToken, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/posts/:id/preview-token", Handler: h.RevokePostPreviewTokens, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/authors", Handler: h.AddPostAuthor, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/posts/:id/authors/:username", Handler: h.RemovePostAuthor, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/posts/:id/analytics", Handler: h.GetPostAnalytics, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/posts/:id/cross-posts", Handler: h.GetPostCrossPosts, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/posts/:id/link-suggestions", Handler: h.GetPostLinkSuggestions, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/posts/:id/cross-posts/retry", Handler: h.RetryPostCrossPosts, Access: routes.AccessUser},
+
+		// Bookmark routes
+		{Method: http.MethodPost, Path: "/posts/:id/bookmark", Handler: h.BookmarkPost, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/posts/:id/bookmark", Handler: h.UnbookmarkPost, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/profile/bookmarks", Handler: h.GetBookmarks, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/news/:id/bookmark", Handler: h.BookmarkNews, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/news/:id/bookmark", Handler: h.UnbookmarkNews, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/profile/reading-list", Handler: h.GetReadingList, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/posts/:id/progress", Handler: h.SaveReadingProgress, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/profile/progress", Handler: h.GetReadingProgress, Access: routes.AccessUser},
+
+		// Notification routes
+		{Method: http.MethodGet, Path: "/notifications", Handler: h.GetNotifications, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/notifications/:id/read", Handler: h.MarkNotificationRead, Access: routes.AccessUser},
+
+		// Author dashboard
+		{Method: http.MethodGet, Path: "/dashboard", Handler: h.GetDashboard, Access: routes.AccessUser},
+
+		// Series routes
+		{Method: http.MethodPost, Path: "/series", Handler: h.CreateSeries, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/series/:id", Handler: h.UpdateSeries, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/series/:id", Handler: h.DeleteSeries, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/series/:id/posts", Handler: h.AddSeriesPost, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/series/:id/posts/:post_id", Handler: h.RemoveSeriesPost, Access: routes.AccessUser},
+
+		// Static page routes for editors
+		{Method: http.MethodPost, Path: "/pages", Handler: h.CreatePage, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/pages/:slug", Handler: h.UpdatePage, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/pages/:slug", Handler: h.DeletePage, Access: routes.AccessUser},
+
+		// Post templates, used by authors and managed by editors
+		{Method: http.MethodGet, Path: "/post-templates", Handler: h.GetPostTemplates, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/post-templates", Handler: h.CreatePostTemplate, Access: routes.AccessUser},
+		{Method: http.MethodGet, Path: "/post-templates/:id", Handler: h.GetPostTemplate, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/post-templates/:id", Handler: h.UpdatePostTemplate, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/post-templates/:id", Handler: h.DeletePostTemplate, Access: routes.AccessUser},
+
+		// Comment routes
+		{Method: http.MethodPost, Path: "/posts/:id/comments", Handler: h.CreateComment, Access: routes.AccessUser},
+		{Method: http.MethodPut, Path: "/comments/:commentID", Handler: h.UpdateComment, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/comments/:commentID", Handler: h.DeleteComment, Access: routes.AccessUser},
+		{Method: http.MethodPost, Path: "/comments/:commentID/reactions/:type", Handler: h.ReactToComment, Access: routes.AccessUser},
+		{Method: http.MethodDelete, Path: "/comments/:commentID/reactions/:type", Handler: h.UnreactToComment, Access: routes.AccessUser},
+
+		// Post import and export
+		{Method: http.MethodGet, Path: "/admin/posts/export", Handler: h.ExportPosts, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/posts/import", Handler: h.ImportPosts, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/posts/import/wordpress", Handler: h.ImportWordPress, Access: routes.AccessAdmin},
+
+		// User management routes
+		{Method: http.MethodPost, Path: "/admin/users/purge", Handler: h.PurgeDeletedUsers, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/users/:id", Handler: h.DeleteUser, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/users/:id/restore", Handler: h.RestoreUser, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/users/:id/role", Handler: h.UpdateUserRole, Access: routes.AccessAdmin},
+
+		// Roles and permissions
+		{Method: http.MethodGet, Path: "/admin/permissions", Handler: h.GetPermissions, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/roles", Handler: h.GetRoles, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/roles", Handler: h.CreateRole, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/roles/:id", Handler: h.UpdateRole, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/roles/:id", Handler: h.DeleteRole, Access: routes.AccessAdmin},
+
+		// Sites served by the deployment
+		{Method: http.MethodGet, Path: "/admin/sites", Handler: h.GetSites, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/sites", Handler: h.CreateSite, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/sites/:id", Handler: h.UpdateSite, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/sites/:id", Handler: h.DeleteSite, Access: routes.AccessAdmin},
+
+		// Audit log
+		{Method: http.MethodGet, Path: "/admin/audit-logs", Handler: h.GetAuditLogs, Access: routes.AccessAdmin},
+
+		// JWT signing keys
+		{Method: http.MethodGet, Path: "/admin/jwt-keys", Handler: h.GetJWTKeys, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/jwt-keys/rotate", Handler: h.RotateJWTKey, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/jwt-keys/:kid", Handler: h.RetireJWTKey, Access: routes.AccessAdmin},
+
+		// Comment moderation routes
+		{Method: http.MethodGet, Path: "/admin/comments/pending", Handler: h.GetPendingComments, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/comments/:commentID/approve", Handler: h.ApproveComment, Access: routes.AccessAdmin},
+
+		// Category management routes
+		{Method: http.MethodPost, Path: "/admin/categories", Handler: h.CreateCategory, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/categories/:id", Handler: h.UpdateCategory, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/categories/:id", Handler: h.DeleteCategory, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/tags/:id", Handler: h.RenameTag, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/tags/:id/merge", Handler: h.MergeTag, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/tags/:id", Handler: h.DeleteTag, Access: routes.AccessAdmin},
+
+		// Outbound link checking
+		{Method: http.MethodGet, Path: "/admin/content/broken-links", Handler: h.GetBrokenLinks, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/content/broken-links/check", Handler: h.CheckLinks, Access: routes.AccessAdmin},
+
+		// Content freeze windows
+		{Method: http.MethodGet, Path: "/admin/freeze-windows", Handler: h.GetFreezeWindows, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/freeze-windows", Handler: h.CreateFreezeWindow, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/freeze-windows/:id", Handler: h.DeleteFreezeWindow, Access: routes.AccessAdmin},
+
+		// Webhook management routes
+		{Method: http.MethodGet, Path: "/admin/webhooks", Handler: h.GetWebhooks, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/webhooks", Handler: h.CreateWebhook, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/webhooks/:id", Handler: h.UpdateWebhook, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/webhooks/:id", Handler: h.DeleteWebhook, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/webhooks/:id/deliveries", Handler: h.GetWebhookDeliveries, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/webhooks/:id/test", Handler: h.TestWebhook, Access: routes.AccessAdmin},
+
+		// Diagnostics
+		{Method: http.MethodGet, Path: "/admin/diagnostics", Handler: h.GetDiagnostics, Access: routes.AccessAdmin},
+
+		// Dashboard stats
+		{Method: http.MethodGet, Path: "/admin/stats", Handler: h.GetAdminStats, Access: routes.AccessAdmin},
+
+		// Newsletter
+		{Method: http.MethodPost, Path: "/admin/newsletter/digest", Handler: h.SendNewsletterDigest, Access: routes.AccessAdmin},
+
+		// Site settings
+		{Method: http.MethodGet, Path: "/admin/settings", Handler: h.GetSiteSettings, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/settings/:key", Handler: h.UpdateSiteSetting, Access: routes.AccessAdmin},
+
+		// Homepage feed curation
+		{Method: http.MethodGet, Path: "/admin/home/picks", Handler: h.GetEditorialPicks, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/home/picks", Handler: h.SetEditorialPick, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/home/picks/:id", Handler: h.DeleteEditorialPick, Access: routes.AccessAdmin},
+
+		// News management routes
+		{Method: http.MethodGet, Path: "/admin/news", Handler: h.GetAdminNews, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news", Handler: h.CreateNews, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/news/:id", Handler: h.UpdateNews, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/news/:id", Handler: h.DeleteNews, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/:id/status", Handler: h.SetNewsStatus, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/bulk-status", Handler: h.BulkSetNewsStatus, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/bulk-delete", Handler: h.BulkDeleteNews, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/:id/commentary", Handler: h.CreateNewsCommentary, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/:id/enrich", Handler: h.EnrichNews, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/enrich-batch", Handler: h.EnrichNewsBatch, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/enrichment-status", Handler: h.GetNewsEnrichmentStatus, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/fetch", Handler: h.FetchExternalNews, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/fetch-rss", Handler: h.FetchRSSNews, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/ingestions", Handler: h.GetIngestionRuns, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/ingestions/:id", Handler: h.GetIngestionRun, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/categories", Handler: h.GetAdminNewsCategories, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/categories", Handler: h.CreateNewsCategory, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/news/categories/:id", Handler: h.UpdateNewsCategory, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/sources", Handler: h.GetNewsSources, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/sources", Handler: h.CreateNewsSource, Access: routes.AccessAdmin},
+		{Method: http.MethodPut, Path: "/admin/news/sources/:id", Handler: h.UpdateNewsSource, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/news/sources/:id", Handler: h.DeleteNewsSource, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/fetch-history", Handler: h.GetNewsFetchHistory, Access: routes.AccessAdmin},
+		{Method: http.MethodGet, Path: "/admin/news/views", Handler: h.GetNewsViews, Access: routes.AccessAdmin},
+		{Method: http.MethodPost, Path: "/admin/news/views", Handler: h.CreateNewsView, Access: routes.AccessAdmin},
+		{Method: http.MethodDelete, Path: "/admin/news/views/:id", Handler: h.DeleteNewsView, Access: routes.AccessAdmin},
+	}
+}
@@ -0,0 +1,533 @@
+// Command genclient generates the TypeScript API client in docs/client.ts from
+// the Swagger spec. The client is embedded in the binary and served at
+// /api/v1/client.ts, so frontends can always download one matching the API.
+//
+// Run it after regenerating the Swagger docs:
+//
+//	swag init -g cmd/api/main.go -o docs && go run ./cmd/genclient
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	docsDir := flag.String("docs", "docs", "Directory containing swagger.json and the generated client")
+	check := flag.Bool("check", false, "Exit with an error if the generated client is out of date instead of writing it")
+	flag.Parse()
+
+	if err := run(*docsDir, *check); err != nil {
+		fmt.Fprintln(os.Stderr, "genclient:", err)
+		os.Exit(1)
+	}
+}
+
+func run(docsDir string, check bool) error {
+	specPath := filepath.Join(docsDir, "swagger.json")
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", specPath, err)
+	}
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", specPath, err)
+	}
+
+	source := generate(&s)
+
+	outPath := filepath.Join(docsDir, "client.ts")
+	if check {
+		current, err := os.ReadFile(outPath)
+		if err != nil || !bytes.Equal(current, source) {
+			return fmt.Errorf("%s is out of date, run go run ./cmd/genclient", outPath)
+		}
+		return nil
+	}
+
+	if err := os.WriteFile(outPath, source, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outPath, err)
+	}
+	fmt.Printf("Wrote a client with %d types and %d operations to %s\n", len(s.Definitions), s.operationCount(), outPath)
+	return nil
+}
+
+// spec is the part of a Swagger 2.0 document the client is generated from
+type spec struct {
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	BasePath    string                          `json:"basePath"`
+	Paths       map[string]map[string]operation `json:"paths"`
+	Definitions map[string]*schema              `json:"definitions"`
+}
+
+func (s *spec) operationCount() int {
+	count := 0
+	for _, operations := range s.Paths {
+		count += len(operations)
+	}
+	return count
+}
+
+type operation struct {
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Produces    []string            `json:"produces"`
+	Parameters  []parameter         `json:"parameters"`
+	Responses   map[string]response `json:"responses"`
+}
+
+type parameter struct {
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description"`
+	Required    bool          `json:"required"`
+	Type        string        `json:"type"`
+	Items       *schema       `json:"items"`
+	Enum        []interface{} `json:"enum"`
+	Schema      *schema       `json:"schema"`
+}
+
+type response struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Description          string             `json:"description"`
+	Enum                 []interface{}      `json:"enum"`
+	Items                *schema            `json:"items"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	AllOf                []*schema          `json:"allOf"`
+}
+
+// methodOrder is the order operations on the same path are written in
+var methodOrder = []string{"get", "post", "put", "patch", "delete"}
+
+// generator writes the client; names maps Swagger definition names to the
+// TypeScript type names they are written as
+type generator struct {
+	buf   bytes.Buffer
+	names map[string]string
+}
+
+func generate(s *spec) []byte {
+	g := &generator{names: typeNames(s.Definitions)}
+
+	g.printf("// Code generated by cmd/genclient. DO NOT EDIT.\n")
+	g.printf("//\n// TypeScript client for the %s, version %s.\n", s.Info.Title, s.Info.Version)
+	g.printf("// Download the current one from /api/v1/client.ts.\n\n")
+	g.printf("export const API_VERSION = %s;\n\n", strconv.Quote(s.Info.Version))
+	g.buf.WriteString(strings.ReplaceAll(runtime, "{{BASE_PATH}}", strconv.Quote(s.BasePath)))
+
+	definitions := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		definitions = append(definitions, name)
+	}
+	sort.Slice(definitions, func(i, j int) bool { return g.names[definitions[i]] < g.names[definitions[j]] })
+	for _, name := range definitions {
+		g.definition(g.names[name], s.Definitions[name])
+	}
+
+	paths := make([]string, 0, len(s.Paths))
+	for path := range s.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	g.printf("export class ApiClient extends BaseClient {\n")
+	used := make(map[string]bool)
+	for _, path := range paths {
+		for _, method := range methodOrder {
+			op, ok := s.Paths[path][method]
+			if !ok {
+				continue
+			}
+			name := operationName(method, path)
+			for i := 2; used[name]; i++ {
+				name = operationName(method, path) + strconv.Itoa(i)
+			}
+			used[name] = true
+			g.operation(name, method, path, op)
+		}
+	}
+	g.printf("}\n")
+	return g.buf.Bytes()
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// definition writes a Swagger definition as an interface, or as a type alias
+// when it isn't an object
+func (g *generator) definition(name string, s *schema) {
+	g.comment("", s.Description)
+	if len(s.Properties) == 0 || len(s.AllOf) > 0 {
+		g.printf("export type %s = %s;\n\n", name, g.tsType(s))
+		return
+	}
+	g.printf("export interface %s {\n", name)
+	g.properties("  ", s)
+	g.printf("}\n\n")
+}
+
+func (g *generator) properties(indent string, s *schema) {
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	for _, name := range sortedKeys(s.Properties) {
+		property := s.Properties[name]
+		g.comment(indent, property.Description)
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+		g.printf("%s%s%s: %s;\n", indent, propertyName(name), optional, g.tsType(property))
+	}
+}
+
+func (g *generator) comment(indent, text string) {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "*/", "* /"))
+	if text == "" {
+		return
+	}
+	g.printf("%s/** %s */\n", indent, strings.ReplaceAll(text, "\n", " "))
+}
+
+// tsType returns the TypeScript type of a schema
+func (g *generator) tsType(s *schema) string {
+	if s == nil {
+		return "unknown"
+	}
+	switch {
+	case s.Ref != "":
+		return g.names[strings.TrimPrefix(s.Ref, "#/definitions/")]
+	case len(s.AllOf) > 0:
+		parts := make([]string, len(s.AllOf))
+		for i, part := range s.AllOf {
+			parts[i] = g.tsType(part)
+		}
+		return strings.Join(parts, " & ")
+	case len(s.Enum) > 0:
+		return enumType(s.Enum)
+	}
+
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "file":
+		return "Blob"
+	case "array":
+		item := g.tsType(s.Items)
+		if strings.ContainsAny(item, "|&") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object":
+		if len(s.Properties) > 0 {
+			var inline generator
+			inline.names = g.names
+			inline.properties("", s)
+			fields := strings.Split(strings.TrimSpace(inline.buf.String()), "\n")
+			return "{ " + strings.Join(fields, " ") + " }"
+		}
+		var additional schema
+		if len(s.AdditionalProperties) > 0 && json.Unmarshal(s.AdditionalProperties, &additional) == nil {
+			return "Record<string, " + g.tsType(&additional) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// operation writes a client method calling one endpoint. Path parameters come
+// first, then the body or the form fields, then an object of query parameters.
+func (g *generator) operation(name, method, path string, op operation) {
+	var args, pathArgs, formArgs []string
+	var bodyArg string
+	var query []parameter
+	queryRequired := false
+	urlPath := path
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			arg := identifier(p.Name)
+			args = append(args, arg+": string | number")
+			pathArgs = append(pathArgs, arg)
+			urlPath = strings.ReplaceAll(urlPath, "{"+p.Name+"}", "${encodeURIComponent(String("+arg+"))}")
+		case "body":
+			bodyArg = "body"
+			optional := "?"
+			if p.Required {
+				optional = ""
+			}
+			args = append(args, "body"+optional+": "+g.tsType(p.Schema))
+		case "formData":
+			arg := identifier(p.Name)
+			optional := "?"
+			if p.Required {
+				optional = ""
+			}
+			args = append(args, arg+optional+": "+g.tsType(&schema{Type: p.Type, Items: p.Items, Enum: p.Enum}))
+			formArgs = append(formArgs, fmt.Sprintf("[%s, %s]", strconv.Quote(p.Name), arg))
+		case "query":
+			query = append(query, p)
+			queryRequired = queryRequired || p.Required
+		}
+	}
+	if len(query) > 0 {
+		fields := make([]string, len(query))
+		for i, p := range query {
+			optional := "?"
+			if p.Required {
+				optional = ""
+			}
+			fields[i] = propertyName(p.Name) + optional + ": " + g.tsType(&schema{Type: p.Type, Items: p.Items, Enum: p.Enum})
+		}
+		optional := "?"
+		if queryRequired {
+			optional = ""
+		}
+		args = append(args, "query"+optional+": { "+strings.Join(fields, "; ")+" }")
+	}
+
+	summary := op.Summary
+	if summary == "" {
+		summary = op.Description
+	}
+	g.comment("  ", fmt.Sprintf("%s — %s %s", summary, strings.ToUpper(method), path))
+
+	body := "undefined"
+	switch {
+	case len(formArgs) > 0:
+		body = "formData([" + strings.Join(formArgs, ", ") + "])"
+	case bodyArg != "":
+		body = bodyArg
+	}
+	queryArg := "undefined"
+	if len(query) > 0 {
+		queryArg = "query"
+	}
+
+	g.printf("  %s(%s): Promise<%s> {\n", name, strings.Join(args, ", "), g.responseType(op))
+	g.printf("    return this.request(%s, `%s`, %s, %s);\n", strconv.Quote(strings.ToUpper(method)), urlPath, queryArg, body)
+	g.printf("  }\n\n")
+}
+
+// responseType is the type of an operation's first successful response
+func (g *generator) responseType(op operation) string {
+	for _, produces := range op.Produces {
+		if produces != "application/json" {
+			return "Blob"
+		}
+	}
+	codes := sortedKeys(op.Responses)
+	for _, code := range codes {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		if response := op.Responses[code]; response.Schema != nil {
+			return g.tsType(response.Schema)
+		}
+		return "void"
+	}
+	return "void"
+}
+
+// typeNames names each definition after its Go type, keeping the package
+// only for names two packages share and for packages other than models
+func typeNames(definitions map[string]*schema) map[string]string {
+	names := make(map[string]string, len(definitions))
+	for name := range definitions {
+		pkg, typ, ok := strings.Cut(name, ".")
+		if !ok {
+			names[name] = identifier(name)
+			continue
+		}
+		if pkg == "models" {
+			names[name] = pascal(typ)
+		} else {
+			names[name] = pascal(pkg) + pascal(typ)
+		}
+	}
+	return names
+}
+
+// operationName derives a method name from the HTTP method and path, e.g.
+// GET /posts/{id}/comments becomes getPostsByIdComments
+func operationName(method, path string) string {
+	var b strings.Builder
+	b.WriteString(method)
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") {
+			b.WriteString("By")
+			segment = strings.Trim(segment, "{}")
+		}
+		b.WriteString(pascal(segment))
+	}
+	return b.String()
+}
+
+// pascal turns snake_case, kebab-case and dotted names into PascalCase
+func pascal(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// identifier turns a parameter name into a valid camelCase identifier
+func identifier(name string) string {
+	p := pascal(name)
+	if p == "" {
+		return "value"
+	}
+	runes := []rune(p)
+	runes[0] = unicode.ToLower(runes[0])
+	if unicode.IsDigit(runes[0]) {
+		return "_" + string(runes)
+	}
+	return string(runes)
+}
+
+// propertyName quotes names that aren't valid identifiers
+func propertyName(name string) string {
+	for i, r := range name {
+		if !(unicode.IsLetter(r) || r == '_' || r == '$' || (i > 0 && unicode.IsDigit(r))) {
+			return strconv.Quote(name)
+		}
+	}
+	return name
+}
+
+func enumType(values []interface{}) string {
+	literals := make([]string, len(values))
+	for i, value := range values {
+		encoded, _ := json.Marshal(value)
+		literals[i] = string(encoded)
+	}
+	return strings.Join(literals, " | ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// runtime is the part of the client shared by every operation
+const runtime = `export const DEFAULT_BASE_URL = {{BASE_PATH}};
+
+export interface ClientOptions {
+  /** URL of the API including the version prefix, e.g. https://api.example.com/api/v1 */
+  baseUrl?: string;
+  /** Bearer token, or a function returning the current one */
+  token?: string | (() => string | undefined | Promise<string | undefined>);
+  /** API key sent in the X-API-Key header instead of a token */
+  apiKey?: string;
+  /** Extra headers sent with every request, e.g. Accept-Language */
+  headers?: Record<string, string>;
+  /** fetch implementation, defaults to the global one */
+  fetch?: typeof fetch;
+}
+
+/** Thrown for every response with an error status */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly body: ErrorResponse | undefined,
+  ) {
+    super(body?.message ?? ` + "`Request failed with status ${status}`" + `);
+    this.name = "ApiError";
+  }
+
+  /** Machine-readable error code, e.g. post_not_found */
+  get code(): string | undefined {
+    return this.body?.code;
+  }
+}
+
+type QueryValue = string | number | boolean | undefined | null | Array<string | number | boolean>;
+
+function formData(fields: Array<[string, unknown]>): FormData {
+  const form = new FormData();
+  for (const [name, value] of fields) {
+    if (value === undefined || value === null) continue;
+    form.append(name, value instanceof Blob ? value : String(value));
+  }
+  return form;
+}
+
+class BaseClient {
+  constructor(protected readonly options: ClientOptions = {}) {}
+
+  protected async request<T>(method: string, path: string, query?: Record<string, QueryValue>, body?: unknown): Promise<T> {
+    const params = new URLSearchParams();
+    for (const [name, value] of Object.entries(query ?? {})) {
+      if (value === undefined || value === null) continue;
+      for (const item of Array.isArray(value) ? value : [value]) params.append(name, String(item));
+    }
+    const search = params.toString();
+    const url = (this.options.baseUrl ?? DEFAULT_BASE_URL) + path + (search ? "?" + search : "");
+
+    const headers: Record<string, string> = { Accept: "application/json", ...this.options.headers };
+    const token = typeof this.options.token === "function" ? await this.options.token() : this.options.token;
+    if (token) headers.Authorization = "Bearer " + token;
+    if (this.options.apiKey) headers["X-API-Key"] = this.options.apiKey;
+
+    let payload: BodyInit | undefined;
+    if (body instanceof FormData) {
+      payload = body;
+    } else if (body !== undefined) {
+      headers["Content-Type"] = "application/json";
+      payload = JSON.stringify(body);
+    }
+
+    const response = await (this.options.fetch ?? fetch)(url, { method, headers, body: payload });
+    const contentType = response.headers.get("Content-Type") ?? "";
+    if (!response.ok) {
+      const error = contentType.includes("json") ? ((await response.json()) as ErrorResponse) : undefined;
+      throw new ApiError(response.status, error);
+    }
+    if (response.status === 204) return undefined as T;
+    if (contentType.includes("json")) return (await response.json()) as T;
+    return (await response.blob()) as T;
+  }
+}
+
+`
@@ -0,0 +1,100 @@
+// Command genexamples serializes the model fixtures into docs/examples_gen.go.
+// The examples are merged into the served Swagger document so that documented
+// request and response bodies always match the real JSON encoding of the models.
+//
+// Run it after regenerating the Swagger docs:
+//
+//	swag init -g cmd/api/main.go -o docs && go run ./cmd/genexamples
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/fixtures"
+)
+
+func main() {
+	docsDir := flag.String("docs", "docs", "Directory containing swagger.json and the generated examples")
+	check := flag.Bool("check", false, "Exit with an error if the generated file is out of date instead of writing it")
+	flag.Parse()
+
+	if err := run(*docsDir, *check); err != nil {
+		fmt.Fprintln(os.Stderr, "genexamples:", err)
+		os.Exit(1)
+	}
+}
+
+func run(docsDir string, check bool) error {
+	definitions, err := loadDefinitions(filepath.Join(docsDir, "swagger.json"))
+	if err != nil {
+		return err
+	}
+
+	examples := fixtures.Examples()
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		if _, ok := definitions[name]; !ok {
+			return fmt.Errorf("fixture %q has no matching Swagger definition", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by cmd/genexamples. DO NOT EDIT.\n\n")
+	buf.WriteString("package docs\n\n")
+	buf.WriteString("// Examples maps Swagger definition names to example JSON produced from the model fixtures\n")
+	buf.WriteString("var Examples = map[string]string{\n")
+	for _, name := range names {
+		body, err := json.Marshal(examples[name])
+		if err != nil {
+			return fmt.Errorf("failed to encode fixture %q: %w", name, err)
+		}
+		fmt.Fprintf(&buf, "%s: %s,\n", strconv.Quote(name), strconv.Quote(string(body)))
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	outPath := filepath.Join(docsDir, "examples_gen.go")
+	if check {
+		current, err := os.ReadFile(outPath)
+		if err != nil || !bytes.Equal(current, source) {
+			return fmt.Errorf("%s is out of date, run go run ./cmd/genexamples", outPath)
+		}
+		return nil
+	}
+
+	if err := os.WriteFile(outPath, source, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outPath, err)
+	}
+	fmt.Printf("Wrote %d examples to %s\n", len(names), outPath)
+	return nil
+}
+
+// loadDefinitions reads the definitions section of the generated Swagger spec
+func loadDefinitions(path string) (map[string]json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var spec struct {
+		Definitions map[string]json.RawMessage `json:"definitions"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return spec.Definitions, nil
+}
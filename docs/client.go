@@ -0,0 +1,8 @@
+package docs
+
+import _ "embed"
+
+// ClientTS is the TypeScript API client generated by cmd/genclient
+//
+//go:embed client.ts
+var ClientTS string
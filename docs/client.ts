@@ -0,0 +1,2707 @@
+// Code generated by cmd/genclient. DO NOT EDIT.
+//
+// TypeScript client for the TaiPhanVan API, version 1.0.
+// Download the current one from /api/v1/client.ts.
+
+export const API_VERSION = "1.0";
+
+export const DEFAULT_BASE_URL = "/api/v1";
+
+export interface ClientOptions {
+  /** URL of the API including the version prefix, e.g. https://api.example.com/api/v1 */
+  baseUrl?: string;
+  /** Bearer token, or a function returning the current one */
+  token?: string | (() => string | undefined | Promise<string | undefined>);
+  /** API key sent in the X-API-Key header instead of a token */
+  apiKey?: string;
+  /** Extra headers sent with every request, e.g. Accept-Language */
+  headers?: Record<string, string>;
+  /** fetch implementation, defaults to the global one */
+  fetch?: typeof fetch;
+}
+
+/** Thrown for every response with an error status */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly body: ErrorResponse | undefined,
+  ) {
+    super(body?.message ?? `Request failed with status ${status}`);
+    this.name = "ApiError";
+  }
+
+  /** Machine-readable error code, e.g. post_not_found */
+  get code(): string | undefined {
+    return this.body?.code;
+  }
+}
+
+type QueryValue = string | number | boolean | undefined | null | Array<string | number | boolean>;
+
+function formData(fields: Array<[string, unknown]>): FormData {
+  const form = new FormData();
+  for (const [name, value] of fields) {
+    if (value === undefined || value === null) continue;
+    form.append(name, value instanceof Blob ? value : String(value));
+  }
+  return form;
+}
+
+class BaseClient {
+  constructor(protected readonly options: ClientOptions = {}) {}
+
+  protected async request<T>(method: string, path: string, query?: Record<string, QueryValue>, body?: unknown): Promise<T> {
+    const params = new URLSearchParams();
+    for (const [name, value] of Object.entries(query ?? {})) {
+      if (value === undefined || value === null) continue;
+      for (const item of Array.isArray(value) ? value : [value]) params.append(name, String(item));
+    }
+    const search = params.toString();
+    const url = (this.options.baseUrl ?? DEFAULT_BASE_URL) + path + (search ? "?" + search : "");
+
+    const headers: Record<string, string> = { Accept: "application/json", ...this.options.headers };
+    const token = typeof this.options.token === "function" ? await this.options.token() : this.options.token;
+    if (token) headers.Authorization = "Bearer " + token;
+    if (this.options.apiKey) headers["X-API-Key"] = this.options.apiKey;
+
+    let payload: BodyInit | undefined;
+    if (body instanceof FormData) {
+      payload = body;
+    } else if (body !== undefined) {
+      headers["Content-Type"] = "application/json";
+      payload = JSON.stringify(body);
+    }
+
+    const response = await (this.options.fetch ?? fetch)(url, { method, headers, body: payload });
+    const contentType = response.headers.get("Content-Type") ?? "";
+    if (!response.ok) {
+      const error = contentType.includes("json") ? ((await response.json()) as ErrorResponse) : undefined;
+      throw new ApiError(response.status, error);
+    }
+    if (response.status === 204) return undefined as T;
+    if (contentType.includes("json")) return (await response.json()) as T;
+    return (await response.blob()) as T;
+  }
+}
+
+/** An API key for programmatic access. The key itself is only shown when it is created. */
+export interface APIKey {
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  last_used_at?: string;
+  name?: string;
+  prefix?: string;
+  revoked_at?: string;
+  scopes?: APIKeyScope[];
+  user_id?: number;
+}
+
+export type APIKeyScope = "read" | "write";
+
+/** API version, generated client checksum and feature flags */
+export interface APIMeta {
+  api_version?: string;
+  client_checksum?: string;
+  features?: Record<string, boolean>;
+  git_sha?: string;
+}
+
+/** Request model for adding a co-author to a post */
+export interface AddPostAuthorRequest {
+  role: PostAuthorRole;
+  username: string;
+}
+
+/** Request model for adding a post to a series or moving it within one */
+export interface AddSeriesPostRequest {
+  position?: number;
+  post_id: string;
+}
+
+/** Filters for the admin news list */
+export interface AdminNewsFilter {
+  category?: NewsCategory;
+  from?: string;
+  has_image?: boolean;
+  search?: string;
+  source?: string;
+  status?: NewsStatus;
+  tag?: string;
+  to?: string;
+  truncated?: boolean;
+}
+
+/** Aggregate counts and time series for the admin dashboard */
+export interface AdminStats {
+  comments_per_day?: StatsPoint[];
+  generated_at?: string;
+  new_users_per_day?: StatsPoint[];
+  news_per_day?: IngestionStatsPoint[];
+  posts_per_month?: StatsPoint[];
+  top_tags?: TagWithCount[];
+  totals?: AdminStatsTotals;
+}
+
+/** Overall counters for the admin dashboard */
+export interface AdminStatsTotals {
+  comments?: number;
+  comments_by_status?: Record<string, number>;
+  news?: number;
+  post_views?: number;
+  posts?: number;
+  posts_by_status?: Record<string, number>;
+  tags?: number;
+  users?: number;
+}
+
+/** A reader event on a post page */
+export interface AnalyticsEventInput {
+  post_id: number;
+  progress?: number;
+  reader_id?: string;
+  type: AnalyticsEventType;
+  view_id: string;
+}
+
+export type AnalyticsEventType = "pageview" | "progress";
+
+/** Request model for reporting a batch of reader events */
+export interface AnalyticsEventsRequest {
+  events: AnalyticsEventInput[];
+}
+
+/** Number of reported events that were stored */
+export interface AnalyticsEventsResponse {
+  accepted?: number;
+}
+
+/** An entry in the audit log */
+export interface AuditLog {
+  action?: string;
+  actor_id?: number;
+  actor_role?: string;
+  after?: unknown;
+  before?: unknown;
+  created_at?: string;
+  id?: number;
+  ip_address?: string;
+  request_id?: string;
+  resource_id?: string;
+  resource_type?: string;
+}
+
+/** Response model for listing audit log entries */
+export interface AuditLogListResponse {
+  logs?: AuditLog[];
+  page?: number;
+  per_page?: number;
+  total_items?: number;
+  total_pages?: number;
+}
+
+/** Summary of the current user's posts, comments and notifications for the dashboard home screen */
+export interface AuthorDashboard {
+  awaiting_reply?: Comment[];
+  draft_count?: number;
+  generated_at?: string;
+  notifications?: Notification[];
+  scheduled_posts?: Post[];
+  top_posts?: DashboardPostTrend[];
+  unread_notifications?: number;
+}
+
+/** Request model for saving a draft snapshot of a post being edited */
+export interface AutosavePostRequest {
+  base_updated_at: string;
+  content?: string;
+  excerpt?: string;
+  title?: string;
+}
+
+/** A post saved to read later */
+export interface Bookmark {
+  created_at?: string;
+  id?: number;
+  post?: Post;
+  post_id?: number;
+}
+
+/** Broken outbound link and the content it is in */
+export interface BrokenLink {
+  broken?: boolean;
+  broken_at?: string;
+  checked_at?: string;
+  created_at?: string;
+  domain?: string;
+  error?: string;
+  id?: number;
+  source_id?: number;
+  source_slug?: string;
+  source_title?: string;
+  source_type?: ContentLinkSource;
+  status_code?: number;
+  updated_at?: string;
+  url?: string;
+}
+
+/** Request model for deleting news articles in bulk */
+export interface BulkNewsDeleteRequest {
+  ids: number[];
+}
+
+/** Request model for changing the status of news articles in bulk */
+export interface BulkNewsStatusRequest {
+  ids: number[];
+  status: NewsStatus;
+}
+
+/** Endpoints served by this API instance */
+export interface CapabilitiesResponse {
+  routes?: RouteCapability[];
+}
+
+/** A post category that can be nested under a parent category */
+export interface Category {
+  children?: Category[];
+  created_at?: string;
+  description?: string;
+  id?: number;
+  name?: string;
+  parent_id?: number;
+  position?: number;
+  slug?: string;
+  updated_at?: string;
+}
+
+/** A comment made by a user on a specific post */
+export interface Comment {
+  content?: string;
+  created_at?: string;
+  flag_reason?: string;
+  id?: number;
+  mentions?: CommentMention[];
+  parent_id?: number;
+  post?: Post;
+  post_id?: number;
+  reactions?: CommentReactionCounts;
+  status?: CommentStatus;
+  updated_at?: string;
+  user?: User;
+  user_id?: number;
+  uuid?: string;
+}
+
+export type CommentEmails = "immediate" | "daily" | "off";
+
+/** A user mentioned in a comment */
+export interface CommentMention {
+  created_at?: string;
+  user?: User;
+  user_id?: number;
+}
+
+/** A user's like or heart on a comment */
+export interface CommentReaction {
+  comment_id?: number;
+  created_at?: string;
+  id?: number;
+  type?: CommentReactionType;
+  user_id?: number;
+}
+
+/** Number of reactions to a comment of each type */
+export interface CommentReactionCounts {
+  heart?: number;
+  like?: number;
+}
+
+export type CommentReactionType = "like" | "heart";
+
+export type CommentStatus = "approved" | "pending";
+
+/** Request model for sending a message to the site owner */
+export interface ContactRequest {
+  captcha_token?: string;
+  email: string;
+  message: string;
+  name: string;
+  /** Website is a honeypot: clients render it as a hidden field and leave it empty, so a value means the form was filled in by a bot */
+  website?: string;
+}
+
+export type ContentLinkSource = "post" | "news";
+
+export interface ContentStatus {
+  byline?: string;
+  fetch_error?: string;
+  has_full_content?: boolean;
+  is_truncated?: boolean;
+  lead_image?: string;
+  truncated_chars?: number;
+}
+
+/** Request model for creating an API key */
+export interface CreateAPIKeyRequest {
+  expires_in_days?: number;
+  name: string;
+  scopes: APIKeyScope[];
+}
+
+/** A newly created API key, including the key itself, which is not shown again */
+export interface CreateAPIKeyResponse {
+  created_at?: string;
+  expires_at?: string;
+  id?: number;
+  key?: string;
+  last_used_at?: string;
+  name?: string;
+  prefix?: string;
+  revoked_at?: string;
+  scopes?: APIKeyScope[];
+  user_id?: number;
+}
+
+/** Request model for creating a post category */
+export interface CreateCategoryRequest {
+  description?: string;
+  name: string;
+  parent_id?: number;
+  position?: number;
+  slug?: string;
+}
+
+/** Request model for creating a new comment on a post */
+export interface CreateCommentRequest {
+  content: string;
+  parent_id?: number;
+  /** Website is a honeypot: clients render it as a hidden field and leave it empty, so a value means the form was filled in by a bot */
+  website?: string;
+}
+
+/** Request model for creating a content freeze window */
+export interface CreateFreezeWindowRequest {
+  ends_at: string;
+  reason: string;
+  starts_at: string;
+}
+
+/** Request model for creating a news category */
+export interface CreateNewsCategoryRequest {
+  enabled?: boolean;
+  keywords?: string[];
+  name: string;
+  slug: string;
+}
+
+/** Request model for creating a commentary post from a news article */
+export interface CreateNewsCommentaryRequest {
+  commentary?: string;
+  title?: string;
+}
+
+/** Request model for creating a news article */
+export interface CreateNewsRequest {
+  category?: NewsCategory;
+  content: string;
+  image_url?: string;
+  language?: "en" | "vi";
+  publish_date?: string;
+  source: string;
+  source_url?: string;
+  status?: NewsStatus;
+  summary?: string;
+  tags?: string[];
+  title: string;
+}
+
+/** Request model for adding an RSS feed */
+export interface CreateNewsSourceRequest {
+  category?: string;
+  enabled?: boolean;
+  fetch_interval_minutes?: number;
+  name: string;
+  url: string;
+}
+
+/** Request model for saving a filter for the admin news list */
+export interface CreateNewsViewRequest {
+  filter?: AdminNewsFilter;
+  name: string;
+}
+
+/** Request model for creating a static page */
+export interface CreatePageRequest {
+  content?: string;
+  slug?: string;
+  status?: PageStatus;
+  title: string;
+}
+
+/** Request model for creating a new blog post */
+export interface CreatePostRequest {
+  category_id?: number;
+  content?: string;
+  cover?: string;
+  excerpt?: string;
+  expires_at?: string;
+  language?: "en" | "vi";
+  publish_at?: string;
+  skip_cross_post?: boolean;
+  status?: PostStatus;
+  tags?: string[];
+  template_id?: number;
+  title?: string;
+  translation_of?: number;
+}
+
+/** Request model for creating a post template */
+export interface CreatePostTemplateRequest {
+  category_id?: number;
+  content?: string;
+  description?: string;
+  excerpt?: string;
+  language?: "en" | "vi";
+  name: string;
+  status?: PostStatus;
+  tags?: string[];
+  title_pattern: string;
+}
+
+/** Request model for creating a custom role */
+export interface CreateRoleRequest {
+  description?: string;
+  name: string;
+  permissions?: string[];
+}
+
+/** Request model for creating a post series */
+export interface CreateSeriesRequest {
+  description?: string;
+  slug?: string;
+  title: string;
+}
+
+/** Request model for adding a site */
+export interface CreateSiteRequest {
+  domain: string;
+  is_default?: boolean;
+  name: string;
+}
+
+/** Request model for registering a webhook */
+export interface CreateWebhookRequest {
+  active?: boolean;
+  description?: string;
+  events: string[];
+  url: string;
+}
+
+/** Delivery of a published post to a social network */
+export interface CrossPost {
+  attempts?: number;
+  created_at?: string;
+  external_id?: string;
+  id?: number;
+  last_error?: string;
+  next_attempt_at?: string;
+  post_id?: number;
+  sent_at?: string;
+  status?: CrossPostStatus;
+  target?: CrossPostTarget;
+  updated_at?: string;
+}
+
+/** Result of retrying a post's failed cross-posts */
+export interface CrossPostRetryResponse {
+  cross_posts?: CrossPost[];
+  retried?: number;
+}
+
+export type CrossPostStatus = "pending" | "sent" | "failed" | "canceled";
+
+export type CrossPostTarget = "x" | "telegram" | "linkedin";
+
+/** Views of a post per day over the last 30 days */
+export interface DashboardPostTrend {
+  days?: StatsPoint[];
+  post_id?: number;
+  slug?: string;
+  title?: string;
+  views?: number;
+}
+
+/** Request model for deleting the current user's account */
+export interface DeleteAccountRequest {
+  password: string;
+}
+
+/** Request model for deleting a file */
+export interface DeleteFileRequest {
+  file_url: string;
+}
+
+/** The result of a single diagnostic check */
+export interface DiagnosticCheck {
+  duration_ms?: number;
+  message?: string;
+  name?: string;
+  status?: DiagnosticStatus;
+}
+
+export type DiagnosticStatus = "pass" | "warn" | "fail";
+
+/** Report of the checks run against the application's dependencies */
+export interface DiagnosticsReport {
+  checks?: DiagnosticCheck[];
+  generated_at?: string;
+  status?: DiagnosticStatus;
+}
+
+/** A digest queued for delivery */
+export interface DigestResponse {
+  posts?: number;
+  recipients?: number;
+  since?: string;
+}
+
+/** An editorial boost for a post or news article */
+export interface EditorialPick {
+  created_at?: string;
+  created_by?: number;
+  id?: number;
+  item_id?: number;
+  item_type?: FeedItemType;
+  updated_at?: string;
+  weight?: number;
+}
+
+/** Request model for enriching news articles in a batch */
+export interface EnrichNewsBatchRequest {
+  ids?: number[];
+  limit?: number;
+  retry_failed?: boolean;
+}
+
+/** An error response */
+export interface ErrorResponse {
+  code?: string;
+  details?: unknown;
+  error?: string;
+  message?: string;
+  request_id?: string;
+  status?: string;
+}
+
+/** A post or news article in the homepage feed */
+export interface FeedItem {
+  category?: string;
+  editorial_weight?: number;
+  id?: number;
+  image_url?: string;
+  published_at?: string;
+  reading_time_minutes?: number;
+  score?: number;
+  slug?: string;
+  source?: string;
+  source_url?: string;
+  summary?: string;
+  title?: string;
+  type?: FeedItemType;
+  uuid?: string;
+  view_count?: number;
+}
+
+export type FeedItemType = "post" | "news";
+
+/** Request model for fetching news from external API */
+export interface FetchNewsRequest {
+  categories?: NewsCategory[];
+  limit?: number;
+}
+
+/** A fetch of a single news source */
+export interface FetchRun {
+  duration_ms?: number;
+  error?: string;
+  id?: number;
+  ingestion_run_id?: number;
+  items_found?: number;
+  items_saved?: number;
+  source_id?: number;
+  source_name?: string;
+  started_at?: string;
+  status?: string;
+}
+
+/** A content freeze window during which publishes are paused */
+export interface FreezeWindow {
+  created_at?: string;
+  created_by?: number;
+  ends_at?: string;
+  id?: number;
+  reason?: string;
+  starts_at?: string;
+  updated_at?: string;
+}
+
+/** A request or field error. Errors from the REST layer carry its code in extensions. */
+export interface GraphQLError {
+  extensions?: Record<string, unknown>;
+  message?: string;
+  path?: unknown[];
+}
+
+/** A GraphQL query or mutation with its variables */
+export interface GraphQLRequest {
+  operationName?: string;
+  query: string;
+  variables?: Record<string, unknown>;
+}
+
+/** Result of a GraphQL request. Data is null when the request itself is invalid. */
+export interface GraphQLResponse {
+  data?: unknown;
+  errors?: GraphQLError[];
+}
+
+/** Response model for the homepage feed */
+export interface HomeFeedResponse {
+  generated_at?: string;
+  items?: FeedItem[];
+  ranker?: string;
+}
+
+/** URLs of an uploaded image in several sizes */
+export interface ImageVariants {
+  medium?: string;
+  original?: string;
+  thumbnail?: string;
+}
+
+/** The outcome of a single article in an ingestion run */
+export interface IngestionItem {
+  created_at?: string;
+  external_id?: string;
+  id?: number;
+  news_id?: number;
+  outcome?: IngestionItemOutcome;
+  reason?: string;
+  run_id?: number;
+  source?: string;
+  source_url?: string;
+  title?: string;
+}
+
+export type IngestionItemOutcome = "saved" | "deduped" | "filtered" | "failed";
+
+/** A news ingestion run and its counters */
+export interface IngestionRun {
+  error?: string;
+  finished_at?: string;
+  id?: number;
+  items?: IngestionItem[];
+  items_deduped?: number;
+  items_failed?: number;
+  items_filtered?: number;
+  items_saved?: number;
+  items_seen?: number;
+  source?: string;
+  source_errors?: IngestionSourceError[];
+  started_at?: string;
+  status?: IngestionRunStatus;
+  trigger?: string;
+}
+
+export type IngestionRunStatus = "running" | "completed" | "failed";
+
+export interface IngestionSourceError {
+  error?: string;
+  source?: string;
+}
+
+/** Articles fetched and saved by news ingestion on one day */
+export interface IngestionStatsPoint {
+  fetched?: number;
+  period?: string;
+  saved?: number;
+}
+
+/** A JWT signing key (the secret is never returned) */
+export interface JWTSigningKey {
+  created_at?: string;
+  kid?: string;
+  retired_at?: string;
+  signing?: boolean;
+  source?: string;
+}
+
+/** Published post suggested as an internal link */
+export interface LinkSuggestion {
+  keywords?: string[];
+  post_id?: number;
+  score?: number;
+  shared_tags?: string[];
+  slug?: string;
+  title?: string;
+  url?: string;
+}
+
+/** Liveness probe response */
+export interface LivenessResponse {
+  status?: string;
+  time?: string;
+  uptime_seconds?: number;
+}
+
+export interface LoginRequest {
+  email: string;
+  password: string;
+}
+
+export interface LogoutRequest {
+  revoke_all?: boolean;
+}
+
+/** Request model for merging a tag into another tag */
+export interface MergeTagRequest {
+  into_id: number;
+}
+
+/** A news article with content, metadata, and relationships */
+export interface News {
+  category?: NewsCategory;
+  content?: string;
+  created_at?: string;
+  external_id?: string;
+  id?: number;
+  image_url?: string;
+  language?: string;
+  publish_date?: string;
+  published?: boolean;
+  slug?: string;
+  source?: string;
+  source_url?: string;
+  status?: NewsStatus;
+  summary?: string;
+  tags?: Tag[];
+  title?: string;
+  updated_at?: string;
+  uuid?: string;
+}
+
+/** A news article saved to read later */
+export interface NewsBookmark {
+  created_at?: string;
+  id?: number;
+  news?: News;
+  news_id?: number;
+}
+
+/** Outcome of a bulk operation on one news article */
+export interface NewsBulkItem {
+  error?: string;
+  news_id?: number;
+  status?: NewsBulkItemStatus;
+}
+
+export type NewsBulkItemStatus = "updated" | "unchanged" | "deleted" | "not_found" | "failed";
+
+/** Outcome of a bulk operation on news articles */
+export interface NewsBulkResult {
+  failed?: number;
+  items?: NewsBulkItem[];
+  not_found?: number;
+  succeeded?: number;
+}
+
+export type NewsCategory = "technology" | "science";
+
+/** A news category */
+export interface NewsCategoryModel {
+  created_at?: string;
+  enabled?: boolean;
+  id?: number;
+  keywords?: string[];
+  name?: string;
+  slug?: NewsCategory;
+  updated_at?: string;
+}
+
+/** Outcome of enriching news articles in a batch */
+export interface NewsEnrichmentBatchResult {
+  enriched?: number;
+  failed?: number;
+  items?: NewsEnrichmentItem[];
+  skipped?: number;
+}
+
+/** Outcome of enriching one news article */
+export interface NewsEnrichmentItem {
+  error?: string;
+  news_id?: number;
+  status?: NewsEnrichmentItemStatus;
+}
+
+export type NewsEnrichmentItemStatus = "enriched" | "not_truncated" | "failed" | "not_found";
+
+/** Counts of news articles by enrichment state */
+export interface NewsEnrichmentStatus {
+  enriched?: number;
+  failed?: number;
+  last_enriched_at?: string;
+  pending?: number;
+  total?: number;
+  truncated?: number;
+}
+
+/** An RSS feed news articles are fetched from */
+export interface NewsSource {
+  category?: NewsCategory;
+  consecutive_failures?: number;
+  created_at?: string;
+  enabled?: boolean;
+  /** FetchIntervalMinutes of zero uses RSS_FETCH_INTERVAL */
+  fetch_interval_minutes?: number;
+  healthy?: boolean;
+  id?: number;
+  last_fetch_count?: number;
+  last_fetch_error?: string;
+  last_fetch_status?: string;
+  last_fetched_at?: string;
+  name?: string;
+  updated_at?: string;
+  url?: string;
+}
+
+export type NewsStatus = "published" | "draft" | "archived";
+
+/** A saved filter for the admin news list */
+export interface NewsView {
+  created_at?: string;
+  filter?: AdminNewsFilter;
+  id?: number;
+  name?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface NewsWithoutContent {
+  category?: NewsCategory;
+  created_at?: string;
+  external_id?: string;
+  id?: number;
+  image_url?: string;
+  language?: string;
+  publish_date?: string;
+  published?: boolean;
+  slug?: string;
+  source?: string;
+  source_url?: string;
+  status?: NewsStatus;
+  summary?: string;
+  tags?: Tag[];
+  title?: string;
+  updated_at?: string;
+  uuid?: string;
+}
+
+/** Response model for news list with pagination information and without content */
+export interface NewsWithoutContentResponse {
+  news?: NewsWithoutContent[];
+  next_cursor?: string;
+  page?: number;
+  per_page?: number;
+  total_items?: number;
+  total_pages?: number;
+}
+
+/** An event on the current user's posts or comments */
+export interface Notification {
+  actor?: User;
+  actor_id?: number;
+  comment_id?: number;
+  created_at?: string;
+  id?: number;
+  post?: Post;
+  post_id?: number;
+  read_at?: string;
+  status?: PostStatus;
+  type?: NotificationType;
+}
+
+export type NotificationType = "post_comment" | "comment_reply" | "comment_mention" | "post_status_changed";
+
+/** A static page of the site */
+export interface Page {
+  content?: string;
+  created_at?: string;
+  id?: number;
+  slug?: string;
+  status?: PageStatus;
+  title?: string;
+  updated_at?: string;
+  updated_by?: number;
+}
+
+export type PageStatus = "draft" | "published";
+
+export type PolicyAction = "admin.access" | "post.create" | "post.edit" | "post.publish" | "post.unpublish" | "post.manage" | "post.delete" | "post.analytics" | "comment.edit" | "comment.delete" | "comment.skip_moderation" | "series.manage" | "page.edit" | "template.edit";
+
+export interface PolicyPermission {
+  action?: PolicyAction;
+  description?: string;
+}
+
+/** A post as exported, or as accepted by the importer */
+export interface PortablePost {
+  author?: string;
+  category?: string;
+  content?: string;
+  cover?: string;
+  created_at?: string;
+  excerpt?: string;
+  expires_at?: string;
+  language?: string;
+  publish_at?: string;
+  slug?: string;
+  status?: PostStatus;
+  tags?: string[];
+  title?: string;
+  updated_at?: string;
+}
+
+/** A blog post with content, metadata, and relationships */
+export interface Post {
+  authors?: PostAuthor[];
+  category?: Category;
+  category_id?: number;
+  content?: string;
+  cover?: string;
+  created_at?: string;
+  excerpt?: string;
+  expires_at?: string;
+  id?: number;
+  language?: string;
+  news_id?: number;
+  og_image?: string;
+  publish_at?: string;
+  reading_time_minutes?: number;
+  series?: SeriesNavigation;
+  series_id?: number;
+  series_position?: number;
+  skip_cross_post?: boolean;
+  slug?: string;
+  status?: PostStatus;
+  tags?: Tag[];
+  title?: string;
+  translation_group?: string;
+  translations?: PostTranslation[];
+  updated_at?: string;
+  user?: User;
+  user_id?: number;
+  uuid?: string;
+  /** Bumped by BeforeUpdate */
+  version?: number;
+  view_count?: number;
+  word_count?: number;
+}
+
+/** Views, readers and read-through rates of a post */
+export interface PostAnalytics {
+  days?: PostAnalyticsDay[];
+  from?: string;
+  post_id?: number;
+  read_through?: PostReadThrough;
+  unique_readers?: number;
+  views?: number;
+}
+
+/** Reader counters of a post for one day */
+export interface PostAnalyticsDay {
+  day?: string;
+  reached_100?: number;
+  reached_25?: number;
+  reached_50?: number;
+  reached_75?: number;
+  unique_readers?: number;
+  views?: number;
+}
+
+/** A co-author of a post and their role */
+export interface PostAuthor {
+  created_at?: string;
+  role?: PostAuthorRole;
+  user?: User;
+  user_id?: number;
+}
+
+export type PostAuthorRole = "author" | "contributor" | "reviewer";
+
+/** A draft snapshot of a post saved by the editor */
+export interface PostAutosave {
+  base_updated_at?: string;
+  content?: string;
+  created_at?: string;
+  excerpt?: string;
+  id?: number;
+  post_id?: number;
+  title?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+/** A draft snapshot with a conflict indicator */
+export interface PostAutosaveResult {
+  autosave?: PostAutosave;
+  conflict?: boolean;
+  post_updated_at?: string;
+}
+
+/** Exported posts in the JSON format */
+export interface PostExport {
+  exported_at?: string;
+  posts?: PortablePost[];
+  version?: number;
+}
+
+/** Outcome of importing one post */
+export interface PostImportItem {
+  action?: "create" | "update" | "skip" | "error";
+  errors?: string[];
+  slug?: string;
+  source?: string;
+}
+
+/** Summary of a post import */
+export interface PostImportResult {
+  created?: number;
+  dry_run?: boolean;
+  failed?: number;
+  items?: PostImportItem[];
+  skipped?: number;
+  updated?: number;
+}
+
+/** Share of views reaching each point of a post, between 0 and 1 */
+export interface PostReadThrough {
+  reached_100?: number;
+  reached_25?: number;
+  reached_50?: number;
+  reached_75?: number;
+}
+
+export type PostStatus = "draft" | "published" | "archived" | "scheduled";
+
+/** A starting point for new posts */
+export interface PostTemplate {
+  category_id?: number;
+  content?: string;
+  created_at?: string;
+  description?: string;
+  excerpt?: string;
+  id?: number;
+  language?: string;
+  name?: string;
+  status?: PostStatus;
+  tags?: string[];
+  title_pattern?: string;
+  updated_at?: string;
+  updated_by?: number;
+}
+
+/** A translation of a post into another language */
+export interface PostTranslation {
+  id?: number;
+  language?: string;
+  slug?: string;
+  title?: string;
+  uuid?: string;
+}
+
+/** A signed link that shows an unpublished post without logging in */
+export interface PreviewTokenResponse {
+  expires_at?: string;
+  preview_url?: string;
+  token?: string;
+}
+
+/** Public profile of an author */
+export interface PublicProfile {
+  bio?: string;
+  first_name?: string;
+  joined_at?: string;
+  last_name?: string;
+  post_count?: number;
+  profile_image?: string;
+  username?: string;
+}
+
+/** Public site statistics for widgets such as the blog footer */
+export interface PublicStats {
+  blogging_since?: string;
+  generated_at?: string;
+  total_comments?: number;
+  total_posts?: number;
+  total_views?: number;
+  years_blogging?: number;
+}
+
+/** A saved post whose publish waits for a content freeze to end */
+export interface QueuedPost {
+  authors?: PostAuthor[];
+  category?: Category;
+  category_id?: number;
+  content?: string;
+  cover?: string;
+  created_at?: string;
+  excerpt?: string;
+  expires_at?: string;
+  frozen_until?: string;
+  id?: number;
+  language?: string;
+  news_id?: number;
+  og_image?: string;
+  publish_at?: string;
+  queued?: boolean;
+  reading_time_minutes?: number;
+  series?: SeriesNavigation;
+  series_id?: number;
+  series_position?: number;
+  skip_cross_post?: boolean;
+  slug?: string;
+  status?: PostStatus;
+  tags?: Tag[];
+  title?: string;
+  translation_group?: string;
+  translations?: PostTranslation[];
+  updated_at?: string;
+  user?: User;
+  user_id?: number;
+  uuid?: string;
+  /** Bumped by BeforeUpdate */
+  version?: number;
+  view_count?: number;
+  word_count?: number;
+}
+
+/** Readiness probe report, with the status and latency of each dependency */
+export interface ReadinessReport {
+  checked_at?: string;
+  checks?: DiagnosticCheck[];
+  ready?: boolean;
+  status?: DiagnosticStatus;
+}
+
+/** A post or news article saved to read later */
+export interface ReadingListItem {
+  news?: News;
+  post?: Post;
+  saved_at?: string;
+  type?: ReadingListItemType;
+}
+
+export type ReadingListItemType = "post" | "news";
+
+/** How far the current user got in a post */
+export interface ReadingProgress {
+  anchor?: string;
+  percentage?: number;
+  post?: Post;
+  post_id?: number;
+  updated_at?: string;
+}
+
+export interface RefreshTokenRequest {
+  refresh_token: string;
+}
+
+export interface RegisterRequest {
+  email: string;
+  first_name?: string;
+  last_name?: string;
+  password: string;
+  username: string;
+}
+
+/** Request model for renaming a tag */
+export interface RenameTagRequest {
+  name: string;
+}
+
+/** A role and the permissions it grants */
+export interface Role {
+  built_in?: boolean;
+  created_at?: string;
+  description?: string;
+  id?: number;
+  name?: string;
+  permissions?: string[];
+  updated_at?: string;
+  user_count?: number;
+}
+
+/** An API endpoint and what a caller needs to use it */
+export interface RouteCapability {
+  access?: "public" | "user" | "admin" | "optional";
+  api_key?: boolean;
+  cache?: string;
+  conditional?: boolean;
+  method?: string;
+  path?: string;
+  rate_limit?: "api" | "auth" | "none";
+}
+
+/** Request model for saving how far the current user got in a post */
+export interface SaveReadingProgressRequest {
+  anchor?: string;
+  percentage: number;
+}
+
+/** Search results of one type */
+export interface SearchGroup {
+  results?: SearchResult[];
+  total?: number;
+}
+
+/** Response model for the unified search */
+export interface SearchResponse {
+  news?: SearchGroup;
+  posts?: SearchGroup;
+  query?: string;
+  tags?: SearchGroup;
+}
+
+/** A post, news article or tag matching a search query */
+export interface SearchResult {
+  id?: number;
+  published_at?: string;
+  score?: number;
+  slug?: string;
+  snippet?: string;
+  title?: string;
+  type?: SearchResultType;
+  uuid?: string;
+}
+
+export type SearchResultType = "post" | "news" | "tag";
+
+/** Request model for sending a digest of recent posts */
+export interface SendDigestRequest {
+  days?: number;
+}
+
+/** An ordered collection of posts */
+export interface Series {
+  created_at?: string;
+  description?: string;
+  id?: number;
+  post_count?: number;
+  posts?: Post[];
+  slug?: string;
+  title?: string;
+  updated_at?: string;
+  user?: User;
+  user_id?: number;
+  uuid?: string;
+}
+
+/** Where a post sits in its series, with links to the posts before and after it */
+export interface SeriesNavigation {
+  id?: number;
+  next?: SeriesPostLink;
+  position?: number;
+  previous?: SeriesPostLink;
+  slug?: string;
+  title?: string;
+  total?: number;
+}
+
+/** A post linked from the series navigation */
+export interface SeriesPostLink {
+  id?: number;
+  slug?: string;
+  title?: string;
+  uuid?: string;
+}
+
+/** A device the user is signed in on */
+export interface Session {
+  current?: boolean;
+  expires_at?: string;
+  id?: number;
+  ip_address?: string;
+  issued_at?: string;
+  last_used_at?: string;
+  user_agent?: string;
+}
+
+/** Request model for boosting a post or news article in the homepage feed */
+export interface SetEditorialPickRequest {
+  item_id: number;
+  item_type: FeedItemType;
+  weight?: number;
+}
+
+/** Request model for changing a news article's status */
+export interface SetNewsStatusRequest {
+  status: NewsStatus;
+}
+
+/** Request model for changing a post's status */
+export interface SetPostStatusRequest {
+  publish_at?: string;
+  status: PostStatus;
+}
+
+/** A blog served on its own domain */
+export interface Site {
+  created_at?: string;
+  domain?: string;
+  id?: number;
+  is_default?: boolean;
+  name?: string;
+  updated_at?: string;
+}
+
+/** A site setting */
+export interface SiteSetting {
+  key?: string;
+  updated_at?: string;
+  value?: string;
+}
+
+/** Points at the current slug of a post or news article requested by an old slug */
+export interface SlugRedirect {
+  location?: string;
+  slug?: string;
+}
+
+/** Count for one day or month */
+export interface StatsPoint {
+  count?: number;
+  period?: string;
+}
+
+/** Request model for subscribing to the newsletter */
+export interface SubscribeRequest {
+  email: string;
+}
+
+/** Response model for avatar upload */
+export interface SwaggerAvatarResponse {
+  profile_image?: string;
+  variants?: ImageVariants;
+}
+
+/** Response model for the current user's bookmarked posts */
+export interface SwaggerBookmarksResponse {
+  bookmarks?: Bookmark[];
+  meta?: SwaggerPostsMeta;
+}
+
+/** Response model for the broken outbound links in published content */
+export interface SwaggerBrokenLinksResponse {
+  links?: BrokenLink[];
+  meta?: SwaggerPostsMeta;
+}
+
+/** Response format for deleting a news article */
+export interface SwaggerDeleteNewsResponse {
+  message?: string;
+}
+
+/** Enriched news content with full article text */
+export interface SwaggerEnrichedNewsContent {
+  fetch_error?: string;
+  full_content?: string;
+  is_truncated?: boolean;
+  last_fetched?: string;
+  news_id?: number;
+  original_content?: string;
+  source_url?: string;
+  truncated_chars?: number;
+  truncation_detected?: string;
+  truncation_pattern?: string;
+}
+
+/** Response format for fetching news from external API */
+export interface SwaggerFetchNewsResponse {
+  categories?: NewsCategory[];
+  fetch_time?: string;
+  message?: string;
+  run_id?: number;
+  saved?: number;
+  total?: number;
+}
+
+/** Response format for fetching news from RSS feeds */
+export interface SwaggerFetchRSSNewsResponse {
+  categories?: NewsCategory[];
+  fetch_time?: string;
+  message?: string;
+  run_id?: number;
+  saved?: number;
+  total?: number;
+}
+
+/** Response model for editor file upload */
+export interface SwaggerFileUploadResponse {
+  file_url?: string;
+}
+
+/** News article with content status information for Swagger documentation */
+export interface SwaggerNewsWithContentStatus {
+  content_status?: ContentStatus;
+  news?: News;
+}
+
+/** Pagination metadata for notifications, with the unread count */
+export interface SwaggerNotificationsMeta {
+  lastPage?: number;
+  limit?: number;
+  page?: number;
+  total?: number;
+  unread?: number;
+}
+
+/** Response model for the current user's notifications */
+export interface SwaggerNotificationsResponse {
+  meta?: SwaggerNotificationsMeta;
+  notifications?: Notification[];
+}
+
+/** Response model for post cover upload */
+export interface SwaggerPostCoverResponse {
+  cover?: string;
+  variants?: ImageVariants;
+}
+
+/** Pagination metadata for the post list, with a cursor for the next page */
+export interface SwaggerPostsListMeta {
+  lastPage?: number;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  total?: number;
+}
+
+/** Pagination metadata for blog post listings */
+export interface SwaggerPostsMeta {
+  lastPage?: number;
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+/** Response model for listing blog posts */
+export interface SwaggerPostsResponse {
+  meta?: SwaggerPostsListMeta;
+  posts?: Post[];
+}
+
+/** Response model for user profile information */
+export interface SwaggerProfileResponse {
+  bio?: string;
+  created_at?: string;
+  email?: string;
+  first_name?: string;
+  id?: number;
+  last_name?: string;
+  profile_image?: string;
+  role?: string;
+  username?: string;
+}
+
+/** Response model for the current user's bookmarked posts and news articles */
+export interface SwaggerReadingListResponse {
+  items?: ReadingListItem[];
+  meta?: SwaggerPostsMeta;
+}
+
+/** Response model for the current user's reading progress */
+export interface SwaggerReadingProgressResponse {
+  meta?: SwaggerPostsMeta;
+  progress?: ReadingProgress[];
+}
+
+/** The newly registered user */
+export interface SwaggerRegisteredUser {
+  email?: string;
+  id?: number;
+  username?: string;
+}
+
+/** A standard API response format */
+export interface SwaggerStandardResponse {
+  data?: unknown;
+  message?: string;
+  status?: string;
+}
+
+/** A tag that can be associated with multiple posts */
+export interface Tag {
+  id?: number;
+  name?: string;
+  posts?: Post[];
+}
+
+/** A tag with the count of posts using it */
+export interface TagWithCount {
+  id?: number;
+  name?: string;
+  post_count?: number;
+}
+
+export interface TokenResponse {
+  access_token?: string;
+  /** in seconds */
+  expires_in?: number;
+  refresh_token?: string;
+  token_type?: string;
+}
+
+export interface TokenRevokeRequest {
+  refresh_token: string;
+}
+
+/** Request model for updating a post category */
+export interface UpdateCategoryRequest {
+  description?: string;
+  name?: string;
+  parent_id?: number;
+  position?: number;
+  slug?: string;
+}
+
+/** Request model for updating an existing comment */
+export interface UpdateCommentRequest {
+  content: string;
+}
+
+/** Request model for changing a news category */
+export interface UpdateNewsCategoryRequest {
+  enabled?: boolean;
+  keywords?: string[];
+  name?: string;
+  slug?: string;
+}
+
+/** Request model for updating a news article */
+export interface UpdateNewsRequest {
+  category?: NewsCategory;
+  content?: string;
+  image_url?: string;
+  language?: "en" | "vi";
+  publish_date?: string;
+  source?: string;
+  source_url?: string;
+  status?: NewsStatus;
+  summary?: string;
+  tags?: string[];
+  title?: string;
+}
+
+/** Request model for changing an RSS feed */
+export interface UpdateNewsSourceRequest {
+  category?: string;
+  enabled?: boolean;
+  fetch_interval_minutes?: number;
+  name?: string;
+  url?: string;
+}
+
+/** Request model for updating a static page */
+export interface UpdatePageRequest {
+  content?: string;
+  slug?: string;
+  status?: PageStatus;
+  title?: string;
+}
+
+/** Request model for updating an existing blog post */
+export interface UpdatePostRequest {
+  category_id?: number;
+  clear_expiry?: boolean;
+  content?: string;
+  cover?: string;
+  excerpt?: string;
+  expires_at?: string;
+  language?: "en" | "vi";
+  publish_at?: string;
+  skip_cross_post?: boolean;
+  status?: PostStatus;
+  tags?: string[];
+  title?: string;
+  translation_of?: number;
+  version?: number;
+}
+
+/** Request model for changing a post template; omitted fields are kept */
+export interface UpdatePostTemplateRequest {
+  category_id?: number;
+  content?: string;
+  description?: string;
+  excerpt?: string;
+  language?: "en" | "vi";
+  name?: string;
+  status?: PostStatus;
+  tags?: string[];
+  title_pattern?: string;
+}
+
+/** Request model for updating user profile */
+export interface UpdateProfileRequest {
+  analytics_opt_out?: boolean;
+  bio?: string;
+  comment_emails?: "immediate" | "daily" | "off";
+  first_name?: string;
+  last_name?: string;
+  profile_image?: string;
+}
+
+/** Request model for changing a role; omitted fields are kept */
+export interface UpdateRoleRequest {
+  description?: string;
+  permissions?: string[];
+}
+
+/** Request model for updating a post series */
+export interface UpdateSeriesRequest {
+  description?: string;
+  slug?: string;
+  title?: string;
+}
+
+/** Request model for changing a site; omitted fields are kept */
+export interface UpdateSiteRequest {
+  domain?: string;
+  is_default?: boolean;
+  name?: string;
+}
+
+/** Request model for changing a site setting */
+export interface UpdateSiteSettingRequest {
+  value: string;
+}
+
+/** Request model for changing a user's role */
+export interface UpdateUserRoleRequest {
+  role: string;
+}
+
+/** Request model for updating a webhook */
+export interface UpdateWebhookRequest {
+  active?: boolean;
+  description?: string;
+  events?: string[];
+  url?: string;
+}
+
+/** A user account with profile information and relationships */
+export interface User {
+  analytics_opt_out?: boolean;
+  bio?: string;
+  comment_emails?: CommentEmails;
+  comments?: Comment[];
+  created_at?: string;
+  email?: string;
+  first_name?: string;
+  id?: number;
+  last_name?: string;
+  posts?: Post[];
+  profile_image?: string;
+  role?: string;
+  updated_at?: string;
+  username?: string;
+}
+
+/** All of a user's data: profile, posts, comments, comment reactions, bookmarks and series */
+export interface UserDataExport {
+  bookmarks?: Bookmark[];
+  comment_reactions?: CommentReaction[];
+  comments?: Comment[];
+  exported_at?: string;
+  news_bookmarks?: NewsBookmark[];
+  posts?: Post[];
+  profile?: User;
+  reading_progress?: ReadingProgress[];
+  series?: Series[];
+}
+
+/** A user deletion that can be restored until its undo window ends */
+export interface UserDeletion {
+  comment_ids?: number[];
+  created_at?: string;
+  deleted_by?: number;
+  ghost_user_id?: number;
+  id?: number;
+  post_ids?: number[];
+  purged_at?: string;
+  restored_at?: string;
+  strategy?: UserDeletionStrategy;
+  undo_until?: string;
+  user_id?: number;
+}
+
+export type UserDeletionStrategy = "anonymize" | "reassign" | "delete";
+
+/** Result of purging deleted users whose undo window has passed */
+export interface UserPurgeResult {
+  purged?: number;
+  user_ids?: number[];
+}
+
+/** Build information of the running API instance */
+export interface VersionInfo {
+  build_time?: string;
+  canary?: boolean;
+  git_sha?: string;
+  go_version?: string;
+}
+
+/** Image referenced by an imported post that could not be downloaded */
+export interface WXRImageFailure {
+  error?: string;
+  url?: string;
+}
+
+/** Summary of a WordPress (WXR) import */
+export interface WXRImportResult {
+  authors_created?: number;
+  authors_matched?: number;
+  categories_created?: number;
+  comments_guest?: number;
+  comments_imported?: number;
+  comments_skipped?: number;
+  dry_run?: boolean;
+  images_downloaded?: number;
+  images_failed?: WXRImageFailure[];
+  pages?: number;
+  posts?: PostImportResult;
+  skipped_items?: WXRSkippedItem[];
+}
+
+/** Item of a WordPress export that was not imported */
+export interface WXRSkippedItem {
+  reason?: string;
+  title?: string;
+  type?: string;
+}
+
+/** A registered webhook endpoint and the events it receives */
+export interface Webhook {
+  active?: boolean;
+  created_at?: string;
+  created_by?: number;
+  description?: string;
+  events?: string[];
+  id?: number;
+  updated_at?: string;
+  url?: string;
+}
+
+/** A webhook delivery attempt and its outcome */
+export interface WebhookDelivery {
+  attempt?: number;
+  created_at?: string;
+  delivery_id?: string;
+  duration_ms?: number;
+  error?: string;
+  event?: string;
+  id?: number;
+  status_code?: number;
+  success?: boolean;
+  webhook_id?: number;
+}
+
+/** A newly created webhook including its signing secret */
+export interface WebhookWithSecret {
+  active?: boolean;
+  created_at?: string;
+  created_by?: number;
+  description?: string;
+  events?: string[];
+  id?: number;
+  secret?: string;
+  updated_at?: string;
+  url?: string;
+}
+
+export class ApiClient extends BaseClient {
+  /** List audit log entries — GET /admin/audit-logs */
+  getAdminAuditLogs(query?: { actor_id?: number; action?: string; resource_type?: string; resource_id?: string; request_id?: string; from?: string; to?: string; page?: number; per_page?: number }): Promise<AuditLogListResponse> {
+    return this.request("GET", `/admin/audit-logs`, query, undefined);
+  }
+
+  /** Create a category — POST /admin/categories */
+  postAdminCategories(body: CreateCategoryRequest): Promise<Category> {
+    return this.request("POST", `/admin/categories`, undefined, body);
+  }
+
+  /** Update a category — PUT /admin/categories/{id} */
+  putAdminCategoriesById(id: string | number, body: UpdateCategoryRequest): Promise<Category> {
+    return this.request("PUT", `/admin/categories/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a category — DELETE /admin/categories/{id} */
+  deleteAdminCategoriesById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/categories/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** List comments held for moderation — GET /admin/comments/pending */
+  getAdminCommentsPending(): Promise<Comment[]> {
+    return this.request("GET", `/admin/comments/pending`, undefined, undefined);
+  }
+
+  /** Approve a held comment — POST /admin/comments/{commentID}/approve */
+  postAdminCommentsByCommentIDApprove(commentID: string | number): Promise<Comment> {
+    return this.request("POST", `/admin/comments/${encodeURIComponent(String(commentID))}/approve`, undefined, undefined);
+  }
+
+  /** List broken links — GET /admin/content/broken-links */
+  getAdminContentBrokenLinks(query?: { page?: number; limit?: number; domain?: string; source_type?: string }): Promise<SwaggerBrokenLinksResponse> {
+    return this.request("GET", `/admin/content/broken-links`, query, undefined);
+  }
+
+  /** Check links now — POST /admin/content/broken-links/check */
+  postAdminContentBrokenLinksCheck(): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/admin/content/broken-links/check`, undefined, undefined);
+  }
+
+  /** Run diagnostics — GET /admin/diagnostics */
+  getAdminDiagnostics(): Promise<DiagnosticsReport> {
+    return this.request("GET", `/admin/diagnostics`, undefined, undefined);
+  }
+
+  /** List content freeze windows — GET /admin/freeze-windows */
+  getAdminFreezeWindows(query?: { all?: boolean }): Promise<FreezeWindow[]> {
+    return this.request("GET", `/admin/freeze-windows`, query, undefined);
+  }
+
+  /** Create a content freeze window — POST /admin/freeze-windows */
+  postAdminFreezeWindows(body: CreateFreezeWindowRequest): Promise<FreezeWindow> {
+    return this.request("POST", `/admin/freeze-windows`, undefined, body);
+  }
+
+  /** Delete a content freeze window — DELETE /admin/freeze-windows/{id} */
+  deleteAdminFreezeWindowsById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/freeze-windows/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** List editorial picks — GET /admin/home/picks */
+  getAdminHomePicks(): Promise<EditorialPick[]> {
+    return this.request("GET", `/admin/home/picks`, undefined, undefined);
+  }
+
+  /** Boost a feed item — PUT /admin/home/picks */
+  putAdminHomePicks(body: SetEditorialPickRequest): Promise<EditorialPick> {
+    return this.request("PUT", `/admin/home/picks`, undefined, body);
+  }
+
+  /** Remove an editorial pick — DELETE /admin/home/picks/{id} */
+  deleteAdminHomePicksById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/home/picks/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** List JWT signing keys — GET /admin/jwt-keys */
+  getAdminJwtKeys(): Promise<JWTSigningKey[]> {
+    return this.request("GET", `/admin/jwt-keys`, undefined, undefined);
+  }
+
+  /** Rotate the JWT signing key — POST /admin/jwt-keys/rotate */
+  postAdminJwtKeysRotate(): Promise<JWTSigningKey> {
+    return this.request("POST", `/admin/jwt-keys/rotate`, undefined, undefined);
+  }
+
+  /** Retire a JWT signing key — DELETE /admin/jwt-keys/{kid} */
+  deleteAdminJwtKeysByKid(kid: string | number): Promise<JWTSigningKey> {
+    return this.request("DELETE", `/admin/jwt-keys/${encodeURIComponent(String(kid))}`, undefined, undefined);
+  }
+
+  /** List news articles for curation — GET /admin/news */
+  getAdminNews(query?: { view?: number; status?: string; source?: string; category?: string; tag?: string; search?: string; from?: string; to?: string; has_image?: boolean; truncated?: boolean; page?: number; per_page?: number }): Promise<NewsWithoutContentResponse> {
+    return this.request("GET", `/admin/news`, query, undefined);
+  }
+
+  /** Create a news article — POST /admin/news */
+  postAdminNews(body: CreateNewsRequest): Promise<News> {
+    return this.request("POST", `/admin/news`, undefined, body);
+  }
+
+  /** Delete news articles in bulk — POST /admin/news/bulk-delete */
+  postAdminNewsBulkDelete(body: BulkNewsDeleteRequest): Promise<NewsBulkResult> {
+    return this.request("POST", `/admin/news/bulk-delete`, undefined, body);
+  }
+
+  /** Set the status of news articles in bulk — POST /admin/news/bulk-status */
+  postAdminNewsBulkStatus(body: BulkNewsStatusRequest): Promise<NewsBulkResult> {
+    return this.request("POST", `/admin/news/bulk-status`, undefined, body);
+  }
+
+  /** List news categories — GET /admin/news/categories */
+  getAdminNewsCategories(): Promise<NewsCategoryModel[]> {
+    return this.request("GET", `/admin/news/categories`, undefined, undefined);
+  }
+
+  /** Create a news category — POST /admin/news/categories */
+  postAdminNewsCategories(body: CreateNewsCategoryRequest): Promise<NewsCategoryModel> {
+    return this.request("POST", `/admin/news/categories`, undefined, body);
+  }
+
+  /** Change a news category — PUT /admin/news/categories/{id} */
+  putAdminNewsCategoriesById(id: string | number, body: UpdateNewsCategoryRequest): Promise<NewsCategoryModel> {
+    return this.request("PUT", `/admin/news/categories/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Enrich news articles in a batch — POST /admin/news/enrich-batch */
+  postAdminNewsEnrichBatch(body?: EnrichNewsBatchRequest): Promise<NewsEnrichmentBatchResult> {
+    return this.request("POST", `/admin/news/enrich-batch`, undefined, body);
+  }
+
+  /** Summarize news enrichment — GET /admin/news/enrichment-status */
+  getAdminNewsEnrichmentStatus(): Promise<NewsEnrichmentStatus> {
+    return this.request("GET", `/admin/news/enrichment-status`, undefined, undefined);
+  }
+
+  /** Fetch news from external API — POST /admin/news/fetch */
+  postAdminNewsFetch(body: FetchNewsRequest): Promise<SwaggerFetchNewsResponse> {
+    return this.request("POST", `/admin/news/fetch`, undefined, body);
+  }
+
+  /** List news source fetches — GET /admin/news/fetch-history */
+  getAdminNewsFetchHistory(query?: { source_id?: number; status?: string; limit?: number }): Promise<FetchRun[]> {
+    return this.request("GET", `/admin/news/fetch-history`, query, undefined);
+  }
+
+  /** Fetch news from RSS feeds — POST /admin/news/fetch-rss */
+  postAdminNewsFetchRss(body: FetchNewsRequest): Promise<SwaggerFetchRSSNewsResponse> {
+    return this.request("POST", `/admin/news/fetch-rss`, undefined, body);
+  }
+
+  /** List news ingestion runs — GET /admin/news/ingestions */
+  getAdminNewsIngestions(query?: { source?: string; status?: string; limit?: number }): Promise<IngestionRun[]> {
+    return this.request("GET", `/admin/news/ingestions`, query, undefined);
+  }
+
+  /** Get a news ingestion run — GET /admin/news/ingestions/{id} */
+  getAdminNewsIngestionsById(id: string | number, query?: { outcome?: string }): Promise<IngestionRun> {
+    return this.request("GET", `/admin/news/ingestions/${encodeURIComponent(String(id))}`, query, undefined);
+  }
+
+  /** List news sources — GET /admin/news/sources */
+  getAdminNewsSources(): Promise<NewsSource[]> {
+    return this.request("GET", `/admin/news/sources`, undefined, undefined);
+  }
+
+  /** Add a news source — POST /admin/news/sources */
+  postAdminNewsSources(body: CreateNewsSourceRequest): Promise<NewsSource> {
+    return this.request("POST", `/admin/news/sources`, undefined, body);
+  }
+
+  /** Change a news source — PUT /admin/news/sources/{id} */
+  putAdminNewsSourcesById(id: string | number, body: UpdateNewsSourceRequest): Promise<NewsSource> {
+    return this.request("PUT", `/admin/news/sources/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a news source — DELETE /admin/news/sources/{id} */
+  deleteAdminNewsSourcesById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/news/sources/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** List saved news views — GET /admin/news/views */
+  getAdminNewsViews(): Promise<NewsView[]> {
+    return this.request("GET", `/admin/news/views`, undefined, undefined);
+  }
+
+  /** Save a news view — POST /admin/news/views */
+  postAdminNewsViews(body: CreateNewsViewRequest): Promise<NewsView> {
+    return this.request("POST", `/admin/news/views`, undefined, body);
+  }
+
+  /** Delete a saved news view — DELETE /admin/news/views/{id} */
+  deleteAdminNewsViewsById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/news/views/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Update a news article — PUT /admin/news/{id} */
+  putAdminNewsById(id: string | number, body: UpdateNewsRequest): Promise<News> {
+    return this.request("PUT", `/admin/news/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a news article — DELETE /admin/news/{id} */
+  deleteAdminNewsById(id: string | number): Promise<SwaggerDeleteNewsResponse> {
+    return this.request("DELETE", `/admin/news/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Start a commentary post on a news article — POST /admin/news/{id}/commentary */
+  postAdminNewsByIdCommentary(id: string | number, body?: CreateNewsCommentaryRequest): Promise<Post> {
+    return this.request("POST", `/admin/news/${encodeURIComponent(String(id))}/commentary`, undefined, body);
+  }
+
+  /** Enrich a news article now — POST /admin/news/{id}/enrich */
+  postAdminNewsByIdEnrich(id: string | number): Promise<SwaggerEnrichedNewsContent> {
+    return this.request("POST", `/admin/news/${encodeURIComponent(String(id))}/enrich`, undefined, undefined);
+  }
+
+  /** Set news article status — POST /admin/news/{id}/status */
+  postAdminNewsByIdStatus(id: string | number, body: SetNewsStatusRequest): Promise<News> {
+    return this.request("POST", `/admin/news/${encodeURIComponent(String(id))}/status`, undefined, body);
+  }
+
+  /** Send a newsletter digest — POST /admin/newsletter/digest */
+  postAdminNewsletterDigest(body?: SendDigestRequest): Promise<DigestResponse> {
+    return this.request("POST", `/admin/newsletter/digest`, undefined, body);
+  }
+
+  /** List permissions — GET /admin/permissions */
+  getAdminPermissions(): Promise<PolicyPermission[]> {
+    return this.request("GET", `/admin/permissions`, undefined, undefined);
+  }
+
+  /** Export posts — GET /admin/posts/export */
+  getAdminPostsExport(query?: { format?: string; status?: string }): Promise<Blob> {
+    return this.request("GET", `/admin/posts/export`, query, undefined);
+  }
+
+  /** Import posts — POST /admin/posts/import */
+  postAdminPostsImport(file: Blob, query?: { dry_run?: boolean; on_conflict?: string }): Promise<PostImportResult> {
+    return this.request("POST", `/admin/posts/import`, query, formData([["file", file]]));
+  }
+
+  /** Import a WordPress export — POST /admin/posts/import/wordpress */
+  postAdminPostsImportWordpress(file: Blob, query?: { dry_run?: boolean; on_conflict?: string; download_images?: boolean }): Promise<WXRImportResult> {
+    return this.request("POST", `/admin/posts/import/wordpress`, query, formData([["file", file]]));
+  }
+
+  /** List roles — GET /admin/roles */
+  getAdminRoles(): Promise<Role[]> {
+    return this.request("GET", `/admin/roles`, undefined, undefined);
+  }
+
+  /** Create a role — POST /admin/roles */
+  postAdminRoles(body: CreateRoleRequest): Promise<Role> {
+    return this.request("POST", `/admin/roles`, undefined, body);
+  }
+
+  /** Change a role — PUT /admin/roles/{id} */
+  putAdminRolesById(id: string | number, body: UpdateRoleRequest): Promise<Role> {
+    return this.request("PUT", `/admin/roles/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a role — DELETE /admin/roles/{id} */
+  deleteAdminRolesById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/roles/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** List site settings — GET /admin/settings */
+  getAdminSettings(): Promise<SiteSetting[]> {
+    return this.request("GET", `/admin/settings`, undefined, undefined);
+  }
+
+  /** Change a site setting — PUT /admin/settings/{key} */
+  putAdminSettingsByKey(key: string | number, body: UpdateSiteSettingRequest): Promise<SiteSetting> {
+    return this.request("PUT", `/admin/settings/${encodeURIComponent(String(key))}`, undefined, body);
+  }
+
+  /** List sites — GET /admin/sites */
+  getAdminSites(): Promise<Site[]> {
+    return this.request("GET", `/admin/sites`, undefined, undefined);
+  }
+
+  /** Add a site — POST /admin/sites */
+  postAdminSites(body: CreateSiteRequest): Promise<Site> {
+    return this.request("POST", `/admin/sites`, undefined, body);
+  }
+
+  /** Change a site — PUT /admin/sites/{id} */
+  putAdminSitesById(id: string | number, body: UpdateSiteRequest): Promise<Site> {
+    return this.request("PUT", `/admin/sites/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a site — DELETE /admin/sites/{id} */
+  deleteAdminSitesById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/sites/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Get admin dashboard statistics — GET /admin/stats */
+  getAdminStats(): Promise<AdminStats> {
+    return this.request("GET", `/admin/stats`, undefined, undefined);
+  }
+
+  /** Rename a tag — PUT /admin/tags/{id} */
+  putAdminTagsById(id: string | number, body: RenameTagRequest): Promise<TagWithCount> {
+    return this.request("PUT", `/admin/tags/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a tag — DELETE /admin/tags/{id} */
+  deleteAdminTagsById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/tags/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Merge a tag into another — POST /admin/tags/{id}/merge */
+  postAdminTagsByIdMerge(id: string | number, body: MergeTagRequest): Promise<TagWithCount> {
+    return this.request("POST", `/admin/tags/${encodeURIComponent(String(id))}/merge`, undefined, body);
+  }
+
+  /** Purge deleted users — POST /admin/users/purge */
+  postAdminUsersPurge(): Promise<UserPurgeResult> {
+    return this.request("POST", `/admin/users/purge`, undefined, undefined);
+  }
+
+  /** Delete a user — DELETE /admin/users/{id} */
+  deleteAdminUsersById(id: string | number, query?: { strategy?: string }): Promise<UserDeletion> {
+    return this.request("DELETE", `/admin/users/${encodeURIComponent(String(id))}`, query, undefined);
+  }
+
+  /** Restore a deleted user — POST /admin/users/{id}/restore */
+  postAdminUsersByIdRestore(id: string | number): Promise<UserDeletion> {
+    return this.request("POST", `/admin/users/${encodeURIComponent(String(id))}/restore`, undefined, undefined);
+  }
+
+  /** Change a user's role — PUT /admin/users/{id}/role */
+  putAdminUsersByIdRole(id: string | number, body: UpdateUserRoleRequest): Promise<User> {
+    return this.request("PUT", `/admin/users/${encodeURIComponent(String(id))}/role`, undefined, body);
+  }
+
+  /** List webhooks — GET /admin/webhooks */
+  getAdminWebhooks(): Promise<Webhook[]> {
+    return this.request("GET", `/admin/webhooks`, undefined, undefined);
+  }
+
+  /** Register a webhook — POST /admin/webhooks */
+  postAdminWebhooks(body: CreateWebhookRequest): Promise<WebhookWithSecret> {
+    return this.request("POST", `/admin/webhooks`, undefined, body);
+  }
+
+  /** Update a webhook — PUT /admin/webhooks/{id} */
+  putAdminWebhooksById(id: string | number, body: UpdateWebhookRequest): Promise<Webhook> {
+    return this.request("PUT", `/admin/webhooks/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a webhook — DELETE /admin/webhooks/{id} */
+  deleteAdminWebhooksById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/admin/webhooks/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** List webhook deliveries — GET /admin/webhooks/{id}/deliveries */
+  getAdminWebhooksByIdDeliveries(id: string | number, query?: { limit?: number }): Promise<WebhookDelivery[]> {
+    return this.request("GET", `/admin/webhooks/${encodeURIComponent(String(id))}/deliveries`, query, undefined);
+  }
+
+  /** Send a test delivery — POST /admin/webhooks/{id}/test */
+  postAdminWebhooksByIdTest(id: string | number): Promise<WebhookDelivery> {
+    return this.request("POST", `/admin/webhooks/${encodeURIComponent(String(id))}/test`, undefined, undefined);
+  }
+
+  /** Report reader events — POST /analytics/events */
+  postAnalyticsEvents(body: AnalyticsEventsRequest): Promise<AnalyticsEventsResponse> {
+    return this.request("POST", `/analytics/events`, undefined, body);
+  }
+
+  /** Login to the application — POST /auth/login */
+  postAuthLogin(body: LoginRequest): Promise<TokenResponse> {
+    return this.request("POST", `/auth/login`, undefined, body);
+  }
+
+  /** Logout from the application — POST /auth/logout */
+  postAuthLogout(body?: LogoutRequest): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/auth/logout`, undefined, body);
+  }
+
+  /** Refresh an access token — POST /auth/refresh */
+  postAuthRefresh(body?: RefreshTokenRequest): Promise<SwaggerStandardResponse & { data?: TokenResponse; }> {
+    return this.request("POST", `/auth/refresh`, undefined, body);
+  }
+
+  /** Register a new user — POST /auth/register */
+  postAuthRegister(body: RegisterRequest): Promise<SwaggerStandardResponse & { data?: SwaggerRegisteredUser; }> {
+    return this.request("POST", `/auth/register`, undefined, body);
+  }
+
+  /** Revoke a refresh token — POST /auth/revoke */
+  postAuthRevoke(body?: TokenRevokeRequest): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/auth/revoke`, undefined, body);
+  }
+
+  /** List the API's endpoints — GET /capabilities */
+  getCapabilities(): Promise<CapabilitiesResponse> {
+    return this.request("GET", `/capabilities`, undefined, undefined);
+  }
+
+  /** Get post categories — GET /categories */
+  getCategories(query?: { tree?: boolean }): Promise<Category[]> {
+    return this.request("GET", `/categories`, query, undefined);
+  }
+
+  /** Update a comment — PUT /comments/{commentID} */
+  putCommentsByCommentID(commentID: string | number, body: UpdateCommentRequest): Promise<Comment> {
+    return this.request("PUT", `/comments/${encodeURIComponent(String(commentID))}`, undefined, body);
+  }
+
+  /** Delete a comment — DELETE /comments/{commentID} */
+  deleteCommentsByCommentID(commentID: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/comments/${encodeURIComponent(String(commentID))}`, undefined, undefined);
+  }
+
+  /** React to a comment — POST /comments/{commentID}/reactions/{type} */
+  postCommentsByCommentIDReactionsByType(commentID: string | number, type: string | number): Promise<SwaggerStandardResponse & { data?: CommentReactionCounts; }> {
+    return this.request("POST", `/comments/${encodeURIComponent(String(commentID))}/reactions/${encodeURIComponent(String(type))}`, undefined, undefined);
+  }
+
+  /** Remove a reaction from a comment — DELETE /comments/{commentID}/reactions/{type} */
+  deleteCommentsByCommentIDReactionsByType(commentID: string | number, type: string | number): Promise<SwaggerStandardResponse & { data?: CommentReactionCounts; }> {
+    return this.request("DELETE", `/comments/${encodeURIComponent(String(commentID))}/reactions/${encodeURIComponent(String(type))}`, undefined, undefined);
+  }
+
+  /** Send a message to the site owner — POST /contact */
+  postContact(body: ContactRequest): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/contact`, undefined, body);
+  }
+
+  /** Get the author dashboard — GET /dashboard */
+  getDashboard(): Promise<AuthorDashboard> {
+    return this.request("GET", `/dashboard`, undefined, undefined);
+  }
+
+  /** Delete a file uploaded for editor use — POST /files/delete */
+  postFilesDelete(body: DeleteFileRequest): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/files/delete`, undefined, body);
+  }
+
+  /** Upload a file for editor use — POST /files/upload */
+  postFilesUpload(file: Blob): Promise<SwaggerStandardResponse & { data?: SwaggerFileUploadResponse; }> {
+    return this.request("POST", `/files/upload`, undefined, formData([["file", file]]));
+  }
+
+  /** GraphQL query over GET — GET /graphql */
+  getGraphql(query: { query: string; operationName?: string; variables?: string }): Promise<GraphQLResponse> {
+    return this.request("GET", `/graphql`, query, undefined);
+  }
+
+  /** GraphQL endpoint — POST /graphql */
+  postGraphql(body: GraphQLRequest): Promise<GraphQLResponse> {
+    return this.request("POST", `/graphql`, undefined, body);
+  }
+
+  /** Check API health — GET /health */
+  getHealth(): Promise<SwaggerStandardResponse> {
+    return this.request("GET", `/health`, undefined, undefined);
+  }
+
+  /** Liveness probe — GET /health/live */
+  getHealthLive(): Promise<LivenessResponse> {
+    return this.request("GET", `/health/live`, undefined, undefined);
+  }
+
+  /** Readiness probe — GET /health/ready */
+  getHealthReady(): Promise<ReadinessReport> {
+    return this.request("GET", `/health/ready`, undefined, undefined);
+  }
+
+  /** Get the homepage feed — GET /home/feed */
+  getHomeFeed(query?: { limit?: number }): Promise<HomeFeedResponse> {
+    return this.request("GET", `/home/feed`, query, undefined);
+  }
+
+  /** Get API version and feature flags — GET /meta */
+  getMeta(): Promise<APIMeta> {
+    return this.request("GET", `/meta`, undefined, undefined);
+  }
+
+  /** Get news articles — GET /news */
+  getNews(query?: { category?: string; tag?: string; search?: string; lang?: string; page?: number; per_page?: number; cursor?: string; after?: string }): Promise<NewsWithoutContentResponse> {
+    return this.request("GET", `/news`, query, undefined);
+  }
+
+  /** Get news categories — GET /news/categories */
+  getNewsCategories(): Promise<string[]> {
+    return this.request("GET", `/news/categories`, undefined, undefined);
+  }
+
+  /** Get news article by slug — GET /news/slug/{slug} */
+  getNewsSlugBySlug(slug: string | number): Promise<SwaggerNewsWithContentStatus> {
+    return this.request("GET", `/news/slug/${encodeURIComponent(String(slug))}`, undefined, undefined);
+  }
+
+  /** Get news article by ID — GET /news/{id} */
+  getNewsById(id: string | number): Promise<SwaggerNewsWithContentStatus> {
+    return this.request("GET", `/news/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Bookmark a news article — POST /news/{id}/bookmark */
+  postNewsByIdBookmark(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/news/${encodeURIComponent(String(id))}/bookmark`, undefined, undefined);
+  }
+
+  /** Remove a news bookmark — DELETE /news/{id}/bookmark */
+  deleteNewsByIdBookmark(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/news/${encodeURIComponent(String(id))}/bookmark`, undefined, undefined);
+  }
+
+  /** Get full content for news article — GET /news/{id}/full-content */
+  getNewsByIdFullContent(id: string | number): Promise<SwaggerNewsWithContentStatus> {
+    return this.request("GET", `/news/${encodeURIComponent(String(id))}/full-content`, undefined, undefined);
+  }
+
+  /** Confirm a newsletter subscription — GET /newsletter/confirm */
+  getNewsletterConfirm(query: { token: string }): Promise<SwaggerStandardResponse> {
+    return this.request("GET", `/newsletter/confirm`, query, undefined);
+  }
+
+  /** Subscribe to the newsletter — POST /newsletter/subscribe */
+  postNewsletterSubscribe(body: SubscribeRequest): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/newsletter/subscribe`, undefined, body);
+  }
+
+  /** Unsubscribe from the newsletter — GET /newsletter/unsubscribe */
+  getNewsletterUnsubscribe(query: { token: string }): Promise<SwaggerStandardResponse> {
+    return this.request("GET", `/newsletter/unsubscribe`, query, undefined);
+  }
+
+  /** Unsubscribe from the newsletter — POST /newsletter/unsubscribe */
+  postNewsletterUnsubscribe(query: { token: string }): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/newsletter/unsubscribe`, query, undefined);
+  }
+
+  /** Get notifications — GET /notifications */
+  getNotifications(query?: { unread?: boolean; page?: number; limit?: number }): Promise<SwaggerNotificationsResponse> {
+    return this.request("GET", `/notifications`, query, undefined);
+  }
+
+  /** Stop comment emails — GET /notifications/email/unsubscribe */
+  getNotificationsEmailUnsubscribe(query: { token: string }): Promise<SwaggerStandardResponse> {
+    return this.request("GET", `/notifications/email/unsubscribe`, query, undefined);
+  }
+
+  /** Stop comment emails — POST /notifications/email/unsubscribe */
+  postNotificationsEmailUnsubscribe(query: { token: string }): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/notifications/email/unsubscribe`, query, undefined);
+  }
+
+  /** Mark a notification as read — POST /notifications/{id}/read */
+  postNotificationsByIdRead(id: string | number): Promise<Notification> {
+    return this.request("POST", `/notifications/${encodeURIComponent(String(id))}/read`, undefined, undefined);
+  }
+
+  /** Get static pages — GET /pages */
+  getPages(): Promise<Page[]> {
+    return this.request("GET", `/pages`, undefined, undefined);
+  }
+
+  /** Create a static page — POST /pages */
+  postPages(body: CreatePageRequest): Promise<Page> {
+    return this.request("POST", `/pages`, undefined, body);
+  }
+
+  /** Get a static page by slug — GET /pages/{slug} */
+  getPagesBySlug(slug: string | number): Promise<Page> {
+    return this.request("GET", `/pages/${encodeURIComponent(String(slug))}`, undefined, undefined);
+  }
+
+  /** Update a static page — PUT /pages/{slug} */
+  putPagesBySlug(slug: string | number, body: UpdatePageRequest): Promise<Page> {
+    return this.request("PUT", `/pages/${encodeURIComponent(String(slug))}`, undefined, body);
+  }
+
+  /** Delete a static page — DELETE /pages/{slug} */
+  deletePagesBySlug(slug: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/pages/${encodeURIComponent(String(slug))}`, undefined, undefined);
+  }
+
+  /** List post templates — GET /post-templates */
+  getPostTemplates(): Promise<PostTemplate[]> {
+    return this.request("GET", `/post-templates`, undefined, undefined);
+  }
+
+  /** Create a post template — POST /post-templates */
+  postPostTemplates(body: CreatePostTemplateRequest): Promise<PostTemplate> {
+    return this.request("POST", `/post-templates`, undefined, body);
+  }
+
+  /** Get a post template — GET /post-templates/{id} */
+  getPostTemplatesById(id: string | number): Promise<PostTemplate> {
+    return this.request("GET", `/post-templates/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Update a post template — PUT /post-templates/{id} */
+  putPostTemplatesById(id: string | number, body: UpdatePostTemplateRequest): Promise<PostTemplate> {
+    return this.request("PUT", `/post-templates/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a post template — DELETE /post-templates/{id} */
+  deletePostTemplatesById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/post-templates/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Get list of blog posts — GET /posts */
+  getPosts(query?: { page?: number; limit?: number; cursor?: string; after?: string; tag?: string; status?: string; category?: string; lang?: string }): Promise<SwaggerPostsResponse> {
+    return this.request("GET", `/posts`, query, undefined);
+  }
+
+  /** Create a new blog post — POST /posts */
+  postPosts(body: CreatePostRequest): Promise<Post> {
+    return this.request("POST", `/posts`, undefined, body);
+  }
+
+  /** Get the current user's blog posts — GET /posts/me */
+  getPostsMe(query?: { page?: number; limit?: number }): Promise<SwaggerPostsResponse> {
+    return this.request("GET", `/posts/me`, query, undefined);
+  }
+
+  /** Preview an unpublished post — GET /posts/preview/{token} */
+  getPostsPreviewByToken(token: string | number): Promise<Post> {
+    return this.request("GET", `/posts/preview/${encodeURIComponent(String(token))}`, undefined, undefined);
+  }
+
+  /** Get a blog post by slug — GET /posts/slug/{slug} */
+  getPostsSlugBySlug(slug: string | number): Promise<Post> {
+    return this.request("GET", `/posts/slug/${encodeURIComponent(String(slug))}`, undefined, undefined);
+  }
+
+  /** Update an existing blog post — PUT /posts/{id} */
+  putPostsById(id: string | number, body: UpdatePostRequest): Promise<Post> {
+    return this.request("PUT", `/posts/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a blog post — DELETE /posts/{id} */
+  deletePostsById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/posts/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Get a post's reader analytics — GET /posts/{id}/analytics */
+  getPostsByIdAnalytics(id: string | number, query?: { days?: number }): Promise<PostAnalytics> {
+    return this.request("GET", `/posts/${encodeURIComponent(String(id))}/analytics`, query, undefined);
+  }
+
+  /** Add a co-author to a post — POST /posts/{id}/authors */
+  postPostsByIdAuthors(id: string | number, body: AddPostAuthorRequest): Promise<PostAuthor[]> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/authors`, undefined, body);
+  }
+
+  /** Remove a co-author from a post — DELETE /posts/{id}/authors/{username} */
+  deletePostsByIdAuthorsByUsername(id: string | number, username: string | number): Promise<PostAuthor[]> {
+    return this.request("DELETE", `/posts/${encodeURIComponent(String(id))}/authors/${encodeURIComponent(String(username))}`, undefined, undefined);
+  }
+
+  /** Get the latest autosave of a post — GET /posts/{id}/autosave */
+  getPostsByIdAutosave(id: string | number): Promise<PostAutosaveResult> {
+    return this.request("GET", `/posts/${encodeURIComponent(String(id))}/autosave`, undefined, undefined);
+  }
+
+  /** Autosave a post being edited — PUT /posts/{id}/autosave */
+  putPostsByIdAutosave(id: string | number, body: AutosavePostRequest): Promise<PostAutosaveResult> {
+    return this.request("PUT", `/posts/${encodeURIComponent(String(id))}/autosave`, undefined, body);
+  }
+
+  /** Bookmark a post — POST /posts/{id}/bookmark */
+  postPostsByIdBookmark(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/bookmark`, undefined, undefined);
+  }
+
+  /** Remove a bookmark — DELETE /posts/{id}/bookmark */
+  deletePostsByIdBookmark(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/posts/${encodeURIComponent(String(id))}/bookmark`, undefined, undefined);
+  }
+
+  /** Get comments for a post — GET /posts/{id}/comments */
+  getPostsByIdComments(id: string | number, query?: { page?: number; limit?: number; sort?: "newest" | "top" }): Promise<Comment[]> {
+    return this.request("GET", `/posts/${encodeURIComponent(String(id))}/comments`, query, undefined);
+  }
+
+  /** Create a new comment — POST /posts/{id}/comments */
+  postPostsByIdComments(id: string | number, body: CreateCommentRequest): Promise<Comment> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/comments`, undefined, body);
+  }
+
+  /** Upload post cover image — POST /posts/{id}/cover */
+  postPostsByIdCover(id: string | number, cover: Blob): Promise<SwaggerStandardResponse & { data?: SwaggerPostCoverResponse; }> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/cover`, undefined, formData([["cover", cover]]));
+  }
+
+  /** Delete post cover image — DELETE /posts/{id}/cover */
+  deletePostsByIdCover(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/posts/${encodeURIComponent(String(id))}/cover`, undefined, undefined);
+  }
+
+  /** Get a post's cross-posts — GET /posts/{id}/cross-posts */
+  getPostsByIdCrossPosts(id: string | number): Promise<CrossPost[]> {
+    return this.request("GET", `/posts/${encodeURIComponent(String(id))}/cross-posts`, undefined, undefined);
+  }
+
+  /** Retry a post's failed cross-posts — POST /posts/{id}/cross-posts/retry */
+  postPostsByIdCrossPostsRetry(id: string | number): Promise<CrossPostRetryResponse> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/cross-posts/retry`, undefined, undefined);
+  }
+
+  /** Suggest internal links for a post — GET /posts/{id}/link-suggestions */
+  getPostsByIdLinkSuggestions(id: string | number, query?: { limit?: number }): Promise<LinkSuggestion[]> {
+    return this.request("GET", `/posts/${encodeURIComponent(String(id))}/link-suggestions`, query, undefined);
+  }
+
+  /** Create a preview link for an unpublished post — POST /posts/{id}/preview-token */
+  postPostsByIdPreviewToken(id: string | number): Promise<PreviewTokenResponse> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/preview-token`, undefined, undefined);
+  }
+
+  /** Revoke preview links for a post — DELETE /posts/{id}/preview-token */
+  deletePostsByIdPreviewToken(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/posts/${encodeURIComponent(String(id))}/preview-token`, undefined, undefined);
+  }
+
+  /** Save reading progress — PUT /posts/{id}/progress */
+  putPostsByIdProgress(id: string | number, body: SaveReadingProgressRequest): Promise<ReadingProgress> {
+    return this.request("PUT", `/posts/${encodeURIComponent(String(id))}/progress`, undefined, body);
+  }
+
+  /** Publish a blog post — POST /posts/{id}/publish */
+  postPostsByIdPublish(id: string | number): Promise<Post> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/publish`, undefined, undefined);
+  }
+
+  /** Set the status of a blog post — POST /posts/{id}/status */
+  postPostsByIdStatus(id: string | number, body: SetPostStatusRequest): Promise<Post> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/status`, undefined, body);
+  }
+
+  /** Unpublish a blog post — POST /posts/{id}/unpublish */
+  postPostsByIdUnpublish(id: string | number): Promise<Post> {
+    return this.request("POST", `/posts/${encodeURIComponent(String(id))}/unpublish`, undefined, undefined);
+  }
+
+  /** Get user profile — GET /profile */
+  getProfile(): Promise<SwaggerStandardResponse & { data?: SwaggerProfileResponse; }> {
+    return this.request("GET", `/profile`, undefined, undefined);
+  }
+
+  /** Update user profile — PUT /profile */
+  putProfile(body: UpdateProfileRequest): Promise<SwaggerStandardResponse & { data?: SwaggerProfileResponse; }> {
+    return this.request("PUT", `/profile`, undefined, body);
+  }
+
+  /** Delete your account — DELETE /profile */
+  deleteProfile(body: DeleteAccountRequest): Promise<UserDeletion> {
+    return this.request("DELETE", `/profile`, undefined, body);
+  }
+
+  /** List your API keys — GET /profile/api-keys */
+  getProfileApiKeys(): Promise<APIKey[]> {
+    return this.request("GET", `/profile/api-keys`, undefined, undefined);
+  }
+
+  /** Create an API key — POST /profile/api-keys */
+  postProfileApiKeys(body: CreateAPIKeyRequest): Promise<CreateAPIKeyResponse> {
+    return this.request("POST", `/profile/api-keys`, undefined, body);
+  }
+
+  /** Revoke an API key — DELETE /profile/api-keys/{id} */
+  deleteProfileApiKeysById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/profile/api-keys/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Upload user avatar — POST /profile/avatar */
+  postProfileAvatar(avatar: Blob): Promise<SwaggerStandardResponse & { data?: SwaggerAvatarResponse; }> {
+    return this.request("POST", `/profile/avatar`, undefined, formData([["avatar", avatar]]));
+  }
+
+  /** Get bookmarked posts — GET /profile/bookmarks */
+  getProfileBookmarks(query?: { page?: number; limit?: number }): Promise<SwaggerBookmarksResponse> {
+    return this.request("GET", `/profile/bookmarks`, query, undefined);
+  }
+
+  /** Export your data — GET /profile/export */
+  getProfileExport(query?: { format?: string }): Promise<Blob> {
+    return this.request("GET", `/profile/export`, query, undefined);
+  }
+
+  /** Get reading progress — GET /profile/progress */
+  getProfileProgress(query?: { page?: number; limit?: number; finished?: boolean }): Promise<SwaggerReadingProgressResponse> {
+    return this.request("GET", `/profile/progress`, query, undefined);
+  }
+
+  /** Get the reading list — GET /profile/reading-list */
+  getProfileReadingList(query?: { page?: number; limit?: number }): Promise<SwaggerReadingListResponse> {
+    return this.request("GET", `/profile/reading-list`, query, undefined);
+  }
+
+  /** List your sessions — GET /profile/sessions */
+  getProfileSessions(): Promise<Session[]> {
+    return this.request("GET", `/profile/sessions`, undefined, undefined);
+  }
+
+  /** Sign out a session — DELETE /profile/sessions/{id} */
+  deleteProfileSessionsById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/profile/sessions/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Search posts, news and tags — GET /search */
+  getSearch(query: { q: string; types?: string; limit?: number }): Promise<SearchResponse> {
+    return this.request("GET", `/search`, query, undefined);
+  }
+
+  /** Get post series — GET /series */
+  getSeries(): Promise<Series[]> {
+    return this.request("GET", `/series`, undefined, undefined);
+  }
+
+  /** Create a series — POST /series */
+  postSeries(body: CreateSeriesRequest): Promise<Series> {
+    return this.request("POST", `/series`, undefined, body);
+  }
+
+  /** Update a series — PUT /series/{id} */
+  putSeriesById(id: string | number, body: UpdateSeriesRequest): Promise<Series> {
+    return this.request("PUT", `/series/${encodeURIComponent(String(id))}`, undefined, body);
+  }
+
+  /** Delete a series — DELETE /series/{id} */
+  deleteSeriesById(id: string | number): Promise<SwaggerStandardResponse> {
+    return this.request("DELETE", `/series/${encodeURIComponent(String(id))}`, undefined, undefined);
+  }
+
+  /** Add a post to a series — POST /series/{id}/posts */
+  postSeriesByIdPosts(id: string | number, body: AddSeriesPostRequest): Promise<Series> {
+    return this.request("POST", `/series/${encodeURIComponent(String(id))}/posts`, undefined, body);
+  }
+
+  /** Remove a post from a series — DELETE /series/{id}/posts/{post_id} */
+  deleteSeriesByIdPostsByPostId(id: string | number, postId: string | number): Promise<Series> {
+    return this.request("DELETE", `/series/${encodeURIComponent(String(id))}/posts/${encodeURIComponent(String(postId))}`, undefined, undefined);
+  }
+
+  /** Get a series by slug — GET /series/{slug} */
+  getSeriesBySlug(slug: string | number): Promise<Series> {
+    return this.request("GET", `/series/${encodeURIComponent(String(slug))}`, undefined, undefined);
+  }
+
+  /** Get public site statistics — GET /stats/public */
+  getStatsPublic(): Promise<PublicStats> {
+    return this.request("GET", `/stats/public`, undefined, undefined);
+  }
+
+  /** Get all tags — GET /tags */
+  getTags(): Promise<TagWithCount[]> {
+    return this.request("GET", `/tags`, undefined, undefined);
+  }
+
+  /** Get popular tags — GET /tags/popular */
+  getTagsPopular(): Promise<TagWithCount[]> {
+    return this.request("GET", `/tags/popular`, undefined, undefined);
+  }
+
+  /** Get an author's public profile — GET /users/{username} */
+  getUsersByUsername(username: string | number): Promise<PublicProfile> {
+    return this.request("GET", `/users/${encodeURIComponent(String(username))}`, undefined, undefined);
+  }
+
+  /** Get an author's published posts — GET /users/{username}/posts */
+  getUsersByUsernamePosts(username: string | number, query?: { page?: number; limit?: number }): Promise<SwaggerPostsResponse> {
+    return this.request("GET", `/users/${encodeURIComponent(String(username))}/posts`, query, undefined);
+  }
+
+  /** Get API build information — GET /version */
+  getVersion(): Promise<VersionInfo> {
+    return this.request("GET", `/version`, undefined, undefined);
+  }
+
+}
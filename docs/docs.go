@@ -24,71 +24,119 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/admin/news": {
-            "post": {
+        "/admin/audit-logs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new news article (admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the audit log of admin and destructive actions, newest first, with the state of the resource before and after each action (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Admin"
                 ],
-                "summary": "Create a news article",
+                "summary": "List audit log entries",
                 "parameters": [
                     {
-                        "description": "News article to create",
-                        "name": "news",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateNewsRequest"
-                        }
+                        "type": "integer",
+                        "description": "Only actions by this user",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only this action, e.g. post.deleted or news.status_changed",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only actions on this kind of resource, e.g. post, news, user",
+                        "name": "resource_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only actions on this resource",
+                        "name": "resource_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only actions performed by this request",
+                        "name": "request_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only actions at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only actions before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, default is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, default is 20, max is 100",
+                        "name": "per_page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created news article",
+                    "200": {
+                        "description": "Audit log entries with pagination",
                         "schema": {
-                            "$ref": "#/definitions/models.News"
+                            "$ref": "#/definitions/models.AuditLogListResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/admin/news/fetch": {
+        "/admin/categories": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetch and store news from external API (admin only)",
+                "description": "Creates a post category, optionally nested under a parent",
                 "consumes": [
                     "application/json"
                 ],
@@ -96,56 +144,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Categories"
                 ],
-                "summary": "Fetch news from external API",
+                "summary": "Create a category",
                 "parameters": [
                     {
-                        "description": "Fetch request parameters",
-                        "name": "fetch_request",
+                        "description": "Category details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.FetchNewsRequest"
+                            "$ref": "#/definitions/models.CreateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "News articles fetched",
+                    "201": {
+                        "description": "Created category",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerFetchNewsResponse"
+                            "$ref": "#/definitions/models.Category"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/admin/news/fetch-rss": {
-            "post": {
+        "/admin/categories/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetch and store news from configured RSS feeds (admin only)",
+                "description": "Updates a category's name, slug, description, position, or parent",
                 "consumes": [
                     "application/json"
                 ],
@@ -153,324 +213,344 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Categories"
                 ],
-                "summary": "Fetch news from RSS feeds",
+                "summary": "Update a category",
                 "parameters": [
                     {
-                        "description": "Fetch request parameters (only limit is used for RSS feeds)",
-                        "name": "fetch_request",
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category changes",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.FetchNewsRequest"
+                            "$ref": "#/definitions/models.UpdateCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "News articles fetched from RSS feeds",
+                        "description": "Updated category",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerFetchRSSNewsResponse"
+                            "$ref": "#/definitions/models.Category"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/admin/news/{id}": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing news article (admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes a category. Its subcategories move up to its parent and its posts become uncategorized.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Categories"
                 ],
-                "summary": "Update a news article",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "News article ID",
+                        "description": "Category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated news article",
-                        "name": "news",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateNewsRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated news article",
+                        "description": "Success message",
                         "schema": {
-                            "$ref": "#/definitions/models.News"
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "News article not found",
+                        "description": "Category not found",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/comments/pending": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a news article (admin only)",
+                "description": "Returns the comments held for moderation, oldest first. Approve them with the approve endpoint or reject them by deleting them.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "News"
-                ],
-                "summary": "Delete a news article",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "News article ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Comments"
                 ],
+                "summary": "List comments held for moderation",
                 "responses": {
                     "200": {
-                        "description": "News article deleted",
+                        "description": "Pending comments",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerDeleteNewsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Comment"
+                            }
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "News article not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/admin/news/{id}/status": {
+        "/admin/comments/{commentID}/approve": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the status of a news article (admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Publishes a comment that was held for moderation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Set news article status",
+                "summary": "Approve a held comment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "News article ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Comment ID or UUID",
+                        "name": "commentID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "New status",
-                        "name": "status",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.SetNewsStatusRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated news article",
+                        "description": "Approved comment",
                         "schema": {
-                            "$ref": "#/definitions/models.News"
+                            "$ref": "#/definitions/models.Comment"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "News article not found",
+                        "description": "Comment not found",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Comment is not pending",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Authenticate a user and return JWT tokens",
-                "consumes": [
-                    "application/json"
+        "/admin/content/broken-links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns the outbound links in published posts and news articles that failed their last check, longest broken first, with the title and slug of the content they are in (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
                 ],
-                "summary": "Login to the application",
+                "summary": "List broken links",
                 "parameters": [
                     {
-                        "description": "Login Credentials",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.LoginRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Login successful",
-                        "schema": {
-                            "$ref": "#/definitions/models.TokenResponse"
-                        }
-                    },
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of links per page (default: PAGINATION_DEFAULT_LIMIT, max: PAGINATION_MAX_LIMIT)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include links to this domain",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include links in this kind of content (post, news)",
+                        "name": "source_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Broken links with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerBrokenLinksResponse"
+                        }
+                    },
                     "400": {
-                        "description": "Invalid input",
+                        "description": "Invalid page or page size",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Authentication failed",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/auth/logout": {
+        "/admin/content/broken-links/check": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Invalidate the current user's tokens",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Starts checking the outbound links in published content in the background instead of waiting for the next scheduled run. Links are checked oldest check first, up to LINK_CHECK_BATCH_SIZE, pausing LINK_CHECK_DOMAIN_DELAY between requests to the same domain (admin only).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
-                ],
-                "summary": "Logout from the application",
-                "parameters": [
-                    {
-                        "description": "Logout options",
-                        "name": "body",
-                        "in": "body",
-                        "schema": {
-                            "type": "object"
-                        }
-                    }
+                    "Admin"
                 ],
+                "summary": "Check links now",
                 "responses": {
-                    "200": {
-                        "description": "Successfully logged out",
+                    "202": {
+                        "description": "Link check started",
                         "schema": {
                             "$ref": "#/definitions/models.SwaggerStandardResponse"
                         }
@@ -478,126 +558,121 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A link check is already running",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/auth/refresh": {
-            "post": {
-                "description": "Get a new access token using a refresh token",
-                "consumes": [
-                    "application/json"
+        "/admin/diagnostics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Checks the application's external dependencies and configuration (file storage, NewsAPI, RSS feeds, SMTP, virus scanner, disk space, database schema) and reports pass/warn/fail for each",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
-                ],
-                "summary": "Refresh an access token",
-                "parameters": [
-                    {
-                        "description": "Refresh Token",
-                        "name": "refresh_token",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.RefreshTokenRequest"
-                        }
-                    }
+                    "Admin"
                 ],
+                "summary": "Run diagnostics",
                 "responses": {
                     "200": {
-                        "description": "Token refreshed successfully",
+                        "description": "Diagnostics report",
                         "schema": {
-                            "$ref": "#/definitions/models.TokenResponse"
+                            "$ref": "#/definitions/models.DiagnosticsReport"
                         }
                     },
-                    "400": {
-                        "description": "Invalid input",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Invalid refresh token",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/auth/register": {
-            "post": {
-                "description": "Create a new user account",
-                "consumes": [
-                    "application/json"
+        "/admin/freeze-windows": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns current and upcoming freeze windows, or all windows with all=true",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
                 ],
-                "summary": "Register a new user",
+                "summary": "List content freeze windows",
                 "parameters": [
                     {
-                        "description": "User Registration Data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.RegisterRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Include windows that have already ended",
+                        "name": "all",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User registered successfully",
+                    "200": {
+                        "description": "List of freeze windows",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.FreezeWindow"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Invalid input",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Email or username already exists",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/auth/revoke": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Invalidate a refresh token",
+                "description": "Schedules a period during which publishes and scheduled publishing are paused. Publishes requested during the window are queued until it ends.",
                 "consumes": [
                     "application/json"
                 ],
@@ -605,184 +680,170 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Admin"
                 ],
-                "summary": "Revoke a refresh token",
+                "summary": "Create a content freeze window",
                 "parameters": [
                     {
-                        "description": "Refresh Token",
-                        "name": "refresh_token",
+                        "description": "Freeze window details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.TokenRevokeRequest"
+                            "$ref": "#/definitions/models.CreateFreezeWindowRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Token revoked successfully",
+                    "201": {
+                        "description": "Created freeze window",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.FreezeWindow"
                         }
                     },
                     "400": {
-                        "description": "Invalid input or token revocation failed",
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/comments/{commentID}": {
-            "put": {
+        "/admin/freeze-windows/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing comment",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Removes a freeze window. Deleting an active window lifts the freeze and queued publishes go out on the next scheduler run.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comments"
+                    "Admin"
                 ],
-                "summary": "Update a comment",
+                "summary": "Delete a content freeze window",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Comment ID",
-                        "name": "commentID",
+                        "description": "Freeze window ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated comment content",
-                        "name": "comment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateCommentRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated comment",
+                        "description": "Success message",
                         "schema": {
-                            "$ref": "#/definitions/models.Comment"
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Comment not found",
+                        "description": "Freeze window not found",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/home/picks": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes a comment from a post",
+                "description": "Returns the posts and news articles that are boosted in the homepage feed",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comments"
-                ],
-                "summary": "Delete a comment",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Comment ID",
-                        "name": "commentID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Home"
                 ],
+                "summary": "List editorial picks",
                 "responses": {
                     "200": {
-                        "description": "Success message",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid input",
+                        "description": "Editorial picks",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.EditorialPick"
+                            }
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Comment not found",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/files/delete": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a file that was previously uploaded for use in the editor",
+                "description": "Sets the editorial weight of a post or news article in the homepage feed, replacing any previous weight",
                 "consumes": [
                     "application/json"
                 ],
@@ -790,534 +851,526 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Files"
+                    "Home"
                 ],
-                "summary": "Delete a file uploaded for editor use",
+                "summary": "Boost a feed item",
                 "parameters": [
                     {
-                        "description": "File URL to delete",
+                        "description": "Item and weight",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerDeleteFileRequest"
+                            "$ref": "#/definitions/models.SetEditorialPickRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File deleted successfully",
+                        "description": "Editorial pick",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.EditorialPick"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/files/upload": {
-            "post": {
+        "/admin/home/picks/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a file that can be used in the editor when creating or editing posts",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+                "description": "Removes the editorial boost of a post or news article",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Files"
+                    "Home"
                 ],
-                "summary": "Upload a file for editor use",
+                "summary": "Remove an editorial pick",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "File to upload (JPG, JPEG, PNG, WEBP, GIF, SVG, PDF, max 5MB)",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Editorial pick ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "File uploaded successfully",
+                        "description": "Editorial pick deleted",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerFileUploadResponse"
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Editorial pick not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/health": {
+        "/admin/jwt-keys": {
             "get": {
-                "description": "Provides a simple endpoint to verify the API and database are running",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the keys tokens are signed and verified with, newest first, including retired keys. Secrets are never returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Admin"
                 ],
-                "summary": "Check API health",
+                "summary": "List JWT signing keys",
                 "responses": {
                     "200": {
-                        "description": "API is healthy",
+                        "description": "JWT signing keys",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.JWTSigningKey"
+                            }
                         }
                     },
-                    "503": {
-                        "description": "Database connection issues",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/news": {
-            "get": {
-                "description": "Returns paginated news articles with optional filtering",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "News"
-                ],
-                "summary": "Get news articles",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by tag",
-                        "name": "tag",
-                        "in": "query"
                     },
-                    {
-                        "type": "string",
-                        "description": "Search in title and content",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number, default is 1",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page, default is 10, max is 50",
-                        "name": "per_page",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "List of news articles with pagination (without content)",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.NewsWithoutContentResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/news/categories": {
-            "get": {
-                "description": "Returns all available news categories",
+        "/admin/jwt-keys/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates a new signing key and signs new tokens with it. Tokens signed with older keys stay valid until those keys are retired, so nobody is signed out. Other instances pick up the new key within a minute.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Admin"
                 ],
-                "summary": "Get news categories",
+                "summary": "Rotate the JWT signing key",
                 "responses": {
-                    "200": {
-                        "description": "List of categories",
+                    "201": {
+                        "description": "New signing key",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/models.JWTSigningKey"
                         }
-                    }
-                }
-            }
-        },
-        "/news/slug/{slug}": {
-            "get": {
-                "description": "Returns a specific news article by its slug",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "News"
-                ],
-                "summary": "Get news article by slug",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "News article slug",
-                        "name": "slug",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "News article with content status",
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerNewsWithContentStatus"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "News article not found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/news/{id}": {
-            "get": {
-                "description": "Returns a specific news article by its ID",
+        "/admin/jwt-keys/{kid}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops accepting tokens signed with a key, signing out every session that still uses it. Retire old keys once JWT_REFRESH_EXPIRY has passed since the rotation, or right away if the key was leaked. The current signing key can't be retired.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Admin"
                 ],
-                "summary": "Get news article by ID",
+                "summary": "Retire a JWT signing key",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "News article ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Key ID",
+                        "name": "kid",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "News article with content status",
+                        "description": "Retired key",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerNewsWithContentStatus"
+                            "$ref": "#/definitions/models.JWTSigningKey"
                         }
                     },
-                    "404": {
-                        "description": "News article not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/news/{id}/full-content": {
-            "get": {
-                "description": "Attempts to fetch and return the full content for a news article",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "News"
-                ],
-                "summary": "Get full content for news article",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "News article ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "News article with full content status",
+                    },
+                    "404": {
+                        "description": "Key not found",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerNewsWithContentStatus"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "News article not found",
+                    "409": {
+                        "description": "Key is the current signing key",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts": {
+        "/admin/news": {
             "get": {
-                "description": "Returns a paginated list of blog posts with optional tag and status filtering",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns news articles of every status, filtered for curation (admin only). Pass view to start from a saved view; other query parameters override its filters.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Get list of blog posts",
+                "summary": "List news articles for curation",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
+                        "description": "ID of a saved view to apply",
+                        "name": "view",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Number of items per page (default: 10)",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "Filter by status (published, draft, archived)",
+                        "name": "status",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter posts by tag name",
+                        "description": "Filter by source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag",
                         "name": "tag",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Filter posts by status (draft, published, archived, scheduled)",
-                        "name": "status",
+                        "description": "Search in title, content and summary",
+                        "name": "search",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "List of posts with pagination metadata",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerPostsResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Server error",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates a new blog post with the provided details",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Posts"
-                ],
-                "summary": "Create a new blog post",
-                "parameters": [
+                        "type": "string",
+                        "description": "Published at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
                     {
-                        "description": "Post details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreatePostRequest"
-                        }
+                        "type": "string",
+                        "description": "Published before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only articles with (true) or without (false) an image",
+                        "name": "has_image",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only articles whose content looks cut off (true) or complete (false)",
+                        "name": "truncated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, default is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, default is 10, max is 50, both configurable",
+                        "name": "per_page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created post",
+                    "200": {
+                        "description": "List of news articles with pagination (without content)",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.NewsWithoutContentResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Saved view not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/posts/me": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a paginated list of blog posts authored by the currently authenticated user",
+                "description": "Create a new news article (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Get the current user's blog posts",
+                "summary": "Create a news article",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Page number (default: 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of items per page (default: 10)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "News article to create",
+                        "name": "news",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateNewsRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of the user's posts with pagination metadata",
+                    "201": {
+                        "description": "Created news article",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerPostsResponse"
+                            "$ref": "#/definitions/models.News"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/slug/{slug}": {
-            "get": {
-                "description": "Returns a single blog post by its slug",
+        "/admin/news/bulk-delete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes up to 1000 news articles at once, like deleting each (admin only). Articles are deleted in chunks of 100, each in its own transaction, so a failing chunk only fails its own articles. The outcome of every requested ID is reported.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Get a blog post by slug",
+                "summary": "Delete news articles in bulk",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Post slug",
-                        "name": "slug",
-                        "in": "path",
-                        "required": true
+                        "description": "Articles to delete",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BulkNewsDeleteRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Post details",
+                        "description": "Outcome per article",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.NewsBulkResult"
                         }
                     },
-                    "404": {
-                        "description": "Post not found",
+                    "400": {
+                        "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{id}": {
-            "put": {
+        "/admin/news/bulk-status": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates a blog post with the provided details",
+                "description": "Changes the status of up to 1000 news articles at once, like setting it on each (admin only). Articles are saved in chunks of 100, each in its own transaction, so a failing chunk only fails its own articles. The outcome of every requested ID is reported.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1325,137 +1378,169 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Update an existing blog post",
+                "summary": "Set the status of news articles in bulk",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Post details",
-                        "name": "post",
+                        "description": "Articles and their new status",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdatePostRequest"
+                            "$ref": "#/definitions/models.BulkNewsStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated post",
+                        "description": "Outcome per article",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.NewsBulkResult"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
+                        "description": "Forbidden - admin role required",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/news/categories": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every news category, including disabled ones, with its keyword hints (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "List news categories",
+                "responses": {
+                    "200": {
+                        "description": "News categories",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.NewsCategoryModel"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Post not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a blog post by ID (soft delete)",
+                "description": "Adds a news category. Its keywords are used to classify fetched articles that don't come with a category (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Delete a blog post",
+                "summary": "Create a news category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "News category",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateNewsCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Success message",
+                    "201": {
+                        "description": "Created category",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.NewsCategoryModel"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Post not found",
+                    "409": {
+                        "description": "Slug already in use",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{id}/comments": {
-            "post": {
+        "/admin/news/categories/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a new comment to a post",
+                "description": "Renames, enables or disables a news category, or replaces its keyword hints. Changing the slug moves existing articles to the new slug (admin only).",
                 "consumes": [
                     "application/json"
                 ],
@@ -1463,263 +1548,315 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Comments"
+                    "News"
                 ],
-                "summary": "Create a new comment",
+                "summary": "Change a news category",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
+                        "description": "News category ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Comment content",
-                        "name": "comment",
+                        "description": "Fields to change",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateCommentRequest"
+                            "$ref": "#/definitions/models.UpdateNewsCategoryRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created comment",
+                    "200": {
+                        "description": "Updated category",
                         "schema": {
-                            "$ref": "#/definitions/models.Comment"
+                            "$ref": "#/definitions/models.NewsCategoryModel"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Post not found",
+                        "description": "News category not found",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{id}/cover": {
+        "/admin/news/enrich-batch": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a new cover image for a post",
+                "description": "Scrapes the full content of several news articles from their source pages. Pass ids to pick the articles, or leave it empty to enrich the newest truncated articles that were never enriched, up to limit; retry_failed also picks articles whose last attempt failed. The request returns when the batch is done.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "Admin"
                 ],
-                "summary": "Upload post cover image",
+                "summary": "Enrich news articles in a batch",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Cover image file (JPG, JPEG, PNG, WEBP, max 5MB)",
-                        "name": "cover",
-                        "in": "formData",
-                        "required": true
+                        "description": "Articles to enrich",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.EnrichNewsBatchRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Cover uploaded successfully",
+                        "description": "Outcome per article",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerPostCoverResponse"
+                            "$ref": "#/definitions/models.NewsEnrichmentBatchResult"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
+                        "description": "Forbidden - admin role required",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Post not found",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/news/enrichment-status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Counts news articles by full-content enrichment state: truncated, enriched, failed on the last attempt, and truncated but never enriched.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Summarize news enrichment",
+                "responses": {
+                    "200": {
+                        "description": "Enrichment counts",
+                        "schema": {
+                            "$ref": "#/definitions/models.NewsEnrichmentStatus"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/news/fetch": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove the cover image from a post",
+                "description": "Fetch and store news from external API (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Delete post cover image",
+                "summary": "Fetch news from external API",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Fetch request parameters",
+                        "name": "fetch_request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.FetchNewsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Cover deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "News articles fetched",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.SwaggerFetchNewsResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Post not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{id}/publish": {
-            "post": {
+        "/admin/news/fetch-history": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sets a blog post's status to published",
+                "description": "Returns the most recent fetches of news sources with their duration, the articles found and saved, and the error of failed fetches (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Publish a blog post",
+                "summary": "List news source fetches",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by news source ID",
+                        "name": "source_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by outcome (ok, failed)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of fetches to return (default: 50, max: 200)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Published post",
+                        "description": "Fetches, newest first",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.FetchRun"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid input",
+                        "description": "Invalid news source ID",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Post not found",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{id}/status": {
+        "/admin/news/fetch-rss": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates a post's status to the specified value (draft, published, archived, scheduled)",
+                "description": "Fetch and store news from every enabled news source, whether or not it is due (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1727,212 +1864,236 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Set the status of a blog post",
+                "summary": "Fetch news from RSS feeds",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Status details",
-                        "name": "request",
+                        "description": "Fetch request parameters (only limit is used for RSS feeds)",
+                        "name": "fetch_request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SetPostStatusRequest"
+                            "$ref": "#/definitions/models.FetchNewsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated post",
+                        "description": "News articles fetched from RSS feeds",
                         "schema": {
-                            "$ref": "#/definitions/models.Post"
+                            "$ref": "#/definitions/models.SwaggerFetchRSSNewsResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Post not found",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{id}/unpublish": {
-            "post": {
+        "/admin/news/ingestions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sets a blog post's status to unpublished (draft)",
+                "description": "Returns the most recent news ingestion runs with their counters and source errors (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "News"
                 ],
-                "summary": "Unpublish a blog post",
+                "summary": "List news ingestion runs",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by pipeline (newsapi, rss)",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by run status (running, completed, failed)",
+                        "name": "status",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Number of runs to return (default: 50, max: 200)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Unpublished post",
-                        "schema": {
-                            "$ref": "#/definitions/models.Post"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid input",
+                        "description": "Ingestion runs, newest first",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.IngestionRun"
+                            }
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Post not found",
-                        "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/posts/{postID}/comments": {
+        "/admin/news/ingestions/{id}": {
             "get": {
-                "description": "Returns all comments for a specific post",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single ingestion run with the outcome of every article in it, including why articles were skipped (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comments"
+                    "News"
                 ],
-                "summary": "Get comments for a post",
+                "summary": "Get a news ingestion run",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Post ID",
-                        "name": "postID",
+                        "description": "Ingestion run ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include articles with this outcome (saved, deduped, filtered, failed)",
+                        "name": "outcome",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of comments",
+                        "description": "Ingestion run with its articles",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Comment"
-                            }
+                            "$ref": "#/definitions/models.IngestionRun"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ingestion run not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/profile": {
+        "/admin/news/sources": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve the current user's profile information",
+                "description": "Returns every RSS feed, including disabled ones, with the outcome of its last fetch (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "News"
                 ],
-                "summary": "Get user profile",
+                "summary": "List news sources",
                 "responses": {
                     "200": {
-                        "description": "User profile",
+                        "description": "News sources",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerProfileResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.NewsSource"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update the current user's profile information",
+                "description": "Adds an RSS feed to fetch news from. Articles get the source's category, or are classified when it has none (admin only).",
                 "consumes": [
                     "application/json"
                 ],
@@ -1940,587 +2101,13591 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "News"
                 ],
-                "summary": "Update user profile",
+                "summary": "Add a news source",
                 "parameters": [
                     {
-                        "description": "Profile Data",
-                        "name": "profile",
+                        "description": "News source",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerUpdateProfileRequest"
+                            "$ref": "#/definitions/models.CreateNewsSourceRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Profile updated successfully",
+                    "201": {
+                        "description": "Created source",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerProfileResponse"
+                            "$ref": "#/definitions/models.NewsSource"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/profile/avatar": {
-            "post": {
+        "/admin/news/sources/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upload a new avatar image for the current user",
+                "description": "Renames a news source, changes its feed URL, category or fetch interval, or enables or disables it (admin only)",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "News"
                 ],
-                "summary": "Upload user avatar",
+                "summary": "Change a news source",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Avatar image file (JPG, JPEG, PNG, max 2MB)",
-                        "name": "avatar",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "News source ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateNewsSourceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Avatar uploaded successfully",
+                        "description": "Updated source",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerAvatarResponse"
+                            "$ref": "#/definitions/models.NewsSource"
                         }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News source not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/tags": {
-            "get": {
-                "description": "Returns all tags with their post counts",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops fetching an RSS feed. Articles already fetched from it are kept (admin only).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "News"
+                ],
+                "summary": "Delete a news source",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "News source ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all tags",
                 "responses": {
                     "200": {
-                        "description": "List of tags with post counts",
+                        "description": "Source deleted",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.TagWithCount"
-                            }
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid news source ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News source not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/tags/popular": {
+        "/admin/news/views": {
             "get": {
-                "description": "Returns the most used tags with post counts (limited to 10)",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current admin's saved filters for the admin news list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tags"
+                    "News"
                 ],
-                "summary": "Get popular tags",
+                "summary": "List saved news views",
                 "responses": {
                     "200": {
-                        "description": "List of popular tags with post counts",
+                        "description": "Saved views",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.TagWithCount"
+                                "$ref": "#/definitions/models.NewsView"
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "models.Comment": {
-            "description": "A comment made by a user on a specific post",
-            "type": "object",
-            "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "Great post!"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
-                },
-                "id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "post": {
-                    "$ref": "#/definitions/models.Post"
-                },
-                "post_id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2023-01-02T12:00:00Z"
-                },
-                "user": {
-                    "$ref": "#/definitions/models.User"
-                },
-                "user_id": {
-                    "type": "integer",
-                    "example": 1
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a named filter for the admin news list. Names are unique per admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Save a news view",
+                "parameters": [
+                    {
+                        "description": "View name and filters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateNewsViewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Saved view",
+                        "schema": {
+                            "$ref": "#/definitions/models.NewsView"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A view with this name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "models.ContentStatus": {
-            "type": "object",
-            "properties": {
+        "/admin/news/views/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes one of the current admin's saved views",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Delete a saved news view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "View ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "View deleted",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Saved view not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/news/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing news article (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Update a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated news article",
+                        "name": "news",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateNewsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated news article",
+                        "schema": {
+                            "$ref": "#/definitions/models.News"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a news article (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Delete a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News article deleted",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerDeleteNewsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/news/{id}/commentary": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a draft blog post that quotes the news article, credits its source and links back to the news item, for the \"link blog\" workflow. The post keeps the article's tags and records it in news_id. Each article can have one commentary post (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Start a commentary post on a news article",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "News article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional title and opening commentary",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateNewsCommentaryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Draft commentary post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The article already has a commentary post",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/news/{id}/enrich": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Scrapes the full content of a news article from its source page right away, replacing any earlier enrichment. Readers otherwise only trigger it when opening the full content. Articles whose content isn't truncated are recorded without fetching the page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Enrich a news article now",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stored enrichment; fetch_error explains a page that couldn't be scraped",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerEnrichedNewsContent"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid news ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - admin role required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/news/{id}/status": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the status of a news article (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Set news article status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetNewsStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated news article",
+                        "schema": {
+                            "$ref": "#/definitions/models.News"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/newsletter/digest": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Emails the posts published in the past days to every confirmed subscriber. Delivery runs in the background; failed deliveries are logged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Send a newsletter digest",
+                "parameters": [
+                    {
+                        "description": "Digest period",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.SendDigestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Digest is being sent",
+                        "schema": {
+                            "$ref": "#/definitions/models.DigestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or no posts in the period",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Email is not configured",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every permission a role can grant (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List permissions",
+                "responses": {
+                    "200": {
+                        "description": "Permissions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/policy.Permission"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Downloads every post with its tags, category, cover URL, author and dates, either as one JSON document or as a zip archive of Markdown files with YAML front matter. The files can be imported with POST /admin/posts/import.",
+                "produces": [
+                    "application/json",
+                    "application/zip"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Export posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "json (default) or markdown",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only export posts with this status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON export, or a zip archive for the markdown format",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostExport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid format or status",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Imports posts from a JSON export, a Markdown file with YAML front matter, or a zip archive of Markdown files. Posts are matched to existing ones by slug and skipped, or overwritten with on_conflict=update. Authors are matched by username and default to the importing admin; categories must already exist and tags are created as needed. Posts that fail validation are reported and the rest are imported. With dry_run=true nothing is saved and the report shows what would happen.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Import posts",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "JSON, Markdown or zip file (max 20MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "What to do with posts whose slug exists: skip (default) or update",
+                        "name": "on_conflict",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import report",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or unreadable file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/posts/import/wordpress": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Imports the posts and pages of a WordPress export (WXR) file, made with Tools \u003e Export in WordPress. Pages are imported as posts tagged \"page\". Authors are matched to local users by email, then username, and created with a random password otherwise. Missing categories are created with their parents, tags are created as needed, and the comments of newly created posts are imported, with visitors' comments attributed to the ghost user. Images hosted on the exported site are copied to storage and their URLs rewritten unless download_images=false. Existing slugs are skipped, or overwritten with on_conflict=update. With dry_run=true nothing is saved or downloaded and the summary shows what would happen.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Import a WordPress export",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "WordPress export XML file (max 20MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report without saving",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "What to do with posts whose slug exists: skip (default) or update",
+                        "name": "on_conflict",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Copy images hosted on the exported site to storage (default true)",
+                        "name": "download_images",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import summary",
+                        "schema": {
+                            "$ref": "#/definitions/models.WXRImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing file or not a WordPress export",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the built-in and custom roles with their permissions and the number of users who have each (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List roles",
+                "responses": {
+                    "200": {
+                        "description": "Roles",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Role"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a custom role granting the given permissions. Users are given it with PUT /admin/users/{id}/role (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Create a role",
+                "parameters": [
+                    {
+                        "description": "Role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created role",
+                        "schema": {
+                            "$ref": "#/definitions/models.Role"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/roles/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes a role's description or replaces its permissions. The admin role's permissions can't be changed. Changes apply to users' existing tokens within a minute (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Change a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated role",
+                        "schema": {
+                            "$ref": "#/definitions/models.Role"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Role is locked",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a custom role. Built-in roles can't be deleted, and users with the role must be given another one first (admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete a role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid role ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Role is built in or still assigned",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every site setting with its current value. Settings that were never changed show their default value.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List site settings",
+                "responses": {
+                    "200": {
+                        "description": "Site settings",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SiteSetting"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/settings/{key}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Validates and stores a new value for a site setting. Changes take effect immediately.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Change a site setting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Setting key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New value",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateSiteSettingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated setting",
+                        "schema": {
+                            "$ref": "#/definitions/models.SiteSetting"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown setting",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sites": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the blogs the deployment serves and the domains they are served on, the default site first (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List sites",
+                "responses": {
+                    "200": {
+                        "description": "Sites",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Site"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a blog served on its own domain. Requests for the domain see only the site's posts, tags, pages, news sources and post templates. Other instances serve the site within a minute (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Add a site",
+                "parameters": [
+                    {
+                        "description": "Site",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateSiteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created site",
+                        "schema": {
+                            "$ref": "#/definitions/models.Site"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Domain already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/sites/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a site, moves it to another domain or makes it the default site, which serves hosts no other site is configured for (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Change a site",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Site ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateSiteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated site",
+                        "schema": {
+                            "$ref": "#/definitions/models.Site"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Site not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Domain already in use or default site unset",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a site without content. Its posts, tags, pages, news sources and post templates must be deleted first, and the default site can't be deleted (admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete a site",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Site ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid site ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Site not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Site is the default or still has content",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns overall counters and time series (posts per month, comments and new users per day, news fetched and saved per day, top tags) for the admin dashboard. Results are cached for 5 minutes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get admin dashboard statistics",
+                "responses": {
+                    "200": {
+                        "description": "Admin dashboard statistics",
+                        "schema": {
+                            "$ref": "#/definitions/models.AdminStats"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tags/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a tag. Posts and news keep the tag, and ?tag= filters use the new name. A name another tag already has is refused; merge the tags instead (admin only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Rename a tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RenameTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Renamed tag",
+                        "schema": {
+                            "$ref": "#/definitions/models.TagWithCount"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a tag that no post or news article uses. Tags in use are refused; merge them into another tag instead (admin only).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete a tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag deleted",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/tags/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves every post and news article tagged with the tag to the tag into_id, then deletes the tag (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Merge a tag into another",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the tag to merge",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag to merge into",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MergeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag that was merged into",
+                        "schema": {
+                            "$ref": "#/definitions/models.TagWithCount"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/purge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently removes users whose deletion can no longer be undone. Their posts, comments and series move to the ghost author; their bookmarks, notifications, API keys, saved views, sessions and newsletter subscription are deleted, and so is the account. Purged users can't be restored.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Purge deleted users",
+                "responses": {
+                    "200": {
+                        "description": "Purged users",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserPurgeResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft-deletes a user and anonymizes, reassigns to a ghost author, or deletes their posts and comments. The deletion can be undone until undo_until.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "What to do with the user's content: anonymize (default), reassign, delete",
+                        "name": "strategy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deletion record",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDeletion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Undoes the most recent deletion of a user, including their posts and comments, while the undo window is still open",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Restore a deleted user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored deletion record",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDeletion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No restorable deletion found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Username or email already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Undo window has expired",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets a user's role to a built-in or custom role from GET /admin/roles. The user's refresh tokens are revoked so the new role applies from their next sign-in; access tokens already issued keep the old role until they expire.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Change a user's role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateUserRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated user",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all registered webhooks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "List of webhooks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Webhook"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a URL to be called on content events. The signing secret is only returned in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Register a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created webhook with its signing secret",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookWithSecret"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates a webhook's URL, description, events, or active flag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook changes",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated webhook",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a webhook and its delivery log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the most recent delivery attempts for a webhook",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of attempts to return (default: 50, max: 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery attempts, newest first",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/{id}/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a single ping event to the webhook and returns the outcome",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Send a test delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery attempt",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/analytics/events": {
+            "post": {
+                "description": "Stores a batch of pageview and read progress events from post pages. Events for unknown or unpublished posts are dropped. The reader ID and the signed-in user are only stored when the reader may be tracked individually: not in privacy mode, without Do Not Track or Global Privacy Control, and when the user didn't opt out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "summary": "Report reader events",
+                "parameters": [
+                    {
+                        "description": "Events, at most 50",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AnalyticsEventsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Events stored",
+                        "schema": {
+                            "$ref": "#/definitions/models.AnalyticsEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Authenticate a user and return JWT tokens. With cookie sessions (AUTH_MODE=cookie) the refresh token is set in an httpOnly cookie, along with a CSRF cookie, instead of being returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login to the application",
+                "parameters": [
+                    {
+                        "description": "Login Credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication failed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invalidate the current user's tokens. With cookie sessions the refresh cookie is revoked and the session cookies are cleared.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout from the application",
+                "parameters": [
+                    {
+                        "description": "Logout options",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.LogoutRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token from the CSRF cookie, required with the refresh cookie",
+                        "name": "X-CSRF-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully logged out",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid CSRF token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Get a new access token using a refresh token. With cookie sessions the refresh cookie is used when present, and the request must carry the CSRF token in the X-CSRF-Token header.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh an access token",
+                "parameters": [
+                    {
+                        "description": "Refresh Token, unless sent in the session cookie",
+                        "name": "refresh_token",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.RefreshTokenRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token from the CSRF cookie, required with the refresh cookie",
+                        "name": "X-CSRF-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token refreshed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid CSRF token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Create a new user account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User Registration Data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User registered successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.SwaggerRegisteredUser"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email or username already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invalidate a refresh token. With cookie sessions the refresh cookie is revoked and cleared when no token is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Revoke a refresh token",
+                "parameters": [
+                    {
+                        "description": "Refresh Token, unless sent in the session cookie",
+                        "name": "refresh_token",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenRevokeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSRF token from the CSRF cookie, required with the refresh cookie",
+                        "name": "X-CSRF-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or token revocation failed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Missing or invalid CSRF token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/capabilities": {
+            "get": {
+                "description": "Returns every endpoint this instance serves with the access it requires, its rate limit and its fixed Cache-Control header, so clients can discover what is available",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "List the API's endpoints",
+                "responses": {
+                    "200": {
+                        "description": "Endpoints",
+                        "schema": {
+                            "$ref": "#/definitions/models.CapabilitiesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Returns all categories ordered by position and name, either as a flat list or as a nested tree",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Categories"
+                ],
+                "summary": "Get post categories",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Return categories nested under their parents",
+                        "name": "tree",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of categories",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Category"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{commentID}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing comment. Users newly mentioned with @username are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Update a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID or UUID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated comment content",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated comment",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a comment from a post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID or UUID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{commentID}/reactions/{type}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds the current user's like or heart to an approved comment and returns the comment's reaction counts. A user can give a comment each kind of reaction once; reacting again succeeds without counting twice.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "React to a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID or UUID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "like",
+                            "heart"
+                        ],
+                        "type": "string",
+                        "description": "Reaction type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reaction counts of the comment",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.CommentReactionCounts"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid comment ID or reaction type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the current user's like or heart from an approved comment and returns the comment's reaction counts. Removing a reaction the user hasn't given succeeds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Remove a reaction from a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID or UUID",
+                        "name": "commentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "like",
+                            "heart"
+                        ],
+                        "type": "string",
+                        "description": "Reaction type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reaction counts of the comment",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.CommentReactionCounts"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid comment ID or reaction type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contact": {
+            "post": {
+                "description": "Stores a contact form message and emails it to the site owner, with the sender as Reply-To. When a captcha provider is configured, captcha_token must be a valid hCaptcha or Turnstile response. The message is kept even if the email can't be sent right away.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Contact"
+                ],
+                "summary": "Send a message to the site owner",
+                "parameters": [
+                    {
+                        "description": "Message",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ContactRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Message received",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or captcha",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Email or captcha verification is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dashboard": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns what the dashboard home screen shows for the current user in one call: the number of drafts, upcoming scheduled posts, recent comments by others on their posts they haven't replied to, the daily views of their most viewed posts over the last 30 days and their unread notifications. Posts they co-author count as theirs. View counts are updated every ANALYTICS_ROLLUP_INTERVAL (default 15m).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard"
+                ],
+                "summary": "Get the author dashboard",
+                "responses": {
+                    "200": {
+                        "description": "Dashboard summary",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthorDashboard"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/files/delete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a file that was previously uploaded for use in the editor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Files"
+                ],
+                "summary": "Delete a file uploaded for editor use",
+                "parameters": [
+                    {
+                        "description": "File URL to delete",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteFileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/files/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a file that can be used in the editor when creating or editing posts",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Files"
+                ],
+                "summary": "Upload a file for editor use",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to upload (JPG, JPEG, PNG, WEBP, GIF, SVG, PDF, max 5MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "File uploaded successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.SwaggerFileUploadResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "get": {
+                "description": "Runs a GraphQL query given in the query string. Mutations are rejected; send them with POST.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "GraphQL query over GET",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GraphQL document",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operation to run when the document has several",
+                        "name": "operationName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Variables as a JSON object",
+                        "name": "variables",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Result, with errors for the fields that failed",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing query or invalid variables",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Mutation sent with GET",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Runs a GraphQL query or mutation over posts, tags, comments, news and the caller's profile. The schema can be fetched by introspection. Resolvers call the matching REST endpoints, so permissions, validation and error codes are the same; errors carry the REST error code in extensions.code. Queries may be sent with GET, mutations only with POST. Authentication is optional, but mutations and the me query need a Bearer token or an API key (GET needs the read scope, POST the write scope).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GraphQL"
+                ],
+                "summary": "GraphQL endpoint",
+                "parameters": [
+                    {
+                        "description": "GraphQL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Result, with errors for the fields that failed",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request body",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.GraphQLResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Provides a simple endpoint to verify the API and database are running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Check API health",
+                "responses": {
+                    "200": {
+                        "description": "API is healthy",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Database connection issues",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/live": {
+            "get": {
+                "description": "Reports that the process is up without checking any dependency, for restarting a hung instance",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "Process is up",
+                        "schema": {
+                            "$ref": "#/definitions/models.LivenessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/ready": {
+            "get": {
+                "description": "Checks that the database is reachable, migrations are applied, the storage and NewsAPI configuration is valid and the background workers are running, and reports the status and latency of each. Responds 503 when any check fails so traffic is held back from the instance.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "Instance is ready",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReadinessReport"
+                        }
+                    },
+                    "503": {
+                        "description": "A dependency is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReadinessReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/home/feed": {
+            "get": {
+                "description": "Returns published posts and news articles mixed into a single feed, ranked by recency, popularity and editorial weight. The ranking strategy and its coefficients are site settings.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Home"
+                ],
+                "summary": "Get the homepage feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of items to return (default: 20, max: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ranked homepage feed",
+                        "schema": {
+                            "$ref": "#/definitions/models.HomeFeedResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/meta": {
+            "get": {
+                "description": "Returns the API version, the checksum of the generated TypeScript client and which optional features this instance has enabled, so frontends can detect capabilities at runtime",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get API version and feature flags",
+                "responses": {
+                    "200": {
+                        "description": "API metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.APIMeta"
+                        }
+                    }
+                }
+            }
+        },
+        "/news": {
+            "get": {
+                "description": "Returns paginated news articles with optional filtering. Pages can be requested by number or, so deep pages stay fast and don't shift when articles are added, by passing the next_cursor of the previous page as cursor.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news articles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in title and content",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by language (en, vi), all for every language; defaults to the language preferred in Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, default is 1, ignored when a cursor is given",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, default is 10, max is 50, both configurable",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias of cursor",
+                        "name": "after",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of news articles with pagination (without content)",
+                        "schema": {
+                            "$ref": "#/definitions/models.NewsWithoutContentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, page size, cursor or language",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/categories": {
+            "get": {
+                "description": "Returns the slugs of the enabled news categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news categories",
+                "responses": {
+                    "200": {
+                        "description": "List of categories",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/slug/{slug}": {
+            "get": {
+                "description": "Returns a specific news article by its slug. A slug the article had before its title changed is answered with 301 and the current slug in the Location header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news article by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News article with content status",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerNewsWithContentStatus"
+                        }
+                    },
+                    "301": {
+                        "description": "News article moved to a new slug",
+                        "schema": {
+                            "$ref": "#/definitions/models.SlugRedirect"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}": {
+            "get": {
+                "description": "Returns a specific news article by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news article by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News article with content status",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerNewsWithContentStatus"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/bookmark": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a published news article to the current user's reading list. Bookmarking an article twice succeeds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Bookmark a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News article bookmarked",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid news ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a news article from the current user's reading list. Removing an article that isn't bookmarked succeeds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Remove a news bookmark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookmark removed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid news ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/full-content": {
+            "get": {
+                "description": "Attempts to fetch and return the full content for a news article",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get full content for news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News article ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News article with full content status",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerNewsWithContentStatus"
+                        }
+                    },
+                    "404": {
+                        "description": "News article not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/newsletter/confirm": {
+            "get": {
+                "description": "Confirms the subscription the link in the confirmation email was sent for",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Newsletter"
+                ],
+                "summary": "Confirm a newsletter subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Confirmation token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Subscription confirmed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link is invalid or expired",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/newsletter/subscribe": {
+            "post": {
+                "description": "Emails a confirmation link to the address; it receives digests once the link is followed. The response is the same whether or not the address is already subscribed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Newsletter"
+                ],
+                "summary": "Subscribe to the newsletter",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SubscribeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Confirmation email sent",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Email is not configured",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/newsletter/unsubscribe": {
+            "get": {
+                "description": "Stops digests to the subscriber the link in a digest was sent to. Accepts POST as well for one-click unsubscribe from mail clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Newsletter"
+                ],
+                "summary": "Unsubscribe from the newsletter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unsubscribe token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unsubscribed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Stops digests to the subscriber the link in a digest was sent to. Accepts POST as well for one-click unsubscribe from mail clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Newsletter"
+                ],
+                "summary": "Unsubscribe from the newsletter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unsubscribe token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unsubscribed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current user's notifications, newest first: comments on their posts, replies to their comments and status changes an admin made to their posts. The meta includes the number of unread notifications.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only return unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 20, max: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notifications with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerNotificationsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/email/unsubscribe": {
+            "get": {
+                "description": "Turns off the emails about comments on the user's posts and replies to their comments that the link was sent in. They can be turned back on with comment_emails in PUT /profile. Accepts POST as well for one-click unsubscribe from mail clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Stop comment emails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unsubscribe token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unsubscribed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Turns off the emails about comments on the user's posts and replies to their comments that the link was sent in. They can be turned back on with comment_emails in PUT /profile. Accepts POST as well for one-click unsubscribe from mail clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Stop comment emails",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unsubscribe token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unsubscribed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Link is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks one of the current user's notifications as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification marked as read",
+                        "schema": {
+                            "$ref": "#/definitions/models.Notification"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid notification ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages": {
+            "get": {
+                "description": "Returns the site's static pages ordered by title. Editors and admins also get drafts; everyone else only sees published pages.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Get static pages",
+                "responses": {
+                    "200": {
+                        "description": "List of pages",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Page"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a static page. Only editors and admins can manage pages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Create a static page",
+                "parameters": [
+                    {
+                        "description": "Page details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created page",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/{slug}": {
+            "get": {
+                "description": "Returns a published static page, such as about or contact, with its Markdown content. Editors and admins can also read drafts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Get a static page by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates a static page's title, slug, content or status. Only editors and admins can manage pages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Update a static page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Page changes",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated page",
+                        "schema": {
+                            "$ref": "#/definitions/models.Page"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a static page. Only editors and admins can manage pages.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pages"
+                ],
+                "summary": "Delete a static page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/post-templates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the site's post templates ordered by name, for users who can write posts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "List post templates",
+                "responses": {
+                    "200": {
+                        "description": "Post templates",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PostTemplate"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a template that pre-fills new posts created with its template_id. Only editors and admins can manage templates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Create a post template",
+                "parameters": [
+                    {
+                        "description": "Template details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePostTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created template",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/post-templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a post template, for users who can write posts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get a post template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post template",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes a post template; posts created from it before are left alone. Only editors and admins can manage templates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Update a post template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template changes",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePostTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated template",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a post template; posts created from it are kept. Only editors and admins can manage templates.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Delete a post template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts": {
+            "get": {
+                "description": "Returns a paginated list of blog posts with optional tag and status filtering. Pages can be requested by number or, so deep pages stay fast and don't shift when posts are added, by passing the next_cursor of the previous page as cursor.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get list of blog posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1), ignored when a cursor is given",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10, max: 50, both configurable)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor from the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias of cursor",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter posts by tag name",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter posts by status (draft, published, archived, scheduled). Only honoured for roles that moderate posts; everyone else gets published posts.",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter posts by category slug, including its subcategories",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter posts by language (en, vi), all for every language; defaults to the language preferred in Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of posts with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, page size, cursor or language",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new blog post with the provided details. With template_id, the fields left empty are filled from the post template and the title comes from its title pattern. Accounts that aren't established yet have a daily post quota. Titles, excerpts, content and tags over the configured post limits are rejected with the offending fields in the error details. A post published during a content freeze is saved as scheduled for the end of the freeze, and the response adds \"queued\": true and frozen_until.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Create a new blog post",
+                "parameters": [
+                    {
+                        "description": "Post details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A translation in this language already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Daily post limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of blog posts the currently authenticated user owns or is a co-author of",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get the current user's blog posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10, max: 50, both configurable)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of the user's posts with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerPostsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page or page size",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/preview/{token}": {
+            "get": {
+                "description": "Returns a post, whatever its status, for a valid preview token. No authentication is needed; the token is the credential.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Preview an unpublished post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Preview token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "404": {
+                        "description": "Preview link is invalid, expired or revoked",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/slug/{slug}": {
+            "get": {
+                "description": "Returns a single blog post by its slug. Unpublished posts are only returned to those who may edit them; share them with others through a preview link. Posts in a series include their position in it and links to the previous and next posts, and posts with published translations list them under translations. A slug the post had before its title changed is answered with 301 and the current slug in the Location header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get a blog post by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "301": {
+                        "description": "Post moved to a new slug",
+                        "schema": {
+                            "$ref": "#/definitions/models.SlugRedirect"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates a blog post with the provided details. Fields over the configured post limits are rejected with the offending fields in the error details. To keep two editors from overwriting each other, send the version of the post the edits were made to, or its Last-Modified time in If-Unmodified-Since; if the post was updated since, the update is rejected with 409 and the current post in details.current. Changing the status needs the same permission as the status endpoint, so co-authors whose role can't publish get 403.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Update an existing blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post details",
+                        "name": "post",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdatePostRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only update the post if it hasn't changed since this HTTP date",
+                        "name": "If-Unmodified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "202": {
+                        "description": "Post saved, publish queued by a content freeze",
+                        "schema": {
+                            "$ref": "#/definitions/models.QueuedPost"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The post was updated since the given version or date, or a translation in this language already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a blog post by ID (soft delete)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Delete a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/analytics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the views, unique readers and read-through rates of a post, in total and per day. Counters are updated every ANALYTICS_ROLLUP_INTERVAL (default 15m). Only the post's authors and users whose role grants post.analytics can see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get a post's reader analytics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of days to cover, today included (default: 30, max: 365)",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post analytics",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostAnalytics"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/authors": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Credits another user on a post. Authors may edit and publish the post, contributors may only edit it and reviewers may only publish it. Adding a user who is already a co-author changes their role. Only the post's owner can add co-authors.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Add a co-author to a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Co-author",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddPostAuthorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Co-authors of the post",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PostAuthor"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/authors/{username}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a co-author from a post. The post's owner can remove anyone; a co-author can remove themselves.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Remove a co-author from a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username of the co-author",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Co-authors of the post",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PostAuthor"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid post ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post, user or co-author not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/autosave": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current user's most recent draft snapshot of a post, to offer restoring it when the editor opens. conflict is true when the post was updated since the snapshot's edits started.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get the latest autosave of a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Latest snapshot",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostAutosaveResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid post ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post or snapshot not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Autosave is turned off",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a draft snapshot of the title, excerpt and content being edited, apart from the post itself, so nothing readers see changes. Each user keeps their last AUTOSAVE_MAX_SNAPSHOTS (default 20) snapshots of a post, and a save within AUTOSAVE_MIN_INTERVAL (default 30s) of the previous one replaces it. conflict is true when the post was updated since base_updated_at, the updated_at the editor loaded; the snapshot is saved either way.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Autosave a post being edited",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Edits to save",
+                        "name": "autosave",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AutosavePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved snapshot",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostAutosaveResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Autosave is turned off",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/bookmark": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a published post to the current user's bookmarks to read later. Bookmarking a post twice succeeds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Bookmark a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Post bookmarked",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid post ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a post from the current user's bookmarks. Removing a post that isn't bookmarked succeeds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Remove a bookmark",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookmark removed",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid post ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/comments": {
+            "get": {
+                "description": "Returns the approved comments for a specific post with their reaction counts, newest first or, with sort=top, those with the most reactions first. Comments held for moderation are not included. All comments are returned unless page or limit is given; a page of comments carries the number of approved comments in the X-Total-Count header.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get comments for a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10, max: 50, both configurable)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "top"
+                        ],
+                        "type": "string",
+                        "description": "Order of the comments: newest (default) or top",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of comments",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Comment"
+                            }
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "Number of approved comments, for a page of comments"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input, page, page size or sort",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a new comment to a post. Accounts must wait a short cooldown between comments and new accounts have a daily comment quota. Comments the spam checks flag, such as those with many links, links from new accounts or a filled-in honeypot field, are held for moderation (returned with status \"pending\"). Set parent_id to reply to another comment on the post; the post's author and the author of the comment replied to are notified. Users mentioned with @username are notified too and listed in mentions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Create a new comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment content",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created comment",
+                        "schema": {
+                            "$ref": "#/definitions/models.Comment"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Daily comment limit reached or comment cooldown",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/cover": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a new cover image for a post",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Upload post cover image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Cover image file (JPG, JPEG, PNG, WEBP, max 5MB)",
+                        "name": "cover",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cover uploaded successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.SwaggerPostCoverResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the cover image from a post",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Delete post cover image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cover deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/cross-posts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns where a published post was shared on social networks and the state of each delivery. Only users who can edit the post can see them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get a post's cross-posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cross-posts of the post",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CrossPost"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/cross-posts/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues the cross-posts of a post that failed every attempt again, with a fresh set of attempts. Only users who can edit the post can retry them.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Retry a post's failed cross-posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Retried cross-posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.CrossPostRetryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/link-suggestions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Suggests published posts that a post, typically a draft, could link to. Posts are ranked by the tags they share with it and by how many distinctive words they have in common; the shared words are returned as anchor text ideas. Posts it already links to are left out. Only users who can edit the post can ask.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Suggest internal links for a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of suggestions (default: 5, max: 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suggested posts, best first",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.LinkSuggestion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/preview-token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates a signed, expiring link that lets anyone with it read the post before it is published, without logging in. Links expire after JWT_PREVIEW_EXPIRY (default 72h).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Create a preview link for an unpublished post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Preview link",
+                        "schema": {
+                            "$ref": "#/definitions/models.PreviewTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Post is already published",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invalidates every preview link created for the post so far",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Revoke preview links for a post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Preview links revoked",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/progress": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves how far the current user got in a published post, as a percentage and the anchor of the last paragraph read, so they can resume on another device. Each post keeps only the latest progress.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Progress"
+                ],
+                "summary": "Save reading progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reading position",
+                        "name": "progress",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SaveReadingProgressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved progress",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReadingProgress"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets a blog post's status to published",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Publish a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Published post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "202": {
+                        "description": "Post saved, publish queued by a content freeze",
+                        "schema": {
+                            "$ref": "#/definitions/models.QueuedPost"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/status": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates a post's status to the specified value (draft, published, archived, scheduled). When an admin changes the status of someone else's post, its owner is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Set the status of a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetPostStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "202": {
+                        "description": "Post saved, publish queued by a content freeze",
+                        "schema": {
+                            "$ref": "#/definitions/models.QueuedPost"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{id}/unpublish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets a blog post's status to unpublished (draft). When an admin unpublishes someone else's post, its owner is notified.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Unpublish a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Unpublished post",
+                        "schema": {
+                            "$ref": "#/definitions/models.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve the current user's profile information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "User profile",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.SwaggerProfileResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the current user's profile information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "description": "Profile Data",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.SwaggerProfileResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the current user's account after checking their password. The profile is anonymized, so posts and comments stay up under \"Deleted User\", and every session is signed out. An admin can restore the account until undo_until; after that it is purged for good. Admins must hand over their role before deleting their account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete your account",
+                "parameters": [
+                    {
+                        "description": "Password confirmation",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deletion record",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDeletion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Incorrect password",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Admins can't delete their account",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current user's API keys, newest first, including revoked ones. The keys themselves are not included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profile"
+                ],
+                "summary": "List your API keys",
+                "responses": {
+                    "200": {
+                        "description": "API keys",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.APIKey"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Requested with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an API key that acts as the current user when sent in the X-API-Key header. The read scope allows GET requests and the write scope all others. The key is only returned in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profile"
+                ],
+                "summary": "Create an API key",
+                "parameters": [
+                    {
+                        "description": "Key name, scopes and expiry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created key",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Requested with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes one of the current user's API keys. Requests made with it fail from then on.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profile"
+                ],
+                "summary": "Revoke an API key",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "API key revoked",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Requested with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "API key not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/avatar": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a new avatar image for the current user",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Upload user avatar",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Avatar image file (JPG, JPEG, PNG, max 2MB)",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Avatar uploaded successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.SwaggerStandardResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.SwaggerAvatarResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/bookmarks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current user's bookmarks, most recently saved first. Posts that were unpublished or deleted since are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Get bookmarked posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10, max: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bookmarks with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerBookmarksResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Downloads everything the blog stores about the current user: profile, posts, comments, post and news bookmarks and series, either as one JSON document or as a zip archive with a JSON file for each",
+                "produces": [
+                    "application/json",
+                    "application/zip"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Export your data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "json (default) or zip",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JSON export, or a zip archive for the zip format",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDataExport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current user's reading progress in published posts, most recently read first, so a reader can resume on another device. Finished posts are left out unless finished=true.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Progress"
+                ],
+                "summary": "Get reading progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: PAGINATION_DEFAULT_LIMIT, max: PAGINATION_MAX_LIMIT)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include posts read to the end",
+                        "name": "finished",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reading progress with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerReadingProgressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page or page size",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/reading-list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current user's bookmarked posts and news articles together, most recently saved first. Items that were unpublished or deleted since are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bookmarks"
+                ],
+                "summary": "Get the reading list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10, max: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reading list with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerReadingListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the devices the current user is signed in on, most recent sign-in first, with the user agent and IP address captured at sign-in. The session making the request is marked as current.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List your sessions",
+                "responses": {
+                    "200": {
+                        "description": "Active sessions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Session"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/sessions/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes one of the current user's sessions, so the device can no longer refresh its access token. An access token it already holds keeps working until it expires.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Sign out a session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Session revoked",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid session ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Searches published posts, published news articles and tags in one call and returns the best matches of each type with a relevance score and a snippet around the matched words. The query supports quoted phrases, OR and -word to exclude a word. New content is searchable once the search index is next refreshed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search posts, news and tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated result types to include: posts, news, tags (default: all)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results per type (default: 5, max: 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Results grouped by type",
+                        "schema": {
+                            "$ref": "#/definitions/models.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing query or invalid type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series": {
+            "get": {
+                "description": "Returns every series ordered by title, with the number of published posts in each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get post series",
+                "responses": {
+                    "200": {
+                        "description": "List of series",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Series"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a series owned by the current user. Add posts to it with POST /series/{id}/posts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Create a series",
+                "parameters": [
+                    {
+                        "description": "Series details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateSeriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created series",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the title, slug or description of a series. Only its owner or an admin can update it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Update a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Series changes",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateSeriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated series",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a series. Its posts are kept and no longer belong to a series. Only its owner or an admin can delete it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Delete a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerStandardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{id}/posts": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a post to a series at a position, moving the posts from that position on down by one. A post belongs to at most one series, so adding it moves it out of any other series; adding a post already in the series moves it to the new position. Only the series owner's posts can be added, by the owner or an admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Add a post to a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Post and position",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddSeriesPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series with its published posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series or post not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{id}/posts/{post_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a post from a series, moving the posts after it up by one. The post itself is kept. Only the series owner or an admin can remove posts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Remove a post from a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID or UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID or UUID",
+                        "name": "post_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series with its published posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found or post not in the series",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{slug}": {
+            "get": {
+                "description": "Returns a series with its published posts in series order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get a series by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series with its posts",
+                        "schema": {
+                            "$ref": "#/definitions/models.Series"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/public": {
+            "get": {
+                "description": "Returns non-sensitive counters (posts, comments, views, years blogging) for public widgets. Results are cached for 10 minutes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get public site statistics",
+                "responses": {
+                    "200": {
+                        "description": "Public site statistics",
+                        "schema": {
+                            "$ref": "#/definitions/models.PublicStats"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Returns all tags with their post counts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get all tags",
+                "responses": {
+                    "200": {
+                        "description": "List of tags with post counts",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TagWithCount"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/popular": {
+            "get": {
+                "description": "Returns the most used tags with post counts (limited to 10)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get popular tags",
+                "responses": {
+                    "200": {
+                        "description": "List of popular tags with post counts",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.TagWithCount"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}": {
+            "get": {
+                "description": "Returns the public part of a user's profile (name, bio, avatar, number of published posts) for author pages. No authentication is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get an author's public profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Public profile",
+                        "schema": {
+                            "$ref": "#/definitions/models.PublicProfile"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/posts": {
+            "get": {
+                "description": "Returns a paginated list of a user's published posts, newest first. No authentication is needed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get an author's published posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default: 10, max: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of posts with pagination metadata",
+                        "schema": {
+                            "$ref": "#/definitions/models.SwaggerPostsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Returns the commit and build time of the instance serving the request, and whether it is a canary",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get API build information",
+                "responses": {
+                    "200": {
+                        "description": "Build information",
+                        "schema": {
+                            "$ref": "#/definitions/models.VersionInfo"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.APIKey": {
+            "description": "An API key for programmatic access. The key itself is only shown when it is created.",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Static site build"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "tpv_3f2a9c1d"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIKeyScope"
+                    },
+                    "example": [
+                        "read"
+                    ]
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.APIKeyScope": {
+            "type": "string",
+            "enum": [
+                "read",
+                "write"
+            ],
+            "x-enum-varnames": [
+                "APIKeyScopeRead",
+                "APIKeyScopeWrite"
+            ]
+        },
+        "models.APIMeta": {
+            "description": "API version, generated client checksum and feature flags",
+            "type": "object",
+            "properties": {
+                "api_version": {
+                    "type": "string",
+                    "example": "1.0"
+                },
+                "client_checksum": {
+                    "type": "string",
+                    "example": "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "features": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "git_sha": {
+                    "type": "string",
+                    "example": "3f2a9c1d8e4b"
+                }
+            }
+        },
+        "models.AddPostAuthorRequest": {
+            "description": "Request model for adding a co-author to a post",
+            "type": "object",
+            "required": [
+                "role",
+                "username"
+            ],
+            "properties": {
+                "role": {
+                    "enum": [
+                        "author",
+                        "contributor",
+                        "reviewer"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostAuthorRole"
+                        }
+                    ],
+                    "example": "contributor"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "janedoe"
+                }
+            }
+        },
+        "models.AddSeriesPostRequest": {
+            "description": "Request model for adding a post to a series or moving it within one",
+            "type": "object",
+            "required": [
+                "post_id"
+            ],
+            "properties": {
+                "position": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                },
+                "post_id": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                }
+            }
+        },
+        "models.AdminNewsFilter": {
+            "description": "Filters for the admin news list",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsCategory"
+                        }
+                    ],
+                    "example": "technology"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "has_image": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "search": {
+                    "type": "string",
+                    "example": "quantum"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "TechCrunch"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "tag": {
+                    "type": "string",
+                    "example": "ai"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2023-01-31T23:59:59Z"
+                },
+                "truncated": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.AdminStats": {
+            "description": "Aggregate counts and time series for the admin dashboard",
+            "type": "object",
+            "properties": {
+                "comments_per_day": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatsPoint"
+                    }
+                },
+                "generated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "new_users_per_day": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatsPoint"
+                    }
+                },
+                "news_per_day": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngestionStatsPoint"
+                    }
+                },
+                "posts_per_month": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatsPoint"
+                    }
+                },
+                "top_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TagWithCount"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/models.AdminStatsTotals"
+                }
+            }
+        },
+        "models.AdminStatsTotals": {
+            "description": "Overall counters for the admin dashboard",
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "integer",
+                    "example": 310
+                },
+                "comments_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "news": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "post_views": {
+                    "type": "integer",
+                    "example": 15230
+                },
+                "posts": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "posts_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "tags": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "users": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "models.AnalyticsEventInput": {
+            "description": "A reader event on a post page",
+            "type": "object",
+            "required": [
+                "post_id",
+                "type",
+                "view_id"
+            ],
+            "properties": {
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "progress": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 50
+                },
+                "reader_id": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "b1e8d0c2-6a3f-4f5e-8d7c-9a2b1c0d3e4f"
+                },
+                "type": {
+                    "enum": [
+                        "pageview",
+                        "progress"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.AnalyticsEventType"
+                        }
+                    ],
+                    "example": "progress"
+                },
+                "view_id": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "4f9c2a7e-0d5b-4c1e-9a0f-3b2d8e6c1a57"
+                }
+            }
+        },
+        "models.AnalyticsEventType": {
+            "type": "string",
+            "enum": [
+                "pageview",
+                "progress"
+            ],
+            "x-enum-varnames": [
+                "AnalyticsEventPageview",
+                "AnalyticsEventProgress"
+            ]
+        },
+        "models.AnalyticsEventsRequest": {
+            "description": "Request model for reporting a batch of reader events",
+            "type": "object",
+            "required": [
+                "events"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.AnalyticsEventInput"
+                    }
+                }
+            }
+        },
+        "models.AnalyticsEventsResponse": {
+            "description": "Number of reported events that were stored",
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.AuditLog": {
+            "description": "An entry in the audit log",
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "news.status_changed"
+                },
+                "actor_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "actor_role": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "after": {},
+                "before": {},
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "resource_id": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "resource_type": {
+                    "type": "string",
+                    "example": "news"
+                }
+            }
+        },
+        "models.AuditLogListResponse": {
+            "description": "Response model for listing audit log entries",
+            "type": "object",
+            "properties": {
+                "logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditLog"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "per_page": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.AuthorDashboard": {
+            "description": "Summary of the current user's posts, comments and notifications for the dashboard home screen",
+            "type": "object",
+            "properties": {
+                "awaiting_reply": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "draft_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "generated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Notification"
+                    }
+                },
+                "scheduled_posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                },
+                "top_posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DashboardPostTrend"
+                    }
+                },
+                "unread_notifications": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "models.AutosavePostRequest": {
+            "description": "Request model for saving a draft snapshot of a post being edited",
+            "type": "object",
+            "required": [
+                "base_updated_at"
+            ],
+            "properties": {
+                "base_updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Go is a statically typed language..."
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "Learn the basics of Go"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Getting Started with Go"
+                }
+            }
+        },
+        "models.Bookmark": {
+            "description": "A post saved to read later",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "post": {
+                    "$ref": "#/definitions/models.Post"
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.BrokenLink": {
+            "description": "Broken outbound link and the content it is in",
+            "type": "object",
+            "properties": {
+                "broken": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "broken_at": {
+                    "type": "string",
+                    "example": "2023-01-01T03:00:00Z"
+                },
+                "checked_at": {
+                    "type": "string",
+                    "example": "2023-01-01T03:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "example.com"
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source_slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "source_title": {
+                    "type": "string",
+                    "example": "My First Blog Post"
+                },
+                "source_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ContentLinkSource"
+                        }
+                    ],
+                    "example": "post"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T03:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/gone"
+                }
+            }
+        },
+        "models.BulkNewsDeleteRequest": {
+            "description": "Request model for deleting news articles in bulk",
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3
+                    ]
+                }
+            }
+        },
+        "models.BulkNewsStatusRequest": {
+            "description": "Request model for changing the status of news articles in bulk",
+            "type": "object",
+            "required": [
+                "ids",
+                "status"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3
+                    ]
+                },
+                "status": {
+                    "enum": [
+                        "published",
+                        "draft",
+                        "archived"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsStatus"
+                        }
+                    ],
+                    "example": "archived"
+                }
+            }
+        },
+        "models.CapabilitiesResponse": {
+            "description": "Endpoints served by this API instance",
+            "type": "object",
+            "properties": {
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RouteCapability"
+                    }
+                }
+            }
+        },
+        "models.Category": {
+            "description": "A post category that can be nested under a parent category",
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Category"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Posts about server-side development"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Backend"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "backend"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                }
+            }
+        },
+        "models.Comment": {
+            "description": "A comment made by a user on a specific post",
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Great post!"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "flag_reason": {
+                    "type": "string",
+                    "example": "too_many_links"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommentMention"
+                    }
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "post": {
+                    "$ref": "#/definitions/models.Post"
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reactions": {
+                    "$ref": "#/definitions/models.CommentReactionCounts"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CommentStatus"
+                        }
+                    ],
+                    "example": "approved"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "9a7e1c2d-4b3f-4e5a-8c6d-1f2e3a4b5c6d"
+                }
+            }
+        },
+        "models.CommentEmails": {
+            "type": "string",
+            "enum": [
+                "immediate",
+                "daily",
+                "off"
+            ],
+            "x-enum-varnames": [
+                "CommentEmailsImmediate",
+                "CommentEmailsDaily",
+                "CommentEmailsOff"
+            ]
+        },
+        "models.CommentMention": {
+            "description": "A user mentioned in a comment",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.CommentReaction": {
+            "description": "A user's like or heart on a comment",
+            "type": "object",
+            "properties": {
+                "comment_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CommentReactionType"
+                        }
+                    ],
+                    "example": "like"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.CommentReactionCounts": {
+            "description": "Number of reactions to a comment of each type",
+            "type": "object",
+            "properties": {
+                "heart": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "like": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "models.CommentReactionType": {
+            "type": "string",
+            "enum": [
+                "like",
+                "heart"
+            ],
+            "x-enum-varnames": [
+                "CommentReactionLike",
+                "CommentReactionHeart"
+            ]
+        },
+        "models.CommentStatus": {
+            "type": "string",
+            "enum": [
+                "approved",
+                "pending"
+            ],
+            "x-enum-varnames": [
+                "CommentStatusApproved",
+                "CommentStatusPending"
+            ]
+        },
+        "models.ContactRequest": {
+            "description": "Request model for sending a message to the site owner",
+            "type": "object",
+            "required": [
+                "email",
+                "message",
+                "name"
+            ],
+            "properties": {
+                "captcha_token": {
+                    "type": "string",
+                    "example": "10000000-aaaa-bbbb-cccc-000000000001"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "jane@example.com"
+                },
+                "message": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "minLength": 10,
+                    "example": "I enjoyed your post on Go generics."
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Jane Reader"
+                },
+                "website": {
+                    "description": "Website is a honeypot: clients render it as a hidden field and leave it\nempty, so a value means the form was filled in by a bot",
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "models.ContentLinkSource": {
+            "type": "string",
+            "enum": [
+                "post",
+                "news"
+            ],
+            "x-enum-varnames": [
+                "ContentLinkSourcePost",
+                "ContentLinkSourceNews"
+            ]
+        },
+        "models.ContentStatus": {
+            "type": "object",
+            "properties": {
+                "byline": {
+                    "type": "string",
+                    "example": "Jane Smith"
+                },
+                "fetch_error": {
+                    "type": "string"
+                },
+                "has_full_content": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "is_truncated": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "lead_image": {
+                    "type": "string",
+                    "example": "https://news.com/images/lead.jpg"
+                },
+                "truncated_chars": {
+                    "type": "integer",
+                    "example": 1281
+                }
+            }
+        },
+        "models.CreateAPIKeyRequest": {
+            "description": "Request model for creating an API key",
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_in_days": {
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 1,
+                    "example": 90
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Static site build"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.APIKeyScope"
+                    },
+                    "example": [
+                        "read"
+                    ]
+                }
+            }
+        },
+        "models.CreateAPIKeyResponse": {
+            "description": "A newly created API key, including the key itself, which is not shown again",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "key": {
+                    "type": "string",
+                    "example": "tpv_3f2a9c1d8e4b7a6f5e4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Static site build"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "tpv_3f2a9c1d"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.APIKeyScope"
+                    },
+                    "example": [
+                        "read"
+                    ]
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.CreateCategoryRequest": {
+            "description": "Request model for creating a post category",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Posts about server-side development"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Backend"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "example": "backend"
+                }
+            }
+        },
+        "models.CreateCommentRequest": {
+            "description": "Request model for creating a new comment on a post",
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "This is a great post!"
+                },
+                "parent_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "website": {
+                    "description": "Website is a honeypot: clients render it as a hidden field and leave it\nempty, so a value means the form was filled in by a bot",
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "models.CreateFreezeWindowRequest": {
+            "description": "Request model for creating a content freeze window",
+            "type": "object",
+            "required": [
+                "ends_at",
+                "reason",
+                "starts_at"
+            ],
+            "properties": {
+                "ends_at": {
+                    "type": "string",
+                    "example": "2023-01-02T02:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Database migration"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2023-01-01T22:00:00Z"
+                }
+            }
+        },
+        "models.CreateNewsCategoryRequest": {
+            "description": "Request model for creating a news category",
+            "type": "object",
+            "required": [
+                "name",
+                "slug"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "blockchain",
+                        "crypto",
+                        "bitcoin"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Blockchain"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "blockchain"
+                }
+            }
+        },
+        "models.CreateNewsCommentaryRequest": {
+            "description": "Request model for creating a commentary post from a news article",
+            "type": "object",
+            "properties": {
+                "commentary": {
+                    "type": "string",
+                    "example": "Here's my take on this..."
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Why quantum advantage matters"
+                }
+            }
+        },
+        "models.CreateNewsRequest": {
+            "description": "Request model for creating a news article",
+            "type": "object",
+            "required": [
+                "content",
+                "source",
+                "title"
+            ],
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsCategory"
+                        }
+                    ],
+                    "example": "technology"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Scientists announced a major breakthrough in quantum computing..."
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg"
+                },
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "en"
+                },
+                "publish_date": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "TechNews"
+                },
+                "source_url": {
+                    "type": "string",
+                    "example": "https://technews.com/article/12345"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "A brief summary of the quantum computing breakthrough"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "['technology'",
+                        " 'quantum computing']"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Major Technology Breakthrough Announced"
+                }
+            }
+        },
+        "models.CreateNewsSourceRequest": {
+            "description": "Request model for adding an RSS feed",
+            "type": "object",
+            "required": [
+                "name",
+                "url"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "technology"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "fetch_interval_minutes": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 60
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "TechCrunch"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://techcrunch.com/feed/"
+                }
+            }
+        },
+        "models.CreateNewsViewRequest": {
+            "description": "Request model for saving a filter for the admin news list",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/models.AdminNewsFilter"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Truncated drafts"
+                }
+            }
+        },
+        "models.CreatePageRequest": {
+            "description": "Request model for creating a static page",
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "# About me\n\nI write about Go and the web."
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "example": "about"
+                },
+                "status": {
+                    "enum": [
+                        "draft",
+                        "published"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PageStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "About"
+                }
+            }
+        },
+        "models.CreatePostRequest": {
+            "description": "Request model for creating a new blog post",
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "content": {
+                    "type": "string",
+                    "example": "This is the content of my new post"
+                },
+                "cover": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "A short excerpt"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-02-01T00:00:00Z"
+                },
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "en"
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "skip_cross_post": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"technology\"",
+                        "\"programming\"]"
+                    ]
+                },
+                "template_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My New Post"
+                },
+                "translation_of": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.CreatePostTemplateRequest": {
+            "description": "Request model for creating a post template",
+            "type": "object",
+            "required": [
+                "name",
+                "title_pattern"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "content": {
+                    "type": "string",
+                    "example": "## Articles\n\n- \n\n## Tools\n\n- "
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Links worth reading from the past week"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "The best links of the week"
+                },
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "en"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Weekly links"
+                },
+                "status": {
+                    "enum": [
+                        "draft",
+                        "published"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"links\"",
+                        "\"weekly\"]"
+                    ]
+                },
+                "title_pattern": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Weekly links: week {week}, {year}"
+                }
+            }
+        },
+        "models.CreateRoleRequest": {
+            "description": "Request model for creating a custom role",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Keeps the comments civil"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 2,
+                    "example": "moderator"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "comment.edit",
+                        "comment.delete"
+                    ]
+                }
+            }
+        },
+        "models.CreateSeriesRequest": {
+            "description": "Request model for creating a post series",
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "A step-by-step tutorial from an empty repository to a deployed API"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "building-a-blog-in-go"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Building a Blog in Go"
+                }
+            }
+        },
+        "models.CreateSiteRequest": {
+            "description": "Request model for adding a site",
+            "type": "object",
+            "required": [
+                "domain",
+                "name"
+            ],
+            "properties": {
+                "domain": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "travel.example.com"
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Travel notes"
+                }
+            }
+        },
+        "models.CreateWebhookRequest": {
+            "description": "Request model for registering a webhook",
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Next.js ISR revalidation"
+                },
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "post.published",
+                        "post.updated"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/api/revalidate"
+                }
+            }
+        },
+        "models.CrossPost": {
+            "description": "Delivery of a published post to a social network",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "external_id": {
+                    "type": "string",
+                    "example": "1234"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "next_attempt_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:05:00Z"
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sent_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:05Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CrossPostStatus"
+                        }
+                    ],
+                    "example": "sent"
+                },
+                "target": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CrossPostTarget"
+                        }
+                    ],
+                    "example": "telegram"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:05Z"
+                }
+            }
+        },
+        "models.CrossPostRetryResponse": {
+            "description": "Result of retrying a post's failed cross-posts",
+            "type": "object",
+            "properties": {
+                "cross_posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CrossPost"
+                    }
+                },
+                "retried": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.CrossPostStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "sent",
+                "failed",
+                "canceled"
+            ],
+            "x-enum-varnames": [
+                "CrossPostPending",
+                "CrossPostSent",
+                "CrossPostFailed",
+                "CrossPostCanceled"
+            ]
+        },
+        "models.CrossPostTarget": {
+            "type": "string",
+            "enum": [
+                "x",
+                "telegram",
+                "linkedin"
+            ],
+            "x-enum-varnames": [
+                "CrossPostX",
+                "CrossPostTelegram",
+                "CrossPostLinkedIn"
+            ]
+        },
+        "models.DashboardPostTrend": {
+            "description": "Views of a post per day over the last 30 days",
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatsPoint"
+                    }
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "getting-started-with-go"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Getting Started with Go"
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 200
+                }
+            }
+        },
+        "models.DeleteAccountRequest": {
+            "description": "Request model for deleting the current user's account",
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "example": "password123"
+                }
+            }
+        },
+        "models.DeleteFileRequest": {
+            "description": "Request model for deleting a file",
+            "type": "object",
+            "required": [
+                "file_url"
+            ],
+            "properties": {
+                "file_url": {
+                    "type": "string",
+                    "example": "https://example.com/file.jpg"
+                }
+            }
+        },
+        "models.DiagnosticCheck": {
+            "description": "The result of a single diagnostic check",
+            "type": "object",
+            "properties": {
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 183
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Credentials accepted"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "cloudinary"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DiagnosticStatus"
+                        }
+                    ],
+                    "example": "pass"
+                }
+            }
+        },
+        "models.DiagnosticStatus": {
+            "type": "string",
+            "enum": [
+                "pass",
+                "warn",
+                "fail"
+            ],
+            "x-enum-varnames": [
+                "DiagnosticPass",
+                "DiagnosticWarn",
+                "DiagnosticFail"
+            ]
+        },
+        "models.DiagnosticsReport": {
+            "description": "Report of the checks run against the application's dependencies",
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DiagnosticCheck"
+                    }
+                },
+                "generated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DiagnosticStatus"
+                        }
+                    ],
+                    "example": "warn"
+                }
+            }
+        },
+        "models.DigestResponse": {
+            "description": "A digest queued for delivery",
+            "type": "object",
+            "properties": {
+                "posts": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "recipients": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "since": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "models.EditorialPick": {
+            "description": "An editorial boost for a post or news article",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "item_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "item_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FeedItemType"
+                        }
+                    ],
+                    "example": "news"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "weight": {
+                    "type": "number",
+                    "example": 0.8
+                }
+            }
+        },
+        "models.EnrichNewsBatchRequest": {
+            "description": "Request model for enriching news articles in a batch",
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        2,
+                        3
+                    ]
+                },
+                "limit": {
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1,
+                    "example": 10
+                },
+                "retry_failed": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "description": "An error response",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "post_not_found"
+                },
+                "details": {},
+                "error": {
+                    "type": "string",
+                    "example": "Post not found"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Post not found"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "error"
+                }
+            }
+        },
+        "models.FeedItem": {
+            "description": "A post or news article in the homepage feed",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "backend"
+                },
+                "editorial_weight": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg"
+                },
+                "published_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "reading_time_minutes": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "score": {
+                    "type": "number",
+                    "example": 1.42
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "TechNews"
+                },
+                "source_url": {
+                    "type": "string",
+                    "example": "https://technews.com/article/12345"
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "A short summary of the post"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My First Blog Post"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FeedItemType"
+                        }
+                    ],
+                    "example": "post"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "view_count": {
+                    "type": "integer",
+                    "example": 128
+                }
+            }
+        },
+        "models.FeedItemType": {
+            "type": "string",
+            "enum": [
+                "post",
+                "news"
+            ],
+            "x-enum-varnames": [
+                "FeedItemPost",
+                "FeedItemNews"
+            ]
+        },
+        "models.FetchNewsRequest": {
+            "description": "Request model for fetching news from external API",
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsCategory"
+                    },
+                    "example": [
+                        "['technology'",
+                        " 'business']"
+                    ]
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "models.FetchRun": {
+            "description": "A fetch of a single news source",
+            "type": "object",
+            "properties": {
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 843
+                },
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ingestion_run_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "items_found": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "items_saved": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "source_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source_name": {
+                    "type": "string",
+                    "example": "TechCrunch"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "models.FreezeWindow": {
+            "description": "A content freeze window during which publishes are paused",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2023-01-02T02:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Database migration"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2023-01-01T22:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "models.GraphQLError": {
+            "description": "A request or field error. Errors from the REST layer carry its code in extensions.",
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Post not found"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "models.GraphQLRequest": {
+            "description": "A GraphQL query or mutation with its variables",
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string",
+                    "example": ""
+                },
+                "query": {
+                    "type": "string",
+                    "example": "query { posts(limit: 5) { posts { title slug } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "models.GraphQLResponse": {
+            "description": "Result of a GraphQL request. Data is null when the request itself is invalid.",
+            "type": "object",
+            "properties": {
+                "data": {},
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.GraphQLError"
+                    }
+                }
+            }
+        },
+        "models.HomeFeedResponse": {
+            "description": "Response model for the homepage feed",
+            "type": "object",
+            "properties": {
+                "generated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FeedItem"
+                    }
+                },
+                "ranker": {
+                    "type": "string",
+                    "example": "blend"
+                }
+            }
+        },
+        "models.ImageVariants": {
+            "description": "URLs of an uploaded image in several sizes",
+            "type": "object",
+            "properties": {
+                "medium": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/c_limit,w_1200,q_auto,f_webp/v1234567890/blog_images/post_covers/post_1_1620000000.webp"
+                },
+                "original": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/blog_images/post_covers/post_1_1620000000.jpg"
+                },
+                "thumbnail": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/c_fill,w_400,h_225,q_auto,f_webp/v1234567890/blog_images/post_covers/post_1_1620000000.webp"
+                }
+            }
+        },
+        "models.IngestionItem": {
+            "description": "The outcome of a single article in an ingestion run",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:01Z"
+                },
+                "external_id": {
+                    "type": "string",
+                    "example": "rss-techcrunch-2023-01-01-article"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "outcome": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.IngestionItemOutcome"
+                        }
+                    ],
+                    "example": "deduped"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "An article with this external ID already exists"
+                },
+                "run_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source": {
+                    "type": "string",
+                    "example": "TechCrunch"
+                },
+                "source_url": {
+                    "type": "string",
+                    "example": "https://technews.com/article/12345"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Major Technology Breakthrough Announced"
+                }
+            }
+        },
+        "models.IngestionItemOutcome": {
+            "type": "string",
+            "enum": [
+                "saved",
+                "deduped",
+                "filtered",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "IngestionItemSaved",
+                "IngestionItemDeduped",
+                "IngestionItemFiltered",
+                "IngestionItemFailed"
+            ]
+        },
+        "models.IngestionRun": {
+            "description": "A news ingestion run and its counters",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngestionItem"
+                    }
+                },
+                "items_deduped": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "items_failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items_filtered": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "items_saved": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "items_seen": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "source": {
+                    "type": "string",
+                    "example": "rss"
+                },
+                "source_errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IngestionSourceError"
+                    }
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.IngestionRunStatus"
+                        }
+                    ],
+                    "example": "completed"
+                },
+                "trigger": {
+                    "type": "string",
+                    "example": "scheduled"
+                }
+            }
+        },
+        "models.IngestionRunStatus": {
+            "type": "string",
+            "enum": [
+                "running",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "IngestionRunning",
+                "IngestionCompleted",
+                "IngestionFailed"
+            ]
+        },
+        "models.IngestionSourceError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "RSS feed returned non-OK status: 503"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "TechCrunch"
+                }
+            }
+        },
+        "models.IngestionStatsPoint": {
+            "description": "Articles fetched and saved by news ingestion on one day",
+            "type": "object",
+            "properties": {
+                "fetched": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2023-01-01"
+                },
+                "saved": {
+                    "type": "integer",
+                    "example": 18
+                }
+            }
+        },
+        "models.JWTSigningKey": {
+            "description": "A JWT signing key (the secret is never returned)",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "kid": {
+                    "type": "string",
+                    "example": "20230101120000-3f9a2c7e"
+                },
+                "retired_at": {
+                    "type": "string",
+                    "example": "2023-01-09T12:00:00Z"
+                },
+                "signing": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "source": {
+                    "type": "string",
+                    "example": "rotation"
+                }
+            }
+        },
+        "models.LinkSuggestion": {
+            "description": "Published post suggested as an internal link",
+            "type": "object",
+            "properties": {
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "handlers",
+                        "middleware"
+                    ]
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "score": {
+                    "type": "number",
+                    "example": 4.2
+                },
+                "shared_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "go",
+                        "api"
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "structuring-a-go-api"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Structuring a Go API"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://yourdomain.com/posts/structuring-a-go-api"
+                }
+            }
+        },
+        "models.LivenessResponse": {
+            "description": "Liveness probe response",
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "uptime_seconds": {
+                    "type": "integer",
+                    "example": 3600
+                }
+            }
+        },
+        "models.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "models.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "revoke_all": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "models.MergeTagRequest": {
+            "description": "Request model for merging a tag into another tag",
+            "type": "object",
+            "required": [
+                "into_id"
+            ],
+            "properties": {
+                "into_id": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.News": {
+            "description": "A news article with content, metadata, and relationships",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsCategory"
+                        }
+                    ],
+                    "example": "technology"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Scientists announced a major breakthrough in quantum computing..."
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "external_id": {
+                    "type": "string",
+                    "example": "ext-12345"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "publish_date": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "published": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "major-technology-breakthrough-announced"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "TechNews"
+                },
+                "source_url": {
+                    "type": "string",
+                    "example": "https://technews.com/article/12345"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "A brief summary of the quantum computing breakthrough"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Tag"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Major Technology Breakthrough Announced"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "3c2b1a0d-9e8f-4a7b-b6c5-d4e3f2a1b0c9"
+                }
+            }
+        },
+        "models.NewsBookmark": {
+            "description": "A news article saved to read later",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "news": {
+                    "$ref": "#/definitions/models.News"
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.NewsBulkItem": {
+            "description": "Outcome of a bulk operation on one news article",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsBulkItemStatus"
+                        }
+                    ],
+                    "example": "updated"
+                }
+            }
+        },
+        "models.NewsBulkItemStatus": {
+            "type": "string",
+            "enum": [
+                "updated",
+                "unchanged",
+                "deleted",
+                "not_found",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "NewsBulkUpdated",
+                "NewsBulkUnchanged",
+                "NewsBulkDeleted",
+                "NewsBulkNotFound",
+                "NewsBulkFailed"
+            ]
+        },
+        "models.NewsBulkResult": {
+            "description": "Outcome of a bulk operation on news articles",
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsBulkItem"
+                    }
+                },
+                "not_found": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 98
+                }
+            }
+        },
+        "models.NewsCategory": {
+            "type": "string",
+            "enum": [
+                "technology",
+                "science"
+            ],
+            "x-enum-varnames": [
+                "NewsCategoryTechnology",
+                "NewsCategoryScience"
+            ]
+        },
+        "models.NewsCategoryModel": {
+            "description": "A news category",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "software",
+                        "ai",
+                        "robot"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Technology"
+                },
+                "slug": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsCategory"
+                        }
+                    ],
+                    "example": "technology"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                }
+            }
+        },
+        "models.NewsEnrichmentBatchResult": {
+            "description": "Outcome of enriching news articles in a batch",
+            "type": "object",
+            "properties": {
+                "enriched": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsEnrichmentItem"
+                    }
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.NewsEnrichmentItem": {
+            "description": "Outcome of enriching one news article",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "source returned non-OK status: 403"
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsEnrichmentItemStatus"
+                        }
+                    ],
+                    "example": "enriched"
+                }
+            }
+        },
+        "models.NewsEnrichmentItemStatus": {
+            "type": "string",
+            "enum": [
+                "enriched",
+                "not_truncated",
+                "failed",
+                "not_found"
+            ],
+            "x-enum-varnames": [
+                "NewsEnrichmentEnriched",
+                "NewsEnrichmentNotTruncated",
+                "NewsEnrichmentFailed",
+                "NewsEnrichmentNotFound"
+            ]
+        },
+        "models.NewsEnrichmentStatus": {
+            "description": "Counts of news articles by enrichment state",
+            "type": "object",
+            "properties": {
+                "enriched": {
+                    "type": "integer",
+                    "example": 380
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "last_enriched_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "pending": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "truncated": {
+                    "type": "integer",
+                    "example": 450
+                }
+            }
+        },
+        "models.NewsSource": {
+            "description": "An RSS feed news articles are fetched from",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsCategory"
+                        }
+                    ],
+                    "example": "technology"
+                },
+                "consecutive_failures": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "fetch_interval_minutes": {
+                    "description": "FetchIntervalMinutes of zero uses RSS_FETCH_INTERVAL",
+                    "type": "integer",
+                    "example": 60
+                },
+                "healthy": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_fetch_count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "last_fetch_error": {
+                    "type": "string",
+                    "example": ""
+                },
+                "last_fetch_status": {
+                    "type": "string",
+                    "example": "ok"
+                },
+                "last_fetched_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "TechCrunch"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://techcrunch.com/feed/"
+                }
+            }
+        },
+        "models.NewsStatus": {
+            "type": "string",
+            "enum": [
+                "published",
+                "draft",
+                "archived"
+            ],
+            "x-enum-varnames": [
+                "NewsStatusPublished",
+                "NewsStatusDraft",
+                "NewsStatusArchived"
+            ]
+        },
+        "models.NewsView": {
+            "description": "A saved filter for the admin news list",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "filter": {
+                    "$ref": "#/definitions/models.AdminNewsFilter"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Truncated drafts"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.NewsWithoutContent": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/models.NewsCategory"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "publish_date": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "source_url": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.NewsStatus"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Tag"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.NewsWithoutContentResponse": {
+            "description": "Response model for news list with pagination information and without content",
+            "type": "object",
+            "properties": {
+                "news": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsWithoutContent"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJ0IjoiMjAyNS0wNi0wMVQxMjowMDowMFoiLCJpZCI6NDJ9"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "per_page": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_items": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "total_pages": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "models.Notification": {
+            "description": "An event on the current user's posts or comments",
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "actor_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "comment_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "post": {
+                    "$ref": "#/definitions/models.Post"
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "read_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NotificationType"
+                        }
+                    ],
+                    "example": "post_comment"
+                }
+            }
+        },
+        "models.NotificationType": {
+            "type": "string",
+            "enum": [
+                "post_comment",
+                "comment_reply",
+                "comment_mention",
+                "post_status_changed"
+            ],
+            "x-enum-varnames": [
+                "NotificationPostComment",
+                "NotificationCommentReply",
+                "NotificationCommentMention",
+                "NotificationPostStatusChanged"
+            ]
+        },
+        "models.Page": {
+            "description": "A static page of the site",
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "# About me\n\nI write about Go and the web."
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "about"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PageStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "About"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "updated_by": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.PageStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "published"
+            ],
+            "x-enum-varnames": [
+                "PageStatusDraft",
+                "PageStatusPublished"
+            ]
+        },
+        "models.PortablePost": {
+            "description": "A post as exported, or as accepted by the importer",
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "example": "johndoe"
+                },
+                "category": {
+                    "type": "string",
+                    "example": "backend"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "This is the content of my blog post..."
+                },
+                "cover": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "A short summary of the post"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-02-01T00:00:00Z"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"technology\"",
+                        "\"programming\"]"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My First Blog Post"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                }
+            }
+        },
+        "models.Post": {
+            "description": "A blog post with content, metadata, and relationships",
+            "type": "object",
+            "properties": {
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostAuthor"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/models.Category"
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "content": {
+                    "type": "string",
+                    "example": "This is the content of my blog post..."
+                },
+                "cover": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "A short summary of the post"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-02-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "og_image": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/og_images/post_1_1620000000.png"
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "reading_time_minutes": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "series": {
+                    "$ref": "#/definitions/models.SeriesNavigation"
+                },
+                "series_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "series_position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "skip_cross_post": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Tag"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My First Blog Post"
+                },
+                "translation_group": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostTranslation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "version": {
+                    "description": "Bumped by BeforeUpdate",
+                    "type": "integer",
+                    "example": 3
+                },
+                "view_count": {
+                    "type": "integer",
+                    "example": 128
+                },
+                "word_count": {
+                    "type": "integer",
+                    "example": 1250
+                }
+            }
+        },
+        "models.PostAnalytics": {
+            "description": "Views, readers and read-through rates of a post",
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostAnalyticsDay"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "read_through": {
+                    "$ref": "#/definitions/models.PostReadThrough"
+                },
+                "unique_readers": {
+                    "type": "integer",
+                    "example": 95
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "models.PostAnalyticsDay": {
+            "description": "Reader counters of a post for one day",
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "reached_100": {
+                    "type": "integer",
+                    "example": 33
+                },
+                "reached_25": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "reached_50": {
+                    "type": "integer",
+                    "example": 61
+                },
+                "reached_75": {
+                    "type": "integer",
+                    "example": 47
+                },
+                "unique_readers": {
+                    "type": "integer",
+                    "example": 95
+                },
+                "views": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "models.PostAuthor": {
+            "description": "A co-author of a post and their role",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "role": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostAuthorRole"
+                        }
+                    ],
+                    "example": "contributor"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "models.PostAuthorRole": {
+            "type": "string",
+            "enum": [
+                "author",
+                "contributor",
+                "reviewer"
+            ],
+            "x-enum-varnames": [
+                "PostAuthorRoleAuthor",
+                "PostAuthorRoleContributor",
+                "PostAuthorRoleReviewer"
+            ]
+        },
+        "models.PostAutosave": {
+            "description": "A draft snapshot of a post saved by the editor",
+            "type": "object",
+            "properties": {
+                "base_updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Go is a statically typed language..."
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "Learn the basics of Go"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Getting Started with Go"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:20Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.PostAutosaveResult": {
+            "description": "A draft snapshot with a conflict indicator",
+            "type": "object",
+            "properties": {
+                "autosave": {
+                    "$ref": "#/definitions/models.PostAutosave"
+                },
+                "conflict": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "post_updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                }
+            }
+        },
+        "models.PostExport": {
+            "description": "Exported posts in the JSON format",
+            "type": "object",
+            "properties": {
+                "exported_at": {
+                    "type": "string",
+                    "example": "2023-01-05T12:00:00Z"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PortablePost"
+                    }
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.PostImportItem": {
+            "description": "Outcome of importing one post",
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "skip",
+                        "error"
+                    ],
+                    "example": "create"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"title is required\"]"
+                    ]
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "posts/my-first-blog-post.md"
+                }
+            }
+        },
+        "models.PostImportResult": {
+            "description": "Summary of a post import",
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostImportItem"
+                    }
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "models.PostReadThrough": {
+            "description": "Share of views reaching each point of a post, between 0 and 1",
+            "type": "object",
+            "properties": {
+                "reached_100": {
+                    "type": "number",
+                    "example": 0.28
+                },
+                "reached_25": {
+                    "type": "number",
+                    "example": 0.67
+                },
+                "reached_50": {
+                    "type": "number",
+                    "example": 0.51
+                },
+                "reached_75": {
+                    "type": "number",
+                    "example": 0.39
+                }
+            }
+        },
+        "models.PostStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "published",
+                "archived",
+                "scheduled"
+            ],
+            "x-enum-varnames": [
+                "PostStatusDraft",
+                "PostStatusPublished",
+                "PostStatusArchived",
+                "PostStatusScheduled"
+            ]
+        },
+        "models.PostTemplate": {
+            "description": "A starting point for new posts",
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "content": {
+                    "type": "string",
+                    "example": "## Articles\n\n- \n\n## Tools\n\n- "
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Links worth reading from the past week"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "The best links of the week"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Weekly links"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"links\"",
+                        "\"weekly\"]"
+                    ]
+                },
+                "title_pattern": {
+                    "type": "string",
+                    "example": "Weekly links: week {week}, {year}"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "updated_by": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "models.PostTranslation": {
+            "description": "A translation of a post into another language",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "language": {
+                    "type": "string",
+                    "example": "vi"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "bai-viet-dau-tien-cua-toi"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bài viết đầu tiên của tôi"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "7a1d2c3b-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+                }
+            }
+        },
+        "models.PreviewTokenResponse": {
+            "description": "A signed link that shows an unpublished post without logging in",
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-04T12:00:00Z"
+                },
+                "preview_url": {
+                    "type": "string",
+                    "example": "/api/v1/posts/preview/eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "models.PublicProfile": {
+            "description": "Public profile of an author",
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "example": "I'm a software developer interested in web technologies."
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "joined_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "post_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "profile_image": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "johndoe"
+                }
+            }
+        },
+        "models.PublicStats": {
+            "description": "Public site statistics for widgets such as the blog footer",
+            "type": "object",
+            "properties": {
+                "blogging_since": {
+                    "type": "string",
+                    "example": "2021-05-01T12:00:00Z"
+                },
+                "generated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "total_comments": {
+                    "type": "integer",
+                    "example": 310
+                },
+                "total_posts": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "total_views": {
+                    "type": "integer",
+                    "example": 15230
+                },
+                "years_blogging": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.QueuedPost": {
+            "description": "A saved post whose publish waits for a content freeze to end",
+            "type": "object",
+            "properties": {
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostAuthor"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/models.Category"
+                },
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "content": {
+                    "type": "string",
+                    "example": "This is the content of my blog post..."
+                },
+                "cover": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "example": "A short summary of the post"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-02-01T00:00:00Z"
+                },
+                "frozen_until": {
+                    "type": "string",
+                    "example": "2023-01-02T02:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "og_image": {
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/og_images/post_1_1620000000.png"
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "queued": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "reading_time_minutes": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "series": {
+                    "$ref": "#/definitions/models.SeriesNavigation"
+                },
+                "series_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "series_position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "skip_cross_post": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Tag"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My First Blog Post"
+                },
+                "translation_group": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostTranslation"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                },
+                "version": {
+                    "description": "Bumped by BeforeUpdate",
+                    "type": "integer",
+                    "example": 3
+                },
+                "view_count": {
+                    "type": "integer",
+                    "example": 128
+                },
+                "word_count": {
+                    "type": "integer",
+                    "example": 1250
+                }
+            }
+        },
+        "models.ReadinessReport": {
+            "description": "Readiness probe report, with the status and latency of each dependency",
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DiagnosticCheck"
+                    }
+                },
+                "ready": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DiagnosticStatus"
+                        }
+                    ],
+                    "example": "warn"
+                }
+            }
+        },
+        "models.ReadingListItem": {
+            "description": "A post or news article saved to read later",
+            "type": "object",
+            "properties": {
+                "news": {
+                    "$ref": "#/definitions/models.News"
+                },
+                "post": {
+                    "$ref": "#/definitions/models.Post"
+                },
+                "saved_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ReadingListItemType"
+                        }
+                    ],
+                    "example": "news"
+                }
+            }
+        },
+        "models.ReadingListItemType": {
+            "type": "string",
+            "enum": [
+                "post",
+                "news"
+            ],
+            "x-enum-varnames": [
+                "ReadingListItemPost",
+                "ReadingListItemNews"
+            ]
+        },
+        "models.ReadingProgress": {
+            "description": "How far the current user got in a post",
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "type": "string",
+                    "example": "p-12"
+                },
+                "percentage": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "post": {
+                    "$ref": "#/definitions/models.Post"
+                },
+                "post_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                }
+            }
+        },
+        "models.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3
+                }
+            }
+        },
+        "models.RenameTagRequest": {
+            "description": "Request model for renaming a tag",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "golang"
+                }
+            }
+        },
+        "models.Role": {
+            "description": "A role and the permissions it grants",
+            "type": "object",
+            "properties": {
+                "built_in": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Keeps the comments civil"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "moderator"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "comment.edit",
+                        "comment.delete"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "user_count": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.RouteCapability": {
+            "description": "An API endpoint and what a caller needs to use it",
+            "type": "object",
+            "properties": {
+                "access": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "user",
+                        "admin",
+                        "optional"
+                    ],
+                    "example": "public"
+                },
+                "api_key": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "cache": {
+                    "type": "string",
+                    "example": "private, no-store"
+                },
+                "conditional": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "method": {
+                    "type": "string",
+                    "example": "GET"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/api/posts/{id}/comments"
+                },
+                "rate_limit": {
+                    "type": "string",
+                    "enum": [
+                        "api",
+                        "auth",
+                        "none"
+                    ],
+                    "example": "api"
+                }
+            }
+        },
+        "models.SaveReadingProgressRequest": {
+            "description": "Request model for saving how far the current user got in a post",
+            "type": "object",
+            "required": [
+                "percentage"
+            ],
+            "properties": {
+                "anchor": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "p-12"
+                },
+                "percentage": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 42
+                }
+            }
+        },
+        "models.SearchGroup": {
+            "description": "Search results of one type",
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SearchResult"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "models.SearchResponse": {
+            "description": "Response model for the unified search",
+            "type": "object",
+            "properties": {
+                "news": {
+                    "$ref": "#/definitions/models.SearchGroup"
+                },
+                "posts": {
+                    "$ref": "#/definitions/models.SearchGroup"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "golang api"
+                },
+                "tags": {
+                    "$ref": "#/definitions/models.SearchGroup"
+                }
+            }
+        },
+        "models.SearchResult": {
+            "description": "A post, news article or tag matching a search query",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "published_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 0.42
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-first-blog-post"
+                },
+                "snippet": {
+                    "type": "string",
+                    "example": "This is the content of my \u003cmark\u003eblog\u003c/mark\u003e post..."
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My First Blog Post"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SearchResultType"
+                        }
+                    ],
+                    "example": "post"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                }
+            }
+        },
+        "models.SearchResultType": {
+            "type": "string",
+            "enum": [
+                "post",
+                "news",
+                "tag"
+            ],
+            "x-enum-varnames": [
+                "SearchResultPost",
+                "SearchResultNews",
+                "SearchResultTag"
+            ]
+        },
+        "models.SendDigestRequest": {
+            "description": "Request model for sending a digest of recent posts",
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "integer",
+                    "maximum": 90,
+                    "minimum": 1,
+                    "example": 7
+                }
+            }
+        },
+        "models.Series": {
+            "description": "An ordered collection of posts",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "A step-by-step tutorial from an empty repository to a deployed API"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "post_count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "building-a-blog-in-go"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building a Blog in Go"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "3b1f8e2a-6c4d-4f9e-8a7b-2d5c1e0f9a8b"
+                }
+            }
+        },
+        "models.SeriesNavigation": {
+            "description": "Where a post sits in its series, with links to the posts before and after it",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "next": {
+                    "$ref": "#/definitions/models.SeriesPostLink"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "previous": {
+                    "$ref": "#/definitions/models.SeriesPostLink"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "building-a-blog-in-go"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Building a Blog in Go"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "models.SeriesPostLink": {
+            "description": "A post linked from the series navigation",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "part-2-routing"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Part 2: Routing"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
+                }
+            }
+        },
+        "models.Session": {
+            "description": "A device the user is signed in on",
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-01-08T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "issued_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2023-01-02T08:30:00Z"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15"
+                }
+            }
+        },
+        "models.SetEditorialPickRequest": {
+            "description": "Request model for boosting a post or news article in the homepage feed",
+            "type": "object",
+            "required": [
+                "item_id",
+                "item_type"
+            ],
+            "properties": {
+                "item_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "item_type": {
+                    "enum": [
+                        "post",
+                        "news"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FeedItemType"
+                        }
+                    ],
+                    "example": "news"
+                },
+                "weight": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0,
+                    "example": 0.8
+                }
+            }
+        },
+        "models.SetNewsStatusRequest": {
+            "description": "Request model for changing a news article's status",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsStatus"
+                        }
+                    ],
+                    "example": "published"
+                }
+            }
+        },
+        "models.SetPostStatusRequest": {
+            "description": "Request model for changing a post's status",
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "publish_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PostStatus"
+                        }
+                    ],
+                    "example": "published"
+                }
+            }
+        },
+        "models.Site": {
+            "description": "A blog served on its own domain",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "domain": {
+                    "type": "string",
+                    "example": "travel.example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Travel notes"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                }
+            }
+        },
+        "models.SiteSetting": {
+            "description": "A site setting",
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "example": "feed.recency_weight"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "1.0"
+                }
+            }
+        },
+        "models.SlugRedirect": {
+            "description": "Points at the current slug of a post or news article requested by an old slug",
+            "type": "object",
+            "properties": {
+                "location": {
+                    "type": "string",
+                    "example": "/api/posts/slug/my-renamed-post"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "my-renamed-post"
+                }
+            }
+        },
+        "models.StatsPoint": {
+            "description": "Count for one day or month",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2023-01"
+                }
+            }
+        },
+        "models.SubscribeRequest": {
+            "description": "Request model for subscribing to the newsletter",
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "reader@example.com"
+                }
+            }
+        },
+        "models.SwaggerAvatarResponse": {
+            "description": "Response model for avatar upload",
+            "type": "object",
+            "properties": {
+                "profile_image": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "variants": {
+                    "$ref": "#/definitions/models.ImageVariants"
+                }
+            }
+        },
+        "models.SwaggerBookmarksResponse": {
+            "description": "Response model for the current user's bookmarked posts",
+            "type": "object",
+            "properties": {
+                "bookmarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Bookmark"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.SwaggerPostsMeta"
+                }
+            }
+        },
+        "models.SwaggerBrokenLinksResponse": {
+            "description": "Response model for the broken outbound links in published content",
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BrokenLink"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/models.SwaggerPostsMeta"
+                }
+            }
+        },
+        "models.SwaggerDeleteNewsResponse": {
+            "description": "Response format for deleting a news article",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "News article deleted successfully"
+                }
+            }
+        },
+        "models.SwaggerEnrichedNewsContent": {
+            "description": "Enriched news content with full article text",
+            "type": "object",
+            "properties": {
                 "fetch_error": {
-                    "type": "string"
+                    "type": "string",
+                    "example": ""
                 },
-                "has_full_content": {
-                    "type": "boolean",
-                    "example": true
+                "full_content": {
+                    "type": "string",
+                    "example": "Full article content retrieved from the source..."
                 },
                 "is_truncated": {
                     "type": "boolean",
                     "example": true
                 },
+                "last_fetched": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "news_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "original_content": {
+                    "type": "string",
+                    "example": "Truncated content..."
+                },
+                "source_url": {
+                    "type": "string",
+                    "example": "https://news.com/article"
+                },
                 "truncated_chars": {
                     "type": "integer",
                     "example": 1281
+                },
+                "truncation_detected": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "truncation_pattern": {
+                    "type": "string",
+                    "example": "[+1281 chars]"
                 }
             }
         },
-        "models.CreateCommentRequest": {
-            "description": "Request model for creating a new comment on a post",
+        "models.SwaggerFetchNewsResponse": {
+            "description": "Response format for fetching news from external API",
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
-                "content": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsCategory"
+                    },
+                    "example": [
+                        "[\"technology\"",
+                        "\"business\"]"
+                    ]
+                },
+                "fetch_time": {
                     "type": "string",
-                    "example": "This is a great post!"
+                    "example": "2023-01-01T12:00:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "News articles fetched successfully"
+                },
+                "run_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "saved": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 10
                 }
             }
         },
-        "models.CreateNewsRequest": {
-            "description": "Request model for creating a news article",
+        "models.SwaggerFetchRSSNewsResponse": {
+            "description": "Response format for fetching news from RSS feeds",
             "type": "object",
-            "required": [
-                "content",
-                "source",
-                "title"
-            ],
             "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsCategory"
-                        }
-                    ],
-                    "example": "technology"
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsCategory"
+                    },
+                    "example": [
+                        "[\"technology\"",
+                        "\"science\"]"
+                    ]
                 },
-                "content": {
+                "fetch_time": {
                     "type": "string",
-                    "example": "Scientists announced a major breakthrough in quantum computing..."
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "image_url": {
+                "message": {
                     "type": "string",
-                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg"
+                    "example": "RSS news articles fetched successfully"
                 },
-                "publish_date": {
-                    "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                "run_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "source": {
-                    "type": "string",
-                    "example": "TechNews"
+                "saved": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "source_url": {
+                "total": {
+                    "type": "integer",
+                    "example": 15
+                }
+            }
+        },
+        "models.SwaggerFileUploadResponse": {
+            "description": "Response model for editor file upload",
+            "type": "object",
+            "properties": {
+                "file_url": {
                     "type": "string",
-                    "example": "https://technews.com/article/12345"
+                    "example": "https://example.com/file.jpg"
+                }
+            }
+        },
+        "models.SwaggerNewsWithContentStatus": {
+            "description": "News article with content status information for Swagger documentation",
+            "type": "object",
+            "properties": {
+                "content_status": {
+                    "$ref": "#/definitions/models.ContentStatus"
                 },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsStatus"
-                        }
-                    ],
-                    "example": "published"
+                "news": {
+                    "$ref": "#/definitions/models.News"
+                }
+            }
+        },
+        "models.SwaggerNotificationsMeta": {
+            "description": "Pagination metadata for notifications, with the unread count",
+            "type": "object",
+            "properties": {
+                "lastPage": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "summary": {
-                    "type": "string",
-                    "example": "A brief summary of the quantum computing breakthrough"
+                "limit": {
+                    "type": "integer",
+                    "example": 20
                 },
-                "tags": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "unread": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.SwaggerNotificationsResponse": {
+            "description": "Response model for the current user's notifications",
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/models.SwaggerNotificationsMeta"
+                },
+                "notifications": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "['technology'",
-                        " 'quantum computing']"
-                    ]
+                        "$ref": "#/definitions/models.Notification"
+                    }
+                }
+            }
+        },
+        "models.SwaggerPostCoverResponse": {
+            "description": "Response model for post cover upload",
+            "type": "object",
+            "properties": {
+                "cover": {
+                    "type": "string",
+                    "example": "https://example.com/cover.jpg"
                 },
-                "title": {
+                "variants": {
+                    "$ref": "#/definitions/models.ImageVariants"
+                }
+            }
+        },
+        "models.SwaggerPostsListMeta": {
+            "description": "Pagination metadata for the post list, with a cursor for the next page",
+            "type": "object",
+            "properties": {
+                "lastPage": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "next_cursor": {
                     "type": "string",
-                    "example": "Major Technology Breakthrough Announced"
+                    "example": "eyJ0IjoiMjAyNS0wNi0wMVQxMjowMDowMFoiLCJpZCI6NDJ9"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 50
                 }
             }
         },
-        "models.CreatePostRequest": {
-            "description": "Request model for creating a new blog post",
+        "models.SwaggerPostsMeta": {
+            "description": "Pagination metadata for blog post listings",
             "type": "object",
-            "required": [
-                "content",
-                "title"
-            ],
             "properties": {
-                "content": {
+                "lastPage": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 50
+                }
+            }
+        },
+        "models.SwaggerPostsResponse": {
+            "description": "Response model for listing blog posts",
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/models.SwaggerPostsListMeta"
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                }
+            }
+        },
+        "models.SwaggerProfileResponse": {
+            "description": "Response model for user profile information",
+            "type": "object",
+            "properties": {
+                "bio": {
                     "type": "string",
-                    "example": "This is the content of my new post"
+                    "example": "Software developer"
                 },
-                "cover": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-01-01T00:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "first_name": {
                     "type": "string",
-                    "example": "https://example.com/image.jpg"
+                    "example": "John"
                 },
-                "excerpt": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_name": {
                     "type": "string",
-                    "example": "A short excerpt"
+                    "example": "Doe"
                 },
-                "publish_at": {
+                "profile_image": {
                     "type": "string",
-                    "example": "2023-01-03T12:00:00Z"
+                    "example": "https://example.com/avatar.jpg"
                 },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.PostStatus"
-                        }
-                    ],
-                    "example": "published"
+                "role": {
+                    "type": "string",
+                    "example": "user"
                 },
-                "tags": {
+                "username": {
+                    "type": "string",
+                    "example": "johndoe"
+                }
+            }
+        },
+        "models.SwaggerReadingListResponse": {
+            "description": "Response model for the current user's bookmarked posts and news articles",
+            "type": "object",
+            "properties": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"technology\"",
-                        "\"programming\"]"
-                    ]
+                        "$ref": "#/definitions/models.ReadingListItem"
+                    }
                 },
-                "title": {
-                    "type": "string",
-                    "example": "My New Post"
+                "meta": {
+                    "$ref": "#/definitions/models.SwaggerPostsMeta"
                 }
             }
         },
-        "models.FetchNewsRequest": {
-            "description": "Request model for fetching news from external API",
+        "models.SwaggerReadingProgressResponse": {
+            "description": "Response model for the current user's reading progress",
             "type": "object",
             "properties": {
-                "categories": {
+                "meta": {
+                    "$ref": "#/definitions/models.SwaggerPostsMeta"
+                },
+                "progress": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.NewsCategory"
-                    },
-                    "example": [
-                        "['technology'",
-                        " 'business']"
-                    ]
-                },
-                "limit": {
-                    "type": "integer",
-                    "example": 10
+                        "$ref": "#/definitions/models.ReadingProgress"
+                    }
                 }
             }
         },
-        "models.LoginRequest": {
+        "models.SwaggerRegisteredUser": {
+            "description": "The newly registered user",
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
                 "email": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "john@example.com"
                 },
-                "password": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "username": {
                     "type": "string",
-                    "minLength": 6
+                    "example": "johndoe"
                 }
             }
         },
-        "models.News": {
-            "description": "A news article with content, metadata, and relationships",
+        "models.SwaggerStandardResponse": {
+            "description": "A standard API response format",
             "type": "object",
             "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsCategory"
-                        }
-                    ],
-                    "example": "technology"
-                },
-                "content": {
+                "data": {},
+                "message": {
                     "type": "string",
-                    "example": "Scientists announced a major breakthrough in quantum computing..."
+                    "example": "Operation completed successfully"
                 },
-                "created_at": {
+                "status": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "success"
+                }
+            }
+        },
+        "models.Tag": {
+            "description": "A tag that can be associated with multiple posts",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "external_id": {
+                "name": {
                     "type": "string",
-                    "example": "ext-12345"
+                    "example": "technology"
                 },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                }
+            }
+        },
+        "models.TagWithCount": {
+            "description": "A tag with the count of posts using it",
+            "type": "object",
+            "properties": {
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "image_url": {
-                    "type": "string",
-                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg"
-                },
-                "publish_date": {
+                "name": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "technology"
                 },
-                "published": {
-                    "type": "boolean",
-                    "example": true
+                "post_count": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "models.TokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
                 },
-                "slug": {
-                    "type": "string",
-                    "example": "major-technology-breakthrough-announced"
+                "expires_in": {
+                    "description": "in seconds",
+                    "type": "integer"
                 },
-                "source": {
-                    "type": "string",
-                    "example": "TechNews"
+                "refresh_token": {
+                    "type": "string"
                 },
-                "source_url": {
+                "token_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TokenRevokeRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.UpdateCategoryRequest": {
+            "description": "Request model for updating a post category",
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string",
-                    "example": "https://technews.com/article/12345"
-                },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsStatus"
-                        }
-                    ],
-                    "example": "published"
+                    "example": "Posts about server-side development"
                 },
-                "summary": {
+                "name": {
                     "type": "string",
-                    "example": "A brief summary of the quantum computing breakthrough"
+                    "maxLength": 100,
+                    "example": "Backend"
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Tag"
-                    }
+                "parent_id": {
+                    "type": "integer",
+                    "example": 2
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Major Technology Breakthrough Announced"
+                "position": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "updated_at": {
+                "slug": {
                     "type": "string",
-                    "example": "2023-01-02T12:00:00Z"
+                    "maxLength": 120,
+                    "example": "backend"
                 }
             }
         },
-        "models.NewsCategory": {
-            "type": "string",
-            "enum": [
-                "technology",
-                "science"
-            ],
-            "x-enum-varnames": [
-                "NewsCategoryTechnology",
-                "NewsCategoryScience"
-            ]
-        },
-        "models.NewsStatus": {
-            "type": "string",
-            "enum": [
-                "published",
-                "draft",
-                "archived"
+        "models.UpdateCommentRequest": {
+            "description": "Request model for updating an existing comment",
+            "type": "object",
+            "required": [
+                "content"
             ],
-            "x-enum-varnames": [
-                "NewsStatusPublished",
-                "NewsStatusDraft",
-                "NewsStatusArchived"
-            ]
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "This is my updated comment"
+                }
+            }
         },
-        "models.NewsWithoutContent": {
+        "models.UpdateNewsCategoryRequest": {
+            "description": "Request model for changing a news category",
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/models.NewsCategory"
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "created_at": {
-                    "type": "string"
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "web3",
+                        "defi",
+                        "ethereum"
+                    ]
                 },
-                "external_id": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Web3"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "web3"
+                }
+            }
+        },
+        "models.UpdateNewsRequest": {
+            "description": "Request model for updating a news article",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsCategory"
+                        }
+                    ],
+                    "example": "technology"
                 },
-                "id": {
-                    "type": "integer"
+                "content": {
+                    "type": "string",
+                    "example": "Scientists announced a major breakthrough in quantum computing..."
                 },
                 "image_url": {
-                    "type": "string"
-                },
-                "publish_date": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg"
                 },
-                "published": {
-                    "type": "boolean"
+                "language": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "en"
                 },
-                "slug": {
-                    "type": "string"
+                "publish_date": {
+                    "type": "string",
+                    "example": "2023-01-01T12:00:00Z"
                 },
                 "source": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "TechNews"
                 },
                 "source_url": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "https://technews.com/article/12345"
                 },
                 "status": {
-                    "$ref": "#/definitions/models.NewsStatus"
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NewsStatus"
+                        }
+                    ],
+                    "example": "published"
                 },
                 "summary": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "A brief summary of the quantum computing breakthrough"
                 },
                 "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Tag"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "['technology'",
+                        " 'quantum computing']"
+                    ]
                 },
                 "title": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Updated Technology Breakthrough Announced"
                 }
             }
         },
-        "models.NewsWithoutContentResponse": {
-            "description": "Response model for news list with pagination information and without content",
+        "models.UpdateNewsSourceRequest": {
+            "description": "Request model for changing an RSS feed",
             "type": "object",
             "properties": {
-                "news": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.NewsWithoutContent"
-                    }
+                "category": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "science"
                 },
-                "page": {
-                    "type": "integer",
-                    "example": 1
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "per_page": {
+                "fetch_interval_minutes": {
                     "type": "integer",
-                    "example": 10
+                    "minimum": 0,
+                    "example": 30
                 },
-                "total_items": {
-                    "type": "integer",
-                    "example": 100
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "TechCrunch"
                 },
-                "total_pages": {
-                    "type": "integer",
-                    "example": 10
+                "url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://techcrunch.com/feed/"
                 }
             }
         },
-        "models.Post": {
-            "description": "A blog post with content, metadata, and relationships",
+        "models.UpdatePageRequest": {
+            "description": "Request model for updating a static page",
             "type": "object",
             "properties": {
                 "content": {
                     "type": "string",
-                    "example": "This is the content of my blog post..."
+                    "example": "# About me\n\nI write about Go and the web."
                 },
-                "cover": {
+                "slug": {
                     "type": "string",
-                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg"
+                    "maxLength": 120,
+                    "example": "about"
                 },
-                "created_at": {
+                "status": {
+                    "enum": [
+                        "draft",
+                        "published"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PageStatus"
+                        }
+                    ],
+                    "example": "published"
+                },
+                "title": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "maxLength": 200,
+                    "example": "About"
+                }
+            }
+        },
+        "models.UpdatePostRequest": {
+            "description": "Request model for updating an existing blog post",
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "clear_expiry": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Updated content"
+                },
+                "cover": {
+                    "type": "string",
+                    "example": "https://example.com/updated-cover.jpg"
                 },
                 "excerpt": {
                     "type": "string",
-                    "example": "A short summary of the post"
+                    "example": "Updated excerpt"
                 },
-                "id": {
-                    "type": "integer",
-                    "example": 1
+                "expires_at": {
+                    "type": "string",
+                    "example": "2023-02-01T00:00:00Z"
                 },
-                "slug": {
+                "language": {
                     "type": "string",
-                    "example": "my-first-blog-post"
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "vi"
+                },
+                "publish_at": {
+                    "type": "string",
+                    "example": "2023-01-03T12:00:00Z"
+                },
+                "skip_cross_post": {
+                    "type": "boolean",
+                    "example": true
                 },
                 "status": {
                     "allOf": [
@@ -2533,288 +15698,272 @@ const docTemplate = `{
                 "tags": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Tag"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"technology\"",
+                        "\"programming\"",
+                        "\"updated\"]"
+                    ]
                 },
                 "title": {
                     "type": "string",
-                    "example": "My First Blog Post"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2023-01-02T12:00:00Z"
-                },
-                "user": {
-                    "$ref": "#/definitions/models.User"
+                    "example": "Updated Post Title"
                 },
-                "user_id": {
+                "translation_of": {
                     "type": "integer",
                     "example": 1
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "models.PostStatus": {
-            "type": "string",
-            "enum": [
-                "draft",
-                "published",
-                "archived",
-                "scheduled"
-            ],
-            "x-enum-varnames": [
-                "PostStatusDraft",
-                "PostStatusPublished",
-                "PostStatusArchived",
-                "PostStatusScheduled"
-            ]
-        },
-        "models.RefreshTokenRequest": {
-            "type": "object",
-            "required": [
-                "refresh_token"
-            ],
-            "properties": {
-                "refresh_token": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.RegisterRequest": {
+        "models.UpdatePostTemplateRequest": {
+            "description": "Request model for changing a post template; omitted fields are kept",
             "type": "object",
-            "required": [
-                "email",
-                "password",
-                "username"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "category_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "first_name": {
-                    "type": "string"
+                "content": {
+                    "type": "string",
+                    "example": "## Articles\n\n- "
                 },
-                "last_name": {
-                    "type": "string"
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Links worth reading from the past week"
                 },
-                "password": {
+                "excerpt": {
                     "type": "string",
-                    "minLength": 8
+                    "example": "The best links of the week"
                 },
-                "username": {
+                "language": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 3
-                }
-            }
-        },
-        "models.SetNewsStatusRequest": {
-            "description": "Request model for changing a news article's status",
-            "type": "object",
-            "required": [
-                "status"
-            ],
-            "properties": {
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsStatus"
-                        }
+                    "enum": [
+                        "en",
+                        "vi"
                     ],
-                    "example": "published"
-                }
-            }
-        },
-        "models.SetPostStatusRequest": {
-            "description": "Request model for changing a post's status",
-            "type": "object",
-            "required": [
-                "status"
-            ],
-            "properties": {
-                "publish_at": {
+                    "example": "vi"
+                },
+                "name": {
                     "type": "string",
-                    "example": "2023-01-03T12:00:00Z"
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Weekly links"
                 },
                 "status": {
+                    "enum": [
+                        "draft",
+                        "published"
+                    ],
                     "allOf": [
                         {
                             "$ref": "#/definitions/models.PostStatus"
                         }
                     ],
                     "example": "published"
-                }
-            }
-        },
-        "models.SwaggerAvatarResponse": {
-            "description": "Response model for avatar upload",
-            "type": "object",
-            "properties": {
-                "profile_image": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                }
-            }
-        },
-        "models.SwaggerDeleteFileRequest": {
-            "description": "Request model for deleting a file",
-            "type": "object",
-            "properties": {
-                "file_url": {
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"links\"]"
+                    ]
+                },
+                "title_pattern": {
                     "type": "string",
-                    "example": "https://example.com/file.jpg"
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Links of the week {week}"
                 }
             }
         },
-        "models.SwaggerDeleteNewsResponse": {
-            "description": "Response format for deleting a news article",
+        "models.UpdateProfileRequest": {
+            "description": "Request model for updating user profile",
             "type": "object",
             "properties": {
-                "message": {
+                "analytics_opt_out": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "bio": {
                     "type": "string",
-                    "example": "News article deleted successfully"
-                }
-            }
-        },
-        "models.SwaggerFetchNewsResponse": {
-            "description": "Response format for fetching news from external API",
-            "type": "object",
-            "properties": {
-                "categories": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.NewsCategory"
-                    },
-                    "example": [
-                        "[\"technology\"",
-                        "\"business\"]"
-                    ]
+                    "example": "Software developer"
                 },
-                "fetch_time": {
+                "comment_emails": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "enum": [
+                        "immediate",
+                        "daily",
+                        "off"
+                    ],
+                    "example": "daily"
                 },
-                "message": {
+                "first_name": {
                     "type": "string",
-                    "example": "News articles fetched successfully"
+                    "example": "John"
                 },
-                "saved": {
-                    "type": "integer",
-                    "example": 8
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 10
+                "profile_image": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
                 }
             }
         },
-        "models.SwaggerFetchRSSNewsResponse": {
-            "description": "Response format for fetching news from RSS feeds",
+        "models.UpdateRoleRequest": {
+            "description": "Request model for changing a role; omitted fields are kept",
             "type": "object",
             "properties": {
-                "categories": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Moderates comments and pages"
+                },
+                "permissions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.NewsCategory"
+                        "type": "string"
                     },
                     "example": [
-                        "[\"technology\"",
-                        "\"science\"]"
+                        "comment.edit",
+                        "comment.delete",
+                        "page.edit"
                     ]
-                },
-                "fetch_time": {
+                }
+            }
+        },
+        "models.UpdateSeriesRequest": {
+            "description": "Request model for updating a post series",
+            "type": "object",
+            "properties": {
+                "description": {
                     "type": "string",
-                    "example": "2023-01-01T12:00:00Z"
+                    "example": "A step-by-step tutorial"
                 },
-                "message": {
+                "slug": {
                     "type": "string",
-                    "example": "RSS news articles fetched successfully"
-                },
-                "saved": {
-                    "type": "integer",
-                    "example": 12
+                    "maxLength": 255,
+                    "example": "building-a-blog-in-go"
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 15
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Building a Blog in Go"
                 }
             }
         },
-        "models.SwaggerFileUploadResponse": {
-            "description": "Response model for editor file upload",
+        "models.UpdateSiteRequest": {
+            "description": "Request model for changing a site; omitted fields are kept",
             "type": "object",
             "properties": {
-                "file_url": {
+                "domain": {
                     "type": "string",
-                    "example": "https://example.com/file.jpg"
+                    "maxLength": 255,
+                    "example": "travel.example.com"
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Travel notes"
                 }
             }
         },
-        "models.SwaggerNewsWithContentStatus": {
-            "description": "News article with content status information for Swagger documentation",
+        "models.UpdateSiteSettingRequest": {
+            "description": "Request model for changing a site setting",
             "type": "object",
+            "required": [
+                "value"
+            ],
             "properties": {
-                "content_status": {
-                    "$ref": "#/definitions/models.ContentStatus"
-                },
-                "news": {
-                    "$ref": "#/definitions/models.News"
+                "value": {
+                    "type": "string",
+                    "example": "0.75"
                 }
             }
         },
-        "models.SwaggerPostCoverResponse": {
-            "description": "Response model for post cover upload",
+        "models.UpdateUserRoleRequest": {
+            "description": "Request model for changing a user's role",
             "type": "object",
+            "required": [
+                "role"
+            ],
             "properties": {
-                "cover": {
+                "role": {
                     "type": "string",
-                    "example": "https://example.com/cover.jpg"
+                    "maxLength": 20,
+                    "example": "editor"
                 }
             }
         },
-        "models.SwaggerPostsResponse": {
-            "description": "Response model for listing blog posts",
+        "models.UpdateWebhookRequest": {
+            "description": "Request model for updating a webhook",
             "type": "object",
             "properties": {
-                "meta": {
-                    "type": "object",
-                    "properties": {
-                        "last_page": {
-                            "type": "integer",
-                            "example": 5
-                        },
-                        "limit": {
-                            "type": "integer",
-                            "example": 10
-                        },
-                        "page": {
-                            "type": "integer",
-                            "example": 1
-                        },
-                        "total": {
-                            "type": "integer",
-                            "example": 50
-                        }
-                    }
+                "active": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "posts": {
+                "description": {
+                    "type": "string",
+                    "example": "Next.js ISR revalidation"
+                },
+                "events": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Post"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "post.published"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/api/revalidate"
                 }
             }
         },
-        "models.SwaggerProfileResponse": {
-            "description": "Response model for user profile information",
+        "models.User": {
+            "description": "A user account with profile information and relationships",
             "type": "object",
             "properties": {
+                "analytics_opt_out": {
+                    "type": "boolean",
+                    "example": false
+                },
                 "bio": {
                     "type": "string",
-                    "example": "Software developer"
+                    "example": "I'm a software developer interested in web technologies."
+                },
+                "comment_emails": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CommentEmails"
+                        }
+                    ],
+                    "example": "immediate"
+                },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
                 },
                 "created_at": {
                     "type": "string",
-                    "example": "2023-01-01T00:00:00Z"
+                    "example": "2023-01-01T12:00:00Z"
                 },
                 "email": {
                     "type": "string",
@@ -2832,302 +15981,485 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Doe"
                 },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                },
                 "profile_image": {
                     "type": "string",
-                    "example": "https://example.com/avatar.jpg"
+                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg"
                 },
                 "role": {
                     "type": "string",
                     "example": "user"
                 },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
                 "username": {
                     "type": "string",
                     "example": "johndoe"
                 }
             }
         },
-        "models.SwaggerStandardResponse": {
-            "description": "A standard API response format",
+        "models.UserDataExport": {
+            "description": "All of a user's data: profile, posts, comments, comment reactions, bookmarks and series",
             "type": "object",
             "properties": {
-                "data": {},
-                "error": {
+                "bookmarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Bookmark"
+                    }
+                },
+                "comment_reactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CommentReaction"
+                    }
+                },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Comment"
+                    }
+                },
+                "exported_at": {
                     "type": "string",
-                    "example": "Invalid input"
+                    "example": "2023-01-05T12:00:00Z"
                 },
-                "message": {
+                "news_bookmarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.NewsBookmark"
+                    }
+                },
+                "posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Post"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/models.User"
+                },
+                "reading_progress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ReadingProgress"
+                    }
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Series"
+                    }
+                }
+            }
+        },
+        "models.UserDeletion": {
+            "description": "A user deletion that can be restored until its undo window ends",
+            "type": "object",
+            "properties": {
+                "comment_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "created_at": {
                     "type": "string",
-                    "example": "Operation completed successfully"
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "status": {
+                "deleted_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ghost_user_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "post_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "purged_at": {
                     "type": "string",
-                    "example": "success"
+                    "example": "2023-01-05T12:00:00Z"
+                },
+                "restored_at": {
+                    "type": "string",
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "strategy": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.UserDeletionStrategy"
+                        }
+                    ],
+                    "example": "reassign"
+                },
+                "undo_until": {
+                    "type": "string",
+                    "example": "2023-01-04T12:00:00Z"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "models.UserDeletionStrategy": {
+            "type": "string",
+            "enum": [
+                "anonymize",
+                "reassign",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "UserDeletionAnonymize",
+                "UserDeletionReassign",
+                "UserDeletionDelete"
+            ]
+        },
+        "models.UserPurgeResult": {
+            "description": "Result of purging deleted users whose undo window has passed",
+            "type": "object",
+            "properties": {
+                "purged": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        42,
+                        43
+                    ]
                 }
             }
         },
-        "models.SwaggerUpdateProfileRequest": {
-            "description": "Request model for updating user profile",
+        "models.VersionInfo": {
+            "description": "Build information of the running API instance",
             "type": "object",
             "properties": {
-                "bio": {
+                "build_time": {
                     "type": "string",
-                    "example": "Software developer"
+                    "example": "2025-05-09T10:00:00Z"
                 },
-                "first_name": {
+                "canary": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "git_sha": {
                     "type": "string",
-                    "example": "John"
+                    "example": "3f2a9c1d8e4b"
                 },
-                "last_name": {
+                "go_version": {
                     "type": "string",
-                    "example": "Doe"
+                    "example": "go1.24.2"
                 }
             }
         },
-        "models.Tag": {
-            "description": "A tag that can be associated with multiple posts",
+        "models.WXRImageFailure": {
+            "description": "Image referenced by an imported post that could not be downloaded",
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "name": {
+                "error": {
                     "type": "string",
-                    "example": "technology"
+                    "example": "unexpected status 404"
                 },
-                "posts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Post"
-                    }
+                "url": {
+                    "type": "string",
+                    "example": "https://blog.example.com/wp-content/uploads/2023/01/photo.jpg"
                 }
             }
         },
-        "models.TagWithCount": {
-            "description": "A tag with the count of posts using it",
+        "models.WXRImportResult": {
+            "description": "Summary of a WordPress (WXR) import",
             "type": "object",
             "properties": {
-                "id": {
+                "authors_created": {
                     "type": "integer",
                     "example": 1
                 },
-                "name": {
-                    "type": "string",
-                    "example": "technology"
+                "authors_matched": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "post_count": {
+                "categories_created": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "comments_guest": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "comments_imported": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "comments_skipped": {
                     "type": "integer",
                     "example": 5
-                }
-            }
-        },
-        "models.TokenResponse": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "type": "string"
                 },
-                "expires_in": {
-                    "description": "in seconds",
-                    "type": "integer"
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "refresh_token": {
-                    "type": "string"
+                "images_downloaded": {
+                    "type": "integer",
+                    "example": 18
                 },
-                "token_type": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.TokenRevokeRequest": {
-            "type": "object",
-            "required": [
-                "refresh_token"
-            ],
-            "properties": {
-                "refresh_token": {
-                    "type": "string"
+                "images_failed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WXRImageFailure"
+                    }
+                },
+                "pages": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "posts": {
+                    "$ref": "#/definitions/models.PostImportResult"
+                },
+                "skipped_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WXRSkippedItem"
+                    }
                 }
             }
         },
-        "models.UpdateCommentRequest": {
-            "description": "Request model for updating an existing comment",
+        "models.WXRSkippedItem": {
+            "description": "Item of a WordPress export that was not imported",
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
-                "content": {
+                "reason": {
                     "type": "string",
-                    "example": "This is my updated comment"
+                    "example": "post type is not supported"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Main menu"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "nav_menu_item"
                 }
             }
         },
-        "models.UpdateNewsRequest": {
-            "description": "Request model for updating a news article",
+        "models.Webhook": {
+            "description": "A registered webhook endpoint and the events it receives",
             "type": "object",
             "properties": {
-                "category": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsCategory"
-                        }
-                    ],
-                    "example": "technology"
-                },
-                "content": {
-                    "type": "string",
-                    "example": "Scientists announced a major breakthrough in quantum computing..."
-                },
-                "image_url": {
-                    "type": "string",
-                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg"
+                "active": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "publish_date": {
+                "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
                 },
-                "source": {
-                    "type": "string",
-                    "example": "TechNews"
-                },
-                "source_url": {
-                    "type": "string",
-                    "example": "https://technews.com/article/12345"
-                },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.NewsStatus"
-                        }
-                    ],
-                    "example": "published"
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "summary": {
+                "description": {
                     "type": "string",
-                    "example": "A brief summary of the quantum computing breakthrough"
+                    "example": "Next.js ISR revalidation"
                 },
-                "tags": {
+                "events": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "['technology'",
-                        " 'quantum computing']"
+                        "post.published",
+                        "post.updated"
                     ]
                 },
-                "title": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
                     "type": "string",
-                    "example": "Updated Technology Breakthrough Announced"
+                    "example": "2023-01-02T12:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/api/revalidate"
                 }
             }
         },
-        "models.UpdatePostRequest": {
-            "description": "Request model for updating an existing blog post",
+        "models.WebhookDelivery": {
+            "description": "A webhook delivery attempt and its outcome",
             "type": "object",
             "properties": {
-                "content": {
+                "attempt": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
                     "type": "string",
-                    "example": "Updated content"
+                    "example": "2023-01-01T12:00:00Z"
                 },
-                "cover": {
+                "delivery_id": {
                     "type": "string",
-                    "example": "https://example.com/updated-cover.jpg"
+                    "example": "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"
                 },
-                "excerpt": {
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 142
+                },
+                "error": {
                     "type": "string",
-                    "example": "Updated excerpt"
+                    "example": ""
                 },
-                "publish_at": {
+                "event": {
                     "type": "string",
-                    "example": "2023-01-03T12:00:00Z"
+                    "example": "post.published"
                 },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.PostStatus"
-                        }
-                    ],
-                    "example": "published"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"technology\"",
-                        "\"programming\"",
-                        "\"updated\"]"
-                    ]
+                "status_code": {
+                    "type": "integer",
+                    "example": 200
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Updated Post Title"
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "webhook_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "models.User": {
-            "description": "A user account with profile information and relationships",
+        "models.WebhookWithSecret": {
+            "description": "A newly created webhook including its signing secret",
             "type": "object",
             "properties": {
-                "bio": {
-                    "type": "string",
-                    "example": "I'm a software developer interested in web technologies."
-                },
-                "comments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.Comment"
-                    }
+                "active": {
+                    "type": "boolean",
+                    "example": true
                 },
                 "created_at": {
                     "type": "string",
                     "example": "2023-01-01T12:00:00Z"
                 },
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
-                },
-                "first_name": {
-                    "type": "string",
-                    "example": "John"
-                },
-                "id": {
+                "created_by": {
                     "type": "integer",
                     "example": 1
                 },
-                "last_name": {
+                "description": {
                     "type": "string",
-                    "example": "Doe"
+                    "example": "Next.js ISR revalidation"
                 },
-                "posts": {
+                "events": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.Post"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "post.published",
+                        "post.updated"
+                    ]
                 },
-                "profile_image": {
-                    "type": "string",
-                    "example": "https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "role": {
+                "secret": {
                     "type": "string",
-                    "example": "user"
+                    "example": "whsec_3f9a..."
                 },
                 "updated_at": {
                     "type": "string",
                     "example": "2023-01-02T12:00:00Z"
                 },
-                "username": {
+                "url": {
                     "type": "string",
-                    "example": "johndoe"
+                    "example": "https://example.com/api/revalidate"
+                }
+            }
+        },
+        "policy.Action": {
+            "type": "string",
+            "enum": [
+                "admin.access",
+                "post.create",
+                "post.edit",
+                "post.publish",
+                "post.unpublish",
+                "post.manage",
+                "post.delete",
+                "post.analytics",
+                "comment.edit",
+                "comment.delete",
+                "comment.skip_moderation",
+                "series.manage",
+                "page.edit",
+                "template.edit"
+            ],
+            "x-enum-varnames": [
+                "ActionAdminAccess",
+                "ActionPostCreate",
+                "ActionPostEdit",
+                "ActionPostPublish",
+                "ActionPostUnpublish",
+                "ActionPostManage",
+                "ActionPostDelete",
+                "ActionPostAnalytics",
+                "ActionCommentEdit",
+                "ActionCommentDelete",
+                "ActionCommentSkipModeration",
+                "ActionSeriesManage",
+                "ActionPageEdit",
+                "ActionTemplateEdit"
+            ]
+        },
+        "policy.Permission": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/policy.Action"
+                        }
+                    ],
+                    "example": "post.create"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Write new posts"
                 }
             }
         }
     },
     "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API key created under /profile/api-keys, accepted by user endpoints but not admin ones.",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BearerAuth": {
             "description": "Type \"Bearer\" followed by a space and the JWT token.",
             "type": "apiKey",
@@ -3141,7 +16473,7 @@ const docTemplate = `{
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
 	Host:             "localhost:9876",
-	BasePath:         "/api",
+	BasePath:         "/api/v1",
 	Schemes:          []string{},
 	Title:            "TaiPhanVan API",
 	Description:      "A RESTful API for the TaiPhanVan personal blog platform with blog posts, user authentication, file management, and news features",
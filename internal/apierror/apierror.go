@@ -0,0 +1,242 @@
+// Package apierror defines the error type that handlers and middleware report to
+// clients. Errors are rendered by middleware.ErrorHandler into a consistent JSON
+// body carrying a machine-readable code from the i18n package.
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+)
+
+// Error is an error that is reported to the client
+type Error struct {
+	// Status is the HTTP status code of the response
+	Status int
+	// Code is the machine-readable error code, also used to translate the message
+	Code string
+	// Message replaces the translated message when set, e.g. with a validation error
+	Message string
+	// Details is extra structured information for the client, e.g. invalid fields
+	Details interface{}
+	// Err is the underlying cause. It is logged but never sent to the client.
+	Err error
+}
+
+// FieldError describes a request field that failed validation
+type FieldError struct {
+	Field   string `json:"field" example:"title" description:"Path of the invalid field in the request body, e.g. title or events[0].type"`
+	Rule    string `json:"rule" example:"required" description:"Validation rule that failed"`
+	Param   string `json:"param,omitempty" example:"" description:"Parameter of the rule, e.g. the minimum length for min"`
+	Message string `json:"message" example:"title is required" description:"What is wrong with the field, in the request's language"`
+
+	// kind picks the message of rules whose wording depends on the field's
+	// type: string, number or list
+	kind string
+}
+
+// TooLong reports a text field longer than max characters
+func TooLong(field string, max int) FieldError {
+	return FieldError{Field: field, Rule: "max", Param: strconv.Itoa(max), kind: "string"}
+}
+
+// TooLarge reports a text field larger than max bytes
+func TooLarge(field string, max int) FieldError {
+	return FieldError{Field: field, Rule: "max_bytes", Param: strconv.Itoa(max)}
+}
+
+// TooMany reports a list field with more than max items
+func TooMany(field string, max int) FieldError {
+	return FieldError{Field: field, Rule: "max", Param: strconv.Itoa(max), kind: "list"}
+}
+
+// Localize returns the error with its message in lang
+func (f FieldError) Localize(lang string) FieldError {
+	key := "validation." + f.Rule
+	if f.kind != "" {
+		key += "." + f.kind
+	}
+	template := i18n.Translate(lang, key)
+	if template == key {
+		template = i18n.Translate(lang, "validation.invalid")
+	}
+
+	param := f.Param
+	if f.Rule == "oneof" {
+		param = strings.Join(strings.Fields(param), ", ")
+	}
+	f.Message = strings.NewReplacer("{field}", f.Field, "{param}", param).Replace(template)
+	return f
+}
+
+// New creates an error with the given status and code
+func New(status int, code string) *Error {
+	return &Error{Status: status, Code: code}
+}
+
+// BadRequest creates a 400 error
+func BadRequest(code string) *Error { return New(http.StatusBadRequest, code) }
+
+// Unauthorized creates a 401 error
+func Unauthorized(code string) *Error { return New(http.StatusUnauthorized, code) }
+
+// Forbidden creates a 403 error
+func Forbidden(code string) *Error { return New(http.StatusForbidden, code) }
+
+// NotFound creates a 404 error
+func NotFound(code string) *Error { return New(http.StatusNotFound, code) }
+
+// Conflict creates a 409 error
+func Conflict(code string) *Error { return New(http.StatusConflict, code) }
+
+// Internal creates a 500 error caused by err
+func Internal(code string, err error) *Error {
+	return New(http.StatusInternalServerError, code).Wrap(err)
+}
+
+// Validation creates a 400 invalid_input error from a request binding error.
+// Fields the validator rejected, or whose JSON value has the wrong type, are
+// listed in Details; their messages are filled in for the request's language
+// when the error is rendered. Other errors, such as malformed JSON, keep their
+// message.
+func Validation(err error) *Error {
+	apiErr := BadRequest(i18n.CodeInvalidInput)
+
+	var validationErrors validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &validationErrors):
+		fields := make([]FieldError, 0, len(validationErrors))
+		for _, fieldErr := range validationErrors {
+			param := fieldErr.Param()
+			if strings.HasPrefix(fieldErr.Tag(), "required_") {
+				param = snakeCase(param)
+			}
+			fields = append(fields, FieldError{
+				Field: fieldPath(fieldErr.Namespace()),
+				Rule:  fieldErr.Tag(),
+				Param: param,
+				kind:  lengthKind(fieldErr),
+			})
+		}
+		apiErr.Details = fields
+	case errors.As(err, &typeErr):
+		apiErr.Details = []FieldError{{
+			Field: typeErr.Field,
+			Rule:  "type",
+			Param: jsonType(typeErr.Type.Kind()),
+		}}
+	default:
+		apiErr.Message = err.Error()
+	}
+
+	return apiErr.Wrap(err)
+}
+
+// InvalidFields creates a 400 invalid_input error listing fields that failed
+// checks made after binding, such as configurable limits
+func InvalidFields(fields ...FieldError) *Error {
+	return BadRequest(i18n.CodeInvalidInput).WithDetails(fields)
+}
+
+// fieldPath drops the request struct's name from a validator namespace, e.g.
+// AnalyticsEventsRequest.events[0].type becomes events[0].type
+func fieldPath(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+	return namespace
+}
+
+// lengthKind is the message variant of rules that compare a length or value
+func lengthKind(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "min", "max", "len":
+	default:
+		return ""
+	}
+	switch fieldErr.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "list"
+	default:
+		return "number"
+	}
+}
+
+// jsonType names the JSON type a Go kind is decoded from
+func jsonType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	default:
+		return "number"
+	}
+}
+
+// snakeCase converts a Go field name named by a rule parameter to its JSON
+// name, e.g. TemplateID to template_id
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a word at an uppercase letter that follows a lowercase one or
+			// ends an acronym, e.g. the D of TemplateID but not of ID
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && runes[i+1] != 's')) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// WithMessage replaces the translated message
+func (e *Error) WithMessage(message string) *Error {
+	e.Message = message
+	return e
+}
+
+// WithDetails attaches structured details for the client
+func (e *Error) WithDetails(details interface{}) *Error {
+	e.Details = details
+	return e
+}
+
+// Wrap records the underlying cause
+func (e *Error) Wrap(err error) *Error {
+	e.Err = err
+	return e
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Code + ": " + e.Err.Error()
+	}
+	if e.Message != "" {
+		return e.Code + ": " + e.Message
+	}
+	return e.Code
+}
+
+// Unwrap returns the underlying cause
+func (e *Error) Unwrap() error {
+	return e.Err
+}
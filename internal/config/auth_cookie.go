@@ -0,0 +1,63 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AuthCookieConfig holds configuration for cookie sessions. With AUTH_MODE=cookie
+// the refresh token is kept in an httpOnly cookie instead of being handed to
+// JavaScript, and a readable CSRF cookie guards the requests the refresh
+// cookie authenticates.
+type AuthCookieConfig struct {
+	Enabled  bool
+	Name     string // Name of the httpOnly refresh token cookie
+	CSRFName string // Name of the readable cookie holding the CSRF token
+	Domain   string // Empty for the API's own host
+	Path     string // Path the cookies are sent for
+	Secure   bool   // Only send the cookies over HTTPS
+	SameSite http.SameSite
+}
+
+// loadAuthCookieConfig reads the session mode from AUTH_MODE, "token" (the
+// default) or "cookie", and the cookie settings
+func loadAuthCookieConfig(cors CORSConfig) (AuthCookieConfig, error) {
+	cfg := AuthCookieConfig{
+		Name:     getEnv("AUTH_COOKIE_NAME", "refresh_token"),
+		CSRFName: getEnv("AUTH_CSRF_COOKIE_NAME", "csrf_token"),
+		Domain:   getEnv("AUTH_COOKIE_DOMAIN", ""),
+		Path:     getEnv("AUTH_COOKIE_PATH", "/api"),
+		Secure:   GetEnvBool("AUTH_COOKIE_SECURE", true),
+	}
+
+	switch mode := strings.ToLower(getEnv("AUTH_MODE", "token")); mode {
+	case "token":
+	case "cookie":
+		cfg.Enabled = true
+	default:
+		return AuthCookieConfig{}, fmt.Errorf("invalid AUTH_MODE %q: must be token or cookie", mode)
+	}
+
+	switch sameSite := strings.ToLower(getEnv("AUTH_COOKIE_SAMESITE", "lax")); sameSite {
+	case "strict":
+		cfg.SameSite = http.SameSiteStrictMode
+	case "lax":
+		cfg.SameSite = http.SameSiteLaxMode
+	case "none":
+		// Browsers drop SameSite=None cookies that aren't Secure
+		if !cfg.Secure {
+			return AuthCookieConfig{}, fmt.Errorf("AUTH_COOKIE_SAMESITE=none needs AUTH_COOKIE_SECURE=true")
+		}
+		cfg.SameSite = http.SameSiteNoneMode
+	default:
+		return AuthCookieConfig{}, fmt.Errorf("invalid AUTH_COOKIE_SAMESITE %q: must be strict, lax or none", sameSite)
+	}
+
+	if cfg.Enabled && !cors.AllowCredentials {
+		log.Warn().Msg("AUTH_MODE=cookie with CORS_ALLOW_CREDENTIALS=false, so browsers on other origins won't send the refresh cookie")
+	}
+	return cfg, nil
+}
@@ -1,7 +1,9 @@
 package config
 
 import (
+	"compress/gzip"
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -13,23 +15,64 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server     ServerConfig
-	Database   DatabaseConfig
-	JWT        JWTConfig
-	CORS       CORSConfig
-	Logging    LoggingConfig
-	TLS        TLSConfig
-	Admin      AdminConfig
-	Editor     EditorConfig
-	Cloudinary CloudinaryConfig
-	NewsAPI    NewsAPIConfig
-	RSS        RSSConfig
+	Server        ServerConfig
+	Database      DatabaseConfig
+	JWT           JWTConfig
+	CORS          CORSConfig
+	AuthCookie    AuthCookieConfig
+	Security      SecurityHeadersConfig
+	Logging       LoggingConfig
+	TLS           TLSConfig
+	Admin         AdminConfig
+	Editor        EditorConfig
+	Cloudinary    CloudinaryConfig
+	Storage       StorageConfig
+	Uploads       UploadsConfig
+	NewsAPI       NewsAPIConfig
+	RSS           RSSConfig
+	NewsIngestion NewsIngestionConfig
+	RateLimit     RateLimitConfig
+	Users         UsersConfig
+	Pagination    PaginationConfig
+	PostLimits    PostLimitsConfig
+	Autosave      AutosaveConfig
+	Heartbeat     HeartbeatConfig
+	Scheduler     SchedulerConfig
+	Retention     NewsRetentionConfig
+	Search        SearchConfig
+	Compression   CompressionConfig
+	ImageCDN      ImageCDNConfig
+	Webhooks      WebhookConfig
+	SMTP          SMTPConfig
+	Newsletter    NewsletterConfig
+	CommentEmails CommentEmailConfig
+	Contact       ContactConfig
+	OGImage       OGImageConfig
+	Tracing       TracingConfig
+	Analytics     AnalyticsConfig
+	Spam          SpamConfig
+	Encryption    EncryptionConfig
+	Social        SocialConfig
+	LinkCheck     LinkCheckConfig
 }
 
 // ServerConfig holds all server-related configuration
 type ServerConfig struct {
 	Port    string
 	GinMode string
+	// Canary marks this instance as a canary during rollouts. It is reported in
+	// the X-API-Canary header, /api/v1/version and every log line.
+	Canary bool
+	// TrustedProxies are the IPs and CIDR ranges of the load balancers and
+	// proxies in front of the API. X-Forwarded-For and X-Real-IP are only
+	// believed for connections from them; none are trusted by default.
+	TrustedProxies []string
+	// LegacyDeprecatedAt is the date announced in the Deprecation header of
+	// requests to the unversioned /api paths, and LegacySunset the date in
+	// their Sunset header, after which they may stop working. No Sunset
+	// header is sent while LegacySunset is zero.
+	LegacyDeprecatedAt time.Time
+	LegacySunset       time.Time
 }
 
 // DatabaseConfig holds all database-related configuration
@@ -41,18 +84,33 @@ type DatabaseConfig struct {
 	Name     string
 	SSLMode  string
 	DSN      string // Connection string, computed from other fields
+	// MigrateOnStart applies pending migrations at startup. Without it the
+	// server refuses to start while migrations are pending.
+	MigrateOnStart bool
+	// StatementTimeout is the longest a single query may run before Postgres
+	// cancels it. Zero disables the limit.
+	StatementTimeout time.Duration
 }
 
+// JWTKey is a JWT signing secret with the key ID sent in the kid header
+type JWTKey struct {
+	ID     string
+	Secret string
+}
+
+// JWTConfigKeyID is the key ID of JWT_SECRET in the key ring
+const JWTConfigKeyID = "default"
+
+// maxJWTKeyIDLength matches the longest key ID the database stores
+const maxJWTKeyIDLength = 64
+
 // JWTConfig holds all JWT-related configuration
 type JWTConfig struct {
 	Secret        string
+	Keys          []JWTKey // Extra signing keys from JWT_KEYS, oldest first
 	AccessExpiry  time.Duration
 	RefreshExpiry time.Duration
-}
-
-// CORSConfig holds CORS configuration
-type CORSConfig struct {
-	AllowedOrigins []string
+	PreviewExpiry time.Duration // Lifetime of draft preview links
 }
 
 // LoggingConfig holds logging configuration
@@ -90,6 +148,49 @@ type CloudinaryConfig struct {
 	APIKey       string
 	APISecret    string
 	UploadFolder string
+	ImageFormat  string // Format of the resized image variants, e.g. webp
+	ImageQuality string // Quality of the resized image variants, e.g. auto or 80
+}
+
+// Storage backends for uploaded files
+const (
+	StorageBackendCloudinary = "cloudinary"
+	StorageBackendLocal      = "local"
+	StorageBackendS3         = "s3"
+)
+
+// StorageConfig holds configuration for where uploaded files are stored
+type StorageConfig struct {
+	Backend string // One of cloudinary, local or s3
+	Local   LocalStorageConfig
+	S3      S3StorageConfig
+}
+
+// LocalStorageConfig holds configuration for storing uploads on local disk
+type LocalStorageConfig struct {
+	Dir       string // Directory the files are written to
+	PublicURL string // URL prefix the files are served under, e.g. /uploads or https://cdn.example.com
+}
+
+// S3StorageConfig holds configuration for an S3-compatible object store such as MinIO
+type S3StorageConfig struct {
+	Endpoint  string // e.g. https://s3.eu-west-1.amazonaws.com or http://minio:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool   // Address the bucket in the path rather than the host name, as MinIO requires
+	PublicURL string // URL prefix objects are read from, defaults to the bucket URL
+}
+
+// UploadsConfig holds the checks applied to the content of uploaded files
+type UploadsConfig struct {
+	MaxImageWidth  int // Widest image accepted, in pixels
+	MaxImageHeight int // Tallest image accepted, in pixels
+	// ClamAVAddress is the clamd daemon uploads are scanned with, as host:port
+	// or a unix socket path. Scanning is disabled when it is empty.
+	ClamAVAddress string
+	ClamAVTimeout time.Duration
 }
 
 // NewsAPIConfig holds configuration for NewsAPI
@@ -101,7 +202,8 @@ type NewsAPIConfig struct {
 	EnableAutoFetch bool
 }
 
-// RSSFeed holds configuration for a single RSS feed
+// RSSFeed holds configuration for a single RSS feed. Feeds are news sources
+// in the database; RSS_FEEDS only seeds them on first start.
 type RSSFeed struct {
 	Name     string
 	URL      string
@@ -110,12 +212,242 @@ type RSSFeed struct {
 
 // RSSConfig holds configuration for RSS feeds
 type RSSConfig struct {
-	Feeds           []RSSFeed
+	Feeds []RSSFeed // Imported as news sources when there are none
+
 	DefaultLimit    int
 	FetchInterval   time.Duration
 	EnableAutoFetch bool
 }
 
+// NewsIngestionConfig holds configuration for storing fetched news
+type NewsIngestionConfig struct {
+	// KeywordTags is how many keywords extracted from the title and summary
+	// are tagged on fetched articles that come without tags; 0 disables it
+	KeywordTags int
+	// Languages are the languages fetched articles are stored in, as ISO
+	// 639-1 codes; articles detected in any other are skipped. Every
+	// language is stored when it is empty.
+	Languages []string
+}
+
+// RateLimitConfig holds rate limiting configuration
+type RateLimitConfig struct {
+	Store        string // "memory" or "redis"
+	RedisURL     string
+	Requests     int
+	Window       time.Duration
+	AuthRequests int
+	AuthWindow   time.Duration
+}
+
+// UsersConfig holds user account management configuration
+type UsersConfig struct {
+	DeletionUndoWindow time.Duration // How long a deleted user can be restored
+}
+
+// Ceilings of the post limits, enforced by database constraints. The
+// configured limits are capped at them.
+const (
+	PostTitleLengthCeiling   = 255
+	PostExcerptLengthCeiling = 2000
+	PostContentBytesCeiling  = 2 << 20 // 2 MiB
+)
+
+// PostLimitsConfig holds the size limits of posts, so a single huge post can't
+// bloat list responses and caches. Lengths are counted in characters.
+type PostLimitsConfig struct {
+	MaxTitleLength   int
+	MaxExcerptLength int
+	MaxContentBytes  int
+	MaxTags          int
+}
+
+// AutosaveConfig holds configuration for the draft snapshots the editor saves
+// while an author types
+type AutosaveConfig struct {
+	Enabled      bool
+	MaxSnapshots int           // Snapshots kept per user and post, oldest dropped first
+	MinInterval  time.Duration // A save this soon after the user's last snapshot replaces it
+}
+
+// PaginationConfig holds the page sizes of paginated lists
+type PaginationConfig struct {
+	DefaultLimit int // Page size when a request doesn't ask for one
+	MaxLimit     int // Largest page size a request may ask for
+}
+
+// HeartbeatConfig holds the external monitor ping URLs for background jobs.
+// A job without a URL is not monitored.
+type HeartbeatConfig struct {
+	URLs    map[string]string // Job name -> ping URL
+	Timeout time.Duration
+}
+
+// SchedulerConfig holds configuration for the scheduled post publisher
+type SchedulerConfig struct {
+	Interval time.Duration // How often due scheduled posts are published and expired posts taken down
+	// ExpiredStatus is the status posts get when their expiry date passes,
+	// "archived" or "draft"
+	ExpiredStatus string
+}
+
+// What the news retention job does with expired articles
+const (
+	NewsRetentionArchive = "archive"
+	NewsRetentionDelete  = "delete"
+)
+
+// NewsRetentionConfig holds configuration for expiring fetched news.
+// Retention is disabled when Days is 0.
+type NewsRetentionConfig struct {
+	Days     int           // Age in days after which fetched articles expire
+	Mode     string        // archive or delete
+	Interval time.Duration // How often the retention job runs
+}
+
+// SearchConfig holds configuration for the full-text search index
+type SearchConfig struct {
+	RefreshInterval time.Duration // How often the search index is rebuilt from the content
+}
+
+// CompressionConfig holds configuration for gzip response compression
+type CompressionConfig struct {
+	Enabled bool
+	MinSize int // Responses smaller than this many bytes are sent uncompressed
+	Level   int // gzip level, from 1 (fastest) to 9 (smallest)
+}
+
+// ImageCDNConfig holds configuration for rewriting Cloudinary image URLs in
+// API responses
+type ImageCDNConfig struct {
+	Enabled  bool
+	BaseURL  string // Replaces https://res.cloudinary.com/<cloud name>; empty keeps it
+	Optimize bool   // Adds f_auto and q_auto so Cloudinary picks the format and quality
+	MaxWidth int    // Largest width a ?width= hint may ask for
+}
+
+// WebhookConfig holds configuration for outgoing webhook deliveries
+type WebhookConfig struct {
+	Timeout      time.Duration // Timeout for a single delivery attempt
+	MaxAttempts  int           // Attempts per delivery, including the first
+	RetryBackoff time.Duration // Delay before the first retry, doubled for each further retry
+}
+
+// SMTPConfig holds configuration for sending email.
+// Email is disabled when Host is empty.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+// NewsletterConfig holds configuration for the newsletter. Subscribing needs
+// SMTP to be configured.
+type NewsletterConfig struct {
+	APIURL        string        // Public URL of the API, confirmation and unsubscribe links point at it
+	SiteURL       string        // Public URL of the blog, digests link to posts at SiteURL/posts/<slug>
+	ConfirmExpiry time.Duration // How long a confirmation link stays valid
+	DigestDays    int           // How many days of posts a digest covers by default
+}
+
+// CommentEmailConfig holds configuration for emailing users about comments on
+// their posts and replies to their comments. Links in the emails use the
+// newsletter URLs.
+type CommentEmailConfig struct {
+	Interval time.Duration // How often queued comment notifications are emailed
+}
+
+// Captcha providers the contact form can verify tokens with
+const (
+	CaptchaHCaptcha  = "hcaptcha"
+	CaptchaTurnstile = "turnstile"
+)
+
+// ContactConfig holds configuration for the contact form. Messages are
+// relayed to Recipient, which needs SMTP to be configured; the captcha check
+// is skipped when CaptchaProvider is empty.
+type ContactConfig struct {
+	Recipient        string // Address contact messages are relayed to
+	CaptchaProvider  string // hcaptcha, turnstile or empty
+	CaptchaSecret    string
+	CaptchaVerifyURL string // Token verification endpoint, defaults to the provider's
+	CaptchaTimeout   time.Duration
+}
+
+// OGImageConfig holds configuration for the share images generated for posts
+// when they are published
+type OGImageConfig struct {
+	Enabled  bool
+	SiteName string // Shown at the bottom of every image
+}
+
+// TracingConfig holds configuration for exporting OpenTelemetry traces.
+// Tracing is disabled when Endpoint is empty.
+type TracingConfig struct {
+	Endpoint      string            // OTLP/HTTP traces endpoint, e.g. http://collector:4318/v1/traces
+	Headers       map[string]string // Extra headers sent with every export, e.g. API keys
+	ServiceName   string
+	SampleRatio   float64       // Fraction of new traces that are recorded, between 0 and 1
+	ExportTimeout time.Duration // Timeout for a single export request
+	BatchDelay    time.Duration // How often queued spans are exported
+}
+
+// AnalyticsConfig holds configuration for reader analytics
+type AnalyticsConfig struct {
+	// PrivacyMode disables individual-level tracking for everyone, so only
+	// aggregated counts are stored
+	PrivacyMode bool
+	// RollupInterval is how often raw events are summed into daily post analytics
+	RollupInterval time.Duration
+	// EventRetention is how long raw events are kept after being rolled up
+	EventRetention time.Duration
+}
+
+// EncryptionConfig holds the master key that sensitive values such as webhook
+// and JWT signing secrets are encrypted with before they are stored
+type EncryptionConfig struct {
+	// Key is the master encryption key. Changing it makes secrets encrypted
+	// with the old key unreadable.
+	Key string
+}
+
+// SpamConfig holds the optional Akismet check of new comments. Akismet is
+// only consulted when both the key and the site URL are set.
+type SpamConfig struct {
+	AkismetKey     string
+	AkismetSiteURL string // Front page of the blog, as registered with Akismet
+	AkismetURL     string // Akismet API base URL
+	AkismetTimeout time.Duration
+}
+
+// SocialConfig holds configuration for cross-posting published posts to
+// social networks. A network is only posted to when its credentials are set;
+// shared links point at the newsletter site URL.
+type SocialConfig struct {
+	Interval     time.Duration // How often queued cross-posts are sent
+	Timeout      time.Duration // Timeout for a single request to a network
+	MaxAttempts  int           // Attempts per cross-post, including the first
+	RetryBackoff time.Duration // Delay before the first retry, doubled for each further retry
+
+	XAccessToken        string // OAuth 2.0 user access token with the tweet.write scope
+	TelegramBotToken    string
+	TelegramChatID      string // Channel username (@channel) or numeric chat ID
+	LinkedInAccessToken string // Access token with the w_member_social or w_organization_social scope
+	LinkedInAuthor      string // URN of the member or organization posting, e.g. urn:li:organization:123
+}
+
+// LinkCheckConfig holds configuration for checking the outbound links in
+// published posts and news articles
+type LinkCheckConfig struct {
+	Interval    time.Duration // How often links are checked
+	Timeout     time.Duration // Timeout for checking a single link
+	DomainDelay time.Duration // Pause between requests to the same domain
+	BatchSize   int           // Links checked per run, those checked longest ago first
+}
+
 // Load loads the configuration from environment variables or .env file
 func Load(_ string) (*Config, error) {
 	// Skip .env file loading in containerized environments (including Railway)
@@ -138,19 +470,44 @@ func Load(_ string) (*Config, error) {
 	config := &Config{}
 
 	// Load server config
+	trustedProxies, err := parseTrustedProxies(getEnv("TRUSTED_PROXIES", ""))
+	if err != nil {
+		return nil, err
+	}
+	legacyDeprecatedAt, err := time.Parse(time.DateOnly, getEnv("API_LEGACY_DEPRECATED_AT", "2026-10-15"))
+	if err != nil {
+		legacyDeprecatedAt = time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC) // Default to the release of /api/v1 if invalid
+	}
+	var legacySunset time.Time
+	if value := getEnv("API_LEGACY_SUNSET", ""); value != "" {
+		if legacySunset, err = time.Parse(time.DateOnly, value); err != nil {
+			return nil, fmt.Errorf("invalid API_LEGACY_SUNSET %q, expected a date such as 2027-04-01: %w", value, err)
+		}
+	}
 	config.Server = ServerConfig{
-		Port:    getEnv("API_PORT", "9876"),
-		GinMode: getEnv("GIN_MODE", "debug"),
+		Port:               getEnv("API_PORT", "9876"),
+		GinMode:            getEnv("GIN_MODE", "debug"),
+		Canary:             GetEnvBool("API_CANARY", false),
+		TrustedProxies:     trustedProxies,
+		LegacyDeprecatedAt: legacyDeprecatedAt,
+		LegacySunset:       legacySunset,
 	}
 
 	// Load database config
+	statementTimeout, err := time.ParseDuration(getEnv("DB_STATEMENT_TIMEOUT", "30s"))
+	if err != nil || statementTimeout < 0 {
+		statementTimeout = 30 * time.Second // Default to 30 seconds if invalid
+	}
+
 	dbConfig := DatabaseConfig{
-		Host:     getEnv("DB_HOST", ""),
-		Port:     getEnv("DB_PORT", "5432"),
-		User:     getEnv("DB_USER", ""),
-		Password: getEnv("DB_PASS", ""),
-		Name:     getEnv("DB_NAME", "blog_db"),
-		SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+		Host:             getEnv("DB_HOST", ""),
+		Port:             getEnv("DB_PORT", "5432"),
+		User:             getEnv("DB_USER", ""),
+		Password:         getEnv("DB_PASS", ""),
+		Name:             getEnv("DB_NAME", "blog_db"),
+		SSLMode:          getEnv("DB_SSL_MODE", "disable"),
+		MigrateOnStart:   GetEnvBool("DB_MIGRATE_ON_START", false),
+		StatementTimeout: statementTimeout,
 	}
 
 	// Initial DSN construction (may be overridden in ValidateWithFallbacks)
@@ -176,22 +533,44 @@ func Load(_ string) (*Config, error) {
 		return nil, fmt.Errorf("invalid JWT_REFRESH_EXPIRY: %w", err)
 	}
 
+	previewExpiry, err := time.ParseDuration(getEnv("JWT_PREVIEW_EXPIRY", "72h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT_PREVIEW_EXPIRY: %w", err)
+	}
+
+	jwtKeys, err := parseJWTKeys(getEnv("JWT_KEYS", ""))
+	if err != nil {
+		return nil, err
+	}
+
 	config.JWT = JWTConfig{
 		Secret:        getEnv("JWT_SECRET", ""),
+		Keys:          jwtKeys,
 		AccessExpiry:  accessExpiry,
 		RefreshExpiry: refreshExpiry,
+		PreviewExpiry: previewExpiry,
 	}
 
 	// Load CORS config
-	corsOrigins := getEnv("CORS_ALLOWED_ORIGINS", "*")
-	origins := strings.Split(corsOrigins, ",")
-	for i, origin := range origins {
-		origins[i] = strings.TrimSpace(origin)
+	corsConfig, err := loadCORSConfig(config.Server.GinMode)
+	if err != nil {
+		return nil, err
 	}
+	config.CORS = corsConfig
 
-	config.CORS = CORSConfig{
-		AllowedOrigins: origins,
+	// Load cookie session config
+	authCookieConfig, err := loadAuthCookieConfig(corsConfig)
+	if err != nil {
+		return nil, err
 	}
+	config.AuthCookie = authCookieConfig
+
+	// Load security headers config
+	securityConfig, err := loadSecurityHeadersConfig(config.Server.GinMode)
+	if err != nil {
+		return nil, err
+	}
+	config.Security = securityConfig
 
 	// Load logging config
 	config.Logging = LoggingConfig{
@@ -230,6 +609,54 @@ func Load(_ string) (*Config, error) {
 		APIKey:       getEnv("CLOUDINARY_API_KEY", ""),
 		APISecret:    getEnv("CLOUDINARY_API_SECRET", ""),
 		UploadFolder: getEnv("CLOUDINARY_UPLOAD_FOLDER", "blog_images"),
+		ImageFormat:  getEnv("CLOUDINARY_IMAGE_FORMAT", "webp"),
+		ImageQuality: getEnv("CLOUDINARY_IMAGE_QUALITY", "auto"),
+	}
+
+	// Load storage config
+	config.Storage = StorageConfig{
+		Backend: strings.ToLower(getEnv("STORAGE_BACKEND", StorageBackendCloudinary)),
+		Local: LocalStorageConfig{
+			Dir:       getEnv("STORAGE_LOCAL_DIR", "./uploads"),
+			PublicURL: strings.TrimSuffix(getEnv("STORAGE_LOCAL_PUBLIC_URL", "/uploads"), "/"),
+		},
+		S3: S3StorageConfig{
+			Endpoint:  strings.TrimSuffix(getEnv("S3_ENDPOINT", ""), "/"),
+			Region:    getEnv("S3_REGION", "us-east-1"),
+			Bucket:    getEnv("S3_BUCKET", ""),
+			AccessKey: getEnv("S3_ACCESS_KEY", ""),
+			SecretKey: getEnv("S3_SECRET_KEY", ""),
+			PathStyle: GetEnvBool("S3_PATH_STYLE", false),
+			PublicURL: strings.TrimSuffix(getEnv("S3_PUBLIC_URL", ""), "/"),
+		},
+	}
+	switch config.Storage.Backend {
+	case StorageBackendCloudinary, StorageBackendLocal, StorageBackendS3:
+	default:
+		return nil, fmt.Errorf("invalid STORAGE_BACKEND %q: must be cloudinary, local or s3", config.Storage.Backend)
+	}
+
+	// Load upload checks config
+	maxImageWidth, err := strconv.Atoi(getEnv("UPLOAD_MAX_IMAGE_WIDTH", "8000"))
+	if err != nil || maxImageWidth <= 0 {
+		maxImageWidth = 8000 // Default to 8000 pixels if invalid
+	}
+
+	maxImageHeight, err := strconv.Atoi(getEnv("UPLOAD_MAX_IMAGE_HEIGHT", "8000"))
+	if err != nil || maxImageHeight <= 0 {
+		maxImageHeight = 8000 // Default to 8000 pixels if invalid
+	}
+
+	clamAVTimeout, err := time.ParseDuration(getEnv("CLAMAV_TIMEOUT", "30s"))
+	if err != nil || clamAVTimeout <= 0 {
+		clamAVTimeout = 30 * time.Second // Default to 30 seconds if invalid
+	}
+
+	config.Uploads = UploadsConfig{
+		MaxImageWidth:  maxImageWidth,
+		MaxImageHeight: maxImageHeight,
+		ClamAVAddress:  getEnv("CLAMAV_ADDRESS", ""),
+		ClamAVTimeout:  clamAVTimeout,
 	}
 
 	// Load NewsAPI config
@@ -292,6 +719,460 @@ func Load(_ string) (*Config, error) {
 		EnableAutoFetch: GetEnvBool("RSS_ENABLE_AUTO_FETCH", false),
 	}
 
+	// Load news ingestion config
+	keywordTags, err := strconv.Atoi(getEnv("NEWS_KEYWORD_TAGS", "5"))
+	if err != nil || keywordTags < 0 {
+		keywordTags = 5 // Default to 5 if invalid
+	}
+
+	config.NewsIngestion = NewsIngestionConfig{
+		KeywordTags: keywordTags,
+		Languages:   parseNewsLanguages(getEnv("NEWS_LANGUAGES", "en,vi")),
+	}
+
+	// Load rate limit config
+	rateLimitRequests, err := strconv.Atoi(getEnv("RATE_LIMIT_REQUESTS", "100"))
+	if err != nil {
+		rateLimitRequests = 100 // Default to 100 if invalid
+	}
+
+	rateLimitWindow, err := time.ParseDuration(getEnv("RATE_LIMIT_WINDOW", "1m"))
+	if err != nil {
+		rateLimitWindow = time.Minute // Default to 1 minute if invalid
+	}
+
+	authRateLimitRequests, err := strconv.Atoi(getEnv("RATE_LIMIT_AUTH_REQUESTS", "20"))
+	if err != nil {
+		authRateLimitRequests = 20 // Default to 20 if invalid
+	}
+
+	authRateLimitWindow, err := time.ParseDuration(getEnv("RATE_LIMIT_AUTH_WINDOW", "1m"))
+	if err != nil {
+		authRateLimitWindow = time.Minute // Default to 1 minute if invalid
+	}
+
+	config.RateLimit = RateLimitConfig{
+		Store:        strings.ToLower(getEnv("RATE_LIMIT_STORE", "memory")),
+		RedisURL:     getEnv("REDIS_URL", ""),
+		Requests:     rateLimitRequests,
+		Window:       rateLimitWindow,
+		AuthRequests: authRateLimitRequests,
+		AuthWindow:   authRateLimitWindow,
+	}
+
+	// Load users config
+	deletionUndoWindow, err := time.ParseDuration(getEnv("USER_DELETION_UNDO_WINDOW", "72h"))
+	if err != nil {
+		deletionUndoWindow = 72 * time.Hour // Default to 3 days if invalid
+	}
+
+	config.Users = UsersConfig{
+		DeletionUndoWindow: deletionUndoWindow,
+	}
+
+	// Load pagination config
+	defaultPageLimit, err := strconv.Atoi(getEnv("PAGINATION_DEFAULT_LIMIT", "10"))
+	if err != nil || defaultPageLimit < 1 {
+		defaultPageLimit = 10 // Default to 10 if invalid
+	}
+
+	maxPageLimit, err := strconv.Atoi(getEnv("PAGINATION_MAX_LIMIT", "50"))
+	if err != nil || maxPageLimit < 1 {
+		maxPageLimit = 50 // Default to 50 if invalid
+	}
+	if defaultPageLimit > maxPageLimit {
+		defaultPageLimit = maxPageLimit
+	}
+
+	config.Pagination = PaginationConfig{
+		DefaultLimit: defaultPageLimit,
+		MaxLimit:     maxPageLimit,
+	}
+
+	// Load post limits, capped at the ceilings the database enforces
+	maxTitleLength, err := strconv.Atoi(getEnv("POST_MAX_TITLE_LENGTH", "200"))
+	if err != nil || maxTitleLength < 1 {
+		maxTitleLength = 200 // Default to 200 if invalid
+	}
+	maxExcerptLength, err := strconv.Atoi(getEnv("POST_MAX_EXCERPT_LENGTH", "500"))
+	if err != nil || maxExcerptLength < 1 {
+		maxExcerptLength = 500 // Default to 500 if invalid
+	}
+	maxContentBytes, err := strconv.Atoi(getEnv("POST_MAX_CONTENT_BYTES", "262144"))
+	if err != nil || maxContentBytes < 1 {
+		maxContentBytes = 256 << 10 // Default to 256 KiB if invalid
+	}
+	maxTags, err := strconv.Atoi(getEnv("POST_MAX_TAGS", "10"))
+	if err != nil || maxTags < 1 {
+		maxTags = 10 // Default to 10 if invalid
+	}
+	config.PostLimits = PostLimitsConfig{
+		MaxTitleLength:   min(maxTitleLength, PostTitleLengthCeiling),
+		MaxExcerptLength: min(maxExcerptLength, PostExcerptLengthCeiling),
+		MaxContentBytes:  min(maxContentBytes, PostContentBytesCeiling),
+		MaxTags:          maxTags,
+	}
+
+	// Load autosave config
+	autosaveMaxSnapshots, err := strconv.Atoi(getEnv("AUTOSAVE_MAX_SNAPSHOTS", "20"))
+	if err != nil || autosaveMaxSnapshots < 1 {
+		autosaveMaxSnapshots = 20 // Default to 20 if invalid
+	}
+	autosaveMinInterval, err := time.ParseDuration(getEnv("AUTOSAVE_MIN_INTERVAL", "30s"))
+	if err != nil || autosaveMinInterval < 0 {
+		autosaveMinInterval = 30 * time.Second // Default to 30 seconds if invalid
+	}
+	config.Autosave = AutosaveConfig{
+		Enabled:      GetEnvBool("AUTOSAVE_ENABLED", true),
+		MaxSnapshots: autosaveMaxSnapshots,
+		MinInterval:  autosaveMinInterval,
+	}
+
+	// Load heartbeat config
+	heartbeatTimeout, err := time.ParseDuration(getEnv("HEARTBEAT_TIMEOUT", "10s"))
+	if err != nil {
+		heartbeatTimeout = 10 * time.Second // Default to 10 seconds if invalid
+	}
+
+	heartbeatURLs := make(map[string]string)
+	for job, envKey := range map[string]string{
+		"news_fetch":       "HEARTBEAT_NEWS_FETCH_URL",
+		"rss_fetch":        "HEARTBEAT_RSS_FETCH_URL",
+		"token_cleanup":    "HEARTBEAT_TOKEN_CLEANUP_URL",
+		"digest":           "HEARTBEAT_DIGEST_URL",
+		"news_retention":   "HEARTBEAT_NEWS_RETENTION_URL",
+		"search_index":     "HEARTBEAT_SEARCH_INDEX_URL",
+		"post_scheduler":   "HEARTBEAT_POST_SCHEDULER_URL",
+		"comment_emails":   "HEARTBEAT_COMMENT_EMAILS_URL",
+		"analytics_rollup": "HEARTBEAT_ANALYTICS_ROLLUP_URL",
+		"cross_posting":    "HEARTBEAT_CROSS_POSTING_URL",
+		"link_check":       "HEARTBEAT_LINK_CHECK_URL",
+	} {
+		if url := getEnv(envKey, ""); url != "" {
+			heartbeatURLs[job] = url
+		}
+	}
+
+	config.Heartbeat = HeartbeatConfig{
+		URLs:    heartbeatURLs,
+		Timeout: heartbeatTimeout,
+	}
+
+	// Load scheduler config
+	schedulerInterval, err := time.ParseDuration(getEnv("POST_SCHEDULER_INTERVAL", "1m"))
+	if err != nil || schedulerInterval <= 0 {
+		schedulerInterval = time.Minute // Default to 1 minute if invalid
+	}
+
+	expiredStatus := strings.ToLower(getEnv("POST_EXPIRED_STATUS", "archived"))
+	if expiredStatus != "archived" && expiredStatus != "draft" {
+		return nil, fmt.Errorf("invalid POST_EXPIRED_STATUS %q: must be archived or draft", expiredStatus)
+	}
+
+	config.Scheduler = SchedulerConfig{
+		Interval:      schedulerInterval,
+		ExpiredStatus: expiredStatus,
+	}
+
+	// Load news retention config
+	retentionDays, err := strconv.Atoi(getEnv("NEWS_RETENTION_DAYS", "0"))
+	if err != nil || retentionDays < 0 {
+		return nil, fmt.Errorf("invalid NEWS_RETENTION_DAYS: must be a whole number of days, or 0 to keep news forever")
+	}
+
+	retentionInterval, err := time.ParseDuration(getEnv("NEWS_RETENTION_INTERVAL", "24h"))
+	if err != nil || retentionInterval <= 0 {
+		retentionInterval = 24 * time.Hour // Default to daily if invalid
+	}
+
+	config.Retention = NewsRetentionConfig{
+		Days:     retentionDays,
+		Mode:     strings.ToLower(getEnv("NEWS_RETENTION_MODE", NewsRetentionArchive)),
+		Interval: retentionInterval,
+	}
+	if config.Retention.Mode != NewsRetentionArchive && config.Retention.Mode != NewsRetentionDelete {
+		return nil, fmt.Errorf("invalid NEWS_RETENTION_MODE %q: must be archive or delete", config.Retention.Mode)
+	}
+
+	// Load search config
+	searchRefreshInterval, err := time.ParseDuration(getEnv("SEARCH_REFRESH_INTERVAL", "5m"))
+	if err != nil || searchRefreshInterval <= 0 {
+		searchRefreshInterval = 5 * time.Minute // Default to 5 minutes if invalid
+	}
+
+	config.Search = SearchConfig{
+		RefreshInterval: searchRefreshInterval,
+	}
+
+	// Load compression config
+	compressionMinSize, err := strconv.Atoi(getEnv("COMPRESSION_MIN_SIZE", "1024"))
+	if err != nil || compressionMinSize < 0 {
+		compressionMinSize = 1024 // Default to 1 KB if invalid
+	}
+
+	compressionLevel, err := strconv.Atoi(getEnv("COMPRESSION_LEVEL", "5"))
+	if err != nil || compressionLevel < gzip.BestSpeed || compressionLevel > gzip.BestCompression {
+		compressionLevel = 5 // Default to a balance of speed and size if invalid
+	}
+
+	config.Compression = CompressionConfig{
+		Enabled: GetEnvBool("COMPRESSION_ENABLED", true),
+		MinSize: compressionMinSize,
+		Level:   compressionLevel,
+	}
+
+	// Load image CDN config
+	imageCDNMaxWidth, err := strconv.Atoi(getEnv("IMAGE_CDN_MAX_WIDTH", "2000"))
+	if err != nil || imageCDNMaxWidth <= 0 {
+		imageCDNMaxWidth = 2000 // Default to 2000 pixels if invalid
+	}
+
+	config.ImageCDN = ImageCDNConfig{
+		Enabled:  GetEnvBool("IMAGE_CDN_ENABLED", false),
+		BaseURL:  strings.TrimRight(getEnv("IMAGE_CDN_BASE_URL", ""), "/"),
+		Optimize: GetEnvBool("IMAGE_CDN_OPTIMIZE", true),
+		MaxWidth: imageCDNMaxWidth,
+	}
+
+	// Load webhook config
+	webhookTimeout, err := time.ParseDuration(getEnv("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil {
+		webhookTimeout = 10 * time.Second // Default to 10 seconds if invalid
+	}
+
+	webhookMaxAttempts, err := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "5"))
+	if err != nil || webhookMaxAttempts < 1 {
+		webhookMaxAttempts = 5 // Default to 5 if invalid
+	}
+
+	webhookRetryBackoff, err := time.ParseDuration(getEnv("WEBHOOK_RETRY_BACKOFF", "10s"))
+	if err != nil {
+		webhookRetryBackoff = 10 * time.Second // Default to 10 seconds if invalid
+	}
+
+	config.Webhooks = WebhookConfig{
+		Timeout:      webhookTimeout,
+		MaxAttempts:  webhookMaxAttempts,
+		RetryBackoff: webhookRetryBackoff,
+	}
+
+	// Load SMTP config
+	smtpPort, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	if err != nil {
+		smtpPort = 587 // Default to 587 if invalid
+	}
+
+	smtpTimeout, err := time.ParseDuration(getEnv("SMTP_TIMEOUT", "10s"))
+	if err != nil {
+		smtpTimeout = 10 * time.Second // Default to 10 seconds if invalid
+	}
+
+	config.SMTP = SMTPConfig{
+		Host:     getEnv("SMTP_HOST", ""),
+		Port:     smtpPort,
+		Username: getEnv("SMTP_USERNAME", ""),
+		Password: getEnv("SMTP_PASSWORD", ""),
+		From:     getEnv("SMTP_FROM", ""),
+		Timeout:  smtpTimeout,
+	}
+
+	// Load newsletter config
+	newsletterConfirmExpiry, err := time.ParseDuration(getEnv("NEWSLETTER_CONFIRM_EXPIRY", "48h"))
+	if err != nil || newsletterConfirmExpiry <= 0 {
+		newsletterConfirmExpiry = 48 * time.Hour // Default to 48 hours if invalid
+	}
+
+	newsletterDigestDays, err := strconv.Atoi(getEnv("NEWSLETTER_DIGEST_DAYS", "7"))
+	if err != nil || newsletterDigestDays <= 0 {
+		newsletterDigestDays = 7 // Default to a week if invalid
+	}
+
+	config.Newsletter = NewsletterConfig{
+		APIURL:        strings.TrimSuffix(getEnv("NEWSLETTER_API_URL", "http://localhost:"+config.Server.Port+"/api/v1"), "/"),
+		SiteURL:       strings.TrimSuffix(getEnv("NEWSLETTER_SITE_URL", "http://localhost:3000"), "/"),
+		ConfirmExpiry: newsletterConfirmExpiry,
+		DigestDays:    newsletterDigestDays,
+	}
+
+	// Load comment email config
+	commentEmailInterval, err := time.ParseDuration(getEnv("COMMENT_EMAIL_INTERVAL", "5m"))
+	if err != nil || commentEmailInterval <= 0 {
+		commentEmailInterval = 5 * time.Minute // Default to 5 minutes if invalid
+	}
+
+	config.CommentEmails = CommentEmailConfig{
+		Interval: commentEmailInterval,
+	}
+
+	// Load tracing config, following the standard OpenTelemetry variable names
+	tracingEndpoint := getEnv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	if tracingEndpoint == "" {
+		if base := getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""); base != "" {
+			tracingEndpoint = strings.TrimSuffix(base, "/") + "/v1/traces"
+		}
+	}
+	if GetEnvBool("OTEL_SDK_DISABLED", false) {
+		tracingEndpoint = ""
+	}
+
+	tracingHeaders := make(map[string]string)
+	for _, pair := range strings.Split(getEnv("OTEL_EXPORTER_OTLP_HEADERS", ""), ",") {
+		if key, value, ok := strings.Cut(pair, "="); ok && strings.TrimSpace(key) != "" {
+			tracingHeaders[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	tracingSampleRatio, err := strconv.ParseFloat(getEnv("OTEL_TRACES_SAMPLER_ARG", "1.0"), 64)
+	if err != nil || tracingSampleRatio < 0 || tracingSampleRatio > 1 {
+		tracingSampleRatio = 1.0 // Default to recording every trace if invalid
+	}
+
+	tracingTimeout, err := strconv.Atoi(getEnv("OTEL_EXPORTER_OTLP_TIMEOUT", "10000"))
+	if err != nil || tracingTimeout <= 0 {
+		tracingTimeout = 10000 // Default to 10 seconds if invalid
+	}
+
+	tracingBatchDelay, err := strconv.Atoi(getEnv("OTEL_BSP_SCHEDULE_DELAY", "5000"))
+	if err != nil || tracingBatchDelay <= 0 {
+		tracingBatchDelay = 5000 // Default to 5 seconds if invalid
+	}
+
+	config.Tracing = TracingConfig{
+		Endpoint:      tracingEndpoint,
+		Headers:       tracingHeaders,
+		ServiceName:   getEnv("OTEL_SERVICE_NAME", "taiphanvan-api"),
+		SampleRatio:   tracingSampleRatio,
+		ExportTimeout: time.Duration(tracingTimeout) * time.Millisecond,
+		BatchDelay:    time.Duration(tracingBatchDelay) * time.Millisecond,
+	}
+
+	// Load analytics config
+	analyticsRollupInterval, err := time.ParseDuration(getEnv("ANALYTICS_ROLLUP_INTERVAL", "15m"))
+	if err != nil || analyticsRollupInterval <= 0 {
+		analyticsRollupInterval = 15 * time.Minute // Default to 15 minutes if invalid
+	}
+	analyticsEventRetention, err := time.ParseDuration(getEnv("ANALYTICS_EVENT_RETENTION", "720h"))
+	if err != nil || analyticsEventRetention < 48*time.Hour {
+		analyticsEventRetention = 720 * time.Hour // Default to 30 days if invalid or shorter than the rollup window
+	}
+	config.Analytics = AnalyticsConfig{
+		PrivacyMode:    GetEnvBool("ANALYTICS_PRIVACY_MODE", false),
+		RollupInterval: analyticsRollupInterval,
+		EventRetention: analyticsEventRetention,
+	}
+
+	// Load spam protection config
+	akismetTimeout, err := time.ParseDuration(getEnv("AKISMET_TIMEOUT", "5s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid AKISMET_TIMEOUT: %w", err)
+	}
+	config.Spam = SpamConfig{
+		AkismetKey:     getEnv("AKISMET_API_KEY", ""),
+		AkismetSiteURL: strings.TrimSuffix(getEnv("AKISMET_SITE_URL", ""), "/"),
+		AkismetURL:     strings.TrimSuffix(getEnv("AKISMET_URL", "https://rest.akismet.com"), "/"),
+		AkismetTimeout: akismetTimeout,
+	}
+
+	// Load encryption config
+	config.Encryption = EncryptionConfig{
+		Key: getEnv("ENCRYPTION_KEY", ""),
+	}
+
+	// Load social cross-posting config
+	socialInterval, err := time.ParseDuration(getEnv("SOCIAL_INTERVAL", "1m"))
+	if err != nil || socialInterval <= 0 {
+		socialInterval = time.Minute // Default to 1 minute if invalid
+	}
+
+	socialTimeout, err := time.ParseDuration(getEnv("SOCIAL_TIMEOUT", "10s"))
+	if err != nil || socialTimeout <= 0 {
+		socialTimeout = 10 * time.Second // Default to 10 seconds if invalid
+	}
+
+	socialMaxAttempts, err := strconv.Atoi(getEnv("SOCIAL_MAX_ATTEMPTS", "5"))
+	if err != nil || socialMaxAttempts < 1 {
+		socialMaxAttempts = 5 // Default to 5 if invalid
+	}
+
+	socialRetryBackoff, err := time.ParseDuration(getEnv("SOCIAL_RETRY_BACKOFF", "1m"))
+	if err != nil || socialRetryBackoff <= 0 {
+		socialRetryBackoff = time.Minute // Default to 1 minute if invalid
+	}
+
+	config.Social = SocialConfig{
+		Interval:            socialInterval,
+		Timeout:             socialTimeout,
+		MaxAttempts:         socialMaxAttempts,
+		RetryBackoff:        socialRetryBackoff,
+		XAccessToken:        getEnv("SOCIAL_X_ACCESS_TOKEN", ""),
+		TelegramBotToken:    getEnv("SOCIAL_TELEGRAM_BOT_TOKEN", ""),
+		TelegramChatID:      getEnv("SOCIAL_TELEGRAM_CHAT_ID", ""),
+		LinkedInAccessToken: getEnv("SOCIAL_LINKEDIN_ACCESS_TOKEN", ""),
+		LinkedInAuthor:      getEnv("SOCIAL_LINKEDIN_AUTHOR_URN", ""),
+	}
+
+	// Load link checker config
+	linkCheckInterval, err := time.ParseDuration(getEnv("LINK_CHECK_INTERVAL", "24h"))
+	if err != nil || linkCheckInterval <= 0 {
+		linkCheckInterval = 24 * time.Hour // Default to 24 hours if invalid
+	}
+
+	linkCheckTimeout, err := time.ParseDuration(getEnv("LINK_CHECK_TIMEOUT", "10s"))
+	if err != nil || linkCheckTimeout <= 0 {
+		linkCheckTimeout = 10 * time.Second // Default to 10 seconds if invalid
+	}
+
+	linkCheckDomainDelay, err := time.ParseDuration(getEnv("LINK_CHECK_DOMAIN_DELAY", "2s"))
+	if err != nil || linkCheckDomainDelay < 0 {
+		linkCheckDomainDelay = 2 * time.Second // Default to 2 seconds if invalid
+	}
+
+	linkCheckBatchSize, err := strconv.Atoi(getEnv("LINK_CHECK_BATCH_SIZE", "500"))
+	if err != nil || linkCheckBatchSize < 1 {
+		linkCheckBatchSize = 500 // Default to 500 if invalid
+	}
+
+	config.LinkCheck = LinkCheckConfig{
+		Interval:    linkCheckInterval,
+		Timeout:     linkCheckTimeout,
+		DomainDelay: linkCheckDomainDelay,
+		BatchSize:   linkCheckBatchSize,
+	}
+
+	// Load contact form config
+	captchaProvider := strings.ToLower(getEnv("CONTACT_CAPTCHA_PROVIDER", ""))
+	captchaVerifyURL := getEnv("CONTACT_CAPTCHA_VERIFY_URL", "")
+	switch captchaProvider {
+	case "":
+	case CaptchaHCaptcha:
+		if captchaVerifyURL == "" {
+			captchaVerifyURL = "https://api.hcaptcha.com/siteverify"
+		}
+	case CaptchaTurnstile:
+		if captchaVerifyURL == "" {
+			captchaVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+		}
+	default:
+		return nil, fmt.Errorf("invalid CONTACT_CAPTCHA_PROVIDER %q: must be hcaptcha or turnstile", captchaProvider)
+	}
+	captchaTimeout, err := time.ParseDuration(getEnv("CONTACT_CAPTCHA_TIMEOUT", "5s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CONTACT_CAPTCHA_TIMEOUT: %w", err)
+	}
+	config.Contact = ContactConfig{
+		Recipient:        getEnv("CONTACT_EMAIL", config.Admin.Email),
+		CaptchaProvider:  captchaProvider,
+		CaptchaSecret:    getEnv("CONTACT_CAPTCHA_SECRET", ""),
+		CaptchaVerifyURL: captchaVerifyURL,
+		CaptchaTimeout:   captchaTimeout,
+	}
+
+	// Load share image config
+	config.OGImage = OGImageConfig{
+		Enabled:  GetEnvBool("OG_IMAGE_ENABLED", true),
+		SiteName: getEnv("OG_IMAGE_SITE_NAME", "TaiPhanVan Blog"),
+	}
+
 	// Validate configuration and apply environment-specific fallbacks
 	if err := config.ValidateWithFallbacks(); err != nil {
 		return nil, err
@@ -300,6 +1181,62 @@ func Load(_ string) (*Config, error) {
 	return config, nil
 }
 
+// parseJWTKeys parses JWT_KEYS, a comma-separated list of kid:secret pairs
+// ordered from oldest to newest
+func parseJWTKeys(value string) ([]JWTKey, error) {
+	var keys []JWTKey
+	seen := map[string]bool{JWTConfigKeyID: true}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(entry, ":")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" || secret == "" {
+			return nil, fmt.Errorf("invalid JWT_KEYS: every entry must be kid:secret")
+		}
+		if len(id) > maxJWTKeyIDLength {
+			return nil, fmt.Errorf("invalid JWT_KEYS: key ID %q is longer than %d characters", id, maxJWTKeyIDLength)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("invalid JWT_KEYS: key ID %q is used more than once or is reserved", id)
+		}
+		seen[id] = true
+		keys = append(keys, JWTKey{ID: id, Secret: secret})
+	}
+	return keys, nil
+}
+
+// parseTrustedProxies parses TRUSTED_PROXIES, a comma-separated list of IP
+// addresses and CIDR ranges
+func parseTrustedProxies(value string) ([]string, error) {
+	var proxies []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(entry); err != nil && net.ParseIP(entry) == nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %q is not an IP address or CIDR range", entry)
+		}
+		proxies = append(proxies, entry)
+	}
+	return proxies, nil
+}
+
+// parseNewsLanguages parses NEWS_LANGUAGES, a comma-separated list of
+// language codes
+func parseNewsLanguages(value string) []string {
+	var languages []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); entry != "" {
+			languages = append(languages, entry)
+		}
+	}
+	return languages
+}
+
 // constructDSN creates a PostgreSQL connection string from individual parameters
 func constructDSN(host, port, user, password, dbname, sslmode string) string {
 	dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s sslmode=%s",
@@ -357,7 +1294,7 @@ func (c *Config) ValidateWithFallbacks() error {
 		}
 
 		// For Railway, ensure we have a JWT secret
-		if c.JWT.Secret == "" {
+		if c.JWT.Secret == "" && len(c.JWT.Keys) == 0 {
 			log.Warn().Msg("No JWT_SECRET provided on Railway. Using a generated secret. It's recommended to set a persistent JWT_SECRET.")
 			c.JWT.Secret = generateTemporarySecret()
 		}
@@ -395,7 +1332,12 @@ func (c *Config) ValidateWithFallbacks() error {
 	}
 
 	// JWT validation with better fallback for development
-	if c.JWT.Secret == "" {
+	for _, key := range c.JWT.Keys {
+		if len(key.Secret) < 32 && os.Getenv("GIN_MODE") == "release" {
+			return fmt.Errorf("JWT_KEYS secret %q should be at least 32 characters long in production mode", key.ID)
+		}
+	}
+	if c.JWT.Secret == "" && len(c.JWT.Keys) == 0 {
 		// In development or container, we can use a default for convenience
 		if os.Getenv("GIN_MODE") != "release" || isContainer {
 			log.Warn().Msg("No JWT_SECRET provided. Using a default secret for development. DO NOT USE IN PRODUCTION!")
@@ -403,10 +1345,25 @@ func (c *Config) ValidateWithFallbacks() error {
 		} else {
 			return fmt.Errorf("JWT_SECRET is required in production mode")
 		}
-	} else if len(c.JWT.Secret) < 32 && os.Getenv("GIN_MODE") == "release" {
+	} else if c.JWT.Secret != "" && len(c.JWT.Secret) < 32 && os.Getenv("GIN_MODE") == "release" {
 		return fmt.Errorf("JWT_SECRET should be at least 32 characters long in production mode")
 	}
 
+	// Stored secrets stay readable without a key, just not encrypted
+	if c.Encryption.Key == "" && os.Getenv("GIN_MODE") == "release" {
+		log.Warn().Msg("No ENCRYPTION_KEY provided. Webhook and JWT signing secrets are stored unencrypted.")
+	} else if c.Encryption.Key != "" && len(c.Encryption.Key) < 32 && os.Getenv("GIN_MODE") == "release" {
+		return fmt.Errorf("ENCRYPTION_KEY should be at least 32 characters long in production mode")
+	}
+
+	// A social network is only posted to when all of its credentials are set
+	if (c.Social.TelegramBotToken == "") != (c.Social.TelegramChatID == "") {
+		log.Warn().Msg("Only one of SOCIAL_TELEGRAM_BOT_TOKEN and SOCIAL_TELEGRAM_CHAT_ID is set, Telegram cross-posting is disabled")
+	}
+	if (c.Social.LinkedInAccessToken == "") != (c.Social.LinkedInAuthor == "") {
+		log.Warn().Msg("Only one of SOCIAL_LINKEDIN_ACCESS_TOKEN and SOCIAL_LINKEDIN_AUTHOR_URN is set, LinkedIn cross-posting is disabled")
+	}
+
 	// Reconstruct DSN with updated values
 	c.Database.DSN = constructDSN(
 		c.Database.Host,
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CORSConfig holds CORS configuration
+type CORSConfig struct {
+	// AllowedOrigins are exact origins such as https://example.com, patterns
+	// such as https://*.example.com or http://localhost:*, or "*" for any origin
+	AllowedOrigins   []string
+	AllowCredentials bool
+	MaxAge           time.Duration // How long browsers may cache preflight responses
+	// OriginsFile lists more allowed origins, one per line. It is read again
+	// when the server receives SIGHUP, so origins can change without a restart.
+	OriginsFile string
+}
+
+// CORSOrigin is a parsed entry of the allowed origins
+type CORSOrigin struct {
+	Any        bool // "*", matching every origin
+	Scheme     string
+	Host       string
+	Subdomains bool   // Matches subdomains of Host, but not Host itself
+	Port       string // "*" for any port, empty when the origin has none
+}
+
+// loadCORSConfig reads the CORS configuration. CORS_ALLOWED_ORIGINS_<GIN_MODE>,
+// such as CORS_ALLOWED_ORIGINS_RELEASE, replaces CORS_ALLOWED_ORIGINS in that
+// mode.
+func loadCORSConfig(ginMode string) (CORSConfig, error) {
+	corsOrigins := getEnv("CORS_ALLOWED_ORIGINS", "*")
+	if override := os.Getenv("CORS_ALLOWED_ORIGINS_" + strings.ToUpper(ginMode)); override != "" {
+		corsOrigins = override
+	}
+
+	maxAge, err := time.ParseDuration(getEnv("CORS_MAX_AGE", "12h"))
+	if err != nil {
+		return CORSConfig{}, fmt.Errorf("invalid CORS_MAX_AGE: %w", err)
+	}
+
+	return CORSConfig{
+		AllowedOrigins:   splitOrigins(corsOrigins),
+		AllowCredentials: GetEnvBool("CORS_ALLOW_CREDENTIALS", true),
+		MaxAge:           maxAge,
+		OriginsFile:      getEnv("CORS_ORIGINS_FILE", ""),
+	}, nil
+}
+
+// Origins parses the allowed origins, reading OriginsFile when it is set.
+// It warns about combinations that let any site make credentialed requests.
+func (c CORSConfig) Origins() ([]CORSOrigin, error) {
+	patterns := c.AllowedOrigins
+	if c.OriginsFile != "" {
+		fileOrigins, err := readOriginsFile(c.OriginsFile)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(append([]string{}, patterns...), fileOrigins...)
+	}
+
+	origins := make([]CORSOrigin, 0, len(patterns))
+	for _, pattern := range patterns {
+		origin, err := ParseCORSOrigin(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if origin.Any && c.AllowCredentials {
+			log.Warn().Msg("CORS allows any origin with credentials, so every site can make requests as a signed-in user. List the allowed origins in CORS_ALLOWED_ORIGINS or set CORS_ALLOW_CREDENTIALS=false")
+		}
+		origins = append(origins, origin)
+	}
+	return origins, nil
+}
+
+// ParseCORSOrigin parses an allowed origin: "*", scheme://host[:port], where
+// host may start with "*." to allow its subdomains and port may be "*"
+func ParseCORSOrigin(pattern string) (CORSOrigin, error) {
+	if pattern == "*" {
+		return CORSOrigin{Any: true}, nil
+	}
+
+	scheme, hostPort, ok := strings.Cut(strings.TrimSuffix(pattern, "/"), "://")
+	if !ok || (scheme != "http" && scheme != "https") {
+		return CORSOrigin{}, fmt.Errorf("invalid CORS origin %q: must start with http:// or https://", pattern)
+	}
+	if hostPort == "" || strings.ContainsAny(hostPort, "/?#@") {
+		return CORSOrigin{}, fmt.Errorf("invalid CORS origin %q: must be scheme://host[:port] without a path", pattern)
+	}
+
+	origin := CORSOrigin{Scheme: scheme, Host: hostPort}
+	if i := strings.LastIndex(hostPort, ":"); i >= 0 && !strings.HasSuffix(hostPort, "]") {
+		origin.Host, origin.Port = hostPort[:i], hostPort[i+1:]
+		if origin.Port == "" {
+			return CORSOrigin{}, fmt.Errorf("invalid CORS origin %q: empty port", pattern)
+		}
+	}
+	if strings.HasPrefix(origin.Host, "*.") {
+		origin.Host, origin.Subdomains = origin.Host[2:], true
+	}
+	if origin.Host == "" || strings.Contains(origin.Host, "*") {
+		return CORSOrigin{}, fmt.Errorf("invalid CORS origin %q: a wildcard may only stand for subdomains or the port", pattern)
+	}
+	origin.Host = strings.ToLower(origin.Host)
+	return origin, nil
+}
+
+// Matches reports whether the Origin header value origin is allowed
+func (o CORSOrigin) Matches(origin string) bool {
+	if o.Any {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Scheme != o.Scheme {
+		return false
+	}
+	if o.Port != "*" && u.Port() != o.Port {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if o.Subdomains {
+		return strings.HasSuffix(host, "."+o.Host)
+	}
+	return host == strings.Trim(o.Host, "[]")
+}
+
+// splitOrigins splits a comma-separated list of origins
+func splitOrigins(value string) []string {
+	var origins []string
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// readOriginsFile reads one origin per line, skipping blank lines and # comments
+func readOriginsFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CORS origins file: %w", err)
+	}
+	defer file.Close()
+
+	var origins []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line != "" {
+			origins = append(origins, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read CORS origins file: %w", err)
+	}
+	return origins, nil
+}
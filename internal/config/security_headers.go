@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultSwaggerCSP lets the Swagger UI run its inline bootstrap script and
+// styles while keeping everything else to the API's own origin
+const defaultSwaggerCSP = "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; connect-src 'self'; frame-ancestors 'none'"
+
+// SecurityHeadersConfig holds configuration for the security headers sent
+// with every response
+type SecurityHeadersConfig struct {
+	Enabled bool
+	// HSTS tells browsers to only use HTTPS for the API's host. Off by default
+	// outside release mode, so local http servers keep working.
+	HSTS                  bool
+	HSTSMaxAge            time.Duration
+	HSTSIncludeSubdomains bool
+	FrameOptions          string // DENY or SAMEORIGIN
+	ReferrerPolicy        string
+	SwaggerCSP            string // Content-Security-Policy of the Swagger UI pages; empty to send none
+}
+
+// loadSecurityHeadersConfig reads the security headers configuration.
+// SECURITY_HEADERS_<GIN_MODE> and SECURITY_HSTS_<GIN_MODE>, such as
+// SECURITY_HSTS_DEBUG, override SECURITY_HEADERS and SECURITY_HSTS in that
+// mode.
+func loadSecurityHeadersConfig(ginMode string) (SecurityHeadersConfig, error) {
+	mode := strings.ToUpper(ginMode)
+
+	hstsMaxAge, err := time.ParseDuration(getEnv("SECURITY_HSTS_MAX_AGE", "8760h"))
+	if err != nil || hstsMaxAge < 0 {
+		return SecurityHeadersConfig{}, fmt.Errorf("invalid SECURITY_HSTS_MAX_AGE: must be a non-negative duration")
+	}
+
+	frameOptions := strings.ToUpper(getEnv("SECURITY_FRAME_OPTIONS", "DENY"))
+	if frameOptions != "DENY" && frameOptions != "SAMEORIGIN" {
+		return SecurityHeadersConfig{}, fmt.Errorf("invalid SECURITY_FRAME_OPTIONS %q: must be DENY or SAMEORIGIN", frameOptions)
+	}
+
+	return SecurityHeadersConfig{
+		Enabled:               GetEnvBool("SECURITY_HEADERS_"+mode, GetEnvBool("SECURITY_HEADERS", true)),
+		HSTS:                  GetEnvBool("SECURITY_HSTS_"+mode, GetEnvBool("SECURITY_HSTS", ginMode == "release")),
+		HSTSMaxAge:            hstsMaxAge,
+		HSTSIncludeSubdomains: GetEnvBool("SECURITY_HSTS_INCLUDE_SUBDOMAINS", false),
+		FrameOptions:          frameOptions,
+		ReferrerPolicy:        getEnv("SECURITY_REFERRER_POLICY", "strict-origin-when-cross-origin"),
+		SwaggerCSP:            getEnv("SECURITY_SWAGGER_CSP", defaultSwaggerCSP),
+	}, nil
+}
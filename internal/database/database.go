@@ -2,13 +2,22 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/phanvantai/taiphanvan_backend/internal/config"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/secrets"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -17,8 +26,8 @@ import (
 
 var DB *gorm.DB
 
-// Initialize sets up the database connection with proper connection pooling
-func Initialize(cfg *config.Config) error {
+// Connect opens the database connection with proper connection pooling
+func Connect(cfg *config.Config) error {
 	logLevel := logger.Info
 	if cfg.Server.GinMode == "release" {
 		logLevel = logger.Error
@@ -28,8 +37,13 @@ func Initialize(cfg *config.Config) error {
 		Logger: logger.Default.LogMode(logLevel),
 	}
 
+	// Secrets are encrypted with the configured key as they are stored
+	if err := secrets.SetKey(cfg.Encryption.Key); err != nil {
+		return err
+	}
+
 	// Use the DSN from config, which is already handled in config.go for Railway
-	dsn := cfg.Database.DSN
+	dsn := withStatementTimeout(cfg.Database.DSN, cfg.Database.StatementTimeout)
 
 	// Log connection attempt (without exposing credentials)
 	hostInfo := "using DATABASE_URL"
@@ -68,8 +82,58 @@ func Initialize(cfg *config.Config) error {
 
 	log.Println("Database connected successfully")
 
-	// Auto migrate database schemas
-	if err := autoMigrate(); err != nil {
+	// Trace queries that run with a traced request's context
+	if err := DB.Use(tracing.GormPlugin{}); err != nil {
+		return fmt.Errorf("failed to register tracing plugin: %w", err)
+	}
+	if err := DB.Use(site.GormPlugin{}); err != nil {
+		return fmt.Errorf("failed to register site plugin: %w", err)
+	}
+
+	return nil
+}
+
+// withStatementTimeout adds a statement_timeout run-time parameter to dsn, so
+// Postgres cancels queries on every pooled connection once they run longer
+// than timeout. Both URL and key=value connection strings are supported.
+func withStatementTimeout(dsn string, timeout time.Duration) string {
+	if timeout <= 0 {
+		return dsn
+	}
+	ms := strconv.FormatInt(timeout.Milliseconds(), 10)
+
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return dsn
+		}
+		query := u.Query()
+		query.Set("statement_timeout", ms)
+		u.RawQuery = query.Encode()
+		return u.String()
+	}
+	return dsn + " statement_timeout=" + ms
+}
+
+// Initialize connects to the database, makes sure its schema is current and
+// prepares the data the application needs
+func Initialize(cfg *config.Config) error {
+	if err := Connect(cfg); err != nil {
+		return err
+	}
+
+	// Refuse to serve on a schema that is behind the code
+	if err := checkSchema(cfg.Database.DSN, cfg.Database.MigrateOnStart); err != nil {
+		return err
+	}
+
+	// Create the default news categories on first start
+	if err := SeedNewsCategories(DB); err != nil {
+		return fmt.Errorf("database migration failed: %w", err)
+	}
+
+	// Import the feeds from RSS_FEEDS on first start
+	if err := SeedNewsSources(DB, cfg.RSS.Feeds); err != nil {
 		return fmt.Errorf("database migration failed: %w", err)
 	}
 
@@ -90,31 +154,59 @@ func Initialize(cfg *config.Config) error {
 	return nil
 }
 
-// autoMigrate automatically migrates the database schema
-func autoMigrate() error {
-	err := DB.AutoMigrate(
-		&models.User{},
-		&models.Post{},
-		&models.Tag{},
-		&models.Comment{},
-		&models.BlacklistedToken{},
-		&models.RefreshToken{},        // Add RefreshToken model
-		&models.News{},                // Add News model
-		&models.EnrichedNewsContent{}, // Add EnrichedNewsContent model
-	)
+// checkSchema applies pending migrations when migrate is set and otherwise
+// fails while any are pending
+func checkSchema(dsn string, migrate bool) error {
+	migrator, err := NewMigrator(dsn)
 	if err != nil {
 		return err
 	}
+	defer migrator.Close()
 
-	log.Println("Database migration completed")
+	if migrate {
+		if _, err := migrator.Up(); err != nil {
+			return fmt.Errorf("database migration failed: %w", err)
+		}
+		log.Println("Database migration completed")
+		return nil
+	}
+
+	pending, err := migrator.Pending()
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("database schema is behind: %d pending migrations, starting with %d_%s; run \"api migrate up\" or set DB_MIGRATE_ON_START=true",
+			len(pending), pending[0].Version, pending[0].Name)
+	}
 	return nil
 }
 
+// PendingMigrations returns the names of the migrations that haven't been
+// applied to the database at dsn
+func PendingMigrations(dsn string) ([]string, error) {
+	migrator, err := NewMigrator(dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer migrator.Close()
+
+	pending, err := migrator.Pending()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(pending))
+	for i, migration := range pending {
+		names[i] = fmt.Sprintf("%d_%s", migration.Version, migration.Name)
+	}
+	return names, nil
+}
+
 // CreateDefaultAdminUser creates a default admin user if no admin exists
 func CreateDefaultAdminUser(cfg *config.Config) error {
 	// Check if admin user already exists
 	var count int64
-	if err := DB.Model(&models.User{}).Where("role = ?", "admin").Count(&count).Error; err != nil {
+	if err := DB.Model(&models.User{}).Where("role = ?", policy.RoleAdmin).Count(&count).Error; err != nil {
 		return fmt.Errorf("failed to check for existing admin: %w", err)
 	}
 
@@ -162,7 +254,7 @@ func CreateDefaultAdminUser(cfg *config.Config) error {
 		Password:  string(hashedPassword),
 		FirstName: "System",
 		LastName:  "Admin",
-		Role:      "admin",
+		Role:      policy.RoleAdmin,
 	}
 
 	if result := DB.Create(&adminUser); result.Error != nil {
@@ -177,7 +269,7 @@ func CreateDefaultAdminUser(cfg *config.Config) error {
 func CreateDefaultEditorUser(cfg *config.Config) error {
 	// Check if editor user already exists
 	var count int64
-	if err := DB.Model(&models.User{}).Where("role = ?", "editor").Count(&count).Error; err != nil {
+	if err := DB.Model(&models.User{}).Where("role = ?", policy.RoleEditor).Count(&count).Error; err != nil {
 		return fmt.Errorf("failed to check for existing editor: %w", err)
 	}
 
@@ -225,7 +317,7 @@ func CreateDefaultEditorUser(cfg *config.Config) error {
 		Password:  string(hashedPassword),
 		FirstName: "Content",
 		LastName:  "Editor",
-		Role:      "editor",
+		Role:      policy.RoleEditor,
 	}
 
 	if result := DB.Create(&editorUser); result.Error != nil {
@@ -250,3 +342,34 @@ func Close() error {
 	log.Println("Database connection closed")
 	return nil
 }
+
+// GetOrCreateGhostUser returns the placeholder author that receives content from deleted users.
+// The ghost account has a random password and cannot be logged into.
+func GetOrCreateGhostUser(tx *gorm.DB) (*models.User, error) {
+	var ghost models.User
+	if err := tx.Where("username = ?", models.GhostUsername).First(&ghost).Error; err == nil {
+		return &ghost, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to look up ghost user: %w", err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(uuid.NewString()), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash ghost user password: %w", err)
+	}
+
+	ghost = models.User{
+		Username:  models.GhostUsername,
+		Email:     "ghost@users.invalid",
+		Password:  string(hashedPassword),
+		FirstName: "Deleted",
+		LastName:  "User",
+		Role:      "user",
+	}
+	if err := tx.Create(&ghost).Error; err != nil {
+		return nil, fmt.Errorf("failed to create ghost user: %w", err)
+	}
+
+	log.Println("Ghost user created for reassigned content")
+	return &ghost, nil
+}
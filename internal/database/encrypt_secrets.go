@@ -0,0 +1,41 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/secrets"
+	"gorm.io/gorm"
+)
+
+// EncryptSecrets encrypts the webhook and JWT signing secrets stored before
+// ENCRYPTION_KEY was set and returns how many it encrypted. It needs the key,
+// so it is run with "admin encrypt-secrets" rather than as a migration;
+// until then the unencrypted values are still read as they are.
+func EncryptSecrets(db *gorm.DB) (int, error) {
+	if !secrets.Enabled() {
+		return 0, secrets.ErrNoKey
+	}
+
+	var webhooks []models.Webhook
+	if err := db.Where("secret NOT LIKE ?", "enc:%").Find(&webhooks).Error; err != nil {
+		return 0, fmt.Errorf("failed to load webhook secrets: %w", err)
+	}
+	for _, webhook := range webhooks {
+		if err := db.Model(&webhook).Select("secret").UpdateColumns(&models.Webhook{Secret: webhook.Secret}).Error; err != nil {
+			return 0, fmt.Errorf("failed to encrypt secret of webhook %d: %w", webhook.ID, err)
+		}
+	}
+
+	var keys []models.JWTSigningKey
+	if err := db.Where("secret <> '' AND secret NOT LIKE ?", "enc:%").Find(&keys).Error; err != nil {
+		return 0, fmt.Errorf("failed to load JWT signing keys: %w", err)
+	}
+	for _, key := range keys {
+		if err := db.Model(&key).Select("secret").UpdateColumns(&models.JWTSigningKey{Secret: key.Secret}).Error; err != nil {
+			return 0, fmt.Errorf("failed to encrypt JWT signing key %s: %w", key.KID, err)
+		}
+	}
+
+	return len(webhooks) + len(keys), nil
+}
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// ActiveFreezeWindow returns the freeze window in effect at t, or nil if publishing is open.
+// When windows overlap, the one ending last is returned so queued posts wait for all of them.
+func ActiveFreezeWindow(db *gorm.DB, t time.Time) (*models.FreezeWindow, error) {
+	var window models.FreezeWindow
+	err := db.Where("starts_at <= ? AND ends_at > ?", t, t).
+		Order("ends_at DESC").
+		First(&window).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check freeze windows: %w", err)
+	}
+	return &window, nil
+}
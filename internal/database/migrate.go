@@ -0,0 +1,238 @@
+package database
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib" // Registers the pgx database/sql driver
+)
+
+// migrationFiles holds the versioned schema migrations. Each version has a
+// NNNN_name.up.sql file and a NNNN_name.down.sql file that reverts it.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// MigrationsDir is where new migration files are created, relative to the
+// repository root
+const MigrationsDir = "internal/database/migrations"
+
+// Migration is a versioned schema change
+type Migration struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// Migrator applies and reverts the embedded migrations with golang-migrate,
+// which records the current version in the schema_migrations table. Each
+// migration file runs as one implicit transaction, so a failed migration
+// leaves nothing half-applied, but the version is marked dirty until it is
+// fixed and forced.
+type Migrator struct {
+	db     *sql.DB
+	source source.Driver
+	m      *migrate.Migrate
+}
+
+// NewMigrator connects to the database at dsn for migrating it. The
+// connection is its own and has no DB_STATEMENT_TIMEOUT, as migrations such
+// as building an index on a large table may legitimately run longer than any
+// request should. Close it when done.
+func NewMigrator(dsn string) (*Migrator, error) {
+	src, err := iofs.New(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	driver, err := pgx.WithInstance(db, &pgx.Config{})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	m, err := migrate.NewWithInstance("iofs", src, "pgx5", driver)
+	if err != nil {
+		driver.Close()
+		return nil, err
+	}
+	m.Log = migrateLogger{}
+	return &Migrator{db: db, source: src, m: m}, nil
+}
+
+// Close closes the migrator's database connection
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// Status returns every migration, with Applied set on those that have been
+// applied. It fails while the schema is dirty.
+func (m *Migrator) Status() ([]Migration, error) {
+	current, err := m.version()
+	if err != nil {
+		return nil, err
+	}
+
+	var status []Migration
+	version, err := m.source.First()
+	for err == nil {
+		r, identifier, readErr := m.source.ReadUp(version)
+		if readErr != nil {
+			return nil, readErr
+		}
+		r.Close()
+		status = append(status, Migration{Version: version, Name: identifier, Applied: version <= current})
+		version, err = m.source.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Pending returns the migrations that haven't been applied, oldest first
+func (m *Migrator) Pending() ([]Migration, error) {
+	status, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range status {
+		if !migration.Applied {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration, oldest first, and reports whether
+// there were any. A database created by AutoMigrate is adopted at the
+// initial schema without running it.
+func (m *Migrator) Up() (bool, error) {
+	if err := m.adoptAutoMigratedSchema(); err != nil {
+		return false, err
+	}
+
+	err := m.m.Up()
+	if errors.Is(err, migrate.ErrNoChange) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Down reverts the latest applied migration and reports whether there was
+// one
+func (m *Migrator) Down() (bool, error) {
+	if _, _, err := m.m.Version(); errors.Is(err, migrate.ErrNilVersion) {
+		return false, nil
+	}
+	if err := m.m.Steps(-1); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Force records version as the current one and clears the dirty flag
+// without running any SQL. It repairs the record after a migration failed
+// or was applied or reverted by hand.
+func (m *Migrator) Force(version uint) error {
+	return m.m.Force(int(version))
+}
+
+// version returns the latest applied migration, 0 when there is none
+func (m *Migrator) version() (uint, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("migration %d failed and left the schema dirty; fix it by hand and run \"api migrate force VERSION\"", version)
+	}
+	return version, nil
+}
+
+// adoptAutoMigratedSchema records the initial schema as applied when the
+// database has tables but no migration record, as databases AutoMigrate
+// created before versioned migrations have. The initial schema is what the
+// first release created; the next migration adds whatever later ones did if
+// it is missing, so it runs on every adopted database.
+func (m *Migrator) adoptAutoMigratedSchema() error {
+	if _, _, err := m.m.Version(); !errors.Is(err, migrate.ErrNilVersion) {
+		return err
+	}
+
+	var hasUsers bool
+	if err := m.db.QueryRow(`SELECT to_regclass('users') IS NOT NULL`).Scan(&hasUsers); err != nil || !hasUsers {
+		return err
+	}
+	initial, err := m.source.First()
+	if err != nil {
+		return err
+	}
+	if err := m.m.Force(int(initial)); err != nil {
+		return err
+	}
+	log.Printf("Adopted existing schema at migration %d", initial)
+	return nil
+}
+
+// migrateLogger prints golang-migrate's progress to the standard logger
+type migrateLogger struct{}
+
+func (migrateLogger) Printf(format string, v ...interface{}) {
+	log.Printf("migrate: "+format, v...)
+}
+
+func (migrateLogger) Verbose() bool { return false }
+
+// CreateMigration writes empty up and down files for a new migration named
+// name in dir, numbered after the latest embedded migration, and returns
+// their paths
+func CreateMigration(dir, name string) ([]string, error) {
+	if !regexp.MustCompile(`^\w+$`).MatchString(name) {
+		return nil, errors.New("migration name may only contain letters, digits and underscores")
+	}
+
+	src, err := iofs.New(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	var latest uint
+	version, err := src.First()
+	for err == nil {
+		latest = version
+		version, err = src.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	var paths []string
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%04d_%s.%s.sql", latest+1, name, direction))
+		if err := os.WriteFile(path, []byte(fmt.Sprintf("-- %s %s\n", name, direction)), 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,285 @@
+package database_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// The models as the last release that used AutoMigrate had them, reduced to
+// their columns and relationships. Migrating them creates the schema
+// production databases were adopted from.
+type User struct {
+	ID           uint      `gorm:"primaryKey"`
+	Username     string    `gorm:"size:50;not null;unique"`
+	Email        string    `gorm:"size:100;not null;unique"`
+	Password     string    `gorm:"size:100;not null"`
+	FirstName    string    `gorm:"size:50"`
+	LastName     string    `gorm:"size:50"`
+	Bio          string    `gorm:"type:text"`
+	Role         string    `gorm:"size:20;default:'user'"`
+	ProfileImage string    `gorm:"size:255"`
+	Posts        []Post    `gorm:"foreignKey:UserID"`
+	Comments     []Comment `gorm:"foreignKey:UserID"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+type Post struct {
+	ID        uint   `gorm:"primaryKey"`
+	Title     string `gorm:"size:255;not null"`
+	Slug      string `gorm:"size:255;not null;unique"`
+	Content   string `gorm:"type:text;not null"`
+	Excerpt   string `gorm:"type:text"`
+	Cover     string `gorm:"size:500"`
+	Status    string `gorm:"type:varchar(20);not null;default:'draft'"`
+	UserID    uint
+	User      User  `gorm:"foreignKey:UserID"`
+	Tags      []Tag `gorm:"many2many:post_tags;"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+type Tag struct {
+	ID    uint   `gorm:"primaryKey"`
+	Name  string `gorm:"size:50;not null;unique"`
+	Posts []Post `gorm:"many2many:post_tags;"`
+}
+
+type Comment struct {
+	ID        uint   `gorm:"primaryKey"`
+	Content   string `gorm:"type:text;not null"`
+	UserID    uint
+	User      User `gorm:"foreignKey:UserID"`
+	PostID    uint
+	Post      Post `gorm:"foreignKey:PostID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+type BlacklistedToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	Token     string `gorm:"size:500;not null;uniqueIndex"`
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+type RefreshToken struct {
+	gorm.Model
+	Token     string    `gorm:"size:255;not null;uniqueIndex"`
+	UserID    uint      `gorm:"not null;index"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	IssuedAt  time.Time `gorm:"not null"`
+	Revoked   bool      `gorm:"default:false"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;"`
+}
+
+type News struct {
+	ID          uint   `gorm:"primaryKey"`
+	Title       string `gorm:"size:255;not null"`
+	Slug        string `gorm:"size:255;not null;unique"`
+	Content     string `gorm:"type:text;not null"`
+	Summary     string `gorm:"type:text"`
+	Source      string `gorm:"size:100;not null"`
+	SourceURL   string `gorm:"size:500"`
+	ImageURL    string `gorm:"size:500"`
+	Category    string `gorm:"type:varchar(20);not null;default:'general'"`
+	Status      string `gorm:"type:varchar(20);not null;default:'published'"`
+	Published   bool   `gorm:"default:true"`
+	PublishDate time.Time
+	ExternalID  string `gorm:"size:100;index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	Tags        []Tag          `gorm:"many2many:news_tags;"`
+}
+
+type EnrichedNewsContent struct {
+	ID                 uint   `gorm:"primaryKey"`
+	NewsID             uint   `gorm:"not null;index"`
+	OriginalContent    string `gorm:"type:text"`
+	FullContent        string `gorm:"type:text"`
+	IsTruncated        bool   `gorm:"default:false"`
+	TruncatedChars     int
+	TruncationPattern  string `gorm:"size:50"`
+	SourceURL          string `gorm:"size:500"`
+	LastFetched        time.Time
+	TruncationDetected time.Time
+	FetchError         string `gorm:"size:255"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// testDSN returns a database for migration tests to create schemas in, from
+// TEST_DATABASE_URL, and skips the test without one
+func testDSN(t *testing.T) string {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	return dsn
+}
+
+// scratchSchema creates an empty schema that is dropped after the test and
+// returns dsn with it as the search path, so tables are created in it
+func scratchSchema(t *testing.T, dsn string) string {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	schema := fmt.Sprintf("migrate_test_%d", time.Now().UnixNano())
+	if _, err := db.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("failed to drop %s: %v", schema, err)
+		}
+	})
+
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		query := u.Query()
+		query.Set("search_path", schema)
+		u.RawQuery = query.Encode()
+		return u.String()
+	}
+	return dsn + " search_path=" + schema
+}
+
+func openGorm(t *testing.T, dsn string) *gorm.DB {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+func migrateUp(t *testing.T, dsn string) {
+	migrator, err := database.NewMigrator(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer migrator.Close()
+
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("migrating up failed: %v", err)
+	}
+	pending, err := migrator.Pending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) > 0 {
+		t.Fatalf("%d migrations still pending, starting with %d_%s", len(pending), pending[0].Version, pending[0].Name)
+	}
+}
+
+// TestMigrateAutoMigratedDatabase adopts a database created by the last
+// AutoMigrate release and checks that the migrations bring it to the current
+// schema, including the tables and columns added before migrations existed
+func TestMigrateAutoMigratedDatabase(t *testing.T) {
+	dsn := scratchSchema(t, testDSN(t))
+
+	db := openGorm(t, dsn)
+	if err := db.AutoMigrate(&User{}, &Post{}, &Tag{}, &Comment{}, &BlacklistedToken{}, &RefreshToken{}, &News{}, &EnrichedNewsContent{}); err != nil {
+		t.Fatal(err)
+	}
+	user := User{Username: "author", Email: "author@example.com", Password: "hash"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	post := Post{Title: "Before migrations", Slug: "before-migrations", Content: "Content", Status: "published", UserID: user.ID}
+	if err := db.Create(&post).Error; err != nil {
+		t.Fatal(err)
+	}
+	comment := Comment{Content: "First", UserID: user.ID, PostID: post.ID}
+	if err := db.Create(&comment).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	migrateUp(t, dsn)
+
+	for table, columns := range map[string][]string{
+		"users":    {"analytics_opt_out"},
+		"posts":    {"uuid", "category_id", "series_id", "site_id", "version", "expires_at"},
+		"comments": {"uuid", "status", "flag_reason"},
+		"news":     {"uuid", "language"},
+	} {
+		for _, column := range columns {
+			if !db.Migrator().HasColumn(table, column) {
+				t.Errorf("%s.%s is missing", table, column)
+			}
+		}
+	}
+	for _, table := range []string{"categories", "series", "freeze_windows", "api_keys", "slug_history", "sites", "post_templates"} {
+		if !db.Migrator().HasTable(table) {
+			t.Errorf("table %s is missing", table)
+		}
+	}
+
+	var missingUUIDs int64
+	if err := db.Raw("SELECT (SELECT COUNT(*) FROM posts WHERE uuid IS NULL) + (SELECT COUNT(*) FROM comments WHERE uuid IS NULL)").
+		Scan(&missingUUIDs).Error; err != nil {
+		t.Fatal(err)
+	}
+	if missingUUIDs > 0 {
+		t.Errorf("%d rows have no public UUID", missingUUIDs)
+	}
+
+	var indexed int64
+	if err := db.Raw("SELECT COUNT(*) FROM search_index WHERE type = 'post' AND id = ? AND site_id = 1", post.ID).
+		Scan(&indexed).Error; err != nil {
+		t.Fatal(err)
+	}
+	if indexed != 1 {
+		t.Errorf("the published post isn't in the search index of the default site")
+	}
+}
+
+// TestMigrateUpAndDown applies every migration to an empty database, reverts
+// them all and applies them again
+func TestMigrateUpAndDown(t *testing.T) {
+	dsn := scratchSchema(t, testDSN(t))
+
+	migrateUp(t, dsn)
+
+	migrator, err := database.NewMigrator(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		reverted, err := migrator.Down()
+		if err != nil {
+			t.Fatalf("migrating down failed: %v", err)
+		}
+		if !reverted {
+			break
+		}
+	}
+	migrator.Close()
+
+	migrateUp(t, dsn)
+}
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS "enriched_news_contents" CASCADE;
+DROP TABLE IF EXISTS "news_tags" CASCADE;
+DROP TABLE IF EXISTS "news" CASCADE;
+DROP TABLE IF EXISTS "refresh_tokens" CASCADE;
+DROP TABLE IF EXISTS "blacklisted_tokens" CASCADE;
+DROP TABLE IF EXISTS "comments" CASCADE;
+DROP TABLE IF EXISTS "post_tags" CASCADE;
+DROP TABLE IF EXISTS "tags" CASCADE;
+DROP TABLE IF EXISTS "posts" CASCADE;
+DROP TABLE IF EXISTS "users" CASCADE;
@@ -0,0 +1,146 @@
+-- Schema as created by AutoMigrate before versioned migrations were introduced
+
+CREATE TABLE "users" (
+    "id" bigserial,
+    "username" varchar(50) NOT NULL,
+    "email" varchar(100) NOT NULL,
+    "password" varchar(100) NOT NULL,
+    "first_name" varchar(50),
+    "last_name" varchar(50),
+    "bio" text,
+    "role" varchar(20) DEFAULT 'user',
+    "profile_image" varchar(255),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_users_username" UNIQUE ("username"),
+    CONSTRAINT "uni_users_email" UNIQUE ("email")
+);
+CREATE INDEX "idx_users_deleted_at" ON "users" ("deleted_at");
+
+CREATE TABLE "posts" (
+    "id" bigserial,
+    "title" varchar(255) NOT NULL,
+    "slug" varchar(255) NOT NULL,
+    "content" text NOT NULL,
+    "excerpt" text,
+    "cover" varchar(500),
+    "status" varchar(20) NOT NULL DEFAULT 'draft',
+    "user_id" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_posts_slug" UNIQUE ("slug")
+);
+CREATE INDEX "idx_posts_deleted_at" ON "posts" ("deleted_at");
+
+CREATE TABLE "tags" (
+    "id" bigserial,
+    "name" varchar(50) NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_tags_name" UNIQUE ("name")
+);
+
+CREATE TABLE "post_tags" (
+    "tag_id" bigint,
+    "post_id" bigint,
+    PRIMARY KEY ("tag_id","post_id")
+);
+
+CREATE TABLE "comments" (
+    "id" bigserial,
+    "content" text NOT NULL,
+    "user_id" bigint,
+    "post_id" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_comments_deleted_at" ON "comments" ("deleted_at");
+
+CREATE TABLE "blacklisted_tokens" (
+    "id" bigserial,
+    "token" varchar(500) NOT NULL,
+    "expires_at" timestamptz,
+    "created_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_blacklisted_tokens_deleted_at" ON "blacklisted_tokens" ("deleted_at");
+CREATE UNIQUE INDEX "idx_blacklisted_tokens_token" ON "blacklisted_tokens" ("token");
+
+CREATE TABLE "refresh_tokens" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "token" varchar(255) NOT NULL,
+    "user_id" bigint NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "issued_at" timestamptz NOT NULL,
+    "revoked" boolean DEFAULT false,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_refresh_tokens_expires_at" ON "refresh_tokens" ("expires_at");
+CREATE INDEX "idx_refresh_tokens_user_id" ON "refresh_tokens" ("user_id");
+CREATE UNIQUE INDEX "idx_refresh_tokens_token" ON "refresh_tokens" ("token");
+CREATE INDEX "idx_refresh_tokens_deleted_at" ON "refresh_tokens" ("deleted_at");
+
+CREATE TABLE "news" (
+    "id" bigserial,
+    "title" varchar(255) NOT NULL,
+    "slug" varchar(255) NOT NULL,
+    "content" text NOT NULL,
+    "summary" text,
+    "source" varchar(100) NOT NULL,
+    "source_url" varchar(500),
+    "image_url" varchar(500),
+    "category" varchar(20) NOT NULL DEFAULT 'general',
+    "status" varchar(20) NOT NULL DEFAULT 'published',
+    "published" boolean DEFAULT true,
+    "publish_date" timestamptz,
+    "external_id" varchar(100),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_news_slug" UNIQUE ("slug")
+);
+CREATE INDEX "idx_news_deleted_at" ON "news" ("deleted_at");
+CREATE INDEX "idx_news_external_id" ON "news" ("external_id");
+
+CREATE TABLE "news_tags" (
+    "news_id" bigint,
+    "tag_id" bigint,
+    PRIMARY KEY ("news_id","tag_id")
+);
+
+CREATE TABLE "enriched_news_contents" (
+    "id" bigserial,
+    "news_id" bigint NOT NULL,
+    "original_content" text,
+    "full_content" text,
+    "is_truncated" boolean DEFAULT false,
+    "truncated_chars" bigint,
+    "truncation_pattern" varchar(50),
+    "source_url" varchar(500),
+    "last_fetched" timestamptz,
+    "truncation_detected" timestamptz,
+    "fetch_error" varchar(255),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_enriched_news_contents_news_id" ON "enriched_news_contents" ("news_id");
+
+ALTER TABLE "posts" ADD CONSTRAINT "fk_users_posts" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "post_tags" ADD CONSTRAINT "fk_post_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "tags"("id");
+ALTER TABLE "post_tags" ADD CONSTRAINT "fk_post_tags_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id");
+ALTER TABLE "comments" ADD CONSTRAINT "fk_comments_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id");
+ALTER TABLE "comments" ADD CONSTRAINT "fk_users_comments" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "refresh_tokens" ADD CONSTRAINT "fk_refresh_tokens_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE;
+ALTER TABLE "news_tags" ADD CONSTRAINT "fk_news_tags_news" FOREIGN KEY ("news_id") REFERENCES "news"("id");
+ALTER TABLE "news_tags" ADD CONSTRAINT "fk_news_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "tags"("id");
@@ -0,0 +1,39 @@
+DROP TABLE IF EXISTS "slug_history" CASCADE;
+DROP TABLE IF EXISTS "post_authors" CASCADE;
+DROP TABLE IF EXISTS "bookmarks" CASCADE;
+DROP TABLE IF EXISTS "subscribers" CASCADE;
+DROP TABLE IF EXISTS "jwt_signing_keys" CASCADE;
+DROP TABLE IF EXISTS "audit_logs" CASCADE;
+DROP TABLE IF EXISTS "series" CASCADE;
+DROP TABLE IF EXISTS "api_keys" CASCADE;
+DROP TABLE IF EXISTS "news_views" CASCADE;
+DROP TABLE IF EXISTS "news_category_examples" CASCADE;
+DROP TABLE IF EXISTS "news_categories" CASCADE;
+DROP TABLE IF EXISTS "editorial_picks" CASCADE;
+DROP TABLE IF EXISTS "site_settings" CASCADE;
+DROP TABLE IF EXISTS "ingestion_items" CASCADE;
+DROP TABLE IF EXISTS "ingestion_runs" CASCADE;
+DROP TABLE IF EXISTS "webhook_deliveries" CASCADE;
+DROP TABLE IF EXISTS "webhooks" CASCADE;
+DROP TABLE IF EXISTS "freeze_windows" CASCADE;
+DROP TABLE IF EXISTS "user_deletions" CASCADE;
+DROP TABLE IF EXISTS "categories" CASCADE;
+
+ALTER TABLE "enriched_news_contents" DROP COLUMN IF EXISTS "lead_image";
+ALTER TABLE "enriched_news_contents" DROP COLUMN IF EXISTS "byline";
+
+ALTER TABLE "news" DROP COLUMN IF EXISTS "uuid";
+
+ALTER TABLE "comments" DROP COLUMN IF EXISTS "status";
+ALTER TABLE "comments" DROP COLUMN IF EXISTS "uuid";
+
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "preview_version";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "series_position";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "series_id";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "news_id";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "publish_at";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "view_count";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "category_id";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "uuid";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "analytics_opt_out";
@@ -0,0 +1,338 @@
+-- Tables and columns added while AutoMigrate still managed the schema.
+-- Databases created by AutoMigrate are adopted at 0001_initial_schema and
+-- may already have some of them, depending on the version they ran, so
+-- everything here is created only if it is missing.
+
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "analytics_opt_out" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "uuid" uuid;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "category_id" bigint;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "view_count" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "publish_at" timestamptz;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "news_id" bigint;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "series_id" bigint;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "series_position" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "posts" ADD COLUMN IF NOT EXISTS "preview_version" bigint NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS "idx_posts_series_id" ON "posts" ("series_id");
+CREATE INDEX IF NOT EXISTS "idx_posts_news_id" ON "posts" ("news_id");
+CREATE INDEX IF NOT EXISTS "idx_posts_publish_at" ON "posts" ("publish_at");
+CREATE INDEX IF NOT EXISTS "idx_posts_category_id" ON "posts" ("category_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_posts_uuid" ON "posts" ("uuid");
+
+ALTER TABLE "comments" ADD COLUMN IF NOT EXISTS "uuid" uuid;
+ALTER TABLE "comments" ADD COLUMN IF NOT EXISTS "status" varchar(20) NOT NULL DEFAULT 'approved';
+CREATE INDEX IF NOT EXISTS "idx_comments_status" ON "comments" ("status");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_comments_uuid" ON "comments" ("uuid");
+
+ALTER TABLE "news" ADD COLUMN IF NOT EXISTS "uuid" uuid;
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_news_uuid" ON "news" ("uuid");
+
+ALTER TABLE "enriched_news_contents" ADD COLUMN IF NOT EXISTS "byline" varchar(255);
+ALTER TABLE "enriched_news_contents" ADD COLUMN IF NOT EXISTS "lead_image" varchar(500);
+
+CREATE TABLE IF NOT EXISTS "categories" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL,
+    "slug" varchar(120) NOT NULL,
+    "description" text,
+    "parent_id" bigint,
+    "position" bigint NOT NULL DEFAULT 0,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_categories_parent_id" ON "categories" ("parent_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_categories_slug" ON "categories" ("slug");
+
+CREATE TABLE IF NOT EXISTS "user_deletions" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "strategy" varchar(20) NOT NULL,
+    "deleted_by" bigint,
+    "ghost_user_id" bigint,
+    "post_ids" text,
+    "comment_ids" text,
+    "snapshot" text,
+    "undo_until" timestamptz NOT NULL,
+    "restored_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_deletions_user_id" ON "user_deletions" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "freeze_windows" (
+    "id" bigserial,
+    "reason" varchar(255) NOT NULL,
+    "starts_at" timestamptz NOT NULL,
+    "ends_at" timestamptz NOT NULL,
+    "created_by" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_freeze_windows_ends_at" ON "freeze_windows" ("ends_at");
+CREATE INDEX IF NOT EXISTS "idx_freeze_windows_starts_at" ON "freeze_windows" ("starts_at");
+
+CREATE TABLE IF NOT EXISTS "webhooks" (
+    "id" bigserial,
+    "url" varchar(500) NOT NULL,
+    "description" varchar(255),
+    "events" text,
+    "secret" varchar(100) NOT NULL,
+    "active" boolean DEFAULT true,
+    "created_by" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+    "id" bigserial,
+    "webhook_id" bigint NOT NULL,
+    "delivery_id" varchar(36) NOT NULL,
+    "event" varchar(50) NOT NULL,
+    "attempt" bigint,
+    "status_code" bigint,
+    "success" boolean,
+    "error" text,
+    "duration_ms" bigint,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_delivery_id" ON "webhook_deliveries" ("delivery_id");
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_webhook_id" ON "webhook_deliveries" ("webhook_id");
+
+CREATE TABLE IF NOT EXISTS "ingestion_runs" (
+    "id" bigserial,
+    "source" varchar(20) NOT NULL,
+    "trigger" varchar(20) NOT NULL,
+    "status" varchar(20) NOT NULL,
+    "items_seen" bigint,
+    "items_deduped" bigint,
+    "items_saved" bigint,
+    "items_failed" bigint,
+    "source_errors" text,
+    "error" text,
+    "started_at" timestamptz NOT NULL,
+    "finished_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ingestion_runs_started_at" ON "ingestion_runs" ("started_at");
+CREATE INDEX IF NOT EXISTS "idx_ingestion_runs_status" ON "ingestion_runs" ("status");
+CREATE INDEX IF NOT EXISTS "idx_ingestion_runs_source" ON "ingestion_runs" ("source");
+
+CREATE TABLE IF NOT EXISTS "ingestion_items" (
+    "id" bigserial,
+    "run_id" bigint NOT NULL,
+    "source" varchar(100),
+    "external_id" varchar(100),
+    "title" varchar(255),
+    "source_url" varchar(500),
+    "outcome" varchar(20) NOT NULL,
+    "reason" text,
+    "news_id" bigint,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_ingestion_items_outcome" ON "ingestion_items" ("outcome");
+CREATE INDEX IF NOT EXISTS "idx_ingestion_items_run_id" ON "ingestion_items" ("run_id");
+
+CREATE TABLE IF NOT EXISTS "site_settings" (
+    "key" varchar(100),
+    "value" text NOT NULL,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("key")
+);
+
+CREATE TABLE IF NOT EXISTS "editorial_picks" (
+    "id" bigserial,
+    "item_type" varchar(10) NOT NULL,
+    "item_id" bigint NOT NULL,
+    "weight" decimal NOT NULL,
+    "created_by" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_editorial_pick_item" ON "editorial_picks" ("item_type","item_id");
+
+CREATE TABLE IF NOT EXISTS "news_categories" (
+    "id" bigserial,
+    "slug" varchar(20) NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "enabled" boolean NOT NULL,
+    "keywords" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_news_categories_slug" ON "news_categories" ("slug");
+
+CREATE TABLE IF NOT EXISTS "news_category_examples" (
+    "id" bigserial,
+    "news_id" bigint NOT NULL,
+    "category" varchar(20) NOT NULL,
+    "previous" varchar(20) NOT NULL,
+    "text" text NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_news_category_examples_category" ON "news_category_examples" ("category");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_news_category_examples_news_id" ON "news_category_examples" ("news_id");
+
+CREATE TABLE IF NOT EXISTS "news_views" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "filter" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_news_views_user_name" ON "news_views" ("user_id","name");
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "prefix" varchar(16) NOT NULL,
+    "key_hash" varchar(64) NOT NULL,
+    "scopes" text,
+    "expires_at" timestamptz,
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_api_keys_key_hash" ON "api_keys" ("key_hash");
+CREATE INDEX IF NOT EXISTS "idx_api_keys_user_id" ON "api_keys" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "series" (
+    "id" bigserial,
+    "uuid" uuid,
+    "title" varchar(255) NOT NULL,
+    "slug" varchar(255) NOT NULL,
+    "description" text,
+    "user_id" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_series_slug" ON "series" ("slug");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_series_uuid" ON "series" ("uuid");
+
+CREATE TABLE IF NOT EXISTS "audit_logs" (
+    "id" bigserial,
+    "actor_id" bigint,
+    "actor_role" varchar(20),
+    "action" varchar(50) NOT NULL,
+    "resource_type" varchar(50) NOT NULL,
+    "resource_id" varchar(64),
+    "before" text,
+    "after" text,
+    "request_id" varchar(64),
+    "ip_address" varchar(45),
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_created_at" ON "audit_logs" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_request_id" ON "audit_logs" ("request_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_resource" ON "audit_logs" ("resource_type","resource_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_action" ON "audit_logs" ("action");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_actor_id" ON "audit_logs" ("actor_id");
+
+CREATE TABLE IF NOT EXISTS "jwt_signing_keys" (
+    "id" bigserial,
+    "kid" varchar(64) NOT NULL,
+    "secret" varchar(128),
+    "source" varchar(20) NOT NULL,
+    "created_at" timestamptz,
+    "retired_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_jwt_signing_keys_k_id" ON "jwt_signing_keys" ("kid");
+
+CREATE TABLE IF NOT EXISTS "subscribers" (
+    "id" bigserial,
+    "email" varchar(255) NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "confirm_token_hash" varchar(64),
+    "confirm_expires_at" timestamptz,
+    "unsubscribe_token" varchar(64) NOT NULL,
+    "confirmed_at" timestamptz,
+    "unsubscribed_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_subscribers_unsubscribe_token" ON "subscribers" ("unsubscribe_token");
+CREATE INDEX IF NOT EXISTS "idx_subscribers_confirm_token_hash" ON "subscribers" ("confirm_token_hash");
+CREATE INDEX IF NOT EXISTS "idx_subscribers_status" ON "subscribers" ("status");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_subscribers_email" ON "subscribers" ("email");
+
+CREATE TABLE IF NOT EXISTS "bookmarks" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "post_id" bigint NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_bookmarks_post_id" ON "bookmarks" ("post_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_bookmarks_user_post" ON "bookmarks" ("user_id","post_id");
+
+CREATE TABLE IF NOT EXISTS "post_authors" (
+    "post_id" bigint,
+    "user_id" bigint,
+    "role" varchar(20) NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("post_id","user_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_post_authors_user_id" ON "post_authors" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "slug_history" (
+    "id" bigserial,
+    "resource_type" varchar(20) NOT NULL,
+    "slug" varchar(255) NOT NULL,
+    "resource_id" bigint NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_slug_history_resource_id" ON "slug_history" ("resource_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_slug_history_slug" ON "slug_history" ("resource_type","slug");
+
+-- Postgres has no ADD CONSTRAINT IF NOT EXISTS
+DO $$
+BEGIN
+    BEGIN
+        ALTER TABLE "categories" ADD CONSTRAINT "fk_categories_children" FOREIGN KEY ("parent_id") REFERENCES "categories"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "posts" ADD CONSTRAINT "fk_posts_category" FOREIGN KEY ("category_id") REFERENCES "categories"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "posts" ADD CONSTRAINT "fk_series_posts" FOREIGN KEY ("series_id") REFERENCES "series"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "ingestion_items" ADD CONSTRAINT "fk_ingestion_runs_items" FOREIGN KEY ("run_id") REFERENCES "ingestion_runs"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "series" ADD CONSTRAINT "fk_series_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "bookmarks" ADD CONSTRAINT "fk_bookmarks_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "post_authors" ADD CONSTRAINT "fk_posts_authors" FOREIGN KEY ("post_id") REFERENCES "posts"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+    BEGIN
+        ALTER TABLE "post_authors" ADD CONSTRAINT "fk_post_authors_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+    EXCEPTION WHEN duplicate_object THEN NULL;
+    END;
+END $$;
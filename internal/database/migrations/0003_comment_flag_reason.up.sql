@@ -0,0 +1 @@
+ALTER TABLE "comments" ADD COLUMN "flag_reason" varchar(50);
@@ -0,0 +1,17 @@
+CREATE TABLE "news_sources" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL,
+    "url" varchar(500) NOT NULL,
+    "category" varchar(20),
+    "enabled" boolean NOT NULL,
+    "fetch_interval_minutes" bigint NOT NULL DEFAULT 0,
+    "last_fetched_at" timestamptz,
+    "last_fetch_status" varchar(20),
+    "last_fetch_error" text,
+    "last_fetch_count" bigint NOT NULL DEFAULT 0,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_news_sources_name" ON "news_sources" ("name");
+
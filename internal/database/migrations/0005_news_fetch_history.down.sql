@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "fetch_runs" CASCADE;
+ALTER TABLE "news_sources" DROP COLUMN IF EXISTS "healthy";
+ALTER TABLE "news_sources" DROP COLUMN IF EXISTS "consecutive_failures";
@@ -0,0 +1,21 @@
+ALTER TABLE "news_sources" ADD COLUMN "consecutive_failures" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "news_sources" ADD COLUMN "healthy" boolean NOT NULL DEFAULT true;
+
+CREATE TABLE "fetch_runs" (
+    "id" bigserial,
+    "source_id" bigint NOT NULL,
+    "source_name" varchar(100) NOT NULL,
+    "ingestion_run_id" bigint,
+    "status" varchar(20) NOT NULL,
+    "duration_ms" bigint,
+    "items_found" bigint,
+    "items_saved" bigint,
+    "error" text,
+    "started_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_fetch_runs_started_at" ON "fetch_runs" ("started_at");
+CREATE INDEX "idx_fetch_runs_status" ON "fetch_runs" ("status");
+CREATE INDEX "idx_fetch_runs_ingestion_run_id" ON "fetch_runs" ("ingestion_run_id");
+CREATE INDEX "idx_fetch_runs_source_id" ON "fetch_runs" ("source_id");
+
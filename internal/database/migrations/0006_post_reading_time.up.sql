@@ -0,0 +1,10 @@
+ALTER TABLE "posts" ADD COLUMN "word_count" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "posts" ADD COLUMN "reading_time_minutes" bigint NOT NULL DEFAULT 0;
+
+-- Count the words of existing posts the way models.CountWords does
+UPDATE "posts" SET "word_count" = (
+    SELECT COUNT(*)
+    FROM regexp_split_to_table(regexp_replace("content", '<[^>]*>|\]\([^)]*\)', ' ', 'g'), '\s+') AS word
+    WHERE word ~ '[[:alnum:]]'
+);
+UPDATE "posts" SET "reading_time_minutes" = ("word_count" + 199) / 200;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "notifications" CASCADE;
+DROP INDEX IF EXISTS "idx_comments_parent_id";
+ALTER TABLE "comments" DROP COLUMN IF EXISTS "parent_id";
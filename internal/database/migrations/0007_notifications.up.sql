@@ -0,0 +1,18 @@
+ALTER TABLE "comments" ADD COLUMN "parent_id" bigint;
+CREATE INDEX "idx_comments_parent_id" ON "comments" ("parent_id");
+
+CREATE TABLE "notifications" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "type" varchar(30) NOT NULL,
+    "actor_id" bigint,
+    "post_id" bigint,
+    "comment_id" bigint,
+    "status" varchar(20),
+    "read_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_notifications_actor" FOREIGN KEY ("actor_id") REFERENCES "users"("id") ON DELETE SET NULL,
+    CONSTRAINT "fk_notifications_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_notifications_user_created" ON "notifications" ("user_id","created_at");
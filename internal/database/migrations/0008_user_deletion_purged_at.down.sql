@@ -0,0 +1 @@
+ALTER TABLE "user_deletions" DROP COLUMN IF EXISTS "purged_at";
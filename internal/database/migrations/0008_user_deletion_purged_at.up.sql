@@ -0,0 +1 @@
+ALTER TABLE "user_deletions" ADD COLUMN "purged_at" timestamptz;
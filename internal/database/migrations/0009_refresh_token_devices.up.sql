@@ -0,0 +1,3 @@
+ALTER TABLE "refresh_tokens" ADD COLUMN "user_agent" varchar(500);
+ALTER TABLE "refresh_tokens" ADD COLUMN "ip_address" varchar(45);
+ALTER TABLE "refresh_tokens" ADD COLUMN "last_used_at" timestamptz;
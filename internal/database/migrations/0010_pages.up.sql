@@ -0,0 +1,12 @@
+CREATE TABLE "pages" (
+    "id" bigserial,
+    "title" varchar(200) NOT NULL,
+    "slug" varchar(120) NOT NULL,
+    "content" text,
+    "status" varchar(20) NOT NULL DEFAULT 'draft',
+    "updated_by" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_pages_slug" ON "pages" ("slug");
@@ -0,0 +1,12 @@
+CREATE TABLE "contact_messages" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL,
+    "email" varchar(255) NOT NULL,
+    "message" text NOT NULL,
+    "ip_address" varchar(45),
+    "user_agent" varchar(500),
+    "relayed_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_contact_messages_created_at" ON "contact_messages" ("created_at");
@@ -0,0 +1 @@
+ALTER TABLE "posts" ADD COLUMN "og_image" varchar(500);
@@ -0,0 +1,3 @@
+ALTER TABLE "news" DROP COLUMN IF EXISTS "language";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "translation_group";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "language";
@@ -0,0 +1,6 @@
+ALTER TABLE "posts" ADD COLUMN "language" varchar(10) NOT NULL DEFAULT 'en';
+ALTER TABLE "posts" ADD COLUMN "translation_group" uuid;
+CREATE INDEX "idx_posts_language" ON "posts" ("language");
+CREATE INDEX "idx_posts_translation_group" ON "posts" ("translation_group");
+ALTER TABLE "news" ADD COLUMN "language" varchar(10) NOT NULL DEFAULT 'en';
+CREATE INDEX "idx_news_language" ON "news" ("language");
@@ -0,0 +1,9 @@
+CREATE TABLE "comment_mentions" (
+    "comment_id" bigint,
+    "user_id" bigint,
+    "created_at" timestamptz,
+    PRIMARY KEY ("comment_id","user_id"),
+    CONSTRAINT "fk_comments_mentions" FOREIGN KEY ("comment_id") REFERENCES "comments"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_comment_mentions_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_comment_mentions_user_id" ON "comment_mentions" ("user_id");
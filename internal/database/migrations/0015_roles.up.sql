@@ -0,0 +1,16 @@
+CREATE TABLE "roles" (
+    "id" bigserial,
+    "name" varchar(20) NOT NULL,
+    "description" varchar(255),
+    "permissions" text,
+    "built_in" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_roles_name" ON "roles" ("name");
+
+INSERT INTO "roles" ("name", "description", "permissions", "built_in", "created_at", "updated_at") VALUES
+    ('admin', 'Runs the site', '["admin.access","post.create","post.unpublish","post.delete","comment.edit","comment.delete","comment.skip_moderation","series.manage","page.edit"]', true, NOW(), NOW()),
+    ('editor', 'Writes posts and edits pages', '["post.create","page.edit"]', true, NOW(), NOW()),
+    ('user', 'Comments and manages their own content', '[]', true, NOW(), NOW());
@@ -0,0 +1,19 @@
+DROP MATERIALIZED VIEW IF EXISTS "search_index";
+
+DROP INDEX IF EXISTS "idx_news_sources_site_name";
+CREATE UNIQUE INDEX "idx_news_sources_name" ON "news_sources" ("name");
+ALTER TABLE "news_sources" DROP COLUMN IF EXISTS "site_id";
+
+DROP INDEX IF EXISTS "idx_pages_site_slug";
+CREATE UNIQUE INDEX "idx_pages_slug" ON "pages" ("slug");
+ALTER TABLE "pages" DROP COLUMN IF EXISTS "site_id";
+
+DROP INDEX IF EXISTS "idx_tags_site_name";
+ALTER TABLE "tags" ADD CONSTRAINT "uni_tags_name" UNIQUE ("name");
+ALTER TABLE "tags" DROP COLUMN IF EXISTS "site_id";
+
+DROP INDEX IF EXISTS "idx_posts_site_slug";
+ALTER TABLE "posts" ADD CONSTRAINT "uni_posts_slug" UNIQUE ("slug");
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "site_id";
+
+DROP TABLE IF EXISTS "sites";
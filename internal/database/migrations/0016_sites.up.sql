@@ -0,0 +1,36 @@
+CREATE TABLE "sites" (
+    "id" bigserial,
+    "name" varchar(100) NOT NULL,
+    "domain" varchar(255) NOT NULL,
+    "is_default" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_sites_domain" ON "sites" ("domain");
+CREATE UNIQUE INDEX "idx_sites_is_default" ON "sites" ("is_default") WHERE "is_default";
+
+-- Existing content belongs to the default site, which serves every host that
+-- isn't given a site of its own
+INSERT INTO "sites" ("id", "name", "domain", "is_default", "created_at", "updated_at") VALUES
+    (1, 'Default', '', true, NOW(), NOW());
+SELECT setval(pg_get_serial_sequence('sites', 'id'), 1);
+
+ALTER TABLE "posts" ADD COLUMN "site_id" bigint NOT NULL DEFAULT 1 REFERENCES "sites" ("id");
+ALTER TABLE "posts" DROP CONSTRAINT "uni_posts_slug";
+CREATE UNIQUE INDEX "idx_posts_site_slug" ON "posts" ("site_id", "slug");
+
+ALTER TABLE "tags" ADD COLUMN "site_id" bigint NOT NULL DEFAULT 1 REFERENCES "sites" ("id");
+ALTER TABLE "tags" DROP CONSTRAINT "uni_tags_name";
+CREATE UNIQUE INDEX "idx_tags_site_name" ON "tags" ("site_id", "name");
+
+ALTER TABLE "pages" ADD COLUMN "site_id" bigint NOT NULL DEFAULT 1 REFERENCES "sites" ("id");
+DROP INDEX "idx_pages_slug";
+CREATE UNIQUE INDEX "idx_pages_site_slug" ON "pages" ("site_id", "slug");
+
+ALTER TABLE "news_sources" ADD COLUMN "site_id" bigint NOT NULL DEFAULT 1 REFERENCES "sites" ("id");
+DROP INDEX "idx_news_sources_name";
+CREATE UNIQUE INDEX "idx_news_sources_site_name" ON "news_sources" ("site_id", "name");
+
+-- The search index gains a site_id column; it is recreated by 0032_search_index
+DROP MATERIALIZED VIEW IF EXISTS "search_index";
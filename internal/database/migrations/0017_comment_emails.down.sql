@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "idx_notifications_unemailed";
+ALTER TABLE "notifications" DROP COLUMN IF EXISTS "emailed_at";
+
+DROP INDEX IF EXISTS "idx_users_comment_emails_token";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "comment_emails_token";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "comment_emails";
@@ -0,0 +1,8 @@
+ALTER TABLE "users" ADD COLUMN "comment_emails" varchar(20) NOT NULL DEFAULT 'immediate';
+ALTER TABLE "users" ADD COLUMN "comment_emails_token" varchar(64);
+CREATE UNIQUE INDEX "idx_users_comment_emails_token" ON "users" ("comment_emails_token");
+
+ALTER TABLE "notifications" ADD COLUMN "emailed_at" timestamptz;
+-- Notifications from before comment emails existed aren't emailed
+UPDATE "notifications" SET "emailed_at" = NOW();
+CREATE INDEX "idx_notifications_unemailed" ON "notifications" ("user_id") WHERE "emailed_at" IS NULL;
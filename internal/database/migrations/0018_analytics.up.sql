@@ -0,0 +1,28 @@
+CREATE TABLE "analytics_events" (
+    "id" bigserial,
+    "post_id" bigint NOT NULL,
+    "view_id" varchar(64) NOT NULL,
+    "reader_id" varchar(64),
+    "user_id" bigint,
+    "type" varchar(20) NOT NULL,
+    "progress" bigint NOT NULL DEFAULT 0,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_analytics_events_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_analytics_events_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE SET NULL
+);
+CREATE INDEX "idx_analytics_events_post_id" ON "analytics_events" ("post_id");
+CREATE INDEX "idx_analytics_events_created_at" ON "analytics_events" ("created_at");
+
+CREATE TABLE "post_analytics_daily" (
+    "post_id" bigint NOT NULL,
+    "day" date NOT NULL,
+    "views" bigint NOT NULL DEFAULT 0,
+    "unique_readers" bigint NOT NULL DEFAULT 0,
+    "reached_25" bigint NOT NULL DEFAULT 0,
+    "reached_50" bigint NOT NULL DEFAULT 0,
+    "reached_75" bigint NOT NULL DEFAULT 0,
+    "reached_100" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("post_id", "day"),
+    CONSTRAINT "fk_post_analytics_daily_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id") ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- Fails while encrypted secrets are stored; unset ENCRYPTION_KEY and save
+-- them again first
+ALTER TABLE "jwt_signing_keys" ALTER COLUMN "secret" TYPE varchar(128);
+ALTER TABLE "webhooks" ALTER COLUMN "secret" TYPE varchar(100);
@@ -0,0 +1,3 @@
+-- Encrypted secrets are longer than the values they hold
+ALTER TABLE "webhooks" ALTER COLUMN "secret" TYPE varchar(255);
+ALTER TABLE "jwt_signing_keys" ALTER COLUMN "secret" TYPE varchar(255);
@@ -0,0 +1,4 @@
+UPDATE "roles" SET "permissions" = ("permissions"::jsonb - 'template.edit')::text
+WHERE "permissions"::jsonb ? 'template.edit';
+
+DROP TABLE IF EXISTS "post_templates";
@@ -0,0 +1,24 @@
+CREATE TABLE "post_templates" (
+    "id" bigserial,
+    "site_id" bigint NOT NULL DEFAULT 1,
+    "name" varchar(100) NOT NULL,
+    "description" varchar(255),
+    "title_pattern" varchar(255) NOT NULL,
+    "content" text,
+    "excerpt" text,
+    "tags" text,
+    "status" varchar(20) NOT NULL DEFAULT 'draft',
+    "category_id" bigint,
+    "language" varchar(10) NOT NULL DEFAULT 'en',
+    "updated_by" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_post_templates_site" FOREIGN KEY ("site_id") REFERENCES "sites"("id"),
+    CONSTRAINT "fk_post_templates_category" FOREIGN KEY ("category_id") REFERENCES "categories"("id") ON DELETE SET NULL
+);
+CREATE UNIQUE INDEX "idx_post_templates_site_name" ON "post_templates" ("site_id", "name");
+
+-- The built-in editor and admin roles manage templates
+UPDATE "roles" SET "permissions" = ("permissions"::jsonb || '["template.edit"]'::jsonb)::text
+WHERE "name" IN ('admin', 'editor') AND "built_in" AND NOT ("permissions"::jsonb ? 'template.edit');
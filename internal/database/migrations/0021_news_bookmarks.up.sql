@@ -0,0 +1,10 @@
+CREATE TABLE "news_bookmarks" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "news_id" bigint NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_news_bookmarks_news" FOREIGN KEY ("news_id") REFERENCES "news"("id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_news_bookmarks_news_id" ON "news_bookmarks" ("news_id");
+CREATE UNIQUE INDEX "idx_news_bookmarks_user_news" ON "news_bookmarks" ("user_id","news_id");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "cross_posts";
+ALTER TABLE "posts" DROP COLUMN IF EXISTS "skip_cross_post";
@@ -0,0 +1,19 @@
+ALTER TABLE "posts" ADD COLUMN "skip_cross_post" boolean NOT NULL DEFAULT false;
+
+CREATE TABLE "cross_posts" (
+    "id" bigserial,
+    "post_id" bigint NOT NULL,
+    "target" varchar(20) NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "next_attempt_at" timestamptz,
+    "last_error" text,
+    "external_id" varchar(255),
+    "sent_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_cross_posts_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "idx_cross_posts_post_target" ON "cross_posts" ("post_id","target");
+CREATE INDEX "idx_cross_posts_status" ON "cross_posts" ("status");
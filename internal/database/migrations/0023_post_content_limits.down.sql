@@ -0,0 +1,3 @@
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS "chk_posts_content_size";
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS "chk_posts_excerpt_length";
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS "chk_posts_title_length";
@@ -0,0 +1,5 @@
+-- NOT VALID keeps existing rows from blocking the migration; new and updated
+-- rows are still checked
+ALTER TABLE "posts" ADD CONSTRAINT "chk_posts_title_length" CHECK (char_length("title") <= 255) NOT VALID;
+ALTER TABLE "posts" ADD CONSTRAINT "chk_posts_excerpt_length" CHECK (char_length("excerpt") <= 2000) NOT VALID;
+ALTER TABLE "posts" ADD CONSTRAINT "chk_posts_content_size" CHECK (octet_length("content") <= 2097152) NOT VALID;
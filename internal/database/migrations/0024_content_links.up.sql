@@ -0,0 +1,19 @@
+CREATE TABLE "content_links" (
+    "id" bigserial,
+    "source_type" varchar(20) NOT NULL,
+    "source_id" bigint NOT NULL,
+    "url" text NOT NULL,
+    "domain" varchar(255) NOT NULL,
+    "status_code" bigint,
+    "error" text,
+    "broken" boolean NOT NULL DEFAULT false,
+    "checked_at" timestamptz,
+    "broken_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_content_links_source_url" ON "content_links" ("source_type","source_id","url");
+CREATE INDEX "idx_content_links_domain" ON "content_links" ("domain");
+CREATE INDEX "idx_content_links_broken" ON "content_links" ("broken");
+CREATE INDEX "idx_content_links_checked_at" ON "content_links" ("checked_at");
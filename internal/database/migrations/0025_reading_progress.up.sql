@@ -0,0 +1,12 @@
+CREATE TABLE "reading_progress" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "post_id" bigint NOT NULL,
+    "percentage" bigint NOT NULL DEFAULT 0,
+    "anchor" varchar(255),
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_reading_progress_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_reading_progress_post_id" ON "reading_progress" ("post_id");
+CREATE UNIQUE INDEX "idx_reading_progress_user_post" ON "reading_progress" ("user_id","post_id");
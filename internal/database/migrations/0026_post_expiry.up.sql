@@ -0,0 +1,2 @@
+ALTER TABLE "posts" ADD COLUMN "expires_at" timestamptz;
+CREATE INDEX "idx_posts_expires_at" ON "posts" ("expires_at");
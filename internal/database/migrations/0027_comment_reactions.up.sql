@@ -0,0 +1,12 @@
+CREATE TABLE "comment_reactions" (
+    "id" bigserial,
+    "comment_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    "type" varchar(20) NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_comment_reactions_comment" FOREIGN KEY ("comment_id") REFERENCES "comments"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_comment_reactions_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX "idx_comment_reactions_comment_user_type" ON "comment_reactions" ("comment_id","user_id","type");
+CREATE INDEX "idx_comment_reactions_user_id" ON "comment_reactions" ("user_id");
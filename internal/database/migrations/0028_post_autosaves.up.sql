@@ -0,0 +1,16 @@
+CREATE TABLE "post_autosaves" (
+    "id" bigserial,
+    "post_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    "title" varchar(255),
+    "excerpt" text,
+    "content" text,
+    "base_updated_at" timestamptz NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_post_autosaves_post" FOREIGN KEY ("post_id") REFERENCES "posts"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_post_autosaves_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_post_autosaves_post_user" ON "post_autosaves" ("post_id","user_id");
+CREATE INDEX "idx_post_autosaves_user_id" ON "post_autosaves" ("user_id");
@@ -0,0 +1 @@
+ALTER TABLE "ingestion_runs" DROP COLUMN IF EXISTS "items_filtered";
@@ -0,0 +1 @@
+ALTER TABLE "ingestion_runs" ADD COLUMN "items_filtered" bigint NOT NULL DEFAULT 0;
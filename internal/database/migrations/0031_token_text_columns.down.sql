@@ -0,0 +1,2 @@
+ALTER TABLE "blacklisted_tokens" ALTER COLUMN "token" TYPE varchar(500);
+ALTER TABLE "refresh_tokens" ALTER COLUMN "token" TYPE varchar(255);
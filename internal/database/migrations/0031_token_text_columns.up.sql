@@ -0,0 +1,4 @@
+-- Tokens carry a kid header since key rotation, which can push them past
+-- the old column sizes
+ALTER TABLE "refresh_tokens" ALTER COLUMN "token" TYPE text;
+ALTER TABLE "blacklisted_tokens" ALTER COLUMN "token" TYPE text;
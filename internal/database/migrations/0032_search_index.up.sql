@@ -0,0 +1,35 @@
+-- Search documents of published posts, published news and tags, one row per
+-- item. Titles and tag names weigh most, then excerpts and summaries, then
+-- the body. The simple text search configuration is used because content is
+-- written in several languages, so words are matched as written rather than
+-- stemmed. News is shared by every site, so its site_id is NULL.
+--
+-- The view was created on startup before, without site_id on databases that
+-- had it before sites were introduced, so it is replaced.
+DROP MATERIALIZED VIEW IF EXISTS "search_index";
+CREATE MATERIALIZED VIEW "search_index" AS
+SELECT 'post' AS type, p.id, p.uuid::text AS uuid, p.title, p.slug,
+    regexp_replace(COALESCE(NULLIF(p.excerpt, ''), p.content), '<[^>]+>', ' ', 'g') AS body,
+    COALESCE(p.publish_at, p.created_at) AS published_at, p.site_id,
+    setweight(to_tsvector('simple', p.title), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(p.excerpt, '')), 'B') ||
+    setweight(to_tsvector('simple', regexp_replace(p.content, '<[^>]+>', ' ', 'g')), 'C') AS document
+FROM posts p
+WHERE p.status = 'published' AND p.deleted_at IS NULL
+UNION ALL
+SELECT 'news', n.id, n.uuid::text, n.title, n.slug,
+    regexp_replace(COALESCE(NULLIF(n.summary, ''), n.content), '<[^>]+>', ' ', 'g'),
+    n.publish_date, NULL::bigint,
+    setweight(to_tsvector('simple', n.title), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(n.summary, '')), 'B') ||
+    setweight(to_tsvector('simple', regexp_replace(n.content, '<[^>]+>', ' ', 'g')), 'C')
+FROM news n
+WHERE n.status = 'published' AND n.published AND n.deleted_at IS NULL
+UNION ALL
+SELECT 'tag', t.id, '', t.name, t.name, '', NULL, t.site_id,
+    setweight(to_tsvector('simple', t.name), 'A')
+FROM tags t;
+
+-- The unique index is required to refresh the view concurrently
+CREATE UNIQUE INDEX "idx_search_index_item" ON "search_index" ("type", "id");
+CREATE INDEX "idx_search_index_document" ON "search_index" USING GIN ("document");
@@ -0,0 +1 @@
+-- Public IDs are kept, as links may already use them
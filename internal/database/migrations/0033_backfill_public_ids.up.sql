@@ -0,0 +1,4 @@
+-- Rows created before the uuid columns existed have no public ID
+UPDATE "posts" SET "uuid" = gen_random_uuid() WHERE "uuid" IS NULL;
+UPDATE "comments" SET "uuid" = gen_random_uuid() WHERE "uuid" IS NULL;
+UPDATE "news" SET "uuid" = gen_random_uuid() WHERE "uuid" IS NULL;
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// SearchIndexView is the materialized view that holds the search documents
+// of published posts, published news and tags. It is created by the
+// 0032_search_index migration; news is shared by every site, so its site_id
+// is NULL.
+const SearchIndexView = "search_index"
+
+// RefreshSearchIndex rebuilds the search index view from the current
+// content without blocking searches running against it
+func RefreshSearchIndex(db *gorm.DB) error {
+	if err := db.Exec(fmt.Sprintf("REFRESH MATERIALIZED VIEW CONCURRENTLY %s", SearchIndexView)).Error; err != nil {
+		return fmt.Errorf("failed to refresh search index: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// defaultNewsCategories are created on first start. Technology and science were
+// the only categories before they moved into the database, so the rest start
+// disabled; admins can enable them from the admin API.
+var defaultNewsCategories = []models.NewsCategoryModel{
+	{Slug: models.NewsCategoryTechnology, Name: "Technology", Enabled: true, Keywords: []string{"tech", "technology", "software", "hardware", "app", "computer", "digital", "cyber", "ai", "artificial intelligence", "machine learning", "robot"}},
+	{Slug: models.NewsCategoryScience, Name: "Science", Enabled: true, Keywords: []string{"science", "research", "study", "discovery", "space", "physics", "chemistry", "biology", "astronomy"}},
+	{Slug: "general", Name: "General", Enabled: false, Keywords: []string{}},
+	{Slug: "business", Name: "Business", Enabled: false, Keywords: []string{"business", "company", "economy", "market", "stock", "finance", "investment", "startup", "entrepreneur"}},
+	{Slug: "health", Name: "Health", Enabled: false, Keywords: []string{"health", "medical", "disease", "virus", "doctor", "hospital", "medicine", "covid", "vaccine", "treatment"}},
+	{Slug: "sports", Name: "Sports", Enabled: false, Keywords: []string{"sport", "game", "team", "player", "football", "soccer", "baseball", "basketball", "tennis", "golf", "olympics"}},
+	{Slug: "entertainment", Name: "Entertainment", Enabled: false, Keywords: []string{"entertainment", "movie", "film", "music", "celebrity", "actor", "actress", "tv", "show", "concert", "festival", "award"}},
+}
+
+// SeedNewsCategories creates the default news categories if the table is empty
+func SeedNewsCategories(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&models.NewsCategoryModel{}).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to count news categories: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	categories := make([]models.NewsCategoryModel, len(defaultNewsCategories))
+	copy(categories, defaultNewsCategories)
+	if err := db.Create(&categories).Error; err != nil {
+		return fmt.Errorf("failed to seed news categories: %w", err)
+	}
+
+	log.Info().Int("count", len(categories)).Msg("Seeded default news categories")
+	return nil
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// SeedNewsSources imports the feeds listed in RSS_FEEDS as news sources if the
+// table is empty. Once imported, sources are managed through the admin API and
+// RSS_FEEDS is no longer read.
+func SeedNewsSources(db *gorm.DB, feeds []config.RSSFeed) error {
+	if len(feeds) == 0 {
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(&models.NewsSource{}).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to count news sources: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	sources := make([]models.NewsSource, 0, len(feeds))
+	seen := make(map[string]bool, len(feeds))
+	for _, feed := range feeds {
+		if seen[feed.Name] {
+			continue
+		}
+		seen[feed.Name] = true
+		sources = append(sources, models.NewsSource{
+			Name:     feed.Name,
+			URL:      feed.URL,
+			Category: models.NewsCategory(feed.Category),
+			Enabled:  true,
+		})
+	}
+	if err := db.Create(&sources).Error; err != nil {
+		return fmt.Errorf("failed to seed news sources: %w", err)
+	}
+
+	log.Info().Int("count", len(sources)).Msg("Imported RSS_FEEDS as news sources")
+	return nil
+}
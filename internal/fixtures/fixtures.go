@@ -0,0 +1,737 @@
+// Package fixtures provides canonical instances of the API models.
+// They are serialized by cmd/genexamples to produce the Swagger examples,
+// so documented bodies always match what the handlers actually send.
+package fixtures
+
+import (
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+)
+
+var (
+	createdAt = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt = time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	publishAt = time.Date(2023, 1, 3, 12, 0, 0, 0, time.UTC)
+)
+
+func uintPtr(v uint) *uint { return &v }
+
+func stringPtr(v string) *string { return &v }
+
+func intPtr(v int) *int { return &v }
+
+// User is a regular author account
+func User() models.User {
+	return models.User{
+		ID:            1,
+		Username:      "johndoe",
+		Email:         "john@example.com",
+		FirstName:     "John",
+		LastName:      "Doe",
+		Bio:           "I'm a software developer interested in web technologies.",
+		Role:          "user",
+		ProfileImage:  "https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg",
+		CommentEmails: models.CommentEmailsImmediate,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+// Tag is a post tag as embedded in posts and news
+func Tag() models.Tag {
+	return models.Tag{ID: 1, Name: "technology"}
+}
+
+// Category is a nested post category
+func Category() models.Category {
+	return models.Category{
+		ID:          1,
+		Name:        "Backend",
+		Slug:        "backend",
+		Description: "Posts about server-side development",
+		ParentID:    uintPtr(2),
+		Position:    0,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// Post is a published post with its author, tags, and category preloaded
+func Post() models.Post {
+	category := Category()
+	return models.Post{
+		ID:               1,
+		UUID:             "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d",
+		Title:            "My First Blog Post",
+		Slug:             "my-first-blog-post",
+		Content:          "This is the content of my blog post...",
+		Excerpt:          "A short summary of the post",
+		Cover:            "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg",
+		OGImage:          "https://res.cloudinary.com/demo/image/upload/v1234567890/folder/og_images/post_1_1620000000.png",
+		Status:           models.PostStatusPublished,
+		Language:         models.LanguageEnglish,
+		TranslationGroup: stringPtr("5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d"),
+		Translations: []models.PostTranslation{{
+			ID:       2,
+			UUID:     "7a1d2c3b-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+			Title:    "Bài viết đầu tiên của tôi",
+			Slug:     "bai-viet-dau-tien-cua-toi",
+			Language: models.LanguageVietnamese,
+		}},
+		UserID:     1,
+		User:       User(),
+		Tags:       []models.Tag{Tag()},
+		CategoryID: &category.ID,
+		Category:   &category,
+		ViewCount:  128,
+		Version:    3,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+// Comment is a comment with its author preloaded
+func Comment() models.Comment {
+	return models.Comment{
+		ID:        1,
+		UUID:      "9a7e1c2d-4b3f-4e5a-8c6d-1f2e3a4b5c6d",
+		Content:   "Great post! @janedoe you'll like this",
+		Status:    models.CommentStatusApproved,
+		UserID:    1,
+		User:      User(),
+		PostID:    1,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+		Mentions: []models.CommentMention{{
+			UserID:    2,
+			User:      models.User{ID: 2, Username: "janedoe", FirstName: "Jane", LastName: "Doe"},
+			CreatedAt: createdAt,
+		}},
+		Reactions: models.CommentReactionCounts{Like: 4, Heart: 2},
+	}
+}
+
+// News is a published news article
+func News() models.News {
+	return models.News{
+		ID:          1,
+		UUID:        "3c2b1a0d-9e8f-4a7b-b6c5-d4e3f2a1b0c9",
+		Title:       "Major Technology Breakthrough Announced",
+		Slug:        "major-technology-breakthrough-announced",
+		Content:     "Scientists announced a major breakthrough in quantum computing...",
+		Summary:     "A brief summary of the quantum computing breakthrough",
+		Source:      "TechNews",
+		SourceURL:   "https://technews.com/article/12345",
+		ImageURL:    "https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg",
+		Category:    models.NewsCategoryTechnology,
+		Status:      models.NewsStatusPublished,
+		Language:    models.LanguageEnglish,
+		Published:   true,
+		PublishDate: createdAt,
+		ExternalID:  "ext-12345",
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+		Tags:        []models.Tag{Tag()},
+	}
+}
+
+// FreezeWindow is an upcoming content freeze
+func FreezeWindow() models.FreezeWindow {
+	return models.FreezeWindow{
+		ID:        1,
+		Reason:    "Database migration",
+		StartsAt:  time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
+		EndsAt:    time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC),
+		CreatedBy: 1,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+// QueuedPost is a post published during a freeze window, queued until it ends
+func QueuedPost() models.QueuedPost {
+	window := FreezeWindow()
+	post := Post()
+	post.Status = models.PostStatusScheduled
+	post.PublishAt = &window.EndsAt
+	return models.QueuedPost{
+		Post:        post,
+		Queued:      true,
+		FrozenUntil: window.EndsAt,
+	}
+}
+
+// Webhook is an active webhook subscribed to post events
+func Webhook() models.Webhook {
+	return models.Webhook{
+		ID:          1,
+		URL:         "https://example.com/api/revalidate",
+		Description: "Next.js ISR revalidation",
+		Events:      []string{models.WebhookEventPostPublished, models.WebhookEventPostUpdated},
+		Secret:      "whsec_3f9a2c7e1b5d4f6a8c0e2b4d6f8a0c2e4b6d8f0a2c4e6b8d0f2a4c6e8b0d2f4a",
+		Active:      true,
+		CreatedBy:   1,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// Series is a tutorial series with its published posts in order
+func Series() models.Series {
+	post := Post()
+	post.SeriesID = uintPtr(1)
+	post.SeriesPosition = 1
+	return models.Series{
+		ID:          1,
+		UUID:        "7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d",
+		Title:       "Building a Go API",
+		Slug:        "building-a-go-api",
+		Description: "A step-by-step tutorial on building a REST API in Go",
+		UserID:      1,
+		User:        User(),
+		PostCount:   1,
+		Posts:       []models.Post{post},
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// AuditLog is an admin unpublishing a news article
+func AuditLog() models.AuditLog {
+	return models.AuditLog{
+		ID:           1,
+		ActorID:      uintPtr(1),
+		ActorRole:    "admin",
+		Action:       models.AuditActionNewsStatusChanged,
+		ResourceType: "news",
+		ResourceID:   "1",
+		Before:       map[string]interface{}{"status": models.NewsStatusPublished, "published": true},
+		After:        map[string]interface{}{"status": models.NewsStatusArchived, "published": false},
+		RequestID:    "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d",
+		IPAddress:    "203.0.113.7",
+		CreatedAt:    createdAt,
+	}
+}
+
+// Page is a published static page
+func Page() models.Page {
+	return models.Page{
+		ID:        1,
+		Title:     "About",
+		Slug:      "about",
+		Content:   "# About me\n\nI write about Go and the web.",
+		Status:    models.PageStatusPublished,
+		UpdatedBy: uintPtr(1),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+// Examples maps Swagger definition names to the fixture documenting them
+func Examples() map[string]interface{} {
+	webhook := Webhook()
+	sentAt := publishAt.Add(5 * time.Second)
+	crossPost := models.CrossPost{
+		ID:         1,
+		PostID:     1,
+		Target:     models.CrossPostTelegram,
+		Status:     models.CrossPostSent,
+		Attempts:   1,
+		ExternalID: "1234",
+		SentAt:     &sentAt,
+		CreatedAt:  publishAt,
+		UpdatedAt:  sentAt,
+	}
+	readingPercentage := 42
+	checkedAt := publishAt.Add(24 * time.Hour)
+	brokenLink := models.BrokenLink{
+		ContentLink: models.ContentLink{
+			ID:         1,
+			SourceType: models.ContentLinkSourcePost,
+			SourceID:   1,
+			URL:        "https://example.com/gone",
+			Domain:     "example.com",
+			StatusCode: 404,
+			Broken:     true,
+			CheckedAt:  &checkedAt,
+			BrokenAt:   &checkedAt,
+			CreatedAt:  publishAt,
+			UpdatedAt:  checkedAt,
+		},
+		SourceTitle: "My First Blog Post",
+		SourceSlug:  "my-first-blog-post",
+	}
+
+	return map[string]interface{}{
+		"models.User":     User(),
+		"models.Tag":      Tag(),
+		"models.Category": Category(),
+		"models.Post":     Post(),
+		"models.Comment":  Comment(),
+		"models.News":     News(),
+		"models.TagWithCount": models.TagWithCount{
+			ID:        1,
+			Name:      "technology",
+			PostCount: 5,
+		},
+		"models.SwaggerPostsResponse": models.SwaggerPostsResponse{
+			Posts: []models.Post{Post()},
+			Meta: models.SwaggerPostsListMeta{
+				SwaggerPostsMeta: models.SwaggerPostsMeta{
+					Page:     1,
+					Limit:    10,
+					Total:    1,
+					LastPage: 1,
+				},
+			},
+		},
+		"models.SearchResponse": models.SearchResponse{
+			Query: "go api",
+			Posts: &models.SearchGroup{
+				Total: 1,
+				Results: []models.SearchResult{{
+					Type:        models.SearchResultPost,
+					ID:          1,
+					UUID:        "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d",
+					Title:       "Building a REST API in Go",
+					Slug:        "building-a-rest-api-in-go",
+					Snippet:     "A step-by-step guide to building a REST <mark>API</mark> in <mark>Go</mark> with gin and gorm",
+					PublishedAt: &createdAt,
+					Score:       0.42,
+				}},
+			},
+			News: &models.SearchGroup{Total: 0, Results: []models.SearchResult{}},
+			Tags: &models.SearchGroup{
+				Total: 1,
+				Results: []models.SearchResult{{
+					Type:  models.SearchResultTag,
+					ID:    3,
+					Title: "go",
+					Slug:  "go",
+					Score: 0.1,
+				}},
+			},
+		},
+		"models.PublicStats": models.PublicStats{
+			TotalPosts:    42,
+			TotalComments: 310,
+			TotalViews:    15230,
+			YearsBlogging: 2,
+			BloggingSince: &createdAt,
+			GeneratedAt:   publishAt,
+		},
+		"models.ErrorResponse": models.ErrorResponse{
+			Status:  "error",
+			Code:    i18n.CodeInvalidInput,
+			Error:   "Invalid input",
+			Message: "title is required when template_id is not set; language must be one of: en, vi",
+			Details: []apierror.FieldError{
+				{Field: "title", Rule: "required_without", Param: "template_id", Message: "title is required when template_id is not set"},
+				{Field: "language", Rule: "oneof", Param: "en vi", Message: "language must be one of: en, vi"},
+			},
+			RequestID: "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d",
+		},
+		"models.FreezeWindow": FreezeWindow(),
+		"models.QueuedPost":   QueuedPost(),
+		"models.Series":       Series(),
+		"models.SeriesNavigation": models.SeriesNavigation{
+			ID:       1,
+			Title:    "Building a Go API",
+			Slug:     "building-a-go-api",
+			Position: 2,
+			Total:    3,
+			Previous: &models.SeriesPostLink{ID: 1, UUID: "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d", Title: "Part 1: Project setup", Slug: "part-1-project-setup"},
+			Next:     &models.SeriesPostLink{ID: 3, UUID: "8e7d6c5b-4a3f-4e2d-9c1b-0a9f8e7d6c5b", Title: "Part 3: Authentication", Slug: "part-3-authentication"},
+		},
+		"models.Webhook": webhook,
+		"models.WebhookWithSecret": models.WebhookWithSecret{
+			Webhook: webhook,
+			Secret:  webhook.Secret,
+		},
+		"models.WebhookDelivery": models.WebhookDelivery{
+			ID:         1,
+			WebhookID:  webhook.ID,
+			DeliveryID: "5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d",
+			Event:      models.WebhookEventPostPublished,
+			Attempt:    1,
+			StatusCode: 200,
+			Success:    true,
+			DurationMs: 142,
+			CreatedAt:  createdAt,
+		},
+		"models.AuditLog": AuditLog(),
+		"models.AuditLogListResponse": models.AuditLogListResponse{
+			Logs:       []models.AuditLog{AuditLog()},
+			TotalItems: 1,
+			Page:       1,
+			PerPage:    20,
+			TotalPages: 1,
+		},
+		"models.JWTSigningKey": models.JWTSigningKey{
+			KID:       "20230101120000-3f9a2c7e",
+			Source:    models.JWTKeySourceRotation,
+			Signing:   true,
+			CreatedAt: createdAt,
+		},
+		"models.UpdateUserRoleRequest": models.UpdateUserRoleRequest{Role: "editor"},
+		"models.Role": models.Role{
+			ID:          4,
+			Name:        "moderator",
+			Description: "Keeps the comments civil",
+			Permissions: []string{"comment.edit", "comment.delete"},
+			UserCount:   3,
+			CreatedAt:   createdAt,
+			UpdatedAt:   updatedAt,
+		},
+		"models.CreateRoleRequest": models.CreateRoleRequest{
+			Name:        "moderator",
+			Description: "Keeps the comments civil",
+			Permissions: []string{"comment.edit", "comment.delete"},
+		},
+		"models.UpdateRoleRequest": models.UpdateRoleRequest{
+			Description: stringPtr("Moderates comments and pages"),
+			Permissions: []string{"comment.edit", "comment.delete", "page.edit"},
+		},
+		"policy.Permission": policy.Permission{Action: policy.ActionPostCreate, Description: "Write new posts"},
+		"models.Site": models.Site{
+			ID:        2,
+			Name:      "Travel notes",
+			Domain:    "travel.example.com",
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		},
+		"models.CreateSiteRequest": models.CreateSiteRequest{
+			Name:   "Travel notes",
+			Domain: "travel.example.com",
+		},
+		"models.UpdateSiteRequest": models.UpdateSiteRequest{
+			Domain: stringPtr("travel.example.org"),
+		},
+		"models.AnalyticsEventsRequest": models.AnalyticsEventsRequest{
+			Events: []models.AnalyticsEventInput{
+				{Type: models.AnalyticsEventPageview, PostID: 1, ViewID: "4f9c2a7e-0d5b-4c1e-9a0f-3b2d8e6c1a57", ReaderID: "b1e8d0c2-6a3f-4f5e-8d7c-9a2b1c0d3e4f"},
+				{Type: models.AnalyticsEventProgress, PostID: 1, ViewID: "4f9c2a7e-0d5b-4c1e-9a0f-3b2d8e6c1a57", ReaderID: "b1e8d0c2-6a3f-4f5e-8d7c-9a2b1c0d3e4f", Progress: 50},
+			},
+		},
+		"models.AnalyticsEventsResponse": models.AnalyticsEventsResponse{Accepted: 2},
+		"models.PostAnalytics": models.PostAnalytics{
+			PostID:        1,
+			From:          time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			Views:         200,
+			UniqueReaders: 160,
+			ReadThrough:   models.PostReadThrough{Reached25: 0.7, Reached50: 0.5, Reached75: 0.4, Reached100: 0.25},
+			Days: []models.PostAnalyticsDay{
+				{Day: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Views: 120, UniqueReaders: 95, Reached25: 84, Reached50: 60, Reached75: 48, Reached100: 30},
+				{Day: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), Views: 80, UniqueReaders: 65, Reached25: 56, Reached50: 40, Reached75: 32, Reached100: 20},
+			},
+		},
+		"models.PostTemplate": models.PostTemplate{
+			ID:           1,
+			Name:         "Weekly links",
+			Description:  "Links worth reading from the past week",
+			TitlePattern: "Weekly links: week {week}, {year}",
+			Content:      "## Articles\n\n- \n\n## Tools\n\n- ",
+			Excerpt:      "The best links of the week",
+			Tags:         []string{"links", "weekly"},
+			Status:       models.PostStatusDraft,
+			Language:     models.DefaultContentLanguage,
+			UpdatedBy:    uintPtr(1),
+			CreatedAt:    createdAt,
+			UpdatedAt:    updatedAt,
+		},
+		"models.CreatePostTemplateRequest": models.CreatePostTemplateRequest{
+			Name:         "Weekly links",
+			Description:  "Links worth reading from the past week",
+			TitlePattern: "Weekly links: week {week}, {year}",
+			Content:      "## Articles\n\n- \n\n## Tools\n\n- ",
+			Tags:         []string{"links", "weekly"},
+		},
+		"models.UpdatePostTemplateRequest": models.UpdatePostTemplateRequest{
+			TitlePattern: stringPtr("Links of the week {week}"),
+		},
+		"models.CrossPost": crossPost,
+		"models.CrossPostRetryResponse": models.CrossPostRetryResponse{
+			Retried: 1,
+			CrossPosts: []models.CrossPost{crossPost, {
+				ID:            2,
+				PostID:        1,
+				Target:        models.CrossPostX,
+				Status:        models.CrossPostPending,
+				NextAttemptAt: &publishAt,
+				LastError:     "network returned status 503: Service Unavailable",
+				CreatedAt:     publishAt,
+				UpdatedAt:     publishAt,
+			}},
+		},
+		"models.BulkNewsStatusRequest": models.BulkNewsStatusRequest{
+			IDs:    []uint{1, 2, 3},
+			Status: models.NewsStatusArchived,
+		},
+		"models.BulkNewsDeleteRequest": models.BulkNewsDeleteRequest{
+			IDs: []uint{1, 2, 3},
+		},
+		"models.NewsBulkResult": models.NewsBulkResult{
+			Succeeded: 2,
+			NotFound:  1,
+			Items: []models.NewsBulkItem{
+				{NewsID: 1, Status: models.NewsBulkUpdated},
+				{NewsID: 2, Status: models.NewsBulkUnchanged},
+				{NewsID: 3, Status: models.NewsBulkNotFound},
+			},
+		},
+		"models.APIMeta": models.APIMeta{
+			APIVersion:     "1.0",
+			GitSHA:         "3f2a9c1d8e4b",
+			ClientChecksum: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			Features: map[string]bool{
+				"newsletter":             true,
+				"contact_form":           true,
+				"captcha":                true,
+				"comment_emails":         true,
+				"og_images":              true,
+				"cross_posting":          false,
+				"analytics_privacy_mode": false,
+				"autosave":               true,
+			},
+		},
+		"models.LinkSuggestion": models.LinkSuggestion{
+			PostID:     2,
+			Title:      "Structuring a Go API",
+			Slug:       "structuring-a-go-api",
+			URL:        "https://yourdomain.com/posts/structuring-a-go-api",
+			SharedTags: []string{"go", "api"},
+			Keywords:   []string{"handlers", "middleware"},
+			Score:      4.2,
+		},
+		"models.BrokenLink": brokenLink,
+		"models.SwaggerBrokenLinksResponse": models.SwaggerBrokenLinksResponse{
+			Links: []models.BrokenLink{brokenLink},
+			Meta: models.SwaggerPostsMeta{
+				Page:     1,
+				Limit:    10,
+				Total:    1,
+				LastPage: 1,
+			},
+		},
+		"models.ReadingProgress": models.ReadingProgress{
+			PostID:     1,
+			Percentage: 42,
+			Anchor:     "p-12",
+			UpdatedAt:  updatedAt,
+		},
+		"models.SaveReadingProgressRequest": models.SaveReadingProgressRequest{
+			Percentage: &readingPercentage,
+			Anchor:     "p-12",
+		},
+		"models.CommentReactionCounts": models.CommentReactionCounts{Like: 4, Heart: 2},
+		"models.AuthorDashboard": models.AuthorDashboard{
+			DraftCount: 3,
+			ScheduledPosts: []models.Post{{
+				ID:        2,
+				UUID:      "5d0c7f4e-2b8a-4c3d-9e6f-1a2b3c4d5e6f",
+				Title:     "Concurrency Patterns in Go",
+				Slug:      "concurrency-patterns-in-go",
+				Status:    models.PostStatusScheduled,
+				PublishAt: &publishAt,
+				UserID:    1,
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			}},
+			AwaitingReply: []models.Comment{{
+				ID:        7,
+				UUID:      "0e1d2c3b-4a59-4687-a6b5-c4d3e2f1a0b9",
+				Content:   "Could you cover error handling next?",
+				Status:    models.CommentStatusApproved,
+				UserID:    2,
+				User:      models.User{ID: 2, Username: "janedoe", FirstName: "Jane", LastName: "Doe"},
+				PostID:    1,
+				Post:      models.Post{ID: 1, UUID: Post().UUID, Title: Post().Title, Slug: Post().Slug, Status: models.PostStatusPublished, UserID: 1},
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+			}},
+			TopPosts: []models.DashboardPostTrend{{
+				PostID: 1,
+				Title:  Post().Title,
+				Slug:   Post().Slug,
+				Views:  200,
+				Days: []models.StatsPoint{
+					{Period: "2023-01-01", Count: 120},
+					{Period: "2023-01-02", Count: 80},
+				},
+			}},
+			UnreadNotifications: 1,
+			Notifications: []models.Notification{{
+				ID:        1,
+				Type:      models.NotificationPostComment,
+				ActorID:   uintPtr(2),
+				PostID:    uintPtr(1),
+				CommentID: uintPtr(7),
+				CreatedAt: createdAt,
+			}},
+			GeneratedAt: updatedAt,
+		},
+		"models.AutosavePostRequest": models.AutosavePostRequest{
+			Title:         "Getting Started with Go",
+			Excerpt:       "Learn the basics of Go",
+			Content:       "Go is a statically typed language...",
+			BaseUpdatedAt: &updatedAt,
+		},
+		"models.PostAutosaveResult": models.PostAutosaveResult{
+			Autosave: models.PostAutosave{
+				ID:            1,
+				PostID:        1,
+				UserID:        1,
+				Title:         "Getting Started with Go",
+				Excerpt:       "Learn the basics of Go",
+				Content:       "Go is a statically typed language...",
+				BaseUpdatedAt: updatedAt,
+				CreatedAt:     publishAt,
+				UpdatedAt:     publishAt.Add(20 * time.Second),
+			},
+			PostUpdatedAt: updatedAt,
+		},
+		"models.UserDeletion": models.UserDeletion{
+			ID:          1,
+			UserID:      42,
+			Strategy:    models.UserDeletionReassign,
+			DeletedBy:   1,
+			GhostUserID: uintPtr(7),
+			PostIDs:     []uint{3, 8},
+			CommentIDs:  []uint{12},
+			UndoUntil:   time.Date(2023, 1, 4, 12, 0, 0, 0, time.UTC),
+			CreatedAt:   createdAt,
+		},
+		"models.CreatePostRequest": models.CreatePostRequest{
+			Title:      "My New Post",
+			Content:    "This is the content of my new post",
+			Excerpt:    "A short excerpt",
+			Cover:      "https://example.com/image.jpg",
+			Tags:       []string{"technology", "programming"},
+			Status:     models.PostStatusPublished,
+			CategoryID: uintPtr(1),
+			Language:   models.LanguageEnglish,
+		},
+		"models.UpdatePostRequest": models.UpdatePostRequest{
+			Title:   stringPtr("Updated Post Title"),
+			Tags:    []string{"technology", "programming", "updated"},
+			Version: intPtr(3),
+		},
+		"models.SetPostStatusRequest": models.SetPostStatusRequest{
+			Status:    models.PostStatusScheduled,
+			PublishAt: &publishAt,
+		},
+		"models.CreateCommentRequest": models.CreateCommentRequest{
+			Content: "This is a great post!",
+		},
+		"models.CreateCategoryRequest": models.CreateCategoryRequest{
+			Name:        "Backend",
+			Description: "Posts about server-side development",
+			ParentID:    uintPtr(2),
+		},
+		"models.Page": Page(),
+		"models.CreatePageRequest": models.CreatePageRequest{
+			Title:   "About",
+			Content: "# About me\n\nI write about Go and the web.",
+			Status:  models.PageStatusDraft,
+		},
+		"models.ContactRequest": models.ContactRequest{
+			Name:         "Jane Reader",
+			Email:        "jane@example.com",
+			Message:      "I enjoyed your post on Go generics.",
+			CaptchaToken: "10000000-aaaa-bbbb-cccc-000000000001",
+		},
+		"models.CreateSeriesRequest": models.CreateSeriesRequest{
+			Title:       "Building a Go API",
+			Description: "A step-by-step tutorial on building a REST API in Go",
+		},
+		"models.AddSeriesPostRequest": models.AddSeriesPostRequest{
+			PostID:   "1",
+			Position: intPtr(2),
+		},
+		"models.CreateFreezeWindowRequest": models.CreateFreezeWindowRequest{
+			Reason:   "Database migration",
+			StartsAt: time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
+			EndsAt:   time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		"models.CreateWebhookRequest": models.CreateWebhookRequest{
+			URL:         webhook.URL,
+			Description: webhook.Description,
+			Events:      webhook.Events,
+		},
+		"models.CreateNewsRequest": models.CreateNewsRequest{
+			Title:     "Major Technology Breakthrough Announced",
+			Content:   "Scientists announced a major breakthrough in quantum computing...",
+			Summary:   "A brief summary of the quantum computing breakthrough",
+			Source:    "TechNews",
+			SourceURL: "https://technews.com/article/12345",
+			Category:  models.NewsCategoryTechnology,
+			Status:    models.NewsStatusPublished,
+			Language:  models.LanguageEnglish,
+			Tags:      []string{"technology", "quantum computing"},
+		},
+		"models.EnrichNewsBatchRequest": models.EnrichNewsBatchRequest{
+			Limit:       10,
+			RetryFailed: true,
+		},
+		"models.NewsEnrichmentBatchResult": models.NewsEnrichmentBatchResult{
+			Enriched: 1,
+			Failed:   1,
+			Skipped:  1,
+			Items: []models.NewsEnrichmentItem{
+				{NewsID: 1, Status: models.NewsEnrichmentEnriched},
+				{NewsID: 2, Status: models.NewsEnrichmentFailed, Error: "source returned non-OK status: 403"},
+				{NewsID: 3, Status: models.NewsEnrichmentNotTruncated},
+			},
+		},
+		"models.NewsEnrichmentStatus": models.NewsEnrichmentStatus{
+			Total:          1200,
+			Truncated:      450,
+			Enriched:       380,
+			Failed:         25,
+			Pending:        45,
+			LastEnrichedAt: &updatedAt,
+		},
+		"models.LoginRequest": models.LoginRequest{
+			Email:    "john@example.com",
+			Password: "secret123",
+		},
+		"models.RegisterRequest": models.RegisterRequest{
+			Username:  "johndoe",
+			Email:     "john@example.com",
+			Password:  "secret123",
+			FirstName: "John",
+			LastName:  "Doe",
+		},
+		"models.TokenResponse": models.TokenResponse{
+			AccessToken:  "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+			RefreshToken: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+			TokenType:    "Bearer",
+			ExpiresIn:    86400,
+		},
+		"models.RefreshTokenRequest": models.RefreshTokenRequest{
+			RefreshToken: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+		},
+		"models.TokenRevokeRequest": models.TokenRevokeRequest{
+			RefreshToken: "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+		},
+		"models.LogoutRequest": models.LogoutRequest{
+			RevokeAll: true,
+		},
+		"models.SwaggerRegisteredUser": models.SwaggerRegisteredUser{
+			ID:       1,
+			Username: "johndoe",
+			Email:    "john@example.com",
+		},
+		"models.UpdateProfileRequest": models.UpdateProfileRequest{
+			Bio:           stringPtr("Software developer writing about Go and the web"),
+			CommentEmails: stringPtr(string(models.CommentEmailsDaily)),
+		},
+		"models.DeleteFileRequest": models.DeleteFileRequest{
+			FileURL: "https://res.cloudinary.com/demo/image/upload/v1234567890/uploads/diagram.png",
+		},
+	}
+}
@@ -0,0 +1,68 @@
+// Package graphql serves the GraphQL endpoint. The schema is in
+// schema.graphqls and gqlgen generates the executor in generated.go from it;
+// regenerate after editing the schema or gqlgen.yml. The resolvers call the
+// REST handlers they mirror with the caller's credentials, so permissions,
+// validation and error codes are the same as over REST.
+package graphql
+
+//go:generate go tool gqlgen generate
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gin-gonic/gin"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// queryCacheSize is how many parsed queries the server keeps
+const queryCacheSize = 1000
+
+// Endpoints are the REST handlers the resolvers call
+type Endpoints struct {
+	GetPosts            gin.HandlerFunc
+	GetPostBySlug       gin.HandlerFunc
+	GetCommentsByPostID gin.HandlerFunc
+	GetAllTags          gin.HandlerFunc
+	GetNews             gin.HandlerFunc
+	GetNewsBySlug       gin.HandlerFunc
+	GetProfile          gin.HandlerFunc
+	CreatePost          gin.HandlerFunc
+	UpdatePost          gin.HandlerFunc
+	DeletePost          gin.HandlerFunc
+}
+
+// Resolver is the root resolver
+type Resolver struct {
+	endpoints Endpoints
+}
+
+// NewServer returns the HTTP handler of the endpoint. Queries may be sent
+// with GET or POST, mutations only with POST. Requests must be passed
+// through WithGinContext so resolvers can call the REST handlers.
+func NewServer(endpoints Endpoints) *handler.Server {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{endpoints: endpoints}}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](queryCacheSize))
+	srv.Use(extension.Introspection{})
+	return srv
+}
+
+// ginContextKey is the context key of the request's gin.Context
+type ginContextKey struct{}
+
+// WithGinContext returns the request of c carrying c, for the resolvers
+func WithGinContext(c *gin.Context) *http.Request {
+	return c.Request.WithContext(context.WithValue(c.Request.Context(), ginContextKey{}, c))
+}
+
+// ginContext returns the gin.Context stored by WithGinContext
+func ginContext(ctx context.Context) *gin.Context {
+	c, _ := ctx.Value(ginContextKey{}).(*gin.Context)
+	return c
+}
@@ -0,0 +1,202 @@
+package graphql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// restCall is a request a resolver makes to a REST handler
+type restCall struct {
+	handler gin.HandlerFunc
+	params  gin.Params
+	query   url.Values
+	body    interface{}
+}
+
+// errorsMu guards the request's gin errors, which resolvers running in
+// parallel record failures in
+var errorsMu sync.Mutex
+
+// serve runs a REST handler on a copy of the GraphQL request, with the
+// caller's authentication, and returns its response. An error reported by
+// the handler is returned as a *gqlerror.Error.
+func serve(ctx context.Context, call restCall) (*bufferedWriter, error) {
+	c := ginContext(ctx)
+	if c == nil {
+		return nil, errors.New("request has no gin context")
+	}
+
+	req := c.Request.Clone(ctx)
+	req.URL.RawQuery = call.query.Encode()
+	req.Body = http.NoBody
+	req.ContentLength = 0
+	if call.body != nil {
+		body, err := json.Marshal(call.body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode input: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	writer := &bufferedWriter{header: http.Header{}}
+	sub := c.Copy()
+	sub.Request = req
+	sub.Writer = writer
+	sub.Params = call.params
+	call.handler(sub)
+
+	if len(sub.Errors) > 0 && !writer.Written() {
+		var apiErr *apierror.Error
+		if !errors.As(sub.Errors.Last().Err, &apiErr) {
+			apiErr = apierror.Internal(i18n.CodeInternalError, sub.Errors.Last().Err)
+		}
+		if apiErr.Status >= http.StatusInternalServerError {
+			// Record the cause so it appears in the request log
+			errorsMu.Lock()
+			_ = c.Error(apiErr)
+			errorsMu.Unlock()
+		}
+		return nil, newError(c, apiErr)
+	}
+	return writer, nil
+}
+
+// call runs a REST handler like serve and decodes its JSON response into
+// target
+func call(ctx context.Context, rest restCall, target interface{}) error {
+	writer, err := serve(ctx, rest)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(writer.body.Bytes(), target); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// requireUser fails a resolver for anonymous callers. The REST routes the
+// resolvers call rely on AuthMiddleware having set the user.
+func requireUser(ctx context.Context) error {
+	c := ginContext(ctx)
+	if c == nil {
+		return errors.New("request has no gin context")
+	}
+	if _, ok := c.Get("userID"); !ok {
+		return newError(c, apierror.Unauthorized(i18n.CodeAuthRequired))
+	}
+	return nil
+}
+
+// newError reports an API error in a GraphQL response, with the code, HTTP
+// status and details the REST endpoint would have sent as extensions
+func newError(c *gin.Context, apiErr *apierror.Error) *gqlerror.Error {
+	response := middleware.ErrorResponse(c, apiErr)
+	extensions := map[string]interface{}{
+		"code":   response.Code,
+		"status": apiErr.Status,
+	}
+	if response.Details != nil {
+		extensions["details"] = response.Details
+	}
+	return &gqlerror.Error{Err: apiErr, Message: response.Message, Extensions: extensions}
+}
+
+// pageQuery builds a REST query string from optional GraphQL arguments,
+// keyed by query parameter name
+func pageQuery(ints map[string]*int, strings map[string]*string) url.Values {
+	values := url.Values{}
+	for param, n := range ints {
+		if n != nil {
+			values.Set(param, strconv.Itoa(*n))
+		}
+	}
+	for param, s := range strings {
+		if s != nil && *s != "" {
+			values.Set(param, *s)
+		}
+	}
+	return values
+}
+
+// idParam is the path parameter of a REST route that takes an ID
+func idParam(id uint) gin.Params {
+	return gin.Params{{Key: "id", Value: strconv.FormatUint(uint64(id), 10)}}
+}
+
+// bufferedWriter is the gin.ResponseWriter of a REST handler called by a
+// resolver. It keeps the response in memory.
+type bufferedWriter struct {
+	header  http.Header
+	body    bytes.Buffer
+	status  int
+	written bool
+}
+
+func (w *bufferedWriter) Header() http.Header { return w.header }
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	w.WriteHeaderNow()
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.WriteHeaderNow()
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if !w.written {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.written = true
+}
+
+func (w *bufferedWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool { return w.written }
+
+func (w *bufferedWriter) Flush() {}
+
+func (w *bufferedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, errors.New("hijacking is not supported for internal calls")
+}
+
+func (w *bufferedWriter) CloseNotify() <-chan bool { return make(chan bool) }
+
+func (w *bufferedWriter) Pusher() http.Pusher { return nil }
@@ -0,0 +1,205 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// CreatePost is the resolver for the createPost field.
+func (r *mutationResolver) CreatePost(ctx context.Context, input models.CreatePostRequest) (*models.Post, error) {
+	if err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+	var post models.Post
+	if err := call(ctx, restCall{handler: r.endpoints.CreatePost, body: input}, &post); err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// UpdatePost is the resolver for the updatePost field.
+func (r *mutationResolver) UpdatePost(ctx context.Context, id uint, input models.UpdatePostRequest) (*models.Post, error) {
+	if err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+	var post models.Post
+	if err := call(ctx, restCall{handler: r.endpoints.UpdatePost, params: idParam(id), body: input}, &post); err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+// DeletePost is the resolver for the deletePost field.
+func (r *mutationResolver) DeletePost(ctx context.Context, id uint) (*bool, error) {
+	if err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := serve(ctx, restCall{handler: r.endpoints.DeletePost, params: idParam(id)}); err != nil {
+		return nil, err
+	}
+	deleted := true
+	return &deleted, nil
+}
+
+// Category is the resolver for the category field.
+func (r *newsResolver) Category(ctx context.Context, obj *models.News) (string, error) {
+	return string(obj.Category), nil
+}
+
+// Category is the resolver for the category field.
+func (r *newsSummaryResolver) Category(ctx context.Context, obj *models.NewsWithoutContent) (string, error) {
+	return string(obj.Category), nil
+}
+
+// Status is the resolver for the status field.
+func (r *postResolver) Status(ctx context.Context, obj *models.Post) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Comments is the resolver for the comments field.
+func (r *postResolver) Comments(ctx context.Context, obj *models.Post) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	if err := call(ctx, restCall{handler: r.endpoints.GetCommentsByPostID, params: gin.Params{{Key: "id", Value: obj.UUID}}}, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// Posts is the resolver for the posts field.
+func (r *queryResolver) Posts(ctx context.Context, page *int, limit *int, tag *string, category *string) (*models.SwaggerPostsResponse, error) {
+	query := pageQuery(map[string]*int{"page": page, "limit": limit}, map[string]*string{"tag": tag, "category": category})
+	var posts models.SwaggerPostsResponse
+	if err := call(ctx, restCall{handler: r.endpoints.GetPosts, query: query}, &posts); err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// Post is the resolver for the post field.
+func (r *queryResolver) Post(ctx context.Context, slug string) (*models.Post, error) {
+	var post models.Post
+	writer, err := serve(ctx, restCall{handler: r.endpoints.GetPostBySlug, params: gin.Params{{Key: "slug", Value: slug}}})
+	if err == nil && writer.Status() == http.StatusMovedPermanently {
+		// The slug was renamed; fetch the post under its current one
+		var redirect models.SlugRedirect
+		if err := json.Unmarshal(writer.body.Bytes(), &redirect); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		writer, err = serve(ctx, restCall{handler: r.endpoints.GetPostBySlug, params: gin.Params{{Key: "slug", Value: redirect.Slug}}})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(writer.body.Bytes(), &post); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &post, nil
+}
+
+// Comments is the resolver for the comments field.
+func (r *queryResolver) Comments(ctx context.Context, postID uint) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	if err := call(ctx, restCall{handler: r.endpoints.GetCommentsByPostID, params: idParam(postID)}, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context) ([]*models.TagWithCount, error) {
+	var tags []*models.TagWithCount
+	if err := call(ctx, restCall{handler: r.endpoints.GetAllTags}, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// News is the resolver for the news field.
+func (r *queryResolver) News(ctx context.Context, page *int, perPage *int, category *string, tag *string, search *string) (*models.NewsWithoutContentResponse, error) {
+	query := pageQuery(map[string]*int{"page": page, "per_page": perPage}, map[string]*string{"category": category, "tag": tag, "search": search})
+	var news models.NewsWithoutContentResponse
+	if err := call(ctx, restCall{handler: r.endpoints.GetNews, query: query}, &news); err != nil {
+		return nil, err
+	}
+	return &news, nil
+}
+
+// NewsArticle is the resolver for the newsArticle field.
+func (r *queryResolver) NewsArticle(ctx context.Context, slug string) (*models.NewsWithContentStatus, error) {
+	var article models.NewsWithContentStatus
+	if err := call(ctx, restCall{handler: r.endpoints.GetNewsBySlug, params: gin.Params{{Key: "slug", Value: slug}}}, &article); err != nil {
+		return nil, err
+	}
+	return &article, nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*models.User, error) {
+	if err := requireUser(ctx); err != nil {
+		return nil, err
+	}
+	// The profile endpoint wraps the user in {"status", "data"}
+	var profile struct {
+		Data models.User `json:"data"`
+	}
+	if err := call(ctx, restCall{handler: r.endpoints.GetProfile}, &profile); err != nil {
+		return nil, err
+	}
+	return &profile.Data, nil
+}
+
+// Status is the resolver for the status field.
+func (r *createPostInputResolver) Status(ctx context.Context, obj *models.CreatePostRequest, data *string) error {
+	if data != nil {
+		obj.Status = models.PostStatus(*data)
+	}
+	return nil
+}
+
+// Status is the resolver for the status field.
+func (r *updatePostInputResolver) Status(ctx context.Context, obj *models.UpdatePostRequest, data *string) error {
+	if data != nil {
+		status := models.PostStatus(*data)
+		obj.Status = &status
+	}
+	return nil
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// News returns NewsResolver implementation.
+func (r *Resolver) News() NewsResolver { return &newsResolver{r} }
+
+// NewsSummary returns NewsSummaryResolver implementation.
+func (r *Resolver) NewsSummary() NewsSummaryResolver { return &newsSummaryResolver{r} }
+
+// Post returns PostResolver implementation.
+func (r *Resolver) Post() PostResolver { return &postResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// CreatePostInput returns CreatePostInputResolver implementation.
+func (r *Resolver) CreatePostInput() CreatePostInputResolver { return &createPostInputResolver{r} }
+
+// UpdatePostInput returns UpdatePostInputResolver implementation.
+func (r *Resolver) UpdatePostInput() UpdatePostInputResolver { return &updatePostInputResolver{r} }
+
+type mutationResolver struct{ *Resolver }
+type newsResolver struct{ *Resolver }
+type newsSummaryResolver struct{ *Resolver }
+type postResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type createPostInputResolver struct{ *Resolver }
+type updatePostInputResolver struct{ *Resolver }
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DeleteAccount godoc
+// @Summary Delete your account
+// @Description Deletes the current user's account after checking their password. The profile is anonymized, so posts and comments stay up under "Deleted User", and every session is signed out. An admin can restore the account until undo_until; after that it is purged for good. Admins must hand over their role before deleting their account.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body models.DeleteAccountRequest true "Password confirmation"
+// @Success 200 {object} models.UserDeletion "Deletion record"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Incorrect password"
+// @Failure 403 {object} models.ErrorResponse "Admins can't delete their account"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile [delete]
+func (h *Handler) DeleteAccount(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	user, err := h.usersFor(c).FindByID(userID.(uint))
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(requestBody.Password)); err != nil {
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeInvalidCredentials))
+		return
+	}
+
+	// Keep the blog from losing its last admin to a single request
+	if policy.Grants(user.Role, policy.ActionAdminAccess) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodeAccountDeleteAdmin))
+		return
+	}
+
+	before := gin.H{"username": user.Username, "email": user.Email, "role": user.Role}
+	deletion, err := services.NewAccountService(h.dbFor(c)).SoftDelete(user, models.UserDeletionAnonymize, user.ID, h.cfg.Users.DeletionUndoWindow)
+	if err != nil {
+		log.Error().Err(err).Uint("user_id", user.ID).Msg("Failed to delete account")
+		middleware.Abort(c, apierror.Internal(i18n.CodeAccountDeleteFailed, err))
+		return
+	}
+
+	log.Info().Uint("user_id", user.ID).Time("undo_until", deletion.UndoUntil).Msg("Account deleted by its owner")
+	h.recordAudit(c, models.AuditActionUserDeleted, "user", user.ID, before, gin.H{
+		"deletion_id": deletion.ID,
+		"strategy":    deletion.Strategy,
+		"undo_until":  deletion.UndoUntil,
+	})
+
+	c.JSON(http.StatusOK, deletion)
+}
+
+// ExportAccountData godoc
+// @Summary Export your data
+// @Description Downloads everything the blog stores about the current user: profile, posts, comments, post and news bookmarks and series, either as one JSON document or as a zip archive with a JSON file for each
+// @Tags Users
+// @Produce json
+// @Produce application/zip
+// @Param format query string false "json (default) or zip"
+// @Success 200 {object} models.UserDataExport "JSON export, or a zip archive for the zip format"
+// @Failure 400 {object} models.ErrorResponse "Invalid format"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/export [get]
+func (h *Handler) ExportAccountData(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "zip" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeAccountExportFormatInvalid))
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	export, err := services.NewAccountService(h.dbFor(c)).Export(userID.(uint))
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeAccountExportFailed, err))
+		return
+	}
+
+	// Encode into a buffer so a failure can still be reported as an error
+	var buf bytes.Buffer
+	filename := export.Profile.Username + "-data-" + time.Now().UTC().Format("20060102")
+	contentType := "application/json"
+	if format == "zip" {
+		err = services.WriteUserDataArchive(&buf, export)
+		filename += ".zip"
+		contentType = "application/zip"
+	} else {
+		err = services.WriteUserDataJSON(&buf, export)
+		filename += ".json"
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeAccountExportFailed, err))
+		return
+	}
+
+	log.Info().Interface("user_id", userID).Str("format", format).Msg("Account data exported")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
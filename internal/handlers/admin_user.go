@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// errUserConflict is returned when a restored user's username or email has since been taken
+var errUserConflict = errors.New("username or email is already in use")
+
+// DeleteUser godoc
+// @Summary Delete a user
+// @Description Soft-deletes a user and anonymizes, reassigns to a ghost author, or deletes their posts and comments. The deletion can be undone until undo_until.
+// @Tags Admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Param strategy query string false "What to do with the user's content: anonymize (default), reassign, delete"
+// @Success 200 {object} models.UserDeletion "Deletion record"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/users/{id} [delete]
+func (h *Handler) DeleteUser(c *gin.Context) {
+	adminID, _ := c.Get("userID")
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidUserID))
+		return
+	}
+
+	strategy := models.UserDeletionStrategy(c.DefaultQuery("strategy", string(models.UserDeletionAnonymize)))
+	switch strategy {
+	case models.UserDeletionAnonymize, models.UserDeletionReassign, models.UserDeletionDelete:
+	default:
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeUserDeletionStrategyInvalid))
+		return
+	}
+
+	if uint(id) == adminID.(uint) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeUserDeleteSelf))
+		return
+	}
+
+	user, err := h.usersFor(c).FindByID(uint(id))
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
+		return
+	}
+
+	if user.Username == models.GhostUsername {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeUserDeleteGhost))
+		return
+	}
+
+	deletion, err := services.NewAccountService(h.dbFor(c)).SoftDelete(user, strategy, adminID.(uint), h.cfg.Users.DeletionUndoWindow)
+	if err != nil {
+		log.Error().Err(err).Uint("user_id", user.ID).Str("strategy", string(strategy)).Msg("Failed to delete user")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUserDeleteFailed, err))
+		return
+	}
+
+	log.Info().
+		Uint("user_id", user.ID).
+		Uint("deleted_by", deletion.DeletedBy).
+		Str("strategy", string(strategy)).
+		Int("posts", len(deletion.PostIDs)).
+		Int("comments", len(deletion.CommentIDs)).
+		Msg("User deleted")
+
+	h.recordAudit(c, models.AuditActionUserDeleted, "user", user.ID, gin.H{
+		"username": user.Username,
+		"email":    user.Email,
+		"role":     user.Role,
+	}, gin.H{
+		"deletion_id": deletion.ID,
+		"strategy":    deletion.Strategy,
+		"post_ids":    deletion.PostIDs,
+		"comment_ids": deletion.CommentIDs,
+		"undo_until":  deletion.UndoUntil,
+	})
+
+	c.JSON(http.StatusOK, deletion)
+}
+
+// RestoreUser godoc
+// @Summary Restore a deleted user
+// @Description Undoes the most recent deletion of a user, including their posts and comments, while the undo window is still open
+// @Tags Admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} models.UserDeletion "Restored deletion record"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "No restorable deletion found"
+// @Failure 409 {object} models.ErrorResponse "Username or email already in use"
+// @Failure 410 {object} models.ErrorResponse "Undo window has expired"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/users/{id}/restore [post]
+func (h *Handler) RestoreUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidUserID))
+		return
+	}
+
+	var deletion models.UserDeletion
+	if err := h.dbFor(c).Where("user_id = ? AND restored_at IS NULL", id).
+		Order("created_at DESC").First(&deletion).Error; err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserDeletionNotFound))
+		return
+	}
+
+	if time.Now().After(deletion.UndoUntil) {
+		middleware.Abort(c, apierror.New(http.StatusGone, i18n.CodeUserRestoreExpired))
+		return
+	}
+
+	err = h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Unscoped().First(&user, deletion.UserID).Error; err != nil {
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+
+		updates := map[string]interface{}{"deleted_at": nil}
+		if deletion.Strategy == models.UserDeletionAnonymize && deletion.Snapshot != nil {
+			var count int64
+			if err := tx.Model(&models.User{}).
+				Where("(username = ? OR email = ?) AND id != ?", deletion.Snapshot.Username, deletion.Snapshot.Email, user.ID).
+				Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check for conflicting users: %w", err)
+			}
+			if count > 0 {
+				return errUserConflict
+			}
+
+			updates["username"] = deletion.Snapshot.Username
+			updates["email"] = deletion.Snapshot.Email
+			updates["first_name"] = deletion.Snapshot.FirstName
+			updates["last_name"] = deletion.Snapshot.LastName
+			updates["bio"] = deletion.Snapshot.Bio
+			updates["profile_image"] = deletion.Snapshot.ProfileImage
+		}
+		if err := tx.Unscoped().Model(&user).Updates(updates).Error; err != nil {
+			return fmt.Errorf("failed to restore user: %w", err)
+		}
+
+		switch deletion.Strategy {
+		case models.UserDeletionReassign:
+			if deletion.GhostUserID == nil {
+				break
+			}
+			if len(deletion.PostIDs) > 0 {
+				if err := tx.Model(&models.Post{}).
+					Where("id IN ? AND user_id = ?", deletion.PostIDs, *deletion.GhostUserID).
+					Update("user_id", user.ID).Error; err != nil {
+					return fmt.Errorf("failed to restore posts: %w", err)
+				}
+			}
+			if len(deletion.CommentIDs) > 0 {
+				if err := tx.Model(&models.Comment{}).
+					Where("id IN ? AND user_id = ?", deletion.CommentIDs, *deletion.GhostUserID).
+					Update("user_id", user.ID).Error; err != nil {
+					return fmt.Errorf("failed to restore comments: %w", err)
+				}
+			}
+		case models.UserDeletionDelete:
+			if len(deletion.PostIDs) > 0 {
+				if err := tx.Unscoped().Model(&models.Post{}).
+					Where("id IN ?", deletion.PostIDs).
+					Update("deleted_at", nil).Error; err != nil {
+					return fmt.Errorf("failed to restore posts: %w", err)
+				}
+			}
+			if len(deletion.CommentIDs) > 0 {
+				if err := tx.Unscoped().Model(&models.Comment{}).
+					Where("id IN ?", deletion.CommentIDs).
+					Update("deleted_at", nil).Error; err != nil {
+					return fmt.Errorf("failed to restore comments: %w", err)
+				}
+			}
+		}
+
+		now := time.Now()
+		deletion.RestoredAt = &now
+		deletion.Snapshot = nil
+		return tx.Save(&deletion).Error
+	})
+	if errors.Is(err, errUserConflict) {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeUserRestoreConflict))
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Uint64("user_id", id).Msg("Failed to restore user")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUserRestoreFailed, err))
+		return
+	}
+
+	log.Info().Uint("user_id", deletion.UserID).Str("strategy", string(deletion.Strategy)).Msg("User restored")
+	h.recordAudit(c, models.AuditActionUserRestored, "user", deletion.UserID, nil, gin.H{
+		"deletion_id": deletion.ID,
+		"strategy":    deletion.Strategy,
+	})
+
+	c.JSON(http.StatusOK, deletion)
+}
+
+// PurgeDeletedUsers godoc
+// @Summary Purge deleted users
+// @Description Permanently removes users whose deletion can no longer be undone. Their posts, comments and series move to the ghost author; their bookmarks, notifications, API keys, saved views, sessions and newsletter subscription are deleted, and so is the account. Purged users can't be restored.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} models.UserPurgeResult "Purged users"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/users/purge [post]
+func (h *Handler) PurgeDeletedUsers(c *gin.Context) {
+	purged, err := services.NewAccountService(h.dbFor(c)).PurgeExpired()
+	for _, userID := range purged {
+		h.recordAudit(c, models.AuditActionUserPurged, "user", userID, nil, nil)
+	}
+	if err != nil {
+		log.Error().Err(err).Int("purged", len(purged)).Msg("Failed to purge deleted users")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUserPurgeFailed, err))
+		return
+	}
+
+	log.Info().Int("purged", len(purged)).Msg("Deleted users purged")
+	c.JSON(http.StatusOK, models.UserPurgeResult{Purged: len(purged), UserIDs: purged})
+}
+
+// UpdateUserRole godoc
+// @Summary Change a user's role
+// @Description Sets a user's role to a built-in or custom role from GET /admin/roles. The user's refresh tokens are revoked so the new role applies from their next sign-in; access tokens already issued keep the old role until they expire.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param request body models.UpdateUserRoleRequest true "New role"
+// @Success 200 {object} models.User "Updated user"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/users/{id}/role [put]
+func (h *Handler) UpdateUserRole(c *gin.Context) {
+	adminID, _ := c.Get("userID")
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidUserID))
+		return
+	}
+
+	var requestBody models.UpdateUserRoleRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	// Keep admins from locking themselves out
+	if uint(id) == adminID.(uint) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeUserRoleChangeSelf))
+		return
+	}
+
+	exists, err := services.NewRoleService(h.dbFor(c)).Exists(requestBody.Role)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeUserRoleUpdateFailed, err))
+		return
+	}
+	if !exists {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeUnknownRole))
+		return
+	}
+
+	user, err := h.usersFor(c).FindByID(uint(id))
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
+		return
+	}
+
+	previous := user.Role
+	if previous != requestBody.Role {
+		err = h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Model(user).Update("role", requestBody.Role).Error; err != nil {
+				return err
+			}
+			return tx.Model(&models.RefreshToken{}).
+				Where("user_id = ? AND revoked = ?", user.ID, false).
+				Update("revoked", true).Error
+		})
+		if err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeUserRoleUpdateFailed, err))
+			return
+		}
+
+		log.Info().Uint("user_id", user.ID).Str("from", previous).Str("to", requestBody.Role).Msg("User role changed")
+		h.recordAudit(c, models.AuditActionUserRoleChanged, "user", user.ID, gin.H{"role": previous}, gin.H{"role": requestBody.Role})
+	}
+
+	c.JSON(http.StatusOK, user)
+}
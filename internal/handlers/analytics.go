@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// RecordAnalyticsEvents godoc
+// @Summary Report reader events
+// @Description Stores a batch of pageview and read progress events from post pages. Events for unknown or unpublished posts are dropped. The reader ID and the signed-in user are only stored when the reader may be tracked individually: not in privacy mode, without Do Not Track or Global Privacy Control, and when the user didn't opt out.
+// @Tags Analytics
+// @Accept json
+// @Produce json
+// @Param request body models.AnalyticsEventsRequest true "Events, at most 50"
+// @Success 202 {object} models.AnalyticsEventsResponse "Events stored"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /analytics/events [post]
+func (h *Handler) RecordAnalyticsEvents(c *gin.Context) {
+	var requestBody models.AnalyticsEventsRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	// The opt-out is part of the user's profile
+	var user *models.User
+	userID := c.GetUint("userID")
+	if userID != 0 {
+		found, err := h.usersFor(c).FindByID(userID)
+		if err != nil {
+			log.Warn().Err(err).Uint("user_id", userID).Msg("Failed to load user for analytics, counting them anonymously")
+			userID = 0
+		} else {
+			user = found
+		}
+	}
+	individual := services.NewAnalyticsPolicy(h.cfg.Analytics).AllowsIndividualTracking(c.Request, user)
+
+	accepted, err := services.NewAnalyticsService(h.dbFor(c), h.cfg.Analytics).Record(requestBody.Events, individual, userID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to record analytics events")
+		middleware.Abort(c, apierror.Internal(i18n.CodeAnalyticsRecordFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, models.AnalyticsEventsResponse{Accepted: accepted})
+}
+
+// GetPostAnalytics godoc
+// @Summary Get a post's reader analytics
+// @Description Returns the views, unique readers and read-through rates of a post, in total and per day. Counters are updated every ANALYTICS_ROLLUP_INTERVAL (default 15m). Only the post's authors and users whose role grants post.analytics can see them.
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Param days query int false "Number of days to cover, today included (default: 30, max: 365)"
+// @Success 200 {object} models.PostAnalytics "Post analytics"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/analytics [get]
+func (h *Handler) GetPostAnalytics(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return
+	}
+
+	if !can(c, policy.ActionPostAnalytics, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostAnalyticsForbidden))
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 {
+		days = 30
+	}
+	if days > 365 {
+		days = 365
+	}
+
+	analytics, err := services.NewAnalyticsService(h.dbFor(c), h.cfg.Analytics).PostAnalytics(post.ID, days, time.Now())
+	if err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to fetch post analytics")
+		middleware.Abort(c, apierror.Internal(i18n.CodePostAnalyticsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, analytics)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetAPIKeys godoc
+// @Summary List your API keys
+// @Description Returns the current user's API keys, newest first, including revoked ones. The keys themselves are not included.
+// @Tags Profile
+// @Produce json
+// @Success 200 {array} models.APIKey "API keys"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Requested with an API key"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/api-keys [get]
+func (h *Handler) GetAPIKeys(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	keys, err := services.NewAPIKeyService(h.dbFor(c)).List(userID.(uint))
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeAPIKeysFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, keys)
+}
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Creates an API key that acts as the current user when sent in the X-API-Key header. The read scope allows GET requests and the write scope all others. The key is only returned in this response.
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Param request body models.CreateAPIKeyRequest true "Key name, scopes and expiry"
+// @Success 201 {object} models.CreateAPIKeyResponse "Created key"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Requested with an API key"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/api-keys [post]
+func (h *Handler) CreateAPIKey(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	key, apiKey, err := services.NewAPIKeyService(h.dbFor(c)).Create(userID.(uint), requestBody)
+	if err != nil {
+		log.Error().Err(err).Interface("user_id", userID).Msg("Failed to create API key")
+		middleware.Abort(c, apierror.Internal(i18n.CodeAPIKeyCreateFailed, err))
+		return
+	}
+
+	log.Info().Interface("user_id", userID).Uint("api_key_id", apiKey.ID).Str("prefix", apiKey.Prefix).Msg("API key created")
+	c.JSON(http.StatusCreated, models.CreateAPIKeyResponse{APIKey: *apiKey, Key: key})
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revokes one of the current user's API keys. Requests made with it fail from then on.
+// @Tags Profile
+// @Produce json
+// @Param id path int true "API key ID"
+// @Success 200 {object} models.SwaggerStandardResponse "API key revoked"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Requested with an API key"
+// @Failure 404 {object} models.ErrorResponse "API key not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/api-keys/{id} [delete]
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidAPIKeyID))
+		return
+	}
+
+	err = services.NewAPIKeyService(h.dbFor(c)).Revoke(userID.(uint), uint(id))
+	if errors.Is(err, services.ErrAPIKeyNotFound) {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeAPIKeyNotFound))
+		return
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeAPIKeyRevokeFailed, err))
+		return
+	}
+
+	log.Info().Interface("user_id", userID).Uint64("api_key_id", id).Msg("API key revoked")
+	h.recordAudit(c, models.AuditActionAPIKeyRevoked, "api_key", id, nil, nil)
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "API key revoked successfully"})
+}
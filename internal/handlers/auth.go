@@ -8,7 +8,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
 	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
 	"github.com/rs/zerolog/log"
@@ -23,31 +24,21 @@ import (
 // @Accept json
 // @Produce json
 // @Param user body models.RegisterRequest true "User Registration Data"
-// @Success 201 {object} models.SwaggerStandardResponse "User registered successfully"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 409 {object} models.SwaggerStandardResponse "Email or username already exists"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 201 {object} models.SwaggerStandardResponse{data=models.SwaggerRegisteredUser} "User registered successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 409 {object} models.ErrorResponse "Email or username already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /auth/register [post]
-func Register(c *gin.Context) {
+func (h *Handler) Register(c *gin.Context) {
 	var request models.RegisterRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": err.Error(),
-		})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Check if user already exists - use Count instead of First to avoid "record not found" error
-	var count int64
-	database.DB.Model(&models.User{}).Where("email = ?", request.Email).Or("username = ?", request.Username).Count(&count)
-	if count > 0 {
-		c.JSON(http.StatusConflict, gin.H{
-			"status":  "error",
-			"error":   "User exists",
-			"message": "Email or username already exists",
-		})
+	if exists, _ := h.usersFor(c).Exists(request.Email, request.Username); exists {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeUserExists))
 		return
 	}
 
@@ -55,11 +46,7 @@ func Register(c *gin.Context) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
 	if err != nil {
 		log.Error().Err(err).Str("email", request.Email).Msg("Failed to hash password")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Server error",
-			"message": "Failed to process registration",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodeRegistrationFailed, err))
 		return
 	}
 
@@ -73,13 +60,9 @@ func Register(c *gin.Context) {
 		Role:      "user", // Default role
 	}
 
-	if result := database.DB.Create(&user); result.Error != nil {
-		log.Error().Err(result.Error).Str("email", request.Email).Msg("Failed to create user")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to create user",
-		})
+	if err := h.usersFor(c).Create(&user); err != nil {
+		log.Error().Err(err).Str("email", request.Email).Msg("Failed to create user")
+		middleware.Abort(c, apierror.Internal(i18n.CodeRegistrationFailed, err))
 		return
 	}
 
@@ -97,115 +80,109 @@ func Register(c *gin.Context) {
 
 // Login godoc
 // @Summary Login to the application
-// @Description Authenticate a user and return JWT tokens
+// @Description Authenticate a user and return JWT tokens. With cookie sessions (AUTH_MODE=cookie) the refresh token is set in an httpOnly cookie, along with a CSRF cookie, instead of being returned.
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param credentials body models.LoginRequest true "Login Credentials"
 // @Success 200 {object} models.TokenResponse "Login successful"
-// @Failure 400 {object} map[string]interface{} "Invalid input"
-// @Failure 401 {object} map[string]interface{} "Authentication failed"
-// @Failure 500 {object} map[string]interface{} "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Authentication failed"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /auth/login [post]
-func Login(c *gin.Context) {
+func (h *Handler) Login(c *gin.Context) {
 	var request models.LoginRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": err.Error(),
-		})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Find the user by email
-	var user models.User
-	if result := database.DB.Where("email = ?", request.Email).First(&user); result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
+	user, err := h.usersFor(c).FindByEmail(request.Email)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
 			log.Info().Str("email", request.Email).Msg("Login attempt with non-existent email")
 		} else {
-			log.Error().Err(result.Error).Str("email", request.Email).Msg("Database error during login")
+			log.Error().Err(err).Str("email", request.Email).Msg("Database error during login")
 		}
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Authentication failed",
-			"message": "Invalid credentials",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeInvalidCredentials))
 		return
 	}
 
 	// Compare passwords
-	err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
 	if err != nil {
 		log.Info().Str("email", request.Email).Msg("Login attempt with incorrect password")
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Authentication failed",
-			"message": "Invalid credentials",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeInvalidCredentials))
 		return
 	}
 
 	// Generate token pair
-	accessToken, refreshToken, _, err := middleware.GenerateTokenPair(user)
+	accessToken, refreshToken, _, err := middleware.GenerateTokenPair(*user, c.Request.UserAgent(), middleware.ClientIP(c))
 	if err != nil {
 		log.Error().Err(err).Str("email", user.Email).Msg("Failed to generate token")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Token generation failed",
-			"message": "Failed to generate authentication tokens",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodeTokenGenerationFailed, err))
 		return
 	}
 
 	// Calculate expiry time in seconds for access token
-	expiresIn := int(middleware.AppConfig.JWT.AccessExpiry.Seconds())
+	expiresIn := int(h.cfg.JWT.AccessExpiry.Seconds())
 
-	log.Info().Str("email", user.Email).Uint("id", user.ID).Msg("User logged in successfully")
-	c.JSON(http.StatusOK, models.TokenResponse{
+	response := models.TokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
 		ExpiresIn:    expiresIn,
-	})
+	}
+
+	// Keep the refresh token away from JavaScript in cookie sessions
+	if h.cfg.AuthCookie.Enabled {
+		if err := h.setSessionCookies(c, refreshToken); err != nil {
+			log.Error().Err(err).Str("email", user.Email).Msg("Failed to set session cookies")
+			middleware.Abort(c, apierror.Internal(i18n.CodeTokenGenerationFailed, err))
+			return
+		}
+		response.RefreshToken = ""
+	}
+
+	log.Info().Str("email", user.Email).Uint("id", user.ID).Msg("User logged in successfully")
+	c.JSON(http.StatusOK, response)
 }
 
 // RefreshToken godoc
 // @Summary Refresh an access token
-// @Description Get a new access token using a refresh token
+// @Description Get a new access token using a refresh token. With cookie sessions the refresh cookie is used when present, and the request must carry the CSRF token in the X-CSRF-Token header.
 // @Tags Auth
 // @Accept json
 // @Produce json
-// @Param refresh_token body models.RefreshTokenRequest true "Refresh Token"
-// @Success 200 {object} models.TokenResponse "Token refreshed successfully"
-// @Failure 400 {object} map[string]interface{} "Invalid input"
-// @Failure 401 {object} map[string]interface{} "Invalid refresh token"
+// @Param refresh_token body models.RefreshTokenRequest false "Refresh Token, unless sent in the session cookie"
+// @Param X-CSRF-Token header string false "CSRF token from the CSRF cookie, required with the refresh cookie"
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.TokenResponse} "Token refreshed successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Invalid refresh token"
+// @Failure 403 {object} models.ErrorResponse "Missing or invalid CSRF token"
 // @Router /auth/refresh [post]
-func RefreshToken(c *gin.Context) {
-	var request models.RefreshTokenRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": err.Error(),
-		})
-		return
+func (h *Handler) RefreshToken(c *gin.Context) {
+	refreshToken, ok := h.sessionRefreshToken(c)
+	if !ok {
+		var request models.RefreshTokenRequest
+		if err := c.ShouldBindJSON(&request); err != nil {
+			middleware.Abort(c, apierror.Validation(err))
+			return
+		}
+		refreshToken = request.RefreshToken
 	}
 
 	// Get a new access token
-	accessToken, err := middleware.RefreshAccessToken(request.RefreshToken)
+	accessToken, err := middleware.RefreshAccessToken(refreshToken)
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to refresh token")
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Invalid refresh token",
-			"message": err.Error(),
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeRefreshTokenInvalid))
 		return
 	}
 
 	// Calculate expiry time in seconds
-	expiresIn := int(middleware.AppConfig.JWT.AccessExpiry.Seconds())
+	expiresIn := int(h.cfg.JWT.AccessExpiry.Seconds())
 
 	log.Info().Msg("Access token refreshed successfully")
 	c.JSON(http.StatusOK, gin.H{
@@ -220,39 +197,42 @@ func RefreshToken(c *gin.Context) {
 
 // RevokeToken godoc
 // @Summary Revoke a refresh token
-// @Description Invalidate a refresh token
+// @Description Invalidate a refresh token. With cookie sessions the refresh cookie is revoked and cleared when no token is given.
 // @Tags Auth
 // @Accept json
 // @Produce json
-// @Param refresh_token body models.TokenRevokeRequest true "Refresh Token"
-// @Success 200 {object} map[string]interface{} "Token revoked successfully"
-// @Failure 400 {object} map[string]interface{} "Invalid input or token revocation failed"
+// @Param refresh_token body models.TokenRevokeRequest false "Refresh Token, unless sent in the session cookie"
+// @Param X-CSRF-Token header string false "CSRF token from the CSRF cookie, required with the refresh cookie"
+// @Success 200 {object} models.SwaggerStandardResponse "Token revoked successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input or token revocation failed"
+// @Failure 403 {object} models.ErrorResponse "Missing or invalid CSRF token"
 // @Security BearerAuth
 // @Router /auth/revoke [post]
-func RevokeToken(c *gin.Context) {
+func (h *Handler) RevokeToken(c *gin.Context) {
+	refreshToken, fromCookie := h.sessionRefreshToken(c)
 	var request models.TokenRevokeRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": err.Error(),
-		})
+	if err := c.ShouldBindJSON(&request); err == nil {
+		refreshToken, fromCookie = request.RefreshToken, false
+	} else if !fromCookie {
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Revoke the refresh token
-	err := middleware.RevokeRefreshToken(request.RefreshToken)
+	token, err := middleware.RevokeRefreshToken(refreshToken)
 	if err != nil {
 		log.Warn().Err(err).Msg("Failed to revoke token")
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Token revocation failed",
-			"message": err.Error(),
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeTokenRevocationFailed).WithMessage(err.Error()))
 		return
 	}
+	if fromCookie {
+		h.clearSessionCookies(c)
+	}
 
 	log.Info().Msg("Refresh token revoked successfully")
+	h.recordAudit(c, models.AuditActionTokenRevoked, "refresh_token", token.ID,
+		gin.H{"user_id": token.UserID, "revoked": false},
+		gin.H{"user_id": token.UserID, "revoked": true})
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "success",
 		"message": "Token revoked successfully",
@@ -264,21 +244,17 @@ func RevokeToken(c *gin.Context) {
 // @Description Retrieve the current user's profile information
 // @Tags Users
 // @Produce json
-// @Success 200 {object} models.SwaggerProfileResponse "User profile"
-// @Failure 404 {object} models.SwaggerStandardResponse "User not found"
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.SwaggerProfileResponse} "User profile"
+// @Failure 404 {object} models.ErrorResponse "User not found"
 // @Security BearerAuth
 // @Router /profile [get]
-func GetProfile(c *gin.Context) {
+func (h *Handler) GetProfile(c *gin.Context) {
 	userID, _ := c.Get("userID")
 
 	var user models.User
-	if result := database.DB.Select("id, username, email, first_name, last_name, bio, role, profile_image, created_at, updated_at").Where("id = ?", userID).First(&user); result.Error != nil {
+	if result := h.dbFor(c).Select("id, username, email, first_name, last_name, bio, role, profile_image, created_at, updated_at").Where("id = ?", userID).First(&user); result.Error != nil {
 		log.Warn().Err(result.Error).Interface("user_id", userID).Msg("User not found when fetching profile")
-		c.JSON(http.StatusNotFound, gin.H{
-			"status":  "error",
-			"error":   "Not found",
-			"message": "User not found",
-		})
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
 		return
 	}
 
@@ -295,40 +271,26 @@ func GetProfile(c *gin.Context) {
 // @Tags Users
 // @Accept json
 // @Produce json
-// @Param profile body models.SwaggerUpdateProfileRequest true "Profile Data"
-// @Success 200 {object} models.SwaggerProfileResponse "Profile updated successfully"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 404 {object} models.SwaggerStandardResponse "User not found"
+// @Param profile body models.UpdateProfileRequest true "Profile Data"
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.SwaggerProfileResponse} "Profile updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 404 {object} models.ErrorResponse "User not found"
 // @Security BearerAuth
 // @Router /profile [put]
-func UpdateProfile(c *gin.Context) {
+func (h *Handler) UpdateProfile(c *gin.Context) {
 	userID, _ := c.Get("userID")
 
-	var user models.User
-	if result := database.DB.Where("id = ?", userID).First(&user); result.Error != nil {
-		log.Warn().Err(result.Error).Interface("user_id", userID).Msg("User not found when updating profile")
-		c.JSON(http.StatusNotFound, gin.H{
-			"status":  "error",
-			"error":   "Not found",
-			"message": "User not found",
-		})
+	user, err := h.usersFor(c).FindByID(userID.(uint))
+	if err != nil {
+		log.Warn().Err(err).Interface("user_id", userID).Msg("User not found when updating profile")
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
 		return
 	}
 
 	// Only allow updating specific fields
-	var requestBody struct {
-		FirstName    *string `json:"first_name"`
-		LastName     *string `json:"last_name"`
-		Bio          *string `json:"bio"`
-		ProfileImage *string `json:"profile_image"`
-	}
-
+	var requestBody models.UpdateProfileRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": err.Error(),
-		})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
@@ -345,14 +307,16 @@ func UpdateProfile(c *gin.Context) {
 	if requestBody.ProfileImage != nil {
 		user.ProfileImage = *requestBody.ProfileImage
 	}
+	if requestBody.AnalyticsOptOut != nil {
+		user.AnalyticsOptOut = *requestBody.AnalyticsOptOut
+	}
+	if requestBody.CommentEmails != nil {
+		user.CommentEmails = models.CommentEmails(*requestBody.CommentEmails)
+	}
 
-	if result := database.DB.Save(&user); result.Error != nil {
-		log.Error().Err(result.Error).Interface("user_id", userID).Msg("Failed to update user profile")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to update profile",
-		})
+	if err := h.usersFor(c).Save(user); err != nil {
+		log.Error().Err(err).Interface("user_id", userID).Msg("Failed to update user profile")
+		middleware.Abort(c, apierror.Internal(i18n.CodeProfileUpdateFailed, err))
 		return
 	}
 
@@ -366,76 +330,64 @@ func UpdateProfile(c *gin.Context) {
 
 // Logout godoc
 // @Summary Logout from the application
-// @Description Invalidate the current user's tokens
+// @Description Invalidate the current user's tokens. With cookie sessions the refresh cookie is revoked and the session cookies are cleared.
 // @Tags Auth
 // @Accept json
 // @Produce json
-// @Param body body object false "Logout options"
+// @Param body body models.LogoutRequest false "Logout options"
+// @Param X-CSRF-Token header string false "CSRF token from the CSRF cookie, required with the refresh cookie"
 // @Success 200 {object} models.SwaggerStandardResponse "Successfully logged out"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Missing or invalid CSRF token"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /auth/logout [post]
-func Logout(c *gin.Context) {
+func (h *Handler) Logout(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
 	// Extract access token
 	tokenString, err := extractToken(c)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "No token provided",
-			"message": err.Error(),
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeAuthHeaderInvalid))
 		return
 	}
 
 	// Handle different logout strategies
-	var request struct {
-		RevokeAll bool `json:"revoke_all"`
-	}
+	var request models.LogoutRequest
 	c.ShouldBindJSON(&request)
 
 	if request.RevokeAll {
 		// Revoke all refresh tokens for this user
 		if err := middleware.RevokeAllUserRefreshTokens(userID.(uint)); err != nil {
 			log.Error().Err(err).Interface("user_id", userID).Msg("Failed to revoke all tokens")
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"status":  "error",
-				"error":   "Server error",
-				"message": "Failed to revoke all tokens",
-			})
+			middleware.Abort(c, apierror.Internal(i18n.CodeLogoutFailed, err))
 			return
 		}
 		log.Info().Interface("user_id", userID).Msg("All refresh tokens revoked")
 	}
 
+	// End the cookie session, if there is one
+	if refreshToken, ok := h.sessionRefreshToken(c); ok {
+		if !request.RevokeAll {
+			if _, err := middleware.RevokeRefreshToken(refreshToken); err != nil {
+				log.Warn().Err(err).Interface("user_id", userID).Msg("Failed to revoke the session's refresh token")
+			}
+		}
+		h.clearSessionCookies(c)
+	}
+
 	// Blacklist the current access token
 	// Parse token to get expiration time
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Verify the signing method is what we expect
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(middleware.AppConfig.JWT.Secret), nil
-	})
+	token, err := jwt.Parse(tokenString, h.keys.KeyFunc)
 
 	if err != nil {
 		log.Warn().Err(err).Msg("Invalid token during logout")
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Invalid token",
-			"message": "The provided token is invalid or malformed",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeTokenInvalid))
 		return
 	}
 
@@ -443,11 +395,7 @@ func Logout(c *gin.Context) {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
 		log.Error().Msg("Failed to parse token claims during logout")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Token parsing failed",
-			"message": "An error occurred while processing the token",
-		})
+		middleware.Abort(c, apierror.New(http.StatusInternalServerError, i18n.CodeLogoutFailed))
 		return
 	}
 
@@ -459,7 +407,7 @@ func Logout(c *gin.Context) {
 	}
 
 	// Use a transaction for checking and creating the blacklisted token
-	err = database.DB.Transaction(func(tx *gorm.DB) error {
+	err = h.dbFor(c).Transaction(func(tx *gorm.DB) error {
 		// Check if token is already blacklisted
 		var count int64
 		if err := tx.Model(&models.BlacklistedToken{}).Where("token = ?", tokenString).Count(&count).Error; err != nil {
@@ -487,11 +435,7 @@ func Logout(c *gin.Context) {
 	// Check for transaction errors
 	if err != nil {
 		log.Error().Err(err).Msg("Database error during logout")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database operation failed",
-			"message": "An error occurred while processing your logout request",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodeLogoutFailed, err))
 		return
 	}
 
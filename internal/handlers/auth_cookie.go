@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// csrfTokenBytes is the number of random bytes in a CSRF token
+const csrfTokenBytes = 32
+
+// setSessionCookies hands the refresh token to the browser in an httpOnly
+// cookie, with a fresh CSRF token in a cookie the frontend can read and
+// send back in the X-CSRF-Token header
+func (h *Handler) setSessionCookies(c *gin.Context, refreshToken string) error {
+	buf := make([]byte, csrfTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Errorf("failed to generate CSRF token: %w", err)
+	}
+
+	cfg := h.cfg.AuthCookie
+	maxAge := int(h.cfg.JWT.RefreshExpiry.Seconds())
+	c.SetSameSite(cfg.SameSite)
+	c.SetCookie(cfg.Name, refreshToken, maxAge, cfg.Path, cfg.Domain, cfg.Secure, true)
+	c.SetCookie(cfg.CSRFName, base64.RawURLEncoding.EncodeToString(buf), maxAge, cfg.Path, cfg.Domain, cfg.Secure, false)
+	return nil
+}
+
+// clearSessionCookies removes the session cookies from the browser
+func (h *Handler) clearSessionCookies(c *gin.Context) {
+	cfg := h.cfg.AuthCookie
+	c.SetSameSite(cfg.SameSite)
+	c.SetCookie(cfg.Name, "", -1, cfg.Path, cfg.Domain, cfg.Secure, true)
+	c.SetCookie(cfg.CSRFName, "", -1, cfg.Path, cfg.Domain, cfg.Secure, false)
+}
+
+// sessionRefreshToken returns the refresh token in the request's session
+// cookie, when cookie sessions are on and the browser sent one
+func (h *Handler) sessionRefreshToken(c *gin.Context) (string, bool) {
+	if !h.cfg.AuthCookie.Enabled {
+		return "", false
+	}
+	token, err := c.Cookie(h.cfg.AuthCookie.Name)
+	if err != nil || token == "" {
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// GetPublicProfile godoc
+// @Summary Get an author's public profile
+// @Description Returns the public part of a user's profile (name, bio, avatar, number of published posts) for author pages. No authentication is needed.
+// @Tags Users
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} models.PublicProfile "Public profile"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /users/{username} [get]
+func (h *Handler) GetPublicProfile(c *gin.Context) {
+	user, ok := h.loadAuthor(c)
+	if !ok {
+		return
+	}
+
+	var postCount int64
+	if err := h.dbFor(c).Model(&models.Post{}).
+		Where("user_id = ? AND status = ?", user.ID, models.PostStatusPublished).Scopes(notExpired).
+		Count(&postCount).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.PublicProfile{
+		Username:     user.Username,
+		FirstName:    user.FirstName,
+		LastName:     user.LastName,
+		Bio:          user.Bio,
+		ProfileImage: user.ProfileImage,
+		PostCount:    postCount,
+		JoinedAt:     user.CreatedAt,
+	})
+}
+
+// GetAuthorPosts godoc
+// @Summary Get an author's published posts
+// @Description Returns a paginated list of a user's published posts, newest first. No authentication is needed.
+// @Tags Users
+// @Produce json
+// @Param username path string true "Username"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10, max: 50)"
+// @Success 200 {object} models.SwaggerPostsResponse "List of posts with pagination metadata"
+// @Failure 404 {object} models.ErrorResponse "User not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /users/{username}/posts [get]
+func (h *Handler) GetAuthorPosts(c *gin.Context) {
+	user, ok := h.loadAuthor(c)
+	if !ok {
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	query := h.dbFor(c).Model(&models.Post{}).Where("user_id = ? AND status = ?", user.ID, models.PostStatusPublished).Scopes(notExpired)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
+		return
+	}
+
+	posts := []models.Post{}
+	if err := query.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Tags").Preload("Category").
+		Order("created_at DESC").
+		Limit(limit).Offset((page - 1) * limit).
+		Find(&posts).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"posts": posts,
+		"meta": gin.H{
+			"page":     page,
+			"limit":    limit,
+			"total":    total,
+			"lastPage": (int(total) + limit - 1) / limit,
+		},
+	})
+}
+
+// loadAuthor loads the user named by the :username parameter, aborting with
+// 404 when there is none
+func (h *Handler) loadAuthor(c *gin.Context) (*models.User, bool) {
+	user, err := h.usersFor(c).FindByUsername(c.Param("username"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
+		} else {
+			middleware.Abort(c, apierror.Internal(i18n.CodeInternalError, err))
+		}
+		return nil, false
+	}
+	return user, true
+}
@@ -6,10 +6,11 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
 	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
-	"github.com/phanvantai/taiphanvan_backend/internal/models"
 	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
 	"github.com/rs/zerolog/log"
 )
 
@@ -23,6 +24,13 @@ var allowedFileTypes = map[string]bool{
 	".png":  true,
 }
 
+// AvatarUpload is the content check applied to avatar uploads
+var AvatarUpload = upload.Policy{
+	Field:   "avatar",
+	Types:   []string{upload.TypeJPEG, upload.TypePNG},
+	MaxSize: maxAvatarSize,
+}
+
 // UploadAvatar godoc
 // @Summary Upload user avatar
 // @Description Upload a new avatar image for the current user
@@ -30,109 +38,78 @@ var allowedFileTypes = map[string]bool{
 // @Accept multipart/form-data
 // @Produce json
 // @Param avatar formData file true "Avatar image file (JPG, JPEG, PNG, max 2MB)"
-// @Success 200 {object} models.SwaggerAvatarResponse "Avatar uploaded successfully"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.SwaggerAvatarResponse} "Avatar uploaded successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /profile/avatar [post]
-func UploadAvatar(c *gin.Context) {
+func (h *Handler) UploadAvatar(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
 	// Get the file from the request
 	file, err := c.FormFile("avatar")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "No file uploaded or invalid file",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileMissing))
 		return
 	}
 
 	// Check file size
 	if file.Size > maxAvatarSize {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "File too large",
-			"message": "Avatar image must be less than 2MB",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeAvatarTooLarge))
 		return
 	}
 
 	// Check file type
 	ext := strings.ToLower(filepath.Ext(file.Filename))
 	if !allowedFileTypes[ext] {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid file type",
-			"message": "Only JPG, JPEG, and PNG files are allowed",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeAvatarInvalidType))
 		return
 	}
 
-	// Initialize Cloudinary service
-	cloudinaryService, err := services.NewCloudinaryService(middleware.AppConfig.Cloudinary)
+	// Initialize storage service
+	storageService, err := services.NewStorageService(h.cfg)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize Cloudinary service")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Server error",
-			"message": "Failed to initialize upload service",
-		})
+		log.Error().Err(err).Msg("Failed to initialize storage service")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUploadServiceFailed, err))
 		return
 	}
 
 	// Get current user data to check if they already have an avatar
-	var user models.User
-	if result := database.DB.Where("id = ?", userID).First(&user); result.Error != nil {
-		log.Error().Err(result.Error).Interface("user_id", userID).Msg("Failed to find user")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to retrieve user profile",
-		})
+	user, err := h.usersFor(c).FindByID(userID.(uint))
+	if err != nil {
+		log.Error().Err(err).Interface("user_id", userID).Msg("Failed to find user")
+		middleware.Abort(c, apierror.Internal(i18n.CodeProfileFetchFailed, err))
 		return
 	}
 
 	// If user already has a profile image, delete the old one
 	if user.ProfileImage != "" {
-		if err := cloudinaryService.DeleteImage(c.Request.Context(), user.ProfileImage); err != nil {
+		if err := storageService.DeleteImage(c.Request.Context(), user.ProfileImage); err != nil {
 			log.Warn().Err(err).Str("profile_image_url", user.ProfileImage).Msg("Failed to delete old avatar image")
 			// Continue with the upload even if deletion fails
 		}
 	}
 
-	// Upload the file to Cloudinary
-	imageURL, err := cloudinaryService.UploadAvatar(c.Request.Context(), file, userID.(uint))
+	// Upload the file to storage
+	variants, err := storageService.UploadAvatar(c.Request.Context(), file, userID.(uint))
 	if err != nil {
 		log.Error().Err(err).Interface("user_id", userID).Msg("Failed to upload avatar")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Upload failed",
-			"message": "Failed to upload avatar image",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodeAvatarUploadFailed, err))
 		return
 	}
 
 	// Update user's profile image in the database
+	imageURL := variants.Original
 	user.ProfileImage = imageURL
-	if result := database.DB.Save(&user); result.Error != nil {
-		log.Error().Err(result.Error).Interface("user_id", userID).Msg("Failed to update user profile")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to update profile image",
-		})
+	if err := h.usersFor(c).Save(user); err != nil {
+		log.Error().Err(err).Interface("user_id", userID).Msg("Failed to update user profile")
+		middleware.Abort(c, apierror.Internal(i18n.CodeProfileImageUpdateFailed, err))
 		return
 	}
 
@@ -142,6 +119,7 @@ func UploadAvatar(c *gin.Context) {
 		"message": "Avatar uploaded successfully",
 		"data": gin.H{
 			"profile_image": imageURL,
+			"variants":      variants,
 		},
 	})
 }
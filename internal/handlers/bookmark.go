@@ -0,0 +1,381 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BookmarkPost godoc
+// @Summary Bookmark a post
+// @Description Saves a published post to the current user's bookmarks to read later. Bookmarking a post twice succeeds.
+// @Tags Bookmarks
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 200 {object} models.SwaggerStandardResponse "Post bookmarked"
+// @Failure 400 {object} models.ErrorResponse "Invalid post ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/bookmark [post]
+func (h *Handler) BookmarkPost(c *gin.Context) {
+	post, ok := h.loadPublishedPost(c)
+	if !ok {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	bookmark := models.Bookmark{UserID: userID.(uint), PostID: post.ID}
+	if err := h.dbFor(c).Clauses(clause.OnConflict{DoNothing: true}).Create(&bookmark).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarkCreateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Post bookmarked",
+	})
+}
+
+// UnbookmarkPost godoc
+// @Summary Remove a bookmark
+// @Description Removes a post from the current user's bookmarks. Removing a post that isn't bookmarked succeeds.
+// @Tags Bookmarks
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 200 {object} models.SwaggerStandardResponse "Bookmark removed"
+// @Failure 400 {object} models.ErrorResponse "Invalid post ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/bookmark [delete]
+func (h *Handler) UnbookmarkPost(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return
+	}
+
+	// Unpublished posts can still be taken out of bookmarks
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return
+	}
+
+	userID, _ := c.Get("userID")
+	if err := h.dbFor(c).Where("user_id = ? AND post_id = ?", userID.(uint), post.ID).
+		Delete(&models.Bookmark{}).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarkDeleteFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Bookmark removed",
+	})
+}
+
+// GetBookmarks godoc
+// @Summary Get bookmarked posts
+// @Description Returns the current user's bookmarks, most recently saved first. Posts that were unpublished or deleted since are left out.
+// @Tags Bookmarks
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10, max: 50)"
+// @Success 200 {object} models.SwaggerBookmarksResponse "Bookmarks with pagination metadata"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/bookmarks [get]
+func (h *Handler) GetBookmarks(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	userID, _ := c.Get("userID")
+
+	query := h.dbFor(c).Model(&models.Bookmark{}).
+		Joins("JOIN posts ON posts.id = bookmarks.post_id AND posts.deleted_at IS NULL").
+		Where("bookmarks.user_id = ? AND posts.status = ?", userID.(uint), models.PostStatusPublished)
+	if siteID, ok := site.IDFromContext(c.Request.Context()); ok {
+		query = query.Where("posts.site_id = ?", siteID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarksFetchFailed, err))
+		return
+	}
+
+	bookmarks := []models.Bookmark{}
+	if err := query.Preload("Post.User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Post.Tags").Preload("Post.Category").
+		Order("bookmarks.created_at DESC, bookmarks.id DESC").
+		Limit(limit).Offset((page - 1) * limit).
+		Find(&bookmarks).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarksFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"bookmarks": bookmarks,
+		"meta": gin.H{
+			"page":     page,
+			"limit":    limit,
+			"total":    total,
+			"lastPage": (int(total) + limit - 1) / limit,
+		},
+	})
+}
+
+// BookmarkNews godoc
+// @Summary Bookmark a news article
+// @Description Saves a published news article to the current user's reading list. Bookmarking an article twice succeeds.
+// @Tags Bookmarks
+// @Produce json
+// @Param id path string true "News article ID or UUID"
+// @Success 200 {object} models.SwaggerStandardResponse "News article bookmarked"
+// @Failure 400 {object} models.ErrorResponse "Invalid news ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /news/{id}/bookmark [post]
+func (h *Handler) BookmarkNews(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
+		return
+	}
+
+	news, err := h.newsFor(c).FindPublished(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	bookmark := models.NewsBookmark{UserID: userID.(uint), NewsID: news.ID}
+	if err := h.dbFor(c).Clauses(clause.OnConflict{DoNothing: true}).Create(&bookmark).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarkCreateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "News article bookmarked",
+	})
+}
+
+// UnbookmarkNews godoc
+// @Summary Remove a news bookmark
+// @Description Removes a news article from the current user's reading list. Removing an article that isn't bookmarked succeeds.
+// @Tags Bookmarks
+// @Produce json
+// @Param id path string true "News article ID or UUID"
+// @Success 200 {object} models.SwaggerStandardResponse "Bookmark removed"
+// @Failure 400 {object} models.ErrorResponse "Invalid news ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /news/{id}/bookmark [delete]
+func (h *Handler) UnbookmarkNews(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
+		return
+	}
+
+	// Archived articles can still be taken out of the reading list
+	news, err := h.newsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
+		return
+	}
+
+	userID, _ := c.Get("userID")
+	if err := h.dbFor(c).Where("user_id = ? AND news_id = ?", userID.(uint), news.ID).
+		Delete(&models.NewsBookmark{}).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarkDeleteFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Bookmark removed",
+	})
+}
+
+// readingListRow is a reading list entry before its post or article is loaded
+type readingListRow struct {
+	Type    models.ReadingListItemType
+	ItemID  uint
+	SavedAt time.Time
+}
+
+// GetReadingList godoc
+// @Summary Get the reading list
+// @Description Returns the current user's bookmarked posts and news articles together, most recently saved first. Items that were unpublished or deleted since are left out.
+// @Tags Bookmarks
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10, max: 50)"
+// @Success 200 {object} models.SwaggerReadingListResponse "Reading list with pagination metadata"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/reading-list [get]
+func (h *Handler) GetReadingList(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	userID, _ := c.Get("userID")
+
+	db := h.dbFor(c)
+	posts := db.Model(&models.Bookmark{}).
+		Select("? AS type, bookmarks.post_id AS item_id, bookmarks.created_at AS saved_at, bookmarks.id AS bookmark_id", models.ReadingListItemPost).
+		Joins("JOIN posts ON posts.id = bookmarks.post_id AND posts.deleted_at IS NULL").
+		Where("bookmarks.user_id = ? AND posts.status = ?", userID.(uint), models.PostStatusPublished)
+	if siteID, ok := site.IDFromContext(c.Request.Context()); ok {
+		posts = posts.Where("posts.site_id = ?", siteID)
+	}
+	news := db.Model(&models.NewsBookmark{}).
+		Select("? AS type, news_bookmarks.news_id AS item_id, news_bookmarks.created_at AS saved_at, news_bookmarks.id AS bookmark_id", models.ReadingListItemNews).
+		Joins("JOIN news ON news.id = news_bookmarks.news_id AND news.deleted_at IS NULL").
+		Where("news_bookmarks.user_id = ? AND news.status = ? AND news.published = ?", userID.(uint), models.NewsStatusPublished, true)
+	query := db.Table("(? UNION ALL ?) AS reading_list", posts, news).Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarksFetchFailed, err))
+		return
+	}
+
+	rows := []readingListRow{}
+	if err := query.Select("type, item_id, saved_at").
+		Order("saved_at DESC, bookmark_id DESC").
+		Limit(limit).Offset((page - 1) * limit).
+		Scan(&rows).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarksFetchFailed, err))
+		return
+	}
+
+	items, err := h.loadReadingListItems(c, rows)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBookmarksFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"items": items,
+		"meta": gin.H{
+			"page":     page,
+			"limit":    limit,
+			"total":    total,
+			"lastPage": (int(total) + limit - 1) / limit,
+		},
+	})
+}
+
+// loadReadingListItems loads the posts and news articles of rows, keeping
+// their order
+func (h *Handler) loadReadingListItems(c *gin.Context, rows []readingListRow) ([]models.ReadingListItem, error) {
+	var postIDs, newsIDs []uint
+	for _, row := range rows {
+		if row.Type == models.ReadingListItemPost {
+			postIDs = append(postIDs, row.ItemID)
+		} else {
+			newsIDs = append(newsIDs, row.ItemID)
+		}
+	}
+
+	posts := map[uint]*models.Post{}
+	if len(postIDs) > 0 {
+		var found []models.Post
+		if err := h.dbFor(c).Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, username, first_name, last_name, profile_image")
+		}).Preload("Tags").Preload("Category").
+			Where("id IN ?", postIDs).Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i := range found {
+			posts[found[i].ID] = &found[i]
+		}
+	}
+
+	news := map[uint]*models.News{}
+	if len(newsIDs) > 0 {
+		var found []models.News
+		if err := h.dbFor(c).Preload("Tags").Where("id IN ?", newsIDs).Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i := range found {
+			news[found[i].ID] = &found[i]
+		}
+	}
+
+	items := make([]models.ReadingListItem, 0, len(rows))
+	for _, row := range rows {
+		item := models.ReadingListItem{Type: row.Type, SavedAt: row.SavedAt}
+		if row.Type == models.ReadingListItemPost {
+			item.Post = posts[row.ItemID]
+		} else {
+			item.News = news[row.ItemID]
+		}
+		// Removed between the two queries
+		if item.Post == nil && item.News == nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// loadPublishedPost loads the published post named by the :id parameter
+func (h *Handler) loadPublishedPost(c *gin.Context) (*models.Post, bool) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return nil, false
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil || post.Status != models.PostStatusPublished {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return nil, false
+	}
+	return post, true
+}
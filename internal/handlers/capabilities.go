@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/routes"
+)
+
+// GetCapabilities godoc
+// @Summary List the API's endpoints
+// @Description Returns every endpoint this instance serves with the access it requires, its rate limit and its fixed Cache-Control header, so clients can discover what is available
+// @Tags System
+// @Produce json
+// @Success 200 {object} models.CapabilitiesResponse "Endpoints"
+// @Router /capabilities [get]
+func (h *Handler) GetCapabilities(c *gin.Context) {
+	table := routes.All()
+	capabilities := make([]models.RouteCapability, 0, len(table))
+	for _, route := range table {
+		capabilities = append(capabilities, models.RouteCapability{
+			Method:      route.Method,
+			Path:        routes.SwaggerPath(route.FullPath()),
+			Access:      string(route.Access),
+			RateLimit:   string(route.RateLimit),
+			Cache:       route.Cache,
+			APIKey:      route.AcceptsAPIKey(),
+			Conditional: route.Conditional,
+		})
+	}
+
+	c.JSON(http.StatusOK, models.CapabilitiesResponse{Routes: capabilities})
+}
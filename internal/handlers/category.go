@@ -0,0 +1,284 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// GetCategories godoc
+// @Summary Get post categories
+// @Description Returns all categories ordered by position and name, either as a flat list or as a nested tree
+// @Tags Categories
+// @Produce json
+// @Param tree query bool false "Return categories nested under their parents"
+// @Success 200 {array} models.Category "List of categories"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /categories [get]
+func (h *Handler) GetCategories(c *gin.Context) {
+	categories := []models.Category{}
+	if err := h.dbFor(c).Order("position ASC, name ASC").Find(&categories).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCategoriesFetchFailed, err))
+		return
+	}
+
+	if c.Query("tree") == "true" {
+		c.JSON(http.StatusOK, buildCategoryTree(categories, nil))
+		return
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+// CreateCategory godoc
+// @Summary Create a category
+// @Description Creates a post category, optionally nested under a parent
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param request body models.CreateCategoryRequest true "Category details"
+// @Success 201 {object} models.Category "Created category"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Slug already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/categories [post]
+func (h *Handler) CreateCategory(c *gin.Context) {
+	var requestBody models.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	category := models.Category{
+		Name:        strings.TrimSpace(requestBody.Name),
+		Slug:        h.slugs.Make(requestBody.Name),
+		Description: requestBody.Description,
+		ParentID:    requestBody.ParentID,
+		Position:    requestBody.Position,
+	}
+	if requestBody.Slug != "" {
+		category.Slug = h.slugs.Make(requestBody.Slug)
+	}
+	if category.Slug == "" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeCategorySlugEmpty))
+		return
+	}
+
+	if category.ParentID != nil {
+		if err := h.dbFor(c).First(&models.Category{}, *category.ParentID).Error; err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeParentCategoryNotFound))
+			return
+		}
+	}
+
+	if h.categorySlugTaken(c, category.Slug, 0) {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeCategorySlugTaken))
+		return
+	}
+
+	if err := h.dbFor(c).Create(&category).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCategoryCreateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, category)
+}
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description Updates a category's name, slug, description, position, or parent
+// @Tags Categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param request body models.UpdateCategoryRequest true "Category changes"
+// @Success 200 {object} models.Category "Updated category"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Category not found"
+// @Failure 409 {object} models.ErrorResponse "Slug already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/categories/{id} [put]
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCategoryID))
+		return
+	}
+
+	var category models.Category
+	if err := h.dbFor(c).First(&category, id).Error; err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeCategoryNotFound))
+		return
+	}
+
+	var requestBody models.UpdateCategoryRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.Name != nil {
+		category.Name = strings.TrimSpace(*requestBody.Name)
+	}
+	if requestBody.Slug != nil {
+		category.Slug = h.slugs.Make(*requestBody.Slug)
+		if category.Slug == "" {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeCategorySlugEmpty))
+			return
+		}
+		if h.categorySlugTaken(c, category.Slug, category.ID) {
+			middleware.Abort(c, apierror.Conflict(i18n.CodeCategorySlugTaken))
+			return
+		}
+	}
+	if requestBody.Description != nil {
+		category.Description = *requestBody.Description
+	}
+	if requestBody.Position != nil {
+		category.Position = *requestBody.Position
+	}
+
+	if requestBody.ParentID != nil {
+		if *requestBody.ParentID == 0 {
+			category.ParentID = nil
+		} else {
+			// A category can't be moved under itself or one of its descendants
+			descendants, err := h.categoryDescendantIDs(c, category.ID)
+			if err != nil {
+				middleware.Abort(c, apierror.Internal(i18n.CodeCategoryUpdateFailed, err))
+				return
+			}
+			for _, descendantID := range descendants {
+				if descendantID == *requestBody.ParentID {
+					middleware.Abort(c, apierror.BadRequest(i18n.CodeCategoryCycle))
+					return
+				}
+			}
+
+			if err := h.dbFor(c).First(&models.Category{}, *requestBody.ParentID).Error; err != nil {
+				middleware.Abort(c, apierror.BadRequest(i18n.CodeParentCategoryNotFound))
+				return
+			}
+			category.ParentID = requestBody.ParentID
+		}
+	}
+
+	if err := h.dbFor(c).Save(&category).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCategoryUpdateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Deletes a category. Its subcategories move up to its parent and its posts become uncategorized.
+// @Tags Categories
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Category not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/categories/{id} [delete]
+func (h *Handler) DeleteCategory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCategoryID))
+		return
+	}
+
+	var category models.Category
+	if err := h.dbFor(c).First(&category, id).Error; err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeCategoryNotFound))
+		return
+	}
+
+	err = h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Category{}).Where("parent_id = ?", category.ID).
+			Update("parent_id", category.ParentID).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.Post{}).Where("category_id = ?", category.ID).
+			Update("category_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&category).Error
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCategoryDeleteFailed, err))
+		return
+	}
+
+	h.recordAudit(c, models.AuditActionCategoryDeleted, "category", category.ID, gin.H{
+		"name":      category.Name,
+		"slug":      category.Slug,
+		"parent_id": category.ParentID,
+	}, nil)
+
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Category deleted successfully"})
+}
+
+// buildCategoryTree nests categories under their parents, starting at parentID
+func buildCategoryTree(categories []models.Category, parentID *uint) []models.Category {
+	tree := []models.Category{}
+	for _, category := range categories {
+		if (parentID == nil && category.ParentID == nil) ||
+			(parentID != nil && category.ParentID != nil && *category.ParentID == *parentID) {
+			category.Children = buildCategoryTree(categories, &category.ID)
+			tree = append(tree, category)
+		}
+	}
+	return tree
+}
+
+// categoryDescendantIDs returns the ID of the category and of all categories nested below it
+func (h *Handler) categoryDescendantIDs(c *gin.Context, id uint) ([]uint, error) {
+	var ids []uint
+	err := h.dbFor(c).Raw(`
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM categories WHERE id = ?
+			UNION
+			SELECT categories.id FROM categories JOIN subtree ON categories.parent_id = subtree.id
+		)
+		SELECT id FROM subtree`, id).Scan(&ids).Error
+	return ids, err
+}
+
+// categorySlugTaken reports whether another category already uses slug
+func (h *Handler) categorySlugTaken(c *gin.Context, slug string, exceptID uint) bool {
+	var count int64
+	h.dbFor(c).Model(&models.Category{}).Where("slug = ? AND id != ?", slug, exceptID).Count(&count)
+	return count > 0
+}
+
+// resolveCategoryID validates an optional category ID from a post request.
+// A zero ID clears the category.
+func (h *Handler) resolveCategoryID(c *gin.Context, categoryID *uint) (*uint, error) {
+	if categoryID == nil || *categoryID == 0 {
+		return nil, nil
+	}
+
+	if err := h.dbFor(c).First(&models.Category{}, *categoryID).Error; err != nil {
+		return nil, errors.New("category not found")
+	}
+	return categoryID, nil
+}
@@ -5,150 +5,239 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
-	"gorm.io/gorm"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
 )
 
 // GetCommentsByPostID godoc
 // @Summary Get comments for a post
-// @Description Returns all comments for a specific post
+// @Description Returns the approved comments for a specific post with their reaction counts, newest first or, with sort=top, those with the most reactions first. Comments held for moderation are not included. All comments are returned unless page or limit is given; a page of comments carries the number of approved comments in the X-Total-Count header.
 // @Tags Comments
 // @Produce json
-// @Param postID path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 10, max: 50, both configurable)"
+// @Param sort query string false "Order of the comments: newest (default) or top" Enums(newest, top)
 // @Success 200 {array} models.Comment "List of comments"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
-// @Router /posts/{postID}/comments [get]
-func GetCommentsByPostID(c *gin.Context) {
-	postID, err := strconv.ParseUint(c.Param("postID"), 10, 32)
+// @Header 200 {integer} X-Total-Count "Number of approved comments, for a page of comments"
+// @Failure 400 {object} models.ErrorResponse "Invalid input, page, page size or sort"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /posts/{id}/comments [get]
+func (h *Handler) GetCommentsByPostID(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
-	var comments []models.Comment
-	if err := database.DB.Where("post_id = ?", postID).Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).Order("created_at DESC").Find(&comments).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch comments"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
+	sort := c.DefaultQuery("sort", models.CommentSortNewest)
+	if sort != models.CommentSortNewest && sort != models.CommentSortTop {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeCommentSortInvalid))
+		return
+	}
+
+	// Clients that don't paginate keep getting every comment
+	if c.Query("page") == "" && c.Query("limit") == "" {
+		comments, err := h.commentsFor(c).ListApproved(post.ID, sort)
+		if err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeCommentsFetchFailed, err))
+			return
+		}
+		c.JSON(http.StatusOK, comments)
+		return
+	}
+
+	page, limit, ok := h.pageQuery(c)
+	if !ok {
+		return
+	}
+	comments, total, err := h.commentsFor(c).PageApproved(post.ID, sort, limit, (page-1)*limit)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentsFetchFailed, err))
+		return
+	}
+
+	c.Header("X-Total-Count", strconv.FormatInt(total, 10))
 	c.JSON(http.StatusOK, comments)
 }
 
 // CreateComment godoc
 // @Summary Create a new comment
-// @Description Adds a new comment to a post
+// @Description Adds a new comment to a post. Accounts must wait a short cooldown between comments and new accounts have a daily comment quota. Comments the spam checks flag, such as those with many links, links from new accounts or a filled-in honeypot field, are held for moderation (returned with status "pending"). Set parent_id to reply to another comment on the post; the post's author and the author of the comment replied to are notified. Users mentioned with @username are notified too and listed in mentions.
 // @Tags Comments
 // @Accept json
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Param comment body models.CreateCommentRequest true "Comment content"
 // @Success 201 {object} models.Comment "Created comment"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 429 {object} models.ErrorResponse "Daily comment limit reached or comment cooldown"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id}/comments [post]
-func CreateComment(c *gin.Context) {
+func (h *Handler) CreateComment(c *gin.Context) {
 	userID, _ := c.Get("userID")
-	postID, err := strconv.ParseUint(c.Param("postID"), 10, 32)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
 	// Check if post exists
-	var post models.Post
-	if err := database.DB.First(&post, postID).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
 	var requestBody models.CreateCommentRequest
 
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	// Replies must answer a visible comment on the same post
+	if requestBody.ParentID != nil {
+		var count int64
+		if err := h.dbFor(c).Model(&models.Comment{}).
+			Where("id = ? AND post_id = ? AND status = ?", *requestBody.ParentID, post.ID, models.CommentStatusApproved).
+			Count(&count).Error; err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeCommentCreateFailed, err))
+			return
+		}
+		if count == 0 {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeParentCommentNotFound))
+			return
+		}
+	}
+
+	level, ok := h.enforceDailyLimit(c, userID.(uint), services.TrustActionComment)
+	if !ok {
+		return
+	}
+
+	spam := services.NewSpamService(h.dbFor(c), h.cfg.Spam)
+	if !h.enforceCommentCooldown(c, spam, userID.(uint), level) {
 		return
 	}
 
 	comment := models.Comment{
-		Content: requestBody.Content,
-		PostID:  uint(postID),
-		UserID:  userID.(uint),
+		Content:  requestBody.Content,
+		Status:   models.CommentStatusApproved,
+		PostID:   post.ID,
+		ParentID: requestBody.ParentID,
+		UserID:   userID.(uint),
+	}
+	comment.FlagReason = spam.Check(c.Request.Context(), h.spamCheck(c, &requestBody, post, userID.(uint), level))
+	if comment.FlagReason != "" {
+		comment.Status = models.CommentStatusPending
 	}
 
-	if err := database.DB.Create(&comment).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create comment"})
+	if err := h.commentsFor(c).Create(&comment); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentCreateFailed, err))
 		return
 	}
+	// Mentioned users are notified with the post's author below
+	h.syncMentions(c, &comment)
 
 	// Reload comment with user info
-	database.DB.Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).First(&comment, comment.ID)
+	h.commentsFor(c).Reload(&comment)
+
+	if comment.Status == models.CommentStatusPending {
+		log.Info().Uint("comment_id", comment.ID).Uint("user_id", comment.UserID).Str("flag_reason", comment.FlagReason).Msg("Comment held for moderation")
+	} else {
+		h.dispatchWebhookEvent(models.WebhookEventCommentCreated, comment)
+		h.notifyComment(comment)
+	}
 
+	// Don't tell spammers which check caught them
+	comment.FlagReason = ""
 	c.JSON(http.StatusCreated, comment)
 }
 
 // UpdateComment godoc
 // @Summary Update a comment
-// @Description Updates an existing comment
+// @Description Updates an existing comment. Users newly mentioned with @username are notified.
 // @Tags Comments
 // @Accept json
 // @Produce json
-// @Param commentID path int true "Comment ID"
+// @Param commentID path string true "Comment ID or UUID"
 // @Param comment body models.UpdateCommentRequest true "Updated comment content"
 // @Success 200 {object} models.Comment "Updated comment"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Comment not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Comment not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /comments/{commentID} [put]
-func UpdateComment(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	commentID, err := strconv.ParseUint(c.Param("commentID"), 10, 32)
+func (h *Handler) UpdateComment(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("commentID"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCommentID))
 		return
 	}
 
-	var comment models.Comment
-	if err := database.DB.First(&comment, commentID).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+	comment, err := h.commentsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeCommentNotFound))
 		return
 	}
 
 	// Check if user is the author of the comment or an admin
-	role, _ := c.Get("userRole")
-	if comment.UserID != userID.(uint) && role != "admin" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to edit this comment"})
+	if !can(c, policy.ActionCommentEdit, policy.Resource{OwnerID: comment.UserID}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodeCommentEditForbidden))
 		return
 	}
 
 	var requestBody models.UpdateCommentRequest
 
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	comment.Content = requestBody.Content
 
-	if err := database.DB.Save(&comment).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update comment"})
+	// Edits are moderated like new comments, so links can't be added afterwards
+	if comment.Status == models.CommentStatusApproved && !can(c, policy.ActionCommentSkipModeration, policy.Resource{}) {
+		status, err := h.commentStatusFor(c, comment.UserID, comment.Content)
+		if err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeTrustCheckFailed, err))
+			return
+		}
+		comment.Status = status
+	}
+
+	if err := h.commentsFor(c).Save(comment); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentUpdateFailed, err))
 		return
 	}
+	mentioned := h.syncMentions(c, comment)
 
 	// Reload comment with user info
-	database.DB.Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).First(&comment, comment.ID)
+	h.commentsFor(c).Reload(comment)
+
+	// Held comments notify everyone they mention once approved
+	if comment.Status == models.CommentStatusApproved {
+		h.notifyMentions(*comment, mentioned)
+	}
 
 	c.JSON(http.StatusOK, comment)
 }
@@ -158,41 +247,41 @@ func UpdateComment(c *gin.Context) {
 // @Description Removes a comment from a post
 // @Tags Comments
 // @Produce json
-// @Param commentID path int true "Comment ID"
+// @Param commentID path string true "Comment ID or UUID"
 // @Success 200 {object} models.SwaggerStandardResponse "Success message"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Comment not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Comment not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /comments/{commentID} [delete]
-func DeleteComment(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	commentID, err := strconv.ParseUint(c.Param("commentID"), 10, 32)
+func (h *Handler) DeleteComment(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("commentID"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCommentID))
 		return
 	}
 
-	var comment models.Comment
-	if err := database.DB.First(&comment, commentID).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Comment not found"})
+	comment, err := h.commentsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeCommentNotFound))
 		return
 	}
 
 	// Check if user is the author of the comment, post author, or an admin
-	role, _ := c.Get("userRole")
-	var post models.Post
-	database.DB.First(&post, comment.PostID)
+	resource := policy.Resource{OwnerID: comment.UserID}
+	if post, err := h.postsFor(c).Find(repository.ByID(comment.PostID)); err == nil {
+		resource.ParentOwnerID = post.UserID
+	}
 
-	if comment.UserID != userID.(uint) && post.UserID != userID.(uint) && role != "admin" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to delete this comment"})
+	if !can(c, policy.ActionCommentDelete, resource) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodeCommentDeleteForbidden))
 		return
 	}
 
-	if err := database.DB.Delete(&comment).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete comment"})
+	if err := h.commentsFor(c).Delete(comment); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentDeleteFailed, err))
 		return
 	}
 
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// GetPendingComments godoc
+// @Summary List comments held for moderation
+// @Description Returns the comments held for moderation, oldest first. Approve them with the approve endpoint or reject them by deleting them.
+// @Tags Comments
+// @Produce json
+// @Success 200 {array} models.Comment "Pending comments"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/comments/pending [get]
+func (h *Handler) GetPendingComments(c *gin.Context) {
+	comments := []models.Comment{}
+	if err := h.dbFor(c).Where("status = ?", models.CommentStatusPending).
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, username, first_name, last_name, profile_image, created_at")
+		}).
+		Preload("Post", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, uuid, title, slug")
+		}).
+		Order("created_at ASC").Find(&comments).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+// ApproveComment godoc
+// @Summary Approve a held comment
+// @Description Publishes a comment that was held for moderation
+// @Tags Comments
+// @Produce json
+// @Param commentID path string true "Comment ID or UUID"
+// @Success 200 {object} models.Comment "Approved comment"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Comment not found"
+// @Failure 409 {object} models.ErrorResponse "Comment is not pending"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/comments/{commentID}/approve [post]
+func (h *Handler) ApproveComment(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("commentID"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCommentID))
+		return
+	}
+
+	comment, err := h.commentsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeCommentNotFound))
+		return
+	}
+
+	if comment.Status != models.CommentStatusPending {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeCommentNotPending))
+		return
+	}
+
+	// The flag reason only matters while the comment waits in the queue
+	if err := h.dbFor(c).Model(comment).Updates(map[string]interface{}{
+		"status":      models.CommentStatusApproved,
+		"flag_reason": "",
+	}).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentApproveFailed, err))
+		return
+	}
+
+	// Reload comment with user info
+	h.commentsFor(c).Reload(comment)
+
+	adminID, _ := c.Get("userID")
+	log.Info().Uint("comment_id", comment.ID).Interface("admin_id", adminID).Msg("Comment approved")
+
+	h.dispatchWebhookEvent(models.WebhookEventCommentCreated, comment)
+	h.notifyComment(*comment)
+
+	c.JSON(http.StatusOK, comment)
+}
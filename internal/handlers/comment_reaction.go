@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+// ReactToComment godoc
+// @Summary React to a comment
+// @Description Adds the current user's like or heart to an approved comment and returns the comment's reaction counts. A user can give a comment each kind of reaction once; reacting again succeeds without counting twice.
+// @Tags Comments
+// @Produce json
+// @Param commentID path string true "Comment ID or UUID"
+// @Param type path string true "Reaction type" Enums(like, heart)
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.CommentReactionCounts} "Reaction counts of the comment"
+// @Failure 400 {object} models.ErrorResponse "Invalid comment ID or reaction type"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Comment not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /comments/{commentID}/reactions/{type} [post]
+func (h *Handler) ReactToComment(c *gin.Context) {
+	comment, reactionType, ok := h.loadReactionTarget(c)
+	if !ok {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	reaction := models.CommentReaction{CommentID: comment.ID, UserID: userID.(uint), Type: reactionType}
+	if err := h.dbFor(c).Clauses(clause.OnConflict{DoNothing: true}).Create(&reaction).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentReactionFailed, err))
+		return
+	}
+
+	h.respondReactionCounts(c, comment)
+}
+
+// UnreactToComment godoc
+// @Summary Remove a reaction from a comment
+// @Description Removes the current user's like or heart from an approved comment and returns the comment's reaction counts. Removing a reaction the user hasn't given succeeds.
+// @Tags Comments
+// @Produce json
+// @Param commentID path string true "Comment ID or UUID"
+// @Param type path string true "Reaction type" Enums(like, heart)
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.CommentReactionCounts} "Reaction counts of the comment"
+// @Failure 400 {object} models.ErrorResponse "Invalid comment ID or reaction type"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Comment not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /comments/{commentID}/reactions/{type} [delete]
+func (h *Handler) UnreactToComment(c *gin.Context) {
+	comment, reactionType, ok := h.loadReactionTarget(c)
+	if !ok {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	if err := h.dbFor(c).Where("comment_id = ? AND user_id = ? AND type = ?", comment.ID, userID.(uint), reactionType).
+		Delete(&models.CommentReaction{}).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentReactionFailed, err))
+		return
+	}
+
+	h.respondReactionCounts(c, comment)
+}
+
+// loadReactionTarget loads the approved comment and the reaction type named in
+// the path, aborting the request if either is invalid. Comments held for
+// moderation aren't listed, so they can't be reacted to.
+func (h *Handler) loadReactionTarget(c *gin.Context) (*models.Comment, models.CommentReactionType, bool) {
+	byID, err := resourceIDScope(c.Param("commentID"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCommentID))
+		return nil, "", false
+	}
+
+	reactionType := models.CommentReactionType(c.Param("type"))
+	if !reactionType.Valid() {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeCommentReactionInvalid))
+		return nil, "", false
+	}
+
+	comment, err := h.commentsFor(c).Find(byID)
+	if err != nil || comment.Status != models.CommentStatusApproved {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeCommentNotFound))
+		return nil, "", false
+	}
+	return comment, reactionType, true
+}
+
+// respondReactionCounts responds with the reaction counts of comment
+func (h *Handler) respondReactionCounts(c *gin.Context, comment *models.Comment) {
+	if err := h.commentsFor(c).Reload(comment); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentReactionFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "success",
+		"data":   comment.Reactions,
+	})
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// SendContactMessage godoc
+// @Summary Send a message to the site owner
+// @Description Stores a contact form message and emails it to the site owner, with the sender as Reply-To. When a captcha provider is configured, captcha_token must be a valid hCaptcha or Turnstile response. The message is kept even if the email can't be sent right away.
+// @Tags Contact
+// @Accept json
+// @Produce json
+// @Param request body models.ContactRequest true "Message"
+// @Success 202 {object} models.SwaggerStandardResponse "Message received"
+// @Failure 400 {object} models.ErrorResponse "Invalid input or captcha"
+// @Failure 429 {object} models.ErrorResponse "Too many requests"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Failure 503 {object} models.ErrorResponse "Email or captcha verification is unavailable"
+// @Router /contact [post]
+func (h *Handler) SendContactMessage(c *gin.Context) {
+	var requestBody models.ContactRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	contact := services.NewContactService(h.dbFor(c), h.cfg)
+	if !contact.Enabled() {
+		middleware.Abort(c, apierror.New(http.StatusServiceUnavailable, i18n.CodeContactUnavailable))
+		return
+	}
+
+	// Bots get the same answer as people, so they can't tell they were caught
+	if requestBody.Website != "" {
+		log.Info().Str("ip", middleware.ClientIP(c)).Msg("Contact message dropped by the honeypot")
+		respondContactReceived(c)
+		return
+	}
+
+	if err := contact.VerifyCaptcha(c.Request.Context(), requestBody.CaptchaToken, middleware.ClientIP(c)); err != nil {
+		if errors.Is(err, services.ErrCaptchaFailed) {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeCaptchaFailed))
+			return
+		}
+		log.Error().Err(err).Msg("Failed to verify captcha")
+		middleware.Abort(c, apierror.New(http.StatusServiceUnavailable, i18n.CodeCaptchaUnavailable).Wrap(err))
+		return
+	}
+
+	message := models.ContactMessage{
+		Name:      strings.TrimSpace(requestBody.Name),
+		Email:     strings.TrimSpace(requestBody.Email),
+		Message:   strings.TrimSpace(requestBody.Message),
+		IPAddress: middleware.ClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+	}
+	if err := contact.Submit(c.Request.Context(), &message); err != nil {
+		if message.ID == 0 {
+			middleware.Abort(c, apierror.Internal(i18n.CodeContactFailed, err))
+			return
+		}
+		// The message is stored, so the owner can still find it
+		log.Error().Err(err).Uint("contact_message_id", message.ID).Msg("Failed to relay contact message")
+	}
+
+	log.Info().Uint("contact_message_id", message.ID).Msg("Contact message received")
+	respondContactReceived(c)
+}
+
+// respondContactReceived answers a contact form submission
+func respondContactReceived(c *gin.Context) {
+	c.JSON(http.StatusAccepted, gin.H{
+		"status":  "success",
+		"message": "Thanks for your message",
+	})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// queueCrossPosts queues a post that was just published for sharing on the
+// configured social networks. A failure is logged and the post stays
+// published without being shared.
+func (h *Handler) queueCrossPosts(post *models.Post, wasPublished bool) {
+	if post.Status != models.PostStatusPublished || wasPublished {
+		return
+	}
+	if err := services.NewCrossPostService(h.db, h.cfg).Queue(post); err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to queue cross-posts")
+	}
+}
+
+// GetPostCrossPosts godoc
+// @Summary Get a post's cross-posts
+// @Description Returns where a published post was shared on social networks and the state of each delivery. Only users who can edit the post can see them.
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 200 {array} models.CrossPost "Cross-posts of the post"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/cross-posts [get]
+func (h *Handler) GetPostCrossPosts(c *gin.Context) {
+	post, ok := h.loadCrossPostedPost(c)
+	if !ok {
+		return
+	}
+
+	crossPosts, err := services.NewCrossPostService(h.dbFor(c), h.cfg).ForPost(post.ID)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCrossPostsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, crossPosts)
+}
+
+// RetryPostCrossPosts godoc
+// @Summary Retry a post's failed cross-posts
+// @Description Queues the cross-posts of a post that failed every attempt again, with a fresh set of attempts. Only users who can edit the post can retry them.
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 200 {object} models.CrossPostRetryResponse "Retried cross-posts"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/cross-posts/retry [post]
+func (h *Handler) RetryPostCrossPosts(c *gin.Context) {
+	post, ok := h.loadCrossPostedPost(c)
+	if !ok {
+		return
+	}
+
+	crossPostService := services.NewCrossPostService(h.dbFor(c), h.cfg)
+	retried, err := crossPostService.Retry(post.ID)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCrossPostRetryFailed, err))
+		return
+	}
+
+	crossPosts, err := crossPostService.ForPost(post.ID)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeCrossPostsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.CrossPostRetryResponse{
+		Retried:    retried,
+		CrossPosts: crossPosts,
+	})
+}
+
+// loadCrossPostedPost loads the post named by the :id parameter, aborting
+// unless the current user can edit it
+func (h *Handler) loadCrossPostedPost(c *gin.Context) (*models.Post, bool) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return nil, false
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return nil, false
+	}
+
+	if !can(c, policy.ActionPostEdit, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodeCrossPostsForbidden))
+		return nil, false
+	}
+	return post, true
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// listCursor is the position of the last item of a page in a list sorted
+// newest first. Clients treat the encoded form as opaque.
+type listCursor struct {
+	Time time.Time `json:"t"`
+	ID   uint      `json:"id"`
+}
+
+// encodeCursor returns the opaque cursor pointing after the item with the
+// given sort time and ID
+func encodeCursor(t time.Time, id uint) string {
+	data, _ := json.Marshal(listCursor{Time: t.UTC(), ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a cursor made by encodeCursor
+func decodeCursor(value string) (*listCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.New("cursor is not valid base64")
+	}
+	var cursor listCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return nil, errors.New("cursor is malformed")
+	}
+	return &cursor, nil
+}
+
+// cursorParam returns the cursor query parameter, accepting after as an alias
+func cursorParam(c *gin.Context) string {
+	if cursor := c.Query("cursor"); cursor != "" {
+		return cursor
+	}
+	return c.Query("after")
+}
+
+// afterCursor returns a scope that keeps the rows sorted after cursor when
+// ordering by timeColumn and idColumn, both descending
+func afterCursor(timeColumn, idColumn string, cursor *listCursor) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("("+timeColumn+", "+idColumn+") < (?, ?)", cursor.Time, cursor.ID)
+	}
+}
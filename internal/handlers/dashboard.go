@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetDashboard godoc
+// @Summary Get the author dashboard
+// @Description Returns what the dashboard home screen shows for the current user in one call: the number of drafts, upcoming scheduled posts, recent comments by others on their posts they haven't replied to, the daily views of their most viewed posts over the last 30 days and their unread notifications. Posts they co-author count as theirs. View counts are updated every ANALYTICS_ROLLUP_INTERVAL (default 15m).
+// @Tags Dashboard
+// @Produce json
+// @Success 200 {object} models.AuthorDashboard "Dashboard summary"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /dashboard [get]
+func (h *Handler) GetDashboard(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	dashboard, err := services.NewDashboardService(h.dbFor(c)).Author(userID.(uint), time.Now())
+	if err != nil {
+		log.Error().Err(err).Uint("user_id", userID.(uint)).Msg("Failed to load the author dashboard")
+		middleware.Abort(c, apierror.Internal(i18n.CodeDashboardFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, dashboard)
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// diagnosticTimeout bounds each individual check
+	diagnosticTimeout = 15 * time.Second
+
+	// Free space thresholds for the upload spool directory
+	diskWarnBytes = 1 << 30   // 1 GiB
+	diskFailBytes = 100 << 20 // 100 MiB
+)
+
+// diagnosticFunc runs one check and returns its status and a human-readable message
+type diagnosticFunc func(ctx context.Context) (models.DiagnosticStatus, string)
+
+// diagnostic is a named check
+type diagnostic struct {
+	name string
+	run  diagnosticFunc
+}
+
+// GetDiagnostics godoc
+// @Summary Run diagnostics
+// @Description Checks the application's external dependencies and configuration (file storage, NewsAPI, RSS feeds, SMTP, virus scanner, disk space, database schema) and reports pass/warn/fail for each
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} models.DiagnosticsReport "Diagnostics report"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Security BearerAuth
+// @Router /admin/diagnostics [get]
+func (h *Handler) GetDiagnostics(c *gin.Context) {
+	cfg := h.cfg
+
+	checks := []diagnostic{
+		{"storage", func(ctx context.Context) (models.DiagnosticStatus, string) { return checkStorage(ctx, cfg) }},
+		{"newsapi", func(ctx context.Context) (models.DiagnosticStatus, string) { return checkNewsAPI(ctx, cfg.NewsAPI) }},
+		{"smtp", func(ctx context.Context) (models.DiagnosticStatus, string) { return checkSMTP(ctx, cfg.SMTP) }},
+		{"virus_scanner", func(ctx context.Context) (models.DiagnosticStatus, string) {
+			return checkVirusScanner(ctx, cfg.Uploads)
+		}},
+		{"disk_space", h.checkDiskSpace},
+		{"migrations", h.checkMigrations},
+	}
+	feeds, err := services.NewNewsSourceService(h.dbFor(c)).Enabled()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load news sources for diagnostics")
+	}
+	for _, feed := range feeds {
+		checks = append(checks, diagnostic{"rss:" + feed.Name, func(ctx context.Context) (models.DiagnosticStatus, string) {
+			return checkRSSFeed(ctx, feed)
+		}})
+	}
+
+	results, status := runDiagnostics(c.Request.Context(), checks, diagnosticTimeout)
+	c.JSON(http.StatusOK, models.DiagnosticsReport{
+		Status:      status,
+		Checks:      results,
+		GeneratedAt: time.Now().UTC(),
+	})
+}
+
+// runDiagnostics runs the checks, each bounded by timeout, and returns their
+// results with the worst status among them
+func runDiagnostics(ctx context.Context, checks []diagnostic, timeout time.Duration) ([]models.DiagnosticCheck, models.DiagnosticStatus) {
+	results := make([]models.DiagnosticCheck, len(checks))
+
+	// Checks are independent and mostly network-bound, so run them in parallel
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check diagnostic) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			status, message := check.run(ctx)
+			results[i] = models.DiagnosticCheck{
+				Name:       check.name,
+				Status:     status,
+				Message:    message,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	status := models.DiagnosticPass
+	for _, result := range results {
+		if result.Status == models.DiagnosticFail {
+			return results, models.DiagnosticFail
+		}
+		if result.Status == models.DiagnosticWarn {
+			status = models.DiagnosticWarn
+		}
+	}
+	return results, status
+}
+
+// checkStorage verifies that the configured storage backend accepts uploads
+func checkStorage(ctx context.Context, cfg *config.Config) (models.DiagnosticStatus, string) {
+	storageService, err := services.NewStorageService(cfg)
+	if err != nil {
+		return models.DiagnosticFail, err.Error() + ", uploads will not work"
+	}
+	if err := storageService.Ping(ctx); err != nil {
+		return models.DiagnosticFail, err.Error()
+	}
+	return models.DiagnosticPass, "Backend " + cfg.Storage.Backend + " is ready"
+}
+
+// checkNewsAPI verifies the NewsAPI key
+func checkNewsAPI(ctx context.Context, cfg config.NewsAPIConfig) (models.DiagnosticStatus, string) {
+	// Nothing is fetched, so the service doesn't need the categories
+	newsService, err := services.NewNewsService(cfg, services.NewNewsTaxonomy(nil, nil))
+	if err != nil {
+		if cfg.EnableAutoFetch {
+			return models.DiagnosticFail, err.Error() + " but auto fetch is enabled"
+		}
+		return models.DiagnosticWarn, err.Error() + ", NewsAPI fetching is unavailable"
+	}
+	if err := newsService.VerifyAPIKey(ctx); err != nil {
+		return models.DiagnosticFail, err.Error()
+	}
+	return models.DiagnosticPass, "API key accepted"
+}
+
+// checkRSSFeed verifies that a feed can be fetched and parsed
+func checkRSSFeed(ctx context.Context, feed models.NewsSource) (models.DiagnosticStatus, string) {
+	// A nil source service keeps checks out of the feed's fetch status
+	rssService := services.NewRSSService(nil, services.NewNewsTaxonomy(nil, nil), nil)
+	if err := rssService.CheckFeed(ctx, feed); err != nil {
+		return models.DiagnosticFail, fmt.Sprintf("%s: %v", feed.URL, err)
+	}
+	return models.DiagnosticPass, "Feed reachable at " + feed.URL
+}
+
+// checkSMTP verifies that the SMTP server accepts the configured login
+func checkSMTP(ctx context.Context, cfg config.SMTPConfig) (models.DiagnosticStatus, string) {
+	emailService := services.NewEmailService(cfg)
+	if !emailService.Enabled() {
+		return models.DiagnosticWarn, "SMTP is not configured, emails will not be sent"
+	}
+	if err := emailService.Verify(ctx); err != nil {
+		return models.DiagnosticFail, err.Error()
+	}
+	return models.DiagnosticPass, fmt.Sprintf("Logged in to %s:%d", cfg.Host, cfg.Port)
+}
+
+// checkVirusScanner verifies that clamd answers when upload scanning is enabled
+func checkVirusScanner(ctx context.Context, cfg config.UploadsConfig) (models.DiagnosticStatus, string) {
+	if cfg.ClamAVAddress == "" {
+		return models.DiagnosticWarn, "ClamAV is not configured, uploads are not scanned for viruses"
+	}
+	if err := upload.NewClamAVScanner(cfg.ClamAVAddress, cfg.ClamAVTimeout).Ping(ctx); err != nil {
+		return models.DiagnosticFail, err.Error() + ", uploads will be rejected"
+	}
+	return models.DiagnosticPass, "clamd reachable at " + cfg.ClamAVAddress
+}
+
+// checkDiskSpace reports the free space where uploads are spooled before they
+// are sent to the storage backend, or where they are stored with local storage
+func (h *Handler) checkDiskSpace(ctx context.Context) (models.DiagnosticStatus, string) {
+	dir := os.TempDir()
+	if storage := h.cfg.Storage; storage.Backend == config.StorageBackendLocal {
+		dir = storage.Local.Dir
+	}
+	free, err := services.DiskFreeBytes(dir)
+	if err != nil {
+		return models.DiagnosticWarn, err.Error()
+	}
+
+	message := fmt.Sprintf("%.1f GiB free in %s", float64(free)/(1<<30), dir)
+	switch {
+	case free < diskFailBytes:
+		return models.DiagnosticFail, message
+	case free < diskWarnBytes:
+		return models.DiagnosticWarn, message
+	default:
+		return models.DiagnosticPass, message
+	}
+}
+
+// checkMigrations reports migrations that haven't been applied to the database
+func (h *Handler) checkMigrations(_ context.Context) (models.DiagnosticStatus, string) {
+	if h.schemaCurrent.Load() {
+		return models.DiagnosticPass, "Schema is up to date"
+	}
+
+	pending, err := database.PendingMigrations(h.cfg.Database.DSN)
+	if err != nil {
+		return models.DiagnosticFail, err.Error()
+	}
+	if len(pending) > 0 {
+		return models.DiagnosticFail, "Schema is behind, run the migrate command to apply: " + strings.Join(pending, ", ")
+	}
+	h.schemaCurrent.Store(true)
+	return models.DiagnosticPass, "Schema is up to date"
+}
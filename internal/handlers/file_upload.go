@@ -6,9 +6,12 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
 	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
 	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
 	"github.com/rs/zerolog/log"
 )
 
@@ -26,6 +29,13 @@ var allowedUploadFileTypes = map[string]bool{
 	".pdf":  true,
 }
 
+// EditorFileUpload is the content check applied to editor file uploads
+var EditorFileUpload = upload.Policy{
+	Field:   "file",
+	Types:   append(append([]string(nil), upload.ImageTypes...), upload.TypePDF),
+	MaxSize: maxFileSize,
+}
+
 // UploadFile godoc
 // @Summary Upload a file for editor use
 // @Description Upload a file that can be used in the editor when creating or editing posts
@@ -33,77 +43,53 @@ var allowedUploadFileTypes = map[string]bool{
 // @Accept multipart/form-data
 // @Produce json
 // @Param file formData file true "File to upload (JPG, JPEG, PNG, WEBP, GIF, SVG, PDF, max 5MB)"
-// @Success 200 {object} models.SwaggerFileUploadResponse "File uploaded successfully"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.SwaggerFileUploadResponse} "File uploaded successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /files/upload [post]
-func UploadFile(c *gin.Context) {
+func (h *Handler) UploadFile(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
 	// Get the file from the request
 	file, err := c.FormFile("file")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "No file uploaded or invalid file",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileMissing))
 		return
 	}
 
 	// Check file size
 	if file.Size > maxFileSize {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "File too large",
-			"message": "File must be less than 5MB",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileTooLarge))
 		return
 	}
 
 	// Check file type
 	ext := strings.ToLower(filepath.Ext(file.Filename))
 	if !allowedUploadFileTypes[ext] {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid file type",
-			"message": "Only JPG, JPEG, PNG, WEBP, GIF, SVG, and PDF files are allowed",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileInvalidType))
 		return
 	}
 
-	// Initialize Cloudinary service
-	cloudinaryService, err := services.NewCloudinaryService(middleware.AppConfig.Cloudinary)
+	// Initialize storage service
+	storageService, err := services.NewStorageService(h.cfg)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize Cloudinary service")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Server error",
-			"message": "Failed to initialize upload service",
-		})
+		log.Error().Err(err).Msg("Failed to initialize storage service")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUploadServiceFailed, err))
 		return
 	}
 
-	// Upload the file to Cloudinary
-	fileURL, err := cloudinaryService.UploadEditorFile(c.Request.Context(), file, userID.(uint))
+	// Upload the file to storage
+	fileURL, err := storageService.UploadEditorFile(c.Request.Context(), file, userID.(uint))
 	if err != nil {
 		log.Error().Err(err).Interface("user_id", userID).Msg("Failed to upload file")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Upload failed",
-			"message": "Failed to upload file",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodeFileUploadFailed, err))
 		return
 	}
 
@@ -123,66 +109,46 @@ func UploadFile(c *gin.Context) {
 // @Tags Files
 // @Accept json
 // @Produce json
-// @Param request body models.SwaggerDeleteFileRequest true "File URL to delete"
+// @Param request body models.DeleteFileRequest true "File URL to delete"
 // @Success 200 {object} models.SwaggerStandardResponse "File deleted successfully"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /files/delete [post]
-func DeleteFile(c *gin.Context) {
+func (h *Handler) DeleteFile(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
 	_, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
 	// Parse request body
 	var request models.DeleteFileRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "Invalid request format",
-		})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Validate file URL
 	if request.FileURL == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "File URL is required",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileURLRequired))
 		return
 	}
 
-	// Initialize Cloudinary service
-	cloudinaryService, err := services.NewCloudinaryService(middleware.AppConfig.Cloudinary)
+	// Initialize storage service
+	storageService, err := services.NewStorageService(h.cfg)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize Cloudinary service")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Server error",
-			"message": "Failed to initialize service",
-		})
+		log.Error().Err(err).Msg("Failed to initialize storage service")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUploadServiceFailed, err))
 		return
 	}
 
-	// Delete the file from Cloudinary
-	if err := cloudinaryService.DeleteImage(c.Request.Context(), request.FileURL); err != nil {
+	// Delete the file from storage
+	if err := storageService.DeleteImage(c.Request.Context(), request.FileURL); err != nil {
 		log.Error().Err(err).Str("file_url", request.FileURL).Msg("Failed to delete file")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Delete failed",
-			"message": "Failed to delete file",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodeFileDeleteFailed, err))
 		return
 	}
 
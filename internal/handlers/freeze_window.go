@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// GetFreezeWindows godoc
+// @Summary List content freeze windows
+// @Description Returns current and upcoming freeze windows, or all windows with all=true
+// @Tags Admin
+// @Produce json
+// @Param all query bool false "Include windows that have already ended"
+// @Success 200 {array} models.FreezeWindow "List of freeze windows"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/freeze-windows [get]
+func (h *Handler) GetFreezeWindows(c *gin.Context) {
+	query := h.dbFor(c).Order("starts_at ASC")
+	if c.Query("all") != "true" {
+		query = query.Where("ends_at > ?", time.Now())
+	}
+
+	windows := []models.FreezeWindow{}
+	if err := query.Find(&windows).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeFreezeWindowsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, windows)
+}
+
+// CreateFreezeWindow godoc
+// @Summary Create a content freeze window
+// @Description Schedules a period during which publishes and scheduled publishing are paused. Publishes requested during the window are queued until it ends.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.CreateFreezeWindowRequest true "Freeze window details"
+// @Success 201 {object} models.FreezeWindow "Created freeze window"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/freeze-windows [post]
+func (h *Handler) CreateFreezeWindow(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.CreateFreezeWindowRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if !requestBody.EndsAt.After(requestBody.StartsAt) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFreezeWindowInvalidRange))
+		return
+	}
+	if requestBody.EndsAt.Before(time.Now()) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFreezeWindowEnded))
+		return
+	}
+
+	window := models.FreezeWindow{
+		Reason:    requestBody.Reason,
+		StartsAt:  requestBody.StartsAt,
+		EndsAt:    requestBody.EndsAt,
+		CreatedBy: userID.(uint),
+	}
+	if err := h.dbFor(c).Create(&window).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeFreezeWindowCreateFailed, err))
+		return
+	}
+
+	log.Info().
+		Uint("id", window.ID).
+		Time("starts_at", window.StartsAt).
+		Time("ends_at", window.EndsAt).
+		Str("reason", window.Reason).
+		Msg("Content freeze window created")
+
+	c.JSON(http.StatusCreated, window)
+}
+
+// DeleteFreezeWindow godoc
+// @Summary Delete a content freeze window
+// @Description Removes a freeze window. Deleting an active window lifts the freeze and queued publishes go out on the next scheduler run.
+// @Tags Admin
+// @Produce json
+// @Param id path int true "Freeze window ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Freeze window not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/freeze-windows/{id} [delete]
+func (h *Handler) DeleteFreezeWindow(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidFreezeWindowID))
+		return
+	}
+
+	result := h.dbFor(c).Delete(&models.FreezeWindow{}, id)
+	if result.Error != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeFreezeWindowDeleteFailed, result.Error))
+		return
+	}
+	if result.RowsAffected == 0 {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeFreezeWindowNotFound))
+		return
+	}
+
+	log.Info().Uint64("id", id).Msg("Content freeze window deleted")
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Freeze window deleted successfully"})
+}
+
+// queuePublishDuringFreeze reschedules a post that is about to be published if a
+// content freeze is in effect. The post is switched to scheduled with a publish time
+// no earlier than the end of the window, and the active window is returned.
+func (h *Handler) queuePublishDuringFreeze(c *gin.Context, post *models.Post) (*models.FreezeWindow, error) {
+	if post.Status != models.PostStatusPublished {
+		return nil, nil
+	}
+
+	window, err := database.ActiveFreezeWindow(h.dbFor(c), time.Now())
+	if err != nil || window == nil {
+		return nil, err
+	}
+
+	publishAt := window.EndsAt
+	post.Status = models.PostStatusScheduled
+	post.PublishAt = &publishAt
+	return window, nil
+}
+
+// respondPublishQueued responds with a post that was saved while a content
+// freeze queued its publish. The post is saved, so status is a success code.
+func respondPublishQueued(c *gin.Context, status int, post models.Post, window *models.FreezeWindow) {
+	c.JSON(status, models.QueuedPost{
+		Post:        post,
+		Queued:      true,
+		FrozenUntil: window.EndsAt,
+	})
+}
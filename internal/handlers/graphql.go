@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/graphql"
+)
+
+// GraphQL godoc
+// @Summary GraphQL endpoint
+// @Description Runs a GraphQL query or mutation over posts, tags, comments, news and the caller's profile. The schema can be fetched by introspection. Resolvers call the matching REST endpoints, so permissions, validation and error codes are the same; errors carry the REST error code in extensions.code. Queries may be sent with GET, mutations only with POST. Authentication is optional, but mutations and the me query need a Bearer token or an API key (GET needs the read scope, POST the write scope).
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Param request body models.GraphQLRequest true "GraphQL request"
+// @Success 200 {object} models.GraphQLResponse "Result, with errors for the fields that failed"
+// @Failure 400 {object} models.GraphQLResponse "Malformed request body"
+// @Failure 401 {object} models.ErrorResponse "Invalid credentials"
+// @Failure 422 {object} models.GraphQLResponse "Invalid query"
+// @Router /graphql [post]
+func (h *Handler) GraphQL(c *gin.Context) {
+	h.graphQL.ServeHTTP(c.Writer, graphql.WithGinContext(c))
+}
+
+// GraphQLQuery godoc
+// @Summary GraphQL query over GET
+// @Description Runs a GraphQL query given in the query string. Mutations are rejected; send them with POST.
+// @Tags GraphQL
+// @Produce json
+// @Param query query string true "GraphQL document"
+// @Param operationName query string false "Operation to run when the document has several"
+// @Param variables query string false "Variables as a JSON object"
+// @Success 200 {object} models.GraphQLResponse "Result, with errors for the fields that failed"
+// @Failure 400 {object} models.GraphQLResponse "Missing query or invalid variables"
+// @Failure 401 {object} models.ErrorResponse "Invalid credentials"
+// @Failure 406 {object} models.GraphQLResponse "Mutation sent with GET"
+// @Failure 422 {object} models.GraphQLResponse "Invalid query"
+// @Router /graphql [get]
+func (h *Handler) GraphQLQuery(c *gin.Context) {
+	h.graphQL.ServeHTTP(c.Writer, graphql.WithGinContext(c))
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/graphql"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"gorm.io/gorm"
+)
+
+// Handler serves the API endpoints. Its dependencies are passed in rather
+// than read from package globals, so it can be built against a test
+// database or fake repositories.
+type Handler struct {
+	db   *gorm.DB
+	cfg  *config.Config
+	keys *services.JWTKeyRing
+	jobs *services.JobMonitor
+
+	slugs *services.SlugService
+
+	posts    repository.PostRepository
+	users    repository.UserRepository
+	comments repository.CommentRepository
+	news     repository.NewsRepository
+
+	// graphQL serves /graphql with resolvers that call the handlers below
+	graphQL *handler.Server
+
+	startedAt time.Time
+	// schemaCurrent caches a passing migration check; the schema only
+	// changes when the process restarts
+	schemaCurrent atomic.Bool
+}
+
+// New creates a Handler for the given database, configuration, JWT key ring,
+// background job monitor and repositories
+func New(db *gorm.DB, cfg *config.Config, keys *services.JWTKeyRing, jobs *services.JobMonitor, repos *repository.Repositories) *Handler {
+	h := &Handler{
+		db:        db,
+		cfg:       cfg,
+		keys:      keys,
+		jobs:      jobs,
+		slugs:     services.NewSlugService(),
+		posts:     repos.Posts,
+		users:     repos.Users,
+		comments:  repos.Comments,
+		news:      repos.News,
+		startedAt: time.Now(),
+	}
+	h.graphQL = graphql.NewServer(graphql.Endpoints{
+		GetPosts:            h.GetPosts,
+		GetPostBySlug:       h.GetPostBySlug,
+		GetCommentsByPostID: h.GetCommentsByPostID,
+		GetAllTags:          h.GetAllTags,
+		GetNews:             h.GetNews,
+		GetNewsBySlug:       h.GetNewsBySlug,
+		GetProfile:          h.GetProfile,
+		CreatePost:          h.CreatePost,
+		UpdatePost:          h.UpdatePost,
+		DeletePost:          h.DeletePost,
+	})
+	return h
+}
+
+// dbFor returns the database bound to the request's context, so the request's
+// queries are cancelled when the client goes away and show up in its trace
+func (h *Handler) dbFor(c *gin.Context) *gorm.DB {
+	return h.db.WithContext(c.Request.Context())
+}
+
+// postsFor returns the post repository bound to the request's context
+func (h *Handler) postsFor(c *gin.Context) repository.PostRepository {
+	return h.posts.WithContext(c.Request.Context())
+}
+
+// usersFor returns the user repository bound to the request's context
+func (h *Handler) usersFor(c *gin.Context) repository.UserRepository {
+	return h.users.WithContext(c.Request.Context())
+}
+
+// commentsFor returns the comment repository bound to the request's context
+func (h *Handler) commentsFor(c *gin.Context) repository.CommentRepository {
+	return h.comments.WithContext(c.Request.Context())
+}
+
+// newsFor returns the news repository bound to the request's context
+func (h *Handler) newsFor(c *gin.Context) repository.NewsRepository {
+	return h.news.WithContext(c.Request.Context())
+}
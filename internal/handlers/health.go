@@ -1,24 +1,32 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
 )
 
+// readinessTimeout bounds each readiness check. Probes usually time out after
+// a few seconds, so the checks only look at local state and the database.
+const readinessTimeout = 3 * time.Second
+
 // HealthCheck godoc
 // @Summary Check API health
 // @Description Provides a simple endpoint to verify the API and database are running
 // @Tags System
 // @Produce json
 // @Success 200 {object} models.SwaggerStandardResponse "API is healthy"
-// @Failure 503 {object} models.SwaggerStandardResponse "Database connection issues"
+// @Failure 503 {object} models.ErrorResponse "Database connection issues"
 // @Router /health [get]
-func HealthCheck(c *gin.Context) {
+func (h *Handler) HealthCheck(c *gin.Context) {
 	// Check database connectivity
-	sqlDB, err := database.DB.DB()
+	sqlDB, err := h.dbFor(c).DB()
 	if err != nil {
 		c.JSON(http.StatusServiceUnavailable, gin.H{
 			"status":  "error",
@@ -44,3 +52,104 @@ func HealthCheck(c *gin.Context) {
 		"time":    time.Now().Format(time.RFC3339),
 	})
 }
+
+// LivenessCheck godoc
+// @Summary Liveness probe
+// @Description Reports that the process is up without checking any dependency, for restarting a hung instance
+// @Tags System
+// @Produce json
+// @Success 200 {object} models.LivenessResponse "Process is up"
+// @Router /health/live [get]
+func (h *Handler) LivenessCheck(c *gin.Context) {
+	c.JSON(http.StatusOK, models.LivenessResponse{
+		Status:        "up",
+		UptimeSeconds: int64(time.Since(h.startedAt).Seconds()),
+		Time:          time.Now().UTC(),
+	})
+}
+
+// ReadinessCheck godoc
+// @Summary Readiness probe
+// @Description Checks that the database is reachable, migrations are applied, the storage and NewsAPI configuration is valid and the background workers are running, and reports the status and latency of each. Responds 503 when any check fails so traffic is held back from the instance.
+// @Tags System
+// @Produce json
+// @Success 200 {object} models.ReadinessReport "Instance is ready"
+// @Failure 503 {object} models.ReadinessReport "A dependency is unavailable"
+// @Router /health/ready [get]
+func (h *Handler) ReadinessCheck(c *gin.Context) {
+	cfg := h.cfg
+	checks := []diagnostic{
+		{"database", h.checkDatabase},
+		{"migrations", h.checkMigrations},
+		{"storage", func(ctx context.Context) (models.DiagnosticStatus, string) { return checkStorageConfig(cfg) }},
+		{"newsapi", func(ctx context.Context) (models.DiagnosticStatus, string) { return checkNewsAPIConfig(cfg.NewsAPI) }},
+	}
+	for _, job := range h.jobs.Statuses() {
+		checks = append(checks, diagnostic{"worker:" + job.Name, func(ctx context.Context) (models.DiagnosticStatus, string) {
+			return checkWorker(job)
+		}})
+	}
+
+	results, status := runDiagnostics(c.Request.Context(), checks, readinessTimeout)
+	report := models.ReadinessReport{
+		Ready:     status != models.DiagnosticFail,
+		Status:    status,
+		Checks:    results,
+		CheckedAt: time.Now().UTC(),
+	}
+
+	code := http.StatusOK
+	if !report.Ready {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, report)
+}
+
+// checkDatabase pings the database
+func (h *Handler) checkDatabase(ctx context.Context) (models.DiagnosticStatus, string) {
+	sqlDB, err := h.db.DB()
+	if err != nil {
+		return models.DiagnosticFail, err.Error()
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return models.DiagnosticFail, err.Error()
+	}
+	stats := sqlDB.Stats()
+	return models.DiagnosticPass, fmt.Sprintf("%d open connections, %d in use", stats.OpenConnections, stats.InUse)
+}
+
+// checkStorageConfig verifies that the storage backend is configured, without
+// writing to it
+func checkStorageConfig(cfg *config.Config) (models.DiagnosticStatus, string) {
+	if _, err := services.NewStorageService(cfg); err != nil {
+		return models.DiagnosticFail, err.Error() + ", uploads will not work"
+	}
+	return models.DiagnosticPass, "Backend " + cfg.Storage.Backend + " is configured"
+}
+
+// checkNewsAPIConfig verifies that NewsAPI is configured when auto fetch needs
+// it, without calling the API
+func checkNewsAPIConfig(cfg config.NewsAPIConfig) (models.DiagnosticStatus, string) {
+	if _, err := services.NewNewsService(cfg, services.NewNewsTaxonomy(nil, nil)); err != nil {
+		if cfg.EnableAutoFetch {
+			return models.DiagnosticFail, err.Error() + " but auto fetch is enabled"
+		}
+		return models.DiagnosticWarn, err.Error() + ", NewsAPI fetching is unavailable"
+	}
+	return models.DiagnosticPass, "API key is configured"
+}
+
+// checkWorker reports whether a background job loop is still completing runs
+func checkWorker(job services.JobStatus) (models.DiagnosticStatus, string) {
+	if job.LastRun.IsZero() {
+		if job.Stalled {
+			return models.DiagnosticFail, fmt.Sprintf("No run completed since it started %s ago", time.Since(job.StartedAt).Round(time.Second))
+		}
+		return models.DiagnosticPass, "Started, waiting for the first run"
+	}
+	since := time.Since(job.LastRun).Round(time.Second)
+	if job.Stalled {
+		return models.DiagnosticFail, fmt.Sprintf("Last run %s ago, expected every %s", since, job.Interval)
+	}
+	return models.DiagnosticPass, fmt.Sprintf("Last run %s ago", since)
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm/clause"
+)
+
+// GetHomeFeed godoc
+// @Summary Get the homepage feed
+// @Description Returns published posts and news articles mixed into a single feed, ranked by recency, popularity and editorial weight. The ranking strategy and its coefficients are site settings.
+// @Tags Home
+// @Produce json
+// @Param limit query int false "Number of items to return (default: 20, max: 50)"
+// @Success 200 {object} models.HomeFeedResponse "Ranked homepage feed"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /home/feed [get]
+func (h *Handler) GetHomeFeed(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	ranker := services.NewRanker(services.NewSiteSettingsService(h.dbFor(c)))
+	feed, err := services.NewHomeFeedService(h.dbFor(c), ranker).Feed(limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to build homepage feed")
+		middleware.Abort(c, apierror.Internal(i18n.CodeFeedBuildFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, feed)
+}
+
+// GetEditorialPicks godoc
+// @Summary List editorial picks
+// @Description Returns the posts and news articles that are boosted in the homepage feed
+// @Tags Home
+// @Produce json
+// @Success 200 {array} models.EditorialPick "Editorial picks"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/home/picks [get]
+func (h *Handler) GetEditorialPicks(c *gin.Context) {
+	picks := []models.EditorialPick{}
+	if err := h.dbFor(c).Order("weight DESC").Find(&picks).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeEditorialPicksFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, picks)
+}
+
+// SetEditorialPick godoc
+// @Summary Boost a feed item
+// @Description Sets the editorial weight of a post or news article in the homepage feed, replacing any previous weight
+// @Tags Home
+// @Accept json
+// @Produce json
+// @Param request body models.SetEditorialPickRequest true "Item and weight"
+// @Success 200 {object} models.EditorialPick "Editorial pick"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Item not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/home/picks [put]
+func (h *Handler) SetEditorialPick(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.SetEditorialPickRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	var count int64
+	var err error
+	if requestBody.ItemType == models.FeedItemPost {
+		err = h.dbFor(c).Model(&models.Post{}).Where("id = ?", requestBody.ItemID).Count(&count).Error
+	} else {
+		err = h.dbFor(c).Model(&models.News{}).Where("id = ?", requestBody.ItemID).Count(&count).Error
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeEditorialPickSaveFailed, err))
+		return
+	}
+	if count == 0 {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeFeedItemNotFound))
+		return
+	}
+
+	pick := models.EditorialPick{
+		ItemType:  requestBody.ItemType,
+		ItemID:    requestBody.ItemID,
+		Weight:    requestBody.Weight,
+		CreatedBy: userID.(uint),
+	}
+	if err := h.dbFor(c).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_type"}, {Name: "item_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"weight", "updated_at"}),
+	}).Create(&pick).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeEditorialPickSaveFailed, err))
+		return
+	}
+
+	// Reload so an updated pick reports its original creator
+	if err := h.dbFor(c).Where("item_type = ? AND item_id = ?", pick.ItemType, pick.ItemID).First(&pick).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeEditorialPickSaveFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, pick)
+}
+
+// DeleteEditorialPick godoc
+// @Summary Remove an editorial pick
+// @Description Removes the editorial boost of a post or news article
+// @Tags Home
+// @Produce json
+// @Param id path int true "Editorial pick ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Editorial pick deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Editorial pick not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/home/picks/{id} [delete]
+func (h *Handler) DeleteEditorialPick(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidEditorialPickID))
+		return
+	}
+
+	result := h.dbFor(c).Delete(&models.EditorialPick{}, id)
+	if result.Error != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeEditorialPickDeleteFailed, result.Error))
+		return
+	}
+	if result.RowsAffected == 0 {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeEditorialPickNotFound))
+		return
+	}
+
+	log.Info().Uint64("id", id).Msg("Editorial pick deleted")
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Editorial pick deleted successfully"})
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// GetIngestionRuns godoc
+// @Summary List news ingestion runs
+// @Description Returns the most recent news ingestion runs with their counters and source errors (admin only)
+// @Tags News
+// @Produce json
+// @Param source query string false "Filter by pipeline (newsapi, rss)"
+// @Param status query string false "Filter by run status (running, completed, failed)"
+// @Param limit query int false "Number of runs to return (default: 50, max: 200)"
+// @Success 200 {array} models.IngestionRun "Ingestion runs, newest first"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/ingestions [get]
+func (h *Handler) GetIngestionRuns(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	query := h.dbFor(c).Model(&models.IngestionRun{})
+	if source := c.Query("source"); source != "" {
+		query = query.Where("source = ?", source)
+	}
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	runs := []models.IngestionRun{}
+	if err := query.Order("started_at DESC").Limit(limit).Find(&runs).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeIngestionRunsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, runs)
+}
+
+// GetIngestionRun godoc
+// @Summary Get a news ingestion run
+// @Description Returns a single ingestion run with the outcome of every article in it, including why articles were skipped (admin only)
+// @Tags News
+// @Produce json
+// @Param id path int true "Ingestion run ID"
+// @Param outcome query string false "Only include articles with this outcome (saved, deduped, filtered, failed)"
+// @Success 200 {object} models.IngestionRun "Ingestion run with its articles"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Ingestion run not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/ingestions/{id} [get]
+func (h *Handler) GetIngestionRun(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidIngestionRunID))
+		return
+	}
+
+	outcome := c.Query("outcome")
+
+	var run models.IngestionRun
+	err = h.dbFor(c).Preload("Items", func(db *gorm.DB) *gorm.DB {
+		if outcome != "" {
+			db = db.Where("outcome = ?", outcome)
+		}
+		return db.Order("id ASC")
+	}).First(&run, uint(id)).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeIngestionRunNotFound))
+			return
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodeIngestionRunsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
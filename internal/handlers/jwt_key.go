@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetJWTKeys godoc
+// @Summary List JWT signing keys
+// @Description Returns the keys tokens are signed and verified with, newest first, including retired keys. Secrets are never returned.
+// @Tags Admin
+// @Produce json
+// @Success 200 {array} models.JWTSigningKey "JWT signing keys"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/jwt-keys [get]
+func (h *Handler) GetJWTKeys(c *gin.Context) {
+	keys, err := h.keys.List()
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeJWTKeysFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, keys)
+}
+
+// RotateJWTKey godoc
+// @Summary Rotate the JWT signing key
+// @Description Generates a new signing key and signs new tokens with it. Tokens signed with older keys stay valid until those keys are retired, so nobody is signed out. Other instances pick up the new key within a minute.
+// @Tags Admin
+// @Produce json
+// @Success 201 {object} models.JWTSigningKey "New signing key"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/jwt-keys/rotate [post]
+func (h *Handler) RotateJWTKey(c *gin.Context) {
+	key, err := h.keys.Rotate()
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeJWTKeyRotateFailed, err))
+		return
+	}
+
+	log.Info().Str("kid", key.KID).Msg("JWT signing key rotated")
+	h.recordAudit(c, models.AuditActionJWTKeyRotated, "jwt_key", key.KID, nil, gin.H{"source": key.Source})
+
+	c.JSON(http.StatusCreated, key)
+}
+
+// RetireJWTKey godoc
+// @Summary Retire a JWT signing key
+// @Description Stops accepting tokens signed with a key, signing out every session that still uses it. Retire old keys once JWT_REFRESH_EXPIRY has passed since the rotation, or right away if the key was leaked. The current signing key can't be retired.
+// @Tags Admin
+// @Produce json
+// @Param kid path string true "Key ID"
+// @Success 200 {object} models.JWTSigningKey "Retired key"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Key not found"
+// @Failure 409 {object} models.ErrorResponse "Key is the current signing key"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/jwt-keys/{kid} [delete]
+func (h *Handler) RetireJWTKey(c *gin.Context) {
+	key, err := h.keys.Retire(c.Param("kid"))
+	switch {
+	case errors.Is(err, services.ErrJWTKeyNotFound):
+		middleware.Abort(c, apierror.NotFound(i18n.CodeJWTKeyNotFound))
+		return
+	case errors.Is(err, services.ErrJWTKeyInUse):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeJWTKeyInUse))
+		return
+	case err != nil:
+		middleware.Abort(c, apierror.Internal(i18n.CodeJWTKeyRetireFailed, err))
+		return
+	}
+
+	log.Info().Str("kid", key.KID).Msg("JWT signing key retired")
+	h.recordAudit(c, models.AuditActionJWTKeyRetired, "jwt_key", key.KID, gin.H{"retired_at": nil}, gin.H{"retired_at": key.RetiredAt})
+
+	c.JSON(http.StatusOK, key)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// allLanguages is the lang value that lists content in every language
+const allLanguages = "all"
+
+// contentLanguageFilter returns the language a public list is narrowed to:
+// lang, the lang query parameter, or else the content language the client
+// prefers in Accept-Language. It returns "" to list every language, which is
+// the case for lang=all and for clients that accept none of the content
+// languages. An unsupported lang aborts the request with 400.
+func contentLanguageFilter(c *gin.Context, lang string) (string, bool) {
+	// The same URL lists different content depending on the header
+	c.Writer.Header().Add("Vary", "Accept-Language")
+
+	switch lang = strings.ToLower(strings.TrimSpace(lang)); {
+	case lang == allLanguages:
+		return "", true
+	case lang != "":
+		if !models.IsContentLanguage(lang) {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidLanguage).WithDetails(gin.H{
+				"languages": []string{models.LanguageEnglish, models.LanguageVietnamese},
+			}))
+			return "", false
+		}
+		return lang, true
+	}
+
+	if preferred, ok := i18n.Match(c.GetHeader("Accept-Language")); ok && models.IsContentLanguage(preferred) {
+		return preferred, true
+	}
+	return "", true
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+)
+
+// GetBrokenLinks godoc
+// @Summary List broken links
+// @Description Returns the outbound links in published posts and news articles that failed their last check, longest broken first, with the title and slug of the content they are in (admin only)
+// @Tags Admin
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of links per page (default: PAGINATION_DEFAULT_LIMIT, max: PAGINATION_MAX_LIMIT)"
+// @Param domain query string false "Only include links to this domain"
+// @Param source_type query string false "Only include links in this kind of content (post, news)"
+// @Success 200 {object} models.SwaggerBrokenLinksResponse "Broken links with pagination metadata"
+// @Failure 400 {object} models.ErrorResponse "Invalid page or page size"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/content/broken-links [get]
+func (h *Handler) GetBrokenLinks(c *gin.Context) {
+	page, limit, ok := h.pageQuery(c)
+	if !ok {
+		return
+	}
+
+	query := h.dbFor(c).Model(&models.ContentLink{}).Where("content_links.broken = ?", true)
+	if domain := c.Query("domain"); domain != "" {
+		query = query.Where("content_links.domain = ?", domain)
+	}
+	if sourceType := c.Query("source_type"); sourceType != "" {
+		query = query.Where("content_links.source_type = ?", sourceType)
+	}
+	// News is shared by every site, but links found in posts are only listed
+	// on the post's site
+	if siteID, ok := site.IDFromContext(c.Request.Context()); ok {
+		query = query.Where("content_links.source_type <> ? OR EXISTS (SELECT 1 FROM posts WHERE posts.id = content_links.source_id AND posts.site_id = ?)",
+			models.ContentLinkSourcePost, siteID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBrokenLinksFetchFailed, err))
+		return
+	}
+
+	links := []models.BrokenLink{}
+	if err := query.
+		Select("content_links.*, COALESCE(posts.title, news.title, '') AS source_title, COALESCE(posts.slug, news.slug, '') AS source_slug").
+		Joins("LEFT JOIN posts ON content_links.source_type = ? AND posts.id = content_links.source_id", models.ContentLinkSourcePost).
+		Joins("LEFT JOIN news ON content_links.source_type = ? AND news.id = content_links.source_id", models.ContentLinkSourceNews).
+		Order("content_links.broken_at ASC").Order("content_links.id ASC").
+		Limit(limit).Offset((page - 1) * limit).
+		Find(&links).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeBrokenLinksFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"links": links,
+		"meta": gin.H{
+			"page":     page,
+			"limit":    limit,
+			"total":    total,
+			"lastPage": (int(total) + limit - 1) / limit,
+		},
+	})
+}
+
+// CheckLinks godoc
+// @Summary Check links now
+// @Description Starts checking the outbound links in published content in the background instead of waiting for the next scheduled run. Links are checked oldest check first, up to LINK_CHECK_BATCH_SIZE, pausing LINK_CHECK_DOMAIN_DELAY between requests to the same domain (admin only).
+// @Tags Admin
+// @Produce json
+// @Success 202 {object} models.SwaggerStandardResponse "Link check started"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "A link check is already running"
+// @Security BearerAuth
+// @Router /admin/content/broken-links/check [post]
+func (h *Handler) CheckLinks(c *gin.Context) {
+	// The check outlives the request, so it runs on the unbound database
+	if !services.NewLinkCheckService(h.db, h.cfg).Start() {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeLinkCheckRunning))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"status":  "success",
+		"message": "Link check started",
+	})
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+)
+
+// GetPostLinkSuggestions godoc
+// @Summary Suggest internal links for a post
+// @Description Suggests published posts that a post, typically a draft, could link to. Posts are ranked by the tags they share with it and by how many distinctive words they have in common; the shared words are returned as anchor text ideas. Posts it already links to are left out. Only users who can edit the post can ask.
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Param limit query int false "Number of suggestions (default: 5, max: 20)"
+// @Success 200 {array} models.LinkSuggestion "Suggested posts, best first"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/link-suggestions [get]
+func (h *Handler) GetPostLinkSuggestions(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "5"))
+	if err != nil || limit < 1 {
+		limit = 5
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return
+	}
+	if !can(c, policy.ActionPostEdit, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostEditForbidden))
+		return
+	}
+
+	if err := h.dbFor(c).Model(post).Association("Tags").Find(&post.Tags); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeLinkSuggestionsFailed, err))
+		return
+	}
+
+	suggestions, err := services.NewLinkSuggestionService(h.dbFor(c), h.cfg.Newsletter.SiteURL).Suggest(post, limit)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeLinkSuggestionsFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
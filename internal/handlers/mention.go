@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// syncMentions records the users comment mentions and returns the ones who
+// weren't mentioned in it before. Failures are logged; they don't fail the
+// request.
+func (h *Handler) syncMentions(c *gin.Context, comment *models.Comment) []uint {
+	added, err := services.NewMentionService(h.dbFor(c)).Sync(comment)
+	if err != nil {
+		log.Error().Err(err).Uint("comment_id", comment.ID).Msg("Failed to record comment mentions")
+		return nil
+	}
+	return added
+}
+
+// notifyMentions notifies users newly mentioned in an edited comment
+func (h *Handler) notifyMentions(comment models.Comment, userIDs []uint) {
+	if len(userIDs) == 0 {
+		return
+	}
+	if err := services.NewNotificationService(h.db).NotifyMentions(comment, userIDs); err != nil {
+		log.Error().Err(err).Uint("comment_id", comment.ID).Msg("Failed to create mention notifications")
+	}
+}
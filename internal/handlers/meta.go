@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/docs"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/version"
+)
+
+// clientChecksum is the checksum of the embedded TypeScript client
+var clientChecksum = sync.OnceValue(func() string {
+	sum := sha256.Sum256([]byte(docs.ClientTS))
+	return "sha256:" + hex.EncodeToString(sum[:])
+})
+
+// GetMeta godoc
+// @Summary Get API version and feature flags
+// @Description Returns the API version, the checksum of the generated TypeScript client and which optional features this instance has enabled, so frontends can detect capabilities at runtime
+// @Tags System
+// @Produce json
+// @Success 200 {object} models.APIMeta "API metadata"
+// @Router /meta [get]
+func (h *Handler) GetMeta(c *gin.Context) {
+	features := map[string]bool{}
+	if h.cfg != nil {
+		features = map[string]bool{
+			"newsletter":             services.NewNewsletterService(nil, h.cfg).Enabled(),
+			"contact_form":           services.NewContactService(nil, h.cfg).Enabled(),
+			"captcha":                h.cfg.Contact.CaptchaProvider != "",
+			"comment_emails":         services.NewCommentEmailService(nil, h.cfg).Enabled(),
+			"og_images":              services.NewOGImageService(nil, h.cfg).Enabled(),
+			"cross_posting":          services.NewCrossPostService(nil, h.cfg).Enabled(),
+			"analytics_privacy_mode": h.cfg.Analytics.PrivacyMode,
+			"autosave":               h.cfg.Autosave.Enabled,
+		}
+	}
+
+	c.JSON(http.StatusOK, models.APIMeta{
+		APIVersion:     docs.SwaggerInfo.Version,
+		GitSHA:         version.GitSHA,
+		ClientChecksum: clientChecksum(),
+		Features:       features,
+	})
+}
+
+// GetClient serves the TypeScript API client generated from the Swagger spec
+// by cmd/genclient. It is not part of the Swagger docs itself.
+func (h *Handler) GetClient(c *gin.Context) {
+	c.Data(http.StatusOK, "application/typescript; charset=utf-8", []byte(docs.ClientTS))
+}
@@ -1,62 +1,66 @@
 package handlers
 
 import (
-	"fmt"
 	"net/http"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gosimple/slug"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
 	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
 	"github.com/phanvantai/taiphanvan_backend/internal/services"
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
-// Default pagination values
-const (
-	defaultNewsPage    = 1
-	defaultNewsPerPage = 10
-	maxNewsPerPage     = 50
-)
-
 // GetNews godoc
 // @Summary Get news articles
-// @Description Returns paginated news articles with optional filtering
+// @Description Returns paginated news articles with optional filtering. Pages can be requested by number or, so deep pages stay fast and don't shift when articles are added, by passing the next_cursor of the previous page as cursor.
 // @Tags News
 // @Produce json
 // @Param category query string false "Filter by category"
 // @Param tag query string false "Filter by tag"
 // @Param search query string false "Search in title and content"
-// @Param page query int false "Page number, default is 1"
-// @Param per_page query int false "Items per page, default is 10, max is 50"
+// @Param lang query string false "Filter by language (en, vi), all for every language; defaults to the language preferred in Accept-Language"
+// @Param page query int false "Page number, default is 1, ignored when a cursor is given"
+// @Param per_page query int false "Items per page, default is 10, max is 50, both configurable"
+// @Param cursor query string false "next_cursor from the previous page"
+// @Param after query string false "Alias of cursor"
 // @Success 200 {object} models.NewsWithoutContentResponse "List of news articles with pagination (without content)"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid page, page size, cursor or language"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /news [get]
-func GetNews(c *gin.Context) {
+func (h *Handler) GetNews(c *gin.Context) {
 	var query models.NewsQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters"})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Apply default and max values for pagination
-	if query.Page <= 0 {
-		query.Page = defaultNewsPage
+	if !h.checkPage(c, &query.Page, &query.PerPage) {
+		return
 	}
-	if query.PerPage <= 0 {
-		query.PerPage = defaultNewsPerPage
+	if query.Cursor == "" {
+		query.Cursor = query.After
 	}
-	if query.PerPage > maxNewsPerPage {
-		query.PerPage = maxNewsPerPage
+	lang, ok := contentLanguageFilter(c, query.Lang)
+	if !ok {
+		return
+	}
+	var cursor *listCursor
+	if query.Cursor != "" {
+		var err error
+		if cursor, err = decodeCursor(query.Cursor); err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCursor))
+			return
+		}
 	}
 
 	// Create database query
-	dbQuery := database.DB.Model(&models.News{}).
+	dbQuery := h.dbFor(c).Model(&models.News{}).
 		Where("status = ? AND published = ?", models.NewsStatusPublished, true)
 
 	// Apply category filter if provided
@@ -64,6 +68,11 @@ func GetNews(c *gin.Context) {
 		dbQuery = dbQuery.Where("category = ?", query.Category)
 	}
 
+	// Apply language filter if one is requested or preferred
+	if lang != "" {
+		dbQuery = dbQuery.Where("news.language = ?", lang)
+	}
+
 	// Apply tag filter if provided
 	if query.Tag != "" {
 		dbQuery = dbQuery.Joins("JOIN news_tags ON news_tags.news_id = news.id").
@@ -81,7 +90,7 @@ func GetNews(c *gin.Context) {
 	var totalItems int64
 	if err := dbQuery.Count(&totalItems).Error; err != nil {
 		log.Error().Err(err).Msg("Failed to count news articles")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve news articles"})
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsListFailed, err))
 		return
 	}
 
@@ -89,19 +98,32 @@ func GetNews(c *gin.Context) {
 	totalPages := (int(totalItems) + query.PerPage - 1) / query.PerPage
 	offset := (query.Page - 1) * query.PerPage
 
+	// A cursor replaces the offset; one extra row tells whether a next page exists
+	if cursor != nil {
+		dbQuery = dbQuery.Scopes(afterCursor("news.publish_date", "news.id", cursor))
+		offset = 0
+	}
+
 	// Retrieve news with limit and offset
 	var news []models.News
 	if err := dbQuery.
-		Order("publish_date DESC").
-		Limit(query.PerPage).
+		Order("news.publish_date DESC, news.id DESC").
+		Limit(query.PerPage + 1).
 		Offset(offset).
 		Preload("Tags").
 		Find(&news).Error; err != nil {
 		log.Error().Err(err).Msg("Failed to retrieve news articles")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve news articles"})
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsListFailed, err))
 		return
 	}
 
+	var nextCursor string
+	if len(news) > query.PerPage {
+		news = news[:query.PerPage]
+		last := news[query.PerPage-1]
+		nextCursor = encodeCursor(last.PublishDate, last.ID)
+	}
+
 	// Create response without content to improve performance
 	var newsWithoutContent []models.NewsWithoutContent
 	for _, article := range news {
@@ -114,6 +136,7 @@ func GetNews(c *gin.Context) {
 		Page:       query.Page,
 		PerPage:    query.PerPage,
 		TotalPages: totalPages,
+		NextCursor: nextCursor,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -121,31 +144,39 @@ func GetNews(c *gin.Context) {
 
 // GetNewsBySlug godoc
 // @Summary Get news article by slug
-// @Description Returns a specific news article by its slug
+// @Description Returns a specific news article by its slug. A slug the article had before its title changed is answered with 301 and the current slug in the Location header.
 // @Tags News
 // @Produce json
 // @Param slug path string true "News article slug"
 // @Success 200 {object} models.SwaggerNewsWithContentStatus "News article with content status"
-// @Failure 404 {object} models.SwaggerStandardResponse "News article not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 301 {object} models.SlugRedirect "News article moved to a new slug"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /news/slug/{slug} [get]
-func GetNewsBySlug(c *gin.Context) {
+func (h *Handler) GetNewsBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 	if slug == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Slug is required"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeSlugRequired))
 		return
 	}
 
-	var news models.News
-	if err := database.DB.
-		Where("slug = ? AND status = ? AND published = ?", slug, models.NewsStatusPublished, true).
-		Preload("Tags").
-		First(&news).Error; err != nil {
+	news, err := h.newsFor(c).FindPublished(repository.BySlug(slug))
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "News article not found"})
+			// Links to the article from before a title change keep working
+			if h.redirectToCurrentSlug(c, models.SlugResourceNews, func(id uint) (string, error) {
+				news, err := h.newsFor(c).FindPublished(repository.ByID(id))
+				if err != nil {
+					return "", err
+				}
+				return news.Slug, nil
+			}) {
+				return
+			}
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
 		} else {
 			log.Error().Err(err).Str("slug", slug).Msg("Failed to retrieve news article")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve news article"})
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsFetchFailed, err))
 		}
 		return
 	}
@@ -162,7 +193,7 @@ func GetNewsBySlug(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, models.NewsWithContentStatus{
-		News:          news,
+		News:          *news,
 		ContentStatus: contentStatus,
 	})
 }
@@ -172,28 +203,25 @@ func GetNewsBySlug(c *gin.Context) {
 // @Description Returns a specific news article by its ID
 // @Tags News
 // @Produce json
-// @Param id path int true "News article ID"
+// @Param id path string true "News article ID or UUID"
 // @Success 200 {object} models.SwaggerNewsWithContentStatus "News article with content status"
-// @Failure 404 {object} models.SwaggerStandardResponse "News article not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /news/{id} [get]
-func GetNewsByID(c *gin.Context) {
-	id := c.Param("id")
-	if id == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+func (h *Handler) GetNewsByID(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
 		return
 	}
 
-	var news models.News
-	if err := database.DB.
-		Where("id = ? AND status = ? AND published = ?", id, models.NewsStatusPublished, true).
-		Preload("Tags").
-		First(&news).Error; err != nil {
+	news, err := h.newsFor(c).FindPublished(byID)
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "News article not found"})
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
 		} else {
-			log.Error().Err(err).Str("id", id).Msg("Failed to retrieve news article")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve news article"})
+			log.Error().Err(err).Str("id", c.Param("id")).Msg("Failed to retrieve news article")
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsFetchFailed, err))
 		}
 		return
 	}
@@ -210,27 +238,28 @@ func GetNewsByID(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, models.NewsWithContentStatus{
-		News:          news,
+		News:          *news,
 		ContentStatus: contentStatus,
 	})
 }
 
 // GetNewsCategories godoc
 // @Summary Get news categories
-// @Description Returns all available news categories
+// @Description Returns the slugs of the enabled news categories
 // @Tags News
 // @Produce json
 // @Success 200 {array} string "List of categories"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /news/categories [get]
-func GetNewsCategories(c *gin.Context) {
-	categories := []string{
-		// string(models.NewsCategoryGeneral),
-		// string(models.NewsCategoryBusiness),
-		string(models.NewsCategoryTechnology),
-		string(models.NewsCategoryScience),
-		// string(models.NewsCategoryHealth),
-		// string(models.NewsCategorySports),
-		// string(models.NewsCategoryEntertainment),
+func (h *Handler) GetNewsCategories(c *gin.Context) {
+	taxonomy, ok := h.loadNewsTaxonomy(c)
+	if !ok {
+		return
+	}
+
+	categories := []string{}
+	for _, slug := range taxonomy.Slugs() {
+		categories = append(categories, string(slug))
 	}
 
 	c.JSON(http.StatusOK, categories)
@@ -244,35 +273,29 @@ func GetNewsCategories(c *gin.Context) {
 // @Produce json
 // @Param news body models.CreateNewsRequest true "News article to create"
 // @Success 201 {object} models.News "Created news article"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /admin/news [post]
-func CreateNews(c *gin.Context) {
+func (h *Handler) CreateNews(c *gin.Context) {
 	// Parse request body
 	var requestBody models.CreateNewsRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
-	// Generate slug from title
-	newsSlug := slug.Make(requestBody.Title)
-
-	// Check if slug already exists
-	var count int64
-	if err := database.DB.Model(&models.News{}).Where("slug = ?", newsSlug).Count(&count).Error; err != nil {
-		log.Error().Err(err).Str("slug", newsSlug).Msg("Failed to check for existing slug")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create news article"})
+	// Generate a unique slug from the title
+	newsSlug, err := h.slugs.Unique(requestBody.Title, func(slug string) (bool, error) {
+		return h.newsFor(c).SlugExists(slug, 0)
+	})
+	if err != nil {
+		log.Error().Err(err).Str("title", requestBody.Title).Msg("Failed to check for existing slug")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsCreateFailed, err))
 		return
 	}
 
-	// If slug exists, append a timestamp
-	if count > 0 {
-		newsSlug = fmt.Sprintf("%s-%d", newsSlug, time.Now().Unix())
-	}
-
 	// Set publish date to now if not provided
 	publishDate := time.Now()
 	if requestBody.PublishDate != nil {
@@ -290,13 +313,23 @@ func CreateNews(c *gin.Context) {
 		ImageURL:    requestBody.ImageURL,
 		Category:    requestBody.Category,
 		Status:      requestBody.Status,
+		Language:    requestBody.Language,
 		Published:   requestBody.Status == models.NewsStatusPublished,
 		PublishDate: publishDate,
 	}
+	if news.Language == "" {
+		news.Language = models.DefaultContentLanguage
+	}
 
-	// If no category is provided, use technology
+	// If no category is provided, use the default category
 	if news.Category == "" {
-		news.Category = models.NewsCategoryTechnology
+		taxonomy, ok := h.loadNewsTaxonomy(c)
+		if !ok {
+			return
+		}
+		news.Category = taxonomy.Default()
+	} else if !h.newsCategoryExists(c, news.Category) {
+		return
 	}
 
 	// If no status is provided, use draft
@@ -306,37 +339,23 @@ func CreateNews(c *gin.Context) {
 	}
 
 	// Begin transaction
-	tx := database.DB.Begin()
+	tx := h.dbFor(c).Begin()
 
 	// Create news article
 	if err := tx.Create(&news).Error; err != nil {
 		tx.Rollback()
 		log.Error().Err(err).Msg("Failed to create news article")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create news article"})
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsCreateFailed, err))
 		return
 	}
 
 	// Add tags if provided
 	if len(requestBody.Tags) > 0 {
-		for _, tagName := range requestBody.Tags {
-			var tag models.Tag
-			tagName = strings.TrimSpace(tagName)
-
-			// Find or create tag
-			if err := tx.Where("name = ?", tagName).FirstOrCreate(&tag, models.Tag{Name: tagName}).Error; err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Str("tag", tagName).Msg("Failed to process tags")
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process tags"})
-				return
-			}
-
-			// Associate tag with news
-			if err := tx.Model(&news).Association("Tags").Append(&tag); err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Str("tag", tagName).Msg("Failed to associate tags")
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to associate tags"})
-				return
-			}
+		if _, err := services.NewTagService(tx).SetNewsTags(news.ID, requestBody.Tags); err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Strs("tags", requestBody.Tags).Msg("Failed to save tags")
+			middleware.Abort(c, apierror.Internal(i18n.CodeTagsUpdateFailed, err))
+			return
 		}
 	}
 
@@ -344,7 +363,9 @@ func CreateNews(c *gin.Context) {
 	tx.Commit()
 
 	// Reload news with tags
-	database.DB.Preload("Tags").First(&news, news.ID)
+	h.newsFor(c).Reload(&news)
+
+	h.dispatchWebhookEvent(models.WebhookEventNewsCreated, news.ToNewsWithoutContent())
 
 	c.JSON(http.StatusCreated, news)
 }
@@ -355,32 +376,31 @@ func CreateNews(c *gin.Context) {
 // @Tags News
 // @Accept json
 // @Produce json
-// @Param id path int true "News article ID"
+// @Param id path string true "News article ID or UUID"
 // @Param news body models.UpdateNewsRequest true "Updated news article"
 // @Success 200 {object} models.News "Updated news article"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 404 {object} models.SwaggerStandardResponse "News article not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /admin/news/{id} [put]
-func UpdateNews(c *gin.Context) {
+func (h *Handler) UpdateNews(c *gin.Context) {
 	// Get news ID from path
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 64)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid news ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
 		return
 	}
 
 	// Find existing news
-	var news models.News
-	if err := database.DB.Preload("Tags").First(&news, id).Error; err != nil {
+	news, err := h.newsFor(c).FindWithTags(byID)
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "News article not found"})
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
 		} else {
-			log.Error().Err(err).Uint64("id", id).Msg("Failed to retrieve news article")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update news article"})
+			log.Error().Err(err).Str("id", c.Param("id")).Msg("Failed to retrieve news article")
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsUpdateFailed, err))
 		}
 		return
 	}
@@ -388,29 +408,24 @@ func UpdateNews(c *gin.Context) {
 	// Parse request body
 	var requestBody models.UpdateNewsRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Update news fields if provided
+	previousSlug := news.Slug
 	if requestBody.Title != "" {
 		// If title is changing, update slug
 		if news.Title != requestBody.Title {
-			newSlug := slug.Make(requestBody.Title)
-
-			// Check if new slug already exists
-			var count int64
-			if err := database.DB.Model(&models.News{}).Where("slug = ? AND id != ?", newSlug, id).Count(&count).Error; err != nil {
-				log.Error().Err(err).Str("slug", newSlug).Msg("Failed to check for existing slug")
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update news article"})
+			newSlug, err := h.slugs.Unique(requestBody.Title, func(slug string) (bool, error) {
+				return h.newsFor(c).SlugExists(slug, news.ID)
+			})
+			if err != nil {
+				log.Error().Err(err).Str("title", requestBody.Title).Msg("Failed to check for existing slug")
+				middleware.Abort(c, apierror.Internal(i18n.CodeNewsUpdateFailed, err))
 				return
 			}
 
-			// If slug exists, append a timestamp
-			if count > 0 {
-				newSlug = fmt.Sprintf("%s-%d", newSlug, time.Now().Unix())
-			}
-
 			news.Slug = newSlug
 		}
 		news.Title = requestBody.Title
@@ -436,7 +451,11 @@ func UpdateNews(c *gin.Context) {
 		news.ImageURL = requestBody.ImageURL
 	}
 
+	previousCategory := news.Category
 	if requestBody.Category != "" {
+		if !h.newsCategoryExists(c, requestBody.Category) {
+			return
+		}
 		news.Category = requestBody.Category
 	}
 
@@ -445,51 +464,50 @@ func UpdateNews(c *gin.Context) {
 		news.Published = requestBody.Status == models.NewsStatusPublished
 	}
 
+	if requestBody.Language != "" {
+		news.Language = requestBody.Language
+	}
+
 	if requestBody.PublishDate != nil {
 		news.PublishDate = *requestBody.PublishDate
 	}
 
 	// Begin transaction
-	tx := database.DB.Begin()
+	tx := h.dbFor(c).Begin()
 
 	// Update news
-	if err := tx.Save(&news).Error; err != nil {
+	if err := tx.Save(news).Error; err != nil {
 		tx.Rollback()
-		log.Error().Err(err).Uint64("id", id).Msg("Failed to update news article")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update news article"})
+		log.Error().Err(err).Uint("id", news.ID).Msg("Failed to update news article")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsUpdateFailed, err))
 		return
 	}
 
-	// Update tags if provided
-	if len(requestBody.Tags) > 0 {
-		// Clear existing tags
-		if err := tx.Model(&news).Association("Tags").Clear(); err != nil {
+	// Keep the old slug so existing links redirect to the new one
+	if err := services.NewSlugHistoryService(tx).Record(models.SlugResourceNews, news.ID, previousSlug, news.Slug); err != nil {
+		tx.Rollback()
+		log.Error().Err(err).Uint("id", news.ID).Msg("Failed to record slug history")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsUpdateFailed, err))
+		return
+	}
+
+	// Teach the classifier from the admin's recategorization
+	if news.Category != previousCategory {
+		if err := services.NewNewsCategoryService(tx).RecordCorrection(news, previousCategory); err != nil {
 			tx.Rollback()
-			log.Error().Err(err).Uint64("id", id).Msg("Failed to clear existing tags")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update tags"})
+			log.Error().Err(err).Uint("id", news.ID).Msg("Failed to record category correction")
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsUpdateFailed, err))
 			return
 		}
+	}
 
-		// Add new tags
-		for _, tagName := range requestBody.Tags {
-			var tag models.Tag
-			tagName = strings.TrimSpace(tagName)
-
-			// Find or create tag
-			if err := tx.Where("name = ?", tagName).FirstOrCreate(&tag, models.Tag{Name: tagName}).Error; err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Str("tag", tagName).Msg("Failed to process tags")
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process tags"})
-				return
-			}
-
-			// Associate tag with news
-			if err := tx.Model(&news).Association("Tags").Append(&tag); err != nil {
-				tx.Rollback()
-				log.Error().Err(err).Str("tag", tagName).Msg("Failed to associate tags")
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to associate tags"})
-				return
-			}
+	// Replace tags if provided
+	if len(requestBody.Tags) > 0 {
+		if _, err := services.NewTagService(tx).SetNewsTags(news.ID, requestBody.Tags); err != nil {
+			tx.Rollback()
+			log.Error().Err(err).Uint("id", news.ID).Msg("Failed to save tags")
+			middleware.Abort(c, apierror.Internal(i18n.CodeTagsUpdateFailed, err))
+			return
 		}
 	}
 
@@ -497,7 +515,9 @@ func UpdateNews(c *gin.Context) {
 	tx.Commit()
 
 	// Reload news with tags
-	database.DB.Preload("Tags").First(&news, news.ID)
+	h.newsFor(c).Reload(news)
+
+	h.dispatchWebhookEvent(models.WebhookEventNewsUpdated, news.ToNewsWithoutContent())
 
 	c.JSON(http.StatusOK, news)
 }
@@ -507,56 +527,69 @@ func UpdateNews(c *gin.Context) {
 // @Description Delete a news article (admin only)
 // @Tags News
 // @Produce json
-// @Param id path int true "News article ID"
+// @Param id path string true "News article ID or UUID"
 // @Success 200 {object} models.SwaggerDeleteNewsResponse "News article deleted"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 404 {object} models.SwaggerStandardResponse "News article not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /admin/news/{id} [delete]
-func DeleteNews(c *gin.Context) {
+func (h *Handler) DeleteNews(c *gin.Context) {
 	// Get news ID from path
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 64)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid news ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
 		return
 	}
 
 	// Check if news exists
-	var news models.News
-	if err := database.DB.First(&news, id).Error; err != nil {
+	news, err := h.newsFor(c).Find(byID)
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "News article not found"})
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
 		} else {
-			log.Error().Err(err).Uint64("id", id).Msg("Failed to retrieve news article")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete news article"})
+			log.Error().Err(err).Str("id", c.Param("id")).Msg("Failed to retrieve news article")
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsDeleteFailed, err))
 		}
 		return
 	}
 
 	// Begin transaction
-	tx := database.DB.Begin()
+	tx := h.dbFor(c).Begin()
 
 	// Clear associations
-	if err := tx.Model(&news).Association("Tags").Clear(); err != nil {
+	if err := tx.Model(news).Association("Tags").Clear(); err != nil {
 		tx.Rollback()
-		log.Error().Err(err).Uint64("id", id).Msg("Failed to clear tags")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete news article"})
+		log.Error().Err(err).Uint("id", news.ID).Msg("Failed to clear tags")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsDeleteFailed, err))
 		return
 	}
 
 	// Delete news
-	if err := tx.Delete(&news).Error; err != nil {
+	if err := tx.Delete(news).Error; err != nil {
 		tx.Rollback()
-		log.Error().Err(err).Uint64("id", id).Msg("Failed to delete news article")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete news article"})
+		log.Error().Err(err).Uint("id", news.ID).Msg("Failed to delete news article")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsDeleteFailed, err))
 		return
 	}
 
 	// Commit transaction
 	tx.Commit()
 
+	h.recordAudit(c, models.AuditActionNewsDeleted, "news", news.ID, gin.H{
+		"uuid":   news.UUID,
+		"title":  news.Title,
+		"slug":   news.Slug,
+		"status": news.Status,
+		"source": news.Source,
+	}, nil)
+
+	h.dispatchWebhookEvent(models.WebhookEventNewsDeleted, gin.H{
+		"id":   news.ID,
+		"uuid": news.UUID,
+		"slug": news.Slug,
+	})
+
 	c.JSON(http.StatusOK, gin.H{"message": "News article deleted successfully"})
 }
 
@@ -566,43 +599,44 @@ func DeleteNews(c *gin.Context) {
 // @Tags News
 // @Accept json
 // @Produce json
-// @Param id path int true "News article ID"
+// @Param id path string true "News article ID or UUID"
 // @Param status body models.SetNewsStatusRequest true "New status"
 // @Success 200 {object} models.News "Updated news article"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 404 {object} models.SwaggerStandardResponse "News article not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /admin/news/{id}/status [post]
-func SetNewsStatus(c *gin.Context) {
+func (h *Handler) SetNewsStatus(c *gin.Context) {
 	// Get news ID from path
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 64)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid news ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
 		return
 	}
 
 	// Parse request body
 	var requestBody models.SetNewsStatusRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
 	// Find news
-	var news models.News
-	if err := database.DB.First(&news, id).Error; err != nil {
+	news, err := h.newsFor(c).Find(byID)
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "News article not found"})
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
 		} else {
-			log.Error().Err(err).Uint64("id", id).Msg("Failed to retrieve news article")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update news status"})
+			log.Error().Err(err).Str("id", c.Param("id")).Msg("Failed to retrieve news article")
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsStatusUpdateFailed, err))
 		}
 		return
 	}
 
+	before := gin.H{"status": news.Status, "published": news.Published}
+
 	// Update status
 	news.Status = requestBody.Status
 	news.Published = requestBody.Status == models.NewsStatusPublished
@@ -614,14 +648,19 @@ func SetNewsStatus(c *gin.Context) {
 	}
 
 	// Save changes
-	if err := database.DB.Save(&news).Error; err != nil {
-		log.Error().Err(err).Uint64("id", id).Msg("Failed to update news status")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update news status"})
+	if err := h.newsFor(c).Save(news); err != nil {
+		log.Error().Err(err).Uint("id", news.ID).Msg("Failed to update news status")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsStatusUpdateFailed, err))
 		return
 	}
 
+	h.recordAudit(c, models.AuditActionNewsStatusChanged, "news", news.ID, before,
+		gin.H{"status": news.Status, "published": news.Published})
+
 	// Reload news with tags
-	database.DB.Preload("Tags").First(&news, news.ID)
+	h.newsFor(c).Reload(news)
+
+	h.dispatchWebhookEvent(models.WebhookEventNewsUpdated, news.ToNewsWithoutContent())
 
 	c.JSON(http.StatusOK, news)
 }
@@ -634,93 +673,51 @@ func SetNewsStatus(c *gin.Context) {
 // @Produce json
 // @Param fetch_request body models.FetchNewsRequest true "Fetch request parameters"
 // @Success 200 {object} models.SwaggerFetchNewsResponse "News articles fetched"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /admin/news/fetch [post]
-func FetchExternalNews(c *gin.Context) {
+func (h *Handler) FetchExternalNews(c *gin.Context) {
 	// Parse request body
 	var requestBody models.FetchNewsRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
-	// Initialize News API service
-	newsService, err := services.NewNewsService(middleware.AppConfig.NewsAPI)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize NewsAPI service")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to initialize news service"})
+	taxonomy, ok := h.loadNewsTaxonomy(c)
+	if !ok {
 		return
 	}
 
-	// Fetch news
-	news, err := newsService.FetchNews(c.Request.Context(), requestBody.Categories, requestBody.Limit)
+	// Initialize News API service
+	newsService, err := services.NewNewsService(h.cfg.NewsAPI, taxonomy)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to fetch news")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch news from external API"})
+		log.Error().Err(err).Msg("Failed to initialize NewsAPI service")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsServiceUnavailable, err))
 		return
 	}
 
-	if len(news) == 0 {
-		c.JSON(http.StatusOK, gin.H{"message": "No news articles found"})
+	// Fetch and store news, recording the run
+	run := h.newIngestionService(c).Ingest(models.IngestionSourceNewsAPI, models.IngestionTriggerManual, func() ([]models.News, []models.IngestionSourceError) {
+		return newsService.FetchNews(c.Request.Context(), requestBody.Categories, requestBody.Limit)
+	})
+	if run.Status == models.IngestionFailed {
+		middleware.Abort(c, apierror.New(http.StatusInternalServerError, i18n.CodeNewsAPIFetchFailed).WithDetails(gin.H{"run_id": run.ID}))
 		return
 	}
 
-	// Store each news article in separate transactions to avoid
-	// aborting the entire batch if one fails
-	var savedCount int
-	for _, article := range news {
-		// Start a new transaction for each article
-		tx := database.DB.Begin()
-
-		// Check if article already exists by external ID
-		var existingCount int64
-		if err := tx.Model(&models.News{}).Where("external_id = ?", article.ExternalID).Count(&existingCount).Error; err != nil {
-			log.Error().Err(err).Str("external_id", article.ExternalID).Msg("Failed to check existing news")
-			tx.Rollback()
-			continue
-		}
-
-		if existingCount > 0 {
-			tx.Rollback() // Clean rollback for skipped articles
-			continue      // Skip existing articles
-		}
-
-		// Check if slug already exists
-		var slugCount int64
-		if err := tx.Model(&models.News{}).Where("slug = ?", article.Slug).Count(&slugCount).Error; err != nil {
-			log.Error().Err(err).Str("slug", article.Slug).Msg("Failed to check existing slug")
-			tx.Rollback()
-			continue
-		}
-
-		// If slug exists, make it unique by adding a timestamp
-		if slugCount > 0 {
-			article.Slug = fmt.Sprintf("%s-%d", article.Slug, time.Now().Unix())
-		}
-
-		// Save article
-		if err := tx.Create(&article).Error; err != nil {
-			log.Error().Err(err).Str("title", article.Title).Msg("Failed to save news article")
-			tx.Rollback()
-			continue
-		}
-
-		// Commit the transaction
-		if err := tx.Commit().Error; err != nil {
-			log.Error().Err(err).Str("title", article.Title).Msg("Failed to commit transaction")
-			continue
-		}
-
-		savedCount++
+	if run.ItemsSeen == 0 {
+		c.JSON(http.StatusOK, gin.H{"message": "No news articles found", "run_id": run.ID})
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message":    "News articles fetched successfully",
-		"total":      len(news),
-		"saved":      savedCount,
+		"run_id":     run.ID,
+		"total":      run.ItemsSeen,
+		"saved":      run.ItemsSaved,
 		"categories": requestBody.Categories,
 		"fetch_time": time.Now().Format(time.RFC3339),
 	})
@@ -728,93 +725,60 @@ func FetchExternalNews(c *gin.Context) {
 
 // FetchRSSNews godoc
 // @Summary Fetch news from RSS feeds
-// @Description Fetch and store news from configured RSS feeds (admin only)
+// @Description Fetch and store news from every enabled news source, whether or not it is due (admin only)
 // @Tags News
 // @Accept json
 // @Produce json
 // @Param fetch_request body models.FetchNewsRequest true "Fetch request parameters (only limit is used for RSS feeds)"
 // @Success 200 {object} models.SwaggerFetchRSSNewsResponse "News articles fetched from RSS feeds"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /admin/news/fetch-rss [post]
-func FetchRSSNews(c *gin.Context) {
+func (h *Handler) FetchRSSNews(c *gin.Context) {
 	// Parse request body
 	var requestBody models.FetchNewsRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
-	// Initialize RSS service
-	rssService, err := services.NewRSSService(middleware.AppConfig.RSS)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize RSS service")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to initialize RSS service"})
+	taxonomy, ok := h.loadNewsTaxonomy(c)
+	if !ok {
 		return
 	}
 
-	// Fetch news from RSS feeds
-	news, err := rssService.FetchNews(c.Request.Context(), requestBody.Limit)
+	// A manual fetch reads every enabled feed, due or not
+	sourceService := services.NewNewsSourceService(h.dbFor(c))
+	feeds, err := sourceService.Enabled()
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to fetch news from RSS feeds")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch news from RSS feeds"})
+		log.Error().Err(err).Msg("Failed to load news sources")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsSourcesFetchFailed, err))
 		return
 	}
-
-	if len(news) == 0 {
-		c.JSON(http.StatusOK, gin.H{"message": "No news articles found in RSS feeds"})
+	if len(feeds) == 0 {
+		middleware.Abort(c, apierror.Internal(i18n.CodeRSSServiceUnavailable, services.ErrNoNewsSources))
 		return
 	}
+	rssService := services.NewRSSService(sourceService, taxonomy, h.dispatchNewsSourceHealth)
 
-	// Store each news article in separate transactions to avoid
-	// aborting the entire batch if one fails
-	var savedCount int
-	for _, article := range news {
-		// Start a new transaction for each article
-		tx := database.DB.Begin()
-
-		// Check if article already exists by external ID
-		var existingCount int64
-		if err := tx.Model(&models.News{}).Where("external_id = ?", article.ExternalID).Count(&existingCount).Error; err != nil {
-			log.Error().Err(err).Str("external_id", article.ExternalID).Msg("Failed to check existing news")
-			tx.Rollback()
-			continue
-		}
-
-		if existingCount > 0 {
-			tx.Rollback() // Clean rollback for skipped articles
-			continue      // Skip existing articles
-		}
-
-		// Check if slug already exists
-		var slugCount int64
-		if err := tx.Model(&models.News{}).Where("slug = ?", article.Slug).Count(&slugCount).Error; err != nil {
-			log.Error().Err(err).Str("slug", article.Slug).Msg("Failed to check existing slug")
-			tx.Rollback()
-			continue
-		}
-
-		// If slug exists, make it unique by adding a timestamp
-		if slugCount > 0 {
-			article.Slug = fmt.Sprintf("%s-%d", article.Slug, time.Now().Unix())
-		}
-
-		// Save article
-		if err := tx.Create(&article).Error; err != nil {
-			log.Error().Err(err).Str("title", article.Title).Msg("Failed to save news article")
-			tx.Rollback()
-			continue
-		}
-
-		// Commit the transaction
-		if err := tx.Commit().Error; err != nil {
-			log.Error().Err(err).Str("title", article.Title).Msg("Failed to commit transaction")
-			continue
-		}
+	// Fetch and store news from RSS feeds, recording the run
+	var news []models.News
+	run := h.newIngestionService(c).Ingest(models.IngestionSourceRSS, models.IngestionTriggerManual, func() ([]models.News, []models.IngestionSourceError) {
+		var sourceErrors []models.IngestionSourceError
+		news, sourceErrors = rssService.FetchNews(c.Request.Context(), feeds, requestBody.Limit)
+		return news, sourceErrors
+	})
+	rssService.FinishFetchRuns(run)
+	if run.Status == models.IngestionFailed {
+		middleware.Abort(c, apierror.New(http.StatusInternalServerError, i18n.CodeRSSFetchFailed).WithDetails(gin.H{"run_id": run.ID}))
+		return
+	}
 
-		savedCount++
+	if len(news) == 0 {
+		c.JSON(http.StatusOK, gin.H{"message": "No news articles found in RSS feeds", "run_id": run.ID})
+		return
 	}
 
 	// Collect all unique categories from the fetched news
@@ -831,8 +795,9 @@ func FetchRSSNews(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"message":    "RSS news articles fetched successfully",
-		"total":      len(news),
-		"saved":      savedCount,
+		"run_id":     run.ID,
+		"total":      run.ItemsSeen,
+		"saved":      run.ItemsSaved,
 		"categories": categoriesSlice,
 		"fetch_time": time.Now().Format(time.RFC3339),
 	})
@@ -843,29 +808,26 @@ func FetchRSSNews(c *gin.Context) {
 // @Description Attempts to fetch and return the full content for a news article
 // @Tags News
 // @Produce json
-// @Param id path int true "News article ID"
+// @Param id path string true "News article ID or UUID"
 // @Success 200 {object} models.SwaggerNewsWithContentStatus "News article with full content status"
-// @Failure 404 {object} models.SwaggerStandardResponse "News article not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /news/{id}/full-content [get]
-func GetNewsFullContent(c *gin.Context) {
-	id := c.Param("id")
-	if id == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+func (h *Handler) GetNewsFullContent(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
 		return
 	}
 
 	// Get the news article
-	var news models.News
-	if err := database.DB.
-		Where("id = ? AND status = ? AND published = ?", id, models.NewsStatusPublished, true).
-		Preload("Tags").
-		First(&news).Error; err != nil {
+	news, err := h.newsFor(c).FindPublished(byID)
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "News article not found"})
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
 		} else {
-			log.Error().Err(err).Str("id", id).Msg("Failed to retrieve news article")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve news article"})
+			log.Error().Err(err).Str("id", c.Param("id")).Msg("Failed to retrieve news article")
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsFetchFailed, err))
 		}
 		return
 	}
@@ -874,7 +836,7 @@ func GetNewsFullContent(c *gin.Context) {
 	var enrichedContent models.EnrichedNewsContent
 	enrichedContentExists := true
 
-	if err := database.DB.Where("news_id = ?", news.ID).First(&enrichedContent).Error; err != nil {
+	if err := h.dbFor(c).Where("news_id = ?", news.ID).First(&enrichedContent).Error; err != nil {
 		if err != gorm.ErrRecordNotFound {
 			log.Error().Err(err).Uint("newsID", news.ID).Msg("Failed to check for enriched content")
 		}
@@ -894,6 +856,8 @@ func GetNewsFullContent(c *gin.Context) {
 		contentStatus.IsTruncated = enrichedContent.IsTruncated
 		contentStatus.TruncatedChars = enrichedContent.TruncatedChars
 		contentStatus.HasFullContent = enrichedContent.FullContent != ""
+		contentStatus.Byline = enrichedContent.Byline
+		contentStatus.LeadImage = enrichedContent.LeadImage
 		contentStatus.FetchError = enrichedContent.FetchError
 
 		// If the enriched content is recent (less than 24 hours old), use it
@@ -904,7 +868,7 @@ func GetNewsFullContent(c *gin.Context) {
 			}
 
 			c.JSON(http.StatusOK, models.NewsWithContentStatus{
-				News:          news,
+				News:          *news,
 				ContentStatus: contentStatus,
 			})
 			return
@@ -913,54 +877,19 @@ func GetNewsFullContent(c *gin.Context) {
 
 	// If we don't have recent enriched content or it doesn't exist,
 	// attempt to fetch it now
-	contentScraper := services.NewContentScraper()
-	enriched, err := contentScraper.EnrichNewsContent(c.Request.Context(), &news)
+	enriched, err := services.NewNewsEnrichmentService(h.dbFor(c)).Enrich(c.Request.Context(), news)
 	if err != nil {
 		log.Error().Err(err).Uint("newsID", news.ID).Msg("Failed to enrich news content")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve full content"})
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsFullContentFailed, err))
 		return
 	}
 
-	// Update or create the enriched content record
-	if enrichedContentExists {
-		// Update existing record
-		enrichedContent.FullContent = enriched.FullContent
-		enrichedContent.IsTruncated = enriched.IsTruncated
-		enrichedContent.TruncatedChars = enriched.TruncatedChars
-		enrichedContent.TruncationPattern = enriched.TruncationPattern
-		enrichedContent.LastFetched = time.Now()
-		enrichedContent.FetchError = enriched.FetchError
-		enrichedContent.UpdatedAt = time.Now()
-
-		if err := database.DB.Save(&enrichedContent).Error; err != nil {
-			log.Error().Err(err).Uint("newsID", news.ID).Msg("Failed to update enriched content")
-		}
-	} else {
-		// Create new record
-		enrichedContent = models.EnrichedNewsContent{
-			NewsID:             news.ID,
-			OriginalContent:    news.Content,
-			FullContent:        enriched.FullContent,
-			IsTruncated:        enriched.IsTruncated,
-			TruncatedChars:     enriched.TruncatedChars,
-			TruncationPattern:  enriched.TruncationPattern,
-			SourceURL:          news.SourceURL,
-			LastFetched:        time.Now(),
-			TruncationDetected: time.Now(),
-			FetchError:         enriched.FetchError,
-			CreatedAt:          time.Now(),
-			UpdatedAt:          time.Now(),
-		}
-
-		if err := database.DB.Create(&enrichedContent).Error; err != nil {
-			log.Error().Err(err).Uint("newsID", news.ID).Msg("Failed to save enriched content")
-		}
-	}
-
 	// Update content status
 	contentStatus.IsTruncated = enriched.IsTruncated
 	contentStatus.TruncatedChars = enriched.TruncatedChars
 	contentStatus.HasFullContent = enriched.FullContent != ""
+	contentStatus.Byline = enriched.Byline
+	contentStatus.LeadImage = enriched.LeadImage
 	contentStatus.FetchError = enriched.FetchError
 
 	// If we have full content, use it instead of the original
@@ -969,7 +898,15 @@ func GetNewsFullContent(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, models.NewsWithContentStatus{
-		News:          news,
+		News:          *news,
 		ContentStatus: contentStatus,
 	})
 }
+
+// newIngestionService creates the service that stores fetched articles and
+// notifies webhooks about the new ones
+func (h *Handler) newIngestionService(c *gin.Context) *services.IngestionService {
+	return services.NewIngestionService(h.dbFor(c), h.cfg.NewsIngestion, func(article models.News) {
+		h.dispatchWebhookEvent(models.WebhookEventNewsCreated, article.ToNewsWithoutContent())
+	})
+}
@@ -0,0 +1,297 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// GetAdminNews godoc
+// @Summary List news articles for curation
+// @Description Returns news articles of every status, filtered for curation (admin only). Pass view to start from a saved view; other query parameters override its filters.
+// @Tags News
+// @Produce json
+// @Param view query int false "ID of a saved view to apply"
+// @Param status query string false "Filter by status (published, draft, archived)"
+// @Param source query string false "Filter by source"
+// @Param category query string false "Filter by category"
+// @Param tag query string false "Filter by tag"
+// @Param search query string false "Search in title, content and summary"
+// @Param from query string false "Published at or after this time (RFC 3339)"
+// @Param to query string false "Published before this time (RFC 3339)"
+// @Param has_image query bool false "Only articles with (true) or without (false) an image"
+// @Param truncated query bool false "Only articles whose content looks cut off (true) or complete (false)"
+// @Param page query int false "Page number, default is 1"
+// @Param per_page query int false "Items per page, default is 10, max is 50, both configurable"
+// @Success 200 {object} models.NewsWithoutContentResponse "List of news articles with pagination (without content)"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Saved view not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news [get]
+func (h *Handler) GetAdminNews(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	// Start from the saved view, if any, so the query parameters bound below override it
+	var query models.AdminNewsQuery
+	if viewID := c.Query("view"); viewID != "" {
+		id, err := strconv.ParseUint(viewID, 10, 32)
+		if err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsViewID))
+			return
+		}
+
+		var view models.NewsView
+		if err := h.dbFor(c).Where("id = ? AND user_id = ?", uint(id), userID).First(&view).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				middleware.Abort(c, apierror.NotFound(i18n.CodeNewsViewNotFound))
+				return
+			}
+			middleware.Abort(c, apierror.Internal(i18n.CodeNewsViewsFetchFailed, err))
+			return
+		}
+		query.AdminNewsFilter = view.Filter
+	}
+
+	if err := c.ShouldBindQuery(&query); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	// Apply default and max values for pagination
+	if !h.checkPage(c, &query.Page, &query.PerPage) {
+		return
+	}
+
+	dbQuery := services.ApplyAdminNewsFilter(h.dbFor(c).Model(&models.News{}), query.AdminNewsFilter)
+
+	// Count total items for pagination
+	var totalItems int64
+	if err := dbQuery.Count(&totalItems).Error; err != nil {
+		log.Error().Err(err).Msg("Failed to count news articles")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsListFailed, err))
+		return
+	}
+
+	// Calculate pagination values
+	totalPages := (int(totalItems) + query.PerPage - 1) / query.PerPage
+	offset := (query.Page - 1) * query.PerPage
+
+	// Newest first, including drafts without a publish date
+	var news []models.News
+	if err := dbQuery.
+		Order("publish_date DESC NULLS FIRST").
+		Order("id DESC").
+		Limit(query.PerPage).
+		Offset(offset).
+		Preload("Tags").
+		Find(&news).Error; err != nil {
+		log.Error().Err(err).Msg("Failed to retrieve news articles")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsListFailed, err))
+		return
+	}
+
+	newsWithoutContent := []models.NewsWithoutContent{}
+	for _, article := range news {
+		newsWithoutContent = append(newsWithoutContent, article.ToNewsWithoutContent())
+	}
+
+	c.JSON(http.StatusOK, models.NewsWithoutContentResponse{
+		News:       newsWithoutContent,
+		TotalItems: totalItems,
+		Page:       query.Page,
+		PerPage:    query.PerPage,
+		TotalPages: totalPages,
+	})
+}
+
+// GetNewsViews godoc
+// @Summary List saved news views
+// @Description Returns the current admin's saved filters for the admin news list
+// @Tags News
+// @Produce json
+// @Success 200 {array} models.NewsView "Saved views"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/views [get]
+func (h *Handler) GetNewsViews(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	views := []models.NewsView{}
+	if err := h.dbFor(c).Where("user_id = ?", userID).Order("name ASC").Find(&views).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsViewsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, views)
+}
+
+// CreateNewsView godoc
+// @Summary Save a news view
+// @Description Saves a named filter for the admin news list. Names are unique per admin.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param request body models.CreateNewsViewRequest true "View name and filters"
+// @Success 201 {object} models.NewsView "Saved view"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "A view with this name already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/views [post]
+func (h *Handler) CreateNewsView(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.CreateNewsViewRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	var count int64
+	if err := h.dbFor(c).Model(&models.NewsView{}).
+		Where("user_id = ? AND name = ?", userID, requestBody.Name).
+		Count(&count).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsViewCreateFailed, err))
+		return
+	}
+	if count > 0 {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeNewsViewExists))
+		return
+	}
+
+	view := models.NewsView{
+		UserID: userID.(uint),
+		Name:   requestBody.Name,
+		Filter: requestBody.Filter,
+	}
+	if err := h.dbFor(c).Create(&view).Error; err != nil {
+		log.Error().Err(err).Msg("Failed to save news view")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsViewCreateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, view)
+}
+
+// DeleteNewsView godoc
+// @Summary Delete a saved news view
+// @Description Deletes one of the current admin's saved views
+// @Tags News
+// @Produce json
+// @Param id path int true "View ID"
+// @Success 200 {object} models.SwaggerStandardResponse "View deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Saved view not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/views/{id} [delete]
+func (h *Handler) DeleteNewsView(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsViewID))
+		return
+	}
+
+	result := h.dbFor(c).Where("id = ? AND user_id = ?", uint(id), userID).Delete(&models.NewsView{})
+	if result.Error != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsViewDeleteFailed, result.Error))
+		return
+	}
+	if result.RowsAffected == 0 {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeNewsViewNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "News view deleted successfully"})
+}
+
+// BulkSetNewsStatus godoc
+// @Summary Set the status of news articles in bulk
+// @Description Changes the status of up to 1000 news articles at once, like setting it on each (admin only). Articles are saved in chunks of 100, each in its own transaction, so a failing chunk only fails its own articles. The outcome of every requested ID is reported.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param request body models.BulkNewsStatusRequest true "Articles and their new status"
+// @Success 200 {object} models.NewsBulkResult "Outcome per article"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Security BearerAuth
+// @Router /admin/news/bulk-status [post]
+func (h *Handler) BulkSetNewsStatus(c *gin.Context) {
+	var requestBody models.BulkNewsStatusRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	result, changes := services.NewNewsBulkService(h.dbFor(c)).SetStatus(requestBody.IDs, requestBody.Status)
+	for _, change := range changes {
+		h.recordAudit(c, models.AuditActionNewsStatusChanged, "news", change.Before.ID,
+			gin.H{"status": change.Before.Status, "published": change.Before.Published},
+			gin.H{"status": change.After.Status, "published": change.After.Published})
+		h.dispatchWebhookEvent(models.WebhookEventNewsUpdated, change.After.ToNewsWithoutContent())
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// BulkDeleteNews godoc
+// @Summary Delete news articles in bulk
+// @Description Deletes up to 1000 news articles at once, like deleting each (admin only). Articles are deleted in chunks of 100, each in its own transaction, so a failing chunk only fails its own articles. The outcome of every requested ID is reported.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param request body models.BulkNewsDeleteRequest true "Articles to delete"
+// @Success 200 {object} models.NewsBulkResult "Outcome per article"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Security BearerAuth
+// @Router /admin/news/bulk-delete [post]
+func (h *Handler) BulkDeleteNews(c *gin.Context) {
+	var requestBody models.BulkNewsDeleteRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	result, changes := services.NewNewsBulkService(h.dbFor(c)).Delete(requestBody.IDs)
+	for _, change := range changes {
+		news := change.Before
+		h.recordAudit(c, models.AuditActionNewsDeleted, "news", news.ID, gin.H{
+			"uuid":   news.UUID,
+			"title":  news.Title,
+			"slug":   news.Slug,
+			"status": news.Status,
+			"source": news.Source,
+		}, nil)
+		h.dispatchWebhookEvent(models.WebhookEventNewsDeleted, gin.H{
+			"id":   news.ID,
+			"uuid": news.UUID,
+			"slug": news.Slug,
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
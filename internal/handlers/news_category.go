@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetAdminNewsCategories godoc
+// @Summary List news categories
+// @Description Returns every news category, including disabled ones, with its keyword hints (admin only)
+// @Tags News
+// @Produce json
+// @Success 200 {array} models.NewsCategoryModel "News categories"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/categories [get]
+func (h *Handler) GetAdminNewsCategories(c *gin.Context) {
+	categories, err := services.NewNewsCategoryService(h.dbFor(c)).All()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch news categories")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsCategoriesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, categories)
+}
+
+// CreateNewsCategory godoc
+// @Summary Create a news category
+// @Description Adds a news category. Its keywords are used to classify fetched articles that don't come with a category (admin only).
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param request body models.CreateNewsCategoryRequest true "News category"
+// @Success 201 {object} models.NewsCategoryModel "Created category"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Slug already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/categories [post]
+func (h *Handler) CreateNewsCategory(c *gin.Context) {
+	var requestBody models.CreateNewsCategoryRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	category, err := services.NewNewsCategoryService(h.dbFor(c)).Create(requestBody)
+	if err != nil {
+		abortNewsCategoryError(c, err, i18n.CodeNewsCategoryCreateFailed)
+		return
+	}
+
+	log.Info().Str("slug", string(category.Slug)).Msg("News category created")
+	c.JSON(http.StatusCreated, category)
+}
+
+// UpdateNewsCategory godoc
+// @Summary Change a news category
+// @Description Renames, enables or disables a news category, or replaces its keyword hints. Changing the slug moves existing articles to the new slug (admin only).
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "News category ID"
+// @Param request body models.UpdateNewsCategoryRequest true "Fields to change"
+// @Success 200 {object} models.NewsCategoryModel "Updated category"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "News category not found"
+// @Failure 409 {object} models.ErrorResponse "Slug already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/categories/{id} [put]
+func (h *Handler) UpdateNewsCategory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsCategoryID))
+		return
+	}
+
+	var requestBody models.UpdateNewsCategoryRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	category, err := services.NewNewsCategoryService(h.dbFor(c)).Update(uint(id), requestBody)
+	if err != nil {
+		abortNewsCategoryError(c, err, i18n.CodeNewsCategoryUpdateFailed)
+		return
+	}
+
+	log.Info().Uint("id", category.ID).Str("slug", string(category.Slug)).Bool("enabled", category.Enabled).Msg("News category changed")
+	c.JSON(http.StatusOK, category)
+}
+
+// abortNewsCategoryError maps news category service errors to responses
+func abortNewsCategoryError(c *gin.Context, err error, failedCode string) {
+	switch {
+	case errors.Is(err, services.ErrNewsCategoryNotFound):
+		middleware.Abort(c, apierror.NotFound(i18n.CodeNewsCategoryNotFound))
+	case errors.Is(err, services.ErrNewsCategoryExists):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeNewsCategoryExists))
+	case errors.Is(err, services.ErrInvalidNewsCategorySlug):
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsCategorySlug))
+	default:
+		log.Error().Err(err).Msg("Failed to save news category")
+		middleware.Abort(c, apierror.Internal(failedCode, err))
+	}
+}
+
+// loadNewsTaxonomy loads the enabled news categories, aborting with an error
+// response if they can't be read
+func (h *Handler) loadNewsTaxonomy(c *gin.Context) (*services.NewsTaxonomy, bool) {
+	taxonomy, err := services.NewNewsCategoryService(h.dbFor(c)).Taxonomy()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load news categories")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsCategoriesFetchFailed, err))
+		return nil, false
+	}
+	return taxonomy, true
+}
+
+// newsCategoryExists checks that an article's category is defined, aborting
+// with an error response if it isn't
+func (h *Handler) newsCategoryExists(c *gin.Context, category models.NewsCategory) bool {
+	exists, err := services.NewNewsCategoryService(h.dbFor(c)).Exists(category)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to look up news category")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsCategoriesFetchFailed, err))
+		return false
+	}
+	if !exists {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeNewsCategoryNotFound))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// CreateNewsCommentary godoc
+// @Summary Start a commentary post on a news article
+// @Description Creates a draft blog post that quotes the news article, credits its source and links back to the news item, for the "link blog" workflow. The post keeps the article's tags and records it in news_id. Each article can have one commentary post (admin only).
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "News article ID"
+// @Param request body models.CreateNewsCommentaryRequest false "Optional title and opening commentary"
+// @Success 201 {object} models.Post "Draft commentary post"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "News article not found"
+// @Failure 409 {object} models.ErrorResponse "The article already has a commentary post"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/{id}/commentary [post]
+func (h *Handler) CreateNewsCommentary(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
+		return
+	}
+
+	// The body is optional
+	var requestBody models.CreateNewsCommentaryRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil && !errors.Is(err, io.EOF) {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	var news models.News
+	if err := h.dbFor(c).Preload("Tags").First(&news, uint(id)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
+			return
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsFetchFailed, err))
+		return
+	}
+
+	var existing models.Post
+	err = h.dbFor(c).Select("id").Where("news_id = ?", news.ID).First(&existing).Error
+	if err == nil {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeNewsCommentaryExists).WithDetails(gin.H{"post_id": existing.ID}))
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCreateFailed, err))
+		return
+	}
+
+	commentary := services.BuildNewsCommentary(&news, requestBody.Title, requestBody.Commentary)
+
+	// Generate a unique slug from the title
+	slug, err := h.slugs.Unique(commentary.Title, func(slug string) (bool, error) {
+		return h.postsFor(c).SlugExists(slug, 0)
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCreateFailed, err))
+		return
+	}
+
+	post := models.Post{
+		Title:   commentary.Title,
+		Content: commentary.Content,
+		Excerpt: commentary.Excerpt,
+		Cover:   news.ImageURL,
+		Slug:    slug,
+		Status:  models.PostStatusDraft,
+		UserID:  userID.(uint),
+		NewsID:  &news.ID,
+	}
+
+	err = h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&post).Error; err != nil {
+			return err
+		}
+		if len(news.Tags) > 0 {
+			return tx.Model(&post).Association("Tags").Append(news.Tags)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Uint("news_id", news.ID).Msg("Failed to create commentary post")
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCreateFailed, err))
+		return
+	}
+
+	// Reload post with tags
+	h.dbFor(c).Preload("Tags").Preload("Category").Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name")
+	}).First(&post, post.ID)
+
+	log.Info().Uint("news_id", news.ID).Uint("post_id", post.ID).Msg("Commentary post drafted from news article")
+	c.JSON(http.StatusCreated, post)
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"gorm.io/gorm"
+)
+
+// defaultEnrichBatchLimit is how many articles a batch picks when no IDs are given
+const defaultEnrichBatchLimit = 10
+
+// EnrichNews godoc
+// @Summary Enrich a news article now
+// @Description Scrapes the full content of a news article from its source page right away, replacing any earlier enrichment. Readers otherwise only trigger it when opening the full content. Articles whose content isn't truncated are recorded without fetching the page.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "News ID or UUID"
+// @Success 200 {object} models.SwaggerEnrichedNewsContent "Stored enrichment; fetch_error explains a page that couldn't be scraped"
+// @Failure 400 {object} models.ErrorResponse "Invalid news ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure 404 {object} models.ErrorResponse "News not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/{id}/enrich [post]
+func (h *Handler) EnrichNews(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsID))
+		return
+	}
+
+	news, err := h.newsFor(c).Find(byID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNewsNotFound))
+			return
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsFetchFailed, err))
+		return
+	}
+
+	enriched, err := services.NewNewsEnrichmentService(h.dbFor(c)).Enrich(c.Request.Context(), news)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsEnrichmentFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, enriched)
+}
+
+// EnrichNewsBatch godoc
+// @Summary Enrich news articles in a batch
+// @Description Scrapes the full content of several news articles from their source pages. Pass ids to pick the articles, or leave it empty to enrich the newest truncated articles that were never enriched, up to limit; retry_failed also picks articles whose last attempt failed. The request returns when the batch is done.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.EnrichNewsBatchRequest false "Articles to enrich"
+// @Success 200 {object} models.NewsEnrichmentBatchResult "Outcome per article"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/enrich-batch [post]
+func (h *Handler) EnrichNewsBatch(c *gin.Context) {
+	// The body is optional
+	var requestBody models.EnrichNewsBatchRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil && !errors.Is(err, io.EOF) {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+	if requestBody.Limit == 0 {
+		requestBody.Limit = defaultEnrichBatchLimit
+	}
+
+	result, err := services.NewNewsEnrichmentService(h.dbFor(c)).EnrichBatch(c.Request.Context(), requestBody.IDs, requestBody.Limit, requestBody.RetryFailed)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsEnrichmentFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetNewsEnrichmentStatus godoc
+// @Summary Summarize news enrichment
+// @Description Counts news articles by full-content enrichment state: truncated, enriched, failed on the last attempt, and truncated but never enriched.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} models.NewsEnrichmentStatus "Enrichment counts"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden - admin role required"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/enrichment-status [get]
+func (h *Handler) GetNewsEnrichmentStatus(c *gin.Context) {
+	status, err := services.NewNewsEnrichmentService(h.dbFor(c)).Status()
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsEnrichmentStatusFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetNewsSources godoc
+// @Summary List news sources
+// @Description Returns every RSS feed, including disabled ones, with the outcome of its last fetch (admin only)
+// @Tags News
+// @Produce json
+// @Success 200 {array} models.NewsSource "News sources"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/sources [get]
+func (h *Handler) GetNewsSources(c *gin.Context) {
+	sources, err := services.NewNewsSourceService(h.dbFor(c)).All()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch news sources")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsSourcesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, sources)
+}
+
+// CreateNewsSource godoc
+// @Summary Add a news source
+// @Description Adds an RSS feed to fetch news from. Articles get the source's category, or are classified when it has none (admin only).
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param request body models.CreateNewsSourceRequest true "News source"
+// @Success 201 {object} models.NewsSource "Created source"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Name already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/sources [post]
+func (h *Handler) CreateNewsSource(c *gin.Context) {
+	var requestBody models.CreateNewsSourceRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.Category != "" && !h.newsCategoryExists(c, models.NewsCategory(requestBody.Category)) {
+		return
+	}
+
+	source, err := services.NewNewsSourceService(h.dbFor(c)).Create(requestBody)
+	if err != nil {
+		abortNewsSourceError(c, err, i18n.CodeNewsSourceCreateFailed)
+		return
+	}
+
+	log.Info().Uint("id", source.ID).Str("name", source.Name).Msg("News source created")
+	c.JSON(http.StatusCreated, source)
+}
+
+// UpdateNewsSource godoc
+// @Summary Change a news source
+// @Description Renames a news source, changes its feed URL, category or fetch interval, or enables or disables it (admin only)
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path int true "News source ID"
+// @Param request body models.UpdateNewsSourceRequest true "Fields to change"
+// @Success 200 {object} models.NewsSource "Updated source"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "News source not found"
+// @Failure 409 {object} models.ErrorResponse "Name already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/sources/{id} [put]
+func (h *Handler) UpdateNewsSource(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsSourceID))
+		return
+	}
+
+	var requestBody models.UpdateNewsSourceRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.Category != nil && *requestBody.Category != "" &&
+		!h.newsCategoryExists(c, models.NewsCategory(*requestBody.Category)) {
+		return
+	}
+
+	source, err := services.NewNewsSourceService(h.dbFor(c)).Update(uint(id), requestBody)
+	if err != nil {
+		abortNewsSourceError(c, err, i18n.CodeNewsSourceUpdateFailed)
+		return
+	}
+
+	log.Info().Uint("id", source.ID).Str("name", source.Name).Bool("enabled", source.Enabled).Msg("News source changed")
+	c.JSON(http.StatusOK, source)
+}
+
+// DeleteNewsSource godoc
+// @Summary Delete a news source
+// @Description Stops fetching an RSS feed. Articles already fetched from it are kept (admin only).
+// @Tags News
+// @Produce json
+// @Param id path int true "News source ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Source deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid news source ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "News source not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/sources/{id} [delete]
+func (h *Handler) DeleteNewsSource(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsSourceID))
+		return
+	}
+
+	if err := services.NewNewsSourceService(h.dbFor(c)).Delete(uint(id)); err != nil {
+		abortNewsSourceError(c, err, i18n.CodeNewsSourceDeleteFailed)
+		return
+	}
+
+	log.Info().Uint64("id", id).Msg("News source deleted")
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "News source deleted successfully"})
+}
+
+// GetNewsFetchHistory godoc
+// @Summary List news source fetches
+// @Description Returns the most recent fetches of news sources with their duration, the articles found and saved, and the error of failed fetches (admin only)
+// @Tags News
+// @Produce json
+// @Param source_id query int false "Filter by news source ID"
+// @Param status query string false "Filter by outcome (ok, failed)"
+// @Param limit query int false "Number of fetches to return (default: 50, max: 200)"
+// @Success 200 {array} models.FetchRun "Fetches, newest first"
+// @Failure 400 {object} models.ErrorResponse "Invalid news source ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/news/fetch-history [get]
+func (h *Handler) GetNewsFetchHistory(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	query := h.dbFor(c).Model(&models.FetchRun{})
+	if sourceID := c.Query("source_id"); sourceID != "" {
+		id, err := strconv.ParseUint(sourceID, 10, 32)
+		if err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNewsSourceID))
+			return
+		}
+		query = query.Where("source_id = ?", id)
+	}
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	runs := []models.FetchRun{}
+	if err := query.Order("started_at DESC").Limit(limit).Find(&runs).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeFetchHistoryFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, runs)
+}
+
+// dispatchNewsSourceHealth notifies webhooks that a news source became
+// unhealthy or recovered
+func (h *Handler) dispatchNewsSourceHealth(source models.NewsSource) {
+	h.dispatchWebhookEvent(source.HealthEvent(), source)
+}
+
+// abortNewsSourceError maps news source service errors to responses
+func abortNewsSourceError(c *gin.Context, err error, failedCode string) {
+	switch {
+	case errors.Is(err, services.ErrNewsSourceNotFound):
+		middleware.Abort(c, apierror.NotFound(i18n.CodeNewsSourceNotFound))
+	case errors.Is(err, services.ErrNewsSourceExists):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeNewsSourceExists))
+	default:
+		log.Error().Err(err).Msg("Failed to save news source")
+		middleware.Abort(c, apierror.Internal(failedCode, err))
+	}
+}
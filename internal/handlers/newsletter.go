@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// newsletter returns the newsletter service for the handler's database and config
+func (h *Handler) newsletter(c *gin.Context) *services.NewsletterService {
+	return services.NewNewsletterService(h.dbFor(c), h.cfg)
+}
+
+// SubscribeNewsletter godoc
+// @Summary Subscribe to the newsletter
+// @Description Emails a confirmation link to the address; it receives digests once the link is followed. The response is the same whether or not the address is already subscribed.
+// @Tags Newsletter
+// @Accept json
+// @Produce json
+// @Param request body models.SubscribeRequest true "Email address"
+// @Success 202 {object} models.SwaggerStandardResponse "Confirmation email sent"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 429 {object} models.ErrorResponse "Too many requests"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Failure 503 {object} models.ErrorResponse "Email is not configured"
+// @Router /newsletter/subscribe [post]
+func (h *Handler) SubscribeNewsletter(c *gin.Context) {
+	var requestBody models.SubscribeRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if err := h.newsletter(c).Subscribe(c.Request.Context(), requestBody.Email); err != nil {
+		if errors.Is(err, services.ErrEmailNotConfigured) {
+			middleware.Abort(c, apierror.New(http.StatusServiceUnavailable, i18n.CodeNewsletterUnavailable))
+			return
+		}
+		log.Error().Err(err).Msg("Failed to subscribe to the newsletter")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsletterSubscribeFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"status":  "success",
+		"message": "Check your inbox to confirm the subscription",
+	})
+}
+
+// ConfirmNewsletter godoc
+// @Summary Confirm a newsletter subscription
+// @Description Confirms the subscription the link in the confirmation email was sent for
+// @Tags Newsletter
+// @Produce json
+// @Param token query string true "Confirmation token"
+// @Success 200 {object} models.SwaggerStandardResponse "Subscription confirmed"
+// @Failure 404 {object} models.ErrorResponse "Link is invalid or expired"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /newsletter/confirm [get]
+func (h *Handler) ConfirmNewsletter(c *gin.Context) {
+	subscriber, err := h.newsletter(c).Confirm(c.Query("token"))
+	if err != nil {
+		if errors.Is(err, services.ErrSubscriptionTokenInvalid) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeSubscriptionTokenInvalid))
+			return
+		}
+		log.Error().Err(err).Msg("Failed to confirm newsletter subscription")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsletterConfirmFailed, err))
+		return
+	}
+
+	log.Info().Uint("subscriber_id", subscriber.ID).Msg("Newsletter subscription confirmed")
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Subscription confirmed",
+	})
+}
+
+// UnsubscribeNewsletter godoc
+// @Summary Unsubscribe from the newsletter
+// @Description Stops digests to the subscriber the link in a digest was sent to. Accepts POST as well for one-click unsubscribe from mail clients.
+// @Tags Newsletter
+// @Produce json
+// @Param token query string true "Unsubscribe token"
+// @Success 200 {object} models.SwaggerStandardResponse "Unsubscribed"
+// @Failure 404 {object} models.ErrorResponse "Link is invalid"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /newsletter/unsubscribe [get]
+// @Router /newsletter/unsubscribe [post]
+func (h *Handler) UnsubscribeNewsletter(c *gin.Context) {
+	if err := h.newsletter(c).Unsubscribe(c.Query("token")); err != nil {
+		if errors.Is(err, services.ErrSubscriptionTokenInvalid) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeSubscriptionTokenInvalid))
+			return
+		}
+		log.Error().Err(err).Msg("Failed to unsubscribe from the newsletter")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsletterUnsubscribeFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "You have been unsubscribed",
+	})
+}
+
+// SendNewsletterDigest godoc
+// @Summary Send a newsletter digest
+// @Description Emails the posts published in the past days to every confirmed subscriber. Delivery runs in the background; failed deliveries are logged.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.SendDigestRequest false "Digest period"
+// @Success 202 {object} models.DigestResponse "Digest is being sent"
+// @Failure 400 {object} models.ErrorResponse "Invalid input or no posts in the period"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Failure 503 {object} models.ErrorResponse "Email is not configured"
+// @Security BearerAuth
+// @Router /admin/newsletter/digest [post]
+func (h *Handler) SendNewsletterDigest(c *gin.Context) {
+	var requestBody models.SendDigestRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil && !errors.Is(err, io.EOF) {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	newsletter := h.newsletter(c)
+	if !newsletter.Enabled() {
+		middleware.Abort(c, apierror.New(http.StatusServiceUnavailable, i18n.CodeNewsletterUnavailable))
+		return
+	}
+
+	digest, err := newsletter.PrepareDigest(requestBody.Days)
+	if err != nil {
+		if errors.Is(err, services.ErrDigestEmpty) {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeNewsletterDigestEmpty))
+			return
+		}
+		log.Error().Err(err).Msg("Failed to prepare newsletter digest")
+		middleware.Abort(c, apierror.Internal(i18n.CodeNewsletterDigestFailed, err))
+		return
+	}
+
+	// Sending outlives the request, so it mustn't use the request's context
+	go newsletter.SendDigest(context.Background(), digest)
+
+	response := models.DigestResponse{
+		Posts:      len(digest.Posts),
+		Recipients: len(digest.Subscribers),
+		Since:      digest.Since,
+	}
+	h.recordAudit(c, models.AuditActionNewsletterSent, "newsletter", "digest", nil, response)
+	c.JSON(http.StatusAccepted, response)
+}
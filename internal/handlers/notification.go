@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Returns the current user's notifications, newest first: comments on their posts, replies to their comments and status changes an admin made to their posts. The meta includes the number of unread notifications.
+// @Tags Notifications
+// @Produce json
+// @Param unread query bool false "Only return unread notifications"
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: 20, max: 50)"
+// @Success 200 {object} models.SwaggerNotificationsResponse "Notifications with pagination metadata"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /notifications [get]
+func (h *Handler) GetNotifications(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 50 {
+		limit = 50
+	}
+	unreadOnly := c.Query("unread") == "true"
+
+	userID, _ := c.Get("userID")
+
+	notifications, total, unread, err := services.NewNotificationService(h.dbFor(c)).List(userID.(uint), unreadOnly, page, limit)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeNotificationsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notifications": notifications,
+		"meta": gin.H{
+			"page":     page,
+			"limit":    limit,
+			"total":    total,
+			"lastPage": (int(total) + limit - 1) / limit,
+			"unread":   unread,
+		},
+	})
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Description Marks one of the current user's notifications as read
+// @Tags Notifications
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Success 200 {object} models.Notification "Notification marked as read"
+// @Failure 400 {object} models.ErrorResponse "Invalid notification ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Notification not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /notifications/{id}/read [post]
+func (h *Handler) MarkNotificationRead(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidNotificationID))
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	notification, err := services.NewNotificationService(h.dbFor(c)).MarkRead(userID.(uint), uint(id))
+	if err != nil {
+		if errors.Is(err, services.ErrNotificationNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeNotificationNotFound))
+			return
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodeNotificationUpdateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, notification)
+}
+
+// UnsubscribeCommentEmails godoc
+// @Summary Stop comment emails
+// @Description Turns off the emails about comments on the user's posts and replies to their comments that the link was sent in. They can be turned back on with comment_emails in PUT /profile. Accepts POST as well for one-click unsubscribe from mail clients.
+// @Tags Notifications
+// @Produce json
+// @Param token query string true "Unsubscribe token"
+// @Success 200 {object} models.SwaggerStandardResponse "Unsubscribed"
+// @Failure 404 {object} models.ErrorResponse "Link is invalid"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /notifications/email/unsubscribe [get]
+// @Router /notifications/email/unsubscribe [post]
+func (h *Handler) UnsubscribeCommentEmails(c *gin.Context) {
+	user, err := services.NewCommentEmailService(h.dbFor(c), h.cfg).Unsubscribe(c.Query("token"))
+	if err != nil {
+		if errors.Is(err, services.ErrSubscriptionTokenInvalid) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeSubscriptionTokenInvalid))
+			return
+		}
+		log.Error().Err(err).Msg("Failed to turn off comment emails")
+		middleware.Abort(c, apierror.Internal(i18n.CodeCommentEmailsUnsubscribeFailed, err))
+		return
+	}
+
+	log.Info().Uint("user_id", user.ID).Msg("Comment emails turned off")
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "You won't get comment emails anymore",
+	})
+}
+
+// notifyComment notifies the post author and, for replies, the author of the
+// parent comment of a newly visible comment. Failures are logged; they don't
+// fail the request.
+func (h *Handler) notifyComment(comment models.Comment) {
+	if err := services.NewNotificationService(h.db).NotifyComment(comment); err != nil {
+		log.Error().Err(err).Uint("comment_id", comment.ID).Msg("Failed to create comment notifications")
+	}
+}
+
+// notifyPostStatus notifies the owner of post that actorID changed its status
+func (h *Handler) notifyPostStatus(post models.Post, actorID uint) {
+	if err := services.NewNotificationService(h.db).NotifyPostStatus(post, actorID); err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to create post status notification")
+	}
+}
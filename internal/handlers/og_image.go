@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// refreshOGImage generates the share image of a published post when it was
+// just published, still has none, or its title changed since the image was
+// made. A failure is logged and the post is served without a new image.
+func (h *Handler) refreshOGImage(c *gin.Context, post *models.Post, wasPublished bool, previousTitle string) {
+	if post.Status != models.PostStatusPublished {
+		return
+	}
+	if wasPublished && post.OGImage != "" && post.Title == previousTitle {
+		return
+	}
+
+	ogImages := services.NewOGImageService(h.dbFor(c), h.cfg)
+	if !ogImages.Enabled() {
+		return
+	}
+	if err := ogImages.Generate(c.Request.Context(), post); err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to generate share image")
+	}
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"gorm.io/gorm"
+)
+
+// GetPages godoc
+// @Summary Get static pages
+// @Description Returns the site's static pages ordered by title. Editors and admins also get drafts; everyone else only sees published pages.
+// @Tags Pages
+// @Produce json
+// @Success 200 {array} models.Page "List of pages"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /pages [get]
+func (h *Handler) GetPages(c *gin.Context) {
+	query := h.dbFor(c).Order("title ASC")
+	if !canEditPages(c) {
+		query = query.Where("status = ?", models.PageStatusPublished)
+	}
+
+	pages := []models.Page{}
+	if err := query.Find(&pages).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePagesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, pages)
+}
+
+// GetPageBySlug godoc
+// @Summary Get a static page by slug
+// @Description Returns a published static page, such as about or contact, with its Markdown content. Editors and admins can also read drafts.
+// @Tags Pages
+// @Produce json
+// @Param slug path string true "Page slug"
+// @Success 200 {object} models.Page "Page details"
+// @Failure 404 {object} models.ErrorResponse "Page not found"
+// @Router /pages/{slug} [get]
+func (h *Handler) GetPageBySlug(c *gin.Context) {
+	page, ok := h.findPage(c)
+	if !ok {
+		return
+	}
+
+	middleware.SetLastModified(c, page.UpdatedAt)
+	c.JSON(http.StatusOK, page)
+}
+
+// CreatePage godoc
+// @Summary Create a static page
+// @Description Creates a static page. Only editors and admins can manage pages.
+// @Tags Pages
+// @Accept json
+// @Produce json
+// @Param request body models.CreatePageRequest true "Page details"
+// @Success 201 {object} models.Page "Created page"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Slug already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /pages [post]
+func (h *Handler) CreatePage(c *gin.Context) {
+	if !canEditPages(c) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePageEditForbidden))
+		return
+	}
+
+	var requestBody models.CreatePageRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	userID := c.GetUint("userID")
+	page := models.Page{
+		Title:     strings.TrimSpace(requestBody.Title),
+		Slug:      h.slugs.Make(requestBody.Title),
+		Content:   requestBody.Content,
+		Status:    requestBody.Status,
+		UpdatedBy: &userID,
+	}
+	if requestBody.Slug != "" {
+		page.Slug = h.slugs.Make(requestBody.Slug)
+	}
+	if page.Status == "" {
+		page.Status = models.PageStatusDraft
+	}
+	if page.Slug == "" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePageSlugEmpty))
+		return
+	}
+
+	if h.pageSlugTaken(c, page.Slug, 0) {
+		middleware.Abort(c, apierror.Conflict(i18n.CodePageSlugTaken))
+		return
+	}
+
+	if err := h.dbFor(c).Create(&page).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePageCreateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, page)
+}
+
+// UpdatePage godoc
+// @Summary Update a static page
+// @Description Updates a static page's title, slug, content or status. Only editors and admins can manage pages.
+// @Tags Pages
+// @Accept json
+// @Produce json
+// @Param slug path string true "Page slug"
+// @Param request body models.UpdatePageRequest true "Page changes"
+// @Success 200 {object} models.Page "Updated page"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Page not found"
+// @Failure 409 {object} models.ErrorResponse "Slug already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /pages/{slug} [put]
+func (h *Handler) UpdatePage(c *gin.Context) {
+	if !canEditPages(c) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePageEditForbidden))
+		return
+	}
+
+	page, ok := h.findPage(c)
+	if !ok {
+		return
+	}
+
+	var requestBody models.UpdatePageRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.Title != nil {
+		page.Title = strings.TrimSpace(*requestBody.Title)
+	}
+	if requestBody.Slug != nil {
+		page.Slug = h.slugs.Make(*requestBody.Slug)
+		if page.Slug == "" {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodePageSlugEmpty))
+			return
+		}
+		if h.pageSlugTaken(c, page.Slug, page.ID) {
+			middleware.Abort(c, apierror.Conflict(i18n.CodePageSlugTaken))
+			return
+		}
+	}
+	if requestBody.Content != nil {
+		page.Content = *requestBody.Content
+	}
+	if requestBody.Status != nil {
+		page.Status = *requestBody.Status
+	}
+	userID := c.GetUint("userID")
+	page.UpdatedBy = &userID
+
+	if err := h.dbFor(c).Save(page).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePageUpdateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// DeletePage godoc
+// @Summary Delete a static page
+// @Description Deletes a static page. Only editors and admins can manage pages.
+// @Tags Pages
+// @Produce json
+// @Param slug path string true "Page slug"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Page not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /pages/{slug} [delete]
+func (h *Handler) DeletePage(c *gin.Context) {
+	if !canEditPages(c) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePageEditForbidden))
+		return
+	}
+
+	page, ok := h.findPage(c)
+	if !ok {
+		return
+	}
+
+	if err := h.dbFor(c).Delete(page).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePageDeleteFailed, err))
+		return
+	}
+
+	h.recordAudit(c, models.AuditActionPageDeleted, "page", page.ID, gin.H{
+		"title":  page.Title,
+		"slug":   page.Slug,
+		"status": page.Status,
+	}, nil)
+
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Page deleted successfully"})
+}
+
+// findPage loads the page named by the slug path parameter, aborting with 404
+// when there is none. Drafts are only found for editors.
+func (h *Handler) findPage(c *gin.Context) (*models.Page, bool) {
+	query := h.dbFor(c).Where("slug = ?", c.Param("slug"))
+	if !canEditPages(c) {
+		query = query.Where("status = ?", models.PageStatusPublished)
+	}
+
+	var page models.Page
+	if err := query.First(&page).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			middleware.Abort(c, apierror.NotFound(i18n.CodePageNotFound))
+			return nil, false
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodePagesFetchFailed, err))
+		return nil, false
+	}
+	return &page, true
+}
+
+// pageSlugTaken reports whether another page already uses slug
+func (h *Handler) pageSlugTaken(c *gin.Context, slug string, exceptID uint) bool {
+	var count int64
+	h.dbFor(c).Model(&models.Page{}).Where("slug = ? AND id != ?", slug, exceptID).Count(&count)
+	return count > 0
+}
+
+// canEditPages reports whether the signed-in user, if any, may edit pages
+func canEditPages(c *gin.Context) bool {
+	return can(c, policy.ActionPageEdit, policy.Resource{})
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+)
+
+// pageQuery reads the page and limit query parameters of a paginated list.
+// A missing page is the first one and a missing limit is the configured
+// default. Anything else that isn't a valid page or page size is rejected and
+// ok is false.
+func (h *Handler) pageQuery(c *gin.Context) (page, limit int, ok bool) {
+	if value := c.Query("page"); value != "" {
+		var err error
+		if page, err = strconv.Atoi(value); err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPage))
+			return 0, 0, false
+		}
+	}
+	if value := c.Query("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil {
+			h.abortPageSize(c)
+			return 0, 0, false
+		}
+	}
+	return page, limit, h.checkPage(c, &page, &limit)
+}
+
+// checkPage applies the pagination defaults to a page and page size bound from
+// the query, where zero means not given, and rejects negative pages and page
+// sizes above the configured maximum
+func (h *Handler) checkPage(c *gin.Context, page, limit *int) bool {
+	if *page < 0 {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPage))
+		return false
+	}
+	if *limit < 0 || *limit > h.cfg.Pagination.MaxLimit {
+		h.abortPageSize(c)
+		return false
+	}
+	if *page == 0 {
+		*page = 1
+	}
+	if *limit == 0 {
+		*limit = h.cfg.Pagination.DefaultLimit
+	}
+	return true
+}
+
+// abortPageSize rejects the request's page size, telling the client the
+// largest one allowed
+func (h *Handler) abortPageSize(c *gin.Context) {
+	middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPageSize).WithDetails(gin.H{
+		"max_limit": h.cfg.Pagination.MaxLimit,
+	}))
+}
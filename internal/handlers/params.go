@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// resourceIDScope resolves a path parameter that may hold either a numeric ID or a
+// public UUID and returns a query scope matching the corresponding record.
+// New endpoints should prefer UUIDs so that sequential IDs can't be enumerated.
+func resourceIDScope(param string) (func(db *gorm.DB) *gorm.DB, error) {
+	if id, err := strconv.ParseUint(param, 10, 32); err == nil {
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Where("id = ?", uint(id))
+		}, nil
+	}
+
+	if publicID, err := uuid.Parse(param); err == nil {
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Where("uuid = ?", publicID.String())
+		}, nil
+	}
+
+	return nil, errors.New("identifier must be a numeric ID or a UUID")
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+)
+
+// subject returns the signed-in user as a policy subject; anonymous callers
+// get the zero subject, which no role grants anything
+func subject(c *gin.Context) policy.Subject {
+	return policy.Subject{ID: c.GetUint("userID"), Role: c.GetString("userRole")}
+}
+
+// can reports whether the signed-in user may perform action on resource
+func can(c *gin.Context, action policy.Action, resource policy.Resource) bool {
+	return policy.Can(subject(c), action, resource)
+}
+
+// moderatesPosts reports whether the signed-in user's role lets them
+// unpublish anyone's post. Post owners are told when such users change the
+// status of their posts.
+func moderatesPosts(c *gin.Context) bool {
+	return policy.Grants(c.GetString("userRole"), policy.ActionPostUnpublish)
+}
+
+// postResource describes post to the policy from the point of view of the
+// signed-in user, including their co-author role
+func (h *Handler) postResource(c *gin.Context, post *models.Post) policy.Resource {
+	role, _ := h.postAuthorRole(c, post, c.GetUint("userID"))
+	return policy.Resource{OwnerID: post.UserID, AuthorRole: role}
+}
@@ -1,50 +1,87 @@
 package handlers
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 )
 
 // GetPosts godoc
 // @Summary Get list of blog posts
-// @Description Returns a paginated list of blog posts with optional tag and status filtering
+// @Description Returns a paginated list of blog posts with optional tag and status filtering. Pages can be requested by number or, so deep pages stay fast and don't shift when posts are added, by passing the next_cursor of the previous page as cursor.
 // @Tags Posts
 // @Produce json
-// @Param page query int false "Page number (default: 1)"
-// @Param limit query int false "Number of items per page (default: 10)"
+// @Param page query int false "Page number (default: 1), ignored when a cursor is given"
+// @Param limit query int false "Number of items per page (default: 10, max: 50, both configurable)"
+// @Param cursor query string false "next_cursor from the previous page"
+// @Param after query string false "Alias of cursor"
 // @Param tag query string false "Filter posts by tag name"
-// @Param status query string false "Filter posts by status (draft, published, archived, scheduled)"
+// @Param status query string false "Filter posts by status (draft, published, archived, scheduled). Only honoured for roles that moderate posts; everyone else gets published posts."
+// @Param category query string false "Filter posts by category slug, including its subcategories"
+// @Param lang query string false "Filter posts by language (en, vi), all for every language; defaults to the language preferred in Accept-Language"
 // @Success 200 {object} models.SwaggerPostsResponse "List of posts with pagination metadata"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid page, page size, cursor or language"
+// @Failure 404 {object} models.ErrorResponse "Category not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /posts [get]
-func GetPosts(c *gin.Context) {
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+func (h *Handler) GetPosts(c *gin.Context) {
+	page, limit, ok := h.pageQuery(c)
+	if !ok {
+		return
+	}
 	tag := c.Query("tag")
 	status := c.Query("status")
+	categorySlug := c.Query("category")
+	lang, ok := contentLanguageFilter(c, c.Query("lang"))
+	if !ok {
+		return
+	}
+
+	var cursor *listCursor
+	if value := cursorParam(c); value != "" {
+		var err error
+		if cursor, err = decodeCursor(value); err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidCursor))
+			return
+		}
+	}
 
 	offset := (page - 1) * limit
 	var posts []models.Post
-	query := database.DB.Model(&models.Post{}).Preload("User", func(db *gorm.DB) *gorm.DB {
+	query := h.dbFor(c).Model(&models.Post{}).Preload("User", func(db *gorm.DB) *gorm.DB {
 		return db.Select("id, username, first_name, last_name, profile_image")
-	}).Preload("Tags").Order("created_at DESC")
+	}).Preload("Tags").Preload("Category").Order("posts.created_at DESC, posts.id DESC")
+
+	// Only moderators may list posts that aren't published; others read
+	// drafts through preview links
+	if !moderatesPosts(c) {
+		status = ""
+	}
 
 	// Default to showing only published posts for public API
-	if status == "" {
-		query = query.Where("status = ?", models.PostStatusPublished)
+	if status == "" || status == string(models.PostStatusPublished) {
+		query = query.Where("status = ?", models.PostStatusPublished).Scopes(notExpired)
 	} else {
 		// If specific status is requested, filter by that status
 		query = query.Where("status = ?", status)
 	}
 
+	// Filter by language if one is requested or preferred
+	if lang != "" {
+		query = query.Where("posts.language = ?", lang)
+	}
+
 	// Filter by tag if specified
 	if tag != "" {
 		query = query.Joins("JOIN post_tags ON post_tags.post_id = posts.id").
@@ -52,102 +89,212 @@ func GetPosts(c *gin.Context) {
 			Where("tags.name = ?", tag)
 	}
 
+	// Filter by category (and its subcategories) if specified
+	if categorySlug != "" {
+		var category models.Category
+		if err := h.dbFor(c).Where("slug = ?", categorySlug).First(&category).Error; err != nil {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeCategoryNotFound))
+			return
+		}
+
+		categoryIDs, err := h.categoryDescendantIDs(c, category.ID)
+		if err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
+			return
+		}
+		query = query.Where("posts.category_id IN ?", categoryIDs)
+	}
+
 	var total int64
 	query.Count(&total)
 
-	if err := query.Limit(limit).Offset(offset).Find(&posts).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch posts"})
+	// A cursor replaces the offset; one extra row tells whether a next page exists
+	if cursor != nil {
+		query = query.Scopes(afterCursor("posts.created_at", "posts.id", cursor))
+		offset = 0
+	}
+	if err := query.Limit(limit + 1).Offset(offset).Find(&posts).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
 		return
 	}
 
+	meta := gin.H{
+		"page":     page,
+		"limit":    limit,
+		"total":    total,
+		"lastPage": (int(total) + limit - 1) / limit,
+	}
+	if len(posts) > limit {
+		posts = posts[:limit]
+		last := posts[limit-1]
+		meta["next_cursor"] = encodeCursor(last.CreatedAt, last.ID)
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"posts": posts,
-		"meta": gin.H{
-			"page":     page,
-			"limit":    limit,
-			"total":    total,
-			"lastPage": (int(total) + limit - 1) / limit,
-		},
+		"meta":  meta,
 	})
 }
 
 // GetPostBySlug godoc
 // @Summary Get a blog post by slug
-// @Description Returns a single blog post by its slug
+// @Description Returns a single blog post by its slug. Unpublished posts are only returned to those who may edit them; share them with others through a preview link. Posts in a series include their position in it and links to the previous and next posts, and posts with published translations list them under translations. A slug the post had before its title changed is answered with 301 and the current slug in the Location header.
 // @Tags Posts
 // @Produce json
 // @Param slug path string true "Post slug"
 // @Success 200 {object} models.Post "Post details"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
+// @Success 301 {object} models.SlugRedirect "Post moved to a new slug"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
 // @Router /posts/slug/{slug} [get]
-func GetPostBySlug(c *gin.Context) {
+func (h *Handler) GetPostBySlug(c *gin.Context) {
 	slug := c.Param("slug")
 
-	var post models.Post
-	if err := database.DB.Where("slug = ?", slug).Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).Preload("Tags").First(&post).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).FindBySlug(slug)
+	if err != nil {
+		// Links to the post from before a title change keep working
+		if errors.Is(err, gorm.ErrRecordNotFound) && h.redirectToCurrentSlug(c, models.SlugResourcePost, func(id uint) (string, error) {
+			post, err := h.postsFor(c).Find(repository.ByID(id))
+			if err != nil {
+				return "", err
+			}
+			return post.Slug, nil
+		}) {
+			return
+		}
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
+	if post.Status != models.PostStatusPublished && !h.canViewUnpublished(c, post) {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return
+	}
+
+	// Count the view for published posts without touching updated_at
+	if post.Status == models.PostStatusPublished {
+		if err := h.postsFor(c).IncrementViewCount(post); err != nil {
+			log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to count post view")
+		}
+	}
+
+	h.loadSeriesNavigation(c, post)
+	h.loadPostTranslations(c, post)
 
+	// Views don't change the post, so the view count is left out of the ETag
+	unviewed := *post
+	unviewed.ViewCount = 0
+	middleware.SetETag(c, unviewed)
+	middleware.SetLastModified(c, post.UpdatedAt)
 	c.JSON(http.StatusOK, post)
 }
 
+// canViewUnpublished reports whether the caller may read post before it is
+// published: moderators and those who may edit it. Everyone else needs a
+// preview link.
+func (h *Handler) canViewUnpublished(c *gin.Context, post *models.Post) bool {
+	if c.GetUint("userID") == 0 {
+		return false
+	}
+	return moderatesPosts(c) || can(c, policy.ActionPostEdit, h.postResource(c, post))
+}
+
 // CreatePost godoc
 // @Summary Create a new blog post
-// @Description Creates a new blog post with the provided details
+// @Description Creates a new blog post with the provided details. With template_id, the fields left empty are filled from the post template and the title comes from its title pattern. Accounts that aren't established yet have a daily post quota. Titles, excerpts, content and tags over the configured post limits are rejected with the offending fields in the error details. A post published during a content freeze is saved as scheduled for the end of the freeze, and the response adds "queued": true and frozen_until.
 // @Tags Posts
 // @Accept json
 // @Produce json
 // @Param request body models.CreatePostRequest true "Post details"
 // @Success 201 {object} models.Post "Created post"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 409 {object} models.ErrorResponse "A translation in this language already exists"
+// @Failure 429 {object} models.ErrorResponse "Daily post limit reached"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts [post]
-func CreatePost(c *gin.Context) {
+func (h *Handler) CreatePost(c *gin.Context) {
 	userID, _ := c.Get("userID")
-	role, exists := c.Get("userRole")
-
-	// Improved permission check with better logging and error handling
-	userRole, ok := role.(string)
-	if !exists || !ok || (userRole != "admin" && userRole != "editor") {
-		c.JSON(http.StatusForbidden, gin.H{
-			"status":  "error",
-			"error":   "Permission denied",
-			"message": "Only administrators and editors can create posts",
-			"debug":   fmt.Sprintf("User role: %v, exists: %v, ok: %v", role, exists, ok),
-		})
+	if !can(c, policy.ActionPostCreate, policy.Resource{}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostCreateForbidden))
 		return
 	}
 
 	var requestBody models.CreatePostRequest
 
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if _, ok := h.enforceDailyLimit(c, userID.(uint), services.TrustActionPost); !ok {
+		return
+	}
+
+	// Fill the fields left empty from the template
+	if requestBody.TemplateID != 0 {
+		var template models.PostTemplate
+		if err := h.dbFor(c).First(&template, requestBody.TemplateID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				middleware.Abort(c, apierror.BadRequest(i18n.CodePostTemplateNotFound))
+				return
+			}
+			middleware.Abort(c, apierror.Internal(i18n.CodePostTemplatesFetchFailed, err))
+			return
+		}
+		applyPostTemplate(&requestBody, &template, time.Now().UTC())
+	}
+
+	if !h.checkPostLimits(c, postFields{
+		Title:   &requestBody.Title,
+		Excerpt: &requestBody.Excerpt,
+		Content: &requestBody.Content,
+		Tags:    requestBody.Tags,
+	}) {
 		return
 	}
 
-	// Generate a slug from the title
-	slug := generateSlug(requestBody.Title)
+	// Generate a unique slug from the title
+	slug, err := h.slugs.Unique(requestBody.Title, func(slug string) (bool, error) {
+		return h.postsFor(c).SlugExists(slug, 0)
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCreateFailed, err))
+		return
+	}
 
-	// Check if slug already exists
-	var existingPost models.Post
-	if result := database.DB.Where("slug = ?", slug).First(&existingPost); result.RowsAffected > 0 {
-		// Append a random suffix to make the slug unique
-		slug = slug + "-" + strconv.FormatInt(time.Now().Unix(), 10)
+	categoryID, err := h.resolveCategoryID(c, requestBody.CategoryID)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeCategoryNotFound))
+		return
 	}
 
 	// Create the post
 	post := models.Post{
-		Title:   requestBody.Title,
-		Content: requestBody.Content,
-		Excerpt: requestBody.Excerpt,
-		Cover:   requestBody.Cover,
-		Slug:    slug,
-		UserID:  userID.(uint),
+		Title:         requestBody.Title,
+		Content:       requestBody.Content,
+		Excerpt:       requestBody.Excerpt,
+		Cover:         requestBody.Cover,
+		Slug:          slug,
+		UserID:        userID.(uint),
+		CategoryID:    categoryID,
+		Language:      requestBody.Language,
+		SkipCrossPost: requestBody.SkipCrossPost,
+	}
+	if post.Language == "" {
+		post.Language = models.DefaultContentLanguage
+	}
+
+	// Join the translation group of the post this one translates
+	if requestBody.TranslationOf != nil {
+		group, ok := h.resolveTranslationGroup(c, *requestBody.TranslationOf, 0)
+		if !ok {
+			return
+		}
+		if h.translationTaken(c, *group, post.Language, 0) {
+			middleware.Abort(c, apierror.Conflict(i18n.CodeTranslationExists))
+			return
+		}
+		post.TranslationGroup = group
 	}
 
 	// Set status (default to draft if not specified)
@@ -161,101 +308,144 @@ func CreatePost(c *gin.Context) {
 	if post.Status == models.PostStatusScheduled && requestBody.PublishAt != nil {
 		// Validate the publish date is in the future
 		if requestBody.PublishAt.Before(time.Now()) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Scheduled publish date must be in the future"})
+			middleware.Abort(c, apierror.BadRequest(i18n.CodePublishDateInPast))
 			return
 		}
+		post.PublishAt = requestBody.PublishAt
 	}
 
-	tx := database.DB.Begin()
+	// Take the post down automatically once it expires
+	post.ExpiresAt = requestBody.ExpiresAt
+	if !checkPostExpiry(c, &post) {
+		return
+	}
+
+	// Queue the publish if a content freeze is in effect
+	freezeWindow, err := h.queuePublishDuringFreeze(c, &post)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeFreezeCheckFailed, err))
+		return
+	}
+
+	tx := h.dbFor(c).Begin()
 
 	if err := tx.Create(&post).Error; err != nil {
 		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create post"})
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCreateFailed, err))
 		return
 	}
 
 	// Add tags
 	if len(requestBody.Tags) > 0 {
-		for _, tagName := range requestBody.Tags {
-			var tag models.Tag
-			tagName = strings.TrimSpace(tagName)
-
-			// Find or create tag
-			if err := tx.Where("name = ?", tagName).FirstOrCreate(&tag, models.Tag{Name: tagName}).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process tags"})
-				return
-			}
-
-			// Associate tag with post
-			if err := tx.Model(&post).Association("Tags").Append(&tag); err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to associate tags"})
-				return
-			}
+		if _, err := services.NewTagService(tx).SetPostTags(post.ID, requestBody.Tags); err != nil {
+			tx.Rollback()
+			middleware.Abort(c, apierror.Internal(i18n.CodeTagsUpdateFailed, err))
+			return
 		}
 	}
 
-	tx.Commit()
+	if err := tx.Commit().Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCreateFailed, err))
+		return
+	}
 
 	// Reload post with tags
-	database.DB.Preload("Tags").Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name")
-	}).First(&post, post.ID)
+	h.postsFor(c).Reload(&post)
+
+	if freezeWindow != nil {
+		respondPublishQueued(c, http.StatusCreated, post, freezeWindow)
+		return
+	}
+
+	if post.Status == models.PostStatusPublished {
+		h.refreshOGImage(c, &post, false, "")
+		h.queueCrossPosts(&post, false)
+		h.dispatchWebhookEvent(models.WebhookEventPostPublished, post)
+	}
 
 	c.JSON(http.StatusCreated, post)
 }
 
 // UpdatePost godoc
 // @Summary Update an existing blog post
-// @Description Updates a blog post with the provided details
+// @Description Updates a blog post with the provided details. Fields over the configured post limits are rejected with the offending fields in the error details. To keep two editors from overwriting each other, send the version of the post the edits were made to, or its Last-Modified time in If-Unmodified-Since; if the post was updated since, the update is rejected with 409 and the current post in details.current. Changing the status needs the same permission as the status endpoint, so co-authors whose role can't publish get 403.
 // @Tags Posts
 // @Accept json
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Param post body models.UpdatePostRequest true "Post details"
+// @Param If-Unmodified-Since header string false "Only update the post if it hasn't changed since this HTTP date"
 // @Success 200 {object} models.Post "Updated post"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 202 {object} models.QueuedPost "Post saved, publish queued by a content freeze"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 409 {object} models.ErrorResponse "The post was updated since the given version or date, or a translation in this language already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id} [put]
-func UpdatePost(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+func (h *Handler) UpdatePost(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
-	var post models.Post
-	if err := database.DB.First(&post, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
-	// Only the author can update the post
-	if post.UserID != userID.(uint) {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Only the author can update this post"})
+	// Only the owner and co-authors allowed to edit can update the post
+	if !can(c, policy.ActionPostEdit, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostEditForbidden))
 		return
 	}
 
 	var requestBody models.UpdatePostRequest
 
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if !h.checkPostLimits(c, postFields{
+		Title:   requestBody.Title,
+		Excerpt: requestBody.Excerpt,
+		Content: requestBody.Content,
+		Tags:    requestBody.Tags,
+	}) {
 		return
 	}
 
-	tx := database.DB.Begin()
+	tx := h.dbFor(c).Begin()
+
+	// Reject the edits if someone else saved the post since the client loaded it
+	if !h.lockPostForUpdate(c, tx, post, requestBody.Version) {
+		tx.Rollback()
+		return
+	}
+	wasPublished := post.Status == models.PostStatusPublished
 
 	// Update fields if provided
+	previousSlug := post.Slug
+	previousTitle := post.Title
 	if requestBody.Title != nil {
-		post.Title = *requestBody.Title
 		// Update slug only if title changes
-		post.Slug = generateSlug(*requestBody.Title)
+		if post.Title != *requestBody.Title {
+			slug, err := h.slugs.Unique(*requestBody.Title, func(slug string) (bool, error) {
+				return repository.NewPostRepository(tx).SlugExists(slug, post.ID)
+			})
+			if err != nil {
+				tx.Rollback()
+				middleware.Abort(c, apierror.Internal(i18n.CodePostUpdateFailed, err))
+				return
+			}
+			post.Slug = slug
+		}
+		post.Title = *requestBody.Title
 	}
 	if requestBody.Content != nil {
 		post.Content = *requestBody.Content
@@ -266,6 +456,38 @@ func UpdatePost(c *gin.Context) {
 	if requestBody.Cover != nil {
 		post.Cover = *requestBody.Cover
 	}
+	if requestBody.CategoryID != nil {
+		categoryID, err := h.resolveCategoryID(c, requestBody.CategoryID)
+		if err != nil {
+			tx.Rollback()
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeCategoryNotFound))
+			return
+		}
+		post.CategoryID = categoryID
+		post.Category = nil
+	}
+	if requestBody.Language != nil {
+		post.Language = *requestBody.Language
+	}
+	if requestBody.SkipCrossPost != nil {
+		post.SkipCrossPost = *requestBody.SkipCrossPost
+	}
+	if requestBody.TranslationOf != nil {
+		post.TranslationGroup = nil
+		if *requestBody.TranslationOf != 0 {
+			group, ok := h.resolveTranslationGroup(c, *requestBody.TranslationOf, post.ID)
+			if !ok {
+				tx.Rollback()
+				return
+			}
+			post.TranslationGroup = group
+		}
+	}
+	if post.TranslationGroup != nil && h.translationTaken(c, *post.TranslationGroup, post.Language, post.ID) {
+		tx.Rollback()
+		middleware.Abort(c, apierror.Conflict(i18n.CodeTranslationExists))
+		return
+	}
 
 	// Handle status update
 	if requestBody.Status != nil {
@@ -274,7 +496,14 @@ func UpdatePost(c *gin.Context) {
 		case models.PostStatusDraft, models.PostStatusPublished, models.PostStatusArchived, models.PostStatusScheduled:
 			// Valid status
 		default:
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status. Allowed values: draft, published, archived, scheduled"})
+			tx.Rollback()
+			middleware.Abort(c, apierror.BadRequest(i18n.CodePostStatusInvalid))
+			return
+		}
+
+		// Changing the status needs the same permission as the status endpoint
+		if *requestBody.Status != post.Status && !authorizePostStatus(c, h.postResource(c, post), *requestBody.Status) {
+			tx.Rollback()
 			return
 		}
 
@@ -285,53 +514,73 @@ func UpdatePost(c *gin.Context) {
 		if *requestBody.Status == models.PostStatusScheduled && requestBody.PublishAt != nil {
 			// Validate the publish date is in the future
 			if requestBody.PublishAt.Before(time.Now()) {
-				c.JSON(http.StatusBadRequest, gin.H{"error": "Scheduled publish date must be in the future"})
+				tx.Rollback()
+				middleware.Abort(c, apierror.BadRequest(i18n.CodePublishDateInPast))
 				return
 			}
+			post.PublishAt = requestBody.PublishAt
 		}
 	}
 
-	if err := tx.Save(&post).Error; err != nil {
+	// Handle the expiry date
+	if requestBody.ClearExpiry {
+		post.ExpiresAt = nil
+	} else if requestBody.ExpiresAt != nil {
+		post.ExpiresAt = requestBody.ExpiresAt
+	}
+	if !checkPostExpiry(c, post) {
 		tx.Rollback()
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update post"})
 		return
 	}
 
-	// Update tags if provided
-	if len(requestBody.Tags) > 0 {
-		// Clear existing tags
-		if err := tx.Model(&post).Association("Tags").Clear(); err != nil {
+	// Queue the publish if a content freeze is in effect
+	var freezeWindow *models.FreezeWindow
+	if !wasPublished {
+		if freezeWindow, err = h.queuePublishDuringFreeze(c, post); err != nil {
 			tx.Rollback()
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update tags"})
+			middleware.Abort(c, apierror.Internal(i18n.CodeFreezeCheckFailed, err))
 			return
 		}
+	}
 
-		for _, tagName := range requestBody.Tags {
-			var tag models.Tag
-			tagName = strings.TrimSpace(tagName)
+	if err := tx.Save(post).Error; err != nil {
+		tx.Rollback()
+		middleware.Abort(c, apierror.Internal(i18n.CodePostUpdateFailed, err))
+		return
+	}
 
-			// Find or create tag
-			if err := tx.Where("name = ?", tagName).FirstOrCreate(&tag, models.Tag{Name: tagName}).Error; err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process tags"})
-				return
-			}
+	// Keep the old slug so existing links redirect to the new one
+	if err := services.NewSlugHistoryService(tx).Record(models.SlugResourcePost, post.ID, previousSlug, post.Slug); err != nil {
+		tx.Rollback()
+		middleware.Abort(c, apierror.Internal(i18n.CodePostUpdateFailed, err))
+		return
+	}
 
-			// Associate tag with post
-			if err := tx.Model(&post).Association("Tags").Append(&tag); err != nil {
-				tx.Rollback()
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to associate tags"})
-				return
-			}
+	// Replace tags if provided
+	if len(requestBody.Tags) > 0 {
+		if _, err := services.NewTagService(tx).SetPostTags(post.ID, requestBody.Tags); err != nil {
+			tx.Rollback()
+			middleware.Abort(c, apierror.Internal(i18n.CodeTagsUpdateFailed, err))
+			return
 		}
 	}
 
-	tx.Commit()
+	if err := tx.Commit().Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostUpdateFailed, err))
+		return
+	}
 
 	// Reload post with tags
-	database.DB.Preload("Tags").Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name")
-	}).First(&post, post.ID)
+	h.postsFor(c).Reload(post)
+
+	if freezeWindow != nil {
+		respondPublishQueued(c, http.StatusAccepted, *post, freezeWindow)
+		return
+	}
+
+	h.refreshOGImage(c, post, wasPublished, previousTitle)
+	h.queueCrossPosts(post, wasPublished)
+	h.dispatchPostStatusEvent(*post, wasPublished)
 
 	c.JSON(http.StatusOK, post)
 }
@@ -341,49 +590,55 @@ func UpdatePost(c *gin.Context) {
 // @Description Deletes a blog post by ID (soft delete)
 // @Tags Posts
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Success 200 {object} models.SwaggerStandardResponse "Success message"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id} [delete]
-func DeletePost(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+func (h *Handler) DeletePost(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
-	var post models.Post
-	if err := database.DB.First(&post, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
 	// Check if user is the author or an admin
-	role, exists := c.Get("userRole")
-	userRole, ok := role.(string)
-	isAdmin := exists && ok && userRole == "admin"
-
-	if post.UserID != userID.(uint) && !isAdmin {
-		c.JSON(http.StatusForbidden, gin.H{
-			"status":  "error",
-			"error":   "Permission denied",
-			"message": "You don't have permission to delete this post",
-		})
+	if !can(c, policy.ActionPostDelete, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostDeleteForbidden))
 		return
 	}
 
 	// Delete post (soft delete because of gorm.DeletedAt field)
-	if err := database.DB.Delete(&post).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete post"})
+	if err := h.postsFor(c).Delete(post); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostDeleteFailed, err))
 		return
 	}
 
+	h.recordAudit(c, models.AuditActionPostDeleted, "post", post.ID, gin.H{
+		"uuid":    post.UUID,
+		"title":   post.Title,
+		"slug":    post.Slug,
+		"status":  post.Status,
+		"user_id": post.UserID,
+	}, nil)
+
+	h.dispatchWebhookEvent(models.WebhookEventPostDeleted, gin.H{
+		"id":     post.ID,
+		"uuid":   post.UUID,
+		"slug":   post.Slug,
+		"status": post.Status,
+	})
+
 	c.JSON(http.StatusOK, gin.H{"message": "Post deleted successfully"})
 }
 
@@ -392,201 +647,174 @@ func DeletePost(c *gin.Context) {
 // @Description Sets a blog post's status to published
 // @Tags Posts
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Success 200 {object} models.Post "Published post"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 202 {object} models.QueuedPost "Post saved, publish queued by a content freeze"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id}/publish [post]
-func PublishPost(c *gin.Context) {
-	userID, _ := c.Get("userID")
-	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+func (h *Handler) PublishPost(c *gin.Context) {
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
-	var post models.Post
-	if err := database.DB.First(&post, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
-	// Only the author can publish the post
-	if post.UserID != userID.(uint) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"status":  "error",
-			"error":   "Permission denied",
-			"message": "Only the author can publish this post",
-		})
+	// Only the owner and co-authors allowed to publish can publish the post
+	if !can(c, policy.ActionPostPublish, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostPublishForbidden))
 		return
 	}
 
 	// Check if post is already published
 	if post.Status == models.PostStatusPublished {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Post is already published"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostAlreadyPublished))
 		return
 	}
 
 	// Set status to published
 	post.Status = models.PostStatusPublished
+	if !checkPostExpiry(c, post) {
+		return
+	}
+
+	// Queue the publish if a content freeze is in effect
+	freezeWindow, err := h.queuePublishDuringFreeze(c, post)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeFreezeCheckFailed, err))
+		return
+	}
 
-	if err := database.DB.Save(&post).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to publish post"})
+	if err := h.postsFor(c).Save(post); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostPublishFailed, err))
 		return
 	}
 
 	// Reload post with tags and user
-	database.DB.Preload("Tags").Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).First(&post, post.ID)
+	h.postsFor(c).Reload(post)
+
+	if freezeWindow != nil {
+		respondPublishQueued(c, http.StatusAccepted, *post, freezeWindow)
+		return
+	}
+
+	h.refreshOGImage(c, post, false, "")
+	h.queueCrossPosts(post, false)
+	h.dispatchWebhookEvent(models.WebhookEventPostPublished, post)
 
 	c.JSON(http.StatusOK, post)
 }
 
 // UnpublishPost godoc
 // @Summary Unpublish a blog post
-// @Description Sets a blog post's status to unpublished (draft)
+// @Description Sets a blog post's status to unpublished (draft). When an admin unpublishes someone else's post, its owner is notified.
 // @Tags Posts
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Success 200 {object} models.Post "Unpublished post"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id}/unpublish [post]
-func UnpublishPost(c *gin.Context) {
+func (h *Handler) UnpublishPost(c *gin.Context) {
 	userID, _ := c.Get("userID")
-	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
-	var post models.Post
-	if err := database.DB.First(&post, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
-	// Check if user is the author or an admin
-	role, exists := c.Get("userRole")
-	userRole, ok := role.(string)
-	isAdmin := exists && ok && userRole == "admin"
-
-	if post.UserID != userID.(uint) && !isAdmin {
-		c.JSON(http.StatusForbidden, gin.H{
-			"status":  "error",
-			"error":   "Permission denied",
-			"message": "You don't have permission to unpublish this post",
-		})
+	// Check if user may publish the post or is an admin
+	if !can(c, policy.ActionPostUnpublish, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostUnpublishForbidden))
 		return
 	}
 
 	// Check if post is already a draft
 	if post.Status == models.PostStatusDraft {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Post is already unpublished"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostAlreadyUnpublished))
 		return
 	}
 
 	// Set status to draft
+	wasPublished := post.Status == models.PostStatusPublished
 	post.Status = models.PostStatusDraft
 
-	if err := database.DB.Save(&post).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unpublish post"})
+	if err := h.postsFor(c).Save(post); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostUnpublishFailed, err))
 		return
 	}
 
 	// Reload post with tags and user
-	database.DB.Preload("Tags").Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).First(&post, post.ID)
+	h.postsFor(c).Reload(post)
+
+	h.dispatchPostStatusEvent(*post, wasPublished)
+	if moderatesPosts(c) {
+		h.notifyPostStatus(*post, userID.(uint))
+	}
 
 	c.JSON(http.StatusOK, post)
 }
 
 // SetPostStatus godoc
 // @Summary Set the status of a blog post
-// @Description Updates a post's status to the specified value (draft, published, archived, scheduled)
+// @Description Updates a post's status to the specified value (draft, published, archived, scheduled). When an admin changes the status of someone else's post, its owner is notified.
 // @Tags Posts
 // @Accept json
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Param request body models.SetPostStatusRequest true "Status details"
 // @Success 200 {object} models.Post "Updated post"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 202 {object} models.QueuedPost "Post saved, publish queued by a content freeze"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id}/status [post]
-func SetPostStatus(c *gin.Context) {
+func (h *Handler) SetPostStatus(c *gin.Context) {
 	userID, _ := c.Get("userID")
-	roleInterface, exists := c.Get("userRole")
-	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	byID, err := resourceIDScope(c.Param("id"))
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
-	var post models.Post
-	if err := database.DB.First(&post, id).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Post not found"})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
 	var requestBody models.SetPostStatusRequest
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		middleware.Abort(c, apierror.Validation(err))
 		return
 	}
 
-	// Properly handling role type assertion
-	userRole, ok := roleInterface.(string)
-
-	// Authorization check based on the requested status change and user role
-	isAuthor := post.UserID == userID.(uint)
-	isAdmin := exists && ok && userRole == "admin"
-
-	// Check permissions based on the action being performed
-	if requestBody.Status == models.PostStatusPublished {
-		// Only the author can publish their post
-		if !isAuthor {
-			c.JSON(http.StatusForbidden, gin.H{
-				"status":  "error",
-				"error":   "Permission denied",
-				"message": "Only the author can publish this post",
-			})
-			return
-		}
-	} else if requestBody.Status == models.PostStatusDraft {
-		// Only admin or author can unpublish a post
-		if !isAuthor && !isAdmin {
-			c.JSON(http.StatusForbidden, gin.H{
-				"status":  "error",
-				"error":   "Permission denied",
-				"message": "Only the author or administrators can unpublish this post",
-			})
-			return
-		}
-	} else {
-		// For other status changes (archived, scheduled), only the author can do this
-		if !isAuthor {
-			c.JSON(http.StatusForbidden, gin.H{
-				"status":  "error",
-				"error":   "Permission denied",
-				"message": "Only the author can change the status of this post",
-			})
-			return
-		}
+	if !authorizePostStatus(c, h.postResource(c, post), requestBody.Status) {
+		return
 	}
 
 	// Validate status
@@ -594,7 +822,7 @@ func SetPostStatus(c *gin.Context) {
 	case models.PostStatusDraft, models.PostStatusPublished, models.PostStatusArchived, models.PostStatusScheduled:
 		// Valid status
 	default:
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status. Allowed values: draft, published, archived, scheduled"})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostStatusInvalid))
 		return
 	}
 
@@ -602,78 +830,133 @@ func SetPostStatus(c *gin.Context) {
 	if requestBody.Status == models.PostStatusScheduled {
 		// For scheduled posts, check if we have a publish date
 		if requestBody.PublishAt == nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "PublishAt date is required for scheduled posts"})
+			middleware.Abort(c, apierror.BadRequest(i18n.CodePublishDateRequired))
 			return
 		}
 
 		// Validate the publish date is in the future
 		if requestBody.PublishAt.Before(time.Now()) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "PublishAt date must be in the future"})
+			middleware.Abort(c, apierror.BadRequest(i18n.CodePublishDateInPast))
 			return
 		}
 	}
 
 	// Update the status
+	wasPublished := post.Status == models.PostStatusPublished
 	post.Status = requestBody.Status
+	if post.Status == models.PostStatusScheduled {
+		post.PublishAt = requestBody.PublishAt
+	}
+	if !checkPostExpiry(c, post) {
+		return
+	}
 
-	if err := database.DB.Save(&post).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update post status"})
+	// Queue the publish if a content freeze is in effect
+	var freezeWindow *models.FreezeWindow
+	if !wasPublished {
+		if freezeWindow, err = h.queuePublishDuringFreeze(c, post); err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeFreezeCheckFailed, err))
+			return
+		}
+	}
+
+	if err := h.postsFor(c).Save(post); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostStatusUpdateFailed, err))
 		return
 	}
 
 	// Reload post with tags and user
-	database.DB.Preload("Tags").Preload("User", func(db *gorm.DB) *gorm.DB {
-		return db.Select("id, username, first_name, last_name, profile_image")
-	}).First(&post, post.ID)
+	h.postsFor(c).Reload(post)
+
+	if freezeWindow != nil {
+		respondPublishQueued(c, http.StatusAccepted, *post, freezeWindow)
+		return
+	}
+
+	h.refreshOGImage(c, post, wasPublished, post.Title)
+	h.queueCrossPosts(post, wasPublished)
+	h.dispatchPostStatusEvent(*post, wasPublished)
+	if moderatesPosts(c) {
+		h.notifyPostStatus(*post, userID.(uint))
+	}
 
 	c.JSON(http.StatusOK, post)
 }
 
+// authorizePostStatus checks that the caller may move a post to status,
+// aborting with 403 when they can't. Publishing and other status changes
+// need the publish permission, going back to draft the unpublish one.
+// Co-authors count as authors here when their role allows publishing.
+func authorizePostStatus(c *gin.Context, resource policy.Resource, status models.PostStatus) bool {
+	action, code := policy.ActionPostPublish, i18n.CodePostStatusForbidden
+	switch status {
+	case models.PostStatusPublished:
+		code = i18n.CodePostPublishForbidden
+	case models.PostStatusDraft:
+		action, code = policy.ActionPostUnpublish, i18n.CodePostUnpublishForbidden
+	}
+	if !can(c, action, resource) {
+		middleware.Abort(c, apierror.Forbidden(code))
+		return false
+	}
+	return true
+}
+
+// dispatchPostStatusEvent notifies webhooks about a saved post, based on whether it was
+// published before the change. Changes to posts that were never public aren't announced.
+func (h *Handler) dispatchPostStatusEvent(post models.Post, wasPublished bool) {
+	isPublished := post.Status == models.PostStatusPublished
+	switch {
+	case isPublished && !wasPublished:
+		h.dispatchWebhookEvent(models.WebhookEventPostPublished, post)
+	case !isPublished && wasPublished:
+		h.dispatchWebhookEvent(models.WebhookEventPostUnpublished, post)
+	case isPublished:
+		h.dispatchWebhookEvent(models.WebhookEventPostUpdated, post)
+	}
+}
+
 // GetMyPosts godoc
 // @Summary Get the current user's blog posts
-// @Description Returns a paginated list of blog posts authored by the currently authenticated user
+// @Description Returns a paginated list of blog posts the currently authenticated user owns or is a co-author of
 // @Tags Posts
 // @Produce json
 // @Param page query int false "Page number (default: 1)"
-// @Param limit query int false "Number of items per page (default: 10)"
+// @Param limit query int false "Number of items per page (default: 10, max: 50, both configurable)"
 // @Success 200 {object} models.SwaggerPostsResponse "List of the user's posts with pagination metadata"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 400 {object} models.ErrorResponse "Invalid page or page size"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/me [get]
-func GetMyPosts(c *gin.Context) {
+func (h *Handler) GetMyPosts(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
 	userID, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	page, limit, ok := h.pageQuery(c)
+	if !ok {
+		return
+	}
 
 	offset := (page - 1) * limit
 	var posts []models.Post
-	query := database.DB.Model(&models.Post{}).Where("user_id = ?", userID).
+	query := h.dbFor(c).Model(&models.Post{}).
+		Where("user_id = ? OR id IN (?)", userID, h.dbFor(c).Model(&models.PostAuthor{}).Select("post_id").Where("user_id = ?", userID)).
 		Preload("User", func(db *gorm.DB) *gorm.DB {
 			return db.Select("id, username, first_name, last_name, profile_image")
 		}).
-		Preload("Tags").
+		Preload("Tags").Preload("Category").
 		Order("created_at DESC")
 
 	var total int64
 	query.Count(&total)
 
 	if err := query.Limit(limit).Offset(offset).Find(&posts).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to fetch posts",
-		})
+		middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
 		return
 	}
 
@@ -688,30 +971,3 @@ func GetMyPosts(c *gin.Context) {
 		},
 	})
 }
-
-// Helper function to generate slug from title
-func generateSlug(title string) string {
-	// Convert to lowercase
-	slug := strings.ToLower(title)
-
-	// Replace spaces with hyphens
-	slug = strings.ReplaceAll(slug, " ", "-")
-
-	// Remove any character that's not alphanumeric or hyphen
-	slug = strings.Map(func(r rune) rune {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			return r
-		}
-		return -1
-	}, slug)
-
-	// Replace multiple hyphens with a single hyphen
-	for strings.Contains(slug, "--") {
-		slug = strings.ReplaceAll(slug, "--", "-")
-	}
-
-	// Remove leading and trailing hyphens
-	slug = strings.Trim(slug, "-")
-
-	return slug
-}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AddPostAuthor godoc
+// @Summary Add a co-author to a post
+// @Description Credits another user on a post. Authors may edit and publish the post, contributors may only edit it and reviewers may only publish it. Adding a user who is already a co-author changes their role. Only the post's owner can add co-authors.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Param request body models.AddPostAuthorRequest true "Co-author"
+// @Success 200 {array} models.PostAuthor "Co-authors of the post"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post or user not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/authors [post]
+func (h *Handler) AddPostAuthor(c *gin.Context) {
+	post, ok := h.loadPostForAuthors(c)
+	if !ok {
+		return
+	}
+
+	// Only the owner decides who else is credited on the post
+	if !can(c, policy.ActionPostManage, policy.Resource{OwnerID: post.UserID}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostAuthorsForbidden))
+		return
+	}
+
+	var requestBody models.AddPostAuthorRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	user, err := h.usersFor(c).FindByUsername(requestBody.Username)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeUserNotFound))
+		return
+	}
+	if user.ID == post.UserID {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostAuthorIsOwner))
+		return
+	}
+
+	author := models.PostAuthor{PostID: post.ID, UserID: user.ID, Role: requestBody.Role}
+	if err := h.dbFor(c).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Omit("User").Create(&author).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostAuthorAddFailed, err))
+		return
+	}
+
+	h.respondWithPostAuthors(c, post)
+}
+
+// RemovePostAuthor godoc
+// @Summary Remove a co-author from a post
+// @Description Removes a co-author from a post. The post's owner can remove anyone; a co-author can remove themselves.
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Param username path string true "Username of the co-author"
+// @Success 200 {array} models.PostAuthor "Co-authors of the post"
+// @Failure 400 {object} models.ErrorResponse "Invalid post ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post, user or co-author not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/authors/{username} [delete]
+func (h *Handler) RemovePostAuthor(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	post, ok := h.loadPostForAuthors(c)
+	if !ok {
+		return
+	}
+
+	user, ok := h.loadAuthor(c)
+	if !ok {
+		return
+	}
+
+	// Co-authors may leave a post on their own
+	if !can(c, policy.ActionPostManage, policy.Resource{OwnerID: post.UserID}) && user.ID != userID.(uint) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostAuthorsForbidden))
+		return
+	}
+
+	result := h.dbFor(c).Where("post_id = ? AND user_id = ?", post.ID, user.ID).Delete(&models.PostAuthor{})
+	if result.Error != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostAuthorRemoveFailed, result.Error))
+		return
+	}
+	if result.RowsAffected == 0 {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostAuthorNotFound))
+		return
+	}
+
+	h.respondWithPostAuthors(c, post)
+}
+
+// loadPostForAuthors loads the post in the path, aborting with an error
+// response when the ID is invalid or there is no such post
+func (h *Handler) loadPostForAuthors(c *gin.Context) (*models.Post, bool) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return nil, false
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return nil, false
+	}
+	return post, true
+}
+
+// respondWithPostAuthors writes the co-authors of post, oldest first
+func (h *Handler) respondWithPostAuthors(c *gin.Context, post *models.Post) {
+	authors := []models.PostAuthor{}
+	if err := h.dbFor(c).Where("post_id = ?", post.ID).
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, username, first_name, last_name, profile_image")
+		}).
+		Order("created_at").Find(&authors).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeInternalError, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, authors)
+}
+
+// postAuthorRole returns the role userID has on post: author for the post's
+// owner and the co-author role for co-authors. ok is false for anyone else.
+func (h *Handler) postAuthorRole(c *gin.Context, post *models.Post, userID uint) (models.PostAuthorRole, bool) {
+	if post.UserID == userID {
+		return models.PostAuthorRoleAuthor, true
+	}
+
+	var author models.PostAuthor
+	if err := h.dbFor(c).Where("post_id = ? AND user_id = ?", post.ID, userID).First(&author).Error; err != nil {
+		return "", false
+	}
+	return author.Role, true
+}
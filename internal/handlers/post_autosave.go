@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"gorm.io/gorm"
+)
+
+// AutosavePost godoc
+// @Summary Autosave a post being edited
+// @Description Saves a draft snapshot of the title, excerpt and content being edited, apart from the post itself, so nothing readers see changes. Each user keeps their last AUTOSAVE_MAX_SNAPSHOTS (default 20) snapshots of a post, and a save within AUTOSAVE_MIN_INTERVAL (default 30s) of the previous one replaces it. conflict is true when the post was updated since base_updated_at, the updated_at the editor loaded; the snapshot is saved either way.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Param autosave body models.AutosavePostRequest true "Edits to save"
+// @Success 200 {object} models.PostAutosaveResult "Saved snapshot"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Failure 503 {object} models.ErrorResponse "Autosave is turned off"
+// @Security BearerAuth
+// @Router /posts/{id}/autosave [put]
+func (h *Handler) AutosavePost(c *gin.Context) {
+	post, ok := h.loadAutosavePost(c)
+	if !ok {
+		return
+	}
+
+	var requestBody models.AutosavePostRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if !h.checkPostLimits(c, postFields{
+		Title:   &requestBody.Title,
+		Excerpt: &requestBody.Excerpt,
+		Content: &requestBody.Content,
+	}) {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+	cfg := h.cfg.Autosave
+
+	var autosave models.PostAutosave
+	err := h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("post_id = ? AND user_id = ?", post.ID, userID.(uint)).
+			Order("updated_at DESC, id DESC").
+			First(&autosave).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		// Saves in quick succession replace the last snapshot instead of
+		// pushing older ones out
+		if err != nil || time.Since(autosave.UpdatedAt) >= cfg.MinInterval {
+			autosave = models.PostAutosave{PostID: post.ID, UserID: userID.(uint)}
+		}
+		autosave.Title = requestBody.Title
+		autosave.Excerpt = requestBody.Excerpt
+		autosave.Content = requestBody.Content
+		autosave.BaseUpdatedAt = *requestBody.BaseUpdatedAt
+		if err := tx.Save(&autosave).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("post_id = ? AND user_id = ?", post.ID, userID.(uint)).
+			Where("id NOT IN (?)", tx.Model(&models.PostAutosave{}).Select("id").
+				Where("post_id = ? AND user_id = ?", post.ID, userID.(uint)).
+				Order("updated_at DESC, id DESC").
+				Limit(cfg.MaxSnapshots)).
+			Delete(&models.PostAutosave{}).Error
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeAutosaveFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, autosaveResult(post, autosave))
+}
+
+// GetAutosave godoc
+// @Summary Get the latest autosave of a post
+// @Description Returns the current user's most recent draft snapshot of a post, to offer restoring it when the editor opens. conflict is true when the post was updated since the snapshot's edits started.
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 200 {object} models.PostAutosaveResult "Latest snapshot"
+// @Failure 400 {object} models.ErrorResponse "Invalid post ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post or snapshot not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Failure 503 {object} models.ErrorResponse "Autosave is turned off"
+// @Security BearerAuth
+// @Router /posts/{id}/autosave [get]
+func (h *Handler) GetAutosave(c *gin.Context) {
+	post, ok := h.loadAutosavePost(c)
+	if !ok {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	var autosave models.PostAutosave
+	if err := h.dbFor(c).Where("post_id = ? AND user_id = ?", post.ID, userID.(uint)).
+		Order("updated_at DESC, id DESC").
+		First(&autosave).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodeAutosaveNotFound))
+			return
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodeAutosaveFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, autosaveResult(post, autosave))
+}
+
+// loadAutosavePost loads the post named in the path, aborting the request if
+// autosave is turned off, the post doesn't exist or the user can't edit it
+func (h *Handler) loadAutosavePost(c *gin.Context) (*models.Post, bool) {
+	if !h.cfg.Autosave.Enabled {
+		middleware.Abort(c, apierror.New(http.StatusServiceUnavailable, i18n.CodeAutosaveUnavailable))
+		return nil, false
+	}
+
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return nil, false
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return nil, false
+	}
+
+	if !can(c, policy.ActionPostEdit, h.postResource(c, post)) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostEditForbidden))
+		return nil, false
+	}
+	return post, true
+}
+
+// autosaveResult pairs a snapshot with whether the post changed since its
+// edits started. Times are compared to the millisecond, as browsers keep no
+// finer precision.
+func autosaveResult(post *models.Post, autosave models.PostAutosave) models.PostAutosaveResult {
+	return models.PostAutosaveResult{
+		Autosave:      autosave,
+		Conflict:      !post.UpdatedAt.Truncate(time.Millisecond).Equal(autosave.BaseUpdatedAt.Truncate(time.Millisecond)),
+		PostUpdatedAt: post.UpdatedAt,
+	}
+}
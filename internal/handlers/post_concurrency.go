@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// lockPostForUpdate locks the post's row for the rest of tx, re-reads it
+// into post, so edits are applied to the latest copy rather than the one
+// loaded before the lock, and checks the client's preconditions against it:
+// the version the edits were made to and the If-Unmodified-Since header,
+// compared to the second as Last-Modified is sent. A post changed since
+// aborts the request with 409 and the current copy of the post in the
+// details. Saving the post bumps its version.
+func (h *Handler) lockPostForUpdate(c *gin.Context, tx *gorm.DB, post *models.Post, version *int) bool {
+	var current models.Post
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, post.ID).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostUpdateFailed, err))
+		return false
+	}
+
+	modified := version != nil && *version != current.Version
+	if since := c.GetHeader("If-Unmodified-Since"); since != "" {
+		// An invalid date is ignored, as HTTP requires
+		if t, err := http.ParseTime(since); err == nil && current.UpdatedAt.Truncate(time.Second).After(t) {
+			modified = true
+		}
+	}
+	if modified {
+		h.abortEditConflict(c, post.ID)
+		return false
+	}
+
+	*post = current
+	return true
+}
+
+// abortEditConflict aborts with 409 and the post as it is now, so the client
+// can merge its edits into it
+func (h *Handler) abortEditConflict(c *gin.Context, postID uint) {
+	apiErr := apierror.Conflict(i18n.CodePostEditConflict)
+	if post, err := h.postsFor(c).Find(repository.ByID(postID)); err == nil {
+		h.postsFor(c).Reload(post)
+		apiErr = apiErr.WithDetails(gin.H{"current": post})
+	}
+	middleware.Abort(c, apiErr)
+}
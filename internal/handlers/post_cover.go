@@ -3,14 +3,15 @@ package handlers
 import (
 	"net/http"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
 	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
-	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
 	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
 	"github.com/rs/zerolog/log"
 )
 
@@ -25,148 +26,117 @@ var allowedCoverFileTypes = map[string]bool{
 	".webp": true,
 }
 
+// CoverUpload is the content check applied to post cover uploads
+var CoverUpload = upload.Policy{
+	Field:   "cover",
+	Types:   []string{upload.TypeJPEG, upload.TypePNG, upload.TypeWebP},
+	MaxSize: maxCoverSize,
+}
+
 // UploadPostCover godoc
 // @Summary Upload post cover image
 // @Description Upload a new cover image for a post
 // @Tags Posts
 // @Accept multipart/form-data
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Param cover formData file true "Cover image file (JPG, JPEG, PNG, WEBP, max 5MB)"
-// @Success 200 {object} models.SwaggerPostCoverResponse "Cover uploaded successfully"
-// @Failure 400 {object} models.SwaggerStandardResponse "Invalid input"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Success 200 {object} models.SwaggerStandardResponse{data=models.SwaggerPostCoverResponse} "Cover uploaded successfully"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id}/cover [post]
-func UploadPostCover(c *gin.Context) {
+func (h *Handler) UploadPostCover(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
-	userID, exists := c.Get("userID")
+	_, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
 	// Get post ID from URL
-	postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "Invalid post ID",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
 	// Find the post
-	var post models.Post
-	if err := database.DB.First(&post, postID).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"status":  "error",
-			"error":   "Not found",
-			"message": "Post not found",
-		})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
 	// Only allow the author to update the post cover
-	if post.UserID != userID.(uint) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"status":  "error",
-			"error":   "Forbidden",
-			"message": "Only the author can update the post cover",
-		})
+	if !can(c, policy.ActionPostManage, policy.Resource{OwnerID: post.UserID}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostCoverForbidden))
 		return
 	}
 
 	// Get the file from the request
 	file, err := c.FormFile("cover")
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "No file uploaded or invalid file",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileMissing))
 		return
 	}
 
 	// Check file size
 	if file.Size > maxCoverSize {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "File too large",
-			"message": "Cover image must be less than 5MB",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostCoverTooLarge))
 		return
 	}
 
 	// Check file type
 	ext := strings.ToLower(filepath.Ext(file.Filename))
 	if !allowedCoverFileTypes[ext] {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid file type",
-			"message": "Only JPG, JPEG, PNG, and WEBP files are allowed",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostCoverInvalidType))
 		return
 	}
 
-	// Initialize Cloudinary service
-	cloudinaryService, err := services.NewCloudinaryService(middleware.AppConfig.Cloudinary)
+	// Initialize storage service
+	storageService, err := services.NewStorageService(h.cfg)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize Cloudinary service")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Server error",
-			"message": "Failed to initialize upload service",
-		})
+		log.Error().Err(err).Msg("Failed to initialize storage service")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUploadServiceFailed, err))
 		return
 	}
 
 	// If post already has a cover, delete the old one
 	if post.Cover != "" {
-		if err := cloudinaryService.DeleteImage(c.Request.Context(), post.Cover); err != nil {
+		if err := storageService.DeleteImage(c.Request.Context(), post.Cover); err != nil {
 			log.Warn().Err(err).Str("cover_url", post.Cover).Msg("Failed to delete old cover image")
 			// Continue with the upload even if deletion fails
 		}
 	}
 
-	// Upload the file to Cloudinary
-	imageURL, err := cloudinaryService.UploadPostCover(c.Request.Context(), file, uint(postID))
+	// Upload the file to storage
+	variants, err := storageService.UploadPostCover(c.Request.Context(), file, post.ID)
 	if err != nil {
-		log.Error().Err(err).Uint64("post_id", postID).Msg("Failed to upload cover")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Upload failed",
-			"message": "Failed to upload cover image",
-		})
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to upload cover")
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCoverUploadFailed, err))
 		return
 	}
 
 	// Update post's cover in the database
+	imageURL := variants.Original
 	post.Cover = imageURL
-	if result := database.DB.Save(&post); result.Error != nil {
-		log.Error().Err(result.Error).Uint64("post_id", postID).Msg("Failed to update post cover")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to update post cover",
-		})
+	if err := h.postsFor(c).Save(post); err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to update post cover")
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCoverUpdateFailed, err))
 		return
 	}
 
-	log.Info().Uint64("post_id", postID).Str("image_url", imageURL).Msg("Post cover updated")
+	log.Info().Uint("post_id", post.ID).Str("image_url", imageURL).Msg("Post cover updated")
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "success",
 		"message": "Cover uploaded successfully",
 		"data": gin.H{
-			"cover": imageURL,
+			"cover":    imageURL,
+			"variants": variants,
 		},
 	})
 }
@@ -176,55 +146,39 @@ func UploadPostCover(c *gin.Context) {
 // @Description Remove the cover image from a post
 // @Tags Posts
 // @Produce json
-// @Param id path int true "Post ID"
+// @Param id path string true "Post ID or UUID"
 // @Success 200 {object} models.SwaggerStandardResponse "Cover deleted successfully"
-// @Failure 401 {object} models.SwaggerStandardResponse "Unauthorized"
-// @Failure 403 {object} models.SwaggerStandardResponse "Forbidden"
-// @Failure 404 {object} models.SwaggerStandardResponse "Post not found"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Security BearerAuth
 // @Router /posts/{id}/cover [delete]
-func DeletePostCover(c *gin.Context) {
+func (h *Handler) DeletePostCover(c *gin.Context) {
 	// Get user ID from context (set by AuthMiddleware)
-	userID, exists := c.Get("userID")
+	_, exists := c.Get("userID")
 	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"status":  "error",
-			"error":   "Unauthorized",
-			"message": "Authentication required",
-		})
+		middleware.Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 		return
 	}
 
 	// Get post ID from URL
-	postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	byID, err := resourceIDScope(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"status":  "error",
-			"error":   "Invalid input",
-			"message": "Invalid post ID",
-		})
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
 		return
 	}
 
 	// Find the post
-	var post models.Post
-	if err := database.DB.First(&post, postID).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"status":  "error",
-			"error":   "Not found",
-			"message": "Post not found",
-		})
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
 		return
 	}
 
 	// Only allow the author to delete the post cover
-	if post.UserID != userID.(uint) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"status":  "error",
-			"error":   "Forbidden",
-			"message": "Only the author can delete the post cover",
-		})
+	if !can(c, policy.ActionPostManage, policy.Resource{OwnerID: post.UserID}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostCoverForbidden))
 		return
 	}
 
@@ -237,37 +191,29 @@ func DeletePostCover(c *gin.Context) {
 		return
 	}
 
-	// Initialize Cloudinary service
-	cloudinaryService, err := services.NewCloudinaryService(middleware.AppConfig.Cloudinary)
+	// Initialize storage service
+	storageService, err := services.NewStorageService(h.cfg)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize Cloudinary service")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Server error",
-			"message": "Failed to initialize service",
-		})
+		log.Error().Err(err).Msg("Failed to initialize storage service")
+		middleware.Abort(c, apierror.Internal(i18n.CodeUploadServiceFailed, err))
 		return
 	}
 
-	// Delete the cover from Cloudinary
-	if err := cloudinaryService.DeleteImage(c.Request.Context(), post.Cover); err != nil {
+	// Delete the cover from storage
+	if err := storageService.DeleteImage(c.Request.Context(), post.Cover); err != nil {
 		log.Error().Err(err).Str("cover_url", post.Cover).Msg("Failed to delete cover image")
-		// Continue with the database update even if Cloudinary deletion fails
+		// Continue with the database update even if deletion fails
 	}
 
 	// Update post in the database
 	post.Cover = ""
-	if result := database.DB.Save(&post); result.Error != nil {
-		log.Error().Err(result.Error).Uint64("post_id", postID).Msg("Failed to update post")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"status":  "error",
-			"error":   "Database error",
-			"message": "Failed to update post",
-		})
+	if err := h.postsFor(c).Save(post); err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to update post")
+		middleware.Abort(c, apierror.Internal(i18n.CodePostCoverUpdateFailed, err))
 		return
 	}
 
-	log.Info().Uint64("post_id", postID).Msg("Post cover deleted")
+	log.Info().Uint("post_id", post.ID).Msg("Post cover deleted")
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "success",
 		"message": "Cover deleted successfully",
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// checkPostExpiry aborts when a post that is being published or scheduled
+// would expire before it goes out, and reports whether its expiry date is
+// usable. Drafts and archived posts may keep a past expiry date.
+func checkPostExpiry(c *gin.Context, post *models.Post) bool {
+	if post.ExpiresAt == nil {
+		return true
+	}
+	if post.Status != models.PostStatusPublished && post.Status != models.PostStatusScheduled {
+		return true
+	}
+
+	if !post.ExpiresAt.After(time.Now()) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeExpiryDateInPast))
+		return false
+	}
+	if post.Status == models.PostStatusScheduled && post.PublishAt != nil && !post.ExpiresAt.After(*post.PublishAt) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeExpiryBeforePublish))
+		return false
+	}
+	return true
+}
+
+// notExpired leaves out posts whose expiry date has passed, which stay
+// published until the scheduler's next run takes them down
+func notExpired(db *gorm.DB) *gorm.DB {
+	return db.Where("posts.expires_at IS NULL OR posts.expires_at > ?", time.Now())
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+)
+
+// postFields are the parts of a post the size limits apply to. Nil fields
+// aren't being set and aren't checked.
+type postFields struct {
+	Title   *string
+	Excerpt *string
+	Content *string
+	Tags    []string
+}
+
+// checkPostLimits aborts with the fields that exceed the configured post
+// limits, and reports whether they were all within them
+func (h *Handler) checkPostLimits(c *gin.Context, fields postFields) bool {
+	limits := h.cfg.PostLimits
+
+	var invalid []apierror.FieldError
+	if fields.Title != nil && utf8.RuneCountInString(*fields.Title) > limits.MaxTitleLength {
+		invalid = append(invalid, apierror.TooLong("title", limits.MaxTitleLength))
+	}
+	if fields.Excerpt != nil && utf8.RuneCountInString(*fields.Excerpt) > limits.MaxExcerptLength {
+		invalid = append(invalid, apierror.TooLong("excerpt", limits.MaxExcerptLength))
+	}
+	if fields.Content != nil && len(*fields.Content) > limits.MaxContentBytes {
+		invalid = append(invalid, apierror.TooLarge("content", limits.MaxContentBytes))
+	}
+	if len(fields.Tags) > limits.MaxTags {
+		invalid = append(invalid, apierror.TooMany("tags", limits.MaxTags))
+	}
+
+	if len(invalid) > 0 {
+		middleware.Abort(c, apierror.InvalidFields(invalid...))
+		return false
+	}
+	return true
+}
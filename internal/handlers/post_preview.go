@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/routes"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// CreatePostPreviewToken godoc
+// @Summary Create a preview link for an unpublished post
+// @Description Generates a signed, expiring link that lets anyone with it read the post before it is published, without logging in. Links expire after JWT_PREVIEW_EXPIRY (default 72h).
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 201 {object} models.PreviewTokenResponse "Preview link"
+// @Failure 400 {object} models.ErrorResponse "Post is already published"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/preview-token [post]
+func (h *Handler) CreatePostPreviewToken(c *gin.Context) {
+	post, ok := h.loadOwnPostForPreview(c)
+	if !ok {
+		return
+	}
+
+	if post.Status == models.PostStatusPublished {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostAlreadyPublished))
+		return
+	}
+
+	token, expiresAt, err := services.NewPreviewTokenService(h.keys, h.cfg.JWT).Issue(post)
+	if err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to create preview token")
+		middleware.Abort(c, apierror.Internal(i18n.CodePreviewTokenCreateFailed, err))
+		return
+	}
+
+	log.Info().Uint("post_id", post.ID).Time("expires_at", expiresAt).Msg("Post preview link created")
+	c.JSON(http.StatusCreated, models.PreviewTokenResponse{
+		Token:      token,
+		PreviewURL: routes.BasePath + "/posts/preview/" + token,
+		ExpiresAt:  expiresAt,
+	})
+}
+
+// RevokePostPreviewTokens godoc
+// @Summary Revoke preview links for a post
+// @Description Invalidates every preview link created for the post so far
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Success 200 {object} models.SwaggerStandardResponse "Preview links revoked"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/preview-token [delete]
+func (h *Handler) RevokePostPreviewTokens(c *gin.Context) {
+	post, ok := h.loadOwnPostForPreview(c)
+	if !ok {
+		return
+	}
+
+	// Tokens carry the version they were issued for, so bumping it revokes them all.
+	// UpdateColumn leaves updated_at alone since the content didn't change.
+	if err := h.dbFor(c).Model(post).UpdateColumn("preview_version", gorm.Expr("preview_version + 1")).Error; err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to revoke preview tokens")
+		middleware.Abort(c, apierror.Internal(i18n.CodePreviewTokenRevokeFailed, err))
+		return
+	}
+
+	log.Info().Uint("post_id", post.ID).Msg("Post preview links revoked")
+	c.JSON(http.StatusOK, gin.H{
+		"status":  "success",
+		"message": "Preview links revoked",
+	})
+}
+
+// GetPostPreview godoc
+// @Summary Preview an unpublished post
+// @Description Returns a post, whatever its status, for a valid preview token. No authentication is needed; the token is the credential.
+// @Tags Posts
+// @Produce json
+// @Param token path string true "Preview token"
+// @Success 200 {object} models.Post "Post"
+// @Failure 404 {object} models.ErrorResponse "Preview link is invalid, expired or revoked"
+// @Router /posts/preview/{token} [get]
+func (h *Handler) GetPostPreview(c *gin.Context) {
+	// Previews must not be indexed by search engines. The route table keeps
+	// them out of caches.
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+
+	claims, err := services.NewPreviewTokenService(h.keys, h.cfg.JWT).Verify(c.Param("token"))
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePreviewTokenInvalid))
+		return
+	}
+
+	var post models.Post
+	err = h.dbFor(c).Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Tags").Preload("Category").First(&post, claims.PostID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodePreviewTokenInvalid))
+			return
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodePostsFetchFailed, err))
+		return
+	}
+
+	// Links revoked after this token was issued no longer work
+	if post.PreviewVersion != claims.Version {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePreviewTokenInvalid))
+		return
+	}
+
+	h.loadSeriesNavigation(c, &post)
+	h.loadPostTranslations(c, &post)
+	c.JSON(http.StatusOK, post)
+}
+
+// loadOwnPostForPreview loads the post in the path and checks that the current
+// user wrote it, aborting with an error response otherwise
+func (h *Handler) loadOwnPostForPreview(c *gin.Context) (*models.Post, bool) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return nil, false
+	}
+
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return nil, false
+	}
+
+	// Only the author can share or revoke previews of the post
+	if !can(c, policy.ActionPostManage, policy.Resource{OwnerID: post.UserID}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostPreviewForbidden))
+		return nil, false
+	}
+
+	return post, true
+}
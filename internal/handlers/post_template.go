@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"gorm.io/gorm"
+)
+
+// GetPostTemplates godoc
+// @Summary List post templates
+// @Description Returns the site's post templates ordered by name, for users who can write posts
+// @Tags Posts
+// @Produce json
+// @Success 200 {array} models.PostTemplate "Post templates"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /post-templates [get]
+func (h *Handler) GetPostTemplates(c *gin.Context) {
+	if !can(c, policy.ActionPostCreate, policy.Resource{}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostCreateForbidden))
+		return
+	}
+
+	templates := []models.PostTemplate{}
+	if err := h.dbFor(c).Order("name ASC").Find(&templates).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostTemplatesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, templates)
+}
+
+// GetPostTemplate godoc
+// @Summary Get a post template
+// @Description Returns a post template, for users who can write posts
+// @Tags Posts
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 200 {object} models.PostTemplate "Post template"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Template not found"
+// @Security BearerAuth
+// @Router /post-templates/{id} [get]
+func (h *Handler) GetPostTemplate(c *gin.Context) {
+	if !can(c, policy.ActionPostCreate, policy.Resource{}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostCreateForbidden))
+		return
+	}
+
+	template, ok := h.findPostTemplate(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// CreatePostTemplate godoc
+// @Summary Create a post template
+// @Description Creates a template that pre-fills new posts created with its template_id. Only editors and admins can manage templates.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param request body models.CreatePostTemplateRequest true "Template details"
+// @Success 201 {object} models.PostTemplate "Created template"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Name already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /post-templates [post]
+func (h *Handler) CreatePostTemplate(c *gin.Context) {
+	if !canEditTemplates(c) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostTemplateEditForbidden))
+		return
+	}
+
+	var requestBody models.CreatePostTemplateRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	categoryID, err := h.resolveCategoryID(c, requestBody.CategoryID)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeCategoryNotFound))
+		return
+	}
+
+	userID := c.GetUint("userID")
+	template := models.PostTemplate{
+		Name:         strings.TrimSpace(requestBody.Name),
+		Description:  strings.TrimSpace(requestBody.Description),
+		TitlePattern: strings.TrimSpace(requestBody.TitlePattern),
+		Content:      requestBody.Content,
+		Excerpt:      requestBody.Excerpt,
+		Tags:         requestBody.Tags,
+		Status:       requestBody.Status,
+		CategoryID:   categoryID,
+		Language:     requestBody.Language,
+		UpdatedBy:    &userID,
+	}
+	if template.Status == "" {
+		template.Status = models.PostStatusDraft
+	}
+	if template.Language == "" {
+		template.Language = models.DefaultContentLanguage
+	}
+	if template.Tags == nil {
+		template.Tags = []string{}
+	}
+
+	if h.postTemplateNameTaken(c, template.Name, 0) {
+		middleware.Abort(c, apierror.Conflict(i18n.CodePostTemplateNameTaken))
+		return
+	}
+
+	if err := h.dbFor(c).Create(&template).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostTemplateCreateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, template)
+}
+
+// UpdatePostTemplate godoc
+// @Summary Update a post template
+// @Description Changes a post template; posts created from it before are left alone. Only editors and admins can manage templates.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param request body models.UpdatePostTemplateRequest true "Template changes"
+// @Success 200 {object} models.PostTemplate "Updated template"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Template not found"
+// @Failure 409 {object} models.ErrorResponse "Name already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /post-templates/{id} [put]
+func (h *Handler) UpdatePostTemplate(c *gin.Context) {
+	if !canEditTemplates(c) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostTemplateEditForbidden))
+		return
+	}
+
+	template, ok := h.findPostTemplate(c)
+	if !ok {
+		return
+	}
+
+	var requestBody models.UpdatePostTemplateRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.Name != nil {
+		template.Name = strings.TrimSpace(*requestBody.Name)
+		if h.postTemplateNameTaken(c, template.Name, template.ID) {
+			middleware.Abort(c, apierror.Conflict(i18n.CodePostTemplateNameTaken))
+			return
+		}
+	}
+	if requestBody.Description != nil {
+		template.Description = strings.TrimSpace(*requestBody.Description)
+	}
+	if requestBody.TitlePattern != nil {
+		template.TitlePattern = strings.TrimSpace(*requestBody.TitlePattern)
+	}
+	if requestBody.Content != nil {
+		template.Content = *requestBody.Content
+	}
+	if requestBody.Excerpt != nil {
+		template.Excerpt = *requestBody.Excerpt
+	}
+	if requestBody.Tags != nil {
+		template.Tags = requestBody.Tags
+	}
+	if requestBody.Status != nil {
+		template.Status = *requestBody.Status
+	}
+	if requestBody.CategoryID != nil {
+		categoryID, err := h.resolveCategoryID(c, requestBody.CategoryID)
+		if err != nil {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeCategoryNotFound))
+			return
+		}
+		template.CategoryID = categoryID
+	}
+	if requestBody.Language != nil {
+		template.Language = *requestBody.Language
+	}
+
+	userID := c.GetUint("userID")
+	template.UpdatedBy = &userID
+	if err := h.dbFor(c).Save(template).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostTemplateUpdateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
+
+// DeletePostTemplate godoc
+// @Summary Delete a post template
+// @Description Deletes a post template; posts created from it are kept. Only editors and admins can manage templates.
+// @Tags Posts
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Template not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /post-templates/{id} [delete]
+func (h *Handler) DeletePostTemplate(c *gin.Context) {
+	if !canEditTemplates(c) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodePostTemplateEditForbidden))
+		return
+	}
+
+	template, ok := h.findPostTemplate(c)
+	if !ok {
+		return
+	}
+
+	if err := h.dbFor(c).Delete(template).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostTemplateDeleteFailed, err))
+		return
+	}
+
+	h.recordAudit(c, models.AuditActionPostTemplateDeleted, "post_template", template.ID, gin.H{
+		"name":          template.Name,
+		"title_pattern": template.TitlePattern,
+	}, nil)
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Post template deleted successfully"})
+}
+
+// findPostTemplate loads the template named by the id path parameter,
+// aborting when there is none
+func (h *Handler) findPostTemplate(c *gin.Context) (*models.PostTemplate, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostTemplateID))
+		return nil, false
+	}
+
+	var template models.PostTemplate
+	if err := h.dbFor(c).First(&template, uint(id)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			middleware.Abort(c, apierror.NotFound(i18n.CodePostTemplateNotFound))
+			return nil, false
+		}
+		middleware.Abort(c, apierror.Internal(i18n.CodePostTemplatesFetchFailed, err))
+		return nil, false
+	}
+	return &template, true
+}
+
+// postTemplateNameTaken reports whether another template already uses name
+func (h *Handler) postTemplateNameTaken(c *gin.Context, name string, exceptID uint) bool {
+	var count int64
+	h.dbFor(c).Model(&models.PostTemplate{}).Where("name = ? AND id != ?", name, exceptID).Count(&count)
+	return count > 0
+}
+
+// applyPostTemplate fills the fields of a new post left empty in req from
+// template, rendering its title pattern at now
+func applyPostTemplate(req *models.CreatePostRequest, template *models.PostTemplate, now time.Time) {
+	if strings.TrimSpace(req.Title) == "" {
+		req.Title = template.RenderTitle(now)
+	}
+	if req.Content == "" {
+		req.Content = template.Content
+	}
+	if req.Excerpt == "" {
+		req.Excerpt = template.Excerpt
+	}
+	if req.Tags == nil {
+		req.Tags = template.Tags
+	}
+	if req.Status == "" {
+		req.Status = template.Status
+	}
+	if req.CategoryID == nil {
+		req.CategoryID = template.CategoryID
+	}
+	if req.Language == "" {
+		req.Language = template.Language
+	}
+}
+
+// canEditTemplates reports whether the signed-in user may manage post templates
+func canEditTemplates(c *gin.Context) bool {
+	return can(c, policy.ActionTemplateEdit, policy.Resource{})
+}
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// maxImportFileSize bounds the size of an uploaded import file
+const maxImportFileSize = 20 * 1024 * 1024
+
+// ExportPosts godoc
+// @Summary Export posts
+// @Description Downloads every post with its tags, category, cover URL, author and dates, either as one JSON document or as a zip archive of Markdown files with YAML front matter. The files can be imported with POST /admin/posts/import.
+// @Tags Admin
+// @Produce json
+// @Produce application/zip
+// @Param format query string false "json (default) or markdown"
+// @Param status query string false "Only export posts with this status"
+// @Success 200 {object} models.PostExport "JSON export, or a zip archive for the markdown format"
+// @Failure 400 {object} models.ErrorResponse "Invalid format or status"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/posts/export [get]
+func (h *Handler) ExportPosts(c *gin.Context) {
+	format := c.DefaultQuery("format", models.PostTransferFormatJSON)
+	if format != models.PostTransferFormatJSON && format != models.PostTransferFormatMarkdown {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostExportFormatInvalid))
+		return
+	}
+
+	status := models.PostStatus(c.Query("status"))
+	switch status {
+	case "", models.PostStatusDraft, models.PostStatusPublished, models.PostStatusArchived, models.PostStatusScheduled:
+	default:
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostStatusInvalid))
+		return
+	}
+
+	posts, err := services.NewPostTransferService(h.dbFor(c)).Export(status)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostExportFailed, err))
+		return
+	}
+
+	// Encode into a buffer so a failure can still be reported as an error
+	var buf bytes.Buffer
+	filename := "posts-" + time.Now().UTC().Format("20060102")
+	contentType := "application/json"
+	if format == models.PostTransferFormatMarkdown {
+		err = services.WritePostsArchive(&buf, posts)
+		filename += ".zip"
+		contentType = "application/zip"
+	} else {
+		err = services.WritePostsJSON(&buf, posts)
+		filename += ".json"
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostExportFailed, err))
+		return
+	}
+
+	log.Info().Int("posts", len(posts)).Str("format", format).Msg("Posts exported")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+// ImportPosts godoc
+// @Summary Import posts
+// @Description Imports posts from a JSON export, a Markdown file with YAML front matter, or a zip archive of Markdown files. Posts are matched to existing ones by slug and skipped, or overwritten with on_conflict=update. Authors are matched by username and default to the importing admin; categories must already exist and tags are created as needed. Posts that fail validation are reported and the rest are imported. With dry_run=true nothing is saved and the report shows what would happen.
+// @Tags Admin
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "JSON, Markdown or zip file (max 20MB)"
+// @Param dry_run query bool false "Validate and report without saving"
+// @Param on_conflict query string false "What to do with posts whose slug exists: skip (default) or update"
+// @Success 200 {object} models.PostImportResult "Import report"
+// @Failure 400 {object} models.ErrorResponse "Missing or unreadable file"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/posts/import [post]
+func (h *Handler) ImportPosts(c *gin.Context) {
+	adminID, _ := c.Get("userID")
+
+	onConflict := c.DefaultQuery("on_conflict", "skip")
+	if onConflict != "skip" && onConflict != "update" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidInput).WithMessage("on_conflict must be skip or update"))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileMissing))
+		return
+	}
+	if file.Size > maxImportFileSize {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileTooLarge))
+		return
+	}
+
+	opened, err := file.Open()
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+		return
+	}
+	defer opened.Close()
+	data, err := io.ReadAll(opened)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+		return
+	}
+
+	posts, err := services.ParsePostImport(file.Filename, data)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostImportMalformed).WithMessage(err.Error()))
+		return
+	}
+
+	result, err := services.NewPostTransferService(h.dbFor(c)).Import(posts, services.PostImportOptions{
+		DryRun:          c.Query("dry_run") == "true",
+		Overwrite:       onConflict == "update",
+		DefaultAuthorID: adminID.(uint),
+	})
+	if errors.Is(err, services.ErrPostImportMalformed) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostImportMalformed).WithMessage(err.Error()))
+		return
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+		return
+	}
+
+	log.Info().
+		Interface("admin_id", adminID).
+		Bool("dry_run", result.DryRun).
+		Int("created", result.Created).
+		Int("updated", result.Updated).
+		Int("skipped", result.Skipped).
+		Int("failed", result.Failed).
+		Msg("Posts imported")
+	c.JSON(http.StatusOK, result)
+}
+
+// ImportWordPress godoc
+// @Summary Import a WordPress export
+// @Description Imports the posts and pages of a WordPress export (WXR) file, made with Tools > Export in WordPress. Pages are imported as posts tagged "page". Authors are matched to local users by email, then username, and created with a random password otherwise. Missing categories are created with their parents, tags are created as needed, and the comments of newly created posts are imported, with visitors' comments attributed to the ghost user. Images hosted on the exported site are copied to storage and their URLs rewritten unless download_images=false. Existing slugs are skipped, or overwritten with on_conflict=update. With dry_run=true nothing is saved or downloaded and the summary shows what would happen.
+// @Tags Admin
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "WordPress export XML file (max 20MB)"
+// @Param dry_run query bool false "Validate and report without saving"
+// @Param on_conflict query string false "What to do with posts whose slug exists: skip (default) or update"
+// @Param download_images query bool false "Copy images hosted on the exported site to storage (default true)"
+// @Success 200 {object} models.WXRImportResult "Import summary"
+// @Failure 400 {object} models.ErrorResponse "Missing file or not a WordPress export"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/posts/import/wordpress [post]
+func (h *Handler) ImportWordPress(c *gin.Context) {
+	adminID, _ := c.Get("userID")
+
+	onConflict := c.DefaultQuery("on_conflict", "skip")
+	if onConflict != "skip" && onConflict != "update" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidInput).WithMessage("on_conflict must be skip or update"))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileMissing))
+		return
+	}
+	if file.Size > maxImportFileSize {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeFileTooLarge))
+		return
+	}
+
+	opened, err := file.Open()
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+		return
+	}
+	defer opened.Close()
+	data, err := io.ReadAll(opened)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+		return
+	}
+
+	opts := services.WXRImportOptions{
+		PostImportOptions: services.PostImportOptions{
+			DryRun:          c.Query("dry_run") == "true",
+			Overwrite:       onConflict == "update",
+			DefaultAuthorID: adminID.(uint),
+		},
+		DownloadImages: c.Query("download_images") != "false",
+	}
+
+	var storageService services.StorageService
+	if opts.DownloadImages && !opts.DryRun {
+		storageService, err = services.NewStorageService(h.cfg)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to initialize storage service")
+			middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+			return
+		}
+	}
+
+	result, err := services.NewPostTransferService(h.dbFor(c)).ImportWordPress(c.Request.Context(), data, storageService, opts)
+	if errors.Is(err, services.ErrPostImportMalformed) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodePostImportMalformed).WithMessage(err.Error()))
+		return
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostImportFailed, err))
+		return
+	}
+
+	log.Info().
+		Interface("admin_id", adminID).
+		Bool("dry_run", result.DryRun).
+		Int("created", result.Posts.Created).
+		Int("updated", result.Posts.Updated).
+		Int("failed", result.Posts.Failed).
+		Int("comments", result.CommentsImported).
+		Int("images", result.ImagesDownloaded).
+		Msg("WordPress export imported")
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// resolveTranslationGroup returns the translation group of the post with ID
+// sourceID, which the post with ID postID is being linked to as a translation.
+// A source without a group starts one named after its UUID. It aborts the
+// request and returns false when the source doesn't exist or is the post
+// itself.
+func (h *Handler) resolveTranslationGroup(c *gin.Context, sourceID, postID uint) (*string, bool) {
+	var source models.Post
+	if sourceID == postID || h.dbFor(c).Select("id, uuid, translation_group").First(&source, sourceID).Error != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeTranslationSourceNotFound))
+		return nil, false
+	}
+	if source.TranslationGroup != nil {
+		return source.TranslationGroup, true
+	}
+
+	if err := h.dbFor(c).Model(&source).UpdateColumn("translation_group", source.UUID).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodePostUpdateFailed, err))
+		return nil, false
+	}
+	return &source.UUID, true
+}
+
+// translationTaken reports whether another post in group is written in lang.
+// A group holds at most one post per language.
+func (h *Handler) translationTaken(c *gin.Context, group, lang string, exceptID uint) bool {
+	var count int64
+	h.dbFor(c).Model(&models.Post{}).Where("translation_group = ? AND language = ? AND id != ?", group, lang, exceptID).Count(&count)
+	return count > 0
+}
+
+// loadPostTranslations sets post.Translations to the published posts in its
+// translation group
+func (h *Handler) loadPostTranslations(c *gin.Context, post *models.Post) {
+	if post.TranslationGroup == nil {
+		return
+	}
+
+	var translations []models.PostTranslation
+	if err := h.dbFor(c).Model(&models.Post{}).Select("id, uuid, title, slug, language").
+		Where("translation_group = ? AND id != ? AND status = ?", *post.TranslationGroup, post.ID, models.PostStatusPublished).
+		Order("language ASC").Scan(&translations).Error; err != nil {
+		log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to load post translations")
+		return
+	}
+	post.Translations = translations
+}
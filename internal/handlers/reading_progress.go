@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveReadingProgress godoc
+// @Summary Save reading progress
+// @Description Saves how far the current user got in a published post, as a percentage and the anchor of the last paragraph read, so they can resume on another device. Each post keeps only the latest progress.
+// @Tags Reading Progress
+// @Accept json
+// @Produce json
+// @Param id path string true "Post ID or UUID"
+// @Param progress body models.SaveReadingProgressRequest true "Reading position"
+// @Success 200 {object} models.ReadingProgress "Saved progress"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /posts/{id}/progress [put]
+func (h *Handler) SaveReadingProgress(c *gin.Context) {
+	var requestBody models.SaveReadingProgressRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	post, ok := h.loadPublishedPost(c)
+	if !ok {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	progress := models.ReadingProgress{
+		UserID:     userID.(uint),
+		PostID:     post.ID,
+		Percentage: *requestBody.Percentage,
+		Anchor:     requestBody.Anchor,
+		UpdatedAt:  time.Now(),
+	}
+	if err := h.dbFor(c).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"percentage", "anchor", "updated_at"}),
+	}).Create(&progress).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeReadingProgressSaveFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// GetReadingProgress godoc
+// @Summary Get reading progress
+// @Description Returns the current user's reading progress in published posts, most recently read first, so a reader can resume on another device. Finished posts are left out unless finished=true.
+// @Tags Reading Progress
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Number of items per page (default: PAGINATION_DEFAULT_LIMIT, max: PAGINATION_MAX_LIMIT)"
+// @Param finished query bool false "Include posts read to the end"
+// @Success 200 {object} models.SwaggerReadingProgressResponse "Reading progress with pagination metadata"
+// @Failure 400 {object} models.ErrorResponse "Invalid page or page size"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/progress [get]
+func (h *Handler) GetReadingProgress(c *gin.Context) {
+	page, limit, ok := h.pageQuery(c)
+	if !ok {
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	query := h.dbFor(c).Model(&models.ReadingProgress{}).
+		Joins("JOIN posts ON posts.id = reading_progress.post_id AND posts.deleted_at IS NULL").
+		Where("reading_progress.user_id = ? AND posts.status = ?", userID.(uint), models.PostStatusPublished)
+	if siteID, ok := site.IDFromContext(c.Request.Context()); ok {
+		query = query.Where("posts.site_id = ?", siteID)
+	}
+	if c.Query("finished") != "true" {
+		query = query.Where("reading_progress.percentage < ?", 100)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeReadingProgressFetchFailed, err))
+		return
+	}
+
+	progress := []models.ReadingProgress{}
+	if err := query.Preload("Post.User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Post.Tags").
+		Order("reading_progress.updated_at DESC, reading_progress.id DESC").
+		Limit(limit).Offset((page - 1) * limit).
+		Find(&progress).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeReadingProgressFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"progress": progress,
+		"meta": gin.H{
+			"page":     page,
+			"limit":    limit,
+			"total":    total,
+			"lastPage": (int(total) + limit - 1) / limit,
+		},
+	})
+}
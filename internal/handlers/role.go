@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetPermissions godoc
+// @Summary List permissions
+// @Description Returns every permission a role can grant (admin only)
+// @Tags Admin
+// @Produce json
+// @Success 200 {array} policy.Permission "Permissions"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Security BearerAuth
+// @Router /admin/permissions [get]
+func (h *Handler) GetPermissions(c *gin.Context) {
+	c.JSON(http.StatusOK, policy.Permissions)
+}
+
+// GetRoles godoc
+// @Summary List roles
+// @Description Returns the built-in and custom roles with their permissions and the number of users who have each (admin only)
+// @Tags Admin
+// @Produce json
+// @Success 200 {array} models.Role "Roles"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/roles [get]
+func (h *Handler) GetRoles(c *gin.Context) {
+	roles, err := services.NewRoleService(h.dbFor(c)).All()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch roles")
+		middleware.Abort(c, apierror.Internal(i18n.CodeRolesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// CreateRole godoc
+// @Summary Create a role
+// @Description Adds a custom role granting the given permissions. Users are given it with PUT /admin/users/{id}/role (admin only).
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.CreateRoleRequest true "Role"
+// @Success 201 {object} models.Role "Created role"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Name already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/roles [post]
+func (h *Handler) CreateRole(c *gin.Context) {
+	var requestBody models.CreateRoleRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	role, err := services.NewRoleService(h.dbFor(c)).Create(requestBody)
+	if err != nil {
+		abortRoleError(c, err, i18n.CodeRoleCreateFailed)
+		return
+	}
+
+	log.Info().Str("role", role.Name).Strs("permissions", role.Permissions).Msg("Role created")
+	h.recordAudit(c, models.AuditActionRoleCreated, "role", role.ID, nil, gin.H{"name": role.Name, "permissions": role.Permissions})
+	c.JSON(http.StatusCreated, role)
+}
+
+// UpdateRole godoc
+// @Summary Change a role
+// @Description Changes a role's description or replaces its permissions. The admin role's permissions can't be changed. Changes apply to users' existing tokens within a minute (admin only).
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Role ID"
+// @Param request body models.UpdateRoleRequest true "Fields to change"
+// @Success 200 {object} models.Role "Updated role"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Role not found"
+// @Failure 409 {object} models.ErrorResponse "Role is locked"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/roles/{id} [put]
+func (h *Handler) UpdateRole(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidRoleID))
+		return
+	}
+
+	var requestBody models.UpdateRoleRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	roles := services.NewRoleService(h.dbFor(c))
+	before, err := roles.Get(uint(id))
+	if err != nil {
+		abortRoleError(c, err, i18n.CodeRoleUpdateFailed)
+		return
+	}
+	role, err := roles.Update(uint(id), requestBody)
+	if err != nil {
+		abortRoleError(c, err, i18n.CodeRoleUpdateFailed)
+		return
+	}
+
+	log.Info().Str("role", role.Name).Strs("permissions", role.Permissions).Msg("Role changed")
+	h.recordAudit(c, models.AuditActionRoleUpdated, "role", role.ID,
+		gin.H{"description": before.Description, "permissions": before.Permissions},
+		gin.H{"description": role.Description, "permissions": role.Permissions})
+	c.JSON(http.StatusOK, role)
+}
+
+// DeleteRole godoc
+// @Summary Delete a role
+// @Description Deletes a custom role. Built-in roles can't be deleted, and users with the role must be given another one first (admin only).
+// @Tags Admin
+// @Produce json
+// @Param id path int true "Role ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid role ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Role not found"
+// @Failure 409 {object} models.ErrorResponse "Role is built in or still assigned"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/roles/{id} [delete]
+func (h *Handler) DeleteRole(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidRoleID))
+		return
+	}
+
+	role, err := services.NewRoleService(h.dbFor(c)).Delete(uint(id))
+	if err != nil {
+		abortRoleError(c, err, i18n.CodeRoleDeleteFailed)
+		return
+	}
+
+	log.Info().Str("role", role.Name).Msg("Role deleted")
+	h.recordAudit(c, models.AuditActionRoleDeleted, "role", role.ID, gin.H{"name": role.Name, "permissions": role.Permissions}, nil)
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Role deleted successfully"})
+}
+
+// abortRoleError maps role service errors to responses
+func abortRoleError(c *gin.Context, err error, failedCode string) {
+	switch {
+	case errors.Is(err, services.ErrRoleNotFound):
+		middleware.Abort(c, apierror.NotFound(i18n.CodeRoleNotFound))
+	case errors.Is(err, services.ErrRoleExists):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeRoleExists))
+	case errors.Is(err, services.ErrInvalidRoleName):
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidRoleName))
+	case errors.Is(err, services.ErrUnknownPermission):
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeUnknownPermission).WithDetails(err.Error()))
+	case errors.Is(err, services.ErrRoleLocked):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeRoleLocked))
+	case errors.Is(err, services.ErrRoleBuiltIn):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeRoleBuiltIn))
+	case errors.Is(err, services.ErrRoleInUse):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeRoleInUse))
+	default:
+		log.Error().Err(err).Msg("Failed to save role")
+		middleware.Abort(c, apierror.Internal(failedCode, err))
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// searchTypes maps the values accepted by the types parameter to result types
+var searchTypes = map[string]models.SearchResultType{
+	"posts": models.SearchResultPost,
+	"news":  models.SearchResultNews,
+	"tags":  models.SearchResultTag,
+}
+
+// Search godoc
+// @Summary Search posts, news and tags
+// @Description Searches published posts, published news articles and tags in one call and returns the best matches of each type with a relevance score and a snippet around the matched words. The query supports quoted phrases, OR and -word to exclude a word. New content is searchable once the search index is next refreshed.
+// @Tags Search
+// @Produce json
+// @Param q query string true "Search query"
+// @Param types query string false "Comma-separated result types to include: posts, news, tags (default: all)"
+// @Param limit query int false "Number of results per type (default: 5, max: 20)"
+// @Success 200 {object} models.SearchResponse "Results grouped by type"
+// @Failure 400 {object} models.ErrorResponse "Missing query or invalid type"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /search [get]
+func (h *Handler) Search(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeSearchQueryRequired))
+		return
+	}
+
+	types := []models.SearchResultType{models.SearchResultPost, models.SearchResultNews, models.SearchResultTag}
+	if param := c.Query("types"); param != "" {
+		types = nil
+		for _, name := range strings.Split(param, ",") {
+			resultType, ok := searchTypes[strings.ToLower(strings.TrimSpace(name))]
+			if !ok {
+				middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidSearchType))
+				return
+			}
+			types = append(types, resultType)
+		}
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "5"))
+	if err != nil || limit < 1 {
+		limit = 5
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	results, err := services.NewSearchService(h.dbFor(c)).Search(query, types, limit)
+	if err != nil {
+		log.Error().Err(err).Str("query", query).Msg("Search failed")
+		middleware.Abort(c, apierror.Internal(i18n.CodeSearchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,449 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// GetSeriesList godoc
+// @Summary Get post series
+// @Description Returns every series ordered by title, with the number of published posts in each
+// @Tags Series
+// @Produce json
+// @Success 200 {array} models.Series "List of series"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /series [get]
+func (h *Handler) GetSeriesList(c *gin.Context) {
+	series := []models.Series{}
+	if err := h.dbFor(c).Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Order("title ASC").Find(&series).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesFetchFailed, err))
+		return
+	}
+
+	var counts []struct {
+		SeriesID uint
+		Count    int64
+	}
+	if err := h.dbFor(c).Model(&models.Post{}).Select("series_id, COUNT(*) AS count").
+		Where("series_id IS NOT NULL AND status = ?", models.PostStatusPublished).
+		Group("series_id").Scan(&counts).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesFetchFailed, err))
+		return
+	}
+	byID := make(map[uint]int64, len(counts))
+	for _, count := range counts {
+		byID[count.SeriesID] = count.Count
+	}
+	for i := range series {
+		series[i].PostCount = byID[series[i].ID]
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// GetSeries godoc
+// @Summary Get a series by slug
+// @Description Returns a series with its published posts in series order
+// @Tags Series
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Success 200 {object} models.Series "Series with its posts"
+// @Failure 404 {object} models.ErrorResponse "Series not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /series/{slug} [get]
+func (h *Handler) GetSeries(c *gin.Context) {
+	series, err := loadSeries(h.dbFor(c).Where("slug = ?", c.Param("slug")))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeSeriesNotFound))
+		return
+	}
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// CreateSeries godoc
+// @Summary Create a series
+// @Description Creates a series owned by the current user. Add posts to it with POST /series/{id}/posts.
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param request body models.CreateSeriesRequest true "Series details"
+// @Success 201 {object} models.Series "Created series"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 409 {object} models.ErrorResponse "Slug already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /series [post]
+func (h *Handler) CreateSeries(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.CreateSeriesRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	series := models.Series{
+		Title:       strings.TrimSpace(requestBody.Title),
+		Slug:        h.slugs.Make(requestBody.Title),
+		Description: requestBody.Description,
+		UserID:      userID.(uint),
+	}
+	if requestBody.Slug != "" {
+		series.Slug = h.slugs.Make(requestBody.Slug)
+	}
+	if series.Slug == "" {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeSeriesSlugEmpty))
+		return
+	}
+	if h.seriesSlugTaken(c, series.Slug, 0) {
+		middleware.Abort(c, apierror.Conflict(i18n.CodeSeriesSlugTaken))
+		return
+	}
+
+	if err := h.dbFor(c).Create(&series).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesCreateFailed, err))
+		return
+	}
+
+	log.Info().Interface("user_id", userID).Str("slug", series.Slug).Msg("Series created")
+	c.JSON(http.StatusCreated, series)
+}
+
+// UpdateSeries godoc
+// @Summary Update a series
+// @Description Updates the title, slug or description of a series. Only its owner or an admin can update it.
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID or UUID"
+// @Param request body models.UpdateSeriesRequest true "Series changes"
+// @Success 200 {object} models.Series "Updated series"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Series not found"
+// @Failure 409 {object} models.ErrorResponse "Slug already exists"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /series/{id} [put]
+func (h *Handler) UpdateSeries(c *gin.Context) {
+	series, ok := h.ownedSeries(c)
+	if !ok {
+		return
+	}
+
+	var requestBody models.UpdateSeriesRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.Title != nil {
+		series.Title = strings.TrimSpace(*requestBody.Title)
+	}
+	if requestBody.Slug != nil {
+		series.Slug = h.slugs.Make(*requestBody.Slug)
+		if series.Slug == "" {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeSeriesSlugEmpty))
+			return
+		}
+		if h.seriesSlugTaken(c, series.Slug, series.ID) {
+			middleware.Abort(c, apierror.Conflict(i18n.CodeSeriesSlugTaken))
+			return
+		}
+	}
+	if requestBody.Description != nil {
+		series.Description = *requestBody.Description
+	}
+
+	if err := h.dbFor(c).Save(series).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesUpdateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, series)
+}
+
+// DeleteSeries godoc
+// @Summary Delete a series
+// @Description Deletes a series. Its posts are kept and no longer belong to a series. Only its owner or an admin can delete it.
+// @Tags Series
+// @Produce json
+// @Param id path string true "Series ID or UUID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Series not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /series/{id} [delete]
+func (h *Handler) DeleteSeries(c *gin.Context) {
+	series, ok := h.ownedSeries(c)
+	if !ok {
+		return
+	}
+
+	err := h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&models.Post{}).Where("series_id = ?", series.ID).
+			Updates(map[string]interface{}{"series_id": nil, "series_position": 0}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(series).Error
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesDeleteFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Series deleted successfully"})
+}
+
+// AddSeriesPost godoc
+// @Summary Add a post to a series
+// @Description Adds a post to a series at a position, moving the posts from that position on down by one. A post belongs to at most one series, so adding it moves it out of any other series; adding a post already in the series moves it to the new position. Only the series owner's posts can be added, by the owner or an admin.
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID or UUID"
+// @Param request body models.AddSeriesPostRequest true "Post and position"
+// @Success 200 {object} models.Series "Series with its published posts"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Series or post not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /series/{id}/posts [post]
+func (h *Handler) AddSeriesPost(c *gin.Context) {
+	series, ok := h.ownedSeries(c)
+	if !ok {
+		return
+	}
+
+	var requestBody models.AddSeriesPostRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	byID, err := resourceIDScope(requestBody.PostID)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return
+	}
+	post, err := h.postsFor(c).Find(byID)
+	if err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodePostNotFound))
+		return
+	}
+	if post.UserID != series.UserID {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodeSeriesPostForbidden))
+		return
+	}
+
+	err = h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		if err := removeFromSeries(tx, post); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.Post{}).Where("series_id = ?", series.ID).Count(&count).Error; err != nil {
+			return err
+		}
+		position := int(count) + 1
+		if requestBody.Position != nil && *requestBody.Position < position {
+			position = *requestBody.Position
+		}
+
+		if err := tx.Model(&models.Post{}).Where("series_id = ? AND series_position >= ?", series.ID, position).
+			UpdateColumn("series_position", gorm.Expr("series_position + 1")).Error; err != nil {
+			return err
+		}
+		return tx.Model(post).UpdateColumns(map[string]interface{}{"series_id": series.ID, "series_position": position}).Error
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesUpdateFailed, err))
+		return
+	}
+
+	log.Info().Uint("series_id", series.ID).Uint("post_id", post.ID).Msg("Post added to series")
+	h.respondWithSeries(c, series.ID)
+}
+
+// RemoveSeriesPost godoc
+// @Summary Remove a post from a series
+// @Description Removes a post from a series, moving the posts after it up by one. The post itself is kept. Only the series owner or an admin can remove posts.
+// @Tags Series
+// @Produce json
+// @Param id path string true "Series ID or UUID"
+// @Param post_id path string true "Post ID or UUID"
+// @Success 200 {object} models.Series "Series with its published posts"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Series not found or post not in the series"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /series/{id}/posts/{post_id} [delete]
+func (h *Handler) RemoveSeriesPost(c *gin.Context) {
+	series, ok := h.ownedSeries(c)
+	if !ok {
+		return
+	}
+
+	byID, err := resourceIDScope(c.Param("post_id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidPostID))
+		return
+	}
+	var post models.Post
+	if err := h.dbFor(c).Scopes(byID).Where("series_id = ?", series.ID).First(&post).Error; err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeSeriesPostNotFound))
+		return
+	}
+
+	if err := h.dbFor(c).Transaction(func(tx *gorm.DB) error {
+		return removeFromSeries(tx, &post)
+	}); err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesUpdateFailed, err))
+		return
+	}
+
+	h.respondWithSeries(c, series.ID)
+}
+
+// loadSeries loads the series matched by query with its published posts in
+// series order
+func loadSeries(query *gorm.DB) (*models.Series, error) {
+	var series models.Series
+	err := query.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Posts", func(db *gorm.DB) *gorm.DB {
+		return db.Where("status = ?", models.PostStatusPublished).Scopes(notExpired).Order("series_position ASC, id ASC")
+	}).Preload("Posts.User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Posts.Tags").Preload("Posts.Category").First(&series).Error
+	if err != nil {
+		return nil, err
+	}
+	series.PostCount = int64(len(series.Posts))
+	return &series, nil
+}
+
+// respondWithSeries sends the series after a change to its posts
+func (h *Handler) respondWithSeries(c *gin.Context, id uint) {
+	series, err := loadSeries(h.dbFor(c).Where("id = ?", id))
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSeriesFetchFailed, err))
+		return
+	}
+	c.JSON(http.StatusOK, series)
+}
+
+// ownedSeries loads the series in the id path parameter and checks that the
+// current user owns it or is an admin. It aborts the request and returns
+// false otherwise.
+func (h *Handler) ownedSeries(c *gin.Context) (*models.Series, bool) {
+	byID, err := resourceIDScope(c.Param("id"))
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidSeriesID))
+		return nil, false
+	}
+
+	var series models.Series
+	if err := h.dbFor(c).Scopes(byID).First(&series).Error; err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeSeriesNotFound))
+		return nil, false
+	}
+
+	if !can(c, policy.ActionSeriesManage, policy.Resource{OwnerID: series.UserID}) {
+		middleware.Abort(c, apierror.Forbidden(i18n.CodeSeriesForbidden))
+		return nil, false
+	}
+	return &series, true
+}
+
+// removeFromSeries takes post out of its series, if any, and closes the gap
+// it leaves
+func removeFromSeries(tx *gorm.DB, post *models.Post) error {
+	if post.SeriesID == nil {
+		return nil
+	}
+	if err := tx.Model(&models.Post{}).Where("series_id = ? AND series_position > ?", *post.SeriesID, post.SeriesPosition).
+		UpdateColumn("series_position", gorm.Expr("series_position - 1")).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(post).UpdateColumns(map[string]interface{}{"series_id": nil, "series_position": 0}).Error; err != nil {
+		return err
+	}
+	post.SeriesID = nil
+	post.SeriesPosition = 0
+	return nil
+}
+
+// seriesSlugTaken reports whether another series already uses slug
+func (h *Handler) seriesSlugTaken(c *gin.Context, slug string, exceptID uint) bool {
+	var count int64
+	h.dbFor(c).Model(&models.Series{}).Where("slug = ? AND id != ?", slug, exceptID).Count(&count)
+	return count > 0
+}
+
+// loadSeriesNavigation sets post.SeriesNav when the post belongs to a series.
+// The position and links count the series' published posts and the post
+// itself, so drafts shown in previews are placed too.
+func (h *Handler) loadSeriesNavigation(c *gin.Context, post *models.Post) {
+	if post.SeriesID == nil {
+		return
+	}
+
+	var series models.Series
+	if err := h.dbFor(c).Select("id, title, slug").First(&series, *post.SeriesID).Error; err != nil {
+		log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to load post series")
+		return
+	}
+
+	var posts []models.SeriesPostLink
+	if err := h.dbFor(c).Model(&models.Post{}).Select("id, uuid, title, slug").
+		Where("series_id = ? AND (status = ? OR id = ?)", series.ID, models.PostStatusPublished, post.ID).
+		Order("series_position ASC, id ASC").Scan(&posts).Error; err != nil {
+		log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to load series posts")
+		return
+	}
+
+	nav := &models.SeriesNavigation{ID: series.ID, Title: series.Title, Slug: series.Slug, Total: len(posts)}
+	for i := range posts {
+		if posts[i].ID != post.ID {
+			continue
+		}
+		nav.Position = i + 1
+		if i > 0 {
+			nav.Previous = &posts[i-1]
+		}
+		if i < len(posts)-1 {
+			nav.Next = &posts[i+1]
+		}
+	}
+	if post.Status != models.PostStatusPublished {
+		nav.Total--
+	}
+	post.SeriesNav = nav
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// GetSessions godoc
+// @Summary List your sessions
+// @Description Returns the devices the current user is signed in on, most recent sign-in first, with the user agent and IP address captured at sign-in. The session making the request is marked as current.
+// @Tags Users
+// @Produce json
+// @Success 200 {array} models.Session "Active sessions"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/sessions [get]
+func (h *Handler) GetSessions(c *gin.Context) {
+	userID, _ := c.Get("userID")
+	currentID := c.GetUint("sessionID")
+
+	var tokens []models.RefreshToken
+	if err := h.dbFor(c).Where("user_id = ? AND revoked = ? AND expires_at > ?", userID.(uint), false, time.Now()).
+		Order("issued_at DESC").Find(&tokens).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSessionsFetchFailed, err))
+		return
+	}
+
+	sessions := make([]models.Session, len(tokens))
+	for i, token := range tokens {
+		sessions[i] = models.Session{
+			ID:         token.ID,
+			UserAgent:  token.UserAgent,
+			IPAddress:  token.IPAddress,
+			IssuedAt:   token.IssuedAt,
+			LastUsedAt: token.LastUsedAt,
+			ExpiresAt:  token.ExpiresAt,
+			Current:    token.ID == currentID,
+		}
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// RevokeSession godoc
+// @Summary Sign out a session
+// @Description Revokes one of the current user's sessions, so the device can no longer refresh its access token. An access token it already holds keeps working until it expires.
+// @Tags Users
+// @Produce json
+// @Param id path int true "Session ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Session revoked"
+// @Failure 400 {object} models.ErrorResponse "Invalid session ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 404 {object} models.ErrorResponse "Session not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /profile/sessions/{id} [delete]
+func (h *Handler) RevokeSession(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidSessionID))
+		return
+	}
+
+	userID, _ := c.Get("userID")
+
+	result := h.dbFor(c).Model(&models.RefreshToken{}).
+		Where("id = ? AND user_id = ? AND revoked = ?", id, userID.(uint), false).
+		Update("revoked", true)
+	if result.Error != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeSessionRevokeFailed, result.Error))
+		return
+	}
+	if result.RowsAffected == 0 {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeSessionNotFound))
+		return
+	}
+
+	log.Info().Interface("user_id", userID).Uint64("session_id", id).Msg("Session revoked")
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Session revoked successfully"})
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetSites godoc
+// @Summary List sites
+// @Description Returns the blogs the deployment serves and the domains they are served on, the default site first (admin only)
+// @Tags Admin
+// @Produce json
+// @Success 200 {array} models.Site "Sites"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/sites [get]
+func (h *Handler) GetSites(c *gin.Context) {
+	sites, err := services.NewSiteService(h.dbFor(c)).All()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch sites")
+		middleware.Abort(c, apierror.Internal(i18n.CodeSitesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, sites)
+}
+
+// CreateSite godoc
+// @Summary Add a site
+// @Description Adds a blog served on its own domain. Requests for the domain see only the site's posts, tags, pages, news sources and post templates. Other instances serve the site within a minute (admin only).
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.CreateSiteRequest true "Site"
+// @Success 201 {object} models.Site "Created site"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 409 {object} models.ErrorResponse "Domain already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/sites [post]
+func (h *Handler) CreateSite(c *gin.Context) {
+	var requestBody models.CreateSiteRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	site, err := services.NewSiteService(h.dbFor(c)).Create(requestBody)
+	if err != nil {
+		abortSiteError(c, err, i18n.CodeSiteCreateFailed)
+		return
+	}
+
+	log.Info().Uint("site_id", site.ID).Str("domain", site.Domain).Msg("Site created")
+	h.recordAudit(c, models.AuditActionSiteCreated, "site", site.ID, nil, gin.H{"name": site.Name, "domain": site.Domain, "is_default": site.Default})
+	c.JSON(http.StatusCreated, site)
+}
+
+// UpdateSite godoc
+// @Summary Change a site
+// @Description Renames a site, moves it to another domain or makes it the default site, which serves hosts no other site is configured for (admin only).
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Site ID"
+// @Param request body models.UpdateSiteRequest true "Fields to change"
+// @Success 200 {object} models.Site "Updated site"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Site not found"
+// @Failure 409 {object} models.ErrorResponse "Domain already in use or default site unset"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/sites/{id} [put]
+func (h *Handler) UpdateSite(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidSiteID))
+		return
+	}
+
+	var requestBody models.UpdateSiteRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	sites := services.NewSiteService(h.dbFor(c))
+	before, err := sites.Get(uint(id))
+	if err != nil {
+		abortSiteError(c, err, i18n.CodeSiteUpdateFailed)
+		return
+	}
+	site, err := sites.Update(uint(id), requestBody)
+	if err != nil {
+		abortSiteError(c, err, i18n.CodeSiteUpdateFailed)
+		return
+	}
+
+	log.Info().Uint("site_id", site.ID).Str("domain", site.Domain).Msg("Site changed")
+	h.recordAudit(c, models.AuditActionSiteUpdated, "site", site.ID,
+		gin.H{"name": before.Name, "domain": before.Domain, "is_default": before.Default},
+		gin.H{"name": site.Name, "domain": site.Domain, "is_default": site.Default})
+	c.JSON(http.StatusOK, site)
+}
+
+// DeleteSite godoc
+// @Summary Delete a site
+// @Description Deletes a site without content. Its posts, tags, pages, news sources and post templates must be deleted first, and the default site can't be deleted (admin only).
+// @Tags Admin
+// @Produce json
+// @Param id path int true "Site ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid site ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Site not found"
+// @Failure 409 {object} models.ErrorResponse "Site is the default or still has content"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/sites/{id} [delete]
+func (h *Handler) DeleteSite(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidSiteID))
+		return
+	}
+
+	site, err := services.NewSiteService(h.dbFor(c)).Delete(uint(id))
+	if err != nil {
+		abortSiteError(c, err, i18n.CodeSiteDeleteFailed)
+		return
+	}
+
+	log.Info().Uint("site_id", site.ID).Str("domain", site.Domain).Msg("Site deleted")
+	h.recordAudit(c, models.AuditActionSiteDeleted, "site", site.ID, gin.H{"name": site.Name, "domain": site.Domain}, nil)
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Site deleted successfully"})
+}
+
+// abortSiteError maps site service errors to responses
+func abortSiteError(c *gin.Context, err error, failedCode string) {
+	switch {
+	case errors.Is(err, services.ErrSiteNotFound):
+		middleware.Abort(c, apierror.NotFound(i18n.CodeSiteNotFound))
+	case errors.Is(err, services.ErrInvalidSiteDomain):
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidSiteDomain))
+	case errors.Is(err, services.ErrSiteDomainTaken):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeSiteDomainTaken))
+	case errors.Is(err, services.ErrSiteIsDefault):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeSiteIsDefault))
+	case errors.Is(err, services.ErrSiteHasContent):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeSiteHasContent))
+	default:
+		log.Error().Err(err).Msg("Failed to save site")
+		middleware.Abort(c, apierror.Internal(failedCode, err))
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// GetSiteSettings godoc
+// @Summary List site settings
+// @Description Returns every site setting with its current value. Settings that were never changed show their default value.
+// @Tags Admin
+// @Produce json
+// @Success 200 {array} models.SiteSetting "Site settings"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/settings [get]
+func (h *Handler) GetSiteSettings(c *gin.Context) {
+	settings, err := services.NewSiteSettingsService(h.dbFor(c)).All()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch site settings")
+		middleware.Abort(c, apierror.Internal(i18n.CodeSettingsFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// UpdateSiteSetting godoc
+// @Summary Change a site setting
+// @Description Validates and stores a new value for a site setting. Changes take effect immediately.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param key path string true "Setting key"
+// @Param request body models.UpdateSiteSettingRequest true "New value"
+// @Success 200 {object} models.SiteSetting "Updated setting"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Unknown setting"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/settings/{key} [put]
+func (h *Handler) UpdateSiteSetting(c *gin.Context) {
+	var requestBody models.UpdateSiteSettingRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	key := c.Param("key")
+	settings := services.NewSiteSettingsService(h.dbFor(c))
+	previous := settings.Get(key)
+	setting, err := settings.Set(key, requestBody.Value)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrUnknownSetting):
+			middleware.Abort(c, apierror.NotFound(i18n.CodeSettingNotFound))
+		case errors.Is(err, services.ErrInvalidSettingValue):
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeSettingInvalidValue).WithMessage(err.Error()))
+		default:
+			log.Error().Err(err).Str("key", key).Msg("Failed to update site setting")
+			middleware.Abort(c, apierror.Internal(i18n.CodeSettingUpdateFailed, err))
+		}
+		return
+	}
+
+	log.Info().Str("key", key).Str("value", setting.Value).Msg("Site setting changed")
+	h.recordAudit(c, models.AuditActionSettingUpdated, "setting", key, gin.H{"value": previous}, gin.H{"value": setting.Value})
+	c.JSON(http.StatusOK, setting)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+)
+
+// redirectToCurrentSlug answers a request for a slug a resource used before
+// with 301 Moved Permanently, pointing at the same path with the resource's
+// current slug. currentSlug loads that slug by ID and fails for resources the
+// caller can't see. It reports whether it responded.
+func (h *Handler) redirectToCurrentSlug(c *gin.Context, resourceType string, currentSlug func(id uint) (string, error)) bool {
+	slug := c.Param("slug")
+	id, err := services.NewSlugHistoryService(h.dbFor(c)).Resolve(resourceType, slug)
+	if err != nil {
+		return false
+	}
+
+	current, err := currentSlug(id)
+	if err != nil || current == slug {
+		return false
+	}
+
+	location := path.Join(path.Dir(c.Request.URL.Path), url.PathEscape(current))
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+	c.Header("Location", location)
+	c.JSON(http.StatusMovedPermanently, models.SlugRedirect{Slug: current, Location: location})
+	return true
+}
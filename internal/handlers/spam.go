@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// enforceCommentCooldown aborts with 429 and a Retry-After header when the
+// user commented too recently. It returns false if the request was aborted.
+func (h *Handler) enforceCommentCooldown(c *gin.Context, spam *services.SpamService, userID uint, level models.TrustLevel) bool {
+	wait, err := spam.CommentCooldown(userID, level)
+	if err != nil {
+		log.Error().Err(err).Uint("user_id", userID).Msg("Failed to check comment cooldown")
+		middleware.Abort(c, apierror.Internal(i18n.CodeTrustCheckFailed, err))
+		return false
+	}
+	if wait <= 0 {
+		return true
+	}
+
+	retryAfter := int(math.Ceil(wait.Seconds()))
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	middleware.Abort(c, apierror.New(http.StatusTooManyRequests, i18n.CodeCommentCooldown).WithDetails(gin.H{
+		"retry_after": retryAfter,
+	}))
+	return false
+}
+
+// spamCheck collects what the spam checks need to know about a new comment
+func (h *Handler) spamCheck(c *gin.Context, request *models.CreateCommentRequest, post *models.Post, userID uint, level models.TrustLevel) services.SpamCheck {
+	check := services.SpamCheck{
+		Content:   request.Content,
+		Honeypot:  request.Website,
+		Level:     level,
+		IP:        middleware.ClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+		Referrer:  c.Request.Referer(),
+	}
+	if h.cfg.Spam.AkismetSiteURL != "" {
+		check.Permalink = h.cfg.Spam.AkismetSiteURL + "/posts/" + post.Slug
+	}
+	if user, err := h.usersFor(c).FindByID(userID); err == nil {
+		check.AuthorName = user.Username
+		check.AuthorEmail = user.Email
+	}
+	return check
+}
@@ -0,0 +1,343 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"github.com/rs/zerolog/log"
+)
+
+// publicStatsTTL is how long public stats are served from cache
+const publicStatsTTL = 10 * time.Minute
+
+// publicStatsCache keeps the last computed public stats of each site in memory
+var publicStatsCache struct {
+	sync.Mutex
+	stats map[uint]*models.PublicStats
+}
+
+const (
+	// adminStatsTTL is how long admin dashboard stats are served from cache
+	adminStatsTTL = 5 * time.Minute
+	// adminStatsMonths and adminStatsDays are the lengths of the time series
+	adminStatsMonths = 12
+	adminStatsDays   = 30
+	// adminStatsTopTags is how many tags the dashboard lists
+	adminStatsTopTags = 10
+)
+
+// adminStatsCache keeps the last computed admin stats of each site in memory
+var adminStatsCache struct {
+	sync.Mutex
+	stats map[uint]*models.AdminStats
+}
+
+// GetPublicStats godoc
+// @Summary Get public site statistics
+// @Description Returns non-sensitive counters (posts, comments, views, years blogging) for public widgets. Results are cached for 10 minutes.
+// @Tags Stats
+// @Produce json
+// @Success 200 {object} models.PublicStats "Public site statistics"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Router /stats/public [get]
+func (h *Handler) GetPublicStats(c *gin.Context) {
+	siteID, _ := site.IDFromContext(c.Request.Context())
+
+	publicStatsCache.Lock()
+	defer publicStatsCache.Unlock()
+
+	stats := publicStatsCache.stats[siteID]
+	if stats == nil || time.Now().After(stats.GeneratedAt.Add(publicStatsTTL)) {
+		var err error
+		if stats, err = h.computePublicStats(c); err != nil {
+			log.Error().Err(err).Msg("Failed to compute public stats")
+			middleware.Abort(c, apierror.Internal(i18n.CodeStatsFetchFailed, err))
+			return
+		}
+		if publicStatsCache.stats == nil {
+			publicStatsCache.stats = make(map[uint]*models.PublicStats)
+		}
+		publicStatsCache.stats[siteID] = stats
+	}
+
+	maxAge := int(time.Until(stats.GeneratedAt.Add(publicStatsTTL)).Seconds())
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", max(maxAge, 0)))
+	c.JSON(http.StatusOK, stats)
+}
+
+// computePublicStats gathers the public counters from published content only
+func (h *Handler) computePublicStats(c *gin.Context) (*models.PublicStats, error) {
+	var postStats struct {
+		Total     int64
+		Views     int64
+		FirstPost *time.Time
+	}
+	if err := h.dbFor(c).Model(&models.Post{}).
+		Select("COUNT(*) AS total, COALESCE(SUM(view_count), 0) AS views, MIN(created_at) AS first_post").
+		Where("status = ?", models.PostStatusPublished).
+		Scan(&postStats).Error; err != nil {
+		return nil, fmt.Errorf("failed to count posts: %w", err)
+	}
+
+	// Comments have no site of their own, so the joined posts are scoped
+	// by hand
+	comments := h.dbFor(c).Model(&models.Comment{}).
+		Joins("JOIN posts ON posts.id = comments.post_id AND posts.deleted_at IS NULL").
+		Where("posts.status = ? AND comments.status = ?", models.PostStatusPublished, models.CommentStatusApproved)
+	if siteID, ok := site.IDFromContext(c.Request.Context()); ok {
+		comments = comments.Where("posts.site_id = ?", siteID)
+	}
+	var totalComments int64
+	if err := comments.Count(&totalComments).Error; err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	now := time.Now()
+	stats := &models.PublicStats{
+		TotalPosts:    postStats.Total,
+		TotalComments: totalComments,
+		TotalViews:    postStats.Views,
+		BloggingSince: postStats.FirstPost,
+		GeneratedAt:   now,
+	}
+	if postStats.FirstPost != nil {
+		stats.YearsBlogging = fullYearsBetween(*postStats.FirstPost, now)
+	}
+
+	return stats, nil
+}
+
+// fullYearsBetween returns the number of complete years between two times
+func fullYearsBetween(from, to time.Time) int {
+	years := to.Year() - from.Year()
+	if to.YearDay() < from.YearDay() {
+		years--
+	}
+	return max(years, 0)
+}
+
+// GetAdminStats godoc
+// @Summary Get admin dashboard statistics
+// @Description Returns overall counters and time series (posts per month, comments and new users per day, news fetched and saved per day, top tags) for the admin dashboard. Results are cached for 5 minutes.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} models.AdminStats "Admin dashboard statistics"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/stats [get]
+func (h *Handler) GetAdminStats(c *gin.Context) {
+	siteID, _ := site.IDFromContext(c.Request.Context())
+
+	adminStatsCache.Lock()
+	defer adminStatsCache.Unlock()
+
+	stats := adminStatsCache.stats[siteID]
+	if stats == nil || time.Now().After(stats.GeneratedAt.Add(adminStatsTTL)) {
+		var err error
+		if stats, err = h.computeAdminStats(c); err != nil {
+			log.Error().Err(err).Msg("Failed to compute admin stats")
+			middleware.Abort(c, apierror.Internal(i18n.CodeStatsFetchFailed, err))
+			return
+		}
+		if adminStatsCache.stats == nil {
+			adminStatsCache.stats = make(map[uint]*models.AdminStats)
+		}
+		adminStatsCache.stats[siteID] = stats
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// computeAdminStats gathers the dashboard counters with one grouped query per
+// table and series
+func (h *Handler) computeAdminStats(c *gin.Context) (*models.AdminStats, error) {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	firstMonth := time.Date(now.Year(), now.Month()-adminStatsMonths+1, 1, 0, 0, 0, 0, time.UTC)
+	firstDay := today.AddDate(0, 0, -adminStatsDays+1)
+
+	stats := &models.AdminStats{GeneratedAt: now}
+
+	var err error
+	if stats.Totals, err = h.adminStatsTotals(c); err != nil {
+		return nil, err
+	}
+
+	postsPerMonth, err := h.countByPeriod(c, &models.Post{}, "month", firstMonth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count posts per month: %w", err)
+	}
+	stats.PostsPerMonth = statsSeries(postsPerMonth, firstMonth, adminStatsMonths, "month")
+
+	commentsPerDay, err := h.countByPeriod(c, &models.Comment{}, "day", firstDay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments per day: %w", err)
+	}
+	stats.CommentsPerDay = statsSeries(commentsPerDay, firstDay, adminStatsDays, "day")
+
+	usersPerDay, err := h.countByPeriod(c, &models.User{}, "day", firstDay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count new users per day: %w", err)
+	}
+	stats.NewUsersPerDay = statsSeries(usersPerDay, firstDay, adminStatsDays, "day")
+
+	if stats.NewsPerDay, err = h.ingestionPerDay(c, firstDay); err != nil {
+		return nil, fmt.Errorf("failed to count ingested news per day: %w", err)
+	}
+
+	stats.TopTags = []models.TagWithCount{}
+	if err := h.dbFor(c).Model(&models.Tag{}).
+		Select("tags.id, tags.name, COUNT(DISTINCT post_tags.post_id) AS post_count").
+		Joins("JOIN post_tags ON post_tags.tag_id = tags.id").
+		Group("tags.id").
+		Order("post_count DESC, tags.name").
+		Limit(adminStatsTopTags).
+		Scan(&stats.TopTags).Error; err != nil {
+		return nil, fmt.Errorf("failed to count top tags: %w", err)
+	}
+
+	return stats, nil
+}
+
+// adminStatsTotals counts posts and comments per status and the other tables
+func (h *Handler) adminStatsTotals(c *gin.Context) (models.AdminStatsTotals, error) {
+	totals := models.AdminStatsTotals{
+		PostsByStatus:    map[string]int64{},
+		CommentsByStatus: map[string]int64{},
+	}
+
+	var postRows []struct {
+		Status string
+		Total  int64
+		Views  int64
+	}
+	if err := h.dbFor(c).Model(&models.Post{}).
+		Select("status, COUNT(*) AS total, COALESCE(SUM(view_count), 0) AS views").
+		Group("status").
+		Scan(&postRows).Error; err != nil {
+		return totals, fmt.Errorf("failed to count posts: %w", err)
+	}
+	for _, row := range postRows {
+		totals.PostsByStatus[row.Status] = row.Total
+		totals.Posts += row.Total
+		totals.PostViews += row.Views
+	}
+
+	var commentRows []struct {
+		Status string
+		Total  int64
+	}
+	if err := h.dbFor(c).Model(&models.Comment{}).
+		Select("status, COUNT(*) AS total").
+		Group("status").
+		Scan(&commentRows).Error; err != nil {
+		return totals, fmt.Errorf("failed to count comments: %w", err)
+	}
+	for _, row := range commentRows {
+		totals.CommentsByStatus[row.Status] = row.Total
+		totals.Comments += row.Total
+	}
+
+	counts := []struct {
+		model interface{}
+		dest  *int64
+		name  string
+	}{
+		{&models.User{}, &totals.Users, "users"},
+		{&models.News{}, &totals.News, "news"},
+		{&models.Tag{}, &totals.Tags, "tags"},
+	}
+	for _, count := range counts {
+		if err := h.dbFor(c).Model(count.model).Count(count.dest).Error; err != nil {
+			return totals, fmt.Errorf("failed to count %s: %w", count.name, err)
+		}
+	}
+
+	return totals, nil
+}
+
+// countByPeriod counts the rows of model created since from per day or month.
+// unit is one of the constants "day" or "month", never user input.
+func (h *Handler) countByPeriod(c *gin.Context, model interface{}, unit string, from time.Time) (map[string]int64, error) {
+	var rows []struct {
+		Period time.Time
+		Total  int64
+	}
+	if err := h.dbFor(c).Model(model).
+		Select("date_trunc('"+unit+"', created_at AT TIME ZONE 'UTC') AS period, COUNT(*) AS total").
+		Where("created_at >= ?", from).
+		Group("period").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[statsPeriod(row.Period, unit)] = row.Total
+	}
+	return counts, nil
+}
+
+// ingestionPerDay sums the articles fetched and saved by ingestion runs per day
+func (h *Handler) ingestionPerDay(c *gin.Context, from time.Time) ([]models.IngestionStatsPoint, error) {
+	var rows []struct {
+		Period  time.Time
+		Fetched int64
+		Saved   int64
+	}
+	if err := h.dbFor(c).Model(&models.IngestionRun{}).
+		Select("date_trunc('day', started_at AT TIME ZONE 'UTC') AS period, "+
+			"COALESCE(SUM(items_seen), 0) AS fetched, COALESCE(SUM(items_saved), 0) AS saved").
+		Where("started_at >= ?", from).
+		Group("period").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	byDay := make(map[string]models.IngestionStatsPoint, len(rows))
+	for _, row := range rows {
+		period := statsPeriod(row.Period, "day")
+		byDay[period] = models.IngestionStatsPoint{Period: period, Fetched: row.Fetched, Saved: row.Saved}
+	}
+
+	points := make([]models.IngestionStatsPoint, adminStatsDays)
+	for i := range points {
+		period := statsPeriod(from.AddDate(0, 0, i), "day")
+		points[i] = byDay[period]
+		points[i].Period = period
+	}
+	return points, nil
+}
+
+// statsSeries lists n consecutive days or months starting at from, with zero
+// for the periods that have no count
+func statsSeries(counts map[string]int64, from time.Time, n int, unit string) []models.StatsPoint {
+	points := make([]models.StatsPoint, n)
+	for i := range points {
+		t := from.AddDate(0, 0, i)
+		if unit == "month" {
+			t = from.AddDate(0, i, 0)
+		}
+		period := statsPeriod(t, unit)
+		points[i] = models.StatsPoint{Period: period, Count: counts[period]}
+	}
+	return points
+}
+
+// statsPeriod formats the day or month t falls in
+func statsPeriod(t time.Time, unit string) string {
+	if unit == "month" {
+		return t.Format("2006-01")
+	}
+	return t.Format("2006-01-02")
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -8,11 +9,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/phanvantai/taiphanvan_backend/docs"
+	"github.com/phanvantai/taiphanvan_backend/internal/routes"
 	"github.com/rs/zerolog/log"
 )
 
 // SwaggerDocHandler handles the Swagger doc.json endpoint to ensure template variables are replaced
-func SwaggerDocHandler(c *gin.Context) {
+func (h *Handler) SwaggerDocHandler(c *gin.Context) {
 	// Determine if we're running in Railway or other production environment
 	isProduction := os.Getenv("RAILWAY_SERVICE_ID") != "" || os.Getenv("PRODUCTION") == "true"
 
@@ -34,7 +36,7 @@ func SwaggerDocHandler(c *gin.Context) {
 
 	// Set the Swagger info
 	docs.SwaggerInfo.Host = host
-	docs.SwaggerInfo.BasePath = "/api"
+	docs.SwaggerInfo.BasePath = routes.BasePath
 
 	// Set the scheme based on environment
 	if isProduction || strings.HasPrefix(host, "api.taiphanvan.dev") {
@@ -48,17 +50,112 @@ func SwaggerDocHandler(c *gin.Context) {
 
 	// Replace any remaining template variables
 	doc = strings.ReplaceAll(doc, "\"host\": \"{{.Host}}\"", fmt.Sprintf("\"host\": \"%s\"", host))
-	doc = strings.ReplaceAll(doc, "\"basePath\": \"{{.BasePath}}\"", "\"basePath\": \"/api\"")
+	doc = strings.ReplaceAll(doc, "\"basePath\": \"{{.BasePath}}\"", "\"basePath\": \""+routes.BasePath+"\"")
 
 	// Also replace any other occurrences of template variables in the document
-	doc = strings.ReplaceAll(doc, "http://{{.Host}}{{.BasePath}}", fmt.Sprintf("http://%s/api", host))
-	doc = strings.ReplaceAll(doc, "https://{{.Host}}{{.BasePath}}", fmt.Sprintf("https://%s/api", host))
+	doc = strings.ReplaceAll(doc, "http://{{.Host}}{{.BasePath}}", fmt.Sprintf("http://%s%s", host, routes.BasePath))
+	doc = strings.ReplaceAll(doc, "https://{{.Host}}{{.BasePath}}", fmt.Sprintf("https://%s%s", host, routes.BasePath))
+
+	// Attach the examples generated from the model fixtures
+	doc = applyGeneratedExamples(doc)
+
+	// Describe each operation's access, rate limit and caching from the route table
+	doc = applyRouteMetadata(doc)
 
 	log.Info().
 		Str("host", host).
-		Str("basePath", "/api").
+		Str("basePath", routes.BasePath).
 		Msg("Serving Swagger documentation with replaced template variables")
 
 	c.Header("Content-Type", "application/json")
 	c.String(http.StatusOK, doc)
 }
+
+// applyGeneratedExamples sets the example of each definition that has a fixture in
+// docs.Examples, replacing the examples assembled from struct tags
+func applyGeneratedExamples(doc string) string {
+	var spec map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(doc), &spec); err != nil {
+		log.Warn().Err(err).Msg("Failed to parse Swagger doc, serving it without generated examples")
+		return doc
+	}
+
+	var definitions map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(spec["definitions"], &definitions); err != nil {
+		log.Warn().Err(err).Msg("Failed to parse Swagger definitions, serving them without generated examples")
+		return doc
+	}
+
+	for name, example := range docs.Examples {
+		if definition, ok := definitions[name]; ok {
+			definition["example"] = json.RawMessage(example)
+		}
+	}
+
+	encoded, err := json.Marshal(definitions)
+	if err != nil {
+		return doc
+	}
+	spec["definitions"] = encoded
+
+	merged, err := json.MarshalIndent(spec, "", "    ")
+	if err != nil {
+		return doc
+	}
+	return string(merged)
+}
+
+// applyRouteMetadata adds the access, rate limit, Cache-Control and
+// conditional response support of each registered route to its Swagger
+// operation as x-access, x-rate-limit, x-cache-control and x-conditional
+// extensions, so the document can't drift from the router.
+// Endpoints that accept an API key are marked as such.
+func applyRouteMetadata(doc string) string {
+	var spec map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(doc), &spec); err != nil {
+		log.Warn().Err(err).Msg("Failed to parse Swagger doc, serving it without route metadata")
+		return doc
+	}
+
+	var paths map[string]map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(spec["paths"], &paths); err != nil {
+		log.Warn().Err(err).Msg("Failed to parse Swagger paths, serving them without route metadata")
+		return doc
+	}
+
+	for _, route := range routes.All() {
+		operation, ok := paths[routes.SwaggerPath(route.Path)][strings.ToLower(route.Method)]
+		if !ok {
+			log.Debug().Str("method", route.Method).Str("path", route.Path).Msg("Route has no Swagger documentation")
+			continue
+		}
+		operation["x-access"], _ = json.Marshal(route.Access)
+		switch {
+		case route.Access == routes.AccessOptional:
+			// The empty requirement allows anonymous requests
+			operation["security"] = json.RawMessage(`[{},{"BearerAuth":[]},{"ApiKeyAuth":[]}]`)
+		case route.AcceptsAPIKey():
+			// Either scheme authenticates the request
+			operation["security"] = json.RawMessage(`[{"BearerAuth":[]},{"ApiKeyAuth":[]}]`)
+		}
+		operation["x-rate-limit"], _ = json.Marshal(route.RateLimit)
+		if route.Cache != "" {
+			operation["x-cache-control"], _ = json.Marshal(route.Cache)
+		}
+		if route.Conditional {
+			operation["x-conditional"] = json.RawMessage("true")
+		}
+	}
+
+	encoded, err := json.Marshal(paths)
+	if err != nil {
+		return doc
+	}
+	spec["paths"] = encoded
+
+	merged, err := json.MarshalIndent(spec, "", "    ")
+	if err != nil {
+		return doc
+	}
+	return string(merged)
+}
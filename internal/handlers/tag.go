@@ -1,11 +1,17 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
 )
 
 // GetAllTags godoc
@@ -14,12 +20,12 @@ import (
 // @Tags Tags
 // @Produce json
 // @Success 200 {array} models.TagWithCount "List of tags with post counts"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /tags [get]
-func GetAllTags(c *gin.Context) {
+func (h *Handler) GetAllTags(c *gin.Context) {
 	var tagsWithCount []models.TagWithCount
 
-	rows, err := database.DB.Table("tags").
+	rows, err := h.dbFor(c).Model(&models.Tag{}).
 		Select("tags.id, tags.name, COUNT(DISTINCT post_tags.post_id) as post_count").
 		Joins("LEFT JOIN post_tags ON post_tags.tag_id = tags.id").
 		Group("tags.id").
@@ -27,15 +33,15 @@ func GetAllTags(c *gin.Context) {
 		Rows()
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tags"})
+		middleware.Abort(c, apierror.Internal(i18n.CodeTagsFetchFailed, err))
 		return
 	}
 	defer rows.Close()
 
 	for rows.Next() {
 		var tag models.TagWithCount
-		if err := database.DB.ScanRows(rows, &tag); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan tags"})
+		if err := h.dbFor(c).ScanRows(rows, &tag); err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeTagsFetchFailed, err))
 			return
 		}
 		tagsWithCount = append(tagsWithCount, tag)
@@ -50,9 +56,9 @@ func GetAllTags(c *gin.Context) {
 // @Tags Tags
 // @Produce json
 // @Success 200 {array} models.TagWithCount "List of popular tags with post counts"
-// @Failure 500 {object} models.SwaggerStandardResponse "Server error"
+// @Failure 500 {object} models.ErrorResponse "Server error"
 // @Router /tags/popular [get]
-func GetPopularTags(c *gin.Context) {
+func (h *Handler) GetPopularTags(c *gin.Context) {
 	limit := 10 // Default limit
 
 	type TagWithCount struct {
@@ -63,7 +69,7 @@ func GetPopularTags(c *gin.Context) {
 
 	var tagsWithCount []TagWithCount
 
-	rows, err := database.DB.Table("tags").
+	rows, err := h.dbFor(c).Model(&models.Tag{}).
 		Select("tags.id, tags.name, COUNT(DISTINCT post_tags.post_id) as post_count").
 		Joins("LEFT JOIN post_tags ON post_tags.tag_id = tags.id").
 		Group("tags.id").
@@ -72,15 +78,15 @@ func GetPopularTags(c *gin.Context) {
 		Rows()
 
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch popular tags"})
+		middleware.Abort(c, apierror.Internal(i18n.CodeTagsFetchFailed, err))
 		return
 	}
 	defer rows.Close()
 
 	for rows.Next() {
 		var tag TagWithCount
-		if err := database.DB.ScanRows(rows, &tag); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan tags"})
+		if err := h.dbFor(c).ScanRows(rows, &tag); err != nil {
+			middleware.Abort(c, apierror.Internal(i18n.CodeTagsFetchFailed, err))
 			return
 		}
 		tagsWithCount = append(tagsWithCount, tag)
@@ -88,3 +94,157 @@ func GetPopularTags(c *gin.Context) {
 
 	c.JSON(http.StatusOK, tagsWithCount)
 }
+
+// RenameTag godoc
+// @Summary Rename a tag
+// @Description Renames a tag. Posts and news keep the tag, and ?tag= filters use the new name. A name another tag already has is refused; merge the tags instead (admin only).
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param request body models.RenameTagRequest true "New name"
+// @Success 200 {object} models.TagWithCount "Renamed tag"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Tag not found"
+// @Failure 409 {object} models.ErrorResponse "Name already in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/tags/{id} [put]
+func (h *Handler) RenameTag(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidTagID))
+		return
+	}
+
+	var requestBody models.RenameTagRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	tags := services.NewTagService(h.dbFor(c))
+	tag, oldName, err := tags.Rename(uint(id), requestBody.Name)
+	if err != nil {
+		abortTagError(c, err, i18n.CodeTagRenameFailed)
+		return
+	}
+
+	h.recordAudit(c, models.AuditActionTagRenamed, "tag", tag.ID, gin.H{"name": oldName}, gin.H{"name": tag.Name})
+	log.Info().Uint("id", tag.ID).Str("from", oldName).Str("to", tag.Name).Msg("Tag renamed")
+	h.respondTag(c, tags, *tag, i18n.CodeTagRenameFailed)
+}
+
+// MergeTag godoc
+// @Summary Merge a tag into another
+// @Description Moves every post and news article tagged with the tag to the tag into_id, then deletes the tag (admin only)
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Param id path int true "ID of the tag to merge"
+// @Param request body models.MergeTagRequest true "Tag to merge into"
+// @Success 200 {object} models.TagWithCount "Tag that was merged into"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Tag not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/tags/{id}/merge [post]
+func (h *Handler) MergeTag(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidTagID))
+		return
+	}
+
+	var requestBody models.MergeTagRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	tags := services.NewTagService(h.dbFor(c))
+	source, err := tags.Find(uint(id))
+	if err != nil {
+		abortTagError(c, err, i18n.CodeTagMergeFailed)
+		return
+	}
+
+	target, err := tags.Merge(source.ID, requestBody.IntoID)
+	if err != nil {
+		abortTagError(c, err, i18n.CodeTagMergeFailed)
+		return
+	}
+
+	h.recordAudit(c, models.AuditActionTagMerged, "tag", source.ID, gin.H{
+		"name": source.Name,
+	}, gin.H{
+		"into_id":   target.ID,
+		"into_name": target.Name,
+	})
+	log.Info().Uint("id", source.ID).Uint("into_id", target.ID).Msg("Tag merged")
+	h.respondTag(c, tags, *target, i18n.CodeTagMergeFailed)
+}
+
+// DeleteTag godoc
+// @Summary Delete a tag
+// @Description Deletes a tag that no post or news article uses. Tags in use are refused; merge them into another tag instead (admin only).
+// @Tags Tags
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Tag deleted"
+// @Failure 400 {object} models.ErrorResponse "Invalid tag ID"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Tag not found"
+// @Failure 409 {object} models.ErrorResponse "Tag in use"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/tags/{id} [delete]
+func (h *Handler) DeleteTag(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidTagID))
+		return
+	}
+
+	tag, err := services.NewTagService(h.dbFor(c)).Delete(uint(id))
+	if err != nil {
+		abortTagError(c, err, i18n.CodeTagDeleteFailed)
+		return
+	}
+
+	h.recordAudit(c, models.AuditActionTagDeleted, "tag", tag.ID, gin.H{"name": tag.Name}, nil)
+	log.Info().Uint("id", tag.ID).Str("name", tag.Name).Msg("Tag deleted")
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Tag deleted successfully"})
+}
+
+// respondTag writes the tag with its post count
+func (h *Handler) respondTag(c *gin.Context, tags *services.TagService, tag models.Tag, failedCode string) {
+	result, err := tags.WithCount(tag)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(failedCode, err))
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// abortTagError maps tag service errors to responses
+func abortTagError(c *gin.Context, err error, failedCode string) {
+	switch {
+	case errors.Is(err, services.ErrTagNotFound):
+		middleware.Abort(c, apierror.NotFound(i18n.CodeTagNotFound))
+	case errors.Is(err, services.ErrTagNameTaken):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeTagNameTaken))
+	case errors.Is(err, services.ErrTagMergeSelf):
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeTagMergeSelf))
+	case errors.Is(err, services.ErrTagInUse):
+		middleware.Abort(c, apierror.Conflict(i18n.CodeTagInUse))
+	default:
+		log.Error().Err(err).Msg("Failed to change tag")
+		middleware.Abort(c, apierror.Internal(failedCode, err))
+	}
+}
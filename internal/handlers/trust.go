@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// enforceDailyLimit checks the user's daily quota for action and returns their
+// trust level. It reports the error and returns false if the request must stop.
+func (h *Handler) enforceDailyLimit(c *gin.Context, userID uint, action string) (models.TrustLevel, bool) {
+	user, err := h.usersFor(c).FindByID(userID)
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeTrustCheckFailed, err))
+		return "", false
+	}
+
+	level, err := services.NewTrustService(h.dbFor(c)).CheckDailyLimit(user, action)
+	if err != nil {
+		var limitErr *services.DailyLimitError
+		if errors.As(err, &limitErr) {
+			code := i18n.CodeDailyCommentLimitReached
+			if action == services.TrustActionPost {
+				code = i18n.CodeDailyPostLimitReached
+			}
+			log.Info().Uint("user_id", userID).Str("action", action).Str("trust_level", string(limitErr.Level)).Msg("Daily limit reached")
+			middleware.Abort(c, apierror.New(http.StatusTooManyRequests, code).WithDetails(gin.H{
+				"limit":       limitErr.Limit,
+				"trust_level": limitErr.Level,
+			}))
+			return "", false
+		}
+
+		log.Error().Err(err).Uint("user_id", userID).Msg("Failed to check daily limit")
+		middleware.Abort(c, apierror.Internal(i18n.CodeTrustCheckFailed, err))
+		return "", false
+	}
+
+	return level, true
+}
+
+// commentStatusFor returns the status of a comment the user wrote or edited,
+// holding it for moderation if the user's trust level requires it
+func (h *Handler) commentStatusFor(c *gin.Context, userID uint, content string) (models.CommentStatus, error) {
+	user, err := h.usersFor(c).FindByID(userID)
+	if err != nil {
+		return "", err
+	}
+
+	level, err := services.NewTrustService(h.dbFor(c)).Level(user)
+	if err != nil {
+		return "", err
+	}
+
+	if services.NeedsModeration(level, content) {
+		return models.CommentStatusPending, nil
+	}
+	return models.CommentStatusApproved, nil
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/version"
+)
+
+// GetVersion godoc
+// @Summary Get API build information
+// @Description Returns the commit and build time of the instance serving the request, and whether it is a canary
+// @Tags System
+// @Produce json
+// @Success 200 {object} models.VersionInfo "Build information"
+// @Router /version [get]
+func (h *Handler) GetVersion(c *gin.Context) {
+	c.JSON(http.StatusOK, models.VersionInfo{
+		GitSHA:    version.GitSHA,
+		BuildTime: version.BuildTime,
+		GoVersion: version.GoVersion(),
+		Canary:    h.cfg != nil && h.cfg.Server.Canary,
+	})
+}
@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// dispatchWebhookEvent notifies subscribed webhooks about a content event in the background
+func (h *Handler) dispatchWebhookEvent(event string, data interface{}) {
+	services.NewWebhookService(h.db, h.cfg.Webhooks).Dispatch(event, data)
+}
+
+// validWebhookEvents reports whether every event in events can be subscribed to
+func validWebhookEvents(events []string) bool {
+	for _, event := range events {
+		valid := event == models.WebhookEventAll
+		for _, known := range models.WebhookEvents {
+			if event == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return false
+		}
+	}
+	return true
+}
+
+// GetWebhooks godoc
+// @Summary List webhooks
+// @Description Returns all registered webhooks
+// @Tags Webhooks
+// @Produce json
+// @Success 200 {array} models.Webhook "List of webhooks"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/webhooks [get]
+func (h *Handler) GetWebhooks(c *gin.Context) {
+	webhooks := []models.Webhook{}
+	if err := h.dbFor(c).Order("created_at DESC").Find(&webhooks).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhooksFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, webhooks)
+}
+
+// CreateWebhook godoc
+// @Summary Register a webhook
+// @Description Registers a URL to be called on content events. The signing secret is only returned in this response.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param request body models.CreateWebhookRequest true "Webhook details"
+// @Success 201 {object} models.WebhookWithSecret "Created webhook with its signing secret"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/webhooks [post]
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	userID, _ := c.Get("userID")
+
+	var requestBody models.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if !validWebhookEvents(requestBody.Events) {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeWebhookEventUnknown).WithDetails(gin.H{"allowed_events": models.WebhookEvents}))
+		return
+	}
+
+	secret, err := services.GenerateWebhookSecret()
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookCreateFailed, err))
+		return
+	}
+
+	webhook := models.Webhook{
+		URL:         requestBody.URL,
+		Description: requestBody.Description,
+		Events:      requestBody.Events,
+		Secret:      secret,
+		Active:      true,
+		CreatedBy:   userID.(uint),
+	}
+	if requestBody.Active != nil {
+		webhook.Active = *requestBody.Active
+	}
+
+	if err := h.dbFor(c).Create(&webhook).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookCreateFailed, err))
+		return
+	}
+
+	log.Info().Uint("id", webhook.ID).Str("url", webhook.URL).Strs("events", webhook.Events).Msg("Webhook registered")
+	c.JSON(http.StatusCreated, models.WebhookWithSecret{Webhook: webhook, Secret: secret})
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Updates a webhook's URL, description, events, or active flag
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param request body models.UpdateWebhookRequest true "Webhook changes"
+// @Success 200 {object} models.Webhook "Updated webhook"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Webhook not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/webhooks/{id} [put]
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	webhook, ok := h.findWebhook(c)
+	if !ok {
+		return
+	}
+
+	var requestBody models.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		middleware.Abort(c, apierror.Validation(err))
+		return
+	}
+
+	if requestBody.URL != nil {
+		webhook.URL = *requestBody.URL
+	}
+	if requestBody.Description != nil {
+		webhook.Description = *requestBody.Description
+	}
+	if requestBody.Events != nil {
+		if len(requestBody.Events) == 0 || !validWebhookEvents(requestBody.Events) {
+			middleware.Abort(c, apierror.BadRequest(i18n.CodeWebhookEventUnknown).WithDetails(gin.H{"allowed_events": models.WebhookEvents}))
+			return
+		}
+		webhook.Events = requestBody.Events
+	}
+	if requestBody.Active != nil {
+		webhook.Active = *requestBody.Active
+	}
+
+	if err := h.dbFor(c).Save(&webhook).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookUpdateFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Removes a webhook and its delivery log
+// @Tags Webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} models.SwaggerStandardResponse "Success message"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Webhook not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	webhook, ok := h.findWebhook(c)
+	if !ok {
+		return
+	}
+
+	if err := h.dbFor(c).Where("webhook_id = ?", webhook.ID).Delete(&models.WebhookDelivery{}).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookDeleteFailed, err))
+		return
+	}
+	if err := h.dbFor(c).Delete(&webhook).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookDeleteFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SwaggerStandardResponse{Message: "Webhook deleted successfully"})
+}
+
+// GetWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Returns the most recent delivery attempts for a webhook
+// @Tags Webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param limit query int false "Number of attempts to return (default: 50, max: 200)"
+// @Success 200 {array} models.WebhookDelivery "Delivery attempts, newest first"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Webhook not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/webhooks/{id}/deliveries [get]
+func (h *Handler) GetWebhookDeliveries(c *gin.Context) {
+	webhook, ok := h.findWebhook(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	deliveries := []models.WebhookDelivery{}
+	if err := h.dbFor(c).Where("webhook_id = ?", webhook.ID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookDeliveriesFetchFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// TestWebhook godoc
+// @Summary Send a test delivery
+// @Description Sends a single ping event to the webhook and returns the outcome
+// @Tags Webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} models.WebhookDelivery "Delivery attempt"
+// @Failure 400 {object} models.ErrorResponse "Invalid input"
+// @Failure 401 {object} models.ErrorResponse "Unauthorized"
+// @Failure 403 {object} models.ErrorResponse "Forbidden"
+// @Failure 404 {object} models.ErrorResponse "Webhook not found"
+// @Failure 500 {object} models.ErrorResponse "Server error"
+// @Security BearerAuth
+// @Router /admin/webhooks/{id}/test [post]
+func (h *Handler) TestWebhook(c *gin.Context) {
+	webhook, ok := h.findWebhook(c)
+	if !ok {
+		return
+	}
+
+	webhookService := services.NewWebhookService(h.dbFor(c), h.cfg.Webhooks)
+	delivery, err := webhookService.Deliver(webhook, models.WebhookEventPing, gin.H{
+		"webhook_id": webhook.ID,
+		"message":    "This is a test delivery",
+		"sent_at":    time.Now().UTC(),
+	})
+	if err != nil {
+		middleware.Abort(c, apierror.Internal(i18n.CodeWebhookTestFailed, err))
+		return
+	}
+
+	c.JSON(http.StatusOK, delivery)
+}
+
+// findWebhook loads the webhook identified by the id path parameter,
+// writing an error response if it can't be found
+func (h *Handler) findWebhook(c *gin.Context) (models.Webhook, bool) {
+	var webhook models.Webhook
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		middleware.Abort(c, apierror.BadRequest(i18n.CodeInvalidWebhookID))
+		return webhook, false
+	}
+
+	if err := h.dbFor(c).First(&webhook, id).Error; err != nil {
+		middleware.Abort(c, apierror.NotFound(i18n.CodeWebhookNotFound))
+		return webhook, false
+	}
+
+	return webhook, true
+}
@@ -0,0 +1,376 @@
+package i18n
+
+// Error codes returned in the "code" field of error responses. Clients can switch
+// on them instead of parsing messages; each code has a message in every locale.
+const (
+	// General
+	CodeInvalidInput          = "invalid_input"
+	CodeRateLimited           = "rate_limited"
+	CodeInternalError         = "internal_error"
+	CodeInvalidCursor         = "invalid_cursor"
+	CodeInvalidPage           = "invalid_page"
+	CodeInvalidPageSize       = "invalid_page_size"
+	CodeInvalidLanguage       = "invalid_language"
+	CodeUnsupportedAPIVersion = "unsupported_api_version"
+
+	// Authentication
+	CodeAuthRequired          = "auth_required"
+	CodeAuthHeaderInvalid     = "auth_header_invalid"
+	CodeTokenInvalid          = "token_invalid"
+	CodeTokenRevoked          = "token_revoked"
+	CodeTokenWrongType        = "token_wrong_type"
+	CodeTokenValidationFailed = "token_validation_failed"
+	CodeTokenGenerationFailed = "token_generation_failed"
+	CodeRefreshTokenInvalid   = "refresh_token_invalid"
+	CodeTokenRevocationFailed = "token_revocation_failed"
+	CodeLogoutFailed          = "logout_failed"
+	CodeAdminRequired         = "admin_required"
+	CodeInvalidCredentials    = "invalid_credentials"
+	CodeUserExists            = "user_exists"
+	CodeRegistrationFailed    = "registration_failed"
+	CodeAPIKeyInvalid         = "api_key_invalid"
+	CodeAPIKeyScopeDenied     = "api_key_scope_denied"
+	CodeAPIKeyNotAllowed      = "api_key_not_allowed"
+	CodeCSRFTokenInvalid      = "csrf_token_invalid"
+
+	// Sessions
+	CodeSessionsFetchFailed = "sessions_fetch_failed"
+	CodeInvalidSessionID    = "invalid_session_id"
+	CodeSessionNotFound     = "session_not_found"
+	CodeSessionRevokeFailed = "session_revoke_failed"
+
+	// API keys
+	CodeAPIKeysFetchFailed = "api_keys_fetch_failed"
+	CodeAPIKeyCreateFailed = "api_key_create_failed"
+	CodeInvalidAPIKeyID    = "invalid_api_key_id"
+	CodeAPIKeyNotFound     = "api_key_not_found"
+	CodeAPIKeyRevokeFailed = "api_key_revoke_failed"
+
+	// Profile
+	CodeUserNotFound               = "user_not_found"
+	CodeProfileFetchFailed         = "profile_fetch_failed"
+	CodeProfileUpdateFailed        = "profile_update_failed"
+	CodeFileMissing                = "file_missing"
+	CodeAvatarTooLarge             = "avatar_too_large"
+	CodeAvatarInvalidType          = "avatar_invalid_type"
+	CodeUploadServiceFailed        = "upload_service_failed"
+	CodeAvatarUploadFailed         = "avatar_upload_failed"
+	CodeProfileImageUpdateFailed   = "profile_image_update_failed"
+	CodeAccountDeleteAdmin         = "account_delete_admin"
+	CodeAccountDeleteFailed        = "account_delete_failed"
+	CodeAccountExportFormatInvalid = "account_export_format_invalid"
+	CodeAccountExportFailed        = "account_export_failed"
+
+	// Files
+	CodeFileTooLarge        = "file_too_large"
+	CodeFileInvalidType     = "file_invalid_type"
+	CodeFileUploadFailed    = "file_upload_failed"
+	CodeFileURLRequired     = "file_url_required"
+	CodeFileDeleteFailed    = "file_delete_failed"
+	CodeFileContentMismatch = "file_content_mismatch"
+	CodeImageTooLarge       = "image_too_large"
+	CodeFileCorrupt         = "file_corrupt"
+	CodeFileInfected        = "file_infected"
+	CodeFileScanFailed      = "file_scan_failed"
+
+	// Posts
+	CodePostsFetchFailed          = "posts_fetch_failed"
+	CodePostCreateFailed          = "post_create_failed"
+	CodePostUpdateFailed          = "post_update_failed"
+	CodePostDeleteFailed          = "post_delete_failed"
+	CodePostPublishFailed         = "post_publish_failed"
+	CodePostUnpublishFailed       = "post_unpublish_failed"
+	CodePostStatusUpdateFailed    = "post_status_update_failed"
+	CodePostCreateForbidden       = "post_create_forbidden"
+	CodePostEditForbidden         = "post_edit_forbidden"
+	CodePostDeleteForbidden       = "post_delete_forbidden"
+	CodePostPublishForbidden      = "post_publish_forbidden"
+	CodePostUnpublishForbidden    = "post_unpublish_forbidden"
+	CodePostStatusForbidden       = "post_status_forbidden"
+	CodePostAlreadyPublished      = "post_already_published"
+	CodePostAlreadyUnpublished    = "post_already_unpublished"
+	CodePostStatusInvalid         = "post_status_invalid"
+	CodePublishDateRequired       = "publish_date_required"
+	CodePublishDateInPast         = "publish_date_in_past"
+	CodeSlugRequired              = "slug_required"
+	CodePostCoverForbidden        = "post_cover_forbidden"
+	CodePostCoverTooLarge         = "post_cover_too_large"
+	CodePostCoverInvalidType      = "post_cover_invalid_type"
+	CodePostCoverUploadFailed     = "post_cover_upload_failed"
+	CodePostCoverUpdateFailed     = "post_cover_update_failed"
+	CodeFreezeCheckFailed         = "freeze_check_failed"
+	CodeDailyPostLimitReached     = "daily_post_limit_reached"
+	CodePostPreviewForbidden      = "post_preview_forbidden"
+	CodePreviewTokenCreateFailed  = "preview_token_create_failed"
+	CodePreviewTokenRevokeFailed  = "preview_token_revoke_failed"
+	CodePreviewTokenInvalid       = "preview_token_invalid"
+	CodeTranslationSourceNotFound = "translation_source_not_found"
+	CodeTranslationExists         = "translation_exists"
+	CodeLinkSuggestionsFailed     = "link_suggestions_failed"
+	CodeExpiryDateInPast          = "expiry_date_in_past"
+	CodeExpiryBeforePublish       = "expiry_before_publish"
+	CodePostEditConflict          = "post_edit_conflict"
+
+	// Post co-authors
+	CodePostAuthorsForbidden   = "post_authors_forbidden"
+	CodePostAuthorIsOwner      = "post_author_is_owner"
+	CodePostAuthorNotFound     = "post_author_not_found"
+	CodePostAuthorAddFailed    = "post_author_add_failed"
+	CodePostAuthorRemoveFailed = "post_author_remove_failed"
+
+	// Post import and export
+	CodePostExportFormatInvalid = "post_export_format_invalid"
+	CodePostExportFailed        = "post_export_failed"
+	CodePostImportMalformed     = "post_import_malformed"
+	CodePostImportFailed        = "post_import_failed"
+
+	// Post templates
+	CodeInvalidPostTemplateID     = "invalid_post_template_id"
+	CodePostTemplateNotFound      = "post_template_not_found"
+	CodePostTemplateEditForbidden = "post_template_edit_forbidden"
+	CodePostTemplateNameTaken     = "post_template_name_taken"
+	CodePostTemplatesFetchFailed  = "post_templates_fetch_failed"
+	CodePostTemplateCreateFailed  = "post_template_create_failed"
+	CodePostTemplateUpdateFailed  = "post_template_update_failed"
+	CodePostTemplateDeleteFailed  = "post_template_delete_failed"
+
+	// Post autosave
+	CodeAutosaveUnavailable = "autosave_unavailable"
+	CodeAutosaveNotFound    = "autosave_not_found"
+	CodeAutosaveFailed      = "autosave_failed"
+	CodeAutosaveFetchFailed = "autosave_fetch_failed"
+
+	// Comments
+	CodeInvalidPostID            = "invalid_post_id"
+	CodePostNotFound             = "post_not_found"
+	CodeInvalidCommentID         = "invalid_comment_id"
+	CodeCommentNotFound          = "comment_not_found"
+	CodeCommentEditForbidden     = "comment_edit_forbidden"
+	CodeCommentDeleteForbidden   = "comment_delete_forbidden"
+	CodeCommentsFetchFailed      = "comments_fetch_failed"
+	CodeCommentCreateFailed      = "comment_create_failed"
+	CodeCommentUpdateFailed      = "comment_update_failed"
+	CodeCommentDeleteFailed      = "comment_delete_failed"
+	CodeCommentApproveFailed     = "comment_approve_failed"
+	CodeCommentNotPending        = "comment_not_pending"
+	CodeDailyCommentLimitReached = "daily_comment_limit_reached"
+	CodeTrustCheckFailed         = "trust_check_failed"
+	CodeCommentCooldown          = "comment_cooldown"
+	CodeParentCommentNotFound    = "parent_comment_not_found"
+	CodeCommentSortInvalid       = "comment_sort_invalid"
+	CodeCommentReactionInvalid   = "comment_reaction_invalid"
+	CodeCommentReactionFailed    = "comment_reaction_failed"
+
+	// Bookmarks
+	CodeBookmarkCreateFailed = "bookmark_create_failed"
+	CodeBookmarkDeleteFailed = "bookmark_delete_failed"
+	CodeBookmarksFetchFailed = "bookmarks_fetch_failed"
+
+	// Reading progress
+	CodeReadingProgressSaveFailed  = "reading_progress_save_failed"
+	CodeReadingProgressFetchFailed = "reading_progress_fetch_failed"
+
+	// Notifications
+	CodeNotificationsFetchFailed       = "notifications_fetch_failed"
+	CodeInvalidNotificationID          = "invalid_notification_id"
+	CodeNotificationNotFound           = "notification_not_found"
+	CodeNotificationUpdateFailed       = "notification_update_failed"
+	CodeCommentEmailsUnsubscribeFailed = "comment_emails_unsubscribe_failed"
+
+	// Dashboard
+	CodeDashboardFetchFailed = "dashboard_fetch_failed"
+
+	// Categories and tags
+	CodeCategoriesFetchFailed  = "categories_fetch_failed"
+	CodeInvalidCategoryID      = "invalid_category_id"
+	CodeCategoryNotFound       = "category_not_found"
+	CodeParentCategoryNotFound = "parent_category_not_found"
+	CodeCategorySlugEmpty      = "category_slug_empty"
+	CodeCategorySlugTaken      = "category_slug_taken"
+	CodeCategoryCycle          = "category_cycle"
+	CodeCategoryCreateFailed   = "category_create_failed"
+	CodeCategoryUpdateFailed   = "category_update_failed"
+	CodeCategoryDeleteFailed   = "category_delete_failed"
+	CodeTagsFetchFailed        = "tags_fetch_failed"
+	CodeTagsUpdateFailed       = "tags_update_failed"
+	CodeStatsFetchFailed       = "stats_fetch_failed"
+	CodeInvalidTagID           = "invalid_tag_id"
+	CodeTagNotFound            = "tag_not_found"
+	CodeTagNameTaken           = "tag_name_taken"
+	CodeTagMergeSelf           = "tag_merge_self"
+	CodeTagInUse               = "tag_in_use"
+	CodeTagRenameFailed        = "tag_rename_failed"
+	CodeTagMergeFailed         = "tag_merge_failed"
+	CodeTagDeleteFailed        = "tag_delete_failed"
+
+	// Series
+	CodeSeriesFetchFailed   = "series_fetch_failed"
+	CodeInvalidSeriesID     = "invalid_series_id"
+	CodeSeriesNotFound      = "series_not_found"
+	CodeSeriesSlugEmpty     = "series_slug_empty"
+	CodeSeriesSlugTaken     = "series_slug_taken"
+	CodeSeriesForbidden     = "series_forbidden"
+	CodeSeriesPostForbidden = "series_post_forbidden"
+	CodeSeriesPostNotFound  = "series_post_not_found"
+	CodeSeriesCreateFailed  = "series_create_failed"
+	CodeSeriesUpdateFailed  = "series_update_failed"
+	CodeSeriesDeleteFailed  = "series_delete_failed"
+
+	// Search
+	CodeSearchQueryRequired = "search_query_required"
+	CodeInvalidSearchType   = "invalid_search_type"
+	CodeSearchFailed        = "search_failed"
+
+	// News
+	CodeInvalidNewsID              = "invalid_news_id"
+	CodeNewsNotFound               = "news_not_found"
+	CodeNewsListFailed             = "news_list_failed"
+	CodeNewsFetchFailed            = "news_fetch_failed"
+	CodeNewsFullContentFailed      = "news_full_content_failed"
+	CodeNewsCreateFailed           = "news_create_failed"
+	CodeNewsUpdateFailed           = "news_update_failed"
+	CodeNewsDeleteFailed           = "news_delete_failed"
+	CodeNewsStatusUpdateFailed     = "news_status_update_failed"
+	CodeNewsServiceUnavailable     = "news_service_unavailable"
+	CodeRSSServiceUnavailable      = "rss_service_unavailable"
+	CodeNewsAPIFetchFailed         = "news_api_fetch_failed"
+	CodeRSSFetchFailed             = "rss_fetch_failed"
+	CodeIngestionRunsFetchFailed   = "ingestion_runs_fetch_failed"
+	CodeInvalidIngestionRunID      = "invalid_ingestion_run_id"
+	CodeIngestionRunNotFound       = "ingestion_run_not_found"
+	CodeNewsCategoriesFetchFailed  = "news_categories_fetch_failed"
+	CodeInvalidNewsCategoryID      = "invalid_news_category_id"
+	CodeInvalidNewsCategorySlug    = "invalid_news_category_slug"
+	CodeNewsCategoryNotFound       = "news_category_not_found"
+	CodeNewsCategoryExists         = "news_category_exists"
+	CodeNewsCategoryCreateFailed   = "news_category_create_failed"
+	CodeNewsCategoryUpdateFailed   = "news_category_update_failed"
+	CodeNewsViewsFetchFailed       = "news_views_fetch_failed"
+	CodeInvalidNewsViewID          = "invalid_news_view_id"
+	CodeNewsViewNotFound           = "news_view_not_found"
+	CodeNewsViewExists             = "news_view_exists"
+	CodeNewsViewCreateFailed       = "news_view_create_failed"
+	CodeNewsViewDeleteFailed       = "news_view_delete_failed"
+	CodeNewsCommentaryExists       = "news_commentary_exists"
+	CodeNewsSourcesFetchFailed     = "news_sources_fetch_failed"
+	CodeInvalidNewsSourceID        = "invalid_news_source_id"
+	CodeNewsSourceNotFound         = "news_source_not_found"
+	CodeNewsSourceExists           = "news_source_exists"
+	CodeNewsSourceCreateFailed     = "news_source_create_failed"
+	CodeNewsSourceUpdateFailed     = "news_source_update_failed"
+	CodeNewsSourceDeleteFailed     = "news_source_delete_failed"
+	CodeFetchHistoryFetchFailed    = "fetch_history_fetch_failed"
+	CodeNewsEnrichmentFailed       = "news_enrichment_failed"
+	CodeNewsEnrichmentStatusFailed = "news_enrichment_status_failed"
+
+	// Homepage feed
+	CodeFeedBuildFailed           = "feed_build_failed"
+	CodeFeedItemNotFound          = "feed_item_not_found"
+	CodeEditorialPicksFetchFailed = "editorial_picks_fetch_failed"
+	CodeEditorialPickSaveFailed   = "editorial_pick_save_failed"
+	CodeInvalidEditorialPickID    = "invalid_editorial_pick_id"
+	CodeEditorialPickNotFound     = "editorial_pick_not_found"
+	CodeEditorialPickDeleteFailed = "editorial_pick_delete_failed"
+
+	// Pages
+	CodePagesFetchFailed  = "pages_fetch_failed"
+	CodePageNotFound      = "page_not_found"
+	CodePageEditForbidden = "page_edit_forbidden"
+	CodePageSlugEmpty     = "page_slug_empty"
+	CodePageSlugTaken     = "page_slug_taken"
+	CodePageCreateFailed  = "page_create_failed"
+	CodePageUpdateFailed  = "page_update_failed"
+	CodePageDeleteFailed  = "page_delete_failed"
+
+	// Contact
+	CodeContactUnavailable = "contact_unavailable"
+	CodeCaptchaFailed      = "captcha_failed"
+	CodeCaptchaUnavailable = "captcha_unavailable"
+	CodeContactFailed      = "contact_failed"
+
+	// Newsletter
+	CodeNewsletterUnavailable       = "newsletter_unavailable"
+	CodeNewsletterSubscribeFailed   = "newsletter_subscribe_failed"
+	CodeSubscriptionTokenInvalid    = "subscription_token_invalid"
+	CodeNewsletterConfirmFailed     = "newsletter_confirm_failed"
+	CodeNewsletterUnsubscribeFailed = "newsletter_unsubscribe_failed"
+	CodeNewsletterDigestEmpty       = "newsletter_digest_empty"
+	CodeNewsletterDigestFailed      = "newsletter_digest_failed"
+
+	// Analytics
+	CodeAnalyticsRecordFailed    = "analytics_record_failed"
+	CodePostAnalyticsForbidden   = "post_analytics_forbidden"
+	CodePostAnalyticsFetchFailed = "post_analytics_fetch_failed"
+
+	// Cross-posting
+	CodeCrossPostsForbidden   = "cross_posts_forbidden"
+	CodeCrossPostsFetchFailed = "cross_posts_fetch_failed"
+	CodeCrossPostRetryFailed  = "cross_post_retry_failed"
+
+	// Link checking
+	CodeBrokenLinksFetchFailed = "broken_links_fetch_failed"
+	CodeLinkCheckRunning       = "link_check_running"
+
+	// Admin
+	CodeSettingsFetchFailed          = "settings_fetch_failed"
+	CodeSettingNotFound              = "setting_not_found"
+	CodeSettingInvalidValue          = "setting_invalid_value"
+	CodeSettingUpdateFailed          = "setting_update_failed"
+	CodeFreezeWindowsFetchFailed     = "freeze_windows_fetch_failed"
+	CodeFreezeWindowInvalidRange     = "freeze_window_invalid_range"
+	CodeFreezeWindowEnded            = "freeze_window_ended"
+	CodeFreezeWindowCreateFailed     = "freeze_window_create_failed"
+	CodeInvalidFreezeWindowID        = "invalid_freeze_window_id"
+	CodeFreezeWindowNotFound         = "freeze_window_not_found"
+	CodeFreezeWindowDeleteFailed     = "freeze_window_delete_failed"
+	CodeWebhooksFetchFailed          = "webhooks_fetch_failed"
+	CodeWebhookEventUnknown          = "webhook_event_unknown"
+	CodeWebhookCreateFailed          = "webhook_create_failed"
+	CodeInvalidWebhookID             = "invalid_webhook_id"
+	CodeWebhookNotFound              = "webhook_not_found"
+	CodeWebhookUpdateFailed          = "webhook_update_failed"
+	CodeWebhookDeleteFailed          = "webhook_delete_failed"
+	CodeWebhookDeliveriesFetchFailed = "webhook_deliveries_fetch_failed"
+	CodeWebhookTestFailed            = "webhook_test_failed"
+	CodeInvalidUserID                = "invalid_user_id"
+	CodeUserDeletionStrategyInvalid  = "user_deletion_strategy_invalid"
+	CodeUserDeleteSelf               = "user_delete_self"
+	CodeUserDeleteGhost              = "user_delete_ghost"
+	CodeUserDeleteFailed             = "user_delete_failed"
+	CodeUserDeletionNotFound         = "user_deletion_not_found"
+	CodeUserRestoreExpired           = "user_restore_expired"
+	CodeUserRestoreConflict          = "user_restore_conflict"
+	CodeUserRestoreFailed            = "user_restore_failed"
+	CodeUserRoleChangeSelf           = "user_role_change_self"
+	CodeUserPurgeFailed              = "user_purge_failed"
+	CodeUserRoleUpdateFailed         = "user_role_update_failed"
+	CodeAuditLogsFetchFailed         = "audit_logs_fetch_failed"
+	CodeJWTKeysFetchFailed           = "jwt_keys_fetch_failed"
+	CodeJWTKeyRotateFailed           = "jwt_key_rotate_failed"
+	CodeJWTKeyNotFound               = "jwt_key_not_found"
+	CodeJWTKeyInUse                  = "jwt_key_in_use"
+	CodeJWTKeyRetireFailed           = "jwt_key_retire_failed"
+	CodeUnknownRole                  = "unknown_role"
+	CodeRolesFetchFailed             = "roles_fetch_failed"
+	CodeInvalidRoleID                = "invalid_role_id"
+	CodeRoleNotFound                 = "role_not_found"
+	CodeRoleExists                   = "role_exists"
+	CodeInvalidRoleName              = "invalid_role_name"
+	CodeUnknownPermission            = "unknown_permission"
+	CodeRoleLocked                   = "role_locked"
+	CodeRoleBuiltIn                  = "role_built_in"
+	CodeRoleInUse                    = "role_in_use"
+	CodeRoleCreateFailed             = "role_create_failed"
+	CodeRoleUpdateFailed             = "role_update_failed"
+	CodeRoleDeleteFailed             = "role_delete_failed"
+	CodeSitesFetchFailed             = "sites_fetch_failed"
+	CodeInvalidSiteID                = "invalid_site_id"
+	CodeSiteNotFound                 = "site_not_found"
+	CodeSiteDomainTaken              = "site_domain_taken"
+	CodeInvalidSiteDomain            = "invalid_site_domain"
+	CodeSiteIsDefault                = "site_is_default"
+	CodeSiteHasContent               = "site_has_content"
+	CodeSiteCreateFailed             = "site_create_failed"
+	CodeSiteUpdateFailed             = "site_update_failed"
+	CodeSiteDeleteFailed             = "site_delete_failed"
+)
@@ -0,0 +1,116 @@
+// Package i18n translates API error messages. Messages are keyed by error code,
+// and the messages describing invalid request fields by validation.<rule>. The
+// language is negotiated from the Accept-Language header, falling back to English.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLanguage is used when the client doesn't ask for a supported language
+const DefaultLanguage = "en"
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// catalogs maps a language to its messages, keyed by error code
+var catalogs = loadCatalogs()
+
+// loadCatalogs parses the embedded translation files. A broken file is a build
+// mistake, so it panics at startup rather than serving untranslated errors.
+func loadCatalogs() map[string]map[string]string {
+	entries, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: failed to read locales: %v", err))
+	}
+
+	result := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		data, err := localeFiles.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s: %v", entry.Name(), err))
+		}
+
+		messages := map[string]string{}
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: failed to parse %s: %v", entry.Name(), err))
+		}
+		result[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+
+	if _, ok := result[DefaultLanguage]; !ok {
+		panic("i18n: missing default locale " + DefaultLanguage)
+	}
+	return result
+}
+
+// Languages returns the supported language tags
+func Languages() []string {
+	languages := make([]string, 0, len(catalogs))
+	for lang := range catalogs {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Negotiate picks the best supported language for an Accept-Language header value,
+// e.g. "vi-VN,vi;q=0.9,en;q=0.8". Region subtags are ignored.
+func Negotiate(acceptLanguage string) string {
+	if lang, ok := Match(acceptLanguage); ok {
+		return lang
+	}
+	return DefaultLanguage
+}
+
+// Match is like Negotiate but reports false instead of falling back to the
+// default language when the client accepts none of the supported ones
+func Match(acceptLanguage string) (string, bool) {
+	best := ""
+	bestQuality := 0.0
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		lang, _, _ := strings.Cut(tag, "-")
+		if _, ok := catalogs[lang]; ok && quality > bestQuality {
+			best = lang
+			bestQuality = quality
+		}
+	}
+
+	return best, best != ""
+}
+
+// Translate returns the message for code in lang. Codes missing from lang fall
+// back to English, and unknown codes to the code itself.
+func Translate(lang, code string) string {
+	message, ok := catalogs[lang][code]
+	if !ok {
+		message, ok = catalogs[DefaultLanguage][code]
+	}
+	if !ok {
+		return code
+	}
+	return message
+}
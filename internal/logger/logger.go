@@ -7,6 +7,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+	"github.com/phanvantai/taiphanvan_backend/internal/version"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
@@ -46,6 +49,13 @@ func Setup(cfg *config.Config) {
 		Logger = Logger.Level(zerolog.InfoLevel)
 	}
 
+	// Tag every log line with the build, so mixed-version rollouts can be told apart
+	Logger = Logger.With().
+		Str("build", version.GitSHA).
+		Bool("canary", cfg.Server.Canary).
+		Logger().
+		Hook(tracing.LogHook{})
+
 	// Set as global logger
 	log.Logger = Logger
 
@@ -70,7 +80,7 @@ func GinMiddleware() gin.HandlerFunc {
 		latency := time.Since(start)
 
 		// Get client IP
-		clientIP := c.ClientIP()
+		clientIP := middleware.ClientIP(c)
 
 		// Get method
 		method := c.Request.Method
@@ -96,8 +106,9 @@ func GinMiddleware() gin.HandlerFunc {
 			event = Logger.Warn()
 		}
 
-		// Add structured context fields
+		// Add structured context fields, including the trace ID when the request is traced
 		event.
+			Ctx(c.Request.Context()).
 			Int("status", statusCode).
 			Str("method", method).
 			Str("path", path).
@@ -120,6 +131,11 @@ func GinMiddleware() gin.HandlerFunc {
 			event.Str("request_id", requestID)
 		}
 
+		// Summarize calls to external services made while handling the request
+		if calls := tracing.OutboundCalls(c.Request.Context()); len(calls) > 0 {
+			event.Interface("outbound", calls)
+		}
+
 		event.Msg("Request processed")
 	}
 }
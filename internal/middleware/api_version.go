@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+)
+
+// APIVersionHeader names the API versions a client accepts on requests, and
+// the version that served the request on responses
+const APIVersionHeader = "API-Version"
+
+// APIVersion negotiates the API version of a request. Clients may list the
+// versions they were written for in the API-Version header, e.g. "1" or
+// "v1, v2"; a request that accepts none of the served version is rejected
+// with 406 so it fails loudly instead of being misread. Requests without the
+// header get the served version, which every response reports.
+func APIVersion(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header(APIVersionHeader, version)
+
+		if requested := c.GetHeader(APIVersionHeader); requested != "" && !acceptsVersion(requested, version) {
+			Abort(c, apierror.New(http.StatusNotAcceptable, i18n.CodeUnsupportedAPIVersion).
+				WithDetails(gin.H{"requested": requested, "supported": []string{version}}))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// acceptsVersion reports whether the comma-separated versions in header
+// include version, with or without a leading v
+func acceptsVersion(header, version string) bool {
+	for _, requested := range strings.Split(header, ",") {
+		requested = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(requested)), "v")
+		if requested == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Deprecated marks responses served under the deprecated prefix with a
+// Deprecation header giving deprecatedAt (RFC 9745), a Sunset header giving
+// the date the prefix stops working when sunset is set (RFC 8594), and a Link
+// to the same path under successorPrefix, so clients can migrate before it
+// goes away
+func Deprecated(prefix, successorPrefix string, deprecatedAt, sunset time.Time) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(deprecatedAt.Unix(), 10)
+	var sunsetHeader string
+	if !sunset.IsZero() {
+		sunsetHeader = sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		if sunsetHeader != "" {
+			c.Header("Sunset", sunsetHeader)
+		}
+		if rest, ok := strings.CutPrefix(c.Request.URL.Path, prefix); ok {
+			c.Writer.Header().Add("Link", "<"+successorPrefix+rest+`>; rel="successor-version"`)
+		}
+
+		c.Next()
+	}
+}
@@ -9,9 +9,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
 	"github.com/phanvantai/taiphanvan_backend/internal/config"
 	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
 )
 
 // JWT claims structure
@@ -19,6 +23,9 @@ type Claims struct {
 	UserID    uint   `json:"user_id"`
 	Role      string `json:"role"`
 	TokenType string `json:"token_type"` // "access" or "refresh"
+	// SessionID is the ID of the refresh token an access token was issued
+	// with, so requests can tell which session they belong to
+	SessionID uint `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -30,84 +37,139 @@ func SetConfig(cfg *config.Config) {
 	AppConfig = cfg
 }
 
-// AuthMiddleware checks for valid JWT access token
+// keyRing holds the keys tokens are signed and verified with
+var keyRing *services.JWTKeyRing
+
+// SetKeyRing sets the JWT key ring used to sign and verify tokens
+func SetKeyRing(ring *services.JWTKeyRing) {
+	keyRing = ring
+}
+
+// KeyRing returns the JWT key ring used to sign and verify tokens
+func KeyRing() *services.JWTKeyRing {
+	return keyRing
+}
+
+// APIKeyHeader carries an API key as an alternative to a Bearer token
+const APIKeyHeader = "X-API-Key"
+
+// AuthMiddleware checks for valid JWT access token, or an API key in the
+// X-API-Key header when no Authorization header is sent
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if key := c.GetHeader(APIKeyHeader); key != "" && c.GetHeader("Authorization") == "" {
+			authenticateAPIKey(c, key)
+			return
+		}
+
 		tokenString, err := extractToken(c)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"status":  "error",
-				"error":   "Authorization required",
-				"message": err.Error(),
-			})
-			c.Abort()
+			Abort(c, apierror.Unauthorized(i18n.CodeAuthRequired))
 			return
 		}
 
 		// Check if token is blacklisted
 		var count int64
-		if err := database.DB.Model(&models.BlacklistedToken{}).
+		if err := database.DB.WithContext(c.Request.Context()).Model(&models.BlacklistedToken{}).
 			Where("token = ?", tokenString).Count(&count).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"status":  "error",
-				"error":   "Authentication error",
-				"message": "Failed to validate token",
-			})
-			c.Abort()
+			Abort(c, apierror.Internal(i18n.CodeTokenValidationFailed, err))
 			return
 		}
 
 		if count > 0 {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"status":  "error",
-				"error":   "Token revoked",
-				"message": "This token has been revoked. Please log in again",
-			})
-			c.Abort()
+			Abort(c, apierror.Unauthorized(i18n.CodeTokenRevoked))
 			return
 		}
 
 		// Parse token
 		claims, err := validateToken(tokenString)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"status":  "error",
-				"error":   "Invalid token",
-				"message": err.Error(),
-			})
-			c.Abort()
+			Abort(c, apierror.Unauthorized(i18n.CodeTokenInvalid))
 			return
 		}
 
 		// Ensure this is an access token, not a refresh token
 		if claims.TokenType != "access" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"status":  "error",
-				"error":   "Invalid token type",
-				"message": "Refresh token cannot be used for authentication",
-			})
-			c.Abort()
+			Abort(c, apierror.Unauthorized(i18n.CodeTokenWrongType))
 			return
 		}
 
 		// Set the user ID in the context for later use
 		c.Set("userID", claims.UserID)
 		c.Set("userRole", claims.Role)
+		if claims.SessionID != 0 {
+			c.Set("sessionID", claims.SessionID)
+		}
 		c.Next()
 	}
 }
 
-// AdminMiddleware ensures the user has admin privileges
+// OptionalAuthMiddleware authenticates the request like AuthMiddleware when it
+// carries an Authorization or X-API-Key header, and lets it through
+// anonymously otherwise. Invalid credentials are still rejected.
+func OptionalAuthMiddleware() gin.HandlerFunc {
+	auth := AuthMiddleware()
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" && c.GetHeader(APIKeyHeader) == "" {
+			c.Next()
+			return
+		}
+		auth(c)
+	}
+}
+
+// authenticateAPIKey authenticates the request as the owner of key. Read-only
+// requests need the read scope and all others the write scope.
+func authenticateAPIKey(c *gin.Context, key string) {
+	apiKey, user, err := services.NewAPIKeyService(database.DB.WithContext(c.Request.Context())).Authenticate(key)
+	if errors.Is(err, services.ErrAPIKeyInvalid) {
+		Abort(c, apierror.Unauthorized(i18n.CodeAPIKeyInvalid))
+		return
+	}
+	if err != nil {
+		Abort(c, apierror.Internal(i18n.CodeTokenValidationFailed, err))
+		return
+	}
+
+	scope := models.APIKeyScopeWrite
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		scope = models.APIKeyScopeRead
+	}
+	if !apiKey.HasScope(scope) {
+		Abort(c, apierror.Forbidden(i18n.CodeAPIKeyScopeDenied).WithDetails(gin.H{"required_scope": scope}))
+		return
+	}
+
+	c.Set("userID", user.ID)
+	c.Set("userRole", user.Role)
+	c.Set("apiKeyID", apiKey.ID)
+	c.Next()
+}
+
+// SessionOnlyMiddleware rejects requests authenticated with an API key, for
+// endpoints that need the signed-in account holder, such as managing the keys
+// themselves
+func SessionOnlyMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Get("apiKeyID"); ok {
+			Abort(c, apierror.Forbidden(i18n.CodeAPIKeyNotAllowed))
+			return
+		}
+		c.Next()
+	}
+}
+
+// AdminMiddleware ensures the user has admin privileges. API keys can't be
+// used for admin endpoints, even an admin's.
 func AdminMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		role, exists := c.Get("userRole")
-		if !exists || role != "admin" {
-			c.JSON(http.StatusForbidden, gin.H{
-				"status":  "error",
-				"error":   "Permission denied",
-				"message": "Admin privileges required for this resource",
-			})
-			c.Abort()
+		if _, ok := c.Get("apiKeyID"); ok {
+			Abort(c, apierror.Forbidden(i18n.CodeAPIKeyNotAllowed))
+			return
+		}
+
+		if !policy.Grants(c.GetString("userRole"), policy.ActionAdminAccess) {
+			Abort(c, apierror.Forbidden(i18n.CodeAdminRequired))
 			return
 		}
 		c.Next()
@@ -115,28 +177,29 @@ func AdminMiddleware() gin.HandlerFunc {
 }
 
 // GenerateTokenPair creates both access and refresh tokens for a user
-func GenerateTokenPair(user models.User) (accessToken string, refreshToken string, refreshTokenID uint, err error) {
+func GenerateTokenPair(user models.User, userAgent, ipAddress string) (accessToken string, refreshToken string, refreshTokenID uint, err error) {
 	if AppConfig == nil {
 		return "", "", 0, errors.New("application configuration not set")
 	}
 
-	// Generate access token
-	accessToken, err = generateAccessToken(user)
+	// Generate refresh token first, so the access token can name its session
+	refreshToken, refreshTokenModel, err := generateRefreshToken(user, userAgent, ipAddress)
 	if err != nil {
-		return "", "", 0, fmt.Errorf("failed to generate access token: %w", err)
+		return "", "", 0, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	// Generate refresh token
-	refreshToken, refreshTokenModel, err := generateRefreshToken(user)
+	// Generate access token
+	accessToken, err = generateAccessToken(user, refreshTokenModel.ID)
 	if err != nil {
-		return "", "", 0, fmt.Errorf("failed to generate refresh token: %w", err)
+		return "", "", 0, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	return accessToken, refreshToken, refreshTokenModel.ID, nil
 }
 
-// generateAccessToken creates a new JWT access token for a user
-func generateAccessToken(user models.User) (string, error) {
+// generateAccessToken creates a new JWT access token for a user, belonging
+// to the session with the given refresh token ID
+func generateAccessToken(user models.User, sessionID uint) (string, error) {
 	if AppConfig == nil {
 		return "", errors.New("application configuration not set")
 	}
@@ -149,6 +212,7 @@ func generateAccessToken(user models.User) (string, error) {
 		UserID:    user.ID,
 		Role:      user.Role,
 		TokenType: "access",
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -156,9 +220,8 @@ func generateAccessToken(user models.User) (string, error) {
 		},
 	}
 
-	// Create the token with claims and sign it
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(AppConfig.JWT.Secret))
+	// Sign the token with the current signing key
+	tokenString, err := keyRing.Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -166,8 +229,9 @@ func generateAccessToken(user models.User) (string, error) {
 	return tokenString, nil
 }
 
-// generateRefreshToken creates a new JWT refresh token and stores it in the database
-func generateRefreshToken(user models.User) (string, *models.RefreshToken, error) {
+// generateRefreshToken creates a new JWT refresh token and stores it in the
+// database with the device it was issued to
+func generateRefreshToken(user models.User, userAgent, ipAddress string) (string, *models.RefreshToken, error) {
 	if AppConfig == nil {
 		return "", nil, errors.New("application configuration not set")
 	}
@@ -188,9 +252,8 @@ func generateRefreshToken(user models.User) (string, *models.RefreshToken, error
 		},
 	}
 
-	// Create the token with claims and sign it
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(AppConfig.JWT.Secret))
+	// Sign the token with the current signing key
+	tokenString, err := keyRing.Sign(claims)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to sign refresh token: %w", err)
 	}
@@ -202,6 +265,8 @@ func generateRefreshToken(user models.User) (string, *models.RefreshToken, error
 		ExpiresAt: expirationTime,
 		IssuedAt:  issuedAt,
 		Revoked:   false,
+		UserAgent: truncateUserAgent(userAgent),
+		IPAddress: ipAddress,
 	}
 
 	if result := database.DB.Create(refreshToken); result.Error != nil {
@@ -242,27 +307,30 @@ func RefreshAccessToken(refreshToken string) (accessToken string, err error) {
 	}
 
 	// Generate new access token
-	newAccessToken, err := generateAccessToken(user)
+	newAccessToken, err := generateAccessToken(user, dbToken.ID)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate new access token: %w", err)
 	}
 
+	// Record the activity for the session list; failing to doesn't matter
+	database.DB.Model(&dbToken).UpdateColumn("last_used_at", time.Now())
+
 	return newAccessToken, nil
 }
 
-// RevokeRefreshToken marks a refresh token as revoked in the database
-func RevokeRefreshToken(refreshToken string) error {
+// RevokeRefreshToken marks a refresh token as revoked in the database and returns it
+func RevokeRefreshToken(refreshToken string) (*models.RefreshToken, error) {
 	var token models.RefreshToken
 	if result := database.DB.Where("token = ?", refreshToken).First(&token); result.Error != nil {
-		return errors.New("refresh token not found")
+		return nil, errors.New("refresh token not found")
 	}
 
 	token.Revoked = true
 	if result := database.DB.Save(&token); result.Error != nil {
-		return fmt.Errorf("failed to revoke refresh token: %w", result.Error)
+		return nil, fmt.Errorf("failed to revoke refresh token: %w", result.Error)
 	}
 
-	return nil
+	return &token, nil
 }
 
 // RevokeAllUserRefreshTokens revokes all refresh tokens for a user
@@ -276,6 +344,15 @@ func RevokeAllUserRefreshTokens(userID uint) error {
 	return nil
 }
 
+// truncateUserAgent shortens a user agent to fit the refresh_tokens column
+func truncateUserAgent(userAgent string) string {
+	const maxLength = 500
+	if len(userAgent) > maxLength {
+		return userAgent[:maxLength]
+	}
+	return userAgent
+}
+
 // extractToken gets the token from the Authorization header
 func extractToken(c *gin.Context) (string, error) {
 	authHeader := c.GetHeader("Authorization")
@@ -298,12 +375,7 @@ func validateToken(tokenString string) (*Claims, error) {
 	}
 
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(AppConfig.JWT.Secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, keyRing.KeyFunc)
 
 	if err != nil {
 		return nil, fmt.Errorf("invalid token: %w", err)
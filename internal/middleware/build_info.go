@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/version"
+)
+
+// BuildInfo adds the X-API-Build header to every response, and X-API-Canary on
+// canary instances, so clients can tell which build served a request during a
+// rollout
+func BuildInfo(canary bool) gin.HandlerFunc {
+	build := version.Build()
+
+	return func(c *gin.Context) {
+		c.Writer.Header().Set("X-API-Build", build)
+		if canary {
+			c.Writer.Header().Set("X-API-Canary", "true")
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClientIP returns the address of the client that sent the request, for rate
+// limiting, logging and anything else keyed on who is calling. Forwarding
+// headers are only believed when the connection comes from one of
+// TRUSTED_PROXIES, and Gin walks them from the right, so a client can't claim
+// an address by sending its own X-Forwarded-For. Anything that doesn't parse
+// as an IP falls back to the connecting address.
+func ClientIP(c *gin.Context) string {
+	if ip := net.ParseIP(c.ClientIP()); ip != nil {
+		return ip.String()
+	}
+	if ip, _, err := net.SplitHostPort(c.Request.RemoteAddr); err == nil {
+		return ip
+	}
+	return c.Request.RemoteAddr
+}
@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+)
+
+// compressibleTypes are the media types worth compressing. Images, archives
+// and other binary formats are already compressed.
+var compressibleTypes = []string{
+	"application/json",
+	"application/xml",
+	"application/rss+xml",
+	"application/atom+xml",
+	"application/javascript",
+	"image/svg+xml",
+	"text/",
+}
+
+// Compress gzips responses for clients that accept it. Responses smaller than
+// cfg.MinSize, of a type that doesn't compress well or already encoded are
+// sent as they are. A strong ETag is made weak on compressed responses since
+// the bytes differ from the uncompressed representation.
+func Compress(cfg config.CompressionConfig) gin.HandlerFunc {
+	pool := sync.Pool{New: func() interface{} {
+		w, _ := gzip.NewWriterLevel(nil, cfg.Level)
+		return w
+	}}
+
+	return func(c *gin.Context) {
+		if !cfg.Enabled {
+			c.Next()
+			return
+		}
+
+		// Caches must keep compressed and uncompressed copies apart
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		if c.Request.Method == http.MethodHead || !acceptsGzip(c.Request) {
+			c.Next()
+			return
+		}
+
+		writer := &compressWriter{ResponseWriter: c.Writer, pool: &pool, minSize: cfg.MinSize, status: http.StatusOK}
+		c.Writer = writer
+		defer restoreWriterOnPanic(c, writer.ResponseWriter)
+		c.Next()
+		writer.finish()
+		c.Writer = writer.ResponseWriter
+	}
+}
+
+// acceptsGzip reports whether the Accept-Encoding header allows gzip
+func acceptsGzip(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			return strings.ReplaceAll(params, " ", "") != "q=0"
+		}
+	}
+	return false
+}
+
+// compressible reports whether a response of contentType should be compressed
+func compressible(contentType string) bool {
+	for _, prefix := range compressibleTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// compressWriter holds the start of the response back until it knows whether
+// the response is large enough to compress
+type compressWriter struct {
+	gin.ResponseWriter
+	pool    *sync.Pool
+	minSize int
+
+	status  int
+	buf     bytes.Buffer
+	gz      *gzip.Writer
+	decided bool
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if !w.decided {
+		w.status = code
+	}
+}
+
+func (w *compressWriter) WriteHeaderNow() {}
+
+func (w *compressWriter) Status() int {
+	if w.decided {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+func (w *compressWriter) Written() bool {
+	return w.decided || w.buf.Len() > 0
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.decided {
+		if w.gz != nil {
+			return w.gz.Write(data)
+		}
+		return w.ResponseWriter.Write(data)
+	}
+
+	w.buf.Write(data)
+	if w.buf.Len() >= w.minSize {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends what has been written so far, compressing it if the response
+// is compressed
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide(w.buf.Len() >= w.minSize)
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide sends the headers, compressing the response when it is large enough
+// and of a compressible type, then writes the buffered start of the body
+func (w *compressWriter) decide(largeEnough bool) error {
+	w.decided = true
+	header := w.ResponseWriter.Header()
+
+	if largeEnough && header.Get("Content-Encoding") == "" && compressible(header.Get("Content-Type")) &&
+		bodyAllowed(w.status) {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+
+		w.gz = w.pool.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(w.buf.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buf.Bytes())
+	}
+	w.buf.Reset()
+	return err
+}
+
+// finish sends a response that stayed under the minimum size and closes the
+// gzip stream
+func (w *compressWriter) finish() {
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		w.pool.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+// bodyAllowed reports whether a response with status can have a body
+func bodyAllowed(status int) bool {
+	return status >= http.StatusOK && status != http.StatusNoContent && status != http.StatusNotModified
+}
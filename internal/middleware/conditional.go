@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// lastModifiedKey is the context key SetLastModified stores the time under
+const lastModifiedKey = "lastModified"
+
+// SetETag sets a weak ETag from the JSON of v instead of the hash of the
+// response body, for responses that carry values, such as view counts, that
+// change without the resource changing
+func SetETag(c *gin.Context, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	sum := sha256.Sum256(data)
+	c.Header("ETag", `W/"`+hex.EncodeToString(sum[:16])+`"`)
+}
+
+// SetLastModified records when the resource in the response last changed, for
+// ConditionalGet to send as Last-Modified and check If-Modified-Since against
+func SetLastModified(c *gin.Context, t time.Time) {
+	c.Set(lastModifiedKey, t)
+}
+
+// ConditionalGet answers GET requests with 304 Not Modified when the client's
+// copy is still current. The handler's response is buffered; unless the
+// handler set one with SetETag, its ETag is the hash of the body.
+// If-None-Match is checked against the ETag and, when it is absent,
+// If-Modified-Since against the time given to SetLastModified. Only 200
+// responses get validators.
+func ConditionalGet() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		defer restoreWriterOnPanic(c, writer.ResponseWriter)
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.status != http.StatusOK {
+			writer.flush()
+			return
+		}
+
+		header := writer.Header()
+		etag := header.Get("ETag")
+		if etag == "" {
+			sum := sha256.Sum256(writer.body.Bytes())
+			etag = `"` + hex.EncodeToString(sum[:16]) + `"`
+			header.Set("ETag", etag)
+		}
+
+		var lastModified time.Time
+		if value, ok := c.Get(lastModifiedKey); ok {
+			lastModified = value.(time.Time).UTC().Truncate(time.Second)
+			header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		}
+
+		if notModified(c.Request, etag, lastModified) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			writer.ResponseWriter.WriteHeader(http.StatusNotModified)
+			writer.ResponseWriter.WriteHeaderNow()
+			return
+		}
+
+		writer.flush()
+	}
+}
+
+// restoreWriterOnPanic puts back the response writer a middleware replaced
+// when the handler panics, so the recovery middleware's 500 response reaches
+// the client instead of a buffer. Deferred, it re-panics after restoring.
+func restoreWriterOnPanic(c *gin.Context, original gin.ResponseWriter) {
+	if err := recover(); err != nil {
+		c.Writer = original
+		panic(err)
+	}
+}
+
+// notModified reports whether the request's validators match the response
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || weakETag(candidate) == weakETag(etag) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := r.Header.Get("If-Modified-Since"); since != "" && !lastModified.IsZero() {
+		t, err := http.ParseTime(since)
+		return err == nil && !lastModified.After(t)
+	}
+	return false
+}
+
+// weakETag strips the weak prefix, since If-None-Match uses weak comparison
+func weakETag(etag string) string {
+	return strings.TrimPrefix(etag, "W/")
+}
+
+// bufferedResponseWriter holds the response back until the handler is done
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// flush sends the buffered response as the handler wrote it
+func (w *bufferedResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"sync/atomic"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+)
+
+// CORSPolicy decides which origins may make cross-origin requests. Reload
+// swaps in the origins from the configuration's origins file while requests
+// are being served.
+type CORSPolicy struct {
+	cfg     config.CORSConfig
+	origins atomic.Pointer[[]config.CORSOrigin]
+}
+
+// NewCORSPolicy parses the allowed origins in cfg
+func NewCORSPolicy(cfg config.CORSConfig) (*CORSPolicy, error) {
+	policy := &CORSPolicy{cfg: cfg}
+	if err := policy.Reload(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Reload parses the allowed origins again, reading the origins file. On
+// error the current origins are kept.
+func (p *CORSPolicy) Reload() error {
+	origins, err := p.cfg.Origins()
+	if err != nil {
+		return err
+	}
+	p.origins.Store(&origins)
+	return nil
+}
+
+// Allowed reports whether origin may make cross-origin requests
+func (p *CORSPolicy) Allowed(origin string) bool {
+	for _, allowed := range *p.origins.Load() {
+		if allowed.Matches(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// CORS answers preflight requests and adds CORS headers for the origins
+// policy allows. Requests from other origins are rejected with 403.
+func CORS(policy *CORSPolicy) gin.HandlerFunc {
+	return cors.New(cors.Config{
+		AllowOriginFunc:  policy.Allowed,
+		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "X-Request-ID", "API-Version", "traceparent", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since", CSRFHeader},
+		ExposeHeaders:    []string{"Content-Length", "X-Request-ID", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After", "X-API-Build", "X-API-Canary", "X-Total-Count", "API-Version", "Deprecation", "Sunset", "Link", "ETag", "Last-Modified"},
+		AllowCredentials: policy.cfg.AllowCredentials,
+		MaxAge:           policy.cfg.MaxAge,
+	})
+}
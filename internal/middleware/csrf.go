@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+)
+
+// CSRFHeader carries the CSRF token on requests made with the refresh cookie
+const CSRFHeader = "X-CSRF-Token"
+
+// CSRF guards requests authenticated by the refresh cookie of cookie
+// sessions with a double-submit token: a state-changing request that carries
+// the refresh cookie must repeat the value of the CSRF cookie in the
+// X-CSRF-Token header. Other sites can make the browser send the cookies but
+// can't read them, so they can't set the header. Requests without the
+// refresh cookie, such as bearer-token ones, pass through.
+func CSRF(cfg config.AuthCookieConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		if _, err := c.Cookie(cfg.Name); err != nil {
+			c.Next()
+			return
+		}
+
+		cookie, err := c.Cookie(cfg.CSRFName)
+		header := c.GetHeader(CSRFHeader)
+		if err != nil || cookie == "" || subtle.ConstantTimeCompare([]byte(cookie), []byte(header)) != 1 {
+			Abort(c, apierror.Forbidden(i18n.CodeCSRFTokenInvalid))
+			return
+		}
+
+		c.Next()
+	}
+}
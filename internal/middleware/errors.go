@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// ErrorHandler renders the last error recorded with Abort (or c.Error) as a JSON
+// error response, unless the handler already wrote a response. Errors that
+// aren't *apierror.Error are reported as internal errors without their message.
+func ErrorHandler() gin.HandlerFunc {
+	// Report JSON field names rather than Go field names in validation errors
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "" || name == "-" {
+				return field.Name
+			}
+			return name
+		})
+	}
+
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		var apiErr *apierror.Error
+		if !errors.As(c.Errors.Last().Err, &apiErr) {
+			apiErr = apierror.Internal(i18n.CodeInternalError, c.Errors.Last().Err)
+		}
+
+		c.JSON(apiErr.Status, ErrorResponse(c, apiErr))
+	}
+}
+
+// Abort records err for ErrorHandler and stops the remaining handlers.
+// The caller must still return.
+func Abort(c *gin.Context, err error) {
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// ErrorResponse builds the JSON body for an error, translating its message into
+// the request's language
+func ErrorResponse(c *gin.Context, apiErr *apierror.Error) models.ErrorResponse {
+	lang := Language(c)
+	message := i18n.Translate(lang, apiErr.Code)
+	detail := message
+	if apiErr.Message != "" {
+		detail = apiErr.Message
+	}
+
+	// Describe invalid fields in the request's language; together they make
+	// up the message
+	details := apiErr.Details
+	if fields, ok := details.([]apierror.FieldError); ok {
+		localized := make([]apierror.FieldError, len(fields))
+		messages := make([]string, len(fields))
+		for i, field := range fields {
+			localized[i] = field.Localize(lang)
+			messages[i] = localized[i].Message
+		}
+		details = localized
+		if apiErr.Message == "" && len(messages) > 0 {
+			detail = strings.Join(messages, "; ")
+		}
+	}
+
+	return models.ErrorResponse{
+		Status:    "error",
+		Code:      apiErr.Code,
+		Error:     message,
+		Message:   detail,
+		Details:   details,
+		RequestID: c.GetString("requestID"),
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+)
+
+// ImageURLs rewrites the Cloudinary image URLs in JSON responses to GET
+// requests so they are served from the image CDN. A width query parameter
+// sizes every image in the response for the client's layout.
+func ImageURLs(cdn *services.ImageCDN) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !cdn.Enabled() || (c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead) {
+			c.Next()
+			return
+		}
+
+		width, err := strconv.Atoi(c.Query("width"))
+		if err != nil || width < 0 {
+			width = 0
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		defer restoreWriterOnPanic(c, writer.ResponseWriter)
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.body.Len() > 0 && strings.HasPrefix(writer.Header().Get("Content-Type"), "application/json") {
+			body := cdn.RewriteAll(writer.body.Bytes(), width)
+			writer.body.Reset()
+			writer.body.Write(body)
+			writer.Header().Del("Content-Length")
+		}
+		writer.flush()
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+)
+
+// languageKey is the context key holding the negotiated response language
+const languageKey = "language"
+
+// Localization negotiates the response language from the Accept-Language header
+func Localization() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := i18n.Negotiate(c.GetHeader("Accept-Language"))
+		c.Set(languageKey, lang)
+		c.Header("Content-Language", lang)
+		c.Next()
+	}
+}
+
+// Language returns the negotiated language for the request
+func Language(c *gin.Context) string {
+	if lang := c.GetString(languageKey); lang != "" {
+		return lang
+	}
+	return i18n.Negotiate(c.GetHeader("Accept-Language"))
+}
@@ -22,7 +22,7 @@ func LoggerMiddleware() gin.HandlerFunc {
 		end := time.Now()
 		latency := end.Sub(start)
 
-		clientIP := c.ClientIP()
+		clientIP := ClientIP(c)
 		method := c.Request.Method
 		statusCode := c.Writer.Status()
 		errorMessage := c.Errors.ByType(gin.ErrorTypePrivate).String()
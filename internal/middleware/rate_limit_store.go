@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// RateLimitResult describes the outcome of a rate limit check
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration // Time until the oldest request leaves the window
+}
+
+// RateLimitStore keeps sliding-window request counters for rate limiting.
+// Implementations must be safe for concurrent use.
+type RateLimitStore interface {
+	// Allow records a request for key if it fits within limit requests per window
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitResult, error)
+}
+
+// NewRateLimitStore creates the rate limit store selected in the configuration
+func NewRateLimitStore(cfg config.RateLimitConfig) (RateLimitStore, error) {
+	switch cfg.Store {
+	case "", "memory":
+		store := NewMemoryRateLimitStore()
+		store.CleanupTask()
+		log.Info().Msg("Using in-memory rate limit store")
+		return store, nil
+	case "redis":
+		if cfg.RedisURL == "" {
+			return nil, fmt.Errorf("REDIS_URL is required when RATE_LIMIT_STORE=redis")
+		}
+
+		opts, err := redis.ParseURL(cfg.RedisURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid REDIS_URL: %w", err)
+		}
+
+		client := redis.NewClient(opts)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := client.Ping(ctx).Err(); err != nil {
+			return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+		}
+
+		log.Info().Str("addr", opts.Addr).Msg("Using Redis rate limit store")
+		return NewRedisRateLimitStore(client), nil
+	default:
+		return nil, fmt.Errorf("unknown RATE_LIMIT_STORE %q (expected memory or redis)", cfg.Store)
+	}
+}
+
+// MemoryRateLimitStore keeps per-key request timestamps in a process-local map.
+// It is only suitable for single-instance deployments.
+type MemoryRateLimitStore struct {
+	// key -> request timestamps inside the window
+	requests map[string]*memoryRateLimitEntry
+	mu       sync.Mutex
+}
+
+// memoryRateLimitEntry holds the requests of one key with the window they
+// are counted in. Limiters with different windows share the store, so each
+// key is pruned by its own window.
+type memoryRateLimitEntry struct {
+	timestamps []time.Time
+	window     time.Duration
+}
+
+// NewMemoryRateLimitStore creates a new in-memory rate limit store
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		requests: make(map[string]*memoryRateLimitEntry),
+	}
+}
+
+// Allow implements RateLimitStore
+func (s *MemoryRateLimitStore) Allow(_ context.Context, key string, limit int, window time.Duration) (RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	// Filter out timestamps that are outside of our window
+	var requests []time.Time
+	if entry, ok := s.requests[key]; ok {
+		for _, timestamp := range entry.timestamps {
+			if now.Sub(timestamp) < window {
+				requests = append(requests, timestamp)
+			}
+		}
+	}
+
+	allowed := len(requests) < limit
+	if allowed {
+		requests = append(requests, now)
+	}
+	s.requests[key] = &memoryRateLimitEntry{timestamps: requests, window: window}
+
+	resetAfter := window
+	if len(requests) > 0 {
+		resetAfter = requests[0].Add(window).Sub(now)
+	}
+
+	return RateLimitResult{
+		Allowed:    allowed,
+		Limit:      limit,
+		Remaining:  max(limit-len(requests), 0),
+		ResetAfter: resetAfter,
+	}, nil
+}
+
+// CleanupTask starts a background goroutine to clean up old records, pruning
+// each key by the window it was last checked with
+func (s *MemoryRateLimitStore) CleanupTask() {
+	go func() {
+		for {
+			time.Sleep(time.Minute)
+
+			s.mu.Lock()
+			now := time.Now()
+
+			// For each key, filter out old timestamps
+			for key, entry := range s.requests {
+				var newTimestamps []time.Time
+				for _, timestamp := range entry.timestamps {
+					if now.Sub(timestamp) < entry.window {
+						newTimestamps = append(newTimestamps, timestamp)
+					}
+				}
+
+				// If no timestamps remain, remove the key entirely
+				if len(newTimestamps) == 0 {
+					delete(s.requests, key)
+				} else {
+					entry.timestamps = newTimestamps
+				}
+			}
+
+			s.mu.Unlock()
+		}
+	}()
+}
+
+// slidingWindowScript atomically trims the window, checks the count and records the request.
+// Returns {allowed, count, reset_ms}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, member)
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, count, reset}
+`)
+
+// RedisRateLimitStore keeps sliding-window counters in Redis sorted sets so that
+// limits are shared across all API instances
+type RedisRateLimitStore struct {
+	client *redis.Client
+}
+
+// NewRedisRateLimitStore creates a new Redis-backed rate limit store
+func NewRedisRateLimitStore(client *redis.Client) *RedisRateLimitStore {
+	return &RedisRateLimitStore{client: client}
+}
+
+// Allow implements RateLimitStore
+func (s *RedisRateLimitStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitResult, error) {
+	now := time.Now().UnixMilli()
+	member := strconv.FormatInt(now, 10) + "-" + uuid.NewString()
+
+	values, err := slidingWindowScript.Run(ctx, s.client, []string{key},
+		now, window.Milliseconds(), limit, member).Int64Slice()
+	if err != nil {
+		return RateLimitResult{}, fmt.Errorf("failed to evaluate rate limit: %w", err)
+	}
+	if len(values) != 3 {
+		return RateLimitResult{}, fmt.Errorf("unexpected rate limit script result: %v", values)
+	}
+
+	count := int(values[1])
+	return RateLimitResult{
+		Allowed:    values[0] == 1,
+		Limit:      limit,
+		Remaining:  max(limit-count, 0),
+		ResetAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
@@ -1,119 +1,67 @@
 package middleware
 
 import (
-	"net"
+	"math"
 	"net/http"
-	"sync"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/rs/zerolog/log"
 )
 
 // Rate limiting configuration
 type RateLimiter struct {
-	// IP address -> last seen time
-	ips map[string][]time.Time
-	mu  sync.Mutex
+	// Backing store that keeps the request counters
+	store RateLimitStore
+	// Name used to namespace keys so that different limiters don't share counters
+	name string
 	// Max requests per time window
 	max int
 	// Time window duration
 	window time.Duration
 }
 
-// NewRateLimiter creates a new rate limiter
-func NewRateLimiter(max int, window time.Duration) *RateLimiter {
+// NewRateLimiter creates a new rate limiter backed by the given store
+func NewRateLimiter(store RateLimitStore, name string, max int, window time.Duration) *RateLimiter {
 	return &RateLimiter{
-		ips:    make(map[string][]time.Time),
+		store:  store,
+		name:   name,
 		max:    max,
 		window: window,
 	}
 }
 
-// RateLimitMiddleware limits the number of requests from a single IP
+// RateLimitMiddleware limits the number of requests from a single IP using a sliding window
 func (rl *RateLimiter) RateLimitMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Get client IP
-		ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
-		if err != nil {
-			// If there's an error, use the RemoteAddr directly
-			ip = c.Request.RemoteAddr
-		}
-
-		// For extra security, also check X-Forwarded-For header
-		// if our application is behind a proxy or load balancer
-		forwardedFor := c.Request.Header.Get("X-Forwarded-For")
-		if forwardedFor != "" {
-			// Use the first IP in the list
-			ip = forwardedFor
-		}
+		// Forwarding headers only count when set by a trusted proxy
+		ip := ClientIP(c)
 
-		rl.mu.Lock()
-		defer rl.mu.Unlock()
-
-		// Get current time
-		now := time.Now()
-
-		// Initialize if this is the first request from this IP
-		if _, exists := rl.ips[ip]; !exists {
-			rl.ips[ip] = []time.Time{now}
+		key := "ratelimit:" + rl.name + ":" + ip
+		result, err := rl.store.Allow(c.Request.Context(), key, rl.max, rl.window)
+		if err != nil {
+			// Fail open: a broken rate limit store should not take the API down
+			log.Warn().Err(err).Str("limiter", rl.name).Msg("Rate limit store unavailable, allowing request")
 			c.Next()
 			return
 		}
 
-		// Filter out timestamps that are outside of our window
-		var requests []time.Time
-		for _, timestamp := range rl.ips[ip] {
-			if now.Sub(timestamp) <= rl.window {
-				requests = append(requests, timestamp)
-			}
-		}
-
-		// Add current request
-		requests = append(requests, now)
-		rl.ips[ip] = requests
+		// Standard RateLimit-* headers (IETF draft-ietf-httpapi-ratelimit-headers)
+		resetSeconds := int(math.Ceil(result.ResetAfter.Seconds()))
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(resetSeconds))
 
 		// Check if we've exceeded our limit
-		if len(requests) > rl.max {
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"status":  "error",
-				"error":   "Rate limit exceeded",
-				"message": "Too many requests, please try again later",
-			})
-			c.Abort()
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(resetSeconds))
+			Abort(c, apierror.New(http.StatusTooManyRequests, i18n.CodeRateLimited))
 			return
 		}
 
 		c.Next()
 	}
 }
-
-// CleanupTask starts a background goroutine to clean up old IP records
-func (rl *RateLimiter) CleanupTask() {
-	go func() {
-		for {
-			time.Sleep(time.Minute)
-
-			rl.mu.Lock()
-			now := time.Now()
-
-			// For each IP, filter out old timestamps
-			for ip, timestamps := range rl.ips {
-				var newTimestamps []time.Time
-				for _, timestamp := range timestamps {
-					if now.Sub(timestamp) <= rl.window {
-						newTimestamps = append(newTimestamps, timestamp)
-					}
-				}
-
-				// If no timestamps remain, remove the IP entirely
-				if len(newTimestamps) == 0 {
-					delete(rl.ips, ip)
-				} else {
-					rl.ips[ip] = newTimestamps
-				}
-			}
-
-			rl.mu.Unlock()
-		}
-	}()
-}
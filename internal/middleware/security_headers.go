@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+)
+
+// swaggerPathPrefix is where the Swagger UI pages are served
+const swaggerPathPrefix = "/swagger/"
+
+// SecurityHeaders adds the browser security headers configured in cfg to
+// every response: HSTS, X-Content-Type-Options, X-Frame-Options and
+// Referrer-Policy, plus a Content-Security-Policy for the Swagger UI pages,
+// the only HTML the API serves.
+func SecurityHeaders(cfg config.SecurityHeadersConfig) gin.HandlerFunc {
+	var hsts string
+	if cfg.HSTS {
+		hsts = "max-age=" + strconv.Itoa(int(cfg.HSTSMaxAge.Seconds()))
+		if cfg.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		if hsts != "" {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("X-Frame-Options", cfg.FrameOptions)
+		if cfg.ReferrerPolicy != "" {
+			header.Set("Referrer-Policy", cfg.ReferrerPolicy)
+		}
+		if cfg.SwaggerCSP != "" && strings.HasPrefix(c.Request.URL.Path, swaggerPathPrefix) {
+			header.Set("Content-Security-Policy", cfg.SwaggerCSP)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"github.com/rs/zerolog/log"
+)
+
+// Site resolves the site a request is for from its Host header and binds it
+// to the request context, so the request's queries only see the site's posts,
+// tags, pages and news sources. Hosts no site is configured for get the
+// default site.
+func Site(sites *services.SiteService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		siteID := models.DefaultSiteID
+		if resolved, err := sites.Resolve(c.Request.Host); err != nil {
+			// Serving the default site keeps single-site deployments and the
+			// health checks working while the database is unreachable
+			log.Warn().Err(err).Str("host", c.Request.Host).Msg("Failed to resolve site, using the default site")
+		} else {
+			siteID = resolved.ID
+		}
+
+		c.Set("siteID", siteID)
+		c.Request = c.Request.WithContext(site.WithID(c.Request.Context(), siteID))
+		c.Next()
+	}
+}
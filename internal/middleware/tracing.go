@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+)
+
+// Tracing records a server span for every request. The span continues the
+// trace in the caller's traceparent header, and is stored in the request
+// context so database queries and outbound calls become its children.
+func Tracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !tracing.Enabled() {
+			c.Next()
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched route"
+		}
+
+		ctx := tracing.Extract(c.Request.Context(), c.Request.Header)
+		ctx, span := tracing.Start(ctx, c.Request.Method+" "+route, tracing.SpanKindServer)
+		defer span.End()
+
+		span.SetAttribute("http.request.method", c.Request.Method)
+		span.SetAttribute("http.route", c.FullPath())
+		span.SetAttribute("url.path", c.Request.URL.Path)
+		span.SetAttribute("client.address", ClientIP(c))
+		span.SetAttribute("request_id", c.GetString("requestID"))
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttribute("http.response.status_code", status)
+		if userID, exists := c.Get("userID"); exists {
+			span.SetAttribute("user.id", fmt.Sprint(userID))
+		}
+		if status >= http.StatusInternalServerError {
+			if err := c.Errors.Last(); err != nil {
+				span.RecordError(err.Err)
+			} else {
+				span.RecordError(fmt.Errorf("HTTP %d", status))
+			}
+		}
+	}
+}
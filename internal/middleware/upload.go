@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/apierror"
+	"github.com/phanvantai/taiphanvan_backend/internal/i18n"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
+	"github.com/rs/zerolog/log"
+)
+
+// UploadGuard checks the content of the file uploaded in policy.Field before
+// the handler stores it: the type is sniffed from the content, images are
+// held to the configured dimension limits, SVG files are replaced with their
+// sanitized content and, when ClamAV is configured, the file is scanned.
+// Requests without the file, or with one over policy.MaxSize, are passed on
+// for the handler to reject.
+func UploadGuard(policy upload.Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		file, err := c.FormFile(policy.Field)
+		if err != nil || (policy.MaxSize > 0 && file.Size > policy.MaxSize) {
+			c.Next()
+			return
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			Abort(c, apierror.BadRequest(i18n.CodeFileCorrupt))
+			return
+		}
+		data, err := io.ReadAll(src)
+		src.Close()
+		if err != nil {
+			Abort(c, apierror.BadRequest(i18n.CodeFileCorrupt))
+			return
+		}
+
+		cfg := AppConfig.Uploads
+		contentType, checked, err := upload.Inspect(file.Filename, data, policy, upload.Limits{
+			MaxWidth:  cfg.MaxImageWidth,
+			MaxHeight: cfg.MaxImageHeight,
+		})
+		if err != nil {
+			log.Warn().Err(err).Str("filename", file.Filename).Msg("Rejected upload")
+			switch {
+			case errors.Is(err, upload.ErrTypeNotAllowed):
+				Abort(c, apierror.BadRequest(i18n.CodeFileContentMismatch))
+			case errors.Is(err, upload.ErrImageTooLarge):
+				Abort(c, apierror.BadRequest(i18n.CodeImageTooLarge).WithDetails(gin.H{
+					"max_width":  cfg.MaxImageWidth,
+					"max_height": cfg.MaxImageHeight,
+				}))
+			default:
+				Abort(c, apierror.BadRequest(i18n.CodeFileCorrupt))
+			}
+			return
+		}
+
+		if cfg.ClamAVAddress != "" {
+			scanner := upload.NewClamAVScanner(cfg.ClamAVAddress, cfg.ClamAVTimeout)
+			if err := scanner.Scan(c.Request.Context(), checked); errors.Is(err, upload.ErrInfected) {
+				log.Warn().Err(err).Str("filename", file.Filename).Msg("Rejected infected upload")
+				Abort(c, apierror.BadRequest(i18n.CodeFileInfected))
+				return
+			} else if err != nil {
+				Abort(c, apierror.Internal(i18n.CodeFileScanFailed, err))
+				return
+			}
+		}
+
+		// Hand the sanitized content to the handler in place of the upload
+		if !bytes.Equal(checked, data) {
+			sanitized, err := upload.FileHeader(policy.Field, file.Filename, contentType, checked)
+			if err != nil {
+				Abort(c, apierror.Internal(i18n.CodeFileUploadFailed, err))
+				return
+			}
+			c.Request.MultipartForm.File[policy.Field][0] = sanitized
+		}
+
+		c.Next()
+	}
+}
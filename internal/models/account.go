@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// UserDataExport holds everything the blog stores about a user, for data
+// export requests
+// @Description All of a user's data: profile, posts, comments, comment reactions, bookmarks and series
+type UserDataExport struct {
+	ExportedAt    time.Time         `json:"exported_at" example:"2023-01-05T12:00:00Z" description:"When the export was made"`
+	Profile       User              `json:"profile" description:"The user's account and profile"`
+	Posts         []Post            `json:"posts" description:"Posts the user owns, with their tags and category"`
+	Comments      []Comment         `json:"comments" description:"Comments the user wrote, including those awaiting moderation"`
+	Reactions     []CommentReaction `json:"comment_reactions" description:"Reactions the user gave to comments"`
+	Bookmarks     []Bookmark        `json:"bookmarks" description:"Posts the user bookmarked"`
+	NewsBookmarks []NewsBookmark    `json:"news_bookmarks" description:"News articles the user bookmarked"`
+	Series        []Series          `json:"series" description:"Series the user created"`
+	Progress      []ReadingProgress `json:"reading_progress" description:"How far the user got in posts"`
+}
+
+// DeleteAccountRequest represents the request body for deleting one's own account
+// @Description Request model for deleting the current user's account
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required" example:"password123" description:"Current password, to confirm the deletion"`
+}
+
+// UserPurgeResult reports the deleted users that were permanently removed
+// @Description Result of purging deleted users whose undo window has passed
+type UserPurgeResult struct {
+	Purged  int    `json:"purged" example:"2" description:"Number of users purged"`
+	UserIDs []uint `json:"user_ids" example:"42,43" description:"IDs of the purged users"`
+}
@@ -0,0 +1,91 @@
+package models
+
+import "time"
+
+// AnalyticsEventType is what a reader did on a post page
+type AnalyticsEventType string
+
+// Events the frontend reports
+const (
+	// AnalyticsEventPageview: a post page was opened
+	AnalyticsEventPageview AnalyticsEventType = "pageview"
+	// AnalyticsEventProgress: the reader scrolled to Progress percent of the post
+	AnalyticsEventProgress AnalyticsEventType = "progress"
+)
+
+// AnalyticsEvent is a raw event reported by the frontend. Events are summed
+// into PostAnalyticsDay rows and deleted after ANALYTICS_EVENT_RETENTION.
+type AnalyticsEvent struct {
+	ID     uint `gorm:"primaryKey"`
+	PostID uint `gorm:"not null;index"`
+	// ViewID is random per page load and ties a view to its progress events
+	ViewID string `gorm:"size:64;not null"`
+	// ReaderID and UserID identify the reader and are only stored when the
+	// analytics policy allows tracking them individually
+	ReaderID  *string            `gorm:"size:64"`
+	UserID    *uint              `gorm:"constraint:OnDelete:SET NULL"`
+	Type      AnalyticsEventType `gorm:"type:varchar(20);not null"`
+	Progress  int                `gorm:"not null;default:0"`
+	CreatedAt time.Time          `gorm:"index"`
+}
+
+// AnalyticsEventInput is one event in an ingestion batch
+// @Description A reader event on a post page
+type AnalyticsEventInput struct {
+	Type     AnalyticsEventType `json:"type" binding:"required,oneof=pageview progress" example:"progress" description:"Event (pageview, progress)"`
+	PostID   uint               `json:"post_id" binding:"required" example:"1" description:"ID of the post being read"`
+	ViewID   string             `json:"view_id" binding:"required,max=64" example:"4f9c2a7e-0d5b-4c1e-9a0f-3b2d8e6c1a57" description:"Random ID generated for each page load"`
+	ReaderID string             `json:"reader_id,omitempty" binding:"max=64" example:"b1e8d0c2-6a3f-4f5e-8d7c-9a2b1c0d3e4f" description:"Random ID kept by the browser across visits; dropped when the reader may not be tracked"`
+	Progress int                `json:"progress" binding:"min=0,max=100" example:"50" description:"Percent of the post scrolled through, for progress events"`
+}
+
+// AnalyticsEventsRequest represents the request body for reporting events
+// @Description Request model for reporting a batch of reader events
+type AnalyticsEventsRequest struct {
+	Events []AnalyticsEventInput `json:"events" binding:"required,min=1,max=50,dive"`
+}
+
+// AnalyticsEventsResponse is the result of reporting events
+// @Description Number of reported events that were stored
+type AnalyticsEventsResponse struct {
+	Accepted int `json:"accepted" example:"3" description:"Events stored; events for unknown or unpublished posts are dropped"`
+}
+
+// PostAnalyticsDay holds the reader counters of a post for one day
+// @Description Reader counters of a post for one day
+type PostAnalyticsDay struct {
+	PostID        uint      `json:"-" gorm:"primaryKey"`
+	Day           time.Time `json:"day" gorm:"primaryKey;type:date" example:"2023-01-01T00:00:00Z" description:"Day, in UTC"`
+	Views         int64     `json:"views" gorm:"not null;default:0" example:"120" description:"Page loads"`
+	UniqueReaders int64     `json:"unique_readers" gorm:"not null;default:0" example:"95" description:"Distinct readers; views of readers who may not be tracked count once each"`
+	Reached25     int64     `json:"reached_25" gorm:"column:reached_25;not null;default:0" example:"80" description:"Views that scrolled through a quarter of the post"`
+	Reached50     int64     `json:"reached_50" gorm:"column:reached_50;not null;default:0" example:"61" description:"Views that scrolled through half of the post"`
+	Reached75     int64     `json:"reached_75" gorm:"column:reached_75;not null;default:0" example:"47" description:"Views that scrolled through three quarters of the post"`
+	Reached100    int64     `json:"reached_100" gorm:"column:reached_100;not null;default:0" example:"33" description:"Views that reached the end of the post"`
+}
+
+// TableName keeps the table name descriptive of its daily rows
+func (PostAnalyticsDay) TableName() string {
+	return "post_analytics_daily"
+}
+
+// PostReadThrough holds the share of views that scrolled through a post
+// @Description Share of views reaching each point of a post, between 0 and 1
+type PostReadThrough struct {
+	Reached25  float64 `json:"reached_25" example:"0.67" description:"Share of views that scrolled through a quarter of the post"`
+	Reached50  float64 `json:"reached_50" example:"0.51" description:"Share of views that scrolled through half of the post"`
+	Reached75  float64 `json:"reached_75" example:"0.39" description:"Share of views that scrolled through three quarters of the post"`
+	Reached100 float64 `json:"reached_100" example:"0.28" description:"Share of views that reached the end of the post"`
+}
+
+// PostAnalytics reports how a post is read. Events are summed every
+// ANALYTICS_ROLLUP_INTERVAL, so the latest ones may not be counted yet.
+// @Description Views, readers and read-through rates of a post
+type PostAnalytics struct {
+	PostID        uint               `json:"post_id" example:"1" description:"ID of the post"`
+	From          time.Time          `json:"from" example:"2023-01-01T00:00:00Z" description:"First day covered, in UTC"`
+	Views         int64              `json:"views" example:"120" description:"Page loads over the period"`
+	UniqueReaders int64              `json:"unique_readers" example:"95" description:"Sum of the daily distinct readers"`
+	ReadThrough   PostReadThrough    `json:"read_through" description:"Share of views reaching each point of the post"`
+	Days          []PostAnalyticsDay `json:"days" description:"Counters per day, oldest first; days without views are left out"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKeyScope is a permission granted to an API key
+type APIKeyScope string
+
+const (
+	// APIKeyScopeRead allows GET and HEAD requests
+	APIKeyScopeRead APIKeyScope = "read"
+	// APIKeyScopeWrite allows requests that change data
+	APIKeyScopeWrite APIKeyScope = "write"
+)
+
+// APIKey is a long-lived credential for programmatic access, sent in the
+// X-API-Key header instead of a Bearer token. Only a hash of the key is stored.
+// @Description An API key for programmatic access. The key itself is only shown when it is created.
+type APIKey struct {
+	ID         uint          `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UserID     uint          `json:"user_id" gorm:"not null;index" example:"1" description:"ID of the user the key acts as"`
+	Name       string        `json:"name" gorm:"size:100;not null" example:"Static site build" description:"Name to recognize the key by"`
+	Prefix     string        `json:"prefix" gorm:"size:16;not null" example:"tpv_3f2a9c1d" description:"First characters of the key, to recognize it by"`
+	KeyHash    string        `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	Scopes     []APIKeyScope `json:"scopes" gorm:"type:text;serializer:json" example:"read" description:"What the key may do (read, write)"`
+	ExpiresAt  *time.Time    `json:"expires_at,omitempty" example:"2024-01-01T12:00:00Z" description:"When the key stops working, if it expires"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty" example:"2023-01-02T12:00:00Z" description:"When the key was last used, to within a minute"`
+	RevokedAt  *time.Time    `json:"revoked_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When the key was revoked"`
+	CreatedAt  time.Time     `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the key was created"`
+}
+
+// HasScope reports whether the key was granted scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// CreateAPIKeyRequest represents the request body for creating an API key
+// @Description Request model for creating an API key
+type CreateAPIKeyRequest struct {
+	Name          string        `json:"name" binding:"required,max=100" example:"Static site build" description:"Name to recognize the key by"`
+	Scopes        []APIKeyScope `json:"scopes" binding:"required,min=1,dive,oneof=read write" example:"read" description:"What the key may do (read, write)"`
+	ExpiresInDays *int          `json:"expires_in_days,omitempty" binding:"omitempty,min=1,max=3650" example:"90" description:"Days until the key expires; omit for a key that doesn't expire"`
+}
+
+// CreateAPIKeyResponse is returned when an API key is created
+// @Description A newly created API key, including the key itself, which is not shown again
+type CreateAPIKeyResponse struct {
+	APIKey
+	Key string `json:"key" example:"tpv_3f2a9c1d8e4b7a6f5e4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b" description:"The API key. Store it now; it can't be retrieved later."`
+}
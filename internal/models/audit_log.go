@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+// Actions recorded in the audit log
+const (
+	AuditActionPostDeleted         = "post.deleted"
+	AuditActionNewsDeleted         = "news.deleted"
+	AuditActionNewsStatusChanged   = "news.status_changed"
+	AuditActionUserDeleted         = "user.deleted"
+	AuditActionUserRestored        = "user.restored"
+	AuditActionUserPurged          = "user.purged"
+	AuditActionUserRoleChanged     = "user.role_changed"
+	AuditActionRoleCreated         = "role.created"
+	AuditActionRoleUpdated         = "role.updated"
+	AuditActionRoleDeleted         = "role.deleted"
+	AuditActionSiteCreated         = "site.created"
+	AuditActionSiteUpdated         = "site.updated"
+	AuditActionSiteDeleted         = "site.deleted"
+	AuditActionTokenRevoked        = "token.revoked"
+	AuditActionAPIKeyRevoked       = "api_key.revoked"
+	AuditActionCategoryDeleted     = "category.deleted"
+	AuditActionPageDeleted         = "page.deleted"
+	AuditActionPostTemplateDeleted = "post_template.deleted"
+	AuditActionTagRenamed          = "tag.renamed"
+	AuditActionTagMerged           = "tag.merged"
+	AuditActionTagDeleted          = "tag.deleted"
+	AuditActionSettingUpdated      = "setting.updated"
+	AuditActionJWTKeyRotated       = "jwt_key.rotated"
+	AuditActionJWTKeyRetired       = "jwt_key.retired"
+	AuditActionNewsletterSent      = "newsletter.sent"
+)
+
+// AuditLog records an admin or destructive action: who did it, to what, and
+// what the resource looked like before and after
+// @Description An entry in the audit log
+type AuditLog struct {
+	ID           uint        `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	ActorID      *uint       `json:"actor_id" gorm:"index" example:"1" description:"ID of the user who performed the action"`
+	ActorRole    string      `json:"actor_role" gorm:"size:20" example:"admin" description:"Role of the user when they performed the action"`
+	Action       string      `json:"action" gorm:"size:50;not null;index" example:"news.status_changed" description:"What was done"`
+	ResourceType string      `json:"resource_type" gorm:"size:50;not null;index:idx_audit_logs_resource" example:"news" description:"Kind of resource acted on"`
+	ResourceID   string      `json:"resource_id" gorm:"size:64;index:idx_audit_logs_resource" example:"42" description:"ID of the resource acted on"`
+	Before       interface{} `json:"before,omitempty" gorm:"type:text;serializer:json" description:"Relevant fields of the resource before the action"`
+	After        interface{} `json:"after,omitempty" gorm:"type:text;serializer:json" description:"Relevant fields of the resource after the action"`
+	RequestID    string      `json:"request_id" gorm:"size:64;index" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"ID of the request that performed the action"`
+	IPAddress    string      `json:"ip_address" gorm:"size:45" example:"203.0.113.7" description:"Client IP address of the request"`
+	CreatedAt    time.Time   `json:"created_at" gorm:"index" example:"2023-01-01T12:00:00Z" description:"When the action was performed"`
+}
+
+// AuditLogQuery represents the query parameters of the audit log list
+type AuditLogQuery struct {
+	ActorID      uint       `form:"actor_id" description:"Only actions by this user"`
+	Action       string     `form:"action" description:"Only this action"`
+	ResourceType string     `form:"resource_type" description:"Only actions on this kind of resource"`
+	ResourceID   string     `form:"resource_id" description:"Only actions on this resource"`
+	RequestID    string     `form:"request_id" description:"Only actions performed by this request"`
+	From         *time.Time `form:"from" description:"Only actions at or after this time"`
+	To           *time.Time `form:"to" description:"Only actions before this time"`
+	Page         int        `form:"page" description:"Page number"`
+	PerPage      int        `form:"per_page" description:"Items per page"`
+}
+
+// AuditLogListResponse represents a page of the audit log
+// @Description Response model for listing audit log entries
+type AuditLogListResponse struct {
+	Logs       []AuditLog `json:"logs" description:"Audit log entries, newest first"`
+	TotalItems int64      `json:"total_items" example:"100" description:"Total number of matching entries"`
+	Page       int        `json:"page" example:"1" description:"Current page number"`
+	PerPage    int        `json:"per_page" example:"20" description:"Number of items per page"`
+	TotalPages int        `json:"total_pages" example:"5" description:"Total number of pages"`
+}
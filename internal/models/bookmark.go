@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Bookmark is a post a user saved to read later
+// @Description A post saved to read later
+type Bookmark struct {
+	ID        uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_bookmarks_user_post"`
+	PostID    uint      `json:"post_id" gorm:"not null;uniqueIndex:idx_bookmarks_user_post;index" example:"1" description:"ID of the saved post"`
+	Post      Post      `json:"post" gorm:"foreignKey:PostID" description:"The saved post"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the post was saved"`
+}
+
+// NewsBookmark is a news article a user saved to read later
+// @Description A news article saved to read later
+type NewsBookmark struct {
+	ID        uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UserID    uint      `json:"-" gorm:"not null;uniqueIndex:idx_news_bookmarks_user_news"`
+	NewsID    uint      `json:"news_id" gorm:"not null;uniqueIndex:idx_news_bookmarks_user_news;index" example:"1" description:"ID of the saved news article"`
+	News      News      `json:"news" gorm:"foreignKey:NewsID;constraint:OnDelete:CASCADE" description:"The saved news article"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the news article was saved"`
+}
+
+// ReadingListItemType tells what kind of content a reading list item is
+type ReadingListItemType string
+
+const (
+	// ReadingListItemPost is a bookmarked post
+	ReadingListItemPost ReadingListItemType = "post"
+	// ReadingListItemNews is a bookmarked news article
+	ReadingListItemNews ReadingListItemType = "news"
+)
+
+// ReadingListItem is a bookmarked post or news article in a user's reading
+// list; only the field matching Type is set
+// @Description A post or news article saved to read later
+type ReadingListItem struct {
+	Type    ReadingListItemType `json:"type" example:"news" description:"Kind of item (post, news)"`
+	SavedAt time.Time           `json:"saved_at" example:"2023-01-01T12:00:00Z" description:"When the item was saved"`
+	Post    *Post               `json:"post,omitempty" description:"The saved post, for post items"`
+	News    *News               `json:"news,omitempty" description:"The saved news article, for news items"`
+}
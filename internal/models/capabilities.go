@@ -0,0 +1,19 @@
+package models
+
+// RouteCapability describes one API endpoint
+// @Description An API endpoint and what a caller needs to use it
+type RouteCapability struct {
+	Method      string `json:"method" example:"GET" description:"HTTP method"`
+	Path        string `json:"path" example:"/api/posts/{id}/comments" description:"Path, with parameters in braces"`
+	Access      string `json:"access" example:"public" enums:"public,user,admin,optional" description:"Who may call the endpoint"`
+	RateLimit   string `json:"rate_limit" example:"api" enums:"api,auth,none" description:"Rate limit the endpoint counts against"`
+	Cache       string `json:"cache,omitempty" example:"private, no-store" description:"Cache-Control header set on responses, if fixed"`
+	APIKey      bool   `json:"api_key" example:"false" description:"Whether an X-API-Key header is accepted instead of a Bearer token"`
+	Conditional bool   `json:"conditional" example:"false" description:"Whether responses carry an ETag and If-None-Match or If-Modified-Since can return 304 Not Modified"`
+}
+
+// CapabilitiesResponse lists the endpoints the API serves
+// @Description Endpoints served by this API instance
+type CapabilitiesResponse struct {
+	Routes []RouteCapability `json:"routes"`
+}
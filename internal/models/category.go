@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Category is a node in the hierarchical post taxonomy.
+// Unlike tags, categories form a tree that drives site navigation.
+// @Description A post category that can be nested under a parent category
+type Category struct {
+	ID          uint       `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Name        string     `json:"name" gorm:"size:100;not null" example:"Backend" description:"Category name"`
+	Slug        string     `json:"slug" gorm:"size:120;not null;uniqueIndex" example:"backend" description:"URL-friendly version of the name"`
+	Description string     `json:"description" gorm:"type:text" example:"Posts about server-side development" description:"Category description"`
+	ParentID    *uint      `json:"parent_id" gorm:"index" example:"2" description:"ID of the parent category, null for top-level categories"`
+	Position    int        `json:"position" gorm:"not null;default:0" example:"0" description:"Sort order among siblings"`
+	Children    []Category `json:"children,omitempty" gorm:"foreignKey:ParentID" description:"Child categories (only included in the tree view)"`
+	CreatedAt   time.Time  `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the category was created"`
+	UpdatedAt   time.Time  `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the category was last updated"`
+}
+
+// CreateCategoryRequest represents the request body for creating a category
+// @Description Request model for creating a post category
+type CreateCategoryRequest struct {
+	Name        string `json:"name" binding:"required,max=100" example:"Backend" description:"Category name"`
+	Slug        string `json:"slug" binding:"omitempty,max=120" example:"backend" description:"Custom slug (generated from the name if empty)"`
+	Description string `json:"description" example:"Posts about server-side development" description:"Category description"`
+	ParentID    *uint  `json:"parent_id" example:"2" description:"ID of the parent category"`
+	Position    int    `json:"position" example:"0" description:"Sort order among siblings"`
+}
+
+// UpdateCategoryRequest represents the request body for updating a category
+// @Description Request model for updating a post category
+type UpdateCategoryRequest struct {
+	Name        *string `json:"name" binding:"omitempty,max=100" example:"Backend" description:"New category name"`
+	Slug        *string `json:"slug" binding:"omitempty,max=120" example:"backend" description:"New slug"`
+	Description *string `json:"description" example:"Posts about server-side development" description:"New description"`
+	ParentID    *uint   `json:"parent_id" example:"2" description:"New parent category ID, 0 to move to the top level"`
+	Position    *int    `json:"position" example:"1" description:"New sort order among siblings"`
+}
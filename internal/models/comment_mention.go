@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// CommentMention records that a comment mentions a user with @username
+// @Description A user mentioned in a comment
+type CommentMention struct {
+	CommentID uint      `json:"-" gorm:"primaryKey"`
+	UserID    uint      `json:"user_id" gorm:"primaryKey;index" example:"2" description:"ID of the mentioned user"`
+	User      User      `json:"user" gorm:"foreignKey:UserID" description:"The mentioned user, for linking to their profile"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the user was first mentioned in the comment"`
+}
@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// CommentReactionType is a way readers can react to a comment
+type CommentReactionType string
+
+const (
+	// CommentReactionLike is a thumbs up
+	CommentReactionLike CommentReactionType = "like"
+	// CommentReactionHeart is a heart
+	CommentReactionHeart CommentReactionType = "heart"
+)
+
+// Valid reports whether t is one of the reaction types
+func (t CommentReactionType) Valid() bool {
+	return t == CommentReactionLike || t == CommentReactionHeart
+}
+
+// CommentReaction is a user's reaction to a comment. A user can give a
+// comment each kind of reaction once.
+// @Description A user's like or heart on a comment
+type CommentReaction struct {
+	ID        uint                `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	CommentID uint                `json:"comment_id" gorm:"not null;uniqueIndex:idx_comment_reactions_comment_user_type" example:"3" description:"ID of the comment reacted to"`
+	UserID    uint                `json:"user_id" gorm:"not null;uniqueIndex:idx_comment_reactions_comment_user_type;index" example:"1" description:"ID of the user who reacted"`
+	Type      CommentReactionType `json:"type" gorm:"type:varchar(20);not null;uniqueIndex:idx_comment_reactions_comment_user_type" example:"like" description:"Reaction type (like, heart)"`
+	CreatedAt time.Time           `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the user reacted"`
+}
+
+// CommentReactionCounts counts the reactions to a comment by type
+// @Description Number of reactions to a comment of each type
+type CommentReactionCounts struct {
+	Like  int64 `json:"like" example:"4" description:"Number of likes"`
+	Heart int64 `json:"heart" example:"2" description:"Number of hearts"`
+}
+
+// Total is the number of reactions of every type
+func (c CommentReactionCounts) Total() int64 {
+	return c.Like + c.Heart
+}
+
+// Add counts count more reactions of type t
+func (c *CommentReactionCounts) Add(t CommentReactionType, count int64) {
+	switch t {
+	case CommentReactionLike:
+		c.Like += count
+	case CommentReactionHeart:
+		c.Heart += count
+	}
+}
+
+// Comment orders for listing the comments on a post
+const (
+	// CommentSortNewest lists the newest comments first
+	CommentSortNewest = "newest"
+	// CommentSortTop lists the comments with the most reactions first
+	CommentSortTop = "top"
+)
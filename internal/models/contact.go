@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ContactMessage is a message sent through the site's contact form. Messages
+// are kept even when relaying them by email fails, so none are lost.
+// @Description A message sent through the contact form
+type ContactMessage struct {
+	ID        uint       `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Name      string     `json:"name" gorm:"size:100;not null" example:"Jane Reader" description:"Sender's name"`
+	Email     string     `json:"email" gorm:"size:255;not null" example:"jane@example.com" description:"Sender's email address, used as Reply-To"`
+	Message   string     `json:"message" gorm:"type:text;not null" example:"I enjoyed your post on Go generics." description:"Message text"`
+	IPAddress string     `json:"ip_address" gorm:"size:45" example:"203.0.113.7" description:"IP address the message was sent from"`
+	UserAgent string     `json:"user_agent" gorm:"size:500" example:"Mozilla/5.0" description:"User agent the message was sent with"`
+	RelayedAt *time.Time `json:"relayed_at" example:"2023-01-01T12:00:05Z" description:"When the message was emailed to the site owner, null if relaying failed"`
+	CreatedAt time.Time  `json:"created_at" gorm:"index" example:"2023-01-01T12:00:00Z" description:"When the message was sent"`
+}
+
+// ContactRequest represents the request body for the contact form
+// @Description Request model for sending a message to the site owner
+type ContactRequest struct {
+	Name         string `json:"name" binding:"required,max=100" example:"Jane Reader" description:"Sender's name"`
+	Email        string `json:"email" binding:"required,email,max=255" example:"jane@example.com" description:"Sender's email address"`
+	Message      string `json:"message" binding:"required,min=10,max=5000" example:"I enjoyed your post on Go generics." description:"Message text"`
+	CaptchaToken string `json:"captcha_token" example:"10000000-aaaa-bbbb-cccc-000000000001" description:"hCaptcha or Turnstile response token, required when a captcha is configured"`
+	// Website is a honeypot: clients render it as a hidden field and leave it
+	// empty, so a value means the form was filled in by a bot
+	Website string `json:"website,omitempty" example:"" description:"Leave empty. Hidden field used to detect bots."`
+}
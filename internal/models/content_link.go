@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// ContentLinkSource is the kind of content an outbound link was found in
+type ContentLinkSource string
+
+const (
+	// ContentLinkSourcePost is a link in a blog post
+	ContentLinkSourcePost ContentLinkSource = "post"
+	// ContentLinkSourceNews is a link in a news article
+	ContentLinkSourceNews ContentLinkSource = "news"
+)
+
+// ContentLink is an outbound link in a published post or news article and
+// the outcome of the last check of it. The link checker adds links when they
+// appear in content and removes them when they are edited out or the content
+// is no longer published.
+// @Description Outbound link in published content and the result of its last check
+type ContentLink struct {
+	ID         uint              `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	SourceType ContentLinkSource `json:"source_type" gorm:"size:20;not null;uniqueIndex:idx_content_links_source_url" example:"post" description:"Kind of content the link is in (post, news)"`
+	SourceID   uint              `json:"source_id" gorm:"not null;uniqueIndex:idx_content_links_source_url" example:"1" description:"ID of the post or news article"`
+	URL        string            `json:"url" gorm:"type:text;not null;uniqueIndex:idx_content_links_source_url" example:"https://example.com/gone" description:"Linked URL"`
+	Domain     string            `json:"domain" gorm:"size:255;not null;index" example:"example.com" description:"Host of the linked URL"`
+	StatusCode int               `json:"status_code,omitempty" example:"404" description:"HTTP status of the last check; absent when the request failed"`
+	Error      string            `json:"error,omitempty" gorm:"type:text" example:"" description:"Why the last check failed to get a response"`
+	Broken     bool              `json:"broken" gorm:"not null;default:false;index" example:"true" description:"Whether the last check failed or returned an error status"`
+	CheckedAt  *time.Time        `json:"checked_at,omitempty" gorm:"index" example:"2023-01-01T03:00:00Z" description:"When the link was last checked"`
+	BrokenAt   *time.Time        `json:"broken_at,omitempty" example:"2023-01-01T03:00:00Z" description:"When the link was first found broken, if it still is"`
+	CreatedAt  time.Time         `json:"created_at" example:"2023-01-01T00:00:00Z" description:"When the link was first found in the content"`
+	UpdatedAt  time.Time         `json:"updated_at" example:"2023-01-01T03:00:00Z" description:"When the link last changed"`
+}
+
+// BrokenLink is a broken outbound link with the content it is in, so editors
+// can go and fix it
+// @Description Broken outbound link and the content it is in
+type BrokenLink struct {
+	ContentLink
+	SourceTitle string `json:"source_title" example:"My First Blog Post" description:"Title of the post or news article"`
+	SourceSlug  string `json:"source_slug" example:"my-first-blog-post" description:"Slug of the post or news article"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// CrossPostTarget is a social network published posts are cross-posted to
+type CrossPostTarget string
+
+const (
+	// CrossPostX posts to an X (Twitter) account
+	CrossPostX CrossPostTarget = "x"
+	// CrossPostTelegram posts to a Telegram channel
+	CrossPostTelegram CrossPostTarget = "telegram"
+	// CrossPostLinkedIn posts to a LinkedIn member or organization page
+	CrossPostLinkedIn CrossPostTarget = "linkedin"
+)
+
+// CrossPostStatus is the delivery state of a cross-post
+type CrossPostStatus string
+
+const (
+	// CrossPostPending is waiting for its first attempt or a retry
+	CrossPostPending CrossPostStatus = "pending"
+	// CrossPostSent was posted to the network
+	CrossPostSent CrossPostStatus = "sent"
+	// CrossPostFailed failed every attempt; it can be retried by hand
+	CrossPostFailed CrossPostStatus = "failed"
+	// CrossPostCanceled was dropped because the post was unpublished, deleted
+	// or opted out before it went out
+	CrossPostCanceled CrossPostStatus = "canceled"
+)
+
+// CrossPost tracks sharing a published post's summary and link on one social
+// network. A post is cross-posted to each network once, the first time it is
+// published.
+// @Description Delivery of a published post to a social network
+type CrossPost struct {
+	ID            uint            `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	PostID        uint            `json:"post_id" gorm:"not null;uniqueIndex:idx_cross_posts_post_target" example:"1" description:"ID of the cross-posted post"`
+	Post          Post            `json:"-" gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE"`
+	Target        CrossPostTarget `json:"target" gorm:"size:20;not null;uniqueIndex:idx_cross_posts_post_target" example:"telegram" description:"Social network (x, telegram, linkedin)"`
+	Status        CrossPostStatus `json:"status" gorm:"size:20;not null;default:pending;index" example:"sent" description:"Delivery state (pending, sent, failed, canceled)"`
+	Attempts      int             `json:"attempts" gorm:"not null;default:0" example:"1" description:"Attempts made so far"`
+	NextAttemptAt *time.Time      `json:"next_attempt_at,omitempty" example:"2023-01-01T12:05:00Z" description:"When a pending cross-post is tried next"`
+	LastError     string          `json:"last_error,omitempty" gorm:"type:text" example:"" description:"Error of the last failed attempt"`
+	ExternalID    string          `json:"external_id,omitempty" gorm:"size:255" example:"1234" description:"ID the network gave the shared message"`
+	SentAt        *time.Time      `json:"sent_at,omitempty" example:"2023-01-01T12:00:05Z" description:"When the post was shared"`
+	CreatedAt     time.Time       `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the cross-post was queued"`
+	UpdatedAt     time.Time       `json:"updated_at" example:"2023-01-01T12:00:05Z" description:"When the cross-post last changed"`
+}
+
+// CrossPostRetryResponse reports the failed cross-posts of a post that were
+// queued again
+// @Description Result of retrying a post's failed cross-posts
+type CrossPostRetryResponse struct {
+	Retried    int64       `json:"retried" example:"1" description:"Number of failed cross-posts queued again"`
+	CrossPosts []CrossPost `json:"cross_posts" description:"The post's cross-posts after the retry"`
+}
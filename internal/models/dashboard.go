@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// AuthorDashboard is everything the home screen of an author's dashboard
+// shows, in one response
+// @Description Summary of the current user's posts, comments and notifications for the dashboard home screen
+type AuthorDashboard struct {
+	DraftCount          int64                `json:"draft_count" example:"3" description:"Number of draft posts the user owns or co-authors"`
+	ScheduledPosts      []Post               `json:"scheduled_posts" description:"The user's next 10 scheduled posts, soonest first"`
+	AwaitingReply       []Comment            `json:"awaiting_reply" description:"Up to 10 recent approved comments by others on the user's posts that the user hasn't replied to, newest first"`
+	TopPosts            []DashboardPostTrend `json:"top_posts" description:"The user's 5 most viewed posts over the last 30 days, with their views per day"`
+	UnreadNotifications int64                `json:"unread_notifications" example:"4" description:"Number of unread notifications"`
+	Notifications       []Notification       `json:"notifications" description:"The 5 newest unread notifications"`
+	GeneratedAt         time.Time            `json:"generated_at" example:"2023-01-01T12:00:00Z" description:"When the dashboard was computed"`
+}
+
+// DashboardPostTrend holds the views of one post per day
+// @Description Views of a post per day over the last 30 days
+type DashboardPostTrend struct {
+	PostID uint         `json:"post_id" example:"1" description:"ID of the post"`
+	Title  string       `json:"title" example:"Getting Started with Go" description:"Title of the post"`
+	Slug   string       `json:"slug" example:"getting-started-with-go" description:"Slug of the post"`
+	Views  int64        `json:"views" example:"200" description:"Views over the whole period"`
+	Days   []StatsPoint `json:"days" description:"Views per day (YYYY-MM-DD), oldest first, with zero for days without views"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// DiagnosticStatus is the outcome of a diagnostic check
+type DiagnosticStatus string
+
+const (
+	// DiagnosticPass means the check succeeded
+	DiagnosticPass DiagnosticStatus = "pass"
+	// DiagnosticWarn means the dependency works but needs attention, or isn't configured
+	DiagnosticWarn DiagnosticStatus = "warn"
+	// DiagnosticFail means the dependency is broken
+	DiagnosticFail DiagnosticStatus = "fail"
+)
+
+// DiagnosticCheck is the result of a single check
+// @Description The result of a single diagnostic check
+type DiagnosticCheck struct {
+	Name       string           `json:"name" example:"cloudinary" description:"What was checked"`
+	Status     DiagnosticStatus `json:"status" example:"pass" description:"Outcome of the check (pass, warn, fail)"`
+	Message    string           `json:"message" example:"Credentials accepted" description:"Details about the outcome"`
+	DurationMs int64            `json:"duration_ms" example:"183" description:"How long the check took"`
+}
+
+// DiagnosticsReport collects the results of all diagnostic checks
+// @Description Report of the checks run against the application's dependencies
+type DiagnosticsReport struct {
+	Status      DiagnosticStatus  `json:"status" example:"warn" description:"Worst status among the checks"`
+	Checks      []DiagnosticCheck `json:"checks" description:"Individual check results"`
+	GeneratedAt time.Time         `json:"generated_at" example:"2023-01-01T12:00:00Z" description:"When the checks were run"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// FeedItemType identifies the kind of content in the homepage feed
+type FeedItemType string
+
+const (
+	// FeedItemPost is a blog post
+	FeedItemPost FeedItemType = "post"
+	// FeedItemNews is a news article
+	FeedItemNews FeedItemType = "news"
+)
+
+// FeedItem is a post or news article in the ranked homepage feed
+// @Description A post or news article in the homepage feed
+type FeedItem struct {
+	Type            FeedItemType `json:"type" example:"post" description:"Kind of content (post, news)"`
+	ID              uint         `json:"id" example:"1" description:"ID of the post or news article"`
+	UUID            string       `json:"uuid" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"Public identifier of the post or news article"`
+	Title           string       `json:"title" example:"My First Blog Post" description:"Title"`
+	Slug            string       `json:"slug" example:"my-first-blog-post" description:"URL-friendly version of the title"`
+	Summary         string       `json:"summary" example:"A short summary of the post" description:"Post excerpt or news summary"`
+	ImageURL        string       `json:"image_url,omitempty" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg" description:"Post cover or news image"`
+	Category        string       `json:"category,omitempty" example:"backend" description:"Post category slug or news category"`
+	Source          string       `json:"source,omitempty" example:"TechNews" description:"Original source (news only)"`
+	SourceURL       string       `json:"source_url,omitempty" example:"https://technews.com/article/12345" description:"URL of the original article (news only)"`
+	PublishedAt     time.Time    `json:"published_at" example:"2023-01-01T12:00:00Z" description:"When the item was published"`
+	ViewCount       int64        `json:"view_count" example:"128" description:"Number of views (posts only)"`
+	ReadingTime     int          `json:"reading_time_minutes,omitempty" example:"7" description:"Estimated reading time in minutes (posts only)"`
+	EditorialWeight float64      `json:"editorial_weight" example:"0.8" description:"Editorial boost between 0 and 1 set by an admin"`
+	Score           float64      `json:"score" example:"1.42" description:"Ranking score, higher is shown first"`
+}
+
+// HomeFeedResponse is the ranked homepage feed
+// @Description Response model for the homepage feed
+type HomeFeedResponse struct {
+	Items       []FeedItem `json:"items" description:"Feed items, best first"`
+	Ranker      string     `json:"ranker" example:"blend" description:"Ranking strategy that ordered the feed"`
+	GeneratedAt time.Time  `json:"generated_at" example:"2023-01-01T12:00:00Z" description:"When the feed was ranked"`
+}
+
+// EditorialPick boosts a post or news article in the homepage feed
+// @Description An editorial boost for a post or news article
+type EditorialPick struct {
+	ID        uint         `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	ItemType  FeedItemType `json:"item_type" gorm:"type:varchar(10);not null;uniqueIndex:idx_editorial_pick_item" example:"news" description:"Kind of content (post, news)"`
+	ItemID    uint         `json:"item_id" gorm:"not null;uniqueIndex:idx_editorial_pick_item" example:"42" description:"ID of the post or news article"`
+	Weight    float64      `json:"weight" gorm:"not null" example:"0.8" description:"Editorial boost between 0 and 1"`
+	CreatedBy uint         `json:"created_by" example:"1" description:"ID of the admin who picked the item"`
+	CreatedAt time.Time    `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the item was picked"`
+	UpdatedAt time.Time    `json:"updated_at" example:"2023-01-01T12:00:00Z" description:"When the pick was last changed"`
+}
+
+// SetEditorialPickRequest represents the request body for boosting a feed item
+// @Description Request model for boosting a post or news article in the homepage feed
+type SetEditorialPickRequest struct {
+	ItemType FeedItemType `json:"item_type" binding:"required,oneof=post news" example:"news" description:"Kind of content (post, news)"`
+	ItemID   uint         `json:"item_id" binding:"required" example:"42" description:"ID of the post or news article"`
+	Weight   float64      `json:"weight" binding:"min=0,max=1" example:"0.8" description:"Editorial boost between 0 and 1"`
+}
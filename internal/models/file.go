@@ -1,6 +1,16 @@
 package models
 
 // DeleteFileRequest represents a request to delete a file
+// @Description Request model for deleting a file
 type DeleteFileRequest struct {
-	FileURL string `json:"file_url" binding:"required"`
+	FileURL string `json:"file_url" binding:"required" example:"https://example.com/file.jpg" description:"URL of the file to delete"`
+}
+
+// ImageVariants holds the URLs of an uploaded image and its resized copies, so
+// clients can serve responsive images
+// @Description URLs of an uploaded image in several sizes
+type ImageVariants struct {
+	Original  string `json:"original" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/blog_images/post_covers/post_1_1620000000.jpg" description:"The image as uploaded"`
+	Medium    string `json:"medium" example:"https://res.cloudinary.com/demo/image/upload/c_limit,w_1200,q_auto,f_webp/v1234567890/blog_images/post_covers/post_1_1620000000.webp" description:"Resized for full-width display"`
+	Thumbnail string `json:"thumbnail" example:"https://res.cloudinary.com/demo/image/upload/c_fill,w_400,h_225,q_auto,f_webp/v1234567890/blog_images/post_covers/post_1_1620000000.webp" description:"Small cropped copy for lists and cards"`
 }
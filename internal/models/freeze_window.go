@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// FreezeWindow is a period during which publishing is paused, e.g. while a migration runs.
+// Publishes requested during a freeze are queued and go out when the window ends.
+// @Description A content freeze window during which publishes are paused
+type FreezeWindow struct {
+	ID        uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Reason    string    `json:"reason" gorm:"size:255;not null" example:"Database migration" description:"Why publishing is frozen"`
+	StartsAt  time.Time `json:"starts_at" gorm:"not null;index" example:"2023-01-01T22:00:00Z" description:"When the freeze begins"`
+	EndsAt    time.Time `json:"ends_at" gorm:"not null;index" example:"2023-01-02T02:00:00Z" description:"When the freeze ends and queued publishes resume"`
+	CreatedBy uint      `json:"created_by" example:"1" description:"ID of the admin who created the window"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the window was created"`
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T12:00:00Z" description:"When the window was last updated"`
+}
+
+// QueuedPost is a post that was saved while a content freeze was in effect,
+// with its publish queued until the window ends
+// @Description A saved post whose publish waits for a content freeze to end
+type QueuedPost struct {
+	Post
+	Queued      bool      `json:"queued" example:"true" description:"Always true: the post was saved, but is published when the freeze ends"`
+	FrozenUntil time.Time `json:"frozen_until" example:"2023-01-02T02:00:00Z" description:"When the freeze window ends and the post is published"`
+}
+
+// CreateFreezeWindowRequest represents the request body for creating a freeze window
+// @Description Request model for creating a content freeze window
+type CreateFreezeWindowRequest struct {
+	Reason   string    `json:"reason" binding:"required" example:"Database migration" description:"Why publishing is frozen"`
+	StartsAt time.Time `json:"starts_at" binding:"required" example:"2023-01-01T22:00:00Z" description:"When the freeze begins"`
+	EndsAt   time.Time `json:"ends_at" binding:"required" example:"2023-01-02T02:00:00Z" description:"When the freeze ends"`
+}
@@ -0,0 +1,24 @@
+package models
+
+// GraphQLRequest is the body of a GraphQL POST request
+// @Description A GraphQL query or mutation with its variables
+type GraphQLRequest struct {
+	Query         string                 `json:"query" binding:"required" example:"query { posts(limit: 5) { posts { title slug } } }" description:"GraphQL document"`
+	OperationName string                 `json:"operationName,omitempty" example:"" description:"Operation to run when the document has several"`
+	Variables     map[string]interface{} `json:"variables,omitempty" description:"Values of the operation's variables"`
+}
+
+// GraphQLError is an error in a GraphQL response
+// @Description A request or field error. Errors from the REST layer carry its code in extensions.
+type GraphQLError struct {
+	Message    string                 `json:"message" example:"Post not found" description:"Error message"`
+	Path       []interface{}          `json:"path,omitempty" description:"Path of the field that failed"`
+	Extensions map[string]interface{} `json:"extensions,omitempty" description:"Error code, HTTP status and details"`
+}
+
+// GraphQLResponse is the response to a GraphQL request
+// @Description Result of a GraphQL request. Data is null when the request itself is invalid.
+type GraphQLResponse struct {
+	Data   interface{}    `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
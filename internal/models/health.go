@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// LivenessResponse reports that the process is up
+// @Description Liveness probe response
+type LivenessResponse struct {
+	Status        string    `json:"status" example:"up" description:"Always up when the process answers"`
+	UptimeSeconds int64     `json:"uptime_seconds" example:"3600" description:"Seconds since the server started"`
+	Time          time.Time `json:"time" example:"2023-01-01T12:00:00Z" description:"Server time"`
+}
+
+// ReadinessReport collects the results of the readiness checks
+// @Description Readiness probe report, with the status and latency of each dependency
+type ReadinessReport struct {
+	Ready     bool              `json:"ready" example:"true" description:"Whether the instance can serve traffic"`
+	Status    DiagnosticStatus  `json:"status" example:"warn" description:"Worst status among the checks"`
+	Checks    []DiagnosticCheck `json:"checks" description:"Individual check results"`
+	CheckedAt time.Time         `json:"checked_at" example:"2023-01-01T12:00:00Z" description:"When the checks were run"`
+}
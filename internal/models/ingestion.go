@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// Ingestion sources
+const (
+	IngestionSourceNewsAPI = "newsapi"
+	IngestionSourceRSS     = "rss"
+)
+
+// Ingestion triggers
+const (
+	IngestionTriggerScheduled = "scheduled"
+	IngestionTriggerManual    = "manual"
+)
+
+// IngestionRunStatus represents the state of an ingestion run
+type IngestionRunStatus string
+
+const (
+	// IngestionRunning means the run hasn't finished yet
+	IngestionRunning IngestionRunStatus = "running"
+	// IngestionCompleted means the run finished, possibly with some item or source errors
+	IngestionCompleted IngestionRunStatus = "completed"
+	// IngestionFailed means nothing could be fetched
+	IngestionFailed IngestionRunStatus = "failed"
+)
+
+// IngestionItemOutcome is what happened to a single fetched article
+type IngestionItemOutcome string
+
+const (
+	// IngestionItemSaved means the article was stored
+	IngestionItemSaved IngestionItemOutcome = "saved"
+	// IngestionItemDeduped means the article was skipped because it already exists
+	IngestionItemDeduped IngestionItemOutcome = "deduped"
+	// IngestionItemFiltered means the article was skipped because its language isn't fetched
+	IngestionItemFiltered IngestionItemOutcome = "filtered"
+	// IngestionItemFailed means the article could not be stored
+	IngestionItemFailed IngestionItemOutcome = "failed"
+)
+
+// IngestionSourceError records a feed or category that could not be fetched during a run
+type IngestionSourceError struct {
+	Source string `json:"source" example:"TechCrunch" description:"Feed name or NewsAPI category"`
+	Error  string `json:"error" example:"RSS feed returned non-OK status: 503" description:"Why the source could not be fetched"`
+}
+
+// IngestionRun records one execution of the news ingestion pipeline
+// @Description A news ingestion run and its counters
+type IngestionRun struct {
+	ID            uint                   `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Source        string                 `json:"source" gorm:"size:20;not null;index" example:"rss" description:"Pipeline that ran (newsapi, rss)"`
+	Trigger       string                 `json:"trigger" gorm:"size:20;not null" example:"scheduled" description:"What started the run (scheduled, manual)"`
+	Status        IngestionRunStatus     `json:"status" gorm:"type:varchar(20);not null;index" example:"completed" description:"Run status (running, completed, failed)"`
+	ItemsSeen     int                    `json:"items_seen" example:"20" description:"Articles returned by the sources"`
+	ItemsDeduped  int                    `json:"items_deduped" example:"15" description:"Articles skipped because they already exist"`
+	ItemsFiltered int                    `json:"items_filtered" example:"2" description:"Articles skipped because of their language"`
+	ItemsSaved    int                    `json:"items_saved" example:"4" description:"Articles stored"`
+	ItemsFailed   int                    `json:"items_failed" example:"1" description:"Articles that could not be stored"`
+	SourceErrors  []IngestionSourceError `json:"source_errors" gorm:"type:text;serializer:json" description:"Feeds or categories that could not be fetched"`
+	Error         string                 `json:"error,omitempty" gorm:"type:text" example:"" description:"Why the run failed"`
+	StartedAt     time.Time              `json:"started_at" gorm:"not null;index" example:"2023-01-01T12:00:00Z" description:"When the run started"`
+	FinishedAt    *time.Time             `json:"finished_at,omitempty" example:"2023-01-01T12:00:05Z" description:"When the run finished"`
+	Items         []IngestionItem        `json:"items,omitempty" gorm:"foreignKey:RunID" description:"Per-article outcomes (only included when fetching a single run)"`
+}
+
+// IngestionItem records what happened to one article during an ingestion run
+// @Description The outcome of a single article in an ingestion run
+type IngestionItem struct {
+	ID         uint                 `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	RunID      uint                 `json:"run_id" gorm:"not null;index" example:"1" description:"ID of the ingestion run"`
+	Source     string               `json:"source" gorm:"size:100" example:"TechCrunch" description:"Original source of the article"`
+	ExternalID string               `json:"external_id" gorm:"size:100" example:"rss-techcrunch-2023-01-01-article" description:"ID of the article at the source"`
+	Title      string               `json:"title" gorm:"size:255" example:"Major Technology Breakthrough Announced" description:"Article title"`
+	SourceURL  string               `json:"source_url" gorm:"size:500" example:"https://technews.com/article/12345" description:"URL of the original article"`
+	Outcome    IngestionItemOutcome `json:"outcome" gorm:"type:varchar(20);not null;index" example:"deduped" description:"What happened to the article (saved, deduped, filtered, failed)"`
+	Reason     string               `json:"reason,omitempty" gorm:"type:text" example:"An article with this external ID already exists" description:"Why the article was skipped or failed"`
+	NewsID     *uint                `json:"news_id,omitempty" example:"42" description:"ID of the stored news article"`
+	CreatedAt  time.Time            `json:"created_at" example:"2023-01-01T12:00:01Z" description:"When the outcome was recorded"`
+}
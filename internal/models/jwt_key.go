@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Where the secret of a JWT signing key is kept
+const (
+	// JWTKeySourceConfig keys come from JWT_SECRET or JWT_KEYS; only their
+	// metadata is stored
+	JWTKeySourceConfig = "config"
+	// JWTKeySourceRotation keys were generated by an admin rotation and are
+	// stored with their secret
+	JWTKeySourceRotation = "rotation"
+)
+
+// JWTSigningKey is a key that signs or verifies JWTs, identified by the kid
+// header of the tokens it signs
+// @Description A JWT signing key (the secret is never returned)
+type JWTSigningKey struct {
+	ID        uint       `json:"-" gorm:"primaryKey"`
+	KID       string     `json:"kid" gorm:"column:kid;size:64;not null;uniqueIndex" example:"20230101120000-3f9a2c7e" description:"Key ID sent in the kid header"`
+	Secret    string     `json:"-" gorm:"size:255;serializer:encrypted"` // Empty for config keys
+	Source    string     `json:"source" gorm:"size:20;not null" example:"rotation" description:"Where the key comes from (config, rotation)"`
+	Signing   bool       `json:"signing" gorm:"-" example:"true" description:"Whether new tokens are signed with this key"`
+	CreatedAt time.Time  `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the key was generated or first configured"`
+	RetiredAt *time.Time `json:"retired_at,omitempty" example:"2023-01-09T12:00:00Z" description:"When the key stopped being accepted"`
+}
@@ -0,0 +1,26 @@
+package models
+
+// Languages posts and news can be written in. The blog serves both English
+// and Vietnamese readers.
+const (
+	LanguageEnglish    = "en"
+	LanguageVietnamese = "vi"
+)
+
+// DefaultContentLanguage is the language of content that doesn't set one
+const DefaultContentLanguage = LanguageEnglish
+
+// IsContentLanguage reports whether lang is a language content can be written in
+func IsContentLanguage(lang string) bool {
+	return lang == LanguageEnglish || lang == LanguageVietnamese
+}
+
+// PostTranslation links a post to a translation of it
+// @Description A translation of a post into another language
+type PostTranslation struct {
+	ID       uint   `json:"id" example:"2" description:"ID of the translated post"`
+	UUID     string `json:"uuid" example:"7a1d2c3b-4e5f-4a6b-8c7d-9e0f1a2b3c4d" description:"Public identifier of the translated post"`
+	Title    string `json:"title" example:"Bài viết đầu tiên của tôi" description:"Title of the translated post"`
+	Slug     string `json:"slug" example:"bai-viet-dau-tien-cua-toi" description:"Slug of the translated post"`
+	Language string `json:"language" example:"vi" description:"Language of the translated post"`
+}
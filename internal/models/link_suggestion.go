@@ -0,0 +1,14 @@
+package models
+
+// LinkSuggestion is a published post that a post could link to, with what
+// the two have in common
+// @Description Published post suggested as an internal link
+type LinkSuggestion struct {
+	PostID     uint     `json:"post_id" example:"2" description:"ID of the suggested post"`
+	Title      string   `json:"title" example:"Structuring a Go API" description:"Title of the suggested post"`
+	Slug       string   `json:"slug" example:"structuring-a-go-api" description:"Slug of the suggested post"`
+	URL        string   `json:"url" example:"https://yourdomain.com/posts/structuring-a-go-api" description:"Public URL to link to"`
+	SharedTags []string `json:"shared_tags" example:"go,api" description:"Tags both posts have"`
+	Keywords   []string `json:"keywords" example:"handlers,middleware" description:"Distinctive words both posts use, most distinctive first; good anchor text candidates"`
+	Score      float64  `json:"score" example:"4.2" description:"Relevance score; higher is more relevant"`
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -18,30 +19,22 @@ const (
 	NewsStatusArchived NewsStatus = "archived"
 )
 
-// NewsCategory represents the category of a news article
+// NewsCategory is the slug of a news category. The available categories are
+// stored in the news_categories table (see NewsCategoryModel).
 type NewsCategory string
 
 const (
-	// // NewsCategoryGeneral represents general news
-	// NewsCategoryGeneral NewsCategory = "general"
-	// // NewsCategoryBusiness represents business news
-	// NewsCategoryBusiness NewsCategory = "business"
 	// NewsCategoryTechnology represents technology news
 	NewsCategoryTechnology NewsCategory = "technology"
 	// NewsCategoryScience represents science news
 	NewsCategoryScience NewsCategory = "science"
-	// // NewsCategoryHealth represents health news
-	// NewsCategoryHealth NewsCategory = "health"
-	// // NewsCategorySports represents sports news
-	// NewsCategorySports NewsCategory = "sports"
-	// // NewsCategoryEntertainment represents entertainment news
-	// NewsCategoryEntertainment NewsCategory = "entertainment"
 )
 
 // News represents a news article
 // @Description A news article with content, metadata, and relationships
 type News struct {
 	ID          uint           `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UUID        string         `json:"uuid" gorm:"type:uuid;uniqueIndex" example:"3c2b1a0d-9e8f-4a7b-b6c5-d4e3f2a1b0c9" description:"Stable public identifier"`
 	Title       string         `json:"title" gorm:"size:255;not null" example:"Major Technology Breakthrough Announced" description:"News title"`
 	Slug        string         `json:"slug" gorm:"size:255;not null;unique" example:"major-technology-breakthrough-announced" description:"URL-friendly version of the title"`
 	Content     string         `json:"content" gorm:"type:text;not null" example:"Scientists announced a major breakthrough in quantum computing..." description:"Main content of the news article"`
@@ -51,6 +44,7 @@ type News struct {
 	ImageURL    string         `json:"image_url" gorm:"size:500" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg" description:"URL to the news article's image"`
 	Category    NewsCategory   `json:"category" gorm:"type:varchar(20);not null;default:'general'" example:"technology" description:"Category of the news article"`
 	Status      NewsStatus     `json:"status" gorm:"type:varchar(20);not null;default:'published'" example:"published" description:"Publication status of the news article"`
+	Language    string         `json:"language" gorm:"size:10;not null;default:'en';index" example:"en" description:"Language the article is written in (en, vi)"`
 	Published   bool           `json:"published" gorm:"default:true" example:"true" description:"Whether the news is published and visible"`
 	PublishDate time.Time      `json:"publish_date" example:"2023-01-01T12:00:00Z" description:"When the news was/will be published"`
 	ExternalID  string         `json:"external_id" gorm:"size:100;index" example:"ext-12345" description:"ID from external news API"`
@@ -60,6 +54,14 @@ type News struct {
 	Tags        []Tag          `json:"tags" gorm:"many2many:news_tags;" description:"Tags associated with the news article"`
 }
 
+// BeforeCreate assigns a public UUID to new news articles
+func (n *News) BeforeCreate(tx *gorm.DB) error {
+	if n.UUID == "" {
+		n.UUID = uuid.NewString()
+	}
+	return nil
+}
+
 // CreateNewsRequest represents the request body for creating a news article
 // @Description Request model for creating a news article
 type CreateNewsRequest struct {
@@ -71,6 +73,7 @@ type CreateNewsRequest struct {
 	ImageURL    string       `json:"image_url" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg" description:"URL to the news article's image"`
 	Category    NewsCategory `json:"category" example:"technology" description:"Category of the news article"`
 	Status      NewsStatus   `json:"status" example:"published" description:"Publication status of the news article"`
+	Language    string       `json:"language" binding:"omitempty,oneof=en vi" example:"en" description:"Language the article is written in (en, vi)"`
 	PublishDate *time.Time   `json:"publish_date" example:"2023-01-01T12:00:00Z" description:"When the news will be published"`
 	Tags        []string     `json:"tags" example:"['technology', 'quantum computing']" description:"Tags to associate with the news article"`
 }
@@ -86,6 +89,7 @@ type UpdateNewsRequest struct {
 	ImageURL    string       `json:"image_url" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/news/article1.jpg" description:"URL to the news article's image"`
 	Category    NewsCategory `json:"category" example:"technology" description:"Category of the news article"`
 	Status      NewsStatus   `json:"status" example:"published" description:"Publication status of the news article"`
+	Language    string       `json:"language" binding:"omitempty,oneof=en vi" example:"en" description:"Language the article is written in (en, vi)"`
 	PublishDate *time.Time   `json:"publish_date" example:"2023-01-01T12:00:00Z" description:"When the news will be published"`
 	Tags        []string     `json:"tags" example:"['technology', 'quantum computing']" description:"Tags to associate with the news article"`
 }
@@ -106,8 +110,11 @@ type NewsQuery struct {
 	Category string `form:"category" json:"category" example:"technology" description:"Filter by category"`
 	Tag      string `form:"tag" json:"tag" example:"technology" description:"Filter by tag"`
 	Search   string `form:"search" json:"search" example:"quantum" description:"Search in title and content"`
+	Lang     string `form:"lang" json:"lang" example:"vi" description:"Filter by language (en, vi), all for every language"`
 	Page     int    `form:"page" json:"page" example:"1" description:"Page number"`
 	PerPage  int    `form:"per_page" json:"per_page" example:"10" description:"Items per page"`
+	Cursor   string `form:"cursor" json:"cursor" description:"next_cursor from the previous page"`
+	After    string `form:"after" json:"after" description:"Alias of cursor"`
 }
 
 // SetNewsStatusRequest represents the request body for updating a news article's status
@@ -129,7 +136,9 @@ type EnrichedNewsContent struct {
 	ID                 uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
 	NewsID             uint      `json:"news_id" gorm:"not null;index" example:"1" description:"ID of the associated news article"`
 	OriginalContent    string    `json:"original_content" gorm:"type:text" description:"Original content from the news API"`
-	FullContent        string    `json:"full_content" gorm:"type:text" description:"Full content fetched from the source"`
+	FullContent        string    `json:"full_content" gorm:"type:text" description:"Article HTML extracted from the source page (paragraphs, headings, lists, links and images)"`
+	Byline             string    `json:"byline" gorm:"size:255" example:"Jane Smith" description:"Author credit found on the source page"`
+	LeadImage          string    `json:"lead_image" gorm:"size:500" example:"https://news.com/images/lead.jpg" description:"Main image of the source page"`
 	IsTruncated        bool      `json:"is_truncated" gorm:"default:false" example:"true" description:"Whether the original content was truncated"`
 	TruncatedChars     int       `json:"truncated_chars" example:"1281" description:"Number of characters truncated if known"`
 	TruncationPattern  string    `json:"truncation_pattern" gorm:"size:50" example:"[+1281 chars]" description:"The pattern indicating truncation"`
@@ -146,6 +155,8 @@ type ContentStatus struct {
 	IsTruncated    bool   `json:"is_truncated" example:"true" description:"Whether the content is truncated"`
 	TruncatedChars int    `json:"truncated_chars" example:"1281" description:"Number of characters truncated if known"`
 	HasFullContent bool   `json:"has_full_content" example:"true" description:"Whether full content is available"`
+	Byline         string `json:"byline,omitempty" example:"Jane Smith" description:"Author credit found on the source page"`
+	LeadImage      string `json:"lead_image,omitempty" example:"https://news.com/images/lead.jpg" description:"Main image of the source page"`
 	FetchError     string `json:"fetch_error,omitempty" description:"Error message if fetch failed"`
 }
 
@@ -159,6 +170,7 @@ type NewsWithContentStatus struct {
 // to improve performance for listing endpoints
 type NewsWithoutContent struct {
 	ID          uint           `json:"id"`
+	UUID        string         `json:"uuid"`
 	Title       string         `json:"title"`
 	Slug        string         `json:"slug"`
 	Summary     string         `json:"summary"`
@@ -167,6 +179,7 @@ type NewsWithoutContent struct {
 	ImageURL    string         `json:"image_url"`
 	Category    NewsCategory   `json:"category"`
 	Status      NewsStatus     `json:"status"`
+	Language    string         `json:"language"`
 	Published   bool           `json:"published"`
 	PublishDate time.Time      `json:"publish_date"`
 	ExternalID  string         `json:"external_id"`
@@ -180,6 +193,7 @@ type NewsWithoutContent struct {
 func (n *News) ToNewsWithoutContent() NewsWithoutContent {
 	return NewsWithoutContent{
 		ID:          n.ID,
+		UUID:        n.UUID,
 		Title:       n.Title,
 		Slug:        n.Slug,
 		Summary:     n.Summary,
@@ -188,6 +202,7 @@ func (n *News) ToNewsWithoutContent() NewsWithoutContent {
 		ImageURL:    n.ImageURL,
 		Category:    n.Category,
 		Status:      n.Status,
+		Language:    n.Language,
 		Published:   n.Published,
 		PublishDate: n.PublishDate,
 		ExternalID:  n.ExternalID,
@@ -206,4 +221,5 @@ type NewsWithoutContentResponse struct {
 	Page       int                  `json:"page" example:"1" description:"Current page number"`
 	PerPage    int                  `json:"per_page" example:"10" description:"Number of items per page"`
 	TotalPages int                  `json:"total_pages" example:"10" description:"Total number of pages"`
+	NextCursor string               `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyNS0wNi0wMVQxMjowMDowMFoiLCJpZCI6NDJ9" description:"Cursor for the next page, absent on the last page"`
 }
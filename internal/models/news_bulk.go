@@ -0,0 +1,49 @@
+package models
+
+// NewsBulkItemStatus is the outcome of a bulk operation on one article
+type NewsBulkItemStatus string
+
+const (
+	// NewsBulkUpdated means the article's status was changed
+	NewsBulkUpdated NewsBulkItemStatus = "updated"
+	// NewsBulkUnchanged means the article already had the requested status
+	NewsBulkUnchanged NewsBulkItemStatus = "unchanged"
+	// NewsBulkDeleted means the article was deleted
+	NewsBulkDeleted NewsBulkItemStatus = "deleted"
+	// NewsBulkNotFound means there is no article with the requested ID
+	NewsBulkNotFound NewsBulkItemStatus = "not_found"
+	// NewsBulkFailed means the chunk the article was in couldn't be saved
+	NewsBulkFailed NewsBulkItemStatus = "failed"
+)
+
+// BulkNewsStatusRequest represents the request body for changing the status
+// of several news articles
+// @Description Request model for changing the status of news articles in bulk
+type BulkNewsStatusRequest struct {
+	IDs    []uint     `json:"ids" binding:"required,min=1,max=1000" example:"1,2,3" description:"IDs of the articles to change, at most 1000"`
+	Status NewsStatus `json:"status" binding:"required,oneof=published draft archived" example:"archived" description:"New status (published, draft, archived)"`
+}
+
+// BulkNewsDeleteRequest represents the request body for deleting several
+// news articles
+// @Description Request model for deleting news articles in bulk
+type BulkNewsDeleteRequest struct {
+	IDs []uint `json:"ids" binding:"required,min=1,max=1000" example:"1,2,3" description:"IDs of the articles to delete, at most 1000"`
+}
+
+// NewsBulkItem is the outcome of a bulk operation on one article
+// @Description Outcome of a bulk operation on one news article
+type NewsBulkItem struct {
+	NewsID uint               `json:"news_id" example:"1" description:"ID of the article"`
+	Status NewsBulkItemStatus `json:"status" example:"updated" description:"Outcome (updated, unchanged, deleted, not_found, failed)"`
+	Error  string             `json:"error,omitempty" example:"" description:"Why the article couldn't be changed"`
+}
+
+// NewsBulkResult reports the outcome of a bulk news operation
+// @Description Outcome of a bulk operation on news articles
+type NewsBulkResult struct {
+	Succeeded int            `json:"succeeded" example:"98" description:"Articles that were changed or already had the requested status"`
+	NotFound  int            `json:"not_found" example:"1" description:"Requested IDs with no article"`
+	Failed    int            `json:"failed" example:"1" description:"Articles in chunks that couldn't be saved"`
+	Items     []NewsBulkItem `json:"items" description:"Outcome per article, in the order requested"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// NewsCategoryModel is a news category managed by admins. Articles reference it
+// by Slug in News.Category. Keywords are hints used to classify articles that
+// arrive without a category.
+// @Description A news category
+type NewsCategoryModel struct {
+	ID        uint         `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Slug      NewsCategory `json:"slug" gorm:"size:20;not null;uniqueIndex" example:"technology" description:"Value stored on news articles and used in filters"`
+	Name      string       `json:"name" gorm:"size:50;not null" example:"Technology" description:"Display name"`
+	Enabled   bool         `json:"enabled" gorm:"not null" example:"true" description:"Whether the category is listed, fetched and used for auto-classification"`
+	Keywords  []string     `json:"keywords" gorm:"type:text;serializer:json" example:"software,ai,robot" description:"Keyword hints for auto-classification"`
+	CreatedAt time.Time    `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the category was created"`
+	UpdatedAt time.Time    `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the category was last updated"`
+}
+
+// TableName keeps the table name short
+func (NewsCategoryModel) TableName() string {
+	return "news_categories"
+}
+
+// CreateNewsCategoryRequest represents the request body for creating a news category
+// @Description Request model for creating a news category
+type CreateNewsCategoryRequest struct {
+	Slug     string   `json:"slug" binding:"required,max=20" example:"blockchain" description:"Value stored on news articles"`
+	Name     string   `json:"name" binding:"required,max=50" example:"Blockchain" description:"Display name"`
+	Enabled  *bool    `json:"enabled" example:"true" description:"Whether the category is enabled (default true)"`
+	Keywords []string `json:"keywords" example:"blockchain,crypto,bitcoin" description:"Keyword hints for auto-classification"`
+}
+
+// UpdateNewsCategoryRequest represents the request body for changing a news category.
+// Fields that are omitted are left unchanged.
+// @Description Request model for changing a news category
+type UpdateNewsCategoryRequest struct {
+	Slug     *string  `json:"slug" binding:"omitempty,max=20" example:"web3" description:"New slug; existing articles are moved to it"`
+	Name     *string  `json:"name" binding:"omitempty,max=50" example:"Web3" description:"New display name"`
+	Enabled  *bool    `json:"enabled" example:"false" description:"Enable or disable the category"`
+	Keywords []string `json:"keywords" example:"web3,defi,ethereum" description:"Replaces the keyword hints"`
+}
+
+// NewsCategoryExample is a training example for the news classifier, recorded
+// when an admin moves an article to a different category
+type NewsCategoryExample struct {
+	ID        uint         `json:"id" gorm:"primaryKey"`
+	NewsID    uint         `json:"news_id" gorm:"not null;uniqueIndex"`
+	Category  NewsCategory `json:"category" gorm:"size:20;not null;index"`
+	Previous  NewsCategory `json:"previous" gorm:"size:20;not null"`
+	Text      string       `json:"text" gorm:"type:text;not null"`
+	CreatedAt time.Time    `json:"created_at"`
+}
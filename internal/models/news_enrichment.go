@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+// NewsEnrichmentItemStatus is the outcome of enriching one article in a batch
+type NewsEnrichmentItemStatus string
+
+const (
+	// NewsEnrichmentEnriched means the full content was scraped and stored
+	NewsEnrichmentEnriched NewsEnrichmentItemStatus = "enriched"
+	// NewsEnrichmentNotTruncated means the article's content is complete, so
+	// its source page wasn't fetched
+	NewsEnrichmentNotTruncated NewsEnrichmentItemStatus = "not_truncated"
+	// NewsEnrichmentFailed means the source page couldn't be fetched or had no
+	// article in it
+	NewsEnrichmentFailed NewsEnrichmentItemStatus = "failed"
+	// NewsEnrichmentNotFound means there is no article with the requested ID
+	NewsEnrichmentNotFound NewsEnrichmentItemStatus = "not_found"
+)
+
+// EnrichNewsBatchRequest represents the request body for enriching several
+// news articles
+// @Description Request model for enriching news articles in a batch
+type EnrichNewsBatchRequest struct {
+	IDs         []uint `json:"ids" binding:"omitempty,max=50" example:"1,2,3" description:"IDs of the articles to enrich; when empty, the newest truncated articles that were never enriched are picked"`
+	Limit       int    `json:"limit" binding:"omitempty,min=1,max=50" example:"10" description:"How many articles to pick when ids is empty (default 10, max 50)"`
+	RetryFailed bool   `json:"retry_failed" example:"true" description:"Also pick articles whose last enrichment failed when ids is empty"`
+}
+
+// NewsEnrichmentItem is the outcome of enriching one article
+// @Description Outcome of enriching one news article
+type NewsEnrichmentItem struct {
+	NewsID uint                     `json:"news_id" example:"1" description:"ID of the article"`
+	Status NewsEnrichmentItemStatus `json:"status" example:"enriched" description:"Outcome (enriched, not_truncated, failed, not_found)"`
+	Error  string                   `json:"error,omitempty" example:"source returned non-OK status: 403" description:"Why enrichment failed"`
+}
+
+// NewsEnrichmentBatchResult reports the outcome of an enrichment batch
+// @Description Outcome of enriching news articles in a batch
+type NewsEnrichmentBatchResult struct {
+	Enriched int                  `json:"enriched" example:"8" description:"Articles whose full content was stored"`
+	Failed   int                  `json:"failed" example:"1" description:"Articles whose source page couldn't be scraped"`
+	Skipped  int                  `json:"skipped" example:"1" description:"Articles that weren't truncated or don't exist"`
+	Items    []NewsEnrichmentItem `json:"items" description:"Outcome per article, in the order requested"`
+}
+
+// NewsEnrichmentStatus summarizes how far full-content enrichment has got
+// @Description Counts of news articles by enrichment state
+type NewsEnrichmentStatus struct {
+	Total          int64      `json:"total" example:"1200" description:"News articles stored"`
+	Truncated      int64      `json:"truncated" example:"450" description:"Articles whose content looks cut off"`
+	Enriched       int64      `json:"enriched" example:"380" description:"Articles with full content scraped from their source"`
+	Failed         int64      `json:"failed" example:"25" description:"Articles whose last enrichment attempt failed"`
+	Pending        int64      `json:"pending" example:"45" description:"Truncated articles that were never enriched"`
+	LastEnrichedAt *time.Time `json:"last_enriched_at,omitempty" example:"2023-01-02T12:00:00Z" description:"When an article was last enriched"`
+}
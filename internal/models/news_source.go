@@ -0,0 +1,83 @@
+package models
+
+import "time"
+
+// Outcomes of the last fetch of a news source
+const (
+	NewsSourceFetchOK     = "ok"
+	NewsSourceFetchFailed = "failed"
+)
+
+// NewsSource is an RSS feed the news fetcher reads, managed by admins
+// @Description An RSS feed news articles are fetched from
+type NewsSource struct {
+	ID       uint         `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	SiteID   uint         `json:"-" gorm:"not null;default:1;uniqueIndex:idx_news_sources_site_name"` // Site whose admins manage the source
+	Name     string       `json:"name" gorm:"size:100;not null;uniqueIndex:idx_news_sources_site_name" example:"TechCrunch" description:"Name shown as the source of fetched articles"`
+	URL      string       `json:"url" gorm:"size:500;not null" example:"https://techcrunch.com/feed/" description:"URL of the RSS or Atom feed"`
+	Category NewsCategory `json:"category" gorm:"size:20" example:"technology" description:"Category of fetched articles; empty to classify each article"`
+	Enabled  bool         `json:"enabled" gorm:"not null" example:"true" description:"Whether the feed is fetched"`
+	// FetchIntervalMinutes of zero uses RSS_FETCH_INTERVAL
+	FetchIntervalMinutes int        `json:"fetch_interval_minutes" gorm:"not null;default:0" example:"60" description:"Minutes between automatic fetches; 0 uses the default interval"`
+	LastFetchedAt        *time.Time `json:"last_fetched_at,omitempty" example:"2023-01-01T12:00:00Z" description:"When the feed was last fetched"`
+	LastFetchStatus      string     `json:"last_fetch_status,omitempty" gorm:"size:20" example:"ok" description:"Outcome of the last fetch (ok, failed)"`
+	LastFetchError       string     `json:"last_fetch_error,omitempty" gorm:"type:text" example:"" description:"Error of the last fetch, if it failed"`
+	LastFetchCount       int        `json:"last_fetch_count" gorm:"not null;default:0" example:"10" description:"Articles read by the last fetch"`
+	ConsecutiveFailures  int        `json:"consecutive_failures" gorm:"not null;default:0" example:"0" description:"Fetches that failed in a row"`
+	Healthy              bool       `json:"healthy" gorm:"not null;default:true" example:"true" description:"False once the feed has failed news.source_unhealthy_after times in a row, until it is fetched successfully"`
+	CreatedAt            time.Time  `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the source was added"`
+	UpdatedAt            time.Time  `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the source was last changed"`
+}
+
+// FetchInterval returns how often the source is fetched automatically, using
+// fallback when it has no interval of its own
+func (s NewsSource) FetchInterval(fallback time.Duration) time.Duration {
+	if s.FetchIntervalMinutes > 0 {
+		return time.Duration(s.FetchIntervalMinutes) * time.Minute
+	}
+	return fallback
+}
+
+// HealthEvent returns the webhook event announcing the source's current health
+func (s NewsSource) HealthEvent() string {
+	if s.Healthy {
+		return WebhookEventNewsSourceRecovered
+	}
+	return WebhookEventNewsSourceUnhealthy
+}
+
+// CreateNewsSourceRequest represents the request body for adding a news source
+// @Description Request model for adding an RSS feed
+type CreateNewsSourceRequest struct {
+	Name                 string `json:"name" binding:"required,max=100" example:"TechCrunch" description:"Name shown as the source of fetched articles"`
+	URL                  string `json:"url" binding:"required,url,max=500" example:"https://techcrunch.com/feed/" description:"URL of the RSS or Atom feed"`
+	Category             string `json:"category" binding:"max=20" example:"technology" description:"Category of fetched articles; omit to classify each article"`
+	Enabled              *bool  `json:"enabled" example:"true" description:"Whether the feed is fetched (default true)"`
+	FetchIntervalMinutes int    `json:"fetch_interval_minutes" binding:"min=0" example:"60" description:"Minutes between automatic fetches; 0 uses the default interval"`
+}
+
+// UpdateNewsSourceRequest represents the request body for changing a news source.
+// Fields that are omitted are left unchanged.
+// @Description Request model for changing an RSS feed
+type UpdateNewsSourceRequest struct {
+	Name                 *string `json:"name" binding:"omitempty,max=100" example:"TechCrunch" description:"New name"`
+	URL                  *string `json:"url" binding:"omitempty,url,max=500" example:"https://techcrunch.com/feed/" description:"New feed URL"`
+	Category             *string `json:"category" binding:"omitempty,max=20" example:"science" description:"New category; an empty string classifies each article"`
+	Enabled              *bool   `json:"enabled" example:"false" description:"Enable or disable the feed"`
+	FetchIntervalMinutes *int    `json:"fetch_interval_minutes" binding:"omitempty,min=0" example:"30" description:"Minutes between automatic fetches; 0 uses the default interval"`
+}
+
+// FetchRun records one attempt to fetch a news source
+// @Description A fetch of a single news source
+type FetchRun struct {
+	ID             uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	SourceID       uint      `json:"source_id" gorm:"not null;index" example:"1" description:"ID of the news source"`
+	SourceName     string    `json:"source_name" gorm:"size:100;not null" example:"TechCrunch" description:"Name of the news source at the time of the fetch"`
+	IngestionRunID *uint     `json:"ingestion_run_id,omitempty" gorm:"index" example:"12" description:"Ingestion run the fetch was part of"`
+	Status         string    `json:"status" gorm:"size:20;not null;index" example:"ok" description:"Outcome of the fetch (ok, failed)"`
+	DurationMs     int64     `json:"duration_ms" example:"843" description:"How long the fetch took"`
+	ItemsFound     int       `json:"items_found" example:"10" description:"Articles read from the feed"`
+	ItemsSaved     int       `json:"items_saved" example:"3" description:"Articles stored; the rest already existed or failed"`
+	Error          string    `json:"error,omitempty" gorm:"type:text" example:"" description:"Why the fetch failed"`
+	StartedAt      time.Time `json:"started_at" gorm:"not null;index" example:"2023-01-01T12:00:00Z" description:"When the fetch started"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// AdminNewsFilter narrows the admin news list. Unset fields don't filter.
+// @Description Filters for the admin news list
+type AdminNewsFilter struct {
+	Status    NewsStatus   `form:"status" json:"status,omitempty" example:"draft" description:"Only articles with this status (published, draft, archived)"`
+	Source    string       `form:"source" json:"source,omitempty" example:"TechCrunch" description:"Only articles from this source"`
+	Category  NewsCategory `form:"category" json:"category,omitempty" example:"technology" description:"Only articles in this category"`
+	Tag       string       `form:"tag" json:"tag,omitempty" example:"ai" description:"Only articles with this tag"`
+	Search    string       `form:"search" json:"search,omitempty" example:"quantum" description:"Search in title, content and summary"`
+	From      *time.Time   `form:"from" json:"from,omitempty" example:"2023-01-01T00:00:00Z" description:"Only articles published at or after this time"`
+	To        *time.Time   `form:"to" json:"to,omitempty" example:"2023-01-31T23:59:59Z" description:"Only articles published before this time"`
+	HasImage  *bool        `form:"has_image" json:"has_image,omitempty" example:"true" description:"Only articles with (true) or without (false) an image"`
+	Truncated *bool        `form:"truncated" json:"truncated,omitempty" example:"true" description:"Only articles whose content looks cut off (true) or complete (false)"`
+}
+
+// AdminNewsQuery represents the query parameters of the admin news list
+type AdminNewsQuery struct {
+	AdminNewsFilter
+	View    uint `form:"view" description:"ID of a saved view whose filters are applied first"`
+	Page    int  `form:"page" description:"Page number"`
+	PerPage int  `form:"per_page" description:"Items per page"`
+}
+
+// NewsView is a named admin news list filter saved by an admin for reuse
+// @Description A saved filter for the admin news list
+type NewsView struct {
+	ID        uint            `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UserID    uint            `json:"user_id" gorm:"not null;uniqueIndex:idx_news_views_user_name" example:"1" description:"ID of the admin who saved the view"`
+	Name      string          `json:"name" gorm:"size:100;not null;uniqueIndex:idx_news_views_user_name" example:"Truncated drafts" description:"Name of the view"`
+	Filter    AdminNewsFilter `json:"filter" gorm:"type:text;serializer:json" description:"Saved filters"`
+	CreatedAt time.Time       `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the view was saved"`
+	UpdatedAt time.Time       `json:"updated_at" example:"2023-01-01T12:00:00Z" description:"When the view was last changed"`
+}
+
+// CreateNewsViewRequest represents the request body for saving a news view
+// @Description Request model for saving a filter for the admin news list
+type CreateNewsViewRequest struct {
+	Name   string          `json:"name" binding:"required,max=100" example:"Truncated drafts" description:"Name of the view, unique per admin"`
+	Filter AdminNewsFilter `json:"filter" description:"Filters to save"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// SubscriberStatus represents where a subscriber is in the double opt-in flow
+type SubscriberStatus string
+
+const (
+	// SubscriberPending means the confirmation link hasn't been followed yet
+	SubscriberPending SubscriberStatus = "pending"
+	// SubscriberConfirmed means the subscriber receives digests
+	SubscriberConfirmed SubscriberStatus = "confirmed"
+	// SubscriberUnsubscribed means the subscriber followed an unsubscribe link
+	SubscriberUnsubscribed SubscriberStatus = "unsubscribed"
+)
+
+// Subscriber is an email address signed up for the newsletter. Only a hash of
+// the confirmation token is stored; the unsubscribe token is kept in clear
+// since every digest links to it.
+// @Description A newsletter subscriber
+type Subscriber struct {
+	ID               uint             `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Email            string           `json:"email" gorm:"size:255;not null;uniqueIndex" example:"reader@example.com" description:"Subscriber's email address"`
+	Status           SubscriberStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index" example:"confirmed" description:"Subscription status (pending, confirmed, unsubscribed)"`
+	ConfirmTokenHash string           `json:"-" gorm:"size:64;index"`
+	ConfirmExpiresAt *time.Time       `json:"-"`
+	UnsubscribeToken string           `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	ConfirmedAt      *time.Time       `json:"confirmed_at,omitempty" example:"2023-01-01T12:30:00Z" description:"When the subscription was confirmed"`
+	UnsubscribedAt   *time.Time       `json:"unsubscribed_at,omitempty" example:"2023-02-01T12:00:00Z" description:"When the subscriber unsubscribed"`
+	CreatedAt        time.Time        `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the address was first submitted"`
+	UpdatedAt        time.Time        `json:"updated_at" example:"2023-01-01T12:30:00Z" description:"When the subscription last changed"`
+}
+
+// SubscribeRequest represents the request body for subscribing to the newsletter
+// @Description Request model for subscribing to the newsletter
+type SubscribeRequest struct {
+	Email string `json:"email" binding:"required,email,max=255" example:"reader@example.com" description:"Email address to send the confirmation link to"`
+}
+
+// SendDigestRequest represents the request body for sending a newsletter digest
+// @Description Request model for sending a digest of recent posts
+type SendDigestRequest struct {
+	Days int `json:"days" binding:"omitempty,min=1,max=90" example:"7" description:"Include posts published in this many past days (defaults to NEWSLETTER_DIGEST_DAYS)"`
+}
+
+// DigestResponse reports a digest that is being sent
+// @Description A digest queued for delivery
+type DigestResponse struct {
+	Posts      int       `json:"posts" example:"3" description:"Number of posts in the digest"`
+	Recipients int       `json:"recipients" example:"120" description:"Number of confirmed subscribers it is sent to"`
+	Since      time.Time `json:"since" example:"2023-01-01T12:00:00Z" description:"Posts published after this time are included"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// NotificationType is the event a notification tells its recipient about
+type NotificationType string
+
+// Events users are notified of
+const (
+	// NotificationPostComment: someone commented on the recipient's post
+	NotificationPostComment NotificationType = "post_comment"
+	// NotificationCommentReply: someone replied to the recipient's comment
+	NotificationCommentReply NotificationType = "comment_reply"
+	// NotificationCommentMention: someone mentioned the recipient in a comment
+	NotificationCommentMention NotificationType = "comment_mention"
+	// NotificationPostStatusChanged: an admin changed the status of the recipient's post
+	NotificationPostStatusChanged NotificationType = "post_status_changed"
+)
+
+// Notification tells a user that something happened to their content
+// @Description An event on the current user's posts or comments
+type Notification struct {
+	ID        uint             `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UserID    uint             `json:"-" gorm:"not null;index:idx_notifications_user_created"`
+	Type      NotificationType `json:"type" gorm:"type:varchar(30);not null" example:"post_comment" description:"Event (post_comment, comment_reply, comment_mention, post_status_changed)"`
+	ActorID   *uint            `json:"actor_id,omitempty" example:"2" description:"ID of the user who caused the event"`
+	Actor     *User            `json:"actor,omitempty" gorm:"foreignKey:ActorID;constraint:OnDelete:SET NULL" description:"User who caused the event"`
+	PostID    *uint            `json:"post_id,omitempty" example:"1" description:"ID of the post the event concerns"`
+	Post      *Post            `json:"post,omitempty" gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" description:"Post the event concerns"`
+	CommentID *uint            `json:"comment_id,omitempty" example:"7" description:"ID of the new comment, for post_comment, comment_reply and comment_mention"`
+	Status    PostStatus       `json:"status,omitempty" gorm:"type:varchar(20)" example:"draft" description:"New status of the post, for post_status_changed"`
+	ReadAt    *time.Time       `json:"read_at" example:"2023-01-02T12:00:00Z" description:"When the notification was marked as read, null while unread"`
+	EmailedAt *time.Time       `json:"-"` // Set once the notification was emailed or skipped
+	CreatedAt time.Time        `json:"created_at" gorm:"index:idx_notifications_user_created" example:"2023-01-01T12:00:00Z" description:"When the event happened"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// PageStatus represents the publication status of a static page
+type PageStatus string
+
+const (
+	// PageStatusDraft indicates the page is only visible to editors
+	PageStatusDraft PageStatus = "draft"
+	// PageStatusPublished indicates the page is publicly visible
+	PageStatusPublished PageStatus = "published"
+)
+
+// Page is a standalone page of the site outside the blog, such as about,
+// contact, now or uses. Editors keep its Markdown content up to date without
+// a deploy.
+// @Description A static page of the site
+type Page struct {
+	ID        uint       `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Title     string     `json:"title" gorm:"size:200;not null" example:"About" description:"Page title"`
+	SiteID    uint       `json:"-" gorm:"not null;default:1;uniqueIndex:idx_pages_site_slug"` // Site the page is served on
+	Slug      string     `json:"slug" gorm:"size:120;not null;uniqueIndex:idx_pages_site_slug" example:"about" description:"Slug the page is served under"`
+	Content   string     `json:"content" gorm:"type:text" example:"# About me\n\nI write about Go and the web." description:"Page content in Markdown"`
+	Status    PageStatus `json:"status" gorm:"size:20;not null;default:draft" example:"published" description:"Publication status (draft, published)"`
+	UpdatedBy *uint      `json:"updated_by,omitempty" example:"1" description:"ID of the editor who last changed the page"`
+	CreatedAt time.Time  `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the page was created"`
+	UpdatedAt time.Time  `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the page was last updated"`
+}
+
+// CreatePageRequest represents the request body for creating a page
+// @Description Request model for creating a static page
+type CreatePageRequest struct {
+	Title   string     `json:"title" binding:"required,max=200" example:"About" description:"Page title"`
+	Slug    string     `json:"slug" binding:"omitempty,max=120" example:"about" description:"Custom slug (generated from the title if empty)"`
+	Content string     `json:"content" example:"# About me\n\nI write about Go and the web." description:"Page content in Markdown"`
+	Status  PageStatus `json:"status" binding:"omitempty,oneof=draft published" example:"draft" description:"Publication status, draft if empty"`
+}
+
+// UpdatePageRequest represents the request body for updating a page
+// @Description Request model for updating a static page
+type UpdatePageRequest struct {
+	Title   *string     `json:"title" binding:"omitempty,max=200" example:"About" description:"New page title"`
+	Slug    *string     `json:"slug" binding:"omitempty,max=120" example:"about" description:"New slug"`
+	Content *string     `json:"content" example:"# About me\n\nI write about Go and the web." description:"New page content in Markdown"`
+	Status  *PageStatus `json:"status" binding:"omitempty,oneof=draft published" example:"published" description:"New publication status"`
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -23,71 +24,176 @@ const (
 // Post represents a blog post
 // @Description A blog post with content, metadata, and relationships
 type Post struct {
-	ID        uint           `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
-	Title     string         `json:"title" gorm:"size:255;not null" example:"My First Blog Post" description:"Post title"`
-	Slug      string         `json:"slug" gorm:"size:255;not null;unique" example:"my-first-blog-post" description:"URL-friendly version of the title"`
-	Content   string         `json:"content" gorm:"type:text;not null" example:"This is the content of my blog post..." description:"Main content of the post"`
-	Excerpt   string         `json:"excerpt" gorm:"type:text" example:"A short summary of the post" description:"Short summary or preview of the post"`
-	Cover     string         `json:"cover" gorm:"size:500" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg" description:"URL to the post's cover image"`
-	Status    PostStatus     `json:"status" gorm:"type:varchar(20);not null;default:'draft'" example:"published" description:"Publication status of the post"`
-	UserID    uint           `json:"user_id" example:"1" description:"ID of the post author"`
-	User      User           `json:"user" gorm:"foreignKey:UserID" description:"Author of the post"`
-	Tags      []Tag          `json:"tags" gorm:"many2many:post_tags;" description:"Tags associated with the post"`
-	CreatedAt time.Time      `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the post was created"`
-	UpdatedAt time.Time      `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the post was last updated"`
-	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"` // Hide from Swagger
+	ID               uint              `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UUID             string            `json:"uuid" gorm:"type:uuid;uniqueIndex" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"Stable public identifier"`
+	Title            string            `json:"title" gorm:"size:255;not null" example:"My First Blog Post" description:"Post title"`
+	SiteID           uint              `json:"-" gorm:"not null;default:1;uniqueIndex:idx_posts_site_slug"` // Site the post belongs to
+	Slug             string            `json:"slug" gorm:"size:255;not null;uniqueIndex:idx_posts_site_slug" example:"my-first-blog-post" description:"URL-friendly version of the title"`
+	Content          string            `json:"content" gorm:"type:text;not null" example:"This is the content of my blog post..." description:"Main content of the post"`
+	Excerpt          string            `json:"excerpt" gorm:"type:text" example:"A short summary of the post" description:"Short summary or preview of the post"`
+	Cover            string            `json:"cover" gorm:"size:500" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/folder/post_1_1620000000.jpg" description:"URL to the post's cover image"`
+	OGImage          string            `json:"og_image" gorm:"size:500" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/folder/og_images/post_1_1620000000.png" description:"URL to the post's generated social share image"`
+	Status           PostStatus        `json:"status" gorm:"type:varchar(20);not null;default:'draft'" example:"published" description:"Publication status of the post"`
+	Language         string            `json:"language" gorm:"size:10;not null;default:'en';index" example:"en" description:"Language the post is written in (en, vi)"`
+	TranslationGroup *string           `json:"translation_group,omitempty" gorm:"type:uuid;index" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"Identifier shared by the post and its translations"`
+	Translations     []PostTranslation `json:"translations,omitempty" gorm:"-" description:"Published translations of the post into other languages (only included when fetching one post)"`
+	UserID           uint              `json:"user_id" example:"1" description:"ID of the post author"`
+	User             User              `json:"user" gorm:"foreignKey:UserID" description:"Author of the post"`
+	Tags             []Tag             `json:"tags" gorm:"many2many:post_tags;" description:"Tags associated with the post"`
+	Authors          []PostAuthor      `json:"authors" gorm:"foreignKey:PostID" description:"Co-authors of the post besides its owner"`
+	CategoryID       *uint             `json:"category_id" gorm:"index" example:"1" description:"ID of the post's category"`
+	Category         *Category         `json:"category,omitempty" gorm:"foreignKey:CategoryID" description:"Category the post belongs to"`
+	ViewCount        int64             `json:"view_count" gorm:"not null;default:0" example:"128" description:"Number of times the post has been viewed"`
+	WordCount        int               `json:"word_count" gorm:"not null;default:0" example:"1250" description:"Number of words in the content"`
+	ReadingTime      int               `json:"reading_time_minutes" gorm:"column:reading_time_minutes;not null;default:0" example:"7" description:"Estimated reading time in minutes"`
+	PublishAt        *time.Time        `json:"publish_at,omitempty" gorm:"index" example:"2023-01-03T12:00:00Z" description:"When a scheduled post will be published"`
+	ExpiresAt        *time.Time        `json:"expires_at,omitempty" gorm:"index" example:"2023-02-01T00:00:00Z" description:"When the post stops being published, for time-sensitive content such as announcements"`
+	NewsID           *uint             `json:"news_id,omitempty" gorm:"index" example:"1" description:"ID of the news article the post comments on"`
+	SeriesID         *uint             `json:"series_id,omitempty" gorm:"index" example:"1" description:"ID of the series the post belongs to"`
+	SeriesPosition   int               `json:"series_position,omitempty" gorm:"not null;default:0" example:"2" description:"Position of the post in its series, starting at 1"`
+	SeriesNav        *SeriesNavigation `json:"series,omitempty" gorm:"-" description:"Where the post sits in its series (only included when fetching one post)"`
+	SkipCrossPost    bool              `json:"skip_cross_post" gorm:"not null;default:false" example:"false" description:"Whether publishing the post leaves it off the configured social networks"`
+	PreviewVersion   uint              `json:"-" gorm:"not null;default:0"`                                                                                                                         // Bumped to revoke preview links
+	Version          int               `json:"version" gorm:"not null;default:1" example:"3" description:"Incremented on every update; send it back when updating to detect edits made in between"` // Bumped by BeforeUpdate
+	CreatedAt        time.Time         `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the post was created"`
+	UpdatedAt        time.Time         `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the post was last updated"`
+	DeletedAt        gorm.DeletedAt    `json:"-" gorm:"index"` // Hide from Swagger
+}
+
+// BeforeCreate assigns a public UUID to new posts
+func (p *Post) BeforeCreate(tx *gorm.DB) error {
+	if p.UUID == "" {
+		p.UUID = uuid.NewString()
+	}
+	return nil
+}
+
+// BeforeSave counts the words in the content and estimates the reading time,
+// so lists can show them without loading the content. Partial updates that
+// don't carry the content leave them unchanged.
+func (p *Post) BeforeSave(tx *gorm.DB) error {
+	if p.Content != "" {
+		p.WordCount = CountWords(p.Content)
+		p.ReadingTime = ReadingTimeMinutes(p.WordCount)
+	}
+	return nil
+}
+
+// BeforeUpdate bumps the version on every update, so a client's version
+// tells whether the post changed since it was read. Updates with a map of
+// columns, like the scheduler's, get version = version + 1 added. A saved
+// post may carry a stale version, so the row is bumped first, within the
+// same transaction, and the post saved with the new one. Updates that skip
+// hooks, such as UpdateColumn for view counts, leave it alone.
+func (p *Post) BeforeUpdate(tx *gorm.DB) error {
+	if _, ok := tx.Statement.Dest.(map[string]interface{}); ok {
+		tx.Statement.SetColumn("version", gorm.Expr("version + 1"))
+		return nil
+	}
+	if p.ID == 0 {
+		return nil
+	}
+	return tx.Session(&gorm.Session{NewDB: true}).
+		Raw("UPDATE posts SET version = version + 1 WHERE id = ? RETURNING version", p.ID).
+		Scan(&p.Version).Error
 }
 
 // Tag represents a post tag
 // @Description A tag that can be associated with multiple posts
 type Tag struct {
-	ID    uint   `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
-	Name  string `json:"name" gorm:"size:50;not null;unique" example:"technology" description:"Tag name"`
-	Posts []Post `json:"posts" gorm:"many2many:post_tags;" description:"Posts associated with this tag"`
+	ID     uint   `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	SiteID uint   `json:"-" gorm:"not null;default:1;uniqueIndex:idx_tags_site_name"` // Tags are per site
+	Name   string `json:"name" gorm:"size:50;not null;uniqueIndex:idx_tags_site_name" example:"technology" description:"Tag name"`
+	Posts  []Post `json:"posts" gorm:"many2many:post_tags;" description:"Posts associated with this tag"`
 }
 
+// CommentStatus represents the moderation status of a comment
+type CommentStatus string
+
+const (
+	// CommentStatusApproved indicates the comment is publicly visible
+	CommentStatusApproved CommentStatus = "approved"
+	// CommentStatusPending indicates the comment is held for moderation
+	CommentStatusPending CommentStatus = "pending"
+)
+
 // Comment represents a user comment on a post
 // @Description A comment made by a user on a specific post
 type Comment struct {
-	ID        uint           `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
-	Content   string         `json:"content" gorm:"type:text;not null" example:"Great post!" description:"Comment content"`
-	UserID    uint           `json:"user_id" example:"1" description:"ID of the comment author"`
-	User      User           `json:"user" gorm:"foreignKey:UserID" description:"Author of the comment"`
-	PostID    uint           `json:"post_id" example:"1" description:"ID of the post being commented on"`
-	Post      Post           `json:"post" gorm:"foreignKey:PostID" description:"Post being commented on"`
-	CreatedAt time.Time      `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the comment was created"`
-	UpdatedAt time.Time      `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the comment was last updated"`
-	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"` // Hide from Swagger
+	ID         uint                  `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UUID       string                `json:"uuid" gorm:"type:uuid;uniqueIndex" example:"9a7e1c2d-4b3f-4e5a-8c6d-1f2e3a4b5c6d" description:"Stable public identifier"`
+	Content    string                `json:"content" gorm:"type:text;not null" example:"Great post!" description:"Comment content"`
+	Status     CommentStatus         `json:"status" gorm:"type:varchar(20);not null;default:'approved';index" example:"approved" description:"Moderation status (approved, pending). Pending comments are hidden from the post's comment list."`
+	FlagReason string                `json:"flag_reason,omitempty" gorm:"size:50" example:"too_many_links" description:"Why the spam checks held the comment (honeypot, too_many_links, new_account_link, akismet). Only shown in the moderation queue."`
+	UserID     uint                  `json:"user_id" example:"1" description:"ID of the comment author"`
+	User       User                  `json:"user" gorm:"foreignKey:UserID" description:"Author of the comment"`
+	PostID     uint                  `json:"post_id" example:"1" description:"ID of the post being commented on"`
+	Post       Post                  `json:"post" gorm:"foreignKey:PostID" description:"Post being commented on"`
+	ParentID   *uint                 `json:"parent_id,omitempty" gorm:"index" example:"3" description:"ID of the comment this one replies to"`
+	Mentions   []CommentMention      `json:"mentions" gorm:"foreignKey:CommentID" description:"Users mentioned in the content with @username"`
+	Reactions  CommentReactionCounts `json:"reactions" gorm:"-" description:"Number of reactions to the comment of each type (only counted when listing a post's comments)"`
+	CreatedAt  time.Time             `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the comment was created"`
+	UpdatedAt  time.Time             `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the comment was last updated"`
+	DeletedAt  gorm.DeletedAt        `json:"-" gorm:"index"` // Hide from Swagger
+}
+
+// BeforeCreate assigns a public UUID to new comments and approves them unless
+// they were held for moderation
+func (c *Comment) BeforeCreate(tx *gorm.DB) error {
+	if c.UUID == "" {
+		c.UUID = uuid.NewString()
+	}
+	if c.Status == "" {
+		c.Status = CommentStatusApproved
+	}
+	return nil
 }
 
 // CreatePostRequest represents the request body for creating a new post
 // @Description Request model for creating a new blog post
 type CreatePostRequest struct {
-	Title     string     `json:"title" binding:"required" example:"My New Post" description:"Post title"`
-	Content   string     `json:"content" binding:"required" example:"This is the content of my new post" description:"Main content of the post"`
-	Excerpt   string     `json:"excerpt" example:"A short excerpt" description:"Short summary or preview of the post"`
-	Cover     string     `json:"cover" example:"https://example.com/image.jpg" description:"URL to the post's cover image"`
-	Tags      []string   `json:"tags" example:"[\"technology\",\"programming\"]" description:"Tags associated with the post"`
-	Status    PostStatus `json:"status" example:"published" description:"Publication status of the post (draft, published, archived, scheduled)"`
-	PublishAt *time.Time `json:"publish_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When to publish the post if status is 'scheduled'"`
+	Title         string     `json:"title" binding:"required_without=TemplateID" example:"My New Post" description:"Post title, from the template's title pattern if empty"`
+	Content       string     `json:"content" binding:"required_without=TemplateID" example:"This is the content of my new post" description:"Main content of the post, the template's content skeleton if empty"`
+	Excerpt       string     `json:"excerpt" example:"A short excerpt" description:"Short summary or preview of the post"`
+	Cover         string     `json:"cover" example:"https://example.com/image.jpg" description:"URL to the post's cover image"`
+	Tags          []string   `json:"tags" example:"[\"technology\",\"programming\"]" description:"Tags associated with the post"`
+	Status        PostStatus `json:"status" example:"published" description:"Publication status of the post (draft, published, archived, scheduled)"`
+	PublishAt     *time.Time `json:"publish_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When to publish the post if status is 'scheduled'"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty" example:"2023-02-01T00:00:00Z" description:"When to stop publishing the post; it is then archived or unpublished"`
+	CategoryID    *uint      `json:"category_id" example:"1" description:"ID of the post's category"`
+	Language      string     `json:"language" binding:"omitempty,oneof=en vi" example:"en" description:"Language the post is written in (en, vi), en if empty"`
+	TranslationOf *uint      `json:"translation_of,omitempty" example:"1" description:"ID of the post this post is a translation of"`
+	TemplateID    uint       `json:"template_id,omitempty" example:"1" description:"ID of a post template whose values fill the fields left empty"`
+	SkipCrossPost bool       `json:"skip_cross_post" example:"false" description:"Don't cross-post the post to the configured social networks when it is published"`
 }
 
 // UpdatePostRequest represents the request body for updating an existing post
 // @Description Request model for updating an existing blog post
 type UpdatePostRequest struct {
-	Title     *string     `json:"title" example:"Updated Post Title" description:"New post title"`
-	Content   *string     `json:"content" example:"Updated content" description:"New main content of the post"`
-	Excerpt   *string     `json:"excerpt" example:"Updated excerpt" description:"New short summary or preview of the post"`
-	Cover     *string     `json:"cover" example:"https://example.com/updated-cover.jpg" description:"New URL to the post's cover image"`
-	Tags      []string    `json:"tags" example:"[\"technology\",\"programming\",\"updated\"]" description:"New tags associated with the post"`
-	Status    *PostStatus `json:"status" example:"published" description:"New publication status of the post"`
-	PublishAt *time.Time  `json:"publish_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When to publish the post if status is 'scheduled'"`
+	Title         *string     `json:"title" example:"Updated Post Title" description:"New post title"`
+	Content       *string     `json:"content" example:"Updated content" description:"New main content of the post"`
+	Excerpt       *string     `json:"excerpt" example:"Updated excerpt" description:"New short summary or preview of the post"`
+	Cover         *string     `json:"cover" example:"https://example.com/updated-cover.jpg" description:"New URL to the post's cover image"`
+	Tags          []string    `json:"tags" example:"[\"technology\",\"programming\",\"updated\"]" description:"New tags associated with the post"`
+	Status        *PostStatus `json:"status" example:"published" description:"New publication status of the post"`
+	PublishAt     *time.Time  `json:"publish_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When to publish the post if status is 'scheduled'"`
+	ExpiresAt     *time.Time  `json:"expires_at,omitempty" example:"2023-02-01T00:00:00Z" description:"New expiry date of the post"`
+	ClearExpiry   bool        `json:"clear_expiry,omitempty" example:"false" description:"Remove the post's expiry date"`
+	CategoryID    *uint       `json:"category_id" example:"1" description:"New category ID, 0 to remove the category"`
+	Language      *string     `json:"language" binding:"omitempty,oneof=en vi" example:"vi" description:"New language of the post (en, vi)"`
+	TranslationOf *uint       `json:"translation_of,omitempty" example:"1" description:"ID of the post this post is a translation of, 0 to unlink it from its translations"`
+	SkipCrossPost *bool       `json:"skip_cross_post" example:"true" description:"Whether publishing the post leaves it off the configured social networks"`
+	Version       *int        `json:"version,omitempty" example:"3" description:"Version of the post the edits were made to; the update is rejected with 409 if the post has changed since"`
 }
 
 // CreateCommentRequest represents the request body for creating a new comment
 // @Description Request model for creating a new comment on a post
 type CreateCommentRequest struct {
-	Content string `json:"content" binding:"required" example:"This is a great post!" description:"Comment content"`
+	Content  string `json:"content" binding:"required" example:"This is a great post!" description:"Comment content"`
+	ParentID *uint  `json:"parent_id" example:"3" description:"ID of the comment on the same post this one replies to"`
+	// Website is a honeypot: clients render it as a hidden field and leave it
+	// empty, so a value means the form was filled in by a bot
+	Website string `json:"website,omitempty" example:"" description:"Leave empty. Hidden field used to detect bots."`
 }
 
 // UpdateCommentRequest represents the request body for updating an existing comment
@@ -104,9 +210,37 @@ type TagWithCount struct {
 	PostCount int64  `json:"post_count" example:"5" description:"Number of posts using this tag"`
 }
 
+// RenameTagRequest represents the request body for renaming a tag
+// @Description Request model for renaming a tag
+type RenameTagRequest struct {
+	Name string `json:"name" binding:"required,max=50" example:"golang" description:"New tag name"`
+}
+
+// MergeTagRequest represents the request body for merging a tag into another
+// @Description Request model for merging a tag into another tag
+type MergeTagRequest struct {
+	IntoID uint `json:"into_id" binding:"required" example:"2" description:"ID of the tag that takes over the merged tag's posts and news"`
+}
+
 // SetPostStatusRequest represents the request body for updating a post's status
 // @Description Request model for changing a post's status
 type SetPostStatusRequest struct {
 	Status    PostStatus `json:"status" binding:"required" example:"published" description:"New post status (draft, published, archived, scheduled)"`
 	PublishAt *time.Time `json:"publish_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When to publish the post if status is 'scheduled'"`
 }
+
+// CreateNewsCommentaryRequest represents the optional request body for starting
+// a commentary post on a news article
+// @Description Request model for creating a commentary post from a news article
+type CreateNewsCommentaryRequest struct {
+	Title      string `json:"title" example:"Why quantum advantage matters" description:"Post title; defaults to the news article's title"`
+	Commentary string `json:"commentary" example:"Here's my take on this..." description:"Opening commentary placed after the quote"`
+}
+
+// PreviewTokenResponse is returned when a preview link is created
+// @Description A signed link that shows an unpublished post without logging in
+type PreviewTokenResponse struct {
+	Token      string    `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"Signed preview token"`
+	PreviewURL string    `json:"preview_url" example:"/api/v1/posts/preview/eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"Path of the public preview endpoint for this token"`
+	ExpiresAt  time.Time `json:"expires_at" example:"2023-01-04T12:00:00Z" description:"When the link stops working"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// PostAuthorRole is what a co-author may do with a post
+type PostAuthorRole string
+
+const (
+	// PostAuthorRoleAuthor may edit and publish the post
+	PostAuthorRoleAuthor PostAuthorRole = "author"
+	// PostAuthorRoleContributor may edit the post but not publish it
+	PostAuthorRoleContributor PostAuthorRole = "contributor"
+	// PostAuthorRoleReviewer may publish the post but not edit it
+	PostAuthorRoleReviewer PostAuthorRole = "reviewer"
+)
+
+// CanEdit reports whether the role allows changing the post's content
+func (r PostAuthorRole) CanEdit() bool {
+	return r == PostAuthorRoleAuthor || r == PostAuthorRoleContributor
+}
+
+// CanPublish reports whether the role allows changing the post's status
+func (r PostAuthorRole) CanPublish() bool {
+	return r == PostAuthorRoleAuthor || r == PostAuthorRoleReviewer
+}
+
+// PostAuthor credits a user other than the post's owner on a post
+// @Description A co-author of a post and their role
+type PostAuthor struct {
+	PostID    uint           `json:"-" gorm:"primaryKey"`
+	UserID    uint           `json:"user_id" gorm:"primaryKey;index" example:"2" description:"ID of the co-author"`
+	User      User           `json:"user" gorm:"foreignKey:UserID" description:"The co-author"`
+	Role      PostAuthorRole `json:"role" gorm:"type:varchar(20);not null" example:"contributor" description:"Role of the co-author (author, contributor, reviewer)"`
+	CreatedAt time.Time      `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the co-author was added"`
+}
+
+// AddPostAuthorRequest represents the request body for adding a co-author
+// @Description Request model for adding a co-author to a post
+type AddPostAuthorRequest struct {
+	Username string         `json:"username" binding:"required" example:"janedoe" description:"Username of the co-author"`
+	Role     PostAuthorRole `json:"role" binding:"required,oneof=author contributor reviewer" example:"contributor" description:"Role of the co-author (author, contributor, reviewer)"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// PostAutosave is a snapshot of a post the editor saved while its author was
+// typing. Snapshots are kept apart from the post, so saving one never changes
+// what readers see.
+// @Description A draft snapshot of a post saved by the editor
+type PostAutosave struct {
+	ID            uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	PostID        uint      `json:"post_id" gorm:"not null;index:idx_post_autosaves_post_user" example:"1" description:"ID of the post"`
+	UserID        uint      `json:"user_id" gorm:"not null;index:idx_post_autosaves_post_user;index" example:"1" description:"ID of the user who was editing"`
+	Title         string    `json:"title" gorm:"size:255" example:"Getting Started with Go" description:"Title being edited"`
+	Excerpt       string    `json:"excerpt" gorm:"type:text" example:"Learn the basics of Go" description:"Excerpt being edited"`
+	Content       string    `json:"content" gorm:"type:text" example:"Go is a statically typed language..." description:"Content being edited"`
+	BaseUpdatedAt time.Time `json:"base_updated_at" gorm:"not null" example:"2023-01-02T12:00:00Z" description:"updated_at of the post the edits started from"`
+	CreatedAt     time.Time `json:"created_at" example:"2023-01-03T12:00:00Z" description:"When the snapshot was first saved"`
+	UpdatedAt     time.Time `json:"updated_at" example:"2023-01-03T12:00:20Z" description:"When the snapshot was last saved"`
+}
+
+// AutosavePostRequest represents the request body for autosaving a post
+// @Description Request model for saving a draft snapshot of a post being edited
+type AutosavePostRequest struct {
+	Title         string     `json:"title" example:"Getting Started with Go" description:"Title being edited"`
+	Excerpt       string     `json:"excerpt" example:"Learn the basics of Go" description:"Excerpt being edited"`
+	Content       string     `json:"content" example:"Go is a statically typed language..." description:"Content being edited"`
+	BaseUpdatedAt *time.Time `json:"base_updated_at" binding:"required" example:"2023-01-02T12:00:00Z" description:"updated_at of the post when the editor loaded it"`
+}
+
+// PostAutosaveResult is a saved snapshot and whether the post changed under it
+// @Description A draft snapshot with a conflict indicator
+type PostAutosaveResult struct {
+	Autosave      PostAutosave `json:"autosave" description:"The saved snapshot"`
+	Conflict      bool         `json:"conflict" example:"false" description:"Whether the post was updated since the version the edits started from, so saving the edits would overwrite someone else's changes"`
+	PostUpdatedAt time.Time    `json:"post_updated_at" example:"2023-01-02T12:00:00Z" description:"Current updated_at of the post"`
+}
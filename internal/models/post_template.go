@@ -0,0 +1,69 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PostTemplate pre-fills new posts of a recurring format, such as a weekly
+// link roundup. Editors manage templates; authors pick one with template_id
+// when creating a post.
+// @Description A starting point for new posts
+type PostTemplate struct {
+	ID           uint       `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	SiteID       uint       `json:"-" gorm:"not null;default:1;uniqueIndex:idx_post_templates_site_name"` // Site the template is used on
+	Name         string     `json:"name" gorm:"size:100;not null;uniqueIndex:idx_post_templates_site_name" example:"Weekly links" description:"Name to pick the template by"`
+	Description  string     `json:"description" gorm:"size:255" example:"Links worth reading from the past week" description:"What the template is for"`
+	TitlePattern string     `json:"title_pattern" gorm:"size:255;not null" example:"Weekly links: week {week}, {year}" description:"Title of new posts; {date}, {year}, {month}, {day} and {week} are replaced with the current date"`
+	Content      string     `json:"content" gorm:"type:text" example:"## Articles\n\n- \n\n## Tools\n\n- " description:"Content skeleton of new posts"`
+	Excerpt      string     `json:"excerpt" gorm:"type:text" example:"The best links of the week" description:"Excerpt of new posts"`
+	Tags         []string   `json:"tags" gorm:"type:text;serializer:json" example:"[\"links\",\"weekly\"]" description:"Tags of new posts"`
+	Status       PostStatus `json:"status" gorm:"size:20;not null;default:draft" example:"draft" description:"Status of new posts (draft, published)"`
+	CategoryID   *uint      `json:"category_id,omitempty" example:"1" description:"Category of new posts"`
+	Language     string     `json:"language" gorm:"size:10;not null;default:'en'" example:"en" description:"Language of new posts (en, vi)"`
+	UpdatedBy    *uint      `json:"updated_by,omitempty" example:"1" description:"ID of the editor who last changed the template"`
+	CreatedAt    time.Time  `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the template was created"`
+	UpdatedAt    time.Time  `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the template was last changed"`
+}
+
+// RenderTitle returns the title of a post created from the template at the
+// given time
+func (t *PostTemplate) RenderTitle(at time.Time) string {
+	year, week := at.ISOWeek()
+	return strings.NewReplacer(
+		"{date}", at.Format("2006-01-02"),
+		"{year}", strconv.Itoa(year),
+		"{month}", at.Format("01"),
+		"{day}", at.Format("02"),
+		"{week}", strconv.Itoa(week),
+	).Replace(t.TitlePattern)
+}
+
+// CreatePostTemplateRequest represents the request body for creating a post template
+// @Description Request model for creating a post template
+type CreatePostTemplateRequest struct {
+	Name         string     `json:"name" binding:"required,max=100" example:"Weekly links" description:"Name to pick the template by, unique per site"`
+	Description  string     `json:"description" binding:"max=255" example:"Links worth reading from the past week" description:"What the template is for"`
+	TitlePattern string     `json:"title_pattern" binding:"required,max=255" example:"Weekly links: week {week}, {year}" description:"Title of new posts; {date}, {year}, {month}, {day} and {week} are replaced with the current date"`
+	Content      string     `json:"content" example:"## Articles\n\n- \n\n## Tools\n\n- " description:"Content skeleton of new posts"`
+	Excerpt      string     `json:"excerpt" example:"The best links of the week" description:"Excerpt of new posts"`
+	Tags         []string   `json:"tags" example:"[\"links\",\"weekly\"]" description:"Tags of new posts"`
+	Status       PostStatus `json:"status" binding:"omitempty,oneof=draft published" example:"draft" description:"Status of new posts (draft, published), draft if empty"`
+	CategoryID   *uint      `json:"category_id" example:"1" description:"Category of new posts"`
+	Language     string     `json:"language" binding:"omitempty,oneof=en vi" example:"en" description:"Language of new posts (en, vi), en if empty"`
+}
+
+// UpdatePostTemplateRequest represents the request body for changing a post template
+// @Description Request model for changing a post template; omitted fields are kept
+type UpdatePostTemplateRequest struct {
+	Name         *string     `json:"name" binding:"omitempty,min=1,max=100" example:"Weekly links" description:"New name"`
+	Description  *string     `json:"description" binding:"omitempty,max=255" example:"Links worth reading from the past week" description:"New description"`
+	TitlePattern *string     `json:"title_pattern" binding:"omitempty,min=1,max=255" example:"Links of the week {week}" description:"New title pattern"`
+	Content      *string     `json:"content" example:"## Articles\n\n- " description:"New content skeleton"`
+	Excerpt      *string     `json:"excerpt" example:"The best links of the week" description:"New excerpt"`
+	Tags         []string    `json:"tags" example:"[\"links\"]" description:"New tags"`
+	Status       *PostStatus `json:"status" binding:"omitempty,oneof=draft published" example:"published" description:"New status of new posts"`
+	CategoryID   *uint       `json:"category_id" example:"1" description:"New category, 0 to remove it"`
+	Language     *string     `json:"language" binding:"omitempty,oneof=en vi" example:"vi" description:"New language of new posts"`
+}
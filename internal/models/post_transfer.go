@@ -0,0 +1,104 @@
+package models
+
+import "time"
+
+// Post import and export formats
+const (
+	// PostTransferFormatJSON is a single JSON document holding every post
+	PostTransferFormatJSON = "json"
+	// PostTransferFormatMarkdown is a zip archive with one Markdown file with
+	// YAML front matter per post
+	PostTransferFormatMarkdown = "markdown"
+)
+
+// PostExportVersion is the version of the JSON export format
+const PostExportVersion = 1
+
+// PortablePost is a post in the import and export formats. Authors and
+// categories are referenced by username and slug so posts can move between
+// instances.
+// @Description A post as exported, or as accepted by the importer
+type PortablePost struct {
+	Title     string     `json:"title" yaml:"title" example:"My First Blog Post" description:"Post title"`
+	Slug      string     `json:"slug" yaml:"slug" example:"my-first-blog-post" description:"Slug, generated from the title when empty"`
+	Excerpt   string     `json:"excerpt,omitempty" yaml:"excerpt,omitempty" example:"A short summary of the post" description:"Short summary of the post"`
+	Content   string     `json:"content" yaml:"-" example:"This is the content of my blog post..." description:"Post content. In Markdown archives it is the body after the front matter."`
+	Cover     string     `json:"cover,omitempty" yaml:"cover,omitempty" example:"https://example.com/image.jpg" description:"Cover image URL"`
+	Status    PostStatus `json:"status" yaml:"status" example:"published" description:"Publication status, draft when empty"`
+	Language  string     `json:"language,omitempty" yaml:"language,omitempty" example:"en" description:"Language of the post (en, vi), en when empty"`
+	Tags      []string   `json:"tags,omitempty" yaml:"tags,omitempty" example:"[\"technology\",\"programming\"]" description:"Tag names"`
+	Category  string     `json:"category,omitempty" yaml:"category,omitempty" example:"backend" description:"Slug of an existing category"`
+	Author    string     `json:"author,omitempty" yaml:"author,omitempty" example:"johndoe" description:"Username of the author; the importing admin when empty or unknown"`
+	PublishAt *time.Time `json:"publish_at,omitempty" yaml:"publish_at,omitempty" example:"2023-01-03T12:00:00Z" description:"When a scheduled post will be published"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty" example:"2023-02-01T00:00:00Z" description:"When the post stops being published"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty" example:"2023-01-01T12:00:00Z" description:"Original creation date, which orders the post in listings"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty" example:"2023-01-02T12:00:00Z" description:"Last update date"`
+}
+
+// PostExport is the JSON export document
+// @Description Exported posts in the JSON format
+type PostExport struct {
+	Version    int            `json:"version" example:"1" description:"Format version"`
+	ExportedAt time.Time      `json:"exported_at" example:"2023-01-05T12:00:00Z" description:"When the export was made"`
+	Posts      []PortablePost `json:"posts"`
+}
+
+// Outcomes of importing one post
+const (
+	PostImportCreate = "create"
+	PostImportUpdate = "update"
+	PostImportSkip   = "skip"
+	PostImportError  = "error"
+)
+
+// PostImportItem reports what happened to one imported post
+// @Description Outcome of importing one post
+type PostImportItem struct {
+	Source string   `json:"source" example:"posts/my-first-blog-post.md" description:"File name in the archive, or the post's index in a JSON import"`
+	Slug   string   `json:"slug,omitempty" example:"my-first-blog-post" description:"Slug the post has or would have"`
+	Action string   `json:"action" example:"create" enums:"create,update,skip,error" description:"What the import did, or would do in a dry run"`
+	Errors []string `json:"errors,omitempty" example:"[\"title is required\"]" description:"Why the post could not be imported"`
+}
+
+// PostImportResult summarizes an import
+// @Description Summary of a post import
+type PostImportResult struct {
+	DryRun  bool             `json:"dry_run" example:"false" description:"Whether nothing was saved"`
+	Created int              `json:"created" example:"12" description:"Posts created"`
+	Updated int              `json:"updated" example:"0" description:"Existing posts overwritten"`
+	Skipped int              `json:"skipped" example:"1" description:"Posts skipped because their slug exists"`
+	Failed  int              `json:"failed" example:"1" description:"Posts that failed validation"`
+	Items   []PostImportItem `json:"items"`
+}
+
+// WXRSkippedItem is an item of a WordPress export that wasn't imported
+// @Description Item of a WordPress export that was not imported
+type WXRSkippedItem struct {
+	Title  string `json:"title" example:"Main menu" description:"Title of the item"`
+	Type   string `json:"type" example:"nav_menu_item" description:"WordPress post type"`
+	Reason string `json:"reason" example:"post type is not supported" description:"Why the item was skipped"`
+}
+
+// WXRImageFailure is an image that couldn't be copied to storage
+// @Description Image referenced by an imported post that could not be downloaded
+type WXRImageFailure struct {
+	URL   string `json:"url" example:"https://blog.example.com/wp-content/uploads/2023/01/photo.jpg" description:"Original image URL, which the post keeps"`
+	Error string `json:"error" example:"unexpected status 404" description:"Why the download failed"`
+}
+
+// WXRImportResult summarizes a WordPress import
+// @Description Summary of a WordPress (WXR) import
+type WXRImportResult struct {
+	DryRun            bool              `json:"dry_run" example:"false" description:"Whether nothing was saved"`
+	Posts             PostImportResult  `json:"posts" description:"Outcome of each imported post and page"`
+	Pages             int               `json:"pages" example:"2" description:"Pages among the imported posts, which are tagged \"page\""`
+	AuthorsMatched    int               `json:"authors_matched" example:"1" description:"WordPress authors matched to local users by email or username"`
+	AuthorsCreated    int               `json:"authors_created" example:"1" description:"Local users created for WordPress authors"`
+	CategoriesCreated int               `json:"categories_created" example:"3" description:"Categories created"`
+	CommentsImported  int               `json:"comments_imported" example:"40" description:"Comments imported on newly created posts"`
+	CommentsGuest     int               `json:"comments_guest" example:"25" description:"Imported comments by visitors without a local account, attributed to the ghost user"`
+	CommentsSkipped   int               `json:"comments_skipped" example:"5" description:"Spam, trashed, pingback and trackback comments that were skipped"`
+	ImagesDownloaded  int               `json:"images_downloaded" example:"18" description:"Images copied to storage"`
+	ImagesFailed      []WXRImageFailure `json:"images_failed,omitempty" description:"Images that could not be copied and keep their original URL"`
+	SkippedItems      []WXRSkippedItem  `json:"skipped_items,omitempty" description:"Items of the export that were not imported"`
+}
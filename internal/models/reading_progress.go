@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ReadingProgress is how far a user got in a post, so they can pick up where
+// they left off on another device
+// @Description How far the current user got in a post
+type ReadingProgress struct {
+	ID         uint      `json:"-" gorm:"primaryKey"`
+	UserID     uint      `json:"-" gorm:"not null;uniqueIndex:idx_reading_progress_user_post"`
+	PostID     uint      `json:"post_id" gorm:"not null;uniqueIndex:idx_reading_progress_user_post;index" example:"1" description:"ID of the post"`
+	Post       *Post     `json:"post,omitempty" gorm:"foreignKey:PostID;constraint:OnDelete:CASCADE" description:"The post, when listing progress"`
+	Percentage int       `json:"percentage" gorm:"not null;default:0" example:"42" description:"How much of the post was read, from 0 to 100"`
+	Anchor     string    `json:"anchor,omitempty" gorm:"size:255" example:"p-12" description:"Anchor of the last paragraph read"`
+	UpdatedAt  time.Time `json:"updated_at" example:"2023-01-01T12:00:00Z" description:"When the progress was last saved"`
+}
+
+// TableName keeps the table name singular, as "progresses" reads oddly
+func (ReadingProgress) TableName() string {
+	return "reading_progress"
+}
+
+// SaveReadingProgressRequest represents the request body for saving reading
+// progress
+// @Description Request model for saving how far the current user got in a post
+type SaveReadingProgressRequest struct {
+	Percentage *int   `json:"percentage" binding:"required,min=0,max=100" example:"42" description:"How much of the post was read, from 0 to 100"`
+	Anchor     string `json:"anchor" binding:"max=255" example:"p-12" description:"Anchor of the last paragraph read, to scroll back to"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// wordsPerMinute is the reading speed reading times are estimated with
+const wordsPerMinute = 200
+
+// markupPattern matches HTML tags and Markdown link and image targets, which
+// aren't read
+var markupPattern = regexp.MustCompile(`<[^>]*>|\]\([^)]*\)`)
+
+// CountWords returns the number of words in post content, ignoring HTML tags,
+// link targets and Markdown symbols such as # and - that contain no letters
+// or digits
+func CountWords(content string) int {
+	words := 0
+	for _, field := range strings.Fields(markupPattern.ReplaceAllString(content, " ")) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			words++
+		}
+	}
+	return words
+}
+
+// ReadingTimeMinutes estimates how long words take to read, rounded up to
+// whole minutes. Any text takes at least a minute.
+func ReadingTimeMinutes(words int) int {
+	return (words + wordsPerMinute - 1) / wordsPerMinute
+}
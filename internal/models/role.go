@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// Role is a named set of permissions users are given through their role
+// column. The admin, editor and user roles are built in: they can't be renamed
+// or deleted, and the admin role's permissions can't be changed.
+// @Description A role and the permissions it grants
+type Role struct {
+	ID          uint      `json:"id" gorm:"primaryKey" example:"4" description:"Unique identifier"`
+	Name        string    `json:"name" gorm:"size:20;not null;uniqueIndex" example:"moderator" description:"Role name, stored on users and in their tokens"`
+	Description string    `json:"description" gorm:"size:255" example:"Keeps the comments civil" description:"What the role is for"`
+	Permissions []string  `json:"permissions" gorm:"type:text;serializer:json" example:"comment.edit,comment.delete" description:"Actions the role may perform on any resource"`
+	BuiltIn     bool      `json:"built_in" gorm:"not null;default:false" example:"false" description:"Whether the role is one of admin, editor and user"`
+	UserCount   int64     `json:"user_count" gorm:"-" example:"3" description:"Number of users with the role"`
+	CreatedAt   time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the role was created"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the role was last changed"`
+}
+
+// CreateRoleRequest represents the request body for creating a role
+// @Description Request model for creating a custom role
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required,min=2,max=20" example:"moderator" description:"Role name: lowercase letters, digits, hyphens and underscores"`
+	Description string   `json:"description" binding:"max=255" example:"Keeps the comments civil" description:"What the role is for"`
+	Permissions []string `json:"permissions" example:"comment.edit,comment.delete" description:"Actions the role may perform on any resource, from GET /admin/permissions"`
+}
+
+// UpdateRoleRequest represents the request body for changing a role
+// @Description Request model for changing a role; omitted fields are kept
+type UpdateRoleRequest struct {
+	Description *string  `json:"description" binding:"omitempty,max=255" example:"Moderates comments and pages" description:"New description"`
+	Permissions []string `json:"permissions" example:"comment.edit,comment.delete,page.edit" description:"New list of permissions, replacing the old one"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// SearchResultType identifies the kind of item a search result points to
+type SearchResultType string
+
+const (
+	// SearchResultPost is a published blog post
+	SearchResultPost SearchResultType = "post"
+	// SearchResultNews is a published news article
+	SearchResultNews SearchResultType = "news"
+	// SearchResultTag is a tag
+	SearchResultTag SearchResultType = "tag"
+)
+
+// SearchResult is a single match from the search index
+// @Description A post, news article or tag matching a search query
+type SearchResult struct {
+	Type        SearchResultType `json:"type" example:"post" description:"Kind of item (post, news, tag)"`
+	ID          uint             `json:"id" example:"1" description:"ID of the item"`
+	UUID        string           `json:"uuid,omitempty" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"Public identifier of the post or news article"`
+	Title       string           `json:"title" example:"My First Blog Post" description:"Title, or the tag name"`
+	Slug        string           `json:"slug" example:"my-first-blog-post" description:"Slug used to link to the item"`
+	Snippet     string           `json:"snippet,omitempty" example:"This is the content of my <mark>blog</mark> post..." description:"Excerpt around the matched words, which are wrapped in <mark> tags"`
+	PublishedAt *time.Time       `json:"published_at,omitempty" example:"2023-01-01T12:00:00Z" description:"When the post or news article was published"`
+	Score       float64          `json:"score" example:"0.42" description:"Relevance score, higher is a better match"`
+}
+
+// SearchGroup holds the best matches of one type
+// @Description Search results of one type
+type SearchGroup struct {
+	Total   int64          `json:"total" example:"12" description:"Number of matching items of this type"`
+	Results []SearchResult `json:"results" description:"Best matches, most relevant first"`
+}
+
+// SearchResponse groups the matches of a search by type
+// @Description Response model for the unified search
+type SearchResponse struct {
+	Query string       `json:"query" example:"golang api" description:"Search query as received"`
+	Posts *SearchGroup `json:"posts,omitempty" description:"Matching blog posts"`
+	News  *SearchGroup `json:"news,omitempty" description:"Matching news articles"`
+	Tags  *SearchGroup `json:"tags,omitempty" description:"Matching tags"`
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Series groups posts into an ordered sequence, such as a multi-part tutorial
+// @Description An ordered collection of posts
+type Series struct {
+	ID          uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UUID        string    `json:"uuid" gorm:"type:uuid;uniqueIndex" example:"3b1f8e2a-6c4d-4f9e-8a7b-2d5c1e0f9a8b" description:"Stable public identifier"`
+	Title       string    `json:"title" gorm:"size:255;not null" example:"Building a Blog in Go" description:"Series title"`
+	Slug        string    `json:"slug" gorm:"size:255;not null;uniqueIndex" example:"building-a-blog-in-go" description:"URL-friendly version of the title"`
+	Description string    `json:"description" gorm:"type:text" example:"A step-by-step tutorial from an empty repository to a deployed API" description:"Series description"`
+	UserID      uint      `json:"user_id" example:"1" description:"ID of the user who owns the series"`
+	User        User      `json:"user" gorm:"foreignKey:UserID" description:"Owner of the series"`
+	PostCount   int64     `json:"post_count" gorm:"-" example:"3" description:"Number of published posts in the series"`
+	Posts       []Post    `json:"posts,omitempty" gorm:"foreignKey:SeriesID" description:"Published posts in series order (only included when fetching one series)"`
+	CreatedAt   time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the series was created"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the series was last updated"`
+}
+
+// BeforeCreate assigns a public UUID to new series
+func (s *Series) BeforeCreate(tx *gorm.DB) error {
+	if s.UUID == "" {
+		s.UUID = uuid.NewString()
+	}
+	return nil
+}
+
+// SeriesPostLink identifies a neighbouring post in a series
+// @Description A post linked from the series navigation
+type SeriesPostLink struct {
+	ID    uint   `json:"id" example:"2" description:"Post ID"`
+	UUID  string `json:"uuid" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"Post UUID"`
+	Title string `json:"title" example:"Part 2: Routing" description:"Post title"`
+	Slug  string `json:"slug" example:"part-2-routing" description:"Post slug"`
+}
+
+// SeriesNavigation places a post within its series
+// @Description Where a post sits in its series, with links to the posts before and after it
+type SeriesNavigation struct {
+	ID       uint            `json:"id" example:"1" description:"Series ID"`
+	Title    string          `json:"title" example:"Building a Blog in Go" description:"Series title"`
+	Slug     string          `json:"slug" example:"building-a-blog-in-go" description:"Series slug"`
+	Position int             `json:"position" example:"2" description:"Position of the post in the series, starting at 1"`
+	Total    int             `json:"total" example:"3" description:"Number of published posts in the series"`
+	Previous *SeriesPostLink `json:"previous,omitempty" description:"Previous published post, absent for the first"`
+	Next     *SeriesPostLink `json:"next,omitempty" description:"Next published post, absent for the last"`
+}
+
+// CreateSeriesRequest represents the request body for creating a series
+// @Description Request model for creating a post series
+type CreateSeriesRequest struct {
+	Title       string `json:"title" binding:"required,max=255" example:"Building a Blog in Go" description:"Series title"`
+	Slug        string `json:"slug" binding:"omitempty,max=255" example:"building-a-blog-in-go" description:"Custom slug (generated from the title if empty)"`
+	Description string `json:"description" example:"A step-by-step tutorial from an empty repository to a deployed API" description:"Series description"`
+}
+
+// UpdateSeriesRequest represents the request body for updating a series
+// @Description Request model for updating a post series
+type UpdateSeriesRequest struct {
+	Title       *string `json:"title" binding:"omitempty,max=255" example:"Building a Blog in Go" description:"New title"`
+	Slug        *string `json:"slug" binding:"omitempty,max=255" example:"building-a-blog-in-go" description:"New slug"`
+	Description *string `json:"description" example:"A step-by-step tutorial" description:"New description"`
+}
+
+// AddSeriesPostRequest represents the request body for adding a post to a series
+// @Description Request model for adding a post to a series or moving it within one
+type AddSeriesPostRequest struct {
+	PostID   string `json:"post_id" binding:"required" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"ID or UUID of the post"`
+	Position *int   `json:"position" binding:"omitempty,min=1" example:"2" description:"Position in the series, starting at 1. Later posts move down; omit to add the post at the end."`
+}
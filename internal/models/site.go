@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// DefaultSiteID is the site content created before sites existed belongs to
+const DefaultSiteID uint = 1
+
+// Site is one blog served by the deployment, identified by the domain it is
+// served on. Posts, tags, pages, news sources and post templates belong to a
+// site; users, comments on them and news articles are shared.
+// @Description A blog served on its own domain
+type Site struct {
+	ID        uint      `json:"id" gorm:"primaryKey" example:"2" description:"Unique identifier"`
+	Name      string    `json:"name" gorm:"size:100;not null" example:"Travel notes" description:"Name of the blog"`
+	Domain    string    `json:"domain" gorm:"size:255;not null;uniqueIndex" example:"travel.example.com" description:"Host the blog is served on, without a port"`
+	Default   bool      `json:"is_default" gorm:"column:is_default;not null;default:false" example:"false" description:"Whether the site serves hosts no other site is configured for"`
+	CreatedAt time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the site was added"`
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the site was last changed"`
+}
+
+// CreateSiteRequest represents the request body for adding a site
+// @Description Request model for adding a site
+type CreateSiteRequest struct {
+	Name    string `json:"name" binding:"required,max=100" example:"Travel notes" description:"Name of the blog"`
+	Domain  string `json:"domain" binding:"required,max=255" example:"travel.example.com" description:"Host the blog is served on"`
+	Default bool   `json:"is_default" example:"false" description:"Make the site the one serving unknown hosts"`
+}
+
+// UpdateSiteRequest represents the request body for changing a site
+// @Description Request model for changing a site; omitted fields are kept
+type UpdateSiteRequest struct {
+	Name    *string `json:"name" binding:"omitempty,max=100" example:"Travel notes" description:"New name"`
+	Domain  *string `json:"domain" binding:"omitempty,max=255" example:"travel.example.com" description:"New host"`
+	Default *bool   `json:"is_default" example:"true" description:"Make the site the one serving unknown hosts; the default can only be changed by making another site the default"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// SiteSetting is a runtime-tunable value that admins can change without a redeploy.
+// Settings that have never been set fall back to the defaults in the settings service.
+// @Description A site setting
+type SiteSetting struct {
+	Key       string    `json:"key" gorm:"primaryKey;size:100" example:"feed.recency_weight" description:"Setting key"`
+	Value     string    `json:"value" gorm:"type:text;not null" example:"1.0" description:"Setting value"`
+	UpdatedAt time.Time `json:"updated_at" example:"2023-01-01T12:00:00Z" description:"When the setting was last changed (zero if it still has its default value)"`
+}
+
+// UpdateSiteSettingRequest represents the request body for changing a site setting
+// @Description Request model for changing a site setting
+type UpdateSiteSettingRequest struct {
+	Value string `json:"value" binding:"required" example:"0.75" description:"New value"`
+}
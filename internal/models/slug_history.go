@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Resource types with slug history
+const (
+	SlugResourcePost = "post"
+	SlugResourceNews = "news"
+)
+
+// SlugHistory records a slug a post or news article had before its title
+// changed, so links using it can be redirected to the current slug
+type SlugHistory struct {
+	ID           uint      `gorm:"primaryKey"`
+	ResourceType string    `gorm:"size:20;not null;uniqueIndex:idx_slug_history_slug"`
+	Slug         string    `gorm:"size:255;not null;uniqueIndex:idx_slug_history_slug"`
+	ResourceID   uint      `gorm:"not null;index"`
+	CreatedAt    time.Time // When the resource stopped using the slug
+}
+
+// TableName keeps the table name singular, as the history of each slug
+func (SlugHistory) TableName() string {
+	return "slug_history"
+}
+
+// SlugRedirect is the body of a 301 response for a slug that was renamed
+// @Description Points at the current slug of a post or news article requested by an old slug
+type SlugRedirect struct {
+	Slug     string `json:"slug" example:"my-renamed-post" description:"Current slug"`
+	Location string `json:"location" example:"/api/posts/slug/my-renamed-post" description:"URL of the resource under its current slug, also sent in the Location header"`
+}
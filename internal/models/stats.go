@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// PublicStats holds non-sensitive site counters that are safe to show publicly
+// @Description Public site statistics for widgets such as the blog footer
+type PublicStats struct {
+	TotalPosts    int64      `json:"total_posts" example:"42" description:"Number of published posts"`
+	TotalComments int64      `json:"total_comments" example:"310" description:"Number of comments on published posts"`
+	TotalViews    int64      `json:"total_views" example:"15230" description:"Total views across published posts"`
+	YearsBlogging int        `json:"years_blogging" example:"3" description:"Full years since the first published post"`
+	BloggingSince *time.Time `json:"blogging_since,omitempty" example:"2021-05-01T12:00:00Z" description:"When the first post was published"`
+	GeneratedAt   time.Time  `json:"generated_at" example:"2023-01-01T12:00:00Z" description:"When these stats were computed"`
+}
+
+// StatsPoint is one period of a time series
+// @Description Count for one day or month
+type StatsPoint struct {
+	Period string `json:"period" example:"2023-01" description:"Day (YYYY-MM-DD) or month (YYYY-MM), in UTC"`
+	Count  int64  `json:"count" example:"4" description:"Count for the period"`
+}
+
+// IngestionStatsPoint is one day of news ingestion counters
+// @Description Articles fetched and saved by news ingestion on one day
+type IngestionStatsPoint struct {
+	Period  string `json:"period" example:"2023-01-01" description:"Day (YYYY-MM-DD), in UTC"`
+	Fetched int64  `json:"fetched" example:"120" description:"Articles returned by the sources"`
+	Saved   int64  `json:"saved" example:"18" description:"Articles stored"`
+}
+
+// AdminStatsTotals holds the overall counters of the admin dashboard
+// @Description Overall counters for the admin dashboard
+type AdminStatsTotals struct {
+	Posts            int64            `json:"posts" example:"42" description:"Number of posts"`
+	PostsByStatus    map[string]int64 `json:"posts_by_status" description:"Number of posts per status"`
+	Comments         int64            `json:"comments" example:"310" description:"Number of comments"`
+	CommentsByStatus map[string]int64 `json:"comments_by_status" description:"Number of comments per moderation status"`
+	Users            int64            `json:"users" example:"25" description:"Number of users"`
+	News             int64            `json:"news" example:"1500" description:"Number of news articles"`
+	Tags             int64            `json:"tags" example:"60" description:"Number of tags"`
+	PostViews        int64            `json:"post_views" example:"15230" description:"Total views across posts"`
+}
+
+// AdminStats powers the admin dashboard
+// @Description Aggregate counts and time series for the admin dashboard
+type AdminStats struct {
+	Totals         AdminStatsTotals      `json:"totals" description:"Overall counters"`
+	PostsPerMonth  []StatsPoint          `json:"posts_per_month" description:"Posts created per month over the last 12 months"`
+	CommentsPerDay []StatsPoint          `json:"comments_per_day" description:"Comments created per day over the last 30 days"`
+	NewUsersPerDay []StatsPoint          `json:"new_users_per_day" description:"Users registered per day over the last 30 days"`
+	NewsPerDay     []IngestionStatsPoint `json:"news_per_day" description:"Articles fetched and saved by ingestion per day over the last 30 days"`
+	TopTags        []TagWithCount        `json:"top_tags" description:"The 10 tags used by the most posts"`
+	GeneratedAt    time.Time             `json:"generated_at" example:"2023-01-01T12:00:00Z" description:"When these stats were computed"`
+}
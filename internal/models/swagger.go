@@ -16,7 +16,17 @@ type SwaggerStandardResponse struct {
 	Status  string      `json:"status" example:"success" description:"Response status (success or error)"`
 	Message string      `json:"message,omitempty" example:"Operation completed successfully" description:"Response message"`
 	Data    interface{} `json:"data,omitempty" description:"Response data payload"`
-	Error   string      `json:"error,omitempty" example:"Invalid input" description:"Error message (only present when status is error)"`
+}
+
+// ErrorResponse is the body of every error response
+// @Description An error response
+type ErrorResponse struct {
+	Status    string      `json:"status" example:"error" description:"Always error"`
+	Code      string      `json:"code" example:"post_not_found" description:"Machine-readable error code that clients can switch on"`
+	Error     string      `json:"error" example:"Post not found" description:"Error message in the request's language"`
+	Message   string      `json:"message" example:"Post not found" description:"Error message, or a more specific description such as a validation error"`
+	Details   interface{} `json:"details,omitempty" description:"Extra information about the error, e.g. the fields that failed validation"`
+	RequestID string      `json:"request_id,omitempty" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"ID of the request, also sent in the X-Request-ID header"`
 }
 
 // SwaggerPaginatedResponse represents a paginated API response
@@ -34,13 +44,69 @@ type SwaggerPaginatedResponse struct {
 // SwaggerPostsResponse represents the response for listing posts
 // @Description Response model for listing blog posts
 type SwaggerPostsResponse struct {
-	Posts []Post `json:"posts" description:"List of posts"`
-	Meta  struct {
-		Page     int `json:"page" example:"1" description:"Current page number"`
-		Limit    int `json:"limit" example:"10" description:"Number of items per page"`
-		Total    int `json:"total" example:"50" description:"Total number of items"`
-		LastPage int `json:"last_page" example:"5" description:"Last page number"`
-	} `json:"meta" description:"Pagination metadata"`
+	Posts []Post               `json:"posts" description:"List of posts"`
+	Meta  SwaggerPostsListMeta `json:"meta" description:"Pagination metadata"`
+}
+
+// SwaggerPostsListMeta represents the pagination metadata of the post list
+// @Description Pagination metadata for the post list, with a cursor for the next page
+type SwaggerPostsListMeta struct {
+	SwaggerPostsMeta
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyNS0wNi0wMVQxMjowMDowMFoiLCJpZCI6NDJ9" description:"Cursor for the next page, absent on the last page"`
+}
+
+// SwaggerPostsMeta represents the pagination metadata of a post listing
+// @Description Pagination metadata for blog post listings
+type SwaggerPostsMeta struct {
+	Page     int `json:"page" example:"1" description:"Current page number"`
+	Limit    int `json:"limit" example:"10" description:"Number of items per page"`
+	Total    int `json:"total" example:"50" description:"Total number of items"`
+	LastPage int `json:"lastPage" example:"5" description:"Last page number"`
+}
+
+// SwaggerBookmarksResponse represents the response for listing bookmarks
+// @Description Response model for the current user's bookmarked posts
+type SwaggerBookmarksResponse struct {
+	Bookmarks []Bookmark       `json:"bookmarks" description:"Bookmarked posts, most recently saved first"`
+	Meta      SwaggerPostsMeta `json:"meta" description:"Pagination metadata"`
+}
+
+// SwaggerReadingListResponse represents the response for the reading list
+// @Description Response model for the current user's bookmarked posts and news articles
+type SwaggerReadingListResponse struct {
+	Items []ReadingListItem `json:"items" description:"Saved posts and news articles, most recently saved first"`
+	Meta  SwaggerPostsMeta  `json:"meta" description:"Pagination metadata"`
+}
+
+// SwaggerReadingProgressResponse represents the response for listing reading progress
+// @Description Response model for the current user's reading progress
+type SwaggerReadingProgressResponse struct {
+	Progress []ReadingProgress `json:"progress" description:"Progress in posts, most recently read first"`
+	Meta     SwaggerPostsMeta  `json:"meta" description:"Pagination metadata"`
+}
+
+// SwaggerNotificationsResponse represents the response for listing notifications
+// @Description Response model for the current user's notifications
+type SwaggerNotificationsResponse struct {
+	Notifications []Notification           `json:"notifications" description:"Notifications, newest first"`
+	Meta          SwaggerNotificationsMeta `json:"meta" description:"Pagination metadata"`
+}
+
+// SwaggerNotificationsMeta represents the pagination metadata of the notification list
+// @Description Pagination metadata for notifications, with the unread count
+type SwaggerNotificationsMeta struct {
+	Page     int `json:"page" example:"1" description:"Current page number"`
+	Limit    int `json:"limit" example:"20" description:"Number of items per page"`
+	Total    int `json:"total" example:"42" description:"Total number of items"`
+	LastPage int `json:"lastPage" example:"3" description:"Last page number"`
+	Unread   int `json:"unread" example:"5" description:"Number of unread notifications"`
+}
+
+// SwaggerBrokenLinksResponse represents the response for listing broken links
+// @Description Response model for the broken outbound links in published content
+type SwaggerBrokenLinksResponse struct {
+	Links []BrokenLink     `json:"links" description:"Broken links, longest broken first"`
+	Meta  SwaggerPostsMeta `json:"meta" description:"Pagination metadata"`
 }
 
 // SwaggerProfileResponse represents the user profile response
@@ -57,24 +123,26 @@ type SwaggerProfileResponse struct {
 	CreatedAt    time.Time `json:"created_at" example:"2023-01-01T00:00:00Z" description:"Account creation timestamp"`
 }
 
-// SwaggerUpdateProfileRequest represents the request to update a user profile
-// @Description Request model for updating user profile
-type SwaggerUpdateProfileRequest struct {
-	FirstName string `json:"first_name,omitempty" example:"John" description:"First name"`
-	LastName  string `json:"last_name,omitempty" example:"Doe" description:"Last name"`
-	Bio       string `json:"bio,omitempty" example:"Software developer" description:"User biography"`
+// SwaggerRegisteredUser represents the account created by registering
+// @Description The newly registered user
+type SwaggerRegisteredUser struct {
+	ID       uint   `json:"id" example:"1" description:"User ID"`
+	Username string `json:"username" example:"johndoe" description:"Username"`
+	Email    string `json:"email" example:"john@example.com" description:"Email address"`
 }
 
 // SwaggerAvatarResponse represents the response after uploading an avatar
 // @Description Response model for avatar upload
 type SwaggerAvatarResponse struct {
-	ProfileImage string `json:"profile_image" example:"https://example.com/avatar.jpg" description:"URL to the uploaded avatar"`
+	ProfileImage string        `json:"profile_image" example:"https://example.com/avatar.jpg" description:"URL to the uploaded avatar"`
+	Variants     ImageVariants `json:"variants" description:"URLs of the avatar in several sizes"`
 }
 
 // SwaggerPostCoverResponse represents the response after uploading a post cover
 // @Description Response model for post cover upload
 type SwaggerPostCoverResponse struct {
-	Cover string `json:"cover" example:"https://example.com/cover.jpg" description:"URL to the uploaded cover image"`
+	Cover    string        `json:"cover" example:"https://example.com/cover.jpg" description:"URL to the uploaded cover image"`
+	Variants ImageVariants `json:"variants" description:"URLs of the cover in several sizes"`
 }
 
 // SwaggerFileUploadResponse represents the response after uploading a file for editor use
@@ -82,9 +150,3 @@ type SwaggerPostCoverResponse struct {
 type SwaggerFileUploadResponse struct {
 	FileURL string `json:"file_url" example:"https://example.com/file.jpg" description:"URL to the uploaded file"`
 }
-
-// SwaggerDeleteFileRequest represents a request to delete a file
-// @Description Request model for deleting a file
-type SwaggerDeleteFileRequest struct {
-	FileURL string `json:"file_url" example:"https://example.com/file.jpg" description:"URL of the file to delete"`
-}
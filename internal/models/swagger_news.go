@@ -6,6 +6,7 @@ import "time"
 // @Description Response format for fetching news from external API
 type SwaggerFetchNewsResponse struct {
 	Message    string         `json:"message" example:"News articles fetched successfully" description:"Status message"`
+	RunID      uint           `json:"run_id" example:"1" description:"ID of the recorded ingestion run"`
 	Total      int            `json:"total" example:"10" description:"Total number of news articles fetched"`
 	Saved      int            `json:"saved" example:"8" description:"Number of new articles saved"`
 	Categories []NewsCategory `json:"categories" example:"[\"technology\",\"business\"]" description:"Categories that were fetched"`
@@ -16,6 +17,7 @@ type SwaggerFetchNewsResponse struct {
 // @Description Response format for fetching news from RSS feeds
 type SwaggerFetchRSSNewsResponse struct {
 	Message    string         `json:"message" example:"RSS news articles fetched successfully" description:"Status message"`
+	RunID      uint           `json:"run_id" example:"1" description:"ID of the recorded ingestion run"`
 	Total      int            `json:"total" example:"15" description:"Total number of news articles fetched from RSS feeds"`
 	Saved      int            `json:"saved" example:"12" description:"Number of new articles saved"`
 	Categories []NewsCategory `json:"categories" example:"[\"technology\",\"science\"]" description:"Categories of the fetched articles"`
@@ -63,6 +65,7 @@ type SwaggerContentStatus struct {
 // @Description A news article without the content field for improved performance
 type SwaggerNewsWithoutContent struct {
 	ID          uint         `json:"id" example:"1" description:"Unique identifier"`
+	UUID        string       `json:"uuid" example:"3c2b1a0d-9e8f-4a7b-b6c5-d4e3f2a1b0c9" description:"Stable public identifier"`
 	Title       string       `json:"title" example:"Major Technology Breakthrough Announced" description:"News title"`
 	Slug        string       `json:"slug" example:"major-technology-breakthrough-announced" description:"URL-friendly version of the title"`
 	Summary     string       `json:"summary" example:"A brief summary of the quantum computing breakthrough" description:"Short summary of the news article"`
@@ -86,4 +89,5 @@ type SwaggerNewsWithoutContentResponse struct {
 	Page       int                         `json:"page" example:"1" description:"Current page number"`
 	PerPage    int                         `json:"per_page" example:"10" description:"Number of items per page"`
 	TotalPages int                         `json:"total_pages" example:"10" description:"Total number of pages"`
+	NextCursor string                      `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyNS0wNi0wMVQxMjowMDowMFoiLCJpZCI6NDJ9" description:"Cursor for the next page, absent on the last page"`
 }
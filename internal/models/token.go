@@ -9,19 +9,37 @@ import (
 // RefreshToken represents a refresh token in the database
 type RefreshToken struct {
 	gorm.Model
-	Token     string    `gorm:"size:255;not null;uniqueIndex"`
+	// Token is the refresh JWT, which grows with the kid header and role name
+	Token     string    `gorm:"type:text;not null;uniqueIndex"`
 	UserID    uint      `gorm:"not null;index"`
 	ExpiresAt time.Time `gorm:"not null;index"`
 	IssuedAt  time.Time `gorm:"not null"`
 	Revoked   bool      `gorm:"default:false"`
+	// UserAgent and IPAddress describe the device the token was issued to
+	UserAgent  string     `gorm:"size:500"`
+	IPAddress  string     `gorm:"size:45"`
+	LastUsedAt *time.Time // When the token was last used to get an access token
 	// User is used for creating "belongs to" relationship
 	User User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;"`
 }
 
+// Session is a signed-in device: a refresh token that is neither revoked nor
+// expired
+// @Description A device the user is signed in on
+type Session struct {
+	ID         uint       `json:"id" example:"12" description:"Unique identifier"`
+	UserAgent  string     `json:"user_agent" example:"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15" description:"User agent of the device at sign-in"`
+	IPAddress  string     `json:"ip_address" example:"203.0.113.7" description:"IP address of the device at sign-in"`
+	IssuedAt   time.Time  `json:"issued_at" example:"2023-01-01T12:00:00Z" description:"When the user signed in"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2023-01-02T08:30:00Z" description:"When the session last refreshed its access token"`
+	ExpiresAt  time.Time  `json:"expires_at" example:"2023-01-08T12:00:00Z" description:"When the session ends unless revoked first"`
+	Current    bool       `json:"current" example:"true" description:"Whether this is the session making the request"`
+}
+
 // BlacklistedToken represents a revoked JWT token
 type BlacklistedToken struct {
 	ID        uint           `json:"id" gorm:"primaryKey"`
-	Token     string         `json:"token" gorm:"size:500;not null;uniqueIndex"`
+	Token     string         `json:"token" gorm:"type:text;not null;uniqueIndex"`
 	ExpiresAt time.Time      `json:"expires_at"`
 	CreatedAt time.Time      `json:"created_at"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
@@ -55,6 +73,11 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
+// LogoutRequest represents the optional body of a logout request
+type LogoutRequest struct {
+	RevokeAll bool `json:"revoke_all" example:"true" description:"Also revoke every refresh token of the user, signing out all devices"`
+}
+
 // TokenRevokeRequest represents a request to revoke a refresh token
 type TokenRevokeRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
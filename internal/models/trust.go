@@ -0,0 +1,16 @@
+package models
+
+// TrustLevel describes how far an account is trusted to post without limits.
+// It is derived from the account's age and how much of its content was approved.
+type TrustLevel string
+
+const (
+	// TrustLevelNew accounts have small daily quotas and comments with links are held for moderation
+	TrustLevelNew TrustLevel = "new"
+	// TrustLevelBasic accounts have larger daily quotas and can post links
+	TrustLevelBasic TrustLevel = "basic"
+	// TrustLevelEstablished accounts have no quotas
+	TrustLevelEstablished TrustLevel = "established"
+	// TrustLevelStaff is used for admins, who are never limited
+	TrustLevelStaff TrustLevel = "staff"
+)
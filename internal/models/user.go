@@ -6,21 +6,67 @@ import (
 	"gorm.io/gorm"
 )
 
+// CommentEmails is how a user is emailed about comments on their posts and
+// replies to their comments
+type CommentEmails string
+
+const (
+	// CommentEmailsImmediate sends an email shortly after each comment
+	CommentEmailsImmediate CommentEmails = "immediate"
+	// CommentEmailsDaily sends at most one email a day listing the comments
+	CommentEmailsDaily CommentEmails = "daily"
+	// CommentEmailsOff sends no comment emails
+	CommentEmailsOff CommentEmails = "off"
+)
+
 // User represents a blog user
 // @Description A user account with profile information and relationships
 type User struct {
-	ID           uint           `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
-	Username     string         `json:"username" gorm:"size:50;not null;unique" example:"johndoe" description:"Unique username"`
-	Email        string         `json:"email" gorm:"size:100;not null;unique" example:"john@example.com" description:"Email address"`
-	Password     string         `json:"-" gorm:"size:100;not null"` // Password is not included in JSON responses
-	FirstName    string         `json:"first_name" gorm:"size:50" example:"John" description:"First name"`
-	LastName     string         `json:"last_name" gorm:"size:50" example:"Doe" description:"Last name"`
-	Bio          string         `json:"bio" gorm:"type:text" example:"I'm a software developer interested in web technologies." description:"User biography"`
-	Role         string         `json:"role" gorm:"size:20;default:'user'" example:"user" description:"User role (admin, editor, user)"`
-	ProfileImage string         `json:"profile_image" gorm:"size:255" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg" description:"URL to profile image"`
-	Posts        []Post         `json:"posts,omitempty" gorm:"foreignKey:UserID" description:"Posts created by this user"`
-	Comments     []Comment      `json:"comments,omitempty" gorm:"foreignKey:UserID" description:"Comments made by this user"`
-	CreatedAt    time.Time      `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the user account was created"`
-	UpdatedAt    time.Time      `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the user account was last updated"`
-	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"` // Hide from Swagger
+	ID                 uint           `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	Username           string         `json:"username" gorm:"size:50;not null;unique" example:"johndoe" description:"Unique username"`
+	Email              string         `json:"email" gorm:"size:100;not null;unique" example:"john@example.com" description:"Email address"`
+	Password           string         `json:"-" gorm:"size:100;not null"` // Password is not included in JSON responses
+	FirstName          string         `json:"first_name" gorm:"size:50" example:"John" description:"First name"`
+	LastName           string         `json:"last_name" gorm:"size:50" example:"Doe" description:"Last name"`
+	Bio                string         `json:"bio" gorm:"type:text" example:"I'm a software developer interested in web technologies." description:"User biography"`
+	Role               string         `json:"role" gorm:"size:20;default:'user'" example:"user" description:"User role: admin, editor, user or a custom role"`
+	ProfileImage       string         `json:"profile_image" gorm:"size:255" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg" description:"URL to profile image"`
+	AnalyticsOptOut    bool           `json:"analytics_opt_out" gorm:"not null;default:false" example:"false" description:"Whether the user opted out of individual-level analytics"`
+	CommentEmails      CommentEmails  `json:"comment_emails" gorm:"size:20;not null;default:'immediate'" example:"immediate" description:"How the user is emailed about comments on their posts and replies to their comments (immediate, daily, off)"`
+	CommentEmailsToken *string        `json:"-" gorm:"size:64;uniqueIndex"` // Authenticates the unsubscribe link in comment emails
+	Posts              []Post         `json:"posts,omitempty" gorm:"foreignKey:UserID" description:"Posts created by this user"`
+	Comments           []Comment      `json:"comments,omitempty" gorm:"foreignKey:UserID" description:"Comments made by this user"`
+	CreatedAt          time.Time      `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the user account was created"`
+	UpdatedAt          time.Time      `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the user account was last updated"`
+	DeletedAt          gorm.DeletedAt `json:"-" gorm:"index"` // Hide from Swagger
+}
+
+// PublicProfile is the part of a user's profile anyone may see, for author pages
+// @Description Public profile of an author
+type PublicProfile struct {
+	Username     string    `json:"username" example:"johndoe" description:"Unique username"`
+	FirstName    string    `json:"first_name" example:"John" description:"First name"`
+	LastName     string    `json:"last_name" example:"Doe" description:"Last name"`
+	Bio          string    `json:"bio" example:"I'm a software developer interested in web technologies." description:"User biography"`
+	ProfileImage string    `json:"profile_image" example:"https://res.cloudinary.com/demo/image/upload/v1234567890/avatars/user_1_1620000000.jpg" description:"URL to profile image"`
+	PostCount    int64     `json:"post_count" example:"12" description:"Number of published posts"`
+	JoinedAt     time.Time `json:"joined_at" example:"2023-01-01T12:00:00Z" description:"When the account was created"`
+}
+
+// UpdateUserRoleRequest represents the request body for changing a user's role
+// @Description Request model for changing a user's role
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,max=20" example:"editor" description:"New role: user, editor, admin or a custom role from GET /admin/roles"`
+}
+
+// UpdateProfileRequest represents the request body for updating the current
+// user's profile. Fields left out are kept.
+// @Description Request model for updating user profile
+type UpdateProfileRequest struct {
+	FirstName       *string `json:"first_name,omitempty" example:"John" description:"First name"`
+	LastName        *string `json:"last_name,omitempty" example:"Doe" description:"Last name"`
+	Bio             *string `json:"bio,omitempty" example:"Software developer" description:"User biography"`
+	ProfileImage    *string `json:"profile_image,omitempty" example:"https://example.com/avatar.jpg" description:"Profile image URL"`
+	AnalyticsOptOut *bool   `json:"analytics_opt_out,omitempty" example:"true" description:"Opt out of individual-level analytics"`
+	CommentEmails   *string `json:"comment_emails,omitempty" binding:"omitempty,oneof=immediate daily off" example:"daily" description:"How to be emailed about comments on your posts and replies to your comments: immediate, daily or off"`
 }
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// UserDeletionStrategy determines what happens to a deleted user's posts and comments
+type UserDeletionStrategy string
+
+const (
+	// UserDeletionAnonymize keeps the content but scrubs the author's profile
+	UserDeletionAnonymize UserDeletionStrategy = "anonymize"
+	// UserDeletionReassign moves the content to the shared ghost author
+	UserDeletionReassign UserDeletionStrategy = "reassign"
+	// UserDeletionDelete soft-deletes the user's posts and comments
+	UserDeletionDelete UserDeletionStrategy = "delete"
+)
+
+// GhostUsername is the username of the placeholder author that receives reassigned content
+const GhostUsername = "ghost"
+
+// UserProfileSnapshot holds the profile fields overwritten when a user is anonymized
+type UserProfileSnapshot struct {
+	Username     string `json:"username"`
+	Email        string `json:"email"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Bio          string `json:"bio"`
+	ProfileImage string `json:"profile_image"`
+}
+
+// UserDeletion records a soft-deleted user so that the deletion can be undone
+// @Description A user deletion that can be restored until its undo window ends
+type UserDeletion struct {
+	ID          uint                 `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	UserID      uint                 `json:"user_id" gorm:"not null;index" example:"42" description:"ID of the deleted user"`
+	Strategy    UserDeletionStrategy `json:"strategy" gorm:"type:varchar(20);not null" example:"reassign" description:"What happened to the user's content (anonymize, reassign, delete)"`
+	DeletedBy   uint                 `json:"deleted_by" example:"1" description:"ID of the admin who deleted the user, or of the user when they deleted their own account"`
+	GhostUserID *uint                `json:"ghost_user_id,omitempty" example:"7" description:"ID of the ghost author content was reassigned to"`
+	PostIDs     []uint               `json:"post_ids" gorm:"type:text;serializer:json" description:"Posts affected by the deletion"`
+	CommentIDs  []uint               `json:"comment_ids" gorm:"type:text;serializer:json" description:"Comments affected by the deletion"`
+	Snapshot    *UserProfileSnapshot `json:"-" gorm:"type:text;serializer:json"` // Cleared once the undo window has passed
+	UndoUntil   time.Time            `json:"undo_until" gorm:"not null" example:"2023-01-04T12:00:00Z" description:"Deadline for restoring the user"`
+	RestoredAt  *time.Time           `json:"restored_at,omitempty" example:"2023-01-02T12:00:00Z" description:"When the deletion was undone"`
+	PurgedAt    *time.Time           `json:"purged_at,omitempty" example:"2023-01-05T12:00:00Z" description:"When the user was permanently removed"`
+	CreatedAt   time.Time            `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the user was deleted"`
+}
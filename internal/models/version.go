@@ -0,0 +1,20 @@
+package models
+
+// VersionInfo describes the build of the API serving the request
+// @Description Build information of the running API instance
+type VersionInfo struct {
+	GitSHA    string `json:"git_sha" example:"3f2a9c1d8e4b" description:"Commit the binary was built from"`
+	BuildTime string `json:"build_time" example:"2025-05-09T10:00:00Z" description:"When the binary was built"`
+	GoVersion string `json:"go_version" example:"go1.24.2" description:"Go version the binary was built with"`
+	Canary    bool   `json:"canary" example:"false" description:"Whether this instance is a canary"`
+}
+
+// APIMeta describes what the API serving the request can do, so clients can
+// detect capabilities at runtime
+// @Description API version, generated client checksum and feature flags
+type APIMeta struct {
+	APIVersion     string          `json:"api_version" example:"1.0" description:"Version of the API contract; clients generated from another version may not match it"`
+	GitSHA         string          `json:"git_sha" example:"3f2a9c1d8e4b" description:"Commit the binary was built from"`
+	ClientChecksum string          `json:"client_checksum" example:"sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08" description:"Checksum of /api/client.ts; a client whose checksum differs should be regenerated"`
+	Features       map[string]bool `json:"features" description:"Optional features and whether this instance has them enabled"`
+}
@@ -0,0 +1,99 @@
+package models
+
+import "time"
+
+// Webhook event names
+const (
+	WebhookEventPostPublished       = "post.published"
+	WebhookEventPostUnpublished     = "post.unpublished"
+	WebhookEventPostUpdated         = "post.updated"
+	WebhookEventPostDeleted         = "post.deleted"
+	WebhookEventNewsCreated         = "news.created"
+	WebhookEventNewsUpdated         = "news.updated"
+	WebhookEventNewsDeleted         = "news.deleted"
+	WebhookEventCommentCreated      = "comment.created"
+	WebhookEventNewsSourceUnhealthy = "news_source.unhealthy"
+	WebhookEventNewsSourceRecovered = "news_source.recovered"
+	WebhookEventPing                = "ping"
+
+	// WebhookEventAll subscribes a webhook to every event
+	WebhookEventAll = "*"
+)
+
+// WebhookEvents lists the events a webhook can subscribe to
+var WebhookEvents = []string{
+	WebhookEventPostPublished,
+	WebhookEventPostUnpublished,
+	WebhookEventPostUpdated,
+	WebhookEventPostDeleted,
+	WebhookEventNewsCreated,
+	WebhookEventNewsUpdated,
+	WebhookEventNewsDeleted,
+	WebhookEventCommentCreated,
+	WebhookEventNewsSourceUnhealthy,
+	WebhookEventNewsSourceRecovered,
+}
+
+// Webhook is an external URL that is called when content events happen
+// @Description A registered webhook endpoint and the events it receives
+type Webhook struct {
+	ID          uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	URL         string    `json:"url" gorm:"size:500;not null" example:"https://example.com/api/revalidate" description:"Endpoint that receives event payloads"`
+	Description string    `json:"description" gorm:"size:255" example:"Next.js ISR revalidation" description:"What the webhook is used for"`
+	Events      []string  `json:"events" gorm:"type:text;serializer:json" example:"post.published,post.updated" description:"Subscribed events, or * for all"`
+	Secret      string    `json:"-" gorm:"size:255;not null;serializer:encrypted"` // Used to sign payloads, only returned on create
+	Active      bool      `json:"active" gorm:"default:true" example:"true" description:"Whether deliveries are sent"`
+	CreatedBy   uint      `json:"created_by" example:"1" description:"ID of the admin who registered the webhook"`
+	CreatedAt   time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the webhook was created"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2023-01-02T12:00:00Z" description:"When the webhook was last updated"`
+}
+
+// Subscribes reports whether the webhook should receive event
+func (w *Webhook) Subscribes(event string) bool {
+	for _, e := range w.Events {
+		if e == WebhookEventAll || e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookWithSecret is returned once when a webhook is created so the receiver can verify signatures
+// @Description A newly created webhook including its signing secret
+type WebhookWithSecret struct {
+	Webhook
+	Secret string `json:"secret" example:"whsec_3f9a..." description:"HMAC-SHA256 signing secret, only shown once"`
+}
+
+// WebhookDelivery logs a single attempt to deliver an event to a webhook
+// @Description A webhook delivery attempt and its outcome
+type WebhookDelivery struct {
+	ID         uint      `json:"id" gorm:"primaryKey" example:"1" description:"Unique identifier"`
+	WebhookID  uint      `json:"webhook_id" gorm:"not null;index" example:"1" description:"ID of the webhook"`
+	DeliveryID string    `json:"delivery_id" gorm:"size:36;not null;index" example:"5f0c6a3e-2b8d-4c1e-9f7a-3d2e1b0a9c8d" description:"Identifier shared by all attempts of one delivery"`
+	Event      string    `json:"event" gorm:"size:50;not null" example:"post.published" description:"Event that was delivered"`
+	Attempt    int       `json:"attempt" example:"1" description:"Attempt number, starting at 1"`
+	StatusCode int       `json:"status_code" example:"200" description:"HTTP status returned by the receiver, 0 if the request failed"`
+	Success    bool      `json:"success" example:"true" description:"Whether the receiver returned a 2xx status"`
+	Error      string    `json:"error,omitempty" gorm:"type:text" example:"" description:"Error message if the attempt failed"`
+	DurationMs int64     `json:"duration_ms" example:"142" description:"How long the request took"`
+	CreatedAt  time.Time `json:"created_at" example:"2023-01-01T12:00:00Z" description:"When the attempt was made"`
+}
+
+// CreateWebhookRequest represents the request body for registering a webhook
+// @Description Request model for registering a webhook
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,url" example:"https://example.com/api/revalidate" description:"Endpoint that receives event payloads"`
+	Description string   `json:"description" example:"Next.js ISR revalidation" description:"What the webhook is used for"`
+	Events      []string `json:"events" binding:"required,min=1" example:"post.published,post.updated" description:"Events to subscribe to, or * for all"`
+	Active      *bool    `json:"active" example:"true" description:"Whether deliveries are sent (default: true)"`
+}
+
+// UpdateWebhookRequest represents the request body for updating a webhook
+// @Description Request model for updating a webhook
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url" binding:"omitempty,url" example:"https://example.com/api/revalidate" description:"New endpoint URL"`
+	Description *string  `json:"description" example:"Next.js ISR revalidation" description:"New description"`
+	Events      []string `json:"events" example:"post.published" description:"New list of subscribed events"`
+	Active      *bool    `json:"active" example:"false" description:"Enable or disable deliveries"`
+}
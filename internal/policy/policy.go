@@ -0,0 +1,215 @@
+// Package policy decides what a user may do. Handlers describe who is asking
+// and what they want to act on, and Can answers from one set of rules instead
+// of each handler comparing role names itself.
+//
+// A user may perform an action when their role grants it, which covers every
+// resource, or when a rule for the action allows it through their relation
+// to the resource, such as owning it. Role permissions are managed by admins
+// and loaded with SetRoles; the built-in roles fall back to
+// DefaultRoleGrants.
+package policy
+
+import (
+	"sync"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// Role names
+const (
+	RoleUser   = "user"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// Action is something a user may or may not be allowed to do
+type Action string
+
+const (
+	// ActionAdminAccess uses the /admin endpoints
+	ActionAdminAccess Action = "admin.access"
+
+	// ActionPostCreate writes new posts
+	ActionPostCreate Action = "post.create"
+	// ActionPostEdit changes a post's content
+	ActionPostEdit Action = "post.edit"
+	// ActionPostPublish publishes a post, or archives or schedules it
+	ActionPostPublish Action = "post.publish"
+	// ActionPostUnpublish takes a post back to draft
+	ActionPostUnpublish Action = "post.unpublish"
+	// ActionPostManage changes a post's cover, preview links and co-authors
+	ActionPostManage Action = "post.manage"
+	// ActionPostDelete deletes a post
+	ActionPostDelete Action = "post.delete"
+	// ActionPostAnalytics reads a post's reader analytics
+	ActionPostAnalytics Action = "post.analytics"
+
+	// ActionCommentEdit changes a comment's content
+	ActionCommentEdit Action = "comment.edit"
+	// ActionCommentDelete deletes a comment
+	ActionCommentDelete Action = "comment.delete"
+	// ActionCommentSkipModeration publishes comments and edits without the
+	// spam checks holding them
+	ActionCommentSkipModeration Action = "comment.skip_moderation"
+
+	// ActionSeriesManage changes or deletes a series
+	ActionSeriesManage Action = "series.manage"
+
+	// ActionPageEdit creates, changes and deletes static pages and reads their
+	// drafts
+	ActionPageEdit Action = "page.edit"
+
+	// ActionTemplateEdit creates, changes and deletes post templates
+	ActionTemplateEdit Action = "template.edit"
+)
+
+// Subject is the user asking to perform an action
+type Subject struct {
+	ID   uint
+	Role string
+}
+
+// Resource describes what an action is performed on, as far as the rules
+// need to know. The zero value is a resource nobody owns.
+type Resource struct {
+	// OwnerID is the user the resource belongs to
+	OwnerID uint
+	// AuthorRole is the subject's role on a post: author for its owner and
+	// the co-author role for co-authors, empty for anyone else
+	AuthorRole models.PostAuthorRole
+	// ParentOwnerID is the owner of the resource this one belongs to, such as
+	// the post a comment was made on
+	ParentOwnerID uint
+}
+
+// Rule allows an action through the subject's relation to the resource
+type Rule func(subject Subject, resource Resource) bool
+
+// Permission is an action with a description admins can pick from when
+// building roles
+type Permission struct {
+	Action      Action `json:"action" example:"post.create" description:"Permission name"`
+	Description string `json:"description" example:"Write new posts" description:"What the permission allows"`
+}
+
+// Permissions lists every action a role can grant
+var Permissions = []Permission{
+	{ActionAdminAccess, "Use the admin endpoints"},
+	{ActionPostCreate, "Write new posts"},
+	{ActionPostEdit, "Edit the content of any post"},
+	{ActionPostPublish, "Publish, archive or schedule any post"},
+	{ActionPostUnpublish, "Take any post back to draft"},
+	{ActionPostManage, "Change the cover, preview links and co-authors of any post"},
+	{ActionPostDelete, "Delete any post"},
+	{ActionPostAnalytics, "Read the reader analytics of any post"},
+	{ActionCommentEdit, "Edit any comment"},
+	{ActionCommentDelete, "Delete any comment"},
+	{ActionCommentSkipModeration, "Publish comments and edits without spam checks holding them"},
+	{ActionSeriesManage, "Change or delete any series"},
+	{ActionPageEdit, "Create, change and delete static pages"},
+	{ActionTemplateEdit, "Create, change and delete post templates"},
+}
+
+// IsPermission reports whether name is an action roles can grant
+func IsPermission(name string) bool {
+	for _, permission := range Permissions {
+		if string(permission.Action) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultRoleGrants are the permissions of the built-in roles, used until
+// roles are loaded from the database and for roles missing from it. The
+// admin role always has its defaults, so admins can't lock themselves out.
+var DefaultRoleGrants = map[string][]Action{
+	RoleAdmin: {
+		ActionAdminAccess,
+		ActionPostCreate, ActionPostUnpublish, ActionPostDelete, ActionPostAnalytics,
+		ActionCommentEdit, ActionCommentDelete, ActionCommentSkipModeration,
+		ActionSeriesManage,
+		ActionPageEdit, ActionTemplateEdit,
+	},
+	RoleEditor: {ActionPostCreate, ActionPageEdit, ActionTemplateEdit},
+	RoleUser:   {},
+}
+
+// roleGrants holds the permissions of each role
+var roleGrants struct {
+	sync.RWMutex
+	byRole map[string]map[Action]bool
+}
+
+// SetRoles replaces the permissions of every role but admin. Built-in roles
+// missing from roles keep their defaults.
+func SetRoles(roles map[string][]Action) {
+	byRole := make(map[string]map[Action]bool, len(roles)+len(DefaultRoleGrants))
+	for role, actions := range DefaultRoleGrants {
+		byRole[role] = actionSet(actions)
+	}
+	for role, actions := range roles {
+		if role != RoleAdmin {
+			byRole[role] = actionSet(actions)
+		}
+	}
+
+	roleGrants.Lock()
+	roleGrants.byRole = byRole
+	roleGrants.Unlock()
+}
+
+// actionSet indexes actions for lookups
+func actionSet(actions []Action) map[Action]bool {
+	set := make(map[Action]bool, len(actions))
+	for _, action := range actions {
+		set[action] = true
+	}
+	return set
+}
+
+func init() {
+	SetRoles(nil)
+}
+
+// rules lists how users may perform actions on resources their role doesn't
+// grant them
+var rules = map[Action]Rule{
+	ActionPostEdit:      func(_ Subject, r Resource) bool { return r.AuthorRole.CanEdit() },
+	ActionPostPublish:   func(_ Subject, r Resource) bool { return r.AuthorRole.CanPublish() },
+	ActionPostUnpublish: func(_ Subject, r Resource) bool { return r.AuthorRole.CanPublish() },
+	ActionPostManage:    owns,
+	ActionPostDelete:    owns,
+	// Every author of a post may follow how it is read
+	ActionPostAnalytics: func(_ Subject, r Resource) bool { return r.AuthorRole != "" },
+
+	ActionCommentEdit: owns,
+	// Post owners may remove comments left on their posts
+	ActionCommentDelete: func(s Subject, r Resource) bool { return owns(s, r) || (s.ID != 0 && r.ParentOwnerID == s.ID) },
+
+	ActionSeriesManage: owns,
+}
+
+// Can reports whether subject may perform action on resource
+func Can(subject Subject, action Action, resource Resource) bool {
+	if Grants(subject.Role, action) {
+		return true
+	}
+	if rule, ok := rules[action]; ok {
+		return rule(subject, resource)
+	}
+	return false
+}
+
+// Grants reports whether role may perform action on any resource. Unknown
+// roles grant nothing.
+func Grants(role string, action Action) bool {
+	roleGrants.RLock()
+	defer roleGrants.RUnlock()
+	return roleGrants.byRole[role][action]
+}
+
+// owns is the rule for actions owners may perform on their own resources
+func owns(subject Subject, resource Resource) bool {
+	return subject.ID != 0 && resource.OwnerID == subject.ID
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// CommentRepository loads and stores comments on posts
+type CommentRepository interface {
+	// WithContext returns a copy of the repository whose queries run under ctx
+	WithContext(ctx context.Context) CommentRepository
+	// Find returns the comment matching scope
+	Find(scope Scope) (*models.Comment, error)
+	// ListApproved returns the approved comments on a post in sort order
+	// (models.CommentSortNewest or models.CommentSortTop), with their authors,
+	// mentioned users and reaction counts
+	ListApproved(postID uint, sort string) ([]models.Comment, error)
+	// PageApproved returns one page of the approved comments on a post in sort
+	// order, with their authors, mentioned users and reaction counts and the
+	// number of approved comments
+	PageApproved(postID uint, sort string, limit, offset int) ([]models.Comment, int64, error)
+	// Reload reloads comment with its author, mentioned users and reaction
+	// counts
+	Reload(comment *models.Comment) error
+	Create(comment *models.Comment) error
+	Save(comment *models.Comment) error
+	Delete(comment *models.Comment) error
+}
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentRepository creates a CommentRepository backed by db
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+func (r *commentRepository) WithContext(ctx context.Context) CommentRepository {
+	return &commentRepository{db: r.db.WithContext(ctx)}
+}
+
+func (r *commentRepository) Find(scope Scope) (*models.Comment, error) {
+	var comment models.Comment
+	if err := r.db.Scopes(scope).First(&comment).Error; err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *commentRepository) ListApproved(postID uint, sort string) ([]models.Comment, error) {
+	var comments []models.Comment
+	if err := r.db.Scopes(withAuthor, withMentions, commentOrder(sort)).
+		Where("post_id = ? AND status = ?", postID, models.CommentStatusApproved).
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	if err := r.countReactions(comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *commentRepository) PageApproved(postID uint, sort string, limit, offset int) ([]models.Comment, int64, error) {
+	query := r.db.Model(&models.Comment{}).Where("post_id = ? AND status = ?", postID, models.CommentStatusApproved)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var comments []models.Comment
+	if err := query.Scopes(withAuthor, withMentions, commentOrder(sort)).
+		Limit(limit).Offset(offset).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := r.countReactions(comments); err != nil {
+		return nil, 0, err
+	}
+	return comments, total, nil
+}
+
+func (r *commentRepository) Reload(comment *models.Comment) error {
+	if err := r.db.Scopes(withAuthor, withMentions).First(comment, comment.ID).Error; err != nil {
+		return err
+	}
+	comment.Reactions = models.CommentReactionCounts{}
+	comments := []models.Comment{*comment}
+	if err := r.countReactions(comments); err != nil {
+		return err
+	}
+	comment.Reactions = comments[0].Reactions
+	return nil
+}
+
+func (r *commentRepository) Create(comment *models.Comment) error {
+	return r.db.Create(comment).Error
+}
+
+func (r *commentRepository) Save(comment *models.Comment) error {
+	return r.db.Save(comment).Error
+}
+
+func (r *commentRepository) Delete(comment *models.Comment) error {
+	return r.db.Delete(comment).Error
+}
+
+// commentOrder orders comments newest first, or by their number of reactions
+// for models.CommentSortTop
+func commentOrder(sort string) Scope {
+	return func(db *gorm.DB) *gorm.DB {
+		if sort == models.CommentSortTop {
+			db = db.Order("(SELECT COUNT(*) FROM comment_reactions WHERE comment_reactions.comment_id = comments.id) DESC")
+		}
+		return db.Order("created_at DESC, id DESC")
+	}
+}
+
+// countReactions fills in the reaction counts of comments
+func (r *commentRepository) countReactions(comments []models.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+
+	var rows []struct {
+		CommentID uint
+		Type      models.CommentReactionType
+		Count     int64
+	}
+	if err := r.db.Model(&models.CommentReaction{}).
+		Select("comment_id, type, COUNT(*) AS count").
+		Where("comment_id IN ?", ids).
+		Group("comment_id, type").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	index := make(map[uint]int, len(comments))
+	for i, comment := range comments {
+		index[comment.ID] = i
+	}
+	for _, row := range rows {
+		comments[index[row.CommentID]].Reactions.Add(row.Type, row.Count)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// NewsRepository loads and stores news articles
+type NewsRepository interface {
+	// WithContext returns a copy of the repository whose queries run under ctx
+	WithContext(ctx context.Context) NewsRepository
+	// Find returns the article matching scope
+	Find(scope Scope) (*models.News, error)
+	// FindWithTags returns the article matching scope with its tags
+	FindWithTags(scope Scope) (*models.News, error)
+	// FindPublished returns the published article matching scope with its tags
+	FindPublished(scope Scope) (*models.News, error)
+	// SlugExists reports whether an article other than exceptID uses slug,
+	// counting deleted articles since they keep their slug
+	SlugExists(slug string, exceptID uint) (bool, error)
+	// Reload reloads news with its tags
+	Reload(news *models.News) error
+	Save(news *models.News) error
+}
+
+type newsRepository struct {
+	db *gorm.DB
+}
+
+// NewNewsRepository creates a NewsRepository backed by db
+func NewNewsRepository(db *gorm.DB) NewsRepository {
+	return &newsRepository{db: db}
+}
+
+func (r *newsRepository) WithContext(ctx context.Context) NewsRepository {
+	return &newsRepository{db: r.db.WithContext(ctx)}
+}
+
+func (r *newsRepository) Find(scope Scope) (*models.News, error) {
+	var news models.News
+	if err := r.db.Scopes(scope).First(&news).Error; err != nil {
+		return nil, err
+	}
+	return &news, nil
+}
+
+func (r *newsRepository) FindWithTags(scope Scope) (*models.News, error) {
+	var news models.News
+	if err := r.db.Scopes(scope).Preload("Tags").First(&news).Error; err != nil {
+		return nil, err
+	}
+	return &news, nil
+}
+
+func (r *newsRepository) FindPublished(scope Scope) (*models.News, error) {
+	return r.FindWithTags(func(db *gorm.DB) *gorm.DB {
+		return scope(db).Where("status = ? AND published = ?", models.NewsStatusPublished, true)
+	})
+}
+
+func (r *newsRepository) SlugExists(slug string, exceptID uint) (bool, error) {
+	var count int64
+	query := r.db.Unscoped().Model(&models.News{}).Where("slug = ?", slug)
+	if exceptID != 0 {
+		query = query.Where("id != ?", exceptID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *newsRepository) Reload(news *models.News) error {
+	return r.db.Preload("Tags").First(news, news.ID).Error
+}
+
+func (r *newsRepository) Save(news *models.News) error {
+	return r.db.Save(news).Error
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// PostRepository loads and stores blog posts
+type PostRepository interface {
+	// WithContext returns a copy of the repository whose queries run under ctx
+	WithContext(ctx context.Context) PostRepository
+	// Find returns the post matching scope
+	Find(scope Scope) (*models.Post, error)
+	// FindBySlug returns the post with slug, with its authors, tags and category
+	FindBySlug(slug string) (*models.Post, error)
+	// SlugExists reports whether a post other than exceptID uses slug,
+	// counting deleted posts since they keep their slug
+	SlugExists(slug string, exceptID uint) (bool, error)
+	// Reload reloads post with its authors, tags and category
+	Reload(post *models.Post) error
+	// IncrementViewCount counts a view without touching updated_at
+	IncrementViewCount(post *models.Post) error
+	Save(post *models.Post) error
+	Delete(post *models.Post) error
+}
+
+type postRepository struct {
+	db *gorm.DB
+}
+
+// NewPostRepository creates a PostRepository backed by db
+func NewPostRepository(db *gorm.DB) PostRepository {
+	return &postRepository{db: db}
+}
+
+func (r *postRepository) WithContext(ctx context.Context) PostRepository {
+	return &postRepository{db: r.db.WithContext(ctx)}
+}
+
+func (r *postRepository) Find(scope Scope) (*models.Post, error) {
+	var post models.Post
+	if err := r.db.Scopes(scope).First(&post).Error; err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+func (r *postRepository) FindBySlug(slug string) (*models.Post, error) {
+	var post models.Post
+	if err := r.db.Scopes(withAuthor, withCoAuthors).Preload("Tags").Preload("Category").
+		Where("slug = ?", slug).First(&post).Error; err != nil {
+		return nil, err
+	}
+	return &post, nil
+}
+
+func (r *postRepository) SlugExists(slug string, exceptID uint) (bool, error) {
+	var count int64
+	query := r.db.Unscoped().Model(&models.Post{}).Where("slug = ?", slug)
+	if exceptID != 0 {
+		query = query.Where("id != ?", exceptID)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *postRepository) Reload(post *models.Post) error {
+	return r.db.Scopes(withAuthor, withCoAuthors).Preload("Tags").Preload("Category").First(post, post.ID).Error
+}
+
+func (r *postRepository) IncrementViewCount(post *models.Post) error {
+	if err := r.db.Model(&models.Post{}).Where("id = ?", post.ID).
+		UpdateColumn("view_count", gorm.Expr("view_count + 1")).Error; err != nil {
+		return err
+	}
+	post.ViewCount++
+	return nil
+}
+
+func (r *postRepository) Save(post *models.Post) error {
+	return r.db.Save(post).Error
+}
+
+func (r *postRepository) Delete(post *models.Post) error {
+	return r.db.Delete(post).Error
+}
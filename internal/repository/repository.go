@@ -0,0 +1,67 @@
+// Package repository holds the queries handlers make for posts, users,
+// comments and news behind interfaces, so handlers can be built with fakes
+// instead of a database.
+package repository
+
+import "gorm.io/gorm"
+
+// Scope narrows a query, such as to one record by ID or UUID
+type Scope = func(db *gorm.DB) *gorm.DB
+
+// ByID scopes a query to the record with id
+func ByID(id uint) Scope {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("id = ?", id)
+	}
+}
+
+// BySlug scopes a query to the record with slug
+func BySlug(slug string) Scope {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("slug = ?", slug)
+	}
+}
+
+// authorColumns are the user columns loaded with posts and comments
+const authorColumns = "id, username, first_name, last_name, profile_image"
+
+// withAuthor preloads the public columns of the user a record belongs to
+func withAuthor(db *gorm.DB) *gorm.DB {
+	return db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select(authorColumns)
+	})
+}
+
+// withMentions preloads the users a comment mentions with their public columns
+func withMentions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Mentions.User", func(db *gorm.DB) *gorm.DB {
+		return db.Select(authorColumns)
+	})
+}
+
+// withCoAuthors preloads the co-authors of a post with their public columns
+func withCoAuthors(db *gorm.DB) *gorm.DB {
+	return db.Preload("Authors", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at")
+	}).Preload("Authors.User", func(db *gorm.DB) *gorm.DB {
+		return db.Select(authorColumns)
+	})
+}
+
+// Repositories groups the repositories for the core resources
+type Repositories struct {
+	Posts    PostRepository
+	Users    UserRepository
+	Comments CommentRepository
+	News     NewsRepository
+}
+
+// New creates the gorm-backed repositories for db
+func New(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Posts:    NewPostRepository(db),
+		Users:    NewUserRepository(db),
+		Comments: NewCommentRepository(db),
+		News:     NewNewsRepository(db),
+	}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// UserRepository loads and stores user accounts
+type UserRepository interface {
+	// WithContext returns a copy of the repository whose queries run under ctx
+	WithContext(ctx context.Context) UserRepository
+	FindByID(id uint) (*models.User, error)
+	FindByEmail(email string) (*models.User, error)
+	FindByUsername(username string) (*models.User, error)
+	// Exists reports whether an account uses email or username
+	Exists(email, username string) (bool, error)
+	Create(user *models.User) error
+	Save(user *models.User) error
+}
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository creates a UserRepository backed by db
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+func (r *userRepository) WithContext(ctx context.Context) UserRepository {
+	return &userRepository{db: r.db.WithContext(ctx)}
+}
+
+func (r *userRepository) FindByID(id uint) (*models.User, error) {
+	var user models.User
+	if err := r.db.First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindByEmail(email string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindByUsername(username string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("username = ?", username).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) Exists(email, username string) (bool, error) {
+	var count int64
+	if err := r.db.Model(&models.User{}).Where("email = ?", email).Or("username = ?", username).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *userRepository) Create(user *models.User) error {
+	return r.db.Create(user).Error
+}
+
+func (r *userRepository) Save(user *models.User) error {
+	return r.db.Save(user).Error
+}
@@ -0,0 +1,198 @@
+// Package routes describes API endpoints as a table of Route values. A
+// Registrar turns the table into gin routes, attaching the authentication,
+// rate limiting, caching, conditional response and upload checks each route
+// declares. The
+// registered table is kept so the Swagger document and the capabilities
+// endpoint can describe the same routes that are served.
+package routes
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/phanvantai/taiphanvan_backend/internal/middleware"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
+)
+
+// Access is the permission a caller needs to use a route
+type Access string
+
+const (
+	// AccessPublic routes need no authentication
+	AccessPublic Access = "public"
+	// AccessUser routes need a valid access token
+	AccessUser Access = "user"
+	// AccessAdmin routes need the access token of an admin
+	AccessAdmin Access = "admin"
+	// AccessOptional routes authenticate the caller when credentials are sent
+	// and serve anonymous callers otherwise
+	AccessOptional Access = "optional"
+)
+
+// RateLimit names the rate limiters a route is counted against
+type RateLimit string
+
+const (
+	// RateLimitAPI applies the general API limit, and is the default
+	RateLimitAPI RateLimit = "api"
+	// RateLimitAuth applies the stricter limit for credential endpoints on top
+	// of the API limit
+	RateLimitAuth RateLimit = "auth"
+	// RateLimitNone exempts the route, for probes such as the health check
+	RateLimitNone RateLimit = "none"
+)
+
+// Version is the current API version. Its routes are served under BasePath;
+// LegacyBasePath serves the same routes for clients written before versioning
+// and is deprecated.
+const (
+	Version        = "1"
+	BasePath       = "/api/v" + Version
+	LegacyBasePath = "/api"
+)
+
+// Cache-Control values for Route.Cache
+const (
+	// CacheNoStore keeps responses out of every cache, for links that act as credentials
+	CacheNoStore = "private, no-store"
+)
+
+// Route is one API endpoint
+type Route struct {
+	Method  string
+	Path    string // Relative to BasePath, in gin syntax, e.g. /posts/:id
+	Handler gin.HandlerFunc
+	Access  Access
+	// RateLimit defaults to RateLimitAPI
+	RateLimit RateLimit
+	// Cache is the Cache-Control header sent with responses. Empty leaves it to
+	// the handler.
+	Cache string
+	// Conditional sends an ETag with responses and answers If-None-Match and
+	// If-Modified-Since with 304 Not Modified when the client's copy is current
+	Conditional bool
+	// SessionOnly rejects API keys on a user route, for endpoints that need the
+	// signed-in account holder. Admin routes always reject them.
+	SessionOnly bool
+	// Upload checks the content of the uploaded file before the handler runs
+	Upload *upload.Policy
+}
+
+// AcceptsAPIKey reports whether an API key can be used instead of a Bearer token
+func (r Route) AcceptsAPIKey() bool {
+	return (r.Access == AccessUser && !r.SessionOnly) || r.Access == AccessOptional
+}
+
+// FullPath returns the path including the versioned prefix
+func (r Route) FullPath() string {
+	return BasePath + r.Path
+}
+
+// Registrar adds routes to a router group and any aliases of it
+type Registrar struct {
+	group    *gin.RouterGroup
+	aliases  []*gin.RouterGroup
+	limiters map[RateLimit][]gin.HandlerFunc
+}
+
+var (
+	registeredMu sync.RWMutex
+	registered   []Route
+)
+
+// NewRegistrar creates a registrar for group. limiters maps each rate limit
+// policy to its middleware; RateLimitNone needs no entry.
+func NewRegistrar(group *gin.RouterGroup, limiters map[RateLimit][]gin.HandlerFunc) *Registrar {
+	return &Registrar{group: group, limiters: limiters}
+}
+
+// Alias also serves the routes registered afterwards under group, such as the
+// unversioned prefix kept for old clients. Aliased routes aren't listed again
+// by All.
+func (r *Registrar) Alias(group *gin.RouterGroup) {
+	r.aliases = append(r.aliases, group)
+}
+
+// Register adds the routes to the group. It panics on a route with an unknown
+// access level or rate limit, like gin does on conflicting paths, since both
+// are programming errors.
+func (r *Registrar) Register(table []Route) {
+	for i := range table {
+		route := &table[i]
+		if route.RateLimit == "" {
+			route.RateLimit = RateLimitAPI
+		}
+
+		var chain []gin.HandlerFunc
+		if route.RateLimit != RateLimitNone {
+			limiters, ok := r.limiters[route.RateLimit]
+			if !ok {
+				panic(fmt.Sprintf("routes: %s %s has unknown rate limit %q", route.Method, route.Path, route.RateLimit))
+			}
+			chain = append(chain, limiters...)
+		}
+
+		switch route.Access {
+		case AccessPublic:
+		case AccessUser:
+			chain = append(chain, middleware.AuthMiddleware())
+			if route.SessionOnly {
+				chain = append(chain, middleware.SessionOnlyMiddleware())
+			}
+		case AccessAdmin:
+			chain = append(chain, middleware.AuthMiddleware(), middleware.AdminMiddleware())
+		case AccessOptional:
+			chain = append(chain, middleware.OptionalAuthMiddleware())
+		default:
+			panic(fmt.Sprintf("routes: %s %s has unknown access %q", route.Method, route.Path, route.Access))
+		}
+
+		if route.Cache != "" {
+			chain = append(chain, cacheControl(route.Cache))
+		}
+		if route.Conditional {
+			chain = append(chain, middleware.ConditionalGet())
+		}
+		if route.Upload != nil {
+			chain = append(chain, middleware.UploadGuard(*route.Upload))
+		}
+
+		chain = append(chain, route.Handler)
+		r.group.Handle(route.Method, route.Path, chain...)
+		for _, alias := range r.aliases {
+			alias.Handle(route.Method, route.Path, chain...)
+		}
+	}
+
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+	registered = append(registered, table...)
+}
+
+// All returns the registered routes in registration order
+func All() []Route {
+	registeredMu.RLock()
+	defer registeredMu.RUnlock()
+	return append([]Route(nil), registered...)
+}
+
+// SwaggerPath converts a route path to Swagger syntax, e.g. /posts/{id}
+func SwaggerPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// cacheControl sets the Cache-Control header before the handler runs
+func cacheControl(value string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", value)
+		c.Next()
+	}
+}
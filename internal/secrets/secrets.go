@@ -0,0 +1,195 @@
+// Package secrets encrypts sensitive values before they are stored in the
+// database, such as webhook signing secrets and rotated JWT signing keys.
+// Values are sealed with AES-256-GCM under a key derived from ENCRYPTION_KEY.
+//
+// Model fields opt in with the encrypted GORM serializer:
+//
+//	Secret string `gorm:"serializer:encrypted"`
+//
+// Without a key values are stored as they are. Values stored before
+// encryption was turned on are read back unchanged and encrypted the next time
+// they are saved.
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// prefix marks encrypted values and the format they were sealed in
+const prefix = "enc:v1:"
+
+// keyInfo binds derived keys to their use, so the master key can safely be
+// shared with other derivations later
+const keyInfo = "taiphanvan database secrets v1"
+
+var (
+	// ErrNoKey is returned when reading an encrypted value without ENCRYPTION_KEY
+	ErrNoKey = errors.New("ENCRYPTION_KEY is not set")
+	// ErrMalformed is returned for encrypted values that can't be decrypted
+	ErrMalformed = errors.New("encrypted value is malformed or was sealed with another key")
+)
+
+// Box seals and opens values with one key
+type Box struct {
+	aead cipher.AEAD
+}
+
+// NewBox creates a box keyed from masterKey
+func NewBox(masterKey string) (*Box, error) {
+	key, err := hkdf.Key(sha256.New, []byte(masterKey), nil, keyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive encryption key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return &Box{aead: aead}, nil
+}
+
+// Seal encrypts plaintext. Empty values stay empty, so optional secrets don't
+// look set.
+func (b *Box) Seal(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value sealed by Seal. Values without the encrypted prefix
+// are returned as they are.
+func (b *Box) Open(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil || len(sealed) < b.aead.NonceSize() {
+		return "", ErrMalformed
+	}
+	nonce, ciphertext := sealed[:b.aead.NonceSize()], sealed[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrMalformed
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted reports whether value was sealed by a Box
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// box is the box the serializer uses, nil while no key is set
+var box struct {
+	sync.RWMutex
+	current *Box
+}
+
+// SetKey sets the master key values are stored with. An empty key turns
+// encryption off.
+func SetKey(masterKey string) error {
+	var b *Box
+	if masterKey != "" {
+		var err error
+		if b, err = NewBox(masterKey); err != nil {
+			return err
+		}
+	}
+
+	box.Lock()
+	box.current = b
+	box.Unlock()
+	return nil
+}
+
+// Enabled reports whether values are encrypted before they are stored
+func Enabled() bool {
+	return current() != nil
+}
+
+// current returns the box set by SetKey
+func current() *Box {
+	box.RLock()
+	defer box.RUnlock()
+	return box.current
+}
+
+// Seal encrypts plaintext with the key set by SetKey, or returns it as it is
+// when no key is set
+func Seal(plaintext string) (string, error) {
+	b := current()
+	if b == nil {
+		return plaintext, nil
+	}
+	return b.Seal(plaintext)
+}
+
+// Open decrypts a value stored by Seal
+func Open(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	b := current()
+	if b == nil {
+		return "", ErrNoKey
+	}
+	return b.Open(value)
+}
+
+// Serializer is the encrypted GORM serializer for string fields
+type Serializer struct{}
+
+// Scan decrypts the stored value into the field
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var stored string
+	switch v := dbValue.(type) {
+	case nil:
+	case string:
+		stored = v
+	case []byte:
+		stored = string(v)
+	default:
+		return fmt.Errorf("unsupported encrypted value type %T for %s", dbValue, field.Name)
+	}
+
+	plaintext, err := Open(stored)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", field.Name, err)
+	}
+	return field.Set(ctx, dst, plaintext)
+}
+
+// Value encrypts the field before it is stored
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	plaintext, ok := fieldValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("encrypted field %s must be a string", field.Name)
+	}
+	return Seal(plaintext)
+}
+
+func init() {
+	schema.RegisterSerializer("encrypted", Serializer{})
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// AccountService deletes, exports and purges user accounts
+type AccountService struct {
+	db *gorm.DB
+}
+
+// NewAccountService creates a new account service
+func NewAccountService(db *gorm.DB) *AccountService {
+	return &AccountService{db: db}
+}
+
+// SoftDelete deletes user, handling their posts and comments according to
+// strategy, and signs them out everywhere. The deletion can be undone until
+// undoWindow has passed; after that it can be purged.
+func (s *AccountService) SoftDelete(user *models.User, strategy models.UserDeletionStrategy, deletedBy uint, undoWindow time.Duration) (*models.UserDeletion, error) {
+	deletion := models.UserDeletion{
+		UserID:    user.ID,
+		Strategy:  strategy,
+		DeletedBy: deletedBy,
+		UndoUntil: time.Now().Add(undoWindow),
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Post{}).Where("user_id = ?", user.ID).Pluck("id", &deletion.PostIDs).Error; err != nil {
+			return fmt.Errorf("failed to collect posts: %w", err)
+		}
+		if err := tx.Model(&models.Comment{}).Where("user_id = ?", user.ID).Pluck("id", &deletion.CommentIDs).Error; err != nil {
+			return fmt.Errorf("failed to collect comments: %w", err)
+		}
+
+		switch strategy {
+		case models.UserDeletionAnonymize:
+			deletion.Snapshot = &models.UserProfileSnapshot{
+				Username:     user.Username,
+				Email:        user.Email,
+				FirstName:    user.FirstName,
+				LastName:     user.LastName,
+				Bio:          user.Bio,
+				ProfileImage: user.ProfileImage,
+			}
+			if err := tx.Model(user).Updates(map[string]interface{}{
+				"username":      fmt.Sprintf("deleted-user-%d", user.ID),
+				"email":         fmt.Sprintf("deleted-user-%d@users.invalid", user.ID),
+				"first_name":    "Deleted",
+				"last_name":     "User",
+				"bio":           "",
+				"profile_image": "",
+			}).Error; err != nil {
+				return fmt.Errorf("failed to anonymize user: %w", err)
+			}
+		case models.UserDeletionReassign:
+			ghost, err := database.GetOrCreateGhostUser(tx)
+			if err != nil {
+				return err
+			}
+			deletion.GhostUserID = &ghost.ID
+			if err := tx.Model(&models.Post{}).Where("user_id = ?", user.ID).Update("user_id", ghost.ID).Error; err != nil {
+				return fmt.Errorf("failed to reassign posts: %w", err)
+			}
+			if err := tx.Model(&models.Comment{}).Where("user_id = ?", user.ID).Update("user_id", ghost.ID).Error; err != nil {
+				return fmt.Errorf("failed to reassign comments: %w", err)
+			}
+		case models.UserDeletionDelete:
+			if err := tx.Where("user_id = ?", user.ID).Delete(&models.Post{}).Error; err != nil {
+				return fmt.Errorf("failed to delete posts: %w", err)
+			}
+			if err := tx.Where("user_id = ?", user.ID).Delete(&models.Comment{}).Error; err != nil {
+				return fmt.Errorf("failed to delete comments: %w", err)
+			}
+		}
+
+		// Sign the user out everywhere
+		if err := tx.Model(&models.RefreshToken{}).
+			Where("user_id = ? AND revoked = ?", user.ID, false).
+			Update("revoked", true).Error; err != nil {
+			return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+		}
+
+		if err := tx.Delete(user).Error; err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		return tx.Create(&deletion).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+// Export collects the user's profile, posts, comments, comment reactions,
+// bookmarks, series and reading progress
+func (s *AccountService) Export(userID uint) (*models.UserDataExport, error) {
+	export := models.UserDataExport{
+		ExportedAt:    time.Now().UTC().Truncate(time.Second),
+		Posts:         []models.Post{},
+		Comments:      []models.Comment{},
+		Reactions:     []models.CommentReaction{},
+		Bookmarks:     []models.Bookmark{},
+		NewsBookmarks: []models.NewsBookmark{},
+		Series:        []models.Series{},
+		Progress:      []models.ReadingProgress{},
+	}
+
+	if err := s.db.First(&export.Profile, userID).Error; err != nil {
+		return nil, fmt.Errorf("failed to load profile: %w", err)
+	}
+	if err := s.db.Preload("Tags").Preload("Category").Where("user_id = ?", userID).
+		Order("created_at ASC").Find(&export.Posts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load posts: %w", err)
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&export.Comments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load comments: %w", err)
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&export.Reactions).Error; err != nil {
+		return nil, fmt.Errorf("failed to load comment reactions: %w", err)
+	}
+	if err := s.db.Preload("Post").Where("user_id = ?", userID).Order("created_at ASC").Find(&export.Bookmarks).Error; err != nil {
+		return nil, fmt.Errorf("failed to load bookmarks: %w", err)
+	}
+	if err := s.db.Preload("News").Where("user_id = ?", userID).Order("created_at ASC").Find(&export.NewsBookmarks).Error; err != nil {
+		return nil, fmt.Errorf("failed to load news bookmarks: %w", err)
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&export.Series).Error; err != nil {
+		return nil, fmt.Errorf("failed to load series: %w", err)
+	}
+	if err := s.db.Where("user_id = ?", userID).Order("updated_at ASC").Find(&export.Progress).Error; err != nil {
+		return nil, fmt.Errorf("failed to load reading progress: %w", err)
+	}
+	return &export, nil
+}
+
+// WriteUserDataJSON writes export as one JSON document
+func WriteUserDataJSON(w io.Writer, export *models.UserDataExport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(export)
+}
+
+// WriteUserDataArchive writes export as a zip archive with one JSON file per
+// kind of data
+func WriteUserDataArchive(w io.Writer, export *models.UserDataExport) error {
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", export.Profile},
+		{"posts.json", export.Posts},
+		{"comments.json", export.Comments},
+		{"comment_reactions.json", export.Reactions},
+		{"bookmarks.json", export.Bookmarks},
+		{"news_bookmarks.json", export.NewsBookmarks},
+		{"series.json", export.Series},
+		{"reading_progress.json", export.Progress},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		entry, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: export.ExportedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s to archive: %w", file.name, err)
+		}
+		encoder := json.NewEncoder(entry)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return fmt.Errorf("failed to write %s to archive: %w", file.name, err)
+		}
+	}
+	return archive.Close()
+}
+
+// PurgeExpired permanently removes the users whose deletion can no longer be
+// undone. Their posts, comments and series move to the ghost author, their
+// bookmarks, notifications, API keys, saved views, sessions and newsletter
+// subscription are deleted, and the account itself is removed. It returns the
+// IDs of the purged users.
+func (s *AccountService) PurgeExpired() ([]uint, error) {
+	var deletions []models.UserDeletion
+	if err := s.db.Where("restored_at IS NULL AND purged_at IS NULL AND undo_until < ?", time.Now()).
+		Order("id ASC").Find(&deletions).Error; err != nil {
+		return nil, fmt.Errorf("failed to load expired deletions: %w", err)
+	}
+
+	purged := []uint{}
+	for _, deletion := range deletions {
+		if err := s.db.Transaction(func(tx *gorm.DB) error {
+			return s.purge(tx, deletion)
+		}); err != nil {
+			return purged, fmt.Errorf("failed to purge user %d: %w", deletion.UserID, err)
+		}
+		purged = append(purged, deletion.UserID)
+	}
+	return purged, nil
+}
+
+// purge removes the user of deletion and everything personal linked to them
+func (s *AccountService) purge(tx *gorm.DB, deletion models.UserDeletion) error {
+	var user models.User
+	if err := tx.Unscoped().First(&user, deletion.UserID).Error; err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+
+	ghost, err := database.GetOrCreateGhostUser(tx)
+	if err != nil {
+		return err
+	}
+	for _, model := range []interface{}{&models.Post{}, &models.Comment{}, &models.Series{}} {
+		if err := tx.Unscoped().Model(model).Where("user_id = ?", user.ID).Update("user_id", ghost.ID).Error; err != nil {
+			return fmt.Errorf("failed to reassign content: %w", err)
+		}
+	}
+
+	for _, model := range []interface{}{
+		&models.PostAuthor{}, &models.Bookmark{}, &models.NewsBookmark{}, &models.Notification{},
+		&models.APIKey{}, &models.NewsView{}, &models.RefreshToken{}, &models.CommentMention{},
+		&models.ReadingProgress{}, &models.CommentReaction{}, &models.PostAutosave{},
+	} {
+		if err := tx.Unscoped().Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+			return fmt.Errorf("failed to delete user data: %w", err)
+		}
+	}
+
+	emails := []string{user.Email}
+	if deletion.Snapshot != nil {
+		emails = append(emails, deletion.Snapshot.Email)
+	}
+	if err := tx.Where("email IN ?", emails).Delete(&models.Subscriber{}).Error; err != nil {
+		return fmt.Errorf("failed to delete newsletter subscription: %w", err)
+	}
+
+	if err := tx.Unscoped().Delete(&user).Error; err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	now := time.Now()
+	return tx.Model(&deletion).Updates(map[string]interface{}{
+		"purged_at": now,
+		"snapshot":  nil,
+	}).Error
+}
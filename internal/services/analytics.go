@@ -0,0 +1,155 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// analyticsRollupSQL sums the events since a day into daily post counters,
+// replacing the counters of days summed before. A view reaches a point of the
+// post when any of its progress events got that far.
+const analyticsRollupSQL = `
+INSERT INTO post_analytics_daily (post_id, day, views, unique_readers, reached_25, reached_50, reached_75, reached_100)
+SELECT post_id, (created_at AT TIME ZONE 'UTC')::date,
+	COUNT(DISTINCT view_id) FILTER (WHERE type = @pageview),
+	COUNT(DISTINCT COALESCE(reader_id, view_id)) FILTER (WHERE type = @pageview),
+	COUNT(DISTINCT view_id) FILTER (WHERE type = @progress AND progress >= 25),
+	COUNT(DISTINCT view_id) FILTER (WHERE type = @progress AND progress >= 50),
+	COUNT(DISTINCT view_id) FILTER (WHERE type = @progress AND progress >= 75),
+	COUNT(DISTINCT view_id) FILTER (WHERE type = @progress AND progress >= 100)
+FROM analytics_events
+WHERE created_at >= @since
+GROUP BY 1, 2
+ON CONFLICT (post_id, day) DO UPDATE SET
+	views = EXCLUDED.views,
+	unique_readers = EXCLUDED.unique_readers,
+	reached_25 = EXCLUDED.reached_25,
+	reached_50 = EXCLUDED.reached_50,
+	reached_75 = EXCLUDED.reached_75,
+	reached_100 = EXCLUDED.reached_100`
+
+// AnalyticsService stores reader events reported by the frontend and sums
+// them into the daily counters post authors see
+type AnalyticsService struct {
+	db  *gorm.DB
+	cfg config.AnalyticsConfig
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(db *gorm.DB, cfg config.AnalyticsConfig) *AnalyticsService {
+	return &AnalyticsService{db: db, cfg: cfg}
+}
+
+// Record stores events for published posts and drops the rest. The reader
+// and user IDs are only kept when individual is set, so readers who may not
+// be tracked are only counted. userID is 0 for anonymous readers. It returns
+// the number of events stored.
+func (s *AnalyticsService) Record(inputs []models.AnalyticsEventInput, individual bool, userID uint) (int, error) {
+	postIDs := make([]uint, 0, len(inputs))
+	for _, input := range inputs {
+		postIDs = append(postIDs, input.PostID)
+	}
+	var published []uint
+	if err := s.db.Model(&models.Post{}).
+		Where("id IN ? AND status = ?", postIDs, models.PostStatusPublished).
+		Pluck("id", &published).Error; err != nil {
+		return 0, fmt.Errorf("failed to look up posts: %w", err)
+	}
+	isPublished := make(map[uint]bool, len(published))
+	for _, id := range published {
+		isPublished[id] = true
+	}
+
+	events := make([]models.AnalyticsEvent, 0, len(inputs))
+	for _, input := range inputs {
+		if !isPublished[input.PostID] {
+			continue
+		}
+		event := models.AnalyticsEvent{
+			PostID: input.PostID,
+			ViewID: input.ViewID,
+			Type:   input.Type,
+		}
+		if input.Type == models.AnalyticsEventProgress {
+			event.Progress = input.Progress
+		}
+		if individual {
+			if input.ReaderID != "" {
+				readerID := input.ReaderID
+				event.ReaderID = &readerID
+			}
+			if userID != 0 {
+				event.UserID = &userID
+			}
+		}
+		events = append(events, event)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	if err := s.db.Create(&events).Error; err != nil {
+		return 0, fmt.Errorf("failed to store events: %w", err)
+	}
+	return len(events), nil
+}
+
+// Rollup recomputes the daily counters of yesterday and today from the raw
+// events, so events arriving shortly after midnight still count, then deletes
+// the events older than the retention period. It returns the number of events
+// deleted.
+func (s *AnalyticsService) Rollup(now time.Time) (int64, error) {
+	now = now.UTC()
+	since := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, time.UTC)
+	if err := s.db.Exec(analyticsRollupSQL, map[string]interface{}{
+		"pageview": models.AnalyticsEventPageview,
+		"progress": models.AnalyticsEventProgress,
+		"since":    since,
+	}).Error; err != nil {
+		return 0, fmt.Errorf("failed to sum analytics events: %w", err)
+	}
+
+	result := s.db.Where("created_at < ?", now.Add(-s.cfg.EventRetention)).Delete(&models.AnalyticsEvent{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete old analytics events: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// PostAnalytics returns the counters of a post over the last days days,
+// today included
+func (s *AnalyticsService) PostAnalytics(postID uint, days int, now time.Time) (*models.PostAnalytics, error) {
+	now = now.UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day()-days+1, 0, 0, 0, 0, time.UTC)
+
+	analytics := &models.PostAnalytics{PostID: postID, From: from, Days: []models.PostAnalyticsDay{}}
+	if err := s.db.Where("post_id = ? AND day >= ?", postID, from).
+		Order("day").
+		Find(&analytics.Days).Error; err != nil {
+		return nil, fmt.Errorf("failed to load post analytics: %w", err)
+	}
+
+	var reached25, reached50, reached75, reached100 int64
+	for _, day := range analytics.Days {
+		analytics.Views += day.Views
+		analytics.UniqueReaders += day.UniqueReaders
+		reached25 += day.Reached25
+		reached50 += day.Reached50
+		reached75 += day.Reached75
+		reached100 += day.Reached100
+	}
+	if analytics.Views > 0 {
+		views := float64(analytics.Views)
+		analytics.ReadThrough = models.PostReadThrough{
+			Reached25:  float64(reached25) / views,
+			Reached50:  float64(reached50) / views,
+			Reached75:  float64(reached75) / views,
+			Reached100: float64(reached100) / views,
+		}
+	}
+	return analytics, nil
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// AnalyticsPolicy decides whether a reader may be tracked individually.
+// Analytics code must ask it before storing anything that identifies a reader
+// (user ID, IP address, session) and fall back to aggregated counts otherwise.
+// The post view counter is aggregate-only and is always allowed.
+type AnalyticsPolicy struct {
+	cfg config.AnalyticsConfig
+}
+
+// NewAnalyticsPolicy creates a new analytics policy
+func NewAnalyticsPolicy(cfg config.AnalyticsConfig) *AnalyticsPolicy {
+	return &AnalyticsPolicy{cfg: cfg}
+}
+
+// AllowsIndividualTracking reports whether the reader behind req may be tracked
+// individually. It returns false when privacy mode is on, when the browser sends
+// a Do Not Track (DNT: 1) or Global Privacy Control (Sec-GPC: 1) signal, or when
+// the signed-in user opted out. user is nil for anonymous readers.
+func (p *AnalyticsPolicy) AllowsIndividualTracking(req *http.Request, user *models.User) bool {
+	if p.cfg.PrivacyMode {
+		return false
+	}
+	if req.Header.Get("DNT") == "1" || req.Header.Get("Sec-GPC") == "1" {
+		return false
+	}
+	if user != nil && user.AnalyticsOptOut {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// apiKeyPrefix marks our keys, so leaked keys are easy to find in code and logs
+	apiKeyPrefix = "tpv_"
+	// apiKeyDisplayLength is how much of the key is stored in clear to recognize it by
+	apiKeyDisplayLength = 12
+	// apiKeyLastUsedResolution limits how often last_used_at is written for a busy key
+	apiKeyLastUsedResolution = time.Minute
+)
+
+var (
+	// ErrAPIKeyInvalid is returned for unknown, expired and revoked keys, and
+	// keys of deleted users
+	ErrAPIKeyInvalid = errors.New("invalid API key")
+	// ErrAPIKeyNotFound is returned when the user has no key with the given ID
+	ErrAPIKeyNotFound = errors.New("API key not found")
+)
+
+// APIKeyService manages users' API keys and authenticates requests made with them
+type APIKeyService struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyService creates a new API key service
+func NewAPIKeyService(db *gorm.DB) *APIKeyService {
+	return &APIKeyService{db: db}
+}
+
+// List returns the user's keys, newest first, including revoked ones
+func (s *APIKeyService) List(userID uint) ([]models.APIKey, error) {
+	var keys []models.APIKey
+	if err := s.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	return keys, nil
+}
+
+// Create generates a key for the user and returns it in clear along with its
+// stored record. The clear key can't be recovered afterwards.
+func (s *APIKeyService) Create(userID uint, req models.CreateAPIKeyRequest) (string, *models.APIKey, error) {
+	secret := make([]byte, 24)
+	_, _ = rand.Read(secret) // Never fails since Go 1.24
+	key := apiKeyPrefix + hex.EncodeToString(secret)
+
+	apiKey := &models.APIKey{
+		UserID:  userID,
+		Name:    req.Name,
+		Prefix:  key[:apiKeyDisplayLength],
+		KeyHash: hashAPIKey(key),
+		Scopes:  req.Scopes,
+	}
+	if req.ExpiresInDays != nil {
+		expiresAt := time.Now().AddDate(0, 0, *req.ExpiresInDays)
+		apiKey.ExpiresAt = &expiresAt
+	}
+
+	if err := s.db.Create(apiKey).Error; err != nil {
+		return "", nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+	return key, apiKey, nil
+}
+
+// Revoke stops one of the user's keys from working
+func (s *APIKeyService) Revoke(userID, id uint) error {
+	result := s.db.Model(&models.APIKey{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke API key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		// Revoking an already revoked key succeeds
+		var count int64
+		if err := s.db.Model(&models.APIKey{}).Where("id = ? AND user_id = ?", id, userID).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to revoke API key: %w", err)
+		}
+		if count == 0 {
+			return ErrAPIKeyNotFound
+		}
+	}
+	return nil
+}
+
+// Authenticate returns the key and its user for a key sent with a request
+func (s *APIKeyService) Authenticate(key string) (*models.APIKey, *models.User, error) {
+	var apiKey models.APIKey
+	err := s.db.Where("key_hash = ?", hashAPIKey(key)).First(&apiKey).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to look up API key: %w", err)
+	}
+
+	now := time.Now()
+	if apiKey.RevokedAt != nil || (apiKey.ExpiresAt != nil && now.After(*apiKey.ExpiresAt)) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+
+	var user models.User
+	err = s.db.Select("id, role").First(&user, apiKey.UserID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load API key user: %w", err)
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyLastUsedResolution {
+		// Best effort; a failed write shouldn't fail the request
+		s.db.Model(&apiKey).UpdateColumn("last_used_at", now)
+	}
+
+	return &apiKey, &user, nil
+}
+
+// hashAPIKey returns the SHA-256 of a key. Keys are random, so a plain hash is
+// enough to make a leaked table useless.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// AuditService records and lists audit log entries
+type AuditService struct {
+	db *gorm.DB
+}
+
+// NewAuditService creates a new audit log service
+func NewAuditService(db *gorm.DB) *AuditService {
+	return &AuditService{db: db}
+}
+
+// Record stores an audit log entry
+func (s *AuditService) Record(entry *models.AuditLog) error {
+	if err := s.db.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to record audit log entry: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of the entries matching query, newest first, and the
+// number of matching entries
+func (s *AuditService) List(query models.AuditLogQuery) ([]models.AuditLog, int64, error) {
+	db := s.db.Model(&models.AuditLog{})
+	if query.ActorID != 0 {
+		db = db.Where("actor_id = ?", query.ActorID)
+	}
+	if query.Action != "" {
+		db = db.Where("action = ?", query.Action)
+	}
+	if query.ResourceType != "" {
+		db = db.Where("resource_type = ?", query.ResourceType)
+	}
+	if query.ResourceID != "" {
+		db = db.Where("resource_id = ?", query.ResourceID)
+	}
+	if query.RequestID != "" {
+		db = db.Where("request_id = ?", query.RequestID)
+	}
+	if query.From != nil {
+		db = db.Where("created_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("created_at < ?", *query.To)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit log entries: %w", err)
+	}
+
+	logs := []models.AuditLog{}
+	if err := db.Order("created_at DESC, id DESC").
+		Limit(query.PerPage).
+		Offset((query.Page - 1) * query.PerPage).
+		Find(&logs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to load audit log entries: %w", err)
+	}
+	return logs, total, nil
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"path/filepath"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"github.com/rs/zerolog/log"
 )
 
@@ -25,6 +28,15 @@ const (
 	avatarFolder    = "avatars"
 	postCoverFolder = "post_covers"
 	editorFolder    = "editor_files"
+	importedFolder  = "imported"
+	ogImageFolder   = "og_images"
+)
+
+// Resize transformations of the medium and thumbnail image variants, in that
+// order. Format and quality are added from the config.
+var (
+	avatarVariantSizes    = [2]string{"c_fill,g_face,w_256,h_256", "c_fill,g_face,w_96,h_96"}
+	postCoverVariantSizes = [2]string{"c_limit,w_1200", "c_fill,w_400,h_225"}
 )
 
 // NewCloudinaryService creates a new Cloudinary service
@@ -38,18 +50,27 @@ func NewCloudinaryService(cfg config.CloudinaryConfig) (*CloudinaryService, erro
 		return nil, fmt.Errorf("failed to initialize Cloudinary: %w", err)
 	}
 
+	// Record a span for every Cloudinary API call
+	cld.Upload.Client.Transport = tracing.Transport(cld.Upload.Client.Transport)
+	cld.Admin.Client.Transport = tracing.Transport(cld.Admin.Client.Transport)
+
 	return &CloudinaryService{
 		cld: cld,
 		cfg: cfg,
 	}, nil
 }
 
-// UploadAvatar uploads an avatar image to Cloudinary
-func (s *CloudinaryService) UploadAvatar(ctx context.Context, file *multipart.FileHeader, userID uint) (string, error) {
+// UploadAvatar uploads an avatar image to Cloudinary and returns the URLs of
+// the original and its resized variants
+func (s *CloudinaryService) UploadAvatar(ctx context.Context, file *multipart.FileHeader, userID uint) (*models.ImageVariants, error) {
+	ctx, span := tracing.Start(ctx, "cloudinary.upload", tracing.SpanKindInternal)
+	defer span.End()
+	span.SetAttribute("cloudinary.folder", avatarFolder)
+
 	// Open the uploaded file
 	src, err := file.Open()
 	if err != nil {
-		return "", fmt.Errorf("failed to open uploaded file: %w", err)
+		return nil, fmt.Errorf("failed to open uploaded file: %w", err)
 	}
 	defer src.Close()
 
@@ -63,6 +84,7 @@ func (s *CloudinaryService) UploadAvatar(ctx context.Context, file *multipart.Fi
 		PublicID:     publicID,
 		ResourceType: "image",
 		Folder:       folderPath,
+		Eager:        s.eagerTransformations(avatarVariantSizes),
 	}
 
 	log.Info().
@@ -74,24 +96,30 @@ func (s *CloudinaryService) UploadAvatar(ctx context.Context, file *multipart.Fi
 
 	result, err := s.cld.Upload.Upload(ctx, src, uploadParams)
 	if err != nil {
-		return "", fmt.Errorf("failed to upload to Cloudinary: %w", err)
+		return nil, fmt.Errorf("failed to upload to Cloudinary: %w", err)
 	}
+	variants := imageVariantsFromResult(result)
 
 	log.Info().
 		Str("public_id", publicID).
-		Str("url", result.SecureURL).
+		Str("url", variants.Original).
 		Uint("user_id", userID).
 		Msg("Avatar uploaded successfully")
 
-	return result.SecureURL, nil
+	return variants, nil
 }
 
-// UploadPostCover uploads a cover image for a post to Cloudinary
-func (s *CloudinaryService) UploadPostCover(ctx context.Context, file *multipart.FileHeader, postID uint) (string, error) {
+// UploadPostCover uploads a cover image for a post to Cloudinary and returns
+// the URLs of the original and its resized variants
+func (s *CloudinaryService) UploadPostCover(ctx context.Context, file *multipart.FileHeader, postID uint) (*models.ImageVariants, error) {
+	ctx, span := tracing.Start(ctx, "cloudinary.upload", tracing.SpanKindInternal)
+	defer span.End()
+	span.SetAttribute("cloudinary.folder", postCoverFolder)
+
 	// Open the uploaded file
 	src, err := file.Open()
 	if err != nil {
-		return "", fmt.Errorf("failed to open uploaded file: %w", err)
+		return nil, fmt.Errorf("failed to open uploaded file: %w", err)
 	}
 	defer src.Close()
 
@@ -105,6 +133,7 @@ func (s *CloudinaryService) UploadPostCover(ctx context.Context, file *multipart
 		PublicID:     publicID,
 		ResourceType: "image",
 		Folder:       folderPath,
+		Eager:        s.eagerTransformations(postCoverVariantSizes),
 	}
 
 	log.Info().
@@ -116,20 +145,25 @@ func (s *CloudinaryService) UploadPostCover(ctx context.Context, file *multipart
 
 	result, err := s.cld.Upload.Upload(ctx, src, uploadParams)
 	if err != nil {
-		return "", fmt.Errorf("failed to upload to Cloudinary: %w", err)
+		return nil, fmt.Errorf("failed to upload to Cloudinary: %w", err)
 	}
+	variants := imageVariantsFromResult(result)
 
 	log.Info().
 		Str("public_id", publicID).
-		Str("url", result.SecureURL).
+		Str("url", variants.Original).
 		Uint("post_id", postID).
 		Msg("Post cover uploaded successfully")
 
-	return result.SecureURL, nil
+	return variants, nil
 }
 
 // UploadEditorFile uploads a file for editor use to Cloudinary
 func (s *CloudinaryService) UploadEditorFile(ctx context.Context, file *multipart.FileHeader, userID uint) (string, error) {
+	ctx, span := tracing.Start(ctx, "cloudinary.upload", tracing.SpanKindInternal)
+	defer span.End()
+	span.SetAttribute("cloudinary.folder", editorFolder)
+
 	// Open the uploaded file
 	src, err := file.Open()
 	if err != nil {
@@ -178,12 +212,97 @@ func (s *CloudinaryService) UploadEditorFile(ctx context.Context, file *multipar
 	return result.SecureURL, nil
 }
 
+// UploadImportedImage uploads an image fetched during an import to Cloudinary
+// and returns its URL
+func (s *CloudinaryService) UploadImportedImage(ctx context.Context, filename string, content io.Reader, size int64) (string, error) {
+	ctx, span := tracing.Start(ctx, "cloudinary.upload", tracing.SpanKindInternal)
+	defer span.End()
+	span.SetAttribute("cloudinary.folder", importedFolder)
+
+	folderPath := fmt.Sprintf("%s/%s", s.cfg.UploadFolder, importedFolder)
+	publicID := fmt.Sprintf("import_%d", time.Now().UnixNano())
+
+	log.Info().
+		Str("public_id", publicID).
+		Str("folder", folderPath).
+		Str("filename", filename).
+		Msg("Uploading imported image to Cloudinary")
+
+	result, err := s.cld.Upload.Upload(ctx, content, uploader.UploadParams{
+		PublicID:     publicID,
+		ResourceType: "image",
+		Folder:       folderPath,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload to Cloudinary: %w", err)
+	}
+
+	log.Info().Str("public_id", publicID).Str("url", result.SecureURL).Msg("Imported image uploaded successfully")
+	return result.SecureURL, nil
+}
+
+// UploadOGImage uploads a generated share image for a post to Cloudinary and
+// returns its URL
+func (s *CloudinaryService) UploadOGImage(ctx context.Context, content io.Reader, size int64, postID uint) (string, error) {
+	ctx, span := tracing.Start(ctx, "cloudinary.upload", tracing.SpanKindInternal)
+	defer span.End()
+	span.SetAttribute("cloudinary.folder", ogImageFolder)
+
+	folderPath := fmt.Sprintf("%s/%s", s.cfg.UploadFolder, ogImageFolder)
+	publicID := fmt.Sprintf("post_%d_%d", postID, time.Now().UnixNano())
+
+	log.Info().
+		Str("public_id", publicID).
+		Str("folder", folderPath).
+		Uint("post_id", postID).
+		Msg("Uploading share image to Cloudinary")
+
+	result, err := s.cld.Upload.Upload(ctx, content, uploader.UploadParams{
+		PublicID:     publicID,
+		ResourceType: "image",
+		Folder:       folderPath,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload to Cloudinary: %w", err)
+	}
+
+	log.Info().Str("public_id", publicID).Str("url", result.SecureURL).Msg("Share image uploaded successfully")
+	return result.SecureURL, nil
+}
+
+// eagerTransformations builds the eager transformation string that makes
+// Cloudinary generate the medium and thumbnail variants during upload
+func (s *CloudinaryService) eagerTransformations(sizes [2]string) string {
+	suffix := fmt.Sprintf(",q_%s,f_%s", s.cfg.ImageQuality, s.cfg.ImageFormat)
+	return sizes[0] + suffix + "|" + sizes[1] + suffix
+}
+
+// imageVariantsFromResult reads the variant URLs from an upload result. Variants
+// that Cloudinary didn't generate fall back to the original.
+func imageVariantsFromResult(result *uploader.UploadResult) *models.ImageVariants {
+	variants := &models.ImageVariants{
+		Original:  result.SecureURL,
+		Medium:    result.SecureURL,
+		Thumbnail: result.SecureURL,
+	}
+	if len(result.Eager) > 0 && result.Eager[0].SecureURL != "" {
+		variants.Medium = result.Eager[0].SecureURL
+	}
+	if len(result.Eager) > 1 && result.Eager[1].SecureURL != "" {
+		variants.Thumbnail = result.Eager[1].SecureURL
+	}
+	return variants
+}
+
 // DeleteImage deletes an image from Cloudinary by URL
 func (s *CloudinaryService) DeleteImage(ctx context.Context, imageURL string) error {
 	if imageURL == "" {
 		return nil // Nothing to delete
 	}
 
+	ctx, span := tracing.Start(ctx, "cloudinary.delete", tracing.SpanKindInternal)
+	defer span.End()
+
 	// Extract public ID from URL
 	// Example URL: https://res.cloudinary.com/demo/image/upload/v1234567890/folder/public_id.jpg
 	// We need to extract the "folder/public_id" part
@@ -220,3 +339,15 @@ func (s *CloudinaryService) DeleteImage(ctx context.Context, imageURL string) er
 	log.Info().Str("public_id", publicID).Msg("Image deleted successfully")
 	return nil
 }
+
+// Ping checks that the configured credentials are accepted by the Cloudinary Admin API
+func (s *CloudinaryService) Ping(ctx context.Context) error {
+	result, err := s.cld.Admin.Ping(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reach Cloudinary: %w", err)
+	}
+	if result.Error.Message != "" {
+		return fmt.Errorf("cloudinary rejected the credentials: %s", result.Error.Message)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// commentEmailMaxAge is how old a notification may get before it is no
+	// longer worth emailing, such as when SMTP was down or just configured
+	commentEmailMaxAge = 7 * 24 * time.Hour
+	// commentEmailDigestPeriod is how long daily summaries collect comments
+	commentEmailDigestPeriod = 24 * time.Hour
+	// commentEmailExcerptLength caps the comment text quoted in emails
+	commentEmailExcerptLength = 300
+)
+
+// commentEmailTypes are the notifications that are emailed
+var commentEmailTypes = []models.NotificationType{models.NotificationPostComment, models.NotificationCommentReply}
+
+// commentEmail is the data of the comment_notification email template
+type commentEmail struct {
+	Name           string
+	Digest         bool
+	Comments       []commentEmailItem
+	UnsubscribeURL string
+}
+
+// commentEmailItem is one comment listed in a comment email
+type commentEmailItem struct {
+	Author    string
+	Reply     bool
+	PostTitle string
+	PostURL   string
+	Excerpt   string
+}
+
+// CommentEmailService emails users the comment and reply notifications they
+// haven't seen, right away or as a daily summary depending on their profile
+type CommentEmailService struct {
+	db    *gorm.DB
+	email *EmailService
+	cfg   config.NewsletterConfig
+}
+
+// NewCommentEmailService creates a comment email service sending through the
+// SMTP server
+func NewCommentEmailService(db *gorm.DB, cfg *config.Config) *CommentEmailService {
+	return &CommentEmailService{
+		db:    db,
+		email: NewEmailService(cfg.SMTP),
+		cfg:   cfg.Newsletter,
+	}
+}
+
+// Enabled reports whether email can be sent
+func (s *CommentEmailService) Enabled() bool {
+	return s.email.Enabled()
+}
+
+// SendPending emails each user their queued comment notifications: users
+// with immediate emails get them straight away, users with daily emails once
+// the oldest has waited a day. Notifications that were read in the app, are
+// too old or whose user turned emails off are dropped from the queue. Failed
+// deliveries are logged and retried on the next run. It returns the number of
+// emails sent.
+func (s *CommentEmailService) SendPending(ctx context.Context) (int, error) {
+	if !s.Enabled() {
+		return 0, ErrEmailNotConfigured
+	}
+
+	now := time.Now()
+	if err := s.db.Model(&models.Notification{}).
+		Where("emailed_at IS NULL").
+		Where("read_at IS NOT NULL OR type NOT IN ? OR created_at < ? OR user_id IN (?)",
+			commentEmailTypes, now.Add(-commentEmailMaxAge),
+			s.db.Model(&models.User{}).Unscoped().Select("id").
+				Where("comment_emails = ? OR deleted_at IS NOT NULL", models.CommentEmailsOff)).
+		Update("emailed_at", now).Error; err != nil {
+		return 0, fmt.Errorf("failed to skip notifications: %w", err)
+	}
+
+	var due []struct {
+		UserID        uint
+		CommentEmails models.CommentEmails
+		Oldest        time.Time
+	}
+	if err := s.db.Table("notifications").
+		Select("notifications.user_id, users.comment_emails, MIN(notifications.created_at) AS oldest").
+		Joins("JOIN users ON users.id = notifications.user_id").
+		Where("notifications.emailed_at IS NULL").
+		Group("notifications.user_id, users.comment_emails").
+		Scan(&due).Error; err != nil {
+		return 0, fmt.Errorf("failed to load queued notifications: %w", err)
+	}
+
+	sent := 0
+	for _, recipient := range due {
+		digest := recipient.CommentEmails == models.CommentEmailsDaily
+		if digest && now.Sub(recipient.Oldest) < commentEmailDigestPeriod {
+			continue
+		}
+		if err := s.sendTo(ctx, recipient.UserID, digest); err != nil {
+			log.Error().Err(err).Uint("user_id", recipient.UserID).Msg("Failed to send comment email")
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// sendTo emails a user their queued notifications and marks them as emailed
+func (s *CommentEmailService) sendTo(ctx context.Context, userID uint, digest bool) error {
+	var user models.User
+	if err := s.db.First(&user, userID).Error; err != nil {
+		return fmt.Errorf("failed to load recipient: %w", err)
+	}
+	token, err := s.unsubscribeToken(&user)
+	if err != nil {
+		return err
+	}
+
+	var notifications []models.Notification
+	if err := s.db.Where("user_id = ? AND emailed_at IS NULL", userID).
+		Preload("Actor", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Select("id, username, first_name, last_name")
+		}).
+		Preload("Post", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, title, slug")
+		}).
+		Order("created_at, id").
+		Find(&notifications).Error; err != nil {
+		return fmt.Errorf("failed to load notifications: %w", err)
+	}
+
+	commentIDs := make([]uint, 0, len(notifications))
+	ids := make([]uint, 0, len(notifications))
+	for _, notification := range notifications {
+		ids = append(ids, notification.ID)
+		if notification.CommentID != nil {
+			commentIDs = append(commentIDs, *notification.CommentID)
+		}
+	}
+	var comments []models.Comment
+	if err := s.db.Select("id, content").Where("id IN ?", commentIDs).Find(&comments).Error; err != nil {
+		return fmt.Errorf("failed to load comments: %w", err)
+	}
+	contents := make(map[uint]string, len(comments))
+	for _, comment := range comments {
+		contents[comment.ID] = comment.Content
+	}
+
+	data := commentEmail{
+		Name:           displayName(&user),
+		Digest:         digest,
+		UnsubscribeURL: s.cfg.APIURL + "/notifications/email/unsubscribe?token=" + token,
+	}
+	for _, notification := range notifications {
+		// Comments deleted since they were made aren't worth an email
+		if notification.Post == nil || notification.CommentID == nil {
+			continue
+		}
+		content, ok := contents[*notification.CommentID]
+		if !ok {
+			continue
+		}
+		author := "Someone"
+		if notification.Actor != nil {
+			author = displayName(notification.Actor)
+		}
+		data.Comments = append(data.Comments, commentEmailItem{
+			Author:    author,
+			Reply:     notification.Type == models.NotificationCommentReply,
+			PostTitle: notification.Post.Title,
+			PostURL:   s.cfg.SiteURL + "/posts/" + notification.Post.Slug,
+			Excerpt:   truncateRunes(strings.TrimSpace(content), commentEmailExcerptLength),
+		})
+	}
+
+	if len(data.Comments) > 0 {
+		subject, body, err := s.email.Render("comment_notification", data)
+		if err != nil {
+			return err
+		}
+		if err := s.email.Send(ctx, user.Email, subject, body); err != nil {
+			return fmt.Errorf("failed to send email: %w", err)
+		}
+	}
+
+	if err := s.db.Model(&models.Notification{}).Where("id IN ?", ids).
+		Update("emailed_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("failed to mark notifications as emailed: %w", err)
+	}
+	return nil
+}
+
+// Unsubscribe turns off comment emails for the user an unsubscribe token was
+// sent to. Unsubscribing twice succeeds.
+func (s *CommentEmailService) Unsubscribe(token string) (*models.User, error) {
+	if token == "" {
+		return nil, ErrSubscriptionTokenInvalid
+	}
+
+	var user models.User
+	err := s.db.Where("comment_emails_token = ?", token).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSubscriptionTokenInvalid
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user: %w", err)
+	}
+
+	if err := s.db.Model(&user).Update("comment_emails", models.CommentEmailsOff).Error; err != nil {
+		return nil, fmt.Errorf("failed to turn off comment emails: %w", err)
+	}
+	return &user, nil
+}
+
+// unsubscribeToken returns the user's unsubscribe token, creating it on
+// their first email
+func (s *CommentEmailService) unsubscribeToken(user *models.User) (string, error) {
+	if user.CommentEmailsToken != nil {
+		return *user.CommentEmailsToken, nil
+	}
+
+	token := newSubscriptionToken()
+	if err := s.db.Model(user).Update("comment_emails_token", token).Error; err != nil {
+		return "", fmt.Errorf("failed to save unsubscribe token: %w", err)
+	}
+	user.CommentEmailsToken = &token
+	return token, nil
+}
+
+// displayName returns the name to greet or credit a user with
+func displayName(user *models.User) string {
+	if name := strings.TrimSpace(user.FirstName + " " + user.LastName); name != "" {
+		return name
+	}
+	return user.Username
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// ErrCaptchaFailed is returned when the captcha token is missing or the
+// provider doesn't accept it
+var ErrCaptchaFailed = errors.New("captcha verification failed")
+
+// ContactService stores messages sent through the contact form and relays
+// them to the site owner by email
+type ContactService struct {
+	db         *gorm.DB
+	cfg        config.ContactConfig
+	email      *EmailService
+	httpClient *http.Client
+}
+
+// NewContactService creates a new contact service
+func NewContactService(db *gorm.DB, cfg *config.Config) *ContactService {
+	return &ContactService{
+		db:    db,
+		cfg:   cfg.Contact,
+		email: NewEmailService(cfg.SMTP),
+		httpClient: &http.Client{
+			Timeout:   cfg.Contact.CaptchaTimeout,
+			Transport: tracing.Transport(nil),
+		},
+	}
+}
+
+// Enabled reports whether contact messages can be relayed
+func (s *ContactService) Enabled() bool {
+	return s.email.Enabled() && s.cfg.Recipient != ""
+}
+
+// VerifyCaptcha checks token with the configured captcha provider. It passes
+// when no provider is configured. hCaptcha and Turnstile share the same
+// siteverify protocol.
+func (s *ContactService) VerifyCaptcha(ctx context.Context, token, remoteIP string) error {
+	if s.cfg.CaptchaProvider == "" {
+		return nil
+	}
+	if token == "" {
+		return ErrCaptchaFailed
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.httpClient.Timeout)
+	defer cancel()
+
+	form := url.Values{
+		"secret":   {s.cfg.CaptchaSecret},
+		"response": {token},
+		"remoteip": {remoteIP},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.CaptchaVerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create captcha request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("captcha request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha provider returned status %d", resp.StatusCode)
+	}
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&result); err != nil {
+		return fmt.Errorf("failed to read captcha response: %w", err)
+	}
+	if !result.Success {
+		log.Debug().Strs("error_codes", result.ErrorCodes).Str("provider", s.cfg.CaptchaProvider).Msg("Captcha token rejected")
+		return ErrCaptchaFailed
+	}
+	return nil
+}
+
+// Submit stores message and emails it to the site owner with the sender as
+// Reply-To. The message is stored first, so it's kept when the email can't be
+// sent; the send error is returned after storing it.
+func (s *ContactService) Submit(ctx context.Context, message *models.ContactMessage) error {
+	message.UserAgent = truncateRunes(message.UserAgent, 500)
+	if err := s.db.Create(message).Error; err != nil {
+		return fmt.Errorf("failed to store contact message: %w", err)
+	}
+
+	if !s.Enabled() {
+		return ErrEmailNotConfigured
+	}
+
+	subject := "Contact form: message from " + message.Name
+	body := fmt.Sprintf("%s <%s> wrote:\n\n%s\n\n--\nSent from %s at %s\n",
+		message.Name, message.Email, message.Message,
+		message.IPAddress, message.CreatedAt.UTC().Format(time.RFC1123))
+	replyTo := &mail.Address{Name: message.Name, Address: message.Email}
+	if err := s.email.SendWithReplyTo(ctx, s.cfg.Recipient, replyTo, subject, body); err != nil {
+		return fmt.Errorf("failed to relay contact message: %w", err)
+	}
+
+	now := time.Now()
+	if err := s.db.Model(message).Update("relayed_at", now).Error; err != nil {
+		return fmt.Errorf("failed to mark contact message as relayed: %w", err)
+	}
+	message.RelayedAt = &now
+	return nil
+}
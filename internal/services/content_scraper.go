@@ -6,40 +6,61 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"github.com/rs/zerolog/log"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
 )
 
-// ContentScraper handles fetching full content from news source URLs
+// maxPageSize limits how much of a source page is read
+const maxPageSize = 5 << 20 // 5 MiB
+
+// ContentScraper handles fetching full content from news source URLs. It
+// follows the scraper site settings: blocked and allowed domains, robots.txt
+// and a per-domain delay between requests.
 type ContentScraper struct {
 	httpClient *http.Client
+	settings   *SiteSettingsService
 }
 
 // NewContentScraper creates a new content scraper service
-func NewContentScraper() *ContentScraper {
-	return &ContentScraper{
-		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
+func NewContentScraper(settings *SiteSettingsService) *ContentScraper {
+	s := &ContentScraper{settings: settings}
+	s.httpClient = &http.Client{
+		Timeout:   15 * time.Second,
+		Transport: tracing.Transport(nil),
+		// A redirect must not lead to a domain the admins blocked
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			if !loadScraperPolicy(s.settings).domainAllowed(req.URL.Hostname()) {
+				return fmt.Errorf("redirect to %s: %w", req.URL.Hostname(), ErrDomainBlocked)
+			}
+			return nil
 		},
 	}
+	return s
+}
+
+// truncationPatterns are common signs that content was cut off
+var truncationPatterns = []string{
+	"[+",        // NewsAPI style: "[+1234 chars]"
+	"...",       // Common ellipsis
+	"…",         // Unicode ellipsis
+	"Read more", // Common text
+	"&#8230;",   // HTML entity for ellipsis
+	"[&#8230;]", // HTML entity for ellipsis in brackets
 }
 
 // IsTruncated checks if content appears to be truncated
 func IsTruncated(content string) bool {
-	// Check for common truncation patterns
-	truncationPatterns := []string{
-		"[+",        // NewsAPI style: "[+1234 chars]"
-		"...",       // Common ellipsis
-		"…",         // Unicode ellipsis
-		"Read more", // Common text
-		"&#8230;",   // HTML entity for ellipsis
-		"[&#8230;]", // HTML entity for ellipsis in brackets
-	}
-
 	for _, pattern := range truncationPatterns {
 		if strings.Contains(content, pattern) {
 			return true
@@ -79,52 +100,70 @@ func ExtractTruncationInfo(content string) (bool, int, string) {
 	return false, 0, ""
 }
 
-// FetchFullContent attempts to fetch the full content of a news article
-// Note: This is a simple implementation and may not work for all sources
-// due to different website structures, paywalls, etc.
-func (s *ContentScraper) FetchFullContent(ctx context.Context, sourceURL string) (string, error) {
+// FetchFullContent fetches a news article's page and extracts the article
+// from it with ExtractArticle. Paywalled pages and pages rendered by scripts
+// return ErrNoArticle or only their teaser.
+func (s *ContentScraper) FetchFullContent(ctx context.Context, sourceURL string) (*Article, error) {
 	if sourceURL == "" {
-		return "", errors.New("source URL is empty")
+		return nil, errors.New("source URL is empty")
+	}
+
+	target, err := url.Parse(sourceURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, fmt.Errorf("invalid source URL: %q", sourceURL)
+	}
+
+	policy := loadScraperPolicy(s.settings)
+	if !policy.domainAllowed(target.Hostname()) {
+		return nil, ErrDomainBlocked
+	}
+
+	delay := policy.delay
+	if policy.respectRobots {
+		rules := s.robots(ctx, target, policy.userAgent)
+		if !rules.Allowed(target.RequestURI()) {
+			return nil, ErrRobotsDisallowed
+		}
+		delay = max(delay, min(rules.crawlDelay, maxCrawlDelay))
+	}
+	if err := waitForHost(ctx, strings.ToLower(target.Host), delay, policy.jitter); err != nil {
+		return nil, err
 	}
 
 	// Create request with context
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-
-	// Add user agent to avoid being blocked
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+	req.Header.Set("User-Agent", policy.userAgent)
 
 	// Execute request
 	log.Info().Str("url", sourceURL).Msg("Fetching full content from source")
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("failed to execute request: %w", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Check status code
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("source returned non-OK status: %d", resp.StatusCode)
+		return nil, fmt.Errorf("source returned non-OK status: %d", resp.StatusCode)
 	}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
+	// Pages aren't always UTF-8; the charset comes from the Content-Type
+	// header or the page's meta tags
+	body, err := charset.NewReader(io.LimitReader(resp.Body, maxPageSize), resp.Header.Get("Content-Type"))
 	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to decode response body: %w", err)
+	}
+	doc, err := html.Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response body: %w", err)
 	}
 
-	// Simple extraction of content
-	// Note: This is a very simplified approach and will not work well
-	// for most modern websites. A production solution would use a more
-	// sophisticated approach like Readability algorithms, proper HTML parsing, etc.
-	content := string(body)
-
-	// Simple cleanup
-	content = cleanupContent(content)
-
-	return content, nil
+	// Redirects may have moved the page, and relative links resolve against
+	// where it ended up
+	return ExtractArticle(doc, resp.Request.URL)
 }
 
 // EnrichNewsContent enhances a news article by adding full content information
@@ -152,38 +191,17 @@ func (s *ContentScraper) EnrichNewsContent(ctx context.Context, news *models.New
 	}
 
 	// Attempt to fetch full content
-	fullContent, err := s.FetchFullContent(ctx, news.SourceURL)
+	article, err := s.FetchFullContent(ctx, news.SourceURL)
 	if err != nil {
 		log.Error().Err(err).Uint("newsID", news.ID).Str("sourceURL", news.SourceURL).Msg("Failed to fetch full content")
 		enriched.FetchError = err.Error()
 		return enriched, nil // Return what we have even if fetch failed
 	}
 
-	enriched.FullContent = fullContent
+	enriched.FullContent = article.Content
+	enriched.Byline = article.Byline
+	enriched.LeadImage = article.LeadImage
 	enriched.LastFetched = time.Now()
 
 	return enriched, nil
 }
-
-// cleanupContent performs basic cleanup on scraped content
-func cleanupContent(content string) string {
-	// This is a very simplified cleanup approach
-	// In a production system, you would use proper HTML parsing and extraction
-
-	// Remove script and style tags and their content
-	scriptPattern := regexp.MustCompile(`<script[\s\S]*?</script>`)
-	content = scriptPattern.ReplaceAllString(content, "")
-
-	stylePattern := regexp.MustCompile(`<style[\s\S]*?</style>`)
-	content = stylePattern.ReplaceAllString(content, "")
-
-	// Remove HTML tags
-	htmlTagPattern := regexp.MustCompile(`<[^>]*>`)
-	content = htmlTagPattern.ReplaceAllString(content, " ")
-
-	// Remove excess whitespace
-	whitespacePattern := regexp.MustCompile(`\s+`)
-	content = whitespacePattern.ReplaceAllString(content, " ")
-
-	return strings.TrimSpace(content)
-}
@@ -0,0 +1,366 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// API endpoints of the social networks
+var (
+	crossPostXURL        = "https://api.x.com/2/tweets"
+	crossPostTelegramURL = "https://api.telegram.org"
+	crossPostLinkedInURL = "https://api.linkedin.com/rest/posts"
+)
+
+const (
+	// crossPostLinkedInVersion is the LinkedIn API version requests are made against
+	crossPostLinkedInVersion = "202405"
+	// crossPostBatchSize caps the cross-posts sent in one run
+	crossPostBatchSize = 50
+)
+
+// crossPostLimits are the longest messages each network accepts, in
+// characters, and how many characters it counts a link as
+var crossPostLimits = map[models.CrossPostTarget]struct{ text, link int }{
+	models.CrossPostX:        {280, 23},
+	models.CrossPostTelegram: {4096, 0},
+	models.CrossPostLinkedIn: {3000, 0},
+}
+
+// CrossPostService shares published posts on the configured social networks.
+// Publishing a post queues a cross-post per network; a background job sends
+// them and retries failures with exponential backoff.
+type CrossPostService struct {
+	db         *gorm.DB
+	cfg        config.SocialConfig
+	siteURL    string
+	httpClient *http.Client
+}
+
+// NewCrossPostService creates a new cross-posting service
+func NewCrossPostService(db *gorm.DB, cfg *config.Config) *CrossPostService {
+	return &CrossPostService{
+		db:      db,
+		cfg:     cfg.Social,
+		siteURL: cfg.Newsletter.SiteURL,
+		httpClient: &http.Client{
+			Timeout:   cfg.Social.Timeout,
+			Transport: tracing.Transport(nil),
+		},
+	}
+}
+
+// Targets returns the networks whose credentials are configured
+func (s *CrossPostService) Targets() []models.CrossPostTarget {
+	var targets []models.CrossPostTarget
+	if s.cfg.XAccessToken != "" {
+		targets = append(targets, models.CrossPostX)
+	}
+	if s.cfg.TelegramBotToken != "" && s.cfg.TelegramChatID != "" {
+		targets = append(targets, models.CrossPostTelegram)
+	}
+	if s.cfg.LinkedInAccessToken != "" && s.cfg.LinkedInAuthor != "" {
+		targets = append(targets, models.CrossPostLinkedIn)
+	}
+	return targets
+}
+
+// Enabled reports whether any network is configured
+func (s *CrossPostService) Enabled() bool {
+	return len(s.Targets()) > 0
+}
+
+// Queue schedules post to be shared on every configured network it hasn't
+// been shared on yet, unless the post opted out
+func (s *CrossPostService) Queue(post *models.Post) error {
+	targets := s.Targets()
+	if post.SkipCrossPost || len(targets) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	crossPosts := make([]models.CrossPost, 0, len(targets))
+	for _, target := range targets {
+		crossPosts = append(crossPosts, models.CrossPost{
+			PostID:        post.ID,
+			Target:        target,
+			Status:        models.CrossPostPending,
+			NextAttemptAt: &now,
+		})
+	}
+	if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&crossPosts).Error; err != nil {
+		return fmt.Errorf("failed to queue cross-posts: %w", err)
+	}
+	return nil
+}
+
+// ForPost returns the cross-posts of a post, oldest first
+func (s *CrossPostService) ForPost(postID uint) ([]models.CrossPost, error) {
+	crossPosts := []models.CrossPost{}
+	if err := s.db.Where("post_id = ?", postID).Order("id ASC").Find(&crossPosts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load cross-posts: %w", err)
+	}
+	return crossPosts, nil
+}
+
+// Retry queues the failed cross-posts of a post again with fresh attempts
+// and returns how many there were
+func (s *CrossPostService) Retry(postID uint) (int64, error) {
+	result := s.db.Model(&models.CrossPost{}).
+		Where("post_id = ? AND status = ?", postID, models.CrossPostFailed).
+		Updates(map[string]interface{}{
+			"status":          models.CrossPostPending,
+			"attempts":        0,
+			"next_attempt_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to retry cross-posts: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// SendPending makes an attempt at each pending cross-post that is due.
+// Cross-posts of posts that were unpublished, deleted or opted out since are
+// canceled, and those of networks no longer configured are left queued. It
+// returns the number of cross-posts sent.
+func (s *CrossPostService) SendPending(ctx context.Context) (int, error) {
+	targets := s.Targets()
+	if len(targets) == 0 {
+		return 0, nil
+	}
+
+	var due []models.CrossPost
+	if err := s.db.Preload("Post").
+		Where("status = ? AND target IN ? AND next_attempt_at <= ?", models.CrossPostPending, targets, time.Now()).
+		Order("next_attempt_at ASC").
+		Limit(crossPostBatchSize).
+		Find(&due).Error; err != nil {
+		return 0, fmt.Errorf("failed to load queued cross-posts: %w", err)
+	}
+
+	sent := 0
+	for i := range due {
+		crossPost := &due[i]
+		if crossPost.Post.ID == 0 || crossPost.Post.Status != models.PostStatusPublished || crossPost.Post.SkipCrossPost {
+			s.finish(crossPost, models.CrossPostCanceled, "post is no longer published or opted out of cross-posting")
+			continue
+		}
+		if s.attempt(ctx, crossPost) {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// attempt shares a cross-post's post once, recording the outcome and
+// scheduling a retry on failure. It reports whether the post was shared.
+func (s *CrossPostService) attempt(ctx context.Context, crossPost *models.CrossPost) bool {
+	link := s.siteURL + "/posts/" + crossPost.Post.Slug
+	limits := crossPostLimits[crossPost.Target]
+	text := crossPostText(&crossPost.Post, link, limits.text, limits.link)
+
+	externalID, err := s.send(ctx, crossPost.Target, text, link)
+	crossPost.Attempts++
+	if err == nil {
+		now := time.Now()
+		crossPost.Status = models.CrossPostSent
+		crossPost.ExternalID = externalID
+		crossPost.SentAt = &now
+		crossPost.NextAttemptAt = nil
+		crossPost.LastError = ""
+		s.save(crossPost)
+		return true
+	}
+
+	log.Warn().
+		Err(err).
+		Uint("post_id", crossPost.PostID).
+		Str("target", string(crossPost.Target)).
+		Int("attempt", crossPost.Attempts).
+		Msg("Cross-post attempt failed")
+
+	if crossPost.Attempts >= s.cfg.MaxAttempts {
+		s.finish(crossPost, models.CrossPostFailed, err.Error())
+		return false
+	}
+
+	next := time.Now().Add(s.cfg.RetryBackoff << (crossPost.Attempts - 1))
+	crossPost.NextAttemptAt = &next
+	crossPost.LastError = err.Error()
+	s.save(crossPost)
+	return false
+}
+
+// finish takes a cross-post out of the queue with status
+func (s *CrossPostService) finish(crossPost *models.CrossPost, status models.CrossPostStatus, reason string) {
+	crossPost.Status = status
+	crossPost.LastError = reason
+	crossPost.NextAttemptAt = nil
+	s.save(crossPost)
+}
+
+// save stores the delivery state of a cross-post, leaving its post alone
+func (s *CrossPostService) save(crossPost *models.CrossPost) {
+	if err := s.db.Omit(clause.Associations).Save(crossPost).Error; err != nil {
+		log.Error().Err(err).Uint("cross_post_id", crossPost.ID).Msg("Failed to record cross-post")
+	}
+}
+
+// send shares text on target and returns the ID the network gave the message
+func (s *CrossPostService) send(ctx context.Context, target models.CrossPostTarget, text, link string) (string, error) {
+	switch target {
+	case models.CrossPostX:
+		var response struct {
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		headers := map[string]string{"Authorization": "Bearer " + s.cfg.XAccessToken}
+		if _, err := s.postJSON(ctx, crossPostXURL, headers, map[string]string{"text": text}, &response); err != nil {
+			return "", err
+		}
+		return response.Data.ID, nil
+
+	case models.CrossPostTelegram:
+		var response struct {
+			OK     bool `json:"ok"`
+			Result struct {
+				MessageID int64 `json:"message_id"`
+			} `json:"result"`
+			Description string `json:"description"`
+		}
+		endpoint := crossPostTelegramURL + "/bot" + s.cfg.TelegramBotToken + "/sendMessage"
+		if _, err := s.postJSON(ctx, endpoint, nil, map[string]string{
+			"chat_id": s.cfg.TelegramChatID,
+			"text":    text,
+		}, &response); err != nil {
+			return "", err
+		}
+		if !response.OK {
+			return "", fmt.Errorf("telegram rejected the message: %s", response.Description)
+		}
+		return strconv.FormatInt(response.Result.MessageID, 10), nil
+
+	case models.CrossPostLinkedIn:
+		headers := map[string]string{
+			"Authorization":             "Bearer " + s.cfg.LinkedInAccessToken,
+			"LinkedIn-Version":          crossPostLinkedInVersion,
+			"X-Restli-Protocol-Version": "2.0.0",
+		}
+		resp, err := s.postJSON(ctx, crossPostLinkedInURL, headers, map[string]interface{}{
+			"author":     s.cfg.LinkedInAuthor,
+			"commentary": text,
+			"visibility": "PUBLIC",
+			"distribution": map[string]interface{}{
+				"feedDistribution":               "MAIN_FEED",
+				"targetEntities":                 []string{},
+				"thirdPartyDistributionChannels": []string{},
+			},
+			"content": map[string]interface{}{
+				"article": map[string]string{"source": link},
+			},
+			"lifecycleState":            "PUBLISHED",
+			"isReshareDisabledByAuthor": false,
+		}, nil)
+		if err != nil {
+			return "", err
+		}
+		return resp.Header.Get("x-restli-id"), nil
+	}
+	return "", fmt.Errorf("unknown cross-post target %q", target)
+}
+
+// postJSON sends body as JSON to endpoint and decodes the response into out
+// when it is not nil. Responses other than 2xx are errors.
+func (s *CrossPostService) postJSON(ctx context.Context, endpoint string, headers map[string]string, body, out interface{}) (*http.Response, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "TaiPhanVanBlog/1.0 CrossPosting")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		// The Telegram URL carries the bot token, keep it out of the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("network returned status %d: %s", resp.StatusCode, truncateRunes(strings.TrimSpace(string(data)), 300))
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return resp, nil
+}
+
+// crossPostText is the message shared for post: its title, its excerpt
+// shortened to fit and the link, at most limit characters with the link
+// counted as linkLength characters (or its own length when 0)
+func crossPostText(post *models.Post, link string, limit, linkLength int) string {
+	if linkLength == 0 {
+		linkLength = utf8.RuneCountInString(link)
+	}
+	room := limit - linkLength - 2
+
+	text := shortenText(strings.TrimSpace(post.Title), room)
+	if excerpt := strings.TrimSpace(post.Excerpt); excerpt != "" {
+		if left := room - utf8.RuneCountInString(text) - 2; left > 1 {
+			text += "\n\n" + shortenText(excerpt, left)
+		}
+	}
+	return text + "\n\n" + link
+}
+
+// shortenText cuts s to at most max characters, ending it with an ellipsis
+// when something was cut
+func shortenText(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	cut := truncateRunes(s, max-1)
+	if i := strings.LastIndex(cut, " "); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimSpace(cut) + "…"
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// dashboardScheduledPosts is how many scheduled posts the dashboard lists
+	dashboardScheduledPosts = 10
+	// dashboardAwaitingReply is how many unanswered comments the dashboard
+	// lists, from the last dashboardDays days
+	dashboardAwaitingReply = 10
+	// dashboardTopPosts is how many posts have their view trend shown
+	dashboardTopPosts = 5
+	// dashboardNotifications is how many unread notifications are listed
+	dashboardNotifications = 5
+	// dashboardDays is the period view trends and comments cover, today
+	// included
+	dashboardDays = 30
+)
+
+// DashboardService summarizes an author's posts, comments and notifications
+// for the home screen of their dashboard
+type DashboardService struct {
+	db *gorm.DB
+}
+
+// NewDashboardService creates a new dashboard service
+func NewDashboardService(db *gorm.DB) *DashboardService {
+	return &DashboardService{db: db}
+}
+
+// Author returns the dashboard of a user. Their posts are the ones they own
+// or co-author.
+func (s *DashboardService) Author(userID uint, now time.Time) (*models.AuthorDashboard, error) {
+	now = now.UTC()
+	from := time.Date(now.Year(), now.Month(), now.Day()-dashboardDays+1, 0, 0, 0, 0, time.UTC)
+	owned := s.db.Model(&models.Post{}).Select("id").
+		Where("user_id = ? OR id IN (?)", userID, s.db.Model(&models.PostAuthor{}).Select("post_id").Where("user_id = ?", userID))
+
+	dashboard := &models.AuthorDashboard{
+		ScheduledPosts: []models.Post{},
+		AwaitingReply:  []models.Comment{},
+		GeneratedAt:    now,
+	}
+
+	if err := s.db.Model(&models.Post{}).
+		Where("id IN (?) AND status = ?", owned, models.PostStatusDraft).
+		Count(&dashboard.DraftCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to count drafts: %w", err)
+	}
+
+	if err := s.db.Select("id, uuid, title, slug, status, publish_at, user_id, created_at, updated_at").
+		Where("id IN (?) AND status = ?", owned, models.PostStatusScheduled).
+		Order("publish_at ASC, id ASC").
+		Limit(dashboardScheduledPosts).
+		Find(&dashboard.ScheduledPosts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load scheduled posts: %w", err)
+	}
+
+	if err := s.db.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Post", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, uuid, title, slug, status, user_id")
+	}).
+		Where("post_id IN (?) AND status = ? AND user_id != ? AND created_at >= ?", owned, models.CommentStatusApproved, userID, from).
+		Where("NOT EXISTS (SELECT 1 FROM comments replies WHERE replies.parent_id = comments.id AND replies.user_id = ? AND replies.deleted_at IS NULL)", userID).
+		Order("created_at DESC, id DESC").
+		Limit(dashboardAwaitingReply).
+		Find(&dashboard.AwaitingReply).Error; err != nil {
+		return nil, fmt.Errorf("failed to load comments awaiting reply: %w", err)
+	}
+
+	var err error
+	if dashboard.TopPosts, err = s.topPosts(owned, from); err != nil {
+		return nil, err
+	}
+
+	notifications, _, unread, err := NewNotificationService(s.db).List(userID, true, 1, dashboardNotifications)
+	if err != nil {
+		return nil, err
+	}
+	dashboard.Notifications = notifications
+	dashboard.UnreadNotifications = unread
+
+	return dashboard, nil
+}
+
+// topPosts returns the daily views since from of the most viewed posts among
+// owned
+func (s *DashboardService) topPosts(owned *gorm.DB, from time.Time) ([]models.DashboardPostTrend, error) {
+	var totals []struct {
+		PostID uint
+		Views  int64
+	}
+	if err := s.db.Model(&models.PostAnalyticsDay{}).
+		Select("post_id, SUM(views) AS views").
+		Where("post_id IN (?) AND day >= ?", owned, from).
+		Group("post_id").
+		Having("SUM(views) > 0").
+		Order("views DESC, post_id").
+		Limit(dashboardTopPosts).
+		Scan(&totals).Error; err != nil {
+		return nil, fmt.Errorf("failed to rank posts by views: %w", err)
+	}
+	trends := make([]models.DashboardPostTrend, len(totals))
+	if len(totals) == 0 {
+		return trends, nil
+	}
+
+	ids := make([]uint, len(totals))
+	for i, total := range totals {
+		ids[i] = total.PostID
+	}
+
+	var posts []models.Post
+	if err := s.db.Select("id, title, slug").Where("id IN ?", ids).Find(&posts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load top posts: %w", err)
+	}
+	postsByID := make(map[uint]models.Post, len(posts))
+	for _, post := range posts {
+		postsByID[post.ID] = post
+	}
+
+	var days []models.PostAnalyticsDay
+	if err := s.db.Where("post_id IN ? AND day >= ?", ids, from).Find(&days).Error; err != nil {
+		return nil, fmt.Errorf("failed to load daily views: %w", err)
+	}
+	views := make(map[uint]map[string]int64, len(ids))
+	for _, day := range days {
+		if views[day.PostID] == nil {
+			views[day.PostID] = make(map[string]int64)
+		}
+		views[day.PostID][day.Day.UTC().Format("2006-01-02")] = day.Views
+	}
+
+	for i, total := range totals {
+		trends[i] = models.DashboardPostTrend{
+			PostID: total.PostID,
+			Title:  postsByID[total.PostID].Title,
+			Slug:   postsByID[total.PostID].Slug,
+			Views:  total.Views,
+			Days:   make([]models.StatsPoint, dashboardDays),
+		}
+		for d := range trends[i].Days {
+			period := from.AddDate(0, 0, d).Format("2006-01-02")
+			trends[i].Days[d] = models.StatsPoint{Period: period, Count: views[total.PostID][period]}
+		}
+	}
+	return trends, nil
+}
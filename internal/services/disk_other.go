@@ -0,0 +1,10 @@
+//go:build !unix
+
+package services
+
+import "errors"
+
+// DiskFreeBytes is not supported on this platform
+func DiskFreeBytes(path string) (uint64, error) {
+	return 0, errors.New("disk space check is not supported on this platform")
+}
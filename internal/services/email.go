@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"embed"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+)
+
+// ErrEmailNotConfigured is returned when SMTP_HOST is not set
+var ErrEmailNotConfigured = errors.New("email is not configured")
+
+// emailTemplateFiles holds the plain text templates of emails. Each file
+// defines a "<name>.subject" and a "<name>.body" template.
+//
+//go:embed email_templates/*.tmpl
+var emailTemplateFiles embed.FS
+
+// emailTemplates are the parsed email templates, rendered by EmailService.Render
+var emailTemplates = template.Must(template.ParseFS(emailTemplateFiles, "email_templates/*.tmpl"))
+
+// EmailService sends email through an SMTP server
+type EmailService struct {
+	cfg config.SMTPConfig
+}
+
+// NewEmailService creates a new email service
+func NewEmailService(cfg config.SMTPConfig) *EmailService {
+	return &EmailService{cfg: cfg}
+}
+
+// Enabled reports whether an SMTP server is configured
+func (s *EmailService) Enabled() bool {
+	return s.cfg.Host != ""
+}
+
+// Verify connects and logs in to the SMTP server without sending anything
+func (s *EmailService) Verify(ctx context.Context) error {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return client.Quit()
+}
+
+// Send delivers a plain text email to a single recipient. The request ID in
+// ctx is added as an X-Request-ID header so the message can be traced back to
+// the request that sent it.
+func (s *EmailService) Send(ctx context.Context, to, subject, body string) error {
+	return s.SendWithReplyTo(ctx, to, nil, subject, body)
+}
+
+// SendWithReplyTo is Send with a Reply-To header, so answering the email
+// reaches replyTo instead of the sender. A nil replyTo leaves it out.
+func (s *EmailService) SendWithReplyTo(ctx context.Context, to string, replyTo *mail.Address, subject, body string) (err error) {
+	if s.Enabled() {
+		start := time.Now()
+		defer func() {
+			tracing.RecordOutbound(ctx, s.cfg.Host, "SMTP", 0, time.Since(start), err)
+		}()
+	}
+
+	from, err := mail.ParseAddress(s.cfg.From)
+	if err != nil {
+		return fmt.Errorf("invalid SMTP_FROM address: %w", err)
+	}
+
+	client, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("sender rejected: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("recipient rejected: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message: %w", err)
+	}
+
+	var msg strings.Builder
+	msg.WriteString("From: " + from.String() + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	if replyTo != nil {
+		msg.WriteString("Reply-To: " + replyTo.String() + "\r\n")
+	}
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	if requestID := tracing.RequestIDFromContext(ctx); requestID != "" {
+		msg.WriteString(tracing.RequestIDHeader + ": " + requestID + "\r\n")
+	}
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	if _, err := w.Write([]byte(msg.String())); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// Render executes the subject and body templates of the email name with data
+func (s *EmailService) Render(name string, data any) (subject, body string, err error) {
+	var subjectText, bodyText strings.Builder
+	if err := emailTemplates.ExecuteTemplate(&subjectText, name+".subject", data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s email subject: %w", name, err)
+	}
+	if err := emailTemplates.ExecuteTemplate(&bodyText, name+".body", data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s email body: %w", name, err)
+	}
+	return strings.TrimSpace(subjectText.String()), bodyText.String(), nil
+}
+
+// connect dials the SMTP server, upgrades to TLS and authenticates.
+// Port 465 uses implicit TLS, other ports use STARTTLS when the server offers it.
+func (s *EmailService) connect(ctx context.Context) (*smtp.Client, error) {
+	if !s.Enabled() {
+		return nil, ErrEmailNotConfigured
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: s.cfg.Host}
+
+	var conn net.Conn
+	var err error
+	if s.cfg.Port == 465 {
+		dialer := &tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+
+	// The deadline covers the whole SMTP conversation, not just the dial
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("SMTP handshake failed: %w", err)
+	}
+
+	if ok, _ := client.Extension("STARTTLS"); ok && s.cfg.Port != 465 {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("STARTTLS failed: %w", err)
+		}
+	}
+
+	if s.cfg.Username != "" {
+		auth := smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("SMTP login failed: %w", err)
+		}
+	}
+
+	return client, nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// Background job names used as heartbeat keys
+const (
+	HeartbeatJobNewsFetch       = "news_fetch"
+	HeartbeatJobRSSFetch        = "rss_fetch"
+	HeartbeatJobTokenCleanup    = "token_cleanup"
+	HeartbeatJobDigest          = "digest"
+	HeartbeatJobNewsRetention   = "news_retention"
+	HeartbeatJobSearchIndex     = "search_index"
+	HeartbeatJobPostScheduler   = "post_scheduler"
+	HeartbeatJobCommentEmails   = "comment_emails"
+	HeartbeatJobAnalyticsRollup = "analytics_rollup"
+	HeartbeatJobCrossPosting    = "cross_posting"
+	HeartbeatJobLinkCheck       = "link_check"
+)
+
+// HeartbeatService pings an external monitor (Healthchecks.io style) after a
+// background job completes, so that missed or failing schedules get alerted on
+type HeartbeatService struct {
+	urls       map[string]string
+	httpClient *http.Client
+}
+
+// NewHeartbeatService creates a new heartbeat service
+func NewHeartbeatService(cfg config.HeartbeatConfig) *HeartbeatService {
+	return &HeartbeatService{
+		urls: cfg.URLs,
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+// Ping reports a successful run of job in the background.
+// It does nothing if no URL is configured for the job.
+func (s *HeartbeatService) Ping(job string) {
+	if s == nil {
+		return
+	}
+
+	url, ok := s.urls[job]
+	if !ok {
+		return
+	}
+
+	go func() {
+		if err := s.send(url); err != nil {
+			log.Warn().Err(err).Str("job", job).Msg("Failed to send heartbeat ping")
+			return
+		}
+		log.Debug().Str("job", job).Msg("Heartbeat ping sent")
+	}()
+}
+
+// send performs the ping request
+func (s *HeartbeatService) send(url string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.httpClient.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create heartbeat request: %w", err)
+	}
+	req.Header.Set("User-Agent", "TaiPhanVanBlog/1.0 Heartbeat")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("heartbeat request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("heartbeat endpoint returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// MonitoredJobs returns the names of jobs that have a heartbeat URL configured
+func (s *HeartbeatService) MonitoredJobs() []string {
+	if s == nil {
+		return nil
+	}
+
+	jobs := make([]string, 0, len(s.urls))
+	for job := range s.urls {
+		jobs = append(jobs, job)
+	}
+	sort.Strings(jobs)
+	return jobs
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// homeFeedCandidates is how many of the newest posts and news articles are
+// considered for the homepage feed before ranking
+const homeFeedCandidates = 100
+
+// HomeFeedService builds the homepage feed from published posts and news
+type HomeFeedService struct {
+	db     *gorm.DB
+	ranker Ranker
+}
+
+// NewHomeFeedService creates a new homepage feed service
+func NewHomeFeedService(db *gorm.DB, ranker Ranker) *HomeFeedService {
+	return &HomeFeedService{
+		db:     db,
+		ranker: ranker,
+	}
+}
+
+// Feed returns the best limit items among the newest posts and news articles
+func (s *HomeFeedService) Feed(limit int) (models.HomeFeedResponse, error) {
+	now := time.Now()
+
+	posts, err := s.postCandidates(now)
+	if err != nil {
+		return models.HomeFeedResponse{}, err
+	}
+	news, err := s.newsCandidates()
+	if err != nil {
+		return models.HomeFeedResponse{}, err
+	}
+
+	items := append(posts, news...)
+	if err := s.applyEditorialPicks(items); err != nil {
+		return models.HomeFeedResponse{}, err
+	}
+
+	s.ranker.Rank(items, now)
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return models.HomeFeedResponse{
+		Items:       items,
+		Ranker:      s.ranker.Name(),
+		GeneratedAt: now.UTC(),
+	}, nil
+}
+
+// postCandidates loads the newest published posts that haven't expired by now
+func (s *HomeFeedService) postCandidates(now time.Time) ([]models.FeedItem, error) {
+	var posts []models.Post
+	if err := s.db.Preload("Category").
+		Where("status = ?", models.PostStatusPublished).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("created_at DESC").
+		Limit(homeFeedCandidates).
+		Find(&posts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load posts: %w", err)
+	}
+
+	items := make([]models.FeedItem, 0, len(posts))
+	for _, post := range posts {
+		item := models.FeedItem{
+			Type:        models.FeedItemPost,
+			ID:          post.ID,
+			UUID:        post.UUID,
+			Title:       post.Title,
+			Slug:        post.Slug,
+			Summary:     post.Excerpt,
+			ImageURL:    post.Cover,
+			PublishedAt: post.CreatedAt,
+			ViewCount:   post.ViewCount,
+			ReadingTime: post.ReadingTime,
+		}
+		// Scheduled posts went out at their publish time, not when they were written
+		if post.PublishAt != nil {
+			item.PublishedAt = *post.PublishAt
+		}
+		if post.Category != nil {
+			item.Category = post.Category.Slug
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// newsCandidates loads the newest published news articles
+func (s *HomeFeedService) newsCandidates() ([]models.FeedItem, error) {
+	var news []models.News
+	if err := s.db.Select("id, uuid, title, slug, summary, image_url, category, source, source_url, publish_date").
+		Where("status = ? AND published = ?", models.NewsStatusPublished, true).
+		Order("publish_date DESC").
+		Limit(homeFeedCandidates).
+		Find(&news).Error; err != nil {
+		return nil, fmt.Errorf("failed to load news: %w", err)
+	}
+
+	items := make([]models.FeedItem, 0, len(news))
+	for _, article := range news {
+		items = append(items, models.FeedItem{
+			Type:        models.FeedItemNews,
+			ID:          article.ID,
+			UUID:        article.UUID,
+			Title:       article.Title,
+			Slug:        article.Slug,
+			Summary:     article.Summary,
+			ImageURL:    article.ImageURL,
+			Category:    string(article.Category),
+			Source:      article.Source,
+			SourceURL:   article.SourceURL,
+			PublishedAt: article.PublishDate,
+		})
+	}
+	return items, nil
+}
+
+// applyEditorialPicks copies the editorial weight of picked items onto the feed
+func (s *HomeFeedService) applyEditorialPicks(items []models.FeedItem) error {
+	var picks []models.EditorialPick
+	if err := s.db.Find(&picks).Error; err != nil {
+		return fmt.Errorf("failed to load editorial picks: %w", err)
+	}
+
+	weights := make(map[models.FeedItemType]map[uint]float64)
+	for _, pick := range picks {
+		if weights[pick.ItemType] == nil {
+			weights[pick.ItemType] = make(map[uint]float64)
+		}
+		weights[pick.ItemType][pick.ItemID] = pick.Weight
+	}
+
+	for i := range items {
+		items[i].EditorialWeight = weights[items[i].Type][items[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+)
+
+// imageCDNWidthStep is what width hints are rounded up to a multiple of, so
+// the CDN generates and caches a handful of sizes rather than one per pixel
+const imageCDNWidthStep = 100
+
+// cloudinaryTransformation matches one component of a Cloudinary
+// transformation, such as w_400 or $width_300
+var cloudinaryTransformation = regexp.MustCompile(`^(\$[a-z]+|[a-z]{1,3})_[^,]+$`)
+
+// ImageCDN rewrites the Cloudinary URLs stored with posts, users and
+// uploads to the configured CDN domain and adds delivery transformations, so
+// clients get optimized images without knowing about Cloudinary
+type ImageCDN struct {
+	cfg     config.ImageCDNConfig
+	origin  string
+	pattern *regexp.Regexp
+}
+
+// NewImageCDN creates an image CDN rewriter for the images of the Cloudinary
+// cloud cloudName. It is disabled when cfg is disabled or no cloud is configured.
+func NewImageCDN(cfg config.ImageCDNConfig, cloudName string) *ImageCDN {
+	cdn := &ImageCDN{cfg: cfg}
+	if !cfg.Enabled || cloudName == "" {
+		return cdn
+	}
+
+	cdn.origin = "https://res.cloudinary.com/" + cloudName
+	// URLs end at a quote, whitespace or the backslash of a JSON escape
+	cdn.pattern = regexp.MustCompile(`https?://res\.cloudinary\.com/` + regexp.QuoteMeta(cloudName) + `/image/upload/[^\s"'<>()\\]+`)
+	return cdn
+}
+
+// Enabled reports whether URLs are rewritten
+func (s *ImageCDN) Enabled() bool {
+	return s.pattern != nil
+}
+
+// Rewrite returns rawURL served from the CDN with the delivery
+// transformations for width, or rawURL unchanged when it isn't an image of
+// the configured cloud. A width of 0 leaves the size alone.
+func (s *ImageCDN) Rewrite(rawURL string, width int) string {
+	if !s.Enabled() || !s.pattern.MatchString(rawURL) {
+		return rawURL
+	}
+	return s.rewrite(rawURL, s.transformation(width))
+}
+
+// RewriteAll rewrites every image URL of the configured cloud in body, such
+// as a JSON response including post content
+func (s *ImageCDN) RewriteAll(body []byte, width int) []byte {
+	if !s.Enabled() {
+		return body
+	}
+	transformation := s.transformation(width)
+	return s.pattern.ReplaceAllFunc(body, func(match []byte) []byte {
+		return []byte(s.rewrite(string(match), transformation))
+	})
+}
+
+// rewrite moves a matched URL to the CDN and appends transformation to the
+// transformations it already has, so it applies to the final image
+func (s *ImageCDN) rewrite(rawURL, transformation string) string {
+	_, path, _ := strings.Cut(rawURL, "/image/upload/")
+	segments := strings.Split(path, "/")
+
+	// Transformations come before the version and the public ID, which is
+	// always the last segment
+	end := 0
+	for end < len(segments)-1 && isCloudinaryTransformation(segments[end]) {
+		end++
+	}
+	if transformation != "" {
+		segments = append(segments[:end], append([]string{transformation}, segments[end:]...)...)
+	}
+
+	base := s.origin
+	if s.cfg.BaseURL != "" {
+		base = s.cfg.BaseURL
+	}
+	return base + "/image/upload/" + strings.Join(segments, "/")
+}
+
+// transformation returns the transformation added to every URL for a width
+// hint: automatic format and quality when optimizing, and a width limit that
+// doesn't upscale. Widths are capped at the configured maximum and rounded up
+// to a multiple of imageCDNWidthStep.
+func (s *ImageCDN) transformation(width int) string {
+	var parts []string
+	if s.cfg.Optimize {
+		parts = append(parts, "f_auto", "q_auto")
+	}
+	if width > 0 {
+		width = min((width+imageCDNWidthStep-1)/imageCDNWidthStep*imageCDNWidthStep, s.cfg.MaxWidth)
+		parts = append(parts, "c_limit", "w_"+strconv.Itoa(width))
+	}
+	return strings.Join(parts, ",")
+}
+
+// isCloudinaryTransformation reports whether a URL path segment is a
+// transformation rather than a version, folder or public ID
+func isCloudinaryTransformation(segment string) bool {
+	for _, part := range strings.Split(segment, ",") {
+		if !cloudinaryTransformation.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// IngestionFetchFunc fetches articles for an ingestion run, reporting sources that failed
+type IngestionFetchFunc func() ([]models.News, []models.IngestionSourceError)
+
+// IngestionService stores fetched news articles and records each run, and the
+// outcome of every article in it, so skipped articles can be explained later
+type IngestionService struct {
+	db      *gorm.DB
+	cfg     config.NewsIngestionConfig
+	onSaved func(models.News)
+}
+
+// NewIngestionService creates a new ingestion service. onSaved, if not nil, is
+// called for every article that gets stored.
+func NewIngestionService(db *gorm.DB, cfg config.NewsIngestionConfig, onSaved func(models.News)) *IngestionService {
+	return &IngestionService{
+		db:      db,
+		cfg:     cfg,
+		onSaved: onSaved,
+	}
+}
+
+// Ingest records a run for source, calls fetch and stores the articles it returns.
+// Articles in languages that aren't fetched and articles whose external ID is
+// already stored are skipped. Articles whose slug is
+// taken are skipped on scheduled runs and saved under a suffixed slug on manual runs.
+func (s *IngestionService) Ingest(source, trigger string, fetch IngestionFetchFunc) models.IngestionRun {
+	run := models.IngestionRun{
+		Source:    source,
+		Trigger:   trigger,
+		Status:    models.IngestionRunning,
+		StartedAt: time.Now(),
+	}
+	if err := s.db.Create(&run).Error; err != nil {
+		log.Error().Err(err).Str("source", source).Msg("Failed to record ingestion run")
+	}
+
+	articles, sourceErrors := fetch()
+	run.ItemsSeen = len(articles)
+	run.SourceErrors = sourceErrors
+
+	for _, article := range articles {
+		var outcome models.IngestionItemOutcome
+		var reason string
+		if s.detectLanguage(&article) {
+			outcome, reason = s.saveArticle(&article, trigger == models.IngestionTriggerManual)
+		} else {
+			outcome, reason = models.IngestionItemFiltered, "Articles in "+article.Language+" are not fetched"
+		}
+
+		switch outcome {
+		case models.IngestionItemSaved:
+			run.ItemsSaved++
+			if s.onSaved != nil {
+				s.onSaved(article)
+			}
+		case models.IngestionItemDeduped:
+			run.ItemsDeduped++
+		case models.IngestionItemFiltered:
+			run.ItemsFiltered++
+		default:
+			run.ItemsFailed++
+		}
+
+		s.recordItem(run, article, outcome, reason)
+	}
+
+	run.Status = models.IngestionCompleted
+	if len(articles) == 0 && len(sourceErrors) > 0 {
+		run.Status = models.IngestionFailed
+		run.Error = "All sources failed to fetch"
+	}
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	if run.ID != 0 {
+		if err := s.db.Save(&run).Error; err != nil {
+			log.Error().Err(err).Uint("run_id", run.ID).Msg("Failed to update ingestion run")
+		}
+	}
+
+	log.Info().
+		Uint("run_id", run.ID).
+		Str("source", source).
+		Str("trigger", trigger).
+		Int("seen", run.ItemsSeen).
+		Int("deduped", run.ItemsDeduped).
+		Int("filtered", run.ItemsFiltered).
+		Int("saved", run.ItemsSaved).
+		Int("failed", run.ItemsFailed).
+		Int("source_errors", len(sourceErrors)).
+		Msg("News ingestion run finished")
+
+	return run
+}
+
+// detectLanguage sets the language of an article to the one detected from its
+// title and summary, keeping the language its source declared when the text
+// doesn't tell, and reports whether articles in that language are fetched
+func (s *IngestionService) detectLanguage(article *models.News) bool {
+	if lang := DetectLanguage(article.Title + "\n" + article.Summary); lang != "" {
+		article.Language = lang
+	}
+	return len(s.cfg.Languages) == 0 || slices.Contains(s.cfg.Languages, article.Language)
+}
+
+// saveArticle stores a single article in its own transaction so one bad article
+// doesn't abort the whole batch, and reports what happened to it
+func (s *IngestionService) saveArticle(article *models.News, renameDuplicateSlug bool) (models.IngestionItemOutcome, string) {
+	var outcome models.IngestionItemOutcome
+	var reason string
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var existingCount int64
+		if err := tx.Model(&models.News{}).Where("external_id = ?", article.ExternalID).Count(&existingCount).Error; err != nil {
+			return fmt.Errorf("failed to check existing news: %w", err)
+		}
+		if existingCount > 0 {
+			outcome, reason = models.IngestionItemDeduped, "An article with this external ID already exists"
+			return nil
+		}
+
+		var slugCount int64
+		if err := tx.Model(&models.News{}).Where("slug = ?", article.Slug).Count(&slugCount).Error; err != nil {
+			return fmt.Errorf("failed to check existing slug: %w", err)
+		}
+		if slugCount > 0 {
+			if !renameDuplicateSlug {
+				outcome, reason = models.IngestionItemDeduped, "An article with the slug "+article.Slug+" already exists"
+				return nil
+			}
+			article.Slug = fmt.Sprintf("%s-%d", article.Slug, time.Now().Unix())
+			reason = "Slug was taken, saved as " + article.Slug
+		}
+
+		if err := tx.Create(article).Error; err != nil {
+			return fmt.Errorf("failed to save news article: %w", err)
+		}
+
+		// Fetched articles rarely come with tags, so tag them with keywords
+		// to make them reachable by the ?tag= filter
+		if len(article.Tags) == 0 && s.cfg.KeywordTags > 0 {
+			tags, err := NewTagService(tx).SetNewsTags(article.ID, ExtractKeywords(article.Title, article.Summary, s.cfg.KeywordTags))
+			if err != nil {
+				return fmt.Errorf("failed to tag news article: %w", err)
+			}
+			article.Tags = tags
+		}
+		outcome = models.IngestionItemSaved
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Str("external_id", article.ExternalID).Str("title", article.Title).Msg("Failed to ingest news article")
+		return models.IngestionItemFailed, err.Error()
+	}
+
+	return outcome, reason
+}
+
+// recordItem logs the outcome of an article against its run
+func (s *IngestionService) recordItem(run models.IngestionRun, article models.News, outcome models.IngestionItemOutcome, reason string) {
+	if run.ID == 0 {
+		return
+	}
+
+	item := models.IngestionItem{
+		RunID:      run.ID,
+		Source:     article.Source,
+		ExternalID: article.ExternalID,
+		Title:      truncateRunes(article.Title, 255),
+		SourceURL:  truncateRunes(article.SourceURL, 500),
+		Outcome:    outcome,
+		Reason:     reason,
+	}
+	if outcome == models.IngestionItemSaved {
+		item.NewsID = &article.ID
+	}
+
+	if err := s.db.Create(&item).Error; err != nil {
+		log.Error().Err(err).Uint("run_id", run.ID).Msg("Failed to record ingestion item")
+	}
+}
+
+// truncateRunes shortens s to at most max characters
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
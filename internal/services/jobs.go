@@ -0,0 +1,78 @@
+package services
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// jobStallGrace is how far past twice its interval a job may be before it
+// counts as stalled, so a slow run isn't reported straight away
+const jobStallGrace = time.Minute
+
+// JobStatus is the state of one background job loop
+type JobStatus struct {
+	Name      string
+	Interval  time.Duration
+	StartedAt time.Time
+	LastRun   time.Time // Zero until the first run completes
+	Stalled   bool
+}
+
+// JobMonitor records when each background job loop last completed a run, so
+// readiness checks can tell a stalled or crashed worker from a healthy one.
+// A nil monitor ignores every call.
+type JobMonitor struct {
+	mu   sync.RWMutex
+	jobs map[string]*JobStatus
+}
+
+// NewJobMonitor creates an empty job monitor
+func NewJobMonitor() *JobMonitor {
+	return &JobMonitor{jobs: make(map[string]*JobStatus)}
+}
+
+// Register records that the loop for job started and runs every interval
+func (m *JobMonitor) Register(job string, interval time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[job] = &JobStatus{Name: job, Interval: interval, StartedAt: time.Now()}
+}
+
+// Ran records that job completed a run, whether or not the run succeeded
+func (m *JobMonitor) Ran(job string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if status, ok := m.jobs[job]; ok {
+		status.LastRun = time.Now()
+	}
+}
+
+// Statuses returns the registered jobs by name. A job is stalled when it
+// hasn't completed a run for twice its interval.
+func (m *JobMonitor) Statuses() []JobStatus {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	statuses := make([]JobStatus, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		status := *job
+		last := status.LastRun
+		if last.IsZero() {
+			last = status.StartedAt
+		}
+		status.Stalled = time.Since(last) > 2*status.Interval+jobStallGrace
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
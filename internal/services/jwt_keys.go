@@ -0,0 +1,290 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// jwtKeyReloadInterval is how often keys rotated on other instances are picked up
+	jwtKeyReloadInterval = time.Minute
+	// jwtKeyMissReloadInterval limits reloads triggered by tokens with an unknown kid
+	jwtKeyMissReloadInterval = 5 * time.Second
+)
+
+var (
+	// ErrJWTKeyNotFound is returned when retiring a key that doesn't exist or is already retired
+	ErrJWTKeyNotFound = errors.New("JWT signing key not found")
+	// ErrJWTKeyInUse is returned when retiring the key new tokens are signed with
+	ErrJWTKeyInUse = errors.New("JWT signing key is still used for signing")
+	// ErrNoJWTKey is returned when there is no key to sign tokens with
+	ErrNoJWTKey = errors.New("no active JWT signing key")
+)
+
+// JWTKeyRing holds the keys JWTs are signed and verified with. New tokens are
+// signed with the most recently added active key and carry its ID in the kid
+// header; tokens signed with any active key are accepted, so rotating the key
+// doesn't sign everyone out. Keys come from JWT_SECRET and JWT_KEYS, or are
+// generated by an admin rotation and stored in the database.
+type JWTKeyRing struct {
+	db  *gorm.DB
+	cfg config.JWTConfig
+
+	mu       sync.RWMutex
+	secrets  map[string][]byte
+	signing  string
+	loadedAt time.Time
+}
+
+// NewJWTKeyRing creates a key ring for the configured keys and those stored in db
+func NewJWTKeyRing(db *gorm.DB, cfg config.JWTConfig) *JWTKeyRing {
+	return &JWTKeyRing{db: db, cfg: cfg}
+}
+
+// configSecrets returns the secrets of the configured keys by key ID
+func (r *JWTKeyRing) configSecrets() map[string]string {
+	secrets := make(map[string]string, len(r.cfg.Keys)+1)
+	if r.cfg.Secret != "" {
+		secrets[config.JWTConfigKeyID] = r.cfg.Secret
+	}
+	for _, key := range r.cfg.Keys {
+		secrets[key.ID] = key.Secret
+	}
+	return secrets
+}
+
+// Sync records the configured keys, retires configured keys that were removed
+// from the configuration and loads the ring. A key added to JWT_KEYS becomes
+// the signing key from the first start that sees it.
+func (r *JWTKeyRing) Sync() error {
+	configured := r.configSecrets()
+
+	// JWT_SECRET predates JWT_KEYS, so it is registered first
+	ids := make([]string, 0, len(configured))
+	if r.cfg.Secret != "" {
+		ids = append(ids, config.JWTConfigKeyID)
+	}
+	for _, key := range r.cfg.Keys {
+		ids = append(ids, key.ID)
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			// Space the creation times so the configured order decides which key is newest
+			key := models.JWTSigningKey{
+				KID:       id,
+				Source:    models.JWTKeySourceConfig,
+				CreatedAt: time.Now().Add(time.Duration(i-len(ids)) * time.Millisecond),
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&key).Error; err != nil {
+				return fmt.Errorf("failed to register JWT key %s: %w", id, err)
+			}
+		}
+
+		query := tx.Model(&models.JWTSigningKey{}).
+			Where("source = ? AND retired_at IS NULL", models.JWTKeySourceConfig)
+		if len(ids) > 0 {
+			query = query.Where("kid NOT IN ?", ids)
+		}
+		if err := query.Update("retired_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to retire removed JWT keys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.load()
+}
+
+// load reads the active keys and picks the signing key
+func (r *JWTKeyRing) load() error {
+	var keys []models.JWTSigningKey
+	if err := r.db.Where("retired_at IS NULL").Order("created_at DESC, id DESC").Find(&keys).Error; err != nil {
+		return fmt.Errorf("failed to load JWT keys: %w", err)
+	}
+
+	configured := r.configSecrets()
+	secrets := make(map[string][]byte, len(keys))
+	signing := ""
+	for _, key := range keys {
+		secret := key.Secret
+		if key.Source == models.JWTKeySourceConfig {
+			secret = configured[key.KID]
+		}
+		// A configured key can be registered by another instance that has it
+		// while this one doesn't
+		if secret == "" {
+			continue
+		}
+		secrets[key.KID] = []byte(secret)
+		if signing == "" {
+			signing = key.KID
+		}
+	}
+
+	r.mu.Lock()
+	r.secrets = secrets
+	r.signing = signing
+	r.loadedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+// reloadIfOlder reloads the ring when it was loaded longer than age ago.
+// A failed reload keeps the keys already loaded.
+func (r *JWTKeyRing) reloadIfOlder(age time.Duration) {
+	r.mu.RLock()
+	stale := time.Since(r.loadedAt) > age
+	r.mu.RUnlock()
+	if stale {
+		_ = r.load()
+	}
+}
+
+// Sign signs claims with the signing key and sets the kid header
+func (r *JWTKeyRing) Sign(claims jwt.Claims) (string, error) {
+	r.reloadIfOlder(jwtKeyReloadInterval)
+
+	r.mu.RLock()
+	kid, secret := r.signing, r.secrets[r.signing]
+	r.mu.RUnlock()
+	if kid == "" {
+		return "", ErrNoJWTKey
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(secret)
+}
+
+// KeyFunc resolves the key a token was signed with for jwt.Parse. Tokens
+// without a kid, issued before key rotation existed, are checked against
+// every active key.
+func (r *JWTKeyRing) KeyFunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		r.reloadIfOlder(jwtKeyReloadInterval)
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		set := jwt.VerificationKeySet{}
+		for _, secret := range r.secrets {
+			set.Keys = append(set.Keys, secret)
+		}
+		return set, nil
+	}
+
+	if secret, ok := r.secret(kid); ok {
+		return secret, nil
+	}
+	// The key may have been rotated in on another instance
+	r.reloadIfOlder(jwtKeyMissReloadInterval)
+	if secret, ok := r.secret(kid); ok {
+		return secret, nil
+	}
+	return nil, fmt.Errorf("unknown JWT key %q", kid)
+}
+
+func (r *JWTKeyRing) secret(kid string) ([]byte, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	secret, ok := r.secrets[kid]
+	return secret, ok
+}
+
+// List returns every key, newest first, marking the signing key
+func (r *JWTKeyRing) List() ([]models.JWTSigningKey, error) {
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	keys := []models.JWTSigningKey{}
+	if err := r.db.Order("created_at DESC, id DESC").Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("failed to list JWT keys: %w", err)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := range keys {
+		keys[i].Signing = keys[i].KID == r.signing
+	}
+	return keys, nil
+}
+
+// Rotate generates a new key and makes it the signing key. Tokens signed with
+// the previous keys stay valid until those keys are retired.
+func (r *JWTKeyRing) Rotate() (*models.JWTSigningKey, error) {
+	secret := make([]byte, 32)
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate JWT key: %w", err)
+	}
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("failed to generate JWT key ID: %w", err)
+	}
+
+	now := time.Now()
+	key := models.JWTSigningKey{
+		KID:       now.UTC().Format("20060102150405") + "-" + hex.EncodeToString(suffix),
+		Secret:    hex.EncodeToString(secret),
+		Source:    models.JWTKeySourceRotation,
+		CreatedAt: now,
+	}
+	if err := r.db.Create(&key).Error; err != nil {
+		return nil, fmt.Errorf("failed to store JWT key: %w", err)
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	key.Signing = true
+	return &key, nil
+}
+
+// Retire stops accepting tokens signed with the key. The signing key can't
+// be retired; rotate first.
+func (r *JWTKeyRing) Retire(kid string) (*models.JWTSigningKey, error) {
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	var key models.JWTSigningKey
+	if err := r.db.Where("kid = ? AND retired_at IS NULL", kid).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrJWTKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to load JWT key: %w", err)
+	}
+
+	r.mu.RLock()
+	signing := r.signing
+	r.mu.RUnlock()
+	if key.KID == signing {
+		return nil, ErrJWTKeyInUse
+	}
+
+	now := time.Now()
+	key.RetiredAt = &now
+	if err := r.db.Model(&key).Update("retired_at", now).Error; err != nil {
+		return nil, fmt.Errorf("failed to retire JWT key: %w", err)
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
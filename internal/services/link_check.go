@@ -0,0 +1,384 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// linkCheckConcurrency is how many domains are checked at the same time;
+	// links on one domain are always checked one after another
+	linkCheckConcurrency = 4
+	// linkCheckUserAgent identifies the link checker to the sites it visits
+	linkCheckUserAgent = "Mozilla/5.0 (compatible; LinkChecker/1.0)"
+	// maxLinkCheckBody limits how much of a GET response is read
+	maxLinkCheckBody = 64 << 10 // 64 KiB
+	// maxCheckedLinkLength leaves out URLs too long to be real links, which
+	// also keeps them within what the unique index can hold
+	maxCheckedLinkLength = 2048
+)
+
+// ErrLinkCheckRunning is returned when a link check is started while one is
+// already running
+var ErrLinkCheckRunning = errors.New("a link check is already running")
+
+// linkCheckRunning is held while a link check runs, so the background job and
+// manual runs don't check the same links twice
+var linkCheckRunning sync.Mutex
+
+// outboundLinkPattern matches absolute http and https URLs in Markdown and HTML
+var outboundLinkPattern = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `\[\]{}|\\^]+`)
+
+// errNonPublicAddress is returned for links to loopback, private and other
+// addresses that aren't on the public internet
+var errNonPublicAddress = errors.New("refusing to connect to a non-public address")
+
+// LinkCheckResult is the outcome of a link check run
+type LinkCheckResult struct {
+	Links   int // Outbound links in published content
+	Checked int // Links checked in this run
+	Broken  int // Checked links that were broken
+}
+
+// LinkCheckService finds the outbound links in published posts and news
+// articles and checks that they still work. Each run checks the links that
+// were checked longest ago, pausing between requests to the same domain.
+type LinkCheckService struct {
+	db         *gorm.DB
+	cfg        config.LinkCheckConfig
+	siteHost   string
+	httpClient *http.Client
+}
+
+// NewLinkCheckService creates a new link check service
+func NewLinkCheckService(db *gorm.DB, cfg *config.Config) *LinkCheckService {
+	var siteHost string
+	if siteURL, err := url.Parse(cfg.Newsletter.SiteURL); err == nil {
+		siteHost = strings.ToLower(siteURL.Hostname())
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.LinkCheck.Timeout, Control: refuseNonPublic}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+
+	return &LinkCheckService{
+		db:       db,
+		cfg:      cfg.LinkCheck,
+		siteHost: siteHost,
+		httpClient: &http.Client{
+			Timeout:   cfg.LinkCheck.Timeout,
+			Transport: tracing.Transport(transport),
+		},
+	}
+}
+
+// refuseNonPublic keeps links in content from reaching the server's own
+// network
+func refuseNonPublic(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return errNonPublicAddress
+	}
+	return nil
+}
+
+// Start runs a link check in the background and reports whether it started,
+// which it doesn't when a check is already running
+func (s *LinkCheckService) Start() bool {
+	if !linkCheckRunning.TryLock() {
+		return false
+	}
+
+	go func() {
+		defer linkCheckRunning.Unlock()
+		result, err := s.run(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("Link check failed")
+			return
+		}
+		log.Info().Int("links", result.Links).Int("checked", result.Checked).Int("broken", result.Broken).Msg("Checked links")
+	}()
+	return true
+}
+
+// Run updates the links found in published content and checks the ones
+// that are due. It returns ErrLinkCheckRunning when a check is already
+// running.
+func (s *LinkCheckService) Run(ctx context.Context) (LinkCheckResult, error) {
+	if !linkCheckRunning.TryLock() {
+		return LinkCheckResult{}, ErrLinkCheckRunning
+	}
+	defer linkCheckRunning.Unlock()
+
+	return s.run(ctx)
+}
+
+func (s *LinkCheckService) run(ctx context.Context) (LinkCheckResult, error) {
+	var result LinkCheckResult
+
+	links, err := s.sync()
+	if err != nil {
+		return result, err
+	}
+	result.Links = links
+
+	result.Checked, result.Broken, err = s.checkDue(ctx)
+	return result, err
+}
+
+// linkKey identifies a link in a piece of content
+type linkKey struct {
+	sourceType models.ContentLinkSource
+	sourceID   uint
+	url        string
+}
+
+// sync records the links in published content that are new and forgets the
+// ones that were edited out or whose content is no longer published. It
+// returns the number of links.
+func (s *LinkCheckService) sync() (int, error) {
+	found := make(map[linkKey]string)
+
+	var posts []models.Post
+	if err := s.db.Select("id", "content").Where("status = ?", models.PostStatusPublished).
+		FindInBatches(&posts, 200, func(_ *gorm.DB, _ int) error {
+			for _, post := range posts {
+				s.collectLinks(found, models.ContentLinkSourcePost, post.ID, post.Content)
+			}
+			return nil
+		}).Error; err != nil {
+		return 0, fmt.Errorf("failed to load posts: %w", err)
+	}
+
+	var news []models.News
+	if err := s.db.Select("id", "content").Where("status = ? AND published = ?", models.NewsStatusPublished, true).
+		FindInBatches(&news, 200, func(_ *gorm.DB, _ int) error {
+			for _, article := range news {
+				s.collectLinks(found, models.ContentLinkSourceNews, article.ID, article.Content)
+			}
+			return nil
+		}).Error; err != nil {
+		return 0, fmt.Errorf("failed to load news: %w", err)
+	}
+
+	var existing []models.ContentLink
+	if err := s.db.Select("id", "source_type", "source_id", "url").Find(&existing).Error; err != nil {
+		return 0, fmt.Errorf("failed to load links: %w", err)
+	}
+
+	var stale []uint
+	for _, link := range existing {
+		key := linkKey{link.SourceType, link.SourceID, link.URL}
+		if _, ok := found[key]; ok {
+			delete(found, key)
+		} else {
+			stale = append(stale, link.ID)
+		}
+	}
+
+	if len(stale) > 0 {
+		if err := s.db.Where("id IN ?", stale).Delete(&models.ContentLink{}).Error; err != nil {
+			return 0, fmt.Errorf("failed to remove links: %w", err)
+		}
+	}
+
+	added := make([]models.ContentLink, 0, len(found))
+	for key, domain := range found {
+		added = append(added, models.ContentLink{
+			SourceType: key.sourceType,
+			SourceID:   key.sourceID,
+			URL:        key.url,
+			Domain:     domain,
+		})
+	}
+	if len(added) > 0 {
+		if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&added, 500).Error; err != nil {
+			return 0, fmt.Errorf("failed to add links: %w", err)
+		}
+	}
+
+	return len(existing) - len(stale) + len(added), nil
+}
+
+// collectLinks adds the outbound links in content to found, with their
+// domain. Links to the site itself aren't outbound and are left out.
+func (s *LinkCheckService) collectLinks(found map[linkKey]string, sourceType models.ContentLinkSource, sourceID uint, content string) {
+	for _, link := range extractLinks(content) {
+		if len(link) > maxCheckedLinkLength {
+			continue
+		}
+		parsed, err := url.Parse(link)
+		if err != nil || parsed.Hostname() == "" {
+			continue
+		}
+		domain := strings.ToLower(parsed.Hostname())
+		if domain == s.siteHost {
+			continue
+		}
+		found[linkKey{sourceType, sourceID, link}] = domain
+	}
+}
+
+// extractLinks returns the absolute URLs in Markdown or HTML content,
+// without the punctuation that follows a link in prose
+func extractLinks(content string) []string {
+	var links []string
+	seen := make(map[string]bool)
+	for _, link := range outboundLinkPattern.FindAllString(content, -1) {
+		link = strings.ReplaceAll(link, "&amp;", "&")
+		link = strings.TrimRight(link, ".,;:!?*_~")
+		// A closing parenthesis belongs to the link only when it opened one,
+		// as in Wikipedia URLs, and otherwise closes a Markdown link
+		for strings.HasSuffix(link, ")") && strings.Count(link, "(") < strings.Count(link, ")") {
+			link = strings.TrimSuffix(link, ")")
+		}
+		if !seen[link] {
+			seen[link] = true
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// checkDue checks the links that were checked longest ago, up to the batch
+// size. A URL used in several places is requested once. It returns the
+// number of links checked and how many of them were broken.
+func (s *LinkCheckService) checkDue(ctx context.Context) (checked, broken int, err error) {
+	var due []models.ContentLink
+	if err := s.db.Select("id", "url", "domain").Order("checked_at ASC NULLS FIRST").Order("id ASC").
+		Limit(s.cfg.BatchSize).Find(&due).Error; err != nil {
+		return 0, 0, fmt.Errorf("failed to load due links: %w", err)
+	}
+
+	// Group the links by domain, then by URL
+	byDomain := make(map[string]map[string][]uint)
+	for _, link := range due {
+		if byDomain[link.Domain] == nil {
+			byDomain[link.Domain] = make(map[string][]uint)
+		}
+		byDomain[link.Domain][link.URL] = append(byDomain[link.Domain][link.URL], link.ID)
+	}
+
+	domains := make(chan map[string][]uint)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for range min(linkCheckConcurrency, len(byDomain)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for urls := range domains {
+				for link, ids := range urls {
+					ok, err := s.checkURL(ctx, link, ids)
+					if err != nil {
+						if ctx.Err() == nil {
+							log.Error().Err(err).Str("url", link).Msg("Failed to record link check")
+						}
+						continue
+					}
+
+					mu.Lock()
+					checked += len(ids)
+					if !ok {
+						broken += len(ids)
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, urls := range byDomain {
+		domains <- urls
+	}
+	close(domains)
+	wg.Wait()
+
+	return checked, broken, ctx.Err()
+}
+
+// checkURL requests link once its domain may be visited again and records
+// the outcome on the links with ids. It reports whether the link works.
+func (s *LinkCheckService) checkURL(ctx context.Context, link string, ids []uint) (bool, error) {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false, err
+	}
+	if err := waitForHost(ctx, parsed.Host, s.cfg.DomainDelay, 0); err != nil {
+		return false, err
+	}
+
+	status, fetchErr := s.fetchStatus(ctx, link)
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	// Servers that rate limit the checker haven't said the link is gone
+	isBroken := fetchErr != nil || (status >= 400 && status != http.StatusTooManyRequests)
+
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status_code": status,
+		"error":       "",
+		"broken":      isBroken,
+		"checked_at":  now,
+		"broken_at":   nil,
+	}
+	if fetchErr != nil {
+		updates["error"] = fetchErr.Error()
+	}
+	if isBroken {
+		updates["broken_at"] = gorm.Expr("COALESCE(broken_at, ?)", now)
+	}
+	if err := s.db.Model(&models.ContentLink{}).Where("id IN ?", ids).Updates(updates).Error; err != nil {
+		return false, err
+	}
+	return !isBroken, nil
+}
+
+// fetchStatus returns the HTTP status of link after redirects. It tries a
+// HEAD request first and falls back to GET for servers that don't answer
+// HEAD properly.
+func (s *LinkCheckService) fetchStatus(ctx context.Context, link string) (int, error) {
+	status, err := s.request(ctx, http.MethodHead, link)
+	if err == nil && status < 400 {
+		return status, nil
+	}
+	return s.request(ctx, http.MethodGet, link)
+}
+
+func (s *LinkCheckService) request(ctx context.Context, method, link string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", linkCheckUserAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxLinkCheckBody))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxSuggestionCandidates bounds the published posts compared with a
+	// post, most recent first
+	maxSuggestionCandidates = 500
+	// suggestionTagWeight is what each shared tag adds to a suggestion's score
+	suggestionTagWeight = 1.0
+	// suggestionKeywordWeight scales the keyword similarity, which ranges from
+	// 0 to 1, so posts about the same thing rank above posts that merely share
+	// a couple of tags
+	suggestionKeywordWeight = 5.0
+	// minKeywordSimilarity is the keyword similarity a post without shared
+	// tags needs to be suggested
+	minKeywordSimilarity = 0.05
+	// maxSuggestionKeywords caps the shared keywords listed per suggestion
+	maxSuggestionKeywords = 5
+)
+
+// LinkSuggestionService suggests published posts a post could link to, for
+// internal linking. Posts are ranked by the tags they share with it and by
+// keyword overlap, weighting words by how rare they are across the posts so
+// words every post uses count for little.
+type LinkSuggestionService struct {
+	db      *gorm.DB
+	siteURL string
+}
+
+// NewLinkSuggestionService creates a new link suggestion service. Suggested
+// posts are linked at siteURL/posts/<slug>.
+func NewLinkSuggestionService(db *gorm.DB, siteURL string) *LinkSuggestionService {
+	return &LinkSuggestionService{db: db, siteURL: siteURL}
+}
+
+// suggestionCandidate is a published post with the words it uses
+type suggestionCandidate struct {
+	post  models.Post
+	terms map[string]bool
+}
+
+// Suggest returns up to limit published posts that post could link to, best
+// first. Posts it already links to aren't suggested.
+func (s *LinkSuggestionService) Suggest(post *models.Post, limit int) ([]models.LinkSuggestion, error) {
+	var posts []models.Post
+	if err := s.db.Select("id", "title", "slug", "excerpt", "content").Preload("Tags").
+		Where("status = ? AND id != ?", models.PostStatusPublished, post.ID).
+		Order("COALESCE(publish_at, created_at) DESC").
+		Limit(maxSuggestionCandidates).
+		Find(&posts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load published posts: %w", err)
+	}
+
+	candidates := make([]suggestionCandidate, 0, len(posts))
+	documentFrequency := make(map[string]int)
+	for _, published := range posts {
+		if strings.Contains(post.Content, "/posts/"+published.Slug) {
+			continue
+		}
+		terms := suggestionTerms(published.Title + " " + published.Excerpt + " " + published.Content)
+		for term := range terms {
+			documentFrequency[term]++
+		}
+		candidates = append(candidates, suggestionCandidate{post: published, terms: terms})
+	}
+
+	idf := func(term string) float64 {
+		return math.Log(1 + float64(len(candidates))/float64(documentFrequency[term]+1))
+	}
+
+	postTerms := suggestionTerms(post.Title + " " + post.Excerpt + " " + post.Content)
+	postNorm := 0.0
+	for term := range postTerms {
+		postNorm += idf(term) * idf(term)
+	}
+	postTags := make(map[string]bool, len(post.Tags))
+	for _, tag := range post.Tags {
+		postTags[strings.ToLower(tag.Name)] = true
+	}
+
+	suggestions := []models.LinkSuggestion{}
+	for _, candidate := range candidates {
+		sharedTags := []string{}
+		for _, tag := range candidate.post.Tags {
+			if postTags[strings.ToLower(tag.Name)] {
+				sharedTags = append(sharedTags, tag.Name)
+			}
+		}
+
+		// Cosine similarity of the IDF weighted words of the two posts
+		var shared []string
+		dot, candidateNorm := 0.0, 0.0
+		for term := range candidate.terms {
+			weight := idf(term)
+			candidateNorm += weight * weight
+			if postTerms[term] {
+				dot += weight * weight
+				shared = append(shared, term)
+			}
+		}
+		similarity := 0.0
+		if dot > 0 {
+			similarity = dot / math.Sqrt(postNorm*candidateNorm)
+		}
+
+		if len(sharedTags) == 0 && similarity < minKeywordSimilarity {
+			continue
+		}
+
+		sort.Slice(shared, func(i, j int) bool {
+			if idf(shared[i]) != idf(shared[j]) {
+				return idf(shared[i]) > idf(shared[j])
+			}
+			return shared[i] < shared[j]
+		})
+		keywords := shared[:min(len(shared), maxSuggestionKeywords)]
+
+		score := float64(len(sharedTags))*suggestionTagWeight + similarity*suggestionKeywordWeight
+		suggestions = append(suggestions, models.LinkSuggestion{
+			PostID:     candidate.post.ID,
+			Title:      candidate.post.Title,
+			Slug:       candidate.post.Slug,
+			URL:        s.siteURL + "/posts/" + candidate.post.Slug,
+			SharedTags: sharedTags,
+			Keywords:   append([]string{}, keywords...),
+			Score:      math.Round(score*100) / 100,
+		})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// suggestionTerms returns the distinct words of text worth matching on:
+// links, stop words, numbers and words shorter than three letters are left
+// out
+func suggestionTerms(text string) map[string]bool {
+	text = outboundLinkPattern.ReplaceAllString(text, " ")
+
+	terms := make(map[string]bool)
+	for _, term := range tokenizeForClassifier(text) {
+		if len([]rune(term)) < 3 || strings.IndexFunc(term, unicode.IsLetter) < 0 {
+			continue
+		}
+		terms[term] = true
+	}
+	return terms
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// maxMentionsPerComment limits how many users one comment can notify
+const maxMentionsPerComment = 10
+
+// mentionPattern matches @username where the @ doesn't follow a word
+// character, so email addresses aren't taken for mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([\w.-]+)`)
+
+// ParseMentions returns the usernames mentioned in content with @username, in
+// order of first appearance and at most maxMentionsPerComment of them
+func ParseMentions(content string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		// Punctuation ending a sentence isn't part of the username
+		username := strings.TrimRight(match[1], ".-")
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+		if len(usernames) == maxMentionsPerComment {
+			break
+		}
+	}
+	return usernames
+}
+
+// MentionService keeps the mention records of comments in step with their
+// content
+type MentionService struct {
+	db *gorm.DB
+}
+
+// NewMentionService creates a new mention service
+func NewMentionService(db *gorm.DB) *MentionService {
+	return &MentionService{db: db}
+}
+
+// Sync resolves the @username mentions in comment's content to users and
+// replaces the comment's mention records with them. Usernames without an
+// account are left as plain text, and authors mentioning themselves aren't
+// recorded. It returns the IDs of users who weren't mentioned before, who are
+// the ones to notify.
+func (s *MentionService) Sync(comment *models.Comment) ([]uint, error) {
+	var userIDs []uint
+	if usernames := ParseMentions(comment.Content); len(usernames) > 0 {
+		if err := s.db.Model(&models.User{}).
+			Where("username IN ? AND id <> ?", usernames, comment.UserID).
+			Pluck("id", &userIDs).Error; err != nil {
+			return nil, fmt.Errorf("failed to resolve mentioned users: %w", err)
+		}
+	}
+
+	var added []uint
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var existing []uint
+		if err := tx.Model(&models.CommentMention{}).Where("comment_id = ?", comment.ID).
+			Pluck("user_id", &existing).Error; err != nil {
+			return fmt.Errorf("failed to load mentions: %w", err)
+		}
+		mentioned := make(map[uint]bool, len(existing))
+		for _, id := range existing {
+			mentioned[id] = true
+		}
+
+		removed := tx.Where("comment_id = ?", comment.ID)
+		if len(userIDs) > 0 {
+			removed = removed.Where("user_id NOT IN ?", userIDs)
+		}
+		if err := removed.Delete(&models.CommentMention{}).Error; err != nil {
+			return fmt.Errorf("failed to remove mentions: %w", err)
+		}
+
+		var mentions []models.CommentMention
+		for _, id := range userIDs {
+			if !mentioned[id] {
+				mentions = append(mentions, models.CommentMention{CommentID: comment.ID, UserID: id})
+				added = append(added, id)
+			}
+		}
+		if len(mentions) == 0 {
+			return nil
+		}
+		if err := tx.Create(&mentions).Error; err != nil {
+			return fmt.Errorf("failed to save mentions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
@@ -7,18 +7,19 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
 	"github.com/phanvantai/taiphanvan_backend/internal/config"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"github.com/rs/zerolog/log"
 )
 
 // NewsService handles interactions with external news APIs
 type NewsService struct {
 	cfg        config.NewsAPIConfig
+	taxonomy   *NewsTaxonomy
 	httpClient *http.Client
 }
 
@@ -41,22 +42,26 @@ type NewsAPIResponse struct {
 	} `json:"articles"`
 }
 
-// NewNewsService creates a new NewsAPI service
-func NewNewsService(cfg config.NewsAPIConfig) (*NewsService, error) {
+// NewNewsService creates a new NewsAPI service. The taxonomy decides which
+// categories are fetched by default and how search results are classified.
+func NewNewsService(cfg config.NewsAPIConfig, taxonomy *NewsTaxonomy) (*NewsService, error) {
 	if cfg.APIKey == "" {
 		return nil, fmt.Errorf("missing NewsAPI API key")
 	}
 
 	return &NewsService{
-		cfg: cfg,
+		cfg:      cfg,
+		taxonomy: taxonomy,
 		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   10 * time.Second,
+			Transport: tracing.Transport(nil),
 		},
 	}, nil
 }
 
-// FetchNews fetches news articles from the NewsAPI
-func (s *NewsService) FetchNews(ctx context.Context, categories []models.NewsCategory, limit int) ([]models.News, error) {
+// FetchNews fetches news articles from the NewsAPI.
+// Categories that fail are skipped and reported in the returned source errors.
+func (s *NewsService) FetchNews(ctx context.Context, categories []models.NewsCategory, limit int) ([]models.News, []models.IngestionSourceError) {
 	if limit <= 0 {
 		limit = 10 // Default limit
 	}
@@ -67,18 +72,15 @@ func (s *NewsService) FetchNews(ctx context.Context, categories []models.NewsCat
 	}
 
 	var allNews []models.News
+	var sourceErrors []models.IngestionSourceError
 
-	// If no categories specified, use all available categories
+	// If no categories specified, use the enabled categories NewsAPI knows about
 	if len(categories) == 0 {
-		categories = []models.NewsCategory{
-			//models.NewsCategoryGeneral,
-			//models.NewsCategoryBusiness,
-			models.NewsCategoryTechnology,
-			models.NewsCategoryScience,
-			// models.NewsCategoryHealth,
-			// models.NewsCategorySports,
-			// models.NewsCategoryEntertainment,
-		}
+		categories = s.taxonomy.NewsAPISlugs()
+	}
+	if len(categories) == 0 {
+		log.Warn().Msg("No enabled news categories are available on NewsAPI, nothing to fetch")
+		return nil, nil
 	}
 
 	// Fetch news for each category
@@ -86,13 +88,14 @@ func (s *NewsService) FetchNews(ctx context.Context, categories []models.NewsCat
 		categoryNews, err := s.fetchNewsByCategory(ctx, string(category), limit/len(categories))
 		if err != nil {
 			log.Error().Err(err).Str("category", string(category)).Msg("Failed to fetch news for category")
+			sourceErrors = append(sourceErrors, models.IngestionSourceError{Source: string(category), Error: err.Error()})
 			continue // Continue with other categories
 		}
 
 		allNews = append(allNews, categoryNews...)
 	}
 
-	return allNews, nil
+	return allNews, sourceErrors
 }
 
 // fetchNewsByCategory fetches news articles by category
@@ -183,6 +186,7 @@ func (s *NewsService) fetchNewsByCategory(ctx context.Context, category string,
 			ImageURL:    article.URLToImage,
 			Category:    newsCategory,
 			Status:      models.NewsStatusPublished,
+			Language:    models.LanguageEnglish,
 			Published:   true,
 			PublishDate: article.PublishedAt,
 			ExternalID:  externalID,
@@ -259,7 +263,7 @@ func (s *NewsService) SearchNews(ctx context.Context, query string, limit int) (
 
 	for _, article := range newsResp.Articles {
 		// Determine category based on content analysis
-		category := determineCategory(article.Title, article.Description)
+		category := s.taxonomy.Classify(article.Title, article.Description)
 
 		// Generate a unique slug
 		titleSlug := slug.Make(article.Title)
@@ -296,6 +300,7 @@ func (s *NewsService) SearchNews(ctx context.Context, query string, limit int) (
 			ImageURL:    article.URLToImage,
 			Category:    category,
 			Status:      models.NewsStatusPublished,
+			Language:    models.LanguageEnglish,
 			Published:   true,
 			PublishDate: article.PublishedAt,
 			ExternalID:  externalID,
@@ -310,40 +315,38 @@ func (s *NewsService) SearchNews(ctx context.Context, query string, limit int) (
 	return news, nil
 }
 
-// determineCategory attempts to determine the category of a news article based on its content
-func determineCategory(title, description string) models.NewsCategory {
-	text := strings.ToLower(title + " " + description)
-
-	categoryKeywords := map[models.NewsCategory][]string{
-		models.NewsCategoryTechnology: {"tech", "technology", "software", "hardware", "app", "computer", "digital", "cyber", "ai", "artificial intelligence", "machine learning", "robot"},
-		//models.NewsCategoryBusiness:      {"business", "company", "economy", "market", "stock", "finance", "investment", "startup", "entrepreneur"},
-		models.NewsCategoryScience: {"science", "research", "study", "discovery", "space", "physics", "chemistry", "biology", "astronomy"},
-		//models.NewsCategoryHealth:        {"health", "medical", "disease", "virus", "doctor", "hospital", "medicine", "covid", "vaccine", "treatment"},
-		//models.NewsCategorySports:        {"sport", "game", "team", "player", "football", "soccer", "baseball", "basketball", "tennis", "golf", "olympics"},
-		//models.NewsCategoryEntertainment: {"entertainment", "movie", "film", "music", "celebrity", "actor", "actress", "tv", "show", "concert", "festival", "award"},
+// VerifyAPIKey makes a minimal request to check that NewsAPI accepts the configured key
+func (s *NewsService) VerifyAPIKey(ctx context.Context) error {
+	apiURL, err := url.Parse(s.cfg.BaseURL + "/top-headlines")
+	if err != nil {
+		return fmt.Errorf("failed to parse API URL: %w", err)
 	}
 
-	// Count keyword matches for each category
-	categoryScores := make(map[models.NewsCategory]int)
+	params := url.Values{}
+	params.Add("apiKey", s.cfg.APIKey)
+	params.Add("language", "en")
+	params.Add("pageSize", "1")
+	apiURL.RawQuery = params.Encode()
 
-	for category, keywords := range categoryKeywords {
-		for _, keyword := range keywords {
-			if strings.Contains(text, keyword) {
-				categoryScores[category]++
-			}
-		}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Find category with highest score
-	var bestCategory models.NewsCategory = models.NewsCategoryTechnology
-	highestScore := 0
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
 
-	for category, score := range categoryScores {
-		if score > highestScore {
-			highestScore = score
-			bestCategory = category
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
 		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("API returned status %d: %s %s", resp.StatusCode, apiErr.Code, apiErr.Message)
 	}
 
-	return bestCategory
+	return nil
 }
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// newsBulkChunkSize is how many articles a bulk operation changes in one
+// transaction, so a bad chunk doesn't undo the whole request
+const newsBulkChunkSize = 100
+
+// NewsBulkChange is an article a bulk operation changed, as it was before and
+// after. After is empty for deleted articles.
+type NewsBulkChange struct {
+	Before models.News
+	After  models.News
+}
+
+// NewsBulkService changes the status of, or deletes, many news articles at
+// once, such as when cleaning up fetched articles
+type NewsBulkService struct {
+	db *gorm.DB
+}
+
+// NewNewsBulkService creates a new bulk news service
+func NewNewsBulkService(db *gorm.DB) *NewsBulkService {
+	return &NewsBulkService{db: db}
+}
+
+// SetStatus gives the articles with ids status, like setting it on each of
+// them: published articles are made visible and a publish date older than a
+// day is moved to now. It returns the outcome per article in the order
+// requested and the articles that changed.
+func (s *NewsBulkService) SetStatus(ids []uint, status models.NewsStatus) (*models.NewsBulkResult, []NewsBulkChange) {
+	return s.run(ids, func(tx *gorm.DB, articles []models.News) ([]NewsBulkChange, error) {
+		now := time.Now()
+		var changes []NewsBulkChange
+		var changedIDs []uint
+		for _, article := range articles {
+			if article.Status == status {
+				continue
+			}
+			after := article
+			after.Status = status
+			after.Published = status == models.NewsStatusPublished
+			after.UpdatedAt = now
+			if after.Published && after.PublishDate.Before(now.AddDate(0, 0, -1)) {
+				after.PublishDate = now
+			}
+			changes = append(changes, NewsBulkChange{Before: article, After: after})
+			changedIDs = append(changedIDs, article.ID)
+		}
+		if len(changedIDs) == 0 {
+			return nil, nil
+		}
+
+		if err := tx.Model(&models.News{}).Where("id IN ?", changedIDs).Updates(map[string]interface{}{
+			"status":     status,
+			"published":  status == models.NewsStatusPublished,
+			"updated_at": now,
+		}).Error; err != nil {
+			return nil, fmt.Errorf("failed to update news status: %w", err)
+		}
+		if status == models.NewsStatusPublished {
+			if err := tx.Model(&models.News{}).
+				Where("id IN ? AND publish_date < ?", changedIDs, now.AddDate(0, 0, -1)).
+				UpdateColumn("publish_date", now).Error; err != nil {
+				return nil, fmt.Errorf("failed to update news publish date: %w", err)
+			}
+		}
+		return changes, nil
+	}, models.NewsBulkUpdated)
+}
+
+// Delete deletes the articles with ids and their tag links, like deleting
+// each of them. It returns the outcome per article in the order requested and
+// the articles that were deleted.
+func (s *NewsBulkService) Delete(ids []uint) (*models.NewsBulkResult, []NewsBulkChange) {
+	return s.run(ids, func(tx *gorm.DB, articles []models.News) ([]NewsBulkChange, error) {
+		articleIDs := make([]uint, len(articles))
+		changes := make([]NewsBulkChange, len(articles))
+		for i, article := range articles {
+			articleIDs[i] = article.ID
+			changes[i] = NewsBulkChange{Before: article}
+		}
+
+		if err := tx.Exec("DELETE FROM news_tags WHERE news_id IN ?", articleIDs).Error; err != nil {
+			return nil, fmt.Errorf("failed to delete news tags: %w", err)
+		}
+		if err := tx.Where("id IN ?", articleIDs).Delete(&models.News{}).Error; err != nil {
+			return nil, fmt.Errorf("failed to delete news: %w", err)
+		}
+		return changes, nil
+	}, models.NewsBulkDeleted)
+}
+
+// run applies change to the articles with ids, a chunk per transaction. The
+// articles change reports are marked done and the other existing articles of
+// a saved chunk unchanged; every article of a chunk that failed is marked
+// failed.
+func (s *NewsBulkService) run(ids []uint, change func(tx *gorm.DB, articles []models.News) ([]NewsBulkChange, error), done models.NewsBulkItemStatus) (*models.NewsBulkResult, []NewsBulkChange) {
+	// An article listed twice is only changed once
+	seen := make(map[uint]bool, len(ids))
+	unique := ids[:0:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	ids = unique
+
+	result := &models.NewsBulkResult{Items: make([]models.NewsBulkItem, len(ids))}
+	var changes []NewsBulkChange
+	for start := 0; start < len(ids); start += newsBulkChunkSize {
+		end := start + newsBulkChunkSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+		items := result.Items[start:end]
+
+		var chunkChanges []NewsBulkChange
+		found := make(map[uint]bool, len(chunk))
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var articles []models.News
+			if err := tx.Where("id IN ?", chunk).Find(&articles).Error; err != nil {
+				return fmt.Errorf("failed to load news articles: %w", err)
+			}
+			for _, article := range articles {
+				found[article.ID] = true
+			}
+			if len(articles) == 0 {
+				return nil
+			}
+
+			var err error
+			chunkChanges, err = change(tx, articles)
+			return err
+		})
+
+		changed := make(map[uint]bool, len(chunkChanges))
+		for _, c := range chunkChanges {
+			changed[c.Before.ID] = true
+		}
+		for i, id := range chunk {
+			items[i].NewsID = id
+			switch {
+			case err != nil:
+				items[i].Status = models.NewsBulkFailed
+				items[i].Error = err.Error()
+				result.Failed++
+			case !found[id]:
+				items[i].Status = models.NewsBulkNotFound
+				result.NotFound++
+			case changed[id]:
+				items[i].Status = done
+				result.Succeeded++
+			default:
+				items[i].Status = models.NewsBulkUnchanged
+				result.Succeeded++
+			}
+		}
+		if err != nil {
+			log.Error().Err(err).Int("articles", len(chunk)).Msg("Failed to apply bulk news change")
+			continue
+		}
+		changes = append(changes, chunkChanges...)
+	}
+	return result, changes
+}
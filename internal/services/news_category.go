@@ -0,0 +1,261 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrNewsCategoryNotFound is returned when changing a category that doesn't exist
+	ErrNewsCategoryNotFound = errors.New("news category not found")
+	// ErrNewsCategoryExists is returned when a slug is already taken
+	ErrNewsCategoryExists = errors.New("news category already exists")
+	// ErrInvalidNewsCategorySlug is returned for slugs that aren't lowercase words joined by hyphens
+	ErrInvalidNewsCategorySlug = errors.New("slug must contain only lowercase letters, digits and hyphens")
+)
+
+// newsCategorySlugPattern matches slugs such as "technology" or "climate-change"
+var newsCategorySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// newsAPICategories are the categories NewsAPI's top-headlines endpoint accepts
+var newsAPICategories = map[models.NewsCategory]bool{
+	"business":      true,
+	"entertainment": true,
+	"general":       true,
+	"health":        true,
+	"science":       true,
+	"sports":        true,
+	"technology":    true,
+}
+
+// NewsCategoryService reads the news category taxonomy
+type NewsCategoryService struct {
+	db *gorm.DB
+}
+
+// NewNewsCategoryService creates a new news category service
+func NewNewsCategoryService(db *gorm.DB) *NewsCategoryService {
+	return &NewsCategoryService{db: db}
+}
+
+// All returns every category, enabled or not, in creation order
+func (s *NewsCategoryService) All() ([]models.NewsCategoryModel, error) {
+	categories := []models.NewsCategoryModel{}
+	if err := s.db.Order("id ASC").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to load news categories: %w", err)
+	}
+	return categories, nil
+}
+
+// Taxonomy returns a snapshot of the enabled categories with a classifier
+// trained on their keyword hints and the most recent corrections
+func (s *NewsCategoryService) Taxonomy() (*NewsTaxonomy, error) {
+	var categories []models.NewsCategoryModel
+	if err := s.db.Where("enabled = ?", true).Order("id ASC").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to load news categories: %w", err)
+	}
+
+	var examples []models.NewsCategoryExample
+	if err := s.db.Order("created_at DESC").Limit(maxTrainingExamples).Find(&examples).Error; err != nil {
+		return nil, fmt.Errorf("failed to load category corrections: %w", err)
+	}
+
+	return NewNewsTaxonomy(categories, examples), nil
+}
+
+// Exists reports whether a category with the given slug exists, enabled or not
+func (s *NewsCategoryService) Exists(slug models.NewsCategory) (bool, error) {
+	var count int64
+	if err := s.db.Model(&models.NewsCategoryModel{}).Where("slug = ?", slug).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to look up news category: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Create adds a category
+func (s *NewsCategoryService) Create(req models.CreateNewsCategoryRequest) (*models.NewsCategoryModel, error) {
+	slug, err := normalizeNewsCategorySlug(req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureSlugAvailable(s.db, slug, 0); err != nil {
+		return nil, err
+	}
+
+	category := models.NewsCategoryModel{
+		Slug:     slug,
+		Name:     strings.TrimSpace(req.Name),
+		Enabled:  true,
+		Keywords: normalizeKeywords(req.Keywords),
+	}
+	if req.Enabled != nil {
+		category.Enabled = *req.Enabled
+	}
+
+	if err := s.db.Create(&category).Error; err != nil {
+		return nil, fmt.Errorf("failed to create news category: %w", err)
+	}
+	return &category, nil
+}
+
+// Update changes a category. Renaming the slug moves existing articles to the
+// new slug in the same transaction.
+func (s *NewsCategoryService) Update(id uint, req models.UpdateNewsCategoryRequest) (*models.NewsCategoryModel, error) {
+	var category models.NewsCategoryModel
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&category, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNewsCategoryNotFound
+			}
+			return fmt.Errorf("failed to load news category: %w", err)
+		}
+
+		oldSlug := category.Slug
+		if req.Slug != nil {
+			slug, err := normalizeNewsCategorySlug(*req.Slug)
+			if err != nil {
+				return err
+			}
+			if err := s.ensureSlugAvailable(tx, slug, category.ID); err != nil {
+				return err
+			}
+			category.Slug = slug
+		}
+		if req.Name != nil {
+			category.Name = strings.TrimSpace(*req.Name)
+		}
+		if req.Enabled != nil {
+			category.Enabled = *req.Enabled
+		}
+		if req.Keywords != nil {
+			category.Keywords = normalizeKeywords(req.Keywords)
+		}
+
+		if err := tx.Save(&category).Error; err != nil {
+			return fmt.Errorf("failed to update news category: %w", err)
+		}
+
+		if category.Slug != oldSlug {
+			if err := tx.Model(&models.News{}).Unscoped().
+				Where("category = ?", oldSlug).
+				Update("category", category.Slug).Error; err != nil {
+				return fmt.Errorf("failed to move news to renamed category: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// ensureSlugAvailable returns ErrNewsCategoryExists if another category uses slug
+func (s *NewsCategoryService) ensureSlugAvailable(db *gorm.DB, slug models.NewsCategory, exceptID uint) error {
+	var count int64
+	if err := db.Model(&models.NewsCategoryModel{}).
+		Where("slug = ? AND id <> ?", slug, exceptID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check news category slug: %w", err)
+	}
+	if count > 0 {
+		return ErrNewsCategoryExists
+	}
+	return nil
+}
+
+// normalizeNewsCategorySlug lowercases and validates a slug
+func normalizeNewsCategorySlug(raw string) (models.NewsCategory, error) {
+	slug := strings.ToLower(strings.TrimSpace(raw))
+	if !newsCategorySlugPattern.MatchString(slug) {
+		return "", ErrInvalidNewsCategorySlug
+	}
+	return models.NewsCategory(slug), nil
+}
+
+// normalizeKeywords lowercases keywords and drops blanks and duplicates
+func normalizeKeywords(keywords []string) []string {
+	normalized := make([]string, 0, len(keywords))
+	seen := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		seen[keyword] = true
+		normalized = append(normalized, keyword)
+	}
+	return normalized
+}
+
+// NewsTaxonomy is a snapshot of the enabled news categories used while fetching
+// and classifying articles
+type NewsTaxonomy struct {
+	categories []models.NewsCategoryModel
+	classifier *NewsClassifier
+}
+
+// NewNewsTaxonomy creates a taxonomy from enabled categories and the recorded
+// corrections. The first category is the default for articles that can't be
+// classified.
+func NewNewsTaxonomy(categories []models.NewsCategoryModel, examples []models.NewsCategoryExample) *NewsTaxonomy {
+	return &NewsTaxonomy{
+		categories: categories,
+		classifier: NewNewsClassifier(categories, examples),
+	}
+}
+
+// Slugs returns the slugs of the enabled categories
+func (t *NewsTaxonomy) Slugs() []models.NewsCategory {
+	slugs := make([]models.NewsCategory, 0, len(t.categories))
+	for _, category := range t.categories {
+		slugs = append(slugs, category.Slug)
+	}
+	return slugs
+}
+
+// NewsAPISlugs returns the enabled categories that NewsAPI can fetch directly.
+// Other categories are only filled by classification.
+func (t *NewsTaxonomy) NewsAPISlugs() []models.NewsCategory {
+	var slugs []models.NewsCategory
+	for _, category := range t.categories {
+		if newsAPICategories[category.Slug] {
+			slugs = append(slugs, category.Slug)
+		}
+	}
+	return slugs
+}
+
+// Default returns the category for articles that match no keywords
+func (t *NewsTaxonomy) Default() models.NewsCategory {
+	if len(t.categories) == 0 {
+		return models.NewsCategoryTechnology
+	}
+	return t.categories[0].Slug
+}
+
+// Resolve maps a category name from a feed or request to an enabled category,
+// matching slugs and names case-insensitively. It returns false if nothing matches.
+func (t *NewsTaxonomy) Resolve(name string) (models.NewsCategory, bool) {
+	name = strings.TrimSpace(name)
+	for _, category := range t.categories {
+		if strings.EqualFold(string(category.Slug), name) || strings.EqualFold(category.Name, name) {
+			return category.Slug, true
+		}
+	}
+	return "", false
+}
+
+// Classify picks the enabled category that best matches the article's title
+// and description, or the default category if nothing matches
+func (t *NewsTaxonomy) Classify(title, description string) models.NewsCategory {
+	if category, ok := t.classifier.Classify(title + " " + description); ok {
+		return category
+	}
+	return t.Default()
+}
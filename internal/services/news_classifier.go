@@ -0,0 +1,153 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// keywordHintWeight is how many occurrences an admin keyword hint counts as,
+	// so hints steer classification before there are corrections to learn from
+	keywordHintWeight = 3
+	// maxTrainingExamples bounds the corrections loaded into the classifier
+	maxTrainingExamples = 5000
+)
+
+// classifierStopWords are frequent words that say nothing about the category
+var classifierStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "for": true, "from": true, "has": true, "have": true, "in": true, "is": true,
+	"it": true, "its": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "were": true, "will": true, "with": true,
+}
+
+// NewsClassifier assigns articles to categories using TF-IDF weighted terms.
+// Each category's terms come from its keyword hints and from articles admins
+// moved into it, so terms that are common to every category count for little
+// and classification improves as corrections accumulate.
+type NewsClassifier struct {
+	// weights holds the TF-IDF weight of each term per category
+	weights map[models.NewsCategory]map[string]float64
+	// order is the category order used to break ties
+	order []models.NewsCategory
+}
+
+// NewNewsClassifier trains a classifier for categories from their keyword hints
+// and the recorded corrections. Examples for other categories are ignored.
+func NewNewsClassifier(categories []models.NewsCategoryModel, examples []models.NewsCategoryExample) *NewsClassifier {
+	counts := make(map[models.NewsCategory]map[string]int, len(categories))
+	order := make([]models.NewsCategory, 0, len(categories))
+	for _, category := range categories {
+		terms := make(map[string]int)
+		for _, keyword := range category.Keywords {
+			for _, term := range tokenizeForClassifier(keyword) {
+				terms[term] += keywordHintWeight
+			}
+		}
+		counts[category.Slug] = terms
+		order = append(order, category.Slug)
+	}
+
+	for _, example := range examples {
+		terms, ok := counts[example.Category]
+		if !ok {
+			continue
+		}
+		for _, term := range tokenizeForClassifier(example.Text) {
+			terms[term]++
+		}
+	}
+
+	// Document frequency: the number of categories each term appears in
+	documentFrequency := make(map[string]int)
+	for _, terms := range counts {
+		for term := range terms {
+			documentFrequency[term]++
+		}
+	}
+
+	weights := make(map[models.NewsCategory]map[string]float64, len(counts))
+	for slug, terms := range counts {
+		total := 0
+		for _, count := range terms {
+			total += count
+		}
+
+		categoryWeights := make(map[string]float64, len(terms))
+		for term, count := range terms {
+			tf := float64(count) / float64(total)
+			idf := math.Log(1 + float64(len(counts))/float64(documentFrequency[term]))
+			categoryWeights[term] = tf * idf
+		}
+		weights[slug] = categoryWeights
+	}
+
+	return &NewsClassifier{weights: weights, order: order}
+}
+
+// Classify returns the best-scoring category for the text, and false if no
+// category shares any terms with it
+func (c *NewsClassifier) Classify(text string) (models.NewsCategory, bool) {
+	termCounts := make(map[string]int)
+	for _, term := range tokenizeForClassifier(text) {
+		termCounts[term]++
+	}
+
+	var best models.NewsCategory
+	bestScore := 0.0
+	for _, slug := range c.order {
+		score := 0.0
+		for term, count := range termCounts {
+			score += float64(count) * c.weights[slug][term]
+		}
+		if score > bestScore {
+			bestScore = score
+			best = slug
+		}
+	}
+
+	return best, bestScore > 0
+}
+
+// tokenizeForClassifier lowercases text and splits it into words, dropping
+// stop words and single characters
+func tokenizeForClassifier(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := words[:0]
+	for _, word := range words {
+		if len([]rune(word)) < 2 || classifierStopWords[word] {
+			continue
+		}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// RecordCorrection stores an article that an admin moved from previous to its
+// current category as a training example for the classifier. Only the latest
+// correction of each article is kept.
+func (s *NewsCategoryService) RecordCorrection(news *models.News, previous models.NewsCategory) error {
+	example := models.NewsCategoryExample{
+		NewsID:   news.ID,
+		Category: news.Category,
+		Previous: previous,
+		Text:     news.Title + " " + news.Summary,
+	}
+
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "news_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"category", "previous", "text", "created_at"}),
+	}).Create(&example).Error
+	if err != nil {
+		return fmt.Errorf("failed to record category correction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// commentaryExcerptLength is the most characters quoted from an article
+const commentaryExcerptLength = 400
+
+// NewsCommentary is the starting point of a blog post commenting on a news article
+type NewsCommentary struct {
+	Title   string
+	Content string
+	Excerpt string
+}
+
+// BuildNewsCommentary drafts a link blog post for news: a quoted excerpt, an
+// attribution block crediting the original source and a link back to the news
+// item. The content is Markdown; commentary, if given, follows the attribution.
+func BuildNewsCommentary(news *models.News, title, commentary string) NewsCommentary {
+	if title == "" {
+		title = news.Title
+	}
+
+	excerpt := news.Summary
+	if excerpt == "" {
+		excerpt = news.Content
+	}
+	excerpt = strings.TrimSpace(excerpt)
+	if quoted := truncateRunes(excerpt, commentaryExcerptLength); quoted != excerpt {
+		excerpt = strings.TrimSpace(quoted) + "…"
+	}
+
+	var content strings.Builder
+
+	// Quote the excerpt, one blockquote line per line of text
+	for _, line := range strings.Split(excerpt, "\n") {
+		content.WriteString(strings.TrimRight("> "+line, " ") + "\n")
+	}
+	content.WriteString("\n")
+
+	// Attribution block
+	if news.SourceURL != "" {
+		fmt.Fprintf(&content, "Source: [%s](%s)", news.Title, news.SourceURL)
+	} else {
+		fmt.Fprintf(&content, "Source: %s", news.Title)
+	}
+	if news.Source != "" {
+		fmt.Fprintf(&content, " (%s)", news.Source)
+	}
+	content.WriteString("\n\n")
+	fmt.Fprintf(&content, "[Read the news item](/news/%s)\n", news.Slug)
+
+	if commentary = strings.TrimSpace(commentary); commentary != "" {
+		content.WriteString("\n" + commentary + "\n")
+	}
+
+	return NewsCommentary{
+		Title:   title,
+		Content: content.String(),
+		Excerpt: excerpt,
+	}
+}
@@ -10,16 +10,18 @@ import (
 type NewsConfig struct {
 	APIConfig       config.NewsAPIConfig
 	RSSConfig       config.RSSConfig
+	Ingestion       config.NewsIngestionConfig
 	DefaultLimit    int
 	FetchInterval   time.Duration
 	EnableAutoFetch bool
 }
 
 // NewNewsConfig creates a new NewsConfig from the application config
-func NewNewsConfig(apiCfg config.NewsAPIConfig, rssCfg config.RSSConfig) NewsConfig {
+func NewNewsConfig(apiCfg config.NewsAPIConfig, rssCfg config.RSSConfig, ingestionCfg config.NewsIngestionConfig) NewsConfig {
 	return NewsConfig{
 		APIConfig:       apiCfg,
 		RSSConfig:       rssCfg,
+		Ingestion:       ingestionCfg,
 		DefaultLimit:    apiCfg.DefaultLimit,
 		FetchInterval:   apiCfg.FetchInterval,
 		EnableAutoFetch: apiCfg.EnableAutoFetch,
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// newsEnrichmentWorkers is how many source pages a batch scrapes at once
+const newsEnrichmentWorkers = 4
+
+// NewsEnrichmentService scrapes the full content of truncated news articles
+// from their source pages and stores it next to the article
+type NewsEnrichmentService struct {
+	db      *gorm.DB
+	scraper *ContentScraper
+}
+
+// NewNewsEnrichmentService creates a new news enrichment service
+func NewNewsEnrichmentService(db *gorm.DB) *NewsEnrichmentService {
+	return &NewsEnrichmentService{
+		db:      db,
+		scraper: NewContentScraper(NewSiteSettingsService(db)),
+	}
+}
+
+// Enrich scrapes the full content of news and saves the outcome, replacing
+// the article's previous enrichment. A page that can't be fetched is recorded
+// in FetchError rather than returned as an error.
+func (s *NewsEnrichmentService) Enrich(ctx context.Context, news *models.News) (*models.EnrichedNewsContent, error) {
+	enriched, err := s.scraper.EnrichNewsContent(ctx, news)
+	if err != nil {
+		return nil, err
+	}
+
+	var record models.EnrichedNewsContent
+	err = s.db.Where("news_id = ?", news.ID).First(&record).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to load enriched content: %w", err)
+	}
+
+	now := time.Now()
+	if err == gorm.ErrRecordNotFound {
+		record = models.EnrichedNewsContent{
+			NewsID:             news.ID,
+			OriginalContent:    news.Content,
+			SourceURL:          news.SourceURL,
+			TruncationDetected: now,
+		}
+	}
+	record.FullContent = enriched.FullContent
+	record.Byline = enriched.Byline
+	record.LeadImage = enriched.LeadImage
+	record.IsTruncated = enriched.IsTruncated
+	record.TruncatedChars = enriched.TruncatedChars
+	record.TruncationPattern = enriched.TruncationPattern
+	record.FetchError = enriched.FetchError
+	record.LastFetched = now
+
+	if err := s.db.Save(&record).Error; err != nil {
+		return nil, fmt.Errorf("failed to save enriched content: %w", err)
+	}
+	return &record, nil
+}
+
+// EnrichBatch enriches the articles with the given IDs, or when there are
+// none, up to limit of the newest truncated articles that were never
+// enriched. With retryFailed, articles whose last attempt failed are picked
+// up as well.
+func (s *NewsEnrichmentService) EnrichBatch(ctx context.Context, ids []uint, limit int, retryFailed bool) (*models.NewsEnrichmentBatchResult, error) {
+	if len(ids) == 0 {
+		var err error
+		if ids, err = s.pendingIDs(limit, retryFailed); err != nil {
+			return nil, err
+		}
+	}
+
+	// An article listed twice would be scraped twice at the same time
+	seen := make(map[uint]bool, len(ids))
+	unique := ids[:0:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	ids = unique
+
+	var articles []models.News
+	if len(ids) > 0 {
+		if err := s.db.Where("id IN ?", ids).Find(&articles).Error; err != nil {
+			return nil, fmt.Errorf("failed to load news articles: %w", err)
+		}
+	}
+	found := make(map[uint]int, len(articles))
+	for i, article := range articles {
+		found[article.ID] = i
+	}
+
+	result := &models.NewsEnrichmentBatchResult{Items: make([]models.NewsEnrichmentItem, len(ids))}
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, newsEnrichmentWorkers)
+	for i, id := range ids {
+		result.Items[i].NewsID = id
+		index, ok := found[id]
+		if !ok {
+			result.Items[i].Status = models.NewsEnrichmentNotFound
+			continue
+		}
+
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(item *models.NewsEnrichmentItem, article *models.News) {
+			defer wg.Done()
+			defer func() { <-workers }()
+
+			record, err := s.Enrich(ctx, article)
+			switch {
+			case err != nil:
+				item.Status = models.NewsEnrichmentFailed
+				item.Error = err.Error()
+			case !record.IsTruncated:
+				item.Status = models.NewsEnrichmentNotTruncated
+			case record.FullContent == "":
+				item.Status = models.NewsEnrichmentFailed
+				item.Error = record.FetchError
+			default:
+				item.Status = models.NewsEnrichmentEnriched
+			}
+		}(&result.Items[i], &articles[index])
+	}
+	wg.Wait()
+
+	for _, item := range result.Items {
+		switch item.Status {
+		case models.NewsEnrichmentEnriched:
+			result.Enriched++
+		case models.NewsEnrichmentFailed:
+			result.Failed++
+		default:
+			result.Skipped++
+		}
+	}
+	log.Info().
+		Int("requested", len(ids)).
+		Int("enriched", result.Enriched).
+		Int("failed", result.Failed).
+		Msg("News enrichment batch finished")
+	return result, nil
+}
+
+// Status counts the articles by where they are in enrichment
+func (s *NewsEnrichmentService) Status() (*models.NewsEnrichmentStatus, error) {
+	var status models.NewsEnrichmentStatus
+
+	if err := s.db.Model(&models.News{}).Count(&status.Total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count news articles: %w", err)
+	}
+	if err := s.db.Model(&models.News{}).Scopes(truncatedNews).Count(&status.Truncated).Error; err != nil {
+		return nil, fmt.Errorf("failed to count truncated articles: %w", err)
+	}
+	if err := s.db.Model(&models.News{}).Scopes(truncatedNews).
+		Joins("LEFT JOIN enriched_news_contents ON enriched_news_contents.news_id = news.id").
+		Where("enriched_news_contents.id IS NULL").Count(&status.Pending).Error; err != nil {
+		return nil, fmt.Errorf("failed to count pending articles: %w", err)
+	}
+
+	// Records of deleted articles are left out
+	records := func() *gorm.DB {
+		return s.db.Model(&models.EnrichedNewsContent{}).
+			Joins("JOIN news ON news.id = enriched_news_contents.news_id AND news.deleted_at IS NULL")
+	}
+	if err := records().Where("enriched_news_contents.full_content <> ''").Count(&status.Enriched).Error; err != nil {
+		return nil, fmt.Errorf("failed to count enriched articles: %w", err)
+	}
+	if err := records().Where("enriched_news_contents.full_content = '' AND enriched_news_contents.fetch_error <> ''").
+		Count(&status.Failed).Error; err != nil {
+		return nil, fmt.Errorf("failed to count failed articles: %w", err)
+	}
+
+	var lastFetched struct{ Last *time.Time }
+	if err := records().Select("MAX(enriched_news_contents.last_fetched) AS last").Scan(&lastFetched).Error; err != nil {
+		return nil, fmt.Errorf("failed to read last enrichment: %w", err)
+	}
+	status.LastEnrichedAt = lastFetched.Last
+	return &status, nil
+}
+
+// pendingIDs returns up to limit of the newest truncated articles that were
+// never enriched, or whose last attempt failed when retryFailed is set
+func (s *NewsEnrichmentService) pendingIDs(limit int, retryFailed bool) ([]uint, error) {
+	condition := "enriched_news_contents.id IS NULL"
+	if retryFailed {
+		condition = "(" + condition + " OR (enriched_news_contents.full_content = '' AND enriched_news_contents.fetch_error <> ''))"
+	}
+
+	var ids []uint
+	if err := s.db.Model(&models.News{}).Scopes(truncatedNews).
+		Joins("LEFT JOIN enriched_news_contents ON enriched_news_contents.news_id = news.id").
+		Where(condition).
+		Order("news.publish_date DESC").
+		Limit(limit).
+		Pluck("news.id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find articles to enrich: %w", err)
+	}
+	return ids, nil
+}
+
+// truncatedNews matches the articles IsTruncated reports as cut off
+func truncatedNews(db *gorm.DB) *gorm.DB {
+	conditions := make([]string, len(truncationPatterns))
+	args := make([]interface{}, len(truncationPatterns))
+	for i, pattern := range truncationPatterns {
+		conditions[i] = "news.content LIKE ?"
+		args[i] = "%" + pattern + "%"
+	}
+	return db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// ApplyAdminNewsFilter adds the conditions of filter to a query on the news table
+func ApplyAdminNewsFilter(db *gorm.DB, filter models.AdminNewsFilter) *gorm.DB {
+	if filter.Status != "" {
+		db = db.Where("news.status = ?", filter.Status)
+	}
+	if filter.Source != "" {
+		db = db.Where("news.source = ?", filter.Source)
+	}
+	if filter.Category != "" {
+		db = db.Where("news.category = ?", filter.Category)
+	}
+	if filter.Tag != "" {
+		db = db.Where("EXISTS (SELECT 1 FROM news_tags JOIN tags ON tags.id = news_tags.tag_id WHERE news_tags.news_id = news.id AND tags.name = ?)", filter.Tag)
+	}
+	if filter.Search != "" {
+		searchTerm := "%" + filter.Search + "%"
+		db = db.Where("news.title ILIKE ? OR news.content ILIKE ? OR news.summary ILIKE ?", searchTerm, searchTerm, searchTerm)
+	}
+	if filter.From != nil {
+		db = db.Where("news.publish_date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		db = db.Where("news.publish_date < ?", *filter.To)
+	}
+	if filter.HasImage != nil {
+		if *filter.HasImage {
+			db = db.Where("news.image_url <> ''")
+		} else {
+			db = db.Where("news.image_url = '' OR news.image_url IS NULL")
+		}
+	}
+	if filter.Truncated != nil {
+		condition, args := truncatedContentCondition()
+		if *filter.Truncated {
+			db = db.Where(condition, args...)
+		} else {
+			db = db.Not(condition, args...)
+		}
+	}
+	return db
+}
+
+// truncatedContentCondition matches the same articles as IsTruncated does
+func truncatedContentCondition() (string, []interface{}) {
+	conditions := make([]string, 0, len(truncationPatterns))
+	args := make([]interface{}, 0, len(truncationPatterns))
+	for _, pattern := range truncationPatterns {
+		conditions = append(conditions, "strpos(news.content, ?) > 0")
+		args = append(args, pattern)
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxKeywordWords is the longest phrase that can become a tag
+	maxKeywordWords = 3
+	// maxKeywordLength matches the longest tag name the database allows
+	maxKeywordLength = 50
+	// keywordTitleWeight multiplies the score of phrases found in the title,
+	// which names the subject more reliably than the summary
+	keywordTitleWeight = 2.0
+)
+
+// keywordStopWords split titles and summaries into candidate keyword phrases.
+// They are the classifier's stop words plus the function words, dates and
+// stock verbs and adjectives of news prose that never make useful tags.
+var keywordStopWords = map[string]bool{
+	"announce": true, "announced": true, "announces": true, "big": true, "latest": true,
+	"major": true, "report": true, "reported": true, "reports": true, "reveal": true,
+	"reveals": true, "today": true, "top": true, "unveil": true, "unveiled": true, "unveils": true,
+	"monday": true, "tuesday": true, "wednesday": true, "thursday": true, "friday": true,
+	"saturday": true, "sunday": true, "january": true, "february": true, "march": true,
+	"april": true, "june": true, "july": true, "august": true, "september": true,
+	"october": true, "november": true, "december": true,
+	"about": true, "after": true, "again": true, "against": true, "all": true, "also": true,
+	"am": true, "among": true, "amid": true, "another": true, "any": true, "back": true,
+	"been": true, "before": true, "being": true, "between": true, "both": true, "but": true,
+	"can": true, "could": true, "did": true, "do": true, "does": true, "down": true,
+	"during": true, "each": true, "even": true, "ever": true, "every": true, "few": true,
+	"first": true, "get": true, "gets": true, "had": true, "he": true, "her": true,
+	"here": true, "him": true, "his": true, "how": true, "if": true, "into": true,
+	"just": true, "last": true, "like": true, "made": true, "make": true, "makes": true,
+	"many": true, "may": true, "more": true, "most": true, "much": true, "must": true,
+	"new": true, "next": true, "no": true, "not": true, "now": true, "off": true,
+	"old": true, "one": true, "only": true, "other": true, "our": true, "out": true,
+	"over": true, "own": true, "said": true, "say": true, "says": true, "see": true,
+	"she": true, "should": true, "since": true, "so": true, "some": true, "still": true,
+	"such": true, "than": true, "their": true, "them": true, "then": true, "there": true,
+	"these": true, "they": true, "those": true, "through": true, "too": true, "two": true,
+	"under": true, "up": true, "us": true, "very": true, "via": true, "want": true,
+	"way": true, "we": true, "week": true, "what": true, "when": true, "where": true,
+	"which": true, "while": true, "who": true, "why": true, "would": true, "year": true,
+	"years": true, "yet": true, "you": true, "your": true,
+}
+
+// isKeywordStopWord reports whether word ends a candidate keyword phrase
+func isKeywordStopWord(word string) bool {
+	return classifierStopWords[word] || keywordStopWords[word]
+}
+
+// keywordCandidate is a phrase that may become a tag
+type keywordCandidate struct {
+	words   []string
+	inTitle bool
+	order   int
+}
+
+// ExtractKeywords picks up to limit keywords from an article's title and
+// summary with RAKE (rapid automatic keyword extraction): the text is split
+// into phrases at stop words and punctuation, each word scores its number of
+// co-occurring words over its frequency, and a phrase scores the sum of its
+// words. Phrases covered by a better keyword are skipped, so the keywords
+// don't repeat each other.
+func ExtractKeywords(title, summary string, limit int) []string {
+	if limit <= 0 {
+		return []string{}
+	}
+
+	candidates := map[string]*keywordCandidate{}
+	frequency := map[string]int{}
+	degree := map[string]int{}
+	for _, part := range []struct {
+		text    string
+		inTitle bool
+	}{{title, true}, {summary, false}} {
+		for _, phrase := range keywordPhrases(part.text) {
+			for _, word := range phrase {
+				frequency[word]++
+				degree[word] += len(phrase)
+			}
+			// Headlines rarely have stop words to split them, so long
+			// phrases offer their words instead
+			subphrases := [][]string{phrase}
+			if len(phrase) > maxKeywordWords {
+				subphrases = subphrases[:0]
+				for i := range phrase {
+					subphrases = append(subphrases, phrase[i:i+1])
+				}
+			}
+			for _, subphrase := range subphrases {
+				key := strings.Join(subphrase, " ")
+				if candidate, ok := candidates[key]; ok {
+					candidate.inTitle = candidate.inTitle || part.inTitle
+					continue
+				}
+				candidates[key] = &keywordCandidate{words: subphrase, inTitle: part.inTitle, order: len(candidates)}
+			}
+		}
+	}
+
+	type scoredKeyword struct {
+		keyword   string
+		candidate *keywordCandidate
+		score     float64
+	}
+	scored := make([]scoredKeyword, 0, len(candidates))
+	for keyword, candidate := range candidates {
+		if len(keyword) > maxKeywordLength {
+			continue
+		}
+		score := 0.0
+		for _, word := range candidate.words {
+			score += float64(degree[word]) / float64(frequency[word])
+		}
+		if candidate.inTitle {
+			score *= keywordTitleWeight
+		}
+		scored = append(scored, scoredKeyword{keyword: keyword, candidate: candidate, score: score})
+	}
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].candidate.order < scored[j].candidate.order
+	})
+
+	keywords := []string{}
+	covered := map[string]bool{}
+	for _, keyword := range scored {
+		if len(keywords) == limit {
+			break
+		}
+		fresh := false
+		for _, word := range keyword.candidate.words {
+			if !covered[word] {
+				fresh = true
+			}
+		}
+		if !fresh {
+			continue
+		}
+		for _, word := range keyword.candidate.words {
+			covered[word] = true
+		}
+		keywords = append(keywords, keyword.keyword)
+	}
+	return keywords
+}
+
+// keywordPhrases splits text into lowercase runs of words between stop words
+// and punctuation, dropping possessive 's. Numbers and words shorter than
+// three letters break phrases too, as they rarely make sense as tags.
+func keywordPhrases(text string) [][]string {
+	var phrases [][]string
+	var phrase []string
+	var word strings.Builder
+
+	endPhrase := func() {
+		if len(phrase) > 0 {
+			phrases = append(phrases, phrase)
+			phrase = nil
+		}
+	}
+	endWord := func() {
+		if word.Len() == 0 {
+			return
+		}
+		w := strings.TrimSuffix(strings.Trim(word.String(), "'-"), "'s")
+		word.Reset()
+		if len([]rune(w)) < 3 || isKeywordStopWord(w) || strings.IndexFunc(w, unicode.IsLetter) < 0 {
+			endPhrase()
+			return
+		}
+		phrase = append(phrase, w)
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || ((r == '\'' || r == '-') && word.Len() > 0):
+			word.WriteRune(r)
+		case unicode.IsSpace(r):
+			endWord()
+		default:
+			endWord()
+			endPhrase()
+		}
+	}
+	endWord()
+	endPhrase()
+	return phrases
+}
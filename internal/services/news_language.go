@@ -0,0 +1,130 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// minLanguageMarkers is how many function words must match before a
+	// language is detected from them
+	minLanguageMarkers = 2
+	// minVietnameseLetters is how many letters only Vietnamese uses mark a
+	// text as Vietnamese, whatever its words
+	minVietnameseLetters = 3
+)
+
+// languageMarkers are short, frequent function words that tell Latin script
+// languages apart. Each word is listed for one language only.
+var languageMarkers = map[string][]string{
+	"en": {"the", "and", "of", "to", "is", "in", "for", "with", "that", "on", "are", "was", "from", "by", "its", "this", "has", "have", "will", "after"},
+	"vi": {"của", "và", "là", "những", "các", "được", "trong", "cho", "với", "không", "người", "này", "có", "một", "đã", "khi", "theo", "năm", "tại", "từ"},
+	"fr": {"le", "les", "des", "est", "une", "dans", "pour", "qui", "sur", "avec", "du", "au", "aux", "sont", "par", "cette", "ses", "ont", "été", "leur"},
+	"de": {"der", "die", "und", "das", "ist", "nicht", "mit", "den", "dem", "sich", "auf", "für", "ein", "eine", "auch", "wird", "sind", "nach", "bei", "über"},
+	"es": {"el", "los", "las", "del", "es", "una", "por", "con", "para", "que", "más", "como", "pero", "sus", "fue", "según", "está", "también", "entre", "sobre"},
+	"pt": {"os", "pelas", "dos", "uma", "não", "em", "às", "com", "mais", "foi", "pelo", "pela", "são", "também", "ela", "seu", "sua", "ao", "nos", "pelos"},
+	"it": {"il", "della", "che", "di", "gli", "per", "questa", "sono", "nel", "alla", "dei", "anche", "più", "dal", "questo", "delle", "stato", "non", "ha", "come"},
+	"id": {"yang", "dan", "sudah", "ini", "dengan", "untuk", "dari", "tidak", "akan", "pada", "juga", "dalam", "itu", "adalah", "oleh", "ke", "bisa", "telah", "karena", "kata"},
+	"nl": {"het", "een", "van", "zijn", "niet", "op", "voor", "met", "dat", "ook", "aan", "bij", "naar", "wordt", "door", "maar", "nog", "om", "werd", "deze"},
+}
+
+// languageScripts maps writing systems to the language detected for text
+// mostly written in them
+var languageScripts = []struct {
+	table *unicode.RangeTable
+	lang  string
+}{
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Hangul, "ko"},
+	{unicode.Han, "zh"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+}
+
+// DetectLanguage guesses the language of a news article's text, returning an
+// ISO 639-1 code or "" when the text is too short or mixed to tell. Text in
+// a non-Latin script is detected by its script (Japanese wins over Chinese
+// when kana appear), Vietnamese by the letters only it uses, and the other
+// languages by counting their most frequent function words.
+func DetectLanguage(text string) string {
+	text = strings.ToLower(text)
+
+	letters, latin, vietnamese := 0, 0, 0
+	scripts := map[string]int{}
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Latin, r) {
+			latin++
+			if isVietnameseLetter(r) {
+				vietnamese++
+			}
+			continue
+		}
+		for _, script := range languageScripts {
+			if unicode.Is(script.table, r) {
+				scripts[script.lang]++
+				break
+			}
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+
+	if latin*2 < letters {
+		if scripts["ja"] > 0 {
+			return "ja"
+		}
+		best, bestCount := "", 0
+		for _, script := range languageScripts {
+			if count := scripts[script.lang]; count > bestCount {
+				best, bestCount = script.lang, count
+			}
+		}
+		return best
+	}
+
+	if vietnamese >= minVietnameseLetters {
+		return "vi"
+	}
+
+	words := map[string]int{}
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' }) {
+		words[word]++
+	}
+	best, bestScore, runnerUp := "", 0, 0
+	for lang, markers := range languageMarkers {
+		score := 0
+		for _, marker := range markers {
+			score += words[marker]
+		}
+		switch {
+		case score > bestScore:
+			best, bestScore, runnerUp = lang, score, bestScore
+		case score > runnerUp:
+			runnerUp = score
+		}
+	}
+	if bestScore < minLanguageMarkers || bestScore == runnerUp {
+		return ""
+	}
+	return best
+}
+
+// isVietnameseLetter reports whether r is a lowercase letter that hardly any
+// Latin script language but Vietnamese uses: đ, ơ, ư, ă, ĩ, ũ and the vowels
+// with a tone mark stacked on another diacritic, a hook or a dot below
+func isVietnameseLetter(r rune) bool {
+	switch r {
+	case 'đ', 'ơ', 'ư', 'ă', 'ĩ', 'ũ':
+		return true
+	}
+	// Latin Extended Additional holds the stacked Vietnamese vowels
+	return r >= 'ạ' && r <= 'ỹ'
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// newsRetentionBatchSize is how many articles are expired per transaction
+const newsRetentionBatchSize = 500
+
+// NewsRetentionResult counts the articles expired by one retention run
+type NewsRetentionResult struct {
+	Archived int64
+	Deleted  int64
+}
+
+// NewsRetentionService expires fetched news older than the retention period,
+// so the news tables don't grow without bound. Expired articles are either
+// archived, which keeps the article but drops its enriched content, or deleted
+// outright. Articles written by hand, discussed in a commentary post or picked
+// for the homepage are never expired.
+type NewsRetentionService struct {
+	db  *gorm.DB
+	cfg config.NewsRetentionConfig
+}
+
+// NewNewsRetentionService creates a new news retention service
+func NewNewsRetentionService(db *gorm.DB, cfg config.NewsRetentionConfig) *NewsRetentionService {
+	return &NewsRetentionService{db: db, cfg: cfg}
+}
+
+// Enabled reports whether a retention period is configured
+func (s *NewsRetentionService) Enabled() bool {
+	return s.cfg.Days > 0
+}
+
+// Run expires the articles fetched before the retention period ending at now
+func (s *NewsRetentionService) Run(now time.Time) (NewsRetentionResult, error) {
+	var result NewsRetentionResult
+	if !s.Enabled() {
+		return result, nil
+	}
+
+	cutoff := now.AddDate(0, 0, -s.cfg.Days)
+	for {
+		var ids []uint
+		if err := s.expired(cutoff).Limit(newsRetentionBatchSize).Pluck("news.id", &ids).Error; err != nil {
+			return result, fmt.Errorf("failed to find expired news: %w", err)
+		}
+		if len(ids) == 0 {
+			return result, nil
+		}
+
+		var count int64
+		var err error
+		if s.cfg.Mode == config.NewsRetentionDelete {
+			count, err = s.delete(ids)
+			result.Deleted += count
+		} else {
+			count, err = s.archive(ids)
+			result.Archived += count
+		}
+		if err != nil {
+			return result, err
+		}
+		if len(ids) < newsRetentionBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// expired selects fetched articles created before cutoff that nothing else
+// refers to. Deletion also purges soft-deleted articles; archiving skips
+// articles that are already archived.
+func (s *NewsRetentionService) expired(cutoff time.Time) *gorm.DB {
+	query := s.db.Unscoped().Model(&models.News{}).
+		Where("news.external_id <> '' AND news.created_at < ?", cutoff).
+		Where("NOT EXISTS (SELECT 1 FROM posts WHERE posts.news_id = news.id)").
+		Where("NOT EXISTS (SELECT 1 FROM editorial_picks WHERE editorial_picks.item_type = ? AND editorial_picks.item_id = news.id)", models.FeedItemNews).
+		Order("news.id")
+
+	if s.cfg.Mode != config.NewsRetentionDelete {
+		query = query.Where("news.deleted_at IS NULL AND news.status <> ?", models.NewsStatusArchived)
+	}
+	return query
+}
+
+// archive hides the articles from readers and drops their enriched content
+func (s *NewsRetentionService) archive(ids []uint) (int64, error) {
+	var archived int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("news_id IN ?", ids).Delete(&models.EnrichedNewsContent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete enriched content: %w", err)
+		}
+
+		result := tx.Model(&models.News{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"status":    models.NewsStatusArchived,
+			"published": false,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to archive news: %w", result.Error)
+		}
+		archived = result.RowsAffected
+		return nil
+	})
+	return archived, err
+}
+
+// delete removes the articles with their enriched content and tag links.
+// Category corrections are kept, since they carry their own copy of the text
+// and still train the classifier.
+func (s *NewsRetentionService) delete(ids []uint) (int64, error) {
+	var deleted int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("news_id IN ?", ids).Delete(&models.EnrichedNewsContent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete enriched content: %w", err)
+		}
+		if err := tx.Exec("DELETE FROM news_tags WHERE news_id IN ?", ids).Error; err != nil {
+			return fmt.Errorf("failed to delete news tags: %w", err)
+		}
+
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&models.News{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete news: %w", result.Error)
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	return deleted, err
+}
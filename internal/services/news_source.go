@@ -0,0 +1,216 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrNewsSourceNotFound is returned when changing a source that doesn't exist
+	ErrNewsSourceNotFound = errors.New("news source not found")
+	// ErrNewsSourceExists is returned when a name is already taken
+	ErrNewsSourceExists = errors.New("news source already exists")
+)
+
+// NewsSourceService manages the RSS feeds the news fetcher reads
+type NewsSourceService struct {
+	db *gorm.DB
+}
+
+// NewNewsSourceService creates a new news source service
+func NewNewsSourceService(db *gorm.DB) *NewsSourceService {
+	return &NewsSourceService{db: db}
+}
+
+// All returns every source, enabled or not, in creation order
+func (s *NewsSourceService) All() ([]models.NewsSource, error) {
+	sources := []models.NewsSource{}
+	if err := s.db.Order("id ASC").Find(&sources).Error; err != nil {
+		return nil, fmt.Errorf("failed to load news sources: %w", err)
+	}
+	return sources, nil
+}
+
+// Enabled returns the sources that are fetched, in creation order
+func (s *NewsSourceService) Enabled() ([]models.NewsSource, error) {
+	var sources []models.NewsSource
+	if err := s.db.Where("enabled = ?", true).Order("id ASC").Find(&sources).Error; err != nil {
+		return nil, fmt.Errorf("failed to load news sources: %w", err)
+	}
+	return sources, nil
+}
+
+// Due returns the enabled sources whose fetch interval has passed since their
+// last fetch. Sources without an interval of their own use defaultInterval.
+func (s *NewsSourceService) Due(defaultInterval time.Duration) ([]models.NewsSource, error) {
+	sources, err := s.Enabled()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var due []models.NewsSource
+	for _, source := range sources {
+		if source.LastFetchedAt == nil || now.Sub(*source.LastFetchedAt) >= source.FetchInterval(defaultInterval) {
+			due = append(due, source)
+		}
+	}
+	return due, nil
+}
+
+// Create adds a source
+func (s *NewsSourceService) Create(req models.CreateNewsSourceRequest) (*models.NewsSource, error) {
+	source := models.NewsSource{
+		Name:                 strings.TrimSpace(req.Name),
+		URL:                  strings.TrimSpace(req.URL),
+		Category:             models.NewsCategory(strings.TrimSpace(req.Category)),
+		Enabled:              true,
+		FetchIntervalMinutes: req.FetchIntervalMinutes,
+	}
+	if req.Enabled != nil {
+		source.Enabled = *req.Enabled
+	}
+
+	if err := s.ensureNameAvailable(source.Name, 0); err != nil {
+		return nil, err
+	}
+	if err := s.db.Create(&source).Error; err != nil {
+		return nil, fmt.Errorf("failed to create news source: %w", err)
+	}
+	return &source, nil
+}
+
+// Update changes a source
+func (s *NewsSourceService) Update(id uint, req models.UpdateNewsSourceRequest) (*models.NewsSource, error) {
+	var source models.NewsSource
+	if err := s.db.First(&source, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNewsSourceNotFound
+		}
+		return nil, fmt.Errorf("failed to load news source: %w", err)
+	}
+
+	if req.Name != nil {
+		source.Name = strings.TrimSpace(*req.Name)
+		if err := s.ensureNameAvailable(source.Name, source.ID); err != nil {
+			return nil, err
+		}
+	}
+	if req.URL != nil {
+		source.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.Category != nil {
+		source.Category = models.NewsCategory(strings.TrimSpace(*req.Category))
+	}
+	if req.Enabled != nil {
+		source.Enabled = *req.Enabled
+	}
+	if req.FetchIntervalMinutes != nil {
+		source.FetchIntervalMinutes = *req.FetchIntervalMinutes
+	}
+
+	if err := s.db.Save(&source).Error; err != nil {
+		return nil, fmt.Errorf("failed to update news source: %w", err)
+	}
+	return &source, nil
+}
+
+// Delete removes a source. Articles already fetched from it are kept.
+func (s *NewsSourceService) Delete(id uint) error {
+	result := s.db.Delete(&models.NewsSource{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete news source: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNewsSourceNotFound
+	}
+	return nil
+}
+
+// RecordFetch stores run, a fetch of source, in the fetch history and updates
+// the source's last fetch status. A source becomes unhealthy after
+// news.source_unhealthy_after failures in a row and healthy again after its
+// next successful fetch. It returns the updated source and whether its health
+// changed.
+func (s *NewsSourceService) RecordFetch(source models.NewsSource, run *models.FetchRun) (models.NewsSource, bool, error) {
+	threshold := NewSiteSettingsService(s.db).Int(SettingNewsSourceUnhealthyAfter)
+
+	var healthChanged bool
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(run).Error; err != nil {
+			return fmt.Errorf("failed to record fetch run: %w", err)
+		}
+
+		if err := tx.First(&source, source.ID).Error; err != nil {
+			return fmt.Errorf("failed to load news source: %w", err)
+		}
+
+		source.LastFetchedAt = &run.StartedAt
+		source.LastFetchStatus = run.Status
+		source.LastFetchError = run.Error
+		source.LastFetchCount = run.ItemsFound
+		if run.Status == models.NewsSourceFetchFailed {
+			source.ConsecutiveFailures++
+		} else {
+			source.ConsecutiveFailures = 0
+		}
+		healthy := threshold <= 0 || source.ConsecutiveFailures < threshold
+		healthChanged = healthy != source.Healthy
+		source.Healthy = healthy
+
+		// UpdateColumns keeps updated_at for changes made by admins
+		if err := tx.Model(&source).UpdateColumns(map[string]interface{}{
+			"last_fetched_at":      source.LastFetchedAt,
+			"last_fetch_status":    source.LastFetchStatus,
+			"last_fetch_error":     source.LastFetchError,
+			"last_fetch_count":     source.LastFetchCount,
+			"consecutive_failures": source.ConsecutiveFailures,
+			"healthy":              source.Healthy,
+		}).Error; err != nil {
+			return fmt.Errorf("failed to record news source fetch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return source, false, err
+	}
+	return source, healthChanged, nil
+}
+
+// FinishFetchRuns links fetch runs to the ingestion run that stored their
+// articles and counts the articles each of them saved
+func (s *NewsSourceService) FinishFetchRuns(ids []uint, ingestionRunID uint) error {
+	if len(ids) == 0 || ingestionRunID == 0 {
+		return nil
+	}
+
+	saved := s.db.Model(&models.IngestionItem{}).Select("COUNT(*)").
+		Where("ingestion_items.run_id = ? AND ingestion_items.outcome = ?", ingestionRunID, models.IngestionItemSaved).
+		Where("ingestion_items.source = fetch_runs.source_name")
+	if err := s.db.Model(&models.FetchRun{}).Where("id IN ?", ids).UpdateColumns(map[string]interface{}{
+		"ingestion_run_id": ingestionRunID,
+		"items_saved":      saved,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to update fetch runs: %w", err)
+	}
+	return nil
+}
+
+// ensureNameAvailable returns ErrNewsSourceExists if another source uses name
+func (s *NewsSourceService) ensureNameAvailable(name string, exceptID uint) error {
+	var count int64
+	if err := s.db.Model(&models.NewsSource{}).
+		Where("LOWER(name) = LOWER(?) AND id <> ?", name, exceptID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check news source name: %w", err)
+	}
+	if count > 0 {
+		return ErrNewsSourceExists
+	}
+	return nil
+}
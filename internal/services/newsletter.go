@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrSubscriptionTokenInvalid is returned for unknown and expired
+	// confirmation and unsubscribe tokens
+	ErrSubscriptionTokenInvalid = errors.New("invalid subscription token")
+	// ErrDigestEmpty is returned when no posts were published in the digest period
+	ErrDigestEmpty = errors.New("no posts to send")
+)
+
+// Digest is a newsletter digest ready to be sent
+type Digest struct {
+	Since       time.Time
+	Posts       []models.Post
+	Subscribers []models.Subscriber
+}
+
+// NewsletterService manages newsletter subscriptions with double opt-in and
+// sends digests of recent posts
+type NewsletterService struct {
+	db        *gorm.DB
+	email     *EmailService
+	heartbeat *HeartbeatService
+	cfg       config.NewsletterConfig
+}
+
+// NewNewsletterService creates a newsletter service sending through the SMTP server
+func NewNewsletterService(db *gorm.DB, cfg *config.Config) *NewsletterService {
+	return &NewsletterService{
+		db:        db,
+		email:     NewEmailService(cfg.SMTP),
+		heartbeat: NewHeartbeatService(cfg.Heartbeat),
+		cfg:       cfg.Newsletter,
+	}
+}
+
+// Enabled reports whether email can be sent, without which nobody can confirm
+func (s *NewsletterService) Enabled() bool {
+	return s.email.Enabled()
+}
+
+// Subscribe records the address as pending and emails it a confirmation link.
+// Confirmed addresses are left alone, so the response can't be used to find
+// out who is subscribed.
+func (s *NewsletterService) Subscribe(ctx context.Context, email string) error {
+	if !s.Enabled() {
+		return ErrEmailNotConfigured
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	var subscriber models.Subscriber
+	err := s.db.Where("email = ?", email).First(&subscriber).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		subscriber = models.Subscriber{Email: email, UnsubscribeToken: newSubscriptionToken()}
+	case err != nil:
+		return fmt.Errorf("failed to look up subscriber: %w", err)
+	case subscriber.Status == models.SubscriberConfirmed:
+		return nil
+	}
+
+	token := newSubscriptionToken()
+	expiresAt := time.Now().Add(s.cfg.ConfirmExpiry)
+	subscriber.Status = models.SubscriberPending
+	subscriber.ConfirmTokenHash = hashAPIKey(token) // Random like API keys, so a plain hash is enough
+	subscriber.ConfirmExpiresAt = &expiresAt
+	if err := s.db.Save(&subscriber).Error; err != nil {
+		return fmt.Errorf("failed to save subscriber: %w", err)
+	}
+
+	body := fmt.Sprintf("Thanks for subscribing to the newsletter.\n\n"+
+		"Confirm your subscription by opening this link within %s:\n%s\n\n"+
+		"If you didn't subscribe, ignore this email and you won't hear from us again.\n",
+		s.cfg.ConfirmExpiry, s.cfg.APIURL+"/newsletter/confirm?token="+token)
+	if err := s.email.Send(ctx, email, "Confirm your newsletter subscription", body); err != nil {
+		return fmt.Errorf("failed to send confirmation email: %w", err)
+	}
+	return nil
+}
+
+// Confirm confirms the subscription a confirmation token was sent for
+func (s *NewsletterService) Confirm(token string) (*models.Subscriber, error) {
+	var subscriber models.Subscriber
+	err := s.db.Where("confirm_token_hash = ? AND status = ?", hashAPIKey(token), models.SubscriberPending).
+		First(&subscriber).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSubscriptionTokenInvalid
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up subscriber: %w", err)
+	}
+	if subscriber.ConfirmExpiresAt == nil || time.Now().After(*subscriber.ConfirmExpiresAt) {
+		return nil, ErrSubscriptionTokenInvalid
+	}
+
+	now := time.Now()
+	err = s.db.Model(&subscriber).Updates(map[string]interface{}{
+		"status":             models.SubscriberConfirmed,
+		"confirm_token_hash": "",
+		"confirm_expires_at": nil,
+		"confirmed_at":       now,
+		"unsubscribed_at":    nil,
+	}).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm subscriber: %w", err)
+	}
+	return &subscriber, nil
+}
+
+// Unsubscribe stops digests to the subscriber an unsubscribe token belongs to.
+// Unsubscribing twice succeeds.
+func (s *NewsletterService) Unsubscribe(token string) error {
+	var subscriber models.Subscriber
+	err := s.db.Where("unsubscribe_token = ?", token).First(&subscriber).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrSubscriptionTokenInvalid
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up subscriber: %w", err)
+	}
+	if subscriber.Status == models.SubscriberUnsubscribed {
+		return nil
+	}
+
+	err = s.db.Model(&subscriber).Updates(map[string]interface{}{
+		"status":             models.SubscriberUnsubscribed,
+		"confirm_token_hash": "",
+		"confirm_expires_at": nil,
+		"unsubscribed_at":    time.Now(),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+	return nil
+}
+
+// PrepareDigest collects the posts published in the past days, or
+// NEWSLETTER_DIGEST_DAYS when days is zero, and the confirmed subscribers
+func (s *NewsletterService) PrepareDigest(days int) (*Digest, error) {
+	if days <= 0 {
+		days = s.cfg.DigestDays
+	}
+	digest := &Digest{Since: time.Now().AddDate(0, 0, -days)}
+
+	if err := s.db.Select("id, title, slug, excerpt, publish_at, created_at").
+		Where("status = ? AND COALESCE(publish_at, created_at) >= ?", models.PostStatusPublished, digest.Since).
+		Order("COALESCE(publish_at, created_at) DESC").
+		Find(&digest.Posts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load digest posts: %w", err)
+	}
+	if len(digest.Posts) == 0 {
+		return nil, ErrDigestEmpty
+	}
+
+	if err := s.db.Where("status = ?", models.SubscriberConfirmed).
+		Order("id").
+		Find(&digest.Subscribers).Error; err != nil {
+		return nil, fmt.Errorf("failed to load subscribers: %w", err)
+	}
+	return digest, nil
+}
+
+// SendDigest emails the digest to each subscriber with their own unsubscribe
+// link. Failed deliveries are logged and don't stop the others; the digest
+// heartbeat is only pinged when every delivery succeeded.
+func (s *NewsletterService) SendDigest(ctx context.Context, digest *Digest) {
+	var posts strings.Builder
+	for _, post := range digest.Posts {
+		posts.WriteString(post.Title + "\n")
+		if post.Excerpt != "" {
+			posts.WriteString(post.Excerpt + "\n")
+		}
+		posts.WriteString(s.cfg.SiteURL + "/posts/" + post.Slug + "\n\n")
+	}
+
+	subject := "New posts since " + digest.Since.Format("January 2")
+	if len(digest.Posts) == 1 {
+		subject = digest.Posts[0].Title
+	}
+
+	sent := 0
+	for _, subscriber := range digest.Subscribers {
+		body := "Here's what was published recently:\n\n" +
+			posts.String() +
+			"--\nUnsubscribe: " + s.cfg.APIURL + "/newsletter/unsubscribe?token=" + subscriber.UnsubscribeToken + "\n"
+		if err := s.email.Send(ctx, subscriber.Email, subject, body); err != nil {
+			log.Warn().Err(err).Uint("subscriber_id", subscriber.ID).Msg("Failed to send newsletter digest")
+			continue
+		}
+		sent++
+	}
+
+	log.Info().
+		Int("posts", len(digest.Posts)).
+		Int("recipients", len(digest.Subscribers)).
+		Int("sent", sent).
+		Msg("Newsletter digest sent")
+	if sent == len(digest.Subscribers) {
+		s.heartbeat.Ping(HeartbeatJobDigest)
+	}
+}
+
+// newSubscriptionToken returns a random token for confirmation and unsubscribe links
+func newSubscriptionToken() string {
+	token := make([]byte, 24)
+	_, _ = rand.Read(token) // Never fails since Go 1.24
+	return hex.EncodeToString(token)
+}
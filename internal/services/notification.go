@@ -0,0 +1,186 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrNotificationNotFound is returned when marking a notification the user
+// doesn't have as read
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// NotificationService records events on users' posts and comments and lists
+// them for the users
+type NotificationService struct {
+	db *gorm.DB
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(db *gorm.DB) *NotificationService {
+	return &NotificationService{db: db}
+}
+
+// NotifyComment tells the author of the post that comment was made on it,
+// for a reply, the author of the comment replied to, and the users mentioned
+// in it. Nobody is notified of their own comment, and each user gets one
+// notification: being replied to wins over being mentioned, which wins over
+// owning the post.
+func (s *NotificationService) NotifyComment(comment models.Comment) error {
+	var post models.Post
+	if err := s.db.Select("id, user_id").First(&post, comment.PostID).Error; err != nil {
+		return fmt.Errorf("failed to load commented post: %w", err)
+	}
+
+	var notifications []models.Notification
+	notified := map[uint]bool{comment.UserID: true}
+	if comment.ParentID != nil {
+		var parent models.Comment
+		if err := s.db.Select("id, user_id").First(&parent, *comment.ParentID).Error; err != nil {
+			return fmt.Errorf("failed to load replied comment: %w", err)
+		}
+		if !notified[parent.UserID] {
+			notifications = append(notifications, commentNotification(models.NotificationCommentReply, parent.UserID, comment))
+			notified[parent.UserID] = true
+		}
+	}
+
+	var mentioned []uint
+	if err := s.db.Model(&models.CommentMention{}).Where("comment_id = ?", comment.ID).
+		Order("created_at, user_id").Pluck("user_id", &mentioned).Error; err != nil {
+		return fmt.Errorf("failed to load mentions: %w", err)
+	}
+	for _, userID := range mentioned {
+		if !notified[userID] {
+			notifications = append(notifications, commentNotification(models.NotificationCommentMention, userID, comment))
+			notified[userID] = true
+		}
+	}
+
+	if !notified[post.UserID] {
+		notifications = append(notifications, commentNotification(models.NotificationPostComment, post.UserID, comment))
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err := s.db.Create(&notifications).Error; err != nil {
+		return fmt.Errorf("failed to create notifications: %w", err)
+	}
+	return nil
+}
+
+// NotifyMentions tells users newly mentioned in an edited comment about it.
+// The comment's author isn't notified.
+func (s *NotificationService) NotifyMentions(comment models.Comment, userIDs []uint) error {
+	var notifications []models.Notification
+	for _, userID := range userIDs {
+		if userID != comment.UserID {
+			notifications = append(notifications, commentNotification(models.NotificationCommentMention, userID, comment))
+		}
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err := s.db.Create(&notifications).Error; err != nil {
+		return fmt.Errorf("failed to create notifications: %w", err)
+	}
+	return nil
+}
+
+// NotifyPostStatus tells the owner of post that actorID changed its status.
+// Owners aren't notified of their own changes.
+func (s *NotificationService) NotifyPostStatus(post models.Post, actorID uint) error {
+	if post.UserID == actorID {
+		return nil
+	}
+
+	notification := models.Notification{
+		UserID:  post.UserID,
+		Type:    models.NotificationPostStatusChanged,
+		ActorID: &actorID,
+		PostID:  &post.ID,
+		Status:  post.Status,
+	}
+	if err := s.db.Create(&notification).Error; err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of the user's notifications, newest first, with the
+// total number matching and the number of unread notifications
+func (s *NotificationService) List(userID uint, unreadOnly bool, page, limit int) ([]models.Notification, int64, int64, error) {
+	query := s.db.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+	unread, err := s.UnreadCount(userID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	notifications := []models.Notification{}
+	if err := query.Preload("Actor", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username, first_name, last_name, profile_image")
+	}).Preload("Post", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, uuid, title, slug, status, user_id")
+	}).Order("created_at DESC, id DESC").
+		Limit(limit).Offset((page - 1) * limit).
+		Find(&notifications).Error; err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to load notifications: %w", err)
+	}
+	return notifications, total, unread, nil
+}
+
+// UnreadCount returns the number of the user's unread notifications
+func (s *NotificationService) UnreadCount(userID uint) (int64, error) {
+	var unread int64
+	if err := s.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&unread).Error; err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return unread, nil
+}
+
+// MarkRead marks one of the user's notifications as read. Marking a read
+// notification again keeps the time it was first read.
+func (s *NotificationService) MarkRead(userID, id uint) (*models.Notification, error) {
+	var notification models.Notification
+	if err := s.db.Where("id = ? AND user_id = ?", id, userID).First(&notification).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotificationNotFound
+		}
+		return nil, fmt.Errorf("failed to load notification: %w", err)
+	}
+
+	if notification.ReadAt == nil {
+		now := time.Now()
+		if err := s.db.Model(&notification).Update("read_at", now).Error; err != nil {
+			return nil, fmt.Errorf("failed to mark notification as read: %w", err)
+		}
+		notification.ReadAt = &now
+	}
+	return &notification, nil
+}
+
+// commentNotification builds a notification of comment for userID
+func commentNotification(kind models.NotificationType, userID uint, comment models.Comment) models.Notification {
+	return models.Notification{
+		UserID:    userID,
+		Type:      kind,
+		ActorID:   &comment.UserID,
+		PostID:    &comment.PostID,
+		CommentID: &comment.ID,
+	}
+}
@@ -0,0 +1,230 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+
+	"github.com/gosimple/unidecode"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Share images use the size recommended by Facebook, X and LinkedIn
+const (
+	ogImageWidth  = 1200
+	ogImageHeight = 630
+	ogImageMargin = 80
+
+	// Glyphs are drawn on a 6x10 cell: 5x7 pixels plus spacing
+	ogGlyphAdvance    = 6
+	ogGlyphLineHeight = 10
+
+	// The title is drawn as large as it fits, from ogTitleMaxScale down to
+	// ogTitleMinScale, and ellipsized when it doesn't fit at the smallest
+	ogTitleMaxScale = 12
+	ogTitleMinScale = 5
+	ogTitleTop      = 140
+	ogTitleHeight   = 340
+	ogSiteNameScale = 4
+)
+
+var (
+	ogBackgroundTop    = color.RGBA{R: 0x0f, G: 0x17, B: 0x2a, A: 0xff}
+	ogBackgroundBottom = color.RGBA{R: 0x1e, G: 0x29, B: 0x3b, A: 0xff}
+	ogAccent           = color.RGBA{R: 0x38, G: 0xbd, B: 0xf8, A: 0xff}
+	ogTitleColor       = color.RGBA{R: 0xf8, G: 0xfa, B: 0xfc, A: 0xff}
+	ogSiteNameColor    = color.RGBA{R: 0x94, G: 0xa3, B: 0xb8, A: 0xff}
+)
+
+// OGImageService generates the Open Graph images shown when posts are shared
+// on social networks and stores them through the storage service
+type OGImageService struct {
+	db  *gorm.DB
+	cfg *config.Config
+}
+
+// NewOGImageService creates a new share image service
+func NewOGImageService(db *gorm.DB, cfg *config.Config) *OGImageService {
+	return &OGImageService{db: db, cfg: cfg}
+}
+
+// Enabled reports whether share images are generated
+func (s *OGImageService) Enabled() bool {
+	return s.cfg.OGImage.Enabled
+}
+
+// Generate renders a share image for post, uploads it and stores its URL in
+// the post's og_image column. The image it replaces is deleted from storage.
+func (s *OGImageService) Generate(ctx context.Context, post *models.Post) error {
+	content, err := RenderOGImage(post.Title, s.cfg.OGImage.SiteName)
+	if err != nil {
+		return err
+	}
+
+	storage, err := NewStorageService(s.cfg)
+	if err != nil {
+		return fmt.Errorf("failed to initialize storage: %w", err)
+	}
+	imageURL, err := storage.UploadOGImage(ctx, bytes.NewReader(content), int64(len(content)), post.ID)
+	if err != nil {
+		return fmt.Errorf("failed to upload share image: %w", err)
+	}
+
+	// UpdateColumn leaves updated_at alone, the post's content didn't change
+	if err := s.db.Model(&models.Post{}).Where("id = ?", post.ID).UpdateColumn("og_image", imageURL).Error; err != nil {
+		return fmt.Errorf("failed to save share image URL: %w", err)
+	}
+
+	if post.OGImage != "" {
+		if err := storage.DeleteImage(ctx, post.OGImage); err != nil {
+			log.Warn().Err(err).Uint("post_id", post.ID).Str("url", post.OGImage).Msg("Failed to delete previous share image")
+		}
+	}
+	post.OGImage = imageURL
+	return nil
+}
+
+// RenderOGImage draws title over the site's share image template and returns
+// it as a PNG. Characters outside ASCII are transliterated, so Vietnamese
+// titles lose their diacritics rather than their letters.
+func RenderOGImage(title, siteName string) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, ogImageWidth, ogImageHeight))
+
+	// Vertical gradient background
+	for y := 0; y < ogImageHeight; y++ {
+		row := image.Rect(0, y, ogImageWidth, y+1)
+		draw.Draw(img, row, image.NewUniform(ogBlend(ogBackgroundTop, ogBackgroundBottom, y, ogImageHeight)), image.Point{}, draw.Src)
+	}
+
+	// Accent bar above the title and strip along the bottom edge
+	draw.Draw(img, image.Rect(ogImageMargin, ogImageMargin, ogImageMargin+120, ogImageMargin+12), image.NewUniform(ogAccent), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, ogImageHeight-12, ogImageWidth, ogImageHeight), image.NewUniform(ogAccent), image.Point{}, draw.Src)
+
+	lines, scale := layoutOGTitle(ogText(title), ogImageWidth-2*ogImageMargin, ogTitleHeight)
+	for i, line := range lines {
+		drawOGText(img, line, ogImageMargin, ogTitleTop+i*ogGlyphLineHeight*scale, scale, ogTitleColor)
+	}
+
+	if siteName = ogText(siteName); siteName != "" {
+		maxChars := (ogImageWidth - 2*ogImageMargin) / (ogGlyphAdvance * ogSiteNameScale)
+		drawOGText(img, ogEllipsize(siteName, maxChars), ogImageMargin, ogImageHeight-ogImageMargin-7*ogSiteNameScale, ogSiteNameScale, ogSiteNameColor)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode share image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// layoutOGTitle word-wraps title at the largest scale that fits the box and
+// returns its lines with that scale
+func layoutOGTitle(title string, width, height int) ([]string, int) {
+	for scale := ogTitleMaxScale; scale >= ogTitleMinScale; scale-- {
+		lines := wrapOGText(title, width/(ogGlyphAdvance*scale))
+		if len(lines)*ogGlyphLineHeight*scale <= height {
+			return lines, scale
+		}
+	}
+
+	scale := ogTitleMinScale
+	maxChars := width / (ogGlyphAdvance * scale)
+	maxLines := height / (ogGlyphLineHeight * scale)
+	lines := wrapOGText(title, maxChars)
+	lines = lines[:maxLines]
+	last := lines[maxLines-1]
+	if len(last)+3 > maxChars {
+		last = strings.TrimRight(last[:maxChars-3], " ")
+	}
+	lines[maxLines-1] = last + "..."
+	return lines, scale
+}
+
+// wrapOGText splits text into lines of at most maxChars characters, breaking
+// between words and splitting words that are longer than a line
+func wrapOGText(text string, maxChars int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		for len(word) > maxChars {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			lines = append(lines, word[:maxChars])
+			word = word[maxChars:]
+		}
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= maxChars:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// ogEllipsize shortens text to maxChars characters, ending it with "..." when
+// it's cut
+func ogEllipsize(text string, maxChars int) string {
+	if len(text) <= maxChars {
+		return text
+	}
+	return strings.TrimRight(text[:maxChars-3], " ") + "..."
+}
+
+// ogText transliterates text to the ASCII characters the font can draw
+func ogText(text string) string {
+	text = unidecode.Unidecode(strings.TrimSpace(text))
+	return strings.Map(func(r rune) rune {
+		if _, ok := ogGlyphs[r]; ok {
+			return r
+		}
+		if r == '\t' || r == '\n' || r == '\r' {
+			return ' '
+		}
+		return -1
+	}, text)
+}
+
+// drawOGText draws a line of text with its top left corner at x, y, each font
+// pixel scaled to a scale x scale square
+func drawOGText(img *image.RGBA, text string, x, y, scale int, c color.RGBA) {
+	fill := image.NewUniform(c)
+	for _, r := range text {
+		glyph := ogGlyphs[r]
+		for row, bits := range glyph {
+			for col := 0; col < 5; col++ {
+				if bits&(0x10>>col) == 0 {
+					continue
+				}
+				px := x + col*scale
+				py := y + row*scale
+				draw.Draw(img, image.Rect(px, py, px+scale, py+scale), fill, image.Point{}, draw.Src)
+			}
+		}
+		x += ogGlyphAdvance * scale
+	}
+}
+
+// ogBlend mixes from and to linearly, step of steps along the way
+func ogBlend(from, to color.RGBA, step, steps int) color.RGBA {
+	mix := func(a, b uint8) uint8 {
+		return uint8(int(a) + (int(b)-int(a))*step/steps)
+	}
+	return color.RGBA{R: mix(from.R, to.R), G: mix(from.G, to.G), B: mix(from.B, to.B), A: 0xff}
+}
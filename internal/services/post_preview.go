@@ -0,0 +1,75 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// previewTokenType keeps preview tokens from being accepted as access or refresh tokens
+const previewTokenType = "preview"
+
+// ErrInvalidPreviewToken is returned for preview tokens that are malformed, expired or not preview tokens
+var ErrInvalidPreviewToken = errors.New("invalid preview token")
+
+// PreviewClaims identify the post a preview token grants access to
+type PreviewClaims struct {
+	PostID    uint   `json:"post_id"`
+	Version   uint   `json:"version"`
+	TokenType string `json:"token_type"`
+	jwt.RegisteredClaims
+}
+
+// PreviewTokenService signs and verifies links that let reviewers read a post
+// before it is published, without an account
+type PreviewTokenService struct {
+	keys   *JWTKeyRing
+	expiry time.Duration
+}
+
+// NewPreviewTokenService creates a preview token service signing with the JWT keys
+func NewPreviewTokenService(keys *JWTKeyRing, cfg config.JWTConfig) *PreviewTokenService {
+	return &PreviewTokenService{
+		keys:   keys,
+		expiry: cfg.PreviewExpiry,
+	}
+}
+
+// Issue creates a preview token for post and returns it with its expiry time
+func (s *PreviewTokenService) Issue(post *models.Post) (string, time.Time, error) {
+	issuedAt := time.Now()
+	expiresAt := issuedAt.Add(s.expiry)
+
+	claims := &PreviewClaims{
+		PostID:    post.ID,
+		Version:   post.PreviewVersion,
+		TokenType: previewTokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			Subject:   strconv.FormatUint(uint64(post.ID), 10),
+		},
+	}
+
+	token, err := s.keys.Sign(claims)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign preview token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// Verify checks a preview token's signature and expiry and returns its claims.
+// Callers must still compare Version with the post's current PreviewVersion.
+func (s *PreviewTokenService) Verify(tokenString string) (*PreviewClaims, error) {
+	claims := &PreviewClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.keys.KeyFunc)
+	if err != nil || !token.Valid || claims.TokenType != previewTokenType {
+		return nil, ErrInvalidPreviewToken
+	}
+	return claims, nil
+}
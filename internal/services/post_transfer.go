@@ -0,0 +1,327 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// maxImportPosts bounds the posts in one import
+const maxImportPosts = 1000
+
+// ErrPostImportMalformed is returned when an import file can't be read
+var ErrPostImportMalformed = errors.New("import file is malformed")
+
+// errDryRun rolls back the import transaction of a dry run
+var errDryRun = errors.New("dry run")
+
+// ImportedPost is a post read from an import file
+type ImportedPost struct {
+	// Source names the post in the import report, e.g. its file in an archive
+	Source string
+	Post   models.PortablePost
+}
+
+// PostImportOptions controls an import
+type PostImportOptions struct {
+	// DryRun validates every post and reports what would happen without saving
+	DryRun bool
+	// Overwrite updates posts whose slug already exists instead of skipping them
+	Overwrite bool
+	// DefaultAuthorID owns posts whose author is empty or not a local user
+	DefaultAuthorID uint
+}
+
+// PostTransferService exports posts in a portable format and imports them,
+// for moving a blog between instances or from another platform
+type PostTransferService struct {
+	db *gorm.DB
+}
+
+// NewPostTransferService creates a new post transfer service
+func NewPostTransferService(db *gorm.DB) *PostTransferService {
+	return &PostTransferService{db: db}
+}
+
+// Export returns every post, or those with status, oldest first
+func (s *PostTransferService) Export(status models.PostStatus) ([]models.PortablePost, error) {
+	query := s.db.Preload("Tags").Preload("Category").Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id, username")
+	}).Order("created_at ASC, id ASC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var posts []models.Post
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, fmt.Errorf("failed to load posts: %w", err)
+	}
+
+	portable := make([]models.PortablePost, 0, len(posts))
+	for _, post := range posts {
+		createdAt, updatedAt := post.CreatedAt, post.UpdatedAt
+		exported := models.PortablePost{
+			Title:     post.Title,
+			Slug:      post.Slug,
+			Excerpt:   post.Excerpt,
+			Content:   post.Content,
+			Cover:     post.Cover,
+			Status:    post.Status,
+			Language:  post.Language,
+			Author:    post.User.Username,
+			PublishAt: post.PublishAt,
+			ExpiresAt: post.ExpiresAt,
+			CreatedAt: &createdAt,
+			UpdatedAt: &updatedAt,
+		}
+		for _, tag := range post.Tags {
+			exported.Tags = append(exported.Tags, tag.Name)
+		}
+		if post.Category != nil {
+			exported.Category = post.Category.Slug
+		}
+		portable = append(portable, exported)
+	}
+	return portable, nil
+}
+
+// Import saves posts, matching existing posts by slug. Posts that fail
+// validation are reported and skipped; the others are imported. Imported
+// posts don't trigger webhooks and aren't held by content freezes.
+func (s *PostTransferService) Import(posts []ImportedPost, opts PostImportOptions) (*models.PostImportResult, error) {
+	if len(posts) > maxImportPosts {
+		return nil, fmt.Errorf("%w: at most %d posts can be imported at once", ErrPostImportMalformed, maxImportPosts)
+	}
+
+	var result *models.PostImportResult
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result = importPosts(tx, posts, opts)
+		if opts.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, fmt.Errorf("failed to import posts: %w", err)
+	}
+	return result, nil
+}
+
+// importPosts imports posts in tx. The caller commits, or rolls back a dry run.
+func importPosts(tx *gorm.DB, posts []ImportedPost, opts PostImportOptions) *models.PostImportResult {
+	result := &models.PostImportResult{DryRun: opts.DryRun, Items: make([]models.PostImportItem, 0, len(posts))}
+	run := &postImport{
+		tx:         tx,
+		opts:       opts,
+		authors:    make(map[string]uint),
+		categories: make(map[string]*uint),
+		slugs:      make(map[string]string),
+	}
+
+	for _, imported := range posts {
+		item := run.importPost(imported)
+		switch item.Action {
+		case models.PostImportCreate:
+			result.Created++
+		case models.PostImportUpdate:
+			result.Updated++
+		case models.PostImportSkip:
+			result.Skipped++
+		default:
+			result.Failed++
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result
+}
+
+// postImport is the state of one import run
+type postImport struct {
+	tx   *gorm.DB
+	opts PostImportOptions
+	// authors and categories cache lookups by username and slug
+	authors    map[string]uint
+	categories map[string]*uint
+	// slugs maps the slugs seen so far to their source, to catch duplicates
+	slugs map[string]string
+}
+
+func (r *postImport) importPost(imported ImportedPost) models.PostImportItem {
+	post := imported.Post
+	item := models.PostImportItem{Source: imported.Source}
+
+	post.Title = strings.TrimSpace(post.Title)
+	post.Slug = strings.TrimSpace(post.Slug)
+	if post.Slug == "" {
+		post.Slug = slug.Make(post.Title)
+	}
+	item.Slug = post.Slug
+
+	var problems []string
+	if post.Title == "" {
+		problems = append(problems, "title is required")
+	}
+	if strings.TrimSpace(post.Content) == "" {
+		problems = append(problems, "content is required")
+	}
+	if post.Slug == "" {
+		problems = append(problems, "slug is required when the title has no letters or digits")
+	} else if !slug.IsSlug(post.Slug) {
+		problems = append(problems, fmt.Sprintf("slug %q may only contain lowercase letters, digits and hyphens", post.Slug))
+	} else if source, ok := r.slugs[post.Slug]; ok {
+		problems = append(problems, fmt.Sprintf("slug %q is also used by %s", post.Slug, source))
+	}
+
+	switch post.Status {
+	case "":
+		post.Status = models.PostStatusDraft
+	case models.PostStatusDraft, models.PostStatusPublished, models.PostStatusArchived:
+	case models.PostStatusScheduled:
+		if post.PublishAt == nil {
+			problems = append(problems, "publish_at is required for scheduled posts")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("status %q is not one of draft, published, archived, scheduled", post.Status))
+	}
+
+	if post.Language == "" {
+		post.Language = models.DefaultContentLanguage
+	} else if !models.IsContentLanguage(post.Language) {
+		problems = append(problems, fmt.Sprintf("language %q is not one of en, vi", post.Language))
+	}
+
+	categoryID, err := r.categoryID(post.Category)
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) > 0 {
+		item.Action = models.PostImportError
+		item.Errors = problems
+		return item
+	}
+	r.slugs[post.Slug] = imported.Source
+
+	authorID, err := r.authorID(post.Author)
+	if err != nil {
+		return failedImport(item, err)
+	}
+
+	var existing models.Post
+	err = r.tx.Unscoped().Where("slug = ?", post.Slug).First(&existing).Error
+	switch {
+	case err == nil && existing.DeletedAt.Valid:
+		return failedImport(item, fmt.Errorf("slug %q belongs to a deleted post", post.Slug))
+	case err == nil && !r.opts.Overwrite:
+		item.Action = models.PostImportSkip
+		return item
+	case err == nil:
+		item.Action = models.PostImportUpdate
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		item.Action = models.PostImportCreate
+	default:
+		return failedImport(item, fmt.Errorf("failed to look up slug: %w", err))
+	}
+
+	target := existing
+	target.Title = post.Title
+	target.Slug = post.Slug
+	target.Content = post.Content
+	target.Excerpt = post.Excerpt
+	target.Cover = post.Cover
+	target.Status = post.Status
+	target.Language = post.Language
+	target.UserID = authorID
+	target.CategoryID = categoryID
+	target.PublishAt = post.PublishAt
+	if post.Status != models.PostStatusScheduled {
+		target.PublishAt = nil
+	}
+	target.ExpiresAt = post.ExpiresAt
+	if post.CreatedAt != nil {
+		target.CreatedAt = *post.CreatedAt
+	}
+	if post.UpdatedAt != nil {
+		target.UpdatedAt = *post.UpdatedAt
+	}
+
+	// Each post is saved in a savepoint so a failure only undoes that post
+	r.tx.SavePoint("post_import")
+	if err := r.save(&target, post.Tags); err != nil {
+		r.tx.RollbackTo("post_import")
+		return failedImport(item, err)
+	}
+	return item
+}
+
+// save creates or updates post and replaces its tags
+func (r *postImport) save(post *models.Post, tagNames []string) error {
+	if post.ID == 0 {
+		if err := r.tx.Omit("User", "Tags", "Category").Create(post).Error; err != nil {
+			return fmt.Errorf("failed to create post: %w", err)
+		}
+	} else if err := r.tx.Omit("User", "Tags", "Category").Save(post).Error; err != nil {
+		return fmt.Errorf("failed to update post: %w", err)
+	}
+
+	if _, err := NewTagService(r.tx).SetPostTags(post.ID, tagNames); err != nil {
+		return fmt.Errorf("failed to save tags: %w", err)
+	}
+	return nil
+}
+
+// authorID returns the ID of the user with username, or the default author
+func (r *postImport) authorID(username string) (uint, error) {
+	if username == "" {
+		return r.opts.DefaultAuthorID, nil
+	}
+	if id, ok := r.authors[username]; ok {
+		return id, nil
+	}
+
+	var user models.User
+	err := r.tx.Select("id").Where("username = ?", username).First(&user).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		user.ID = r.opts.DefaultAuthorID
+	case err != nil:
+		return 0, fmt.Errorf("failed to look up author: %w", err)
+	}
+	r.authors[username] = user.ID
+	return user.ID, nil
+}
+
+// categoryID returns the ID of the category with slug, or nil for no category
+func (r *postImport) categoryID(categorySlug string) (*uint, error) {
+	if categorySlug == "" {
+		return nil, nil
+	}
+	if id, ok := r.categories[categorySlug]; ok {
+		if id == nil {
+			return nil, fmt.Errorf("category %q does not exist", categorySlug)
+		}
+		return id, nil
+	}
+
+	var category models.Category
+	err := r.tx.Select("id").Where("slug = ?", categorySlug).First(&category).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		r.categories[categorySlug] = nil
+		return nil, fmt.Errorf("category %q does not exist", categorySlug)
+	case err != nil:
+		return nil, fmt.Errorf("failed to look up category: %w", err)
+	}
+	r.categories[categorySlug] = &category.ID
+	return &category.ID, nil
+}
+
+func failedImport(item models.PostImportItem, err error) models.PostImportItem {
+	item.Action = models.PostImportError
+	item.Errors = []string{err.Error()}
+	return item
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// maxImportArchiveSize bounds the uncompressed size of an import archive, so
+// a small zip can't expand to fill memory
+const maxImportArchiveSize = 100 << 20 // 100 MiB
+
+// frontMatterDelimiter opens and closes the YAML front matter of a Markdown post
+const frontMatterDelimiter = "---"
+
+// WritePostsJSON writes posts as a JSON export document
+func WritePostsJSON(w io.Writer, posts []models.PortablePost) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(models.PostExport{
+		Version:    models.PostExportVersion,
+		ExportedAt: time.Now().UTC().Truncate(time.Second),
+		Posts:      posts,
+	})
+}
+
+// WritePostsArchive writes posts as a zip archive holding posts/<slug>.md for
+// each post
+func WritePostsArchive(w io.Writer, posts []models.PortablePost) error {
+	archive := zip.NewWriter(w)
+	for _, post := range posts {
+		data, err := MarshalMarkdownPost(post)
+		if err != nil {
+			return err
+		}
+
+		file, err := archive.Create(path.Join("posts", post.Slug+".md"))
+		if err != nil {
+			return fmt.Errorf("failed to add %s to archive: %w", post.Slug, err)
+		}
+		if _, err := file.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s to archive: %w", post.Slug, err)
+		}
+	}
+	return archive.Close()
+}
+
+// MarshalMarkdownPost encodes a post as Markdown with YAML front matter
+func MarshalMarkdownPost(post models.PortablePost) ([]byte, error) {
+	frontMatter, err := yaml.Marshal(post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode front matter of %s: %w", post.Slug, err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelimiter + "\n")
+	buf.Write(frontMatter)
+	buf.WriteString(frontMatterDelimiter + "\n\n")
+	buf.WriteString(post.Content)
+	if !strings.HasSuffix(post.Content, "\n") {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalMarkdownPost decodes a post written by MarshalMarkdownPost, or any
+// Markdown file that starts with YAML front matter
+func UnmarshalMarkdownPost(data []byte) (models.PortablePost, error) {
+	var post models.PortablePost
+
+	text := strings.TrimPrefix(strings.ReplaceAll(string(data), "\r\n", "\n"), "\uFEFF")
+	if !strings.HasPrefix(text, frontMatterDelimiter+"\n") {
+		return post, fmt.Errorf("missing front matter")
+	}
+	text = text[len(frontMatterDelimiter)+1:]
+
+	frontMatter, body, found := strings.Cut(text, "\n"+frontMatterDelimiter+"\n")
+	if !found {
+		frontMatter, found = strings.CutSuffix(text, "\n"+frontMatterDelimiter)
+		if !found {
+			return post, fmt.Errorf("front matter is not closed with %s", frontMatterDelimiter)
+		}
+	}
+
+	if err := yaml.Unmarshal([]byte(frontMatter), &post); err != nil {
+		return post, fmt.Errorf("invalid front matter: %w", err)
+	}
+	post.Content = strings.TrimLeft(body, "\n")
+	return post, nil
+}
+
+// ParsePostImport reads the posts of an import file: a JSON export (or a
+// JSON array of posts), a single Markdown file, or a zip archive of Markdown
+// files. Errors wrap ErrPostImportMalformed.
+func ParsePostImport(filename string, data []byte) ([]ImportedPost, error) {
+	ext := strings.ToLower(path.Ext(filename))
+	switch {
+	case ext == ".zip" || bytes.HasPrefix(data, []byte("PK\x03\x04")):
+		return parsePostArchive(data)
+	case ext == ".md" || ext == ".markdown":
+		post, err := UnmarshalMarkdownPost(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrPostImportMalformed, filename, err)
+		}
+		return []ImportedPost{{Source: filename, Post: post}}, nil
+	default:
+		return parsePostJSON(data)
+	}
+}
+
+func parsePostJSON(data []byte) ([]ImportedPost, error) {
+	var posts []models.PortablePost
+	if trimmed := bytes.TrimSpace(data); bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := json.Unmarshal(trimmed, &posts); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrPostImportMalformed, err)
+		}
+	} else {
+		var export models.PostExport
+		if err := json.Unmarshal(trimmed, &export); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrPostImportMalformed, err)
+		}
+		if export.Version > models.PostExportVersion {
+			return nil, fmt.Errorf("%w: export version %d is newer than the supported version %d", ErrPostImportMalformed, export.Version, models.PostExportVersion)
+		}
+		posts = export.Posts
+	}
+
+	imported := make([]ImportedPost, len(posts))
+	for i, post := range posts {
+		imported[i] = ImportedPost{Source: "posts[" + strconv.Itoa(i) + "]", Post: post}
+	}
+	return imported, nil
+}
+
+func parsePostArchive(data []byte) ([]ImportedPost, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPostImportMalformed, err)
+	}
+
+	var imported []ImportedPost
+	var total int64
+	for _, file := range archive.File {
+		name := file.Name
+		ext := strings.ToLower(path.Ext(name))
+		// Skip directories, other files and the metadata macOS adds to archives
+		if file.FileInfo().IsDir() || (ext != ".md" && ext != ".markdown") ||
+			strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".") {
+			continue
+		}
+
+		content, err := readArchiveFile(file, maxImportArchiveSize-total)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrPostImportMalformed, name, err)
+		}
+		total += int64(len(content))
+
+		post, err := UnmarshalMarkdownPost(content)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrPostImportMalformed, name, err)
+		}
+		imported = append(imported, ImportedPost{Source: name, Post: post})
+	}
+
+	if len(imported) == 0 {
+		return nil, fmt.Errorf("%w: archive contains no Markdown files", ErrPostImportMalformed)
+	}
+	return imported, nil
+}
+
+// readArchiveFile reads a file from a zip archive, failing once more than
+// limit bytes have been read
+func readArchiveFile(file *zip.File, limit int64) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > limit {
+		return nil, fmt.Errorf("archive expands to more than %d MiB", maxImportArchiveSize>>20)
+	}
+	return content, nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+)
+
+// Ranker orders feed items, setting each item's Score
+type Ranker interface {
+	// Name identifies the ranker in responses and in the feed.ranker setting
+	Name() string
+	// Rank scores the items and sorts them best first
+	Rank(items []models.FeedItem, now time.Time)
+}
+
+// RankingWeights are the coefficients used to blend the ranking signals
+type RankingWeights struct {
+	Recency    float64
+	Popularity float64
+	Editorial  float64
+	// HalfLife is the age at which an item's recency signal drops to half
+	HalfLife time.Duration
+}
+
+// rankers lists the available ranking strategies by name
+var rankers = map[string]func(RankingWeights) Ranker{
+	"blend":   func(w RankingWeights) Ranker { return &blendRanker{weights: w} },
+	"recency": func(w RankingWeights) Ranker { return recencyRanker{} },
+}
+
+// NewRanker creates the ranker selected in the site settings, with its
+// coefficients taken from the settings as well
+func NewRanker(settings *SiteSettingsService) Ranker {
+	weights := RankingWeights{
+		Recency:    settings.Float(SettingFeedRecencyWeight),
+		Popularity: settings.Float(SettingFeedPopularityWeight),
+		Editorial:  settings.Float(SettingFeedEditorialWeight),
+		HalfLife:   settings.Duration(SettingFeedRecencyHalfLife),
+	}
+
+	newRanker, ok := rankers[settings.Get(SettingFeedRanker)]
+	if !ok {
+		newRanker = rankers["blend"]
+	}
+	return newRanker(weights)
+}
+
+// joinRankerNames lists the ranker names for error messages
+func joinRankerNames() string {
+	names := make([]string, 0, len(rankers))
+	for name := range rankers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// blendRanker scores items as a weighted sum of recency, popularity and editorial weight
+type blendRanker struct {
+	weights RankingWeights
+}
+
+func (r *blendRanker) Name() string { return "blend" }
+
+func (r *blendRanker) Rank(items []models.FeedItem, now time.Time) {
+	// Views are long-tailed, so compare them on a log scale relative to the
+	// most viewed item in the feed
+	var maxViews int64
+	for _, item := range items {
+		if item.ViewCount > maxViews {
+			maxViews = item.ViewCount
+		}
+	}
+
+	for i := range items {
+		recency := math.Pow(0.5, now.Sub(items[i].PublishedAt).Hours()/r.weights.HalfLife.Hours())
+		if recency > 1 {
+			recency = 1 // Items dated in the future
+		}
+
+		var popularity float64
+		if maxViews > 0 {
+			popularity = math.Log1p(float64(items[i].ViewCount)) / math.Log1p(float64(maxViews))
+		}
+
+		items[i].Score = r.weights.Recency*recency +
+			r.weights.Popularity*popularity +
+			r.weights.Editorial*items[i].EditorialWeight
+	}
+
+	sortByScore(items)
+}
+
+// recencyRanker shows the newest items first, ignoring every other signal
+type recencyRanker struct{}
+
+func (recencyRanker) Name() string { return "recency" }
+
+func (recencyRanker) Rank(items []models.FeedItem, now time.Time) {
+	for i := range items {
+		items[i].Score = float64(items[i].PublishedAt.Unix())
+	}
+	sortByScore(items)
+}
+
+// sortByScore sorts items best first, newest first on ties
+func sortByScore(items []models.FeedItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Score != items[j].Score {
+			return items[i].Score > items[j].Score
+		}
+		return items[i].PublishedAt.After(items[j].PublishedAt)
+	})
+}
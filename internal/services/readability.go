@@ -0,0 +1,557 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// readabilityMinParagraph is the shortest paragraph that counts towards a
+	// candidate's score; shorter ones are usually captions or buttons
+	readabilityMinParagraph = 25
+	// readabilityMinText is the least text an extraction must produce to be
+	// trusted over the page as a whole
+	readabilityMinText = 250
+)
+
+// ErrNoArticle is returned when a page has no recognizable article text
+var ErrNoArticle = errors.New("no article content found")
+
+var (
+	// unlikelyCandidates match class and id values of page furniture
+	unlikelyCandidates = regexp.MustCompile(`(?i)banner|breadcrumb|combx|comment|community|cookie|disqus|extra|footer|gdpr|header|menu|modal|nav|newsletter|pager|popup|promo|related|remark|replies|rss|share|shoutbox|sidebar|skyscraper|social|sponsor|subscribe|ad-break|agegate|pagination|tags`)
+	// maybeCandidates rescue elements that match unlikelyCandidates but are
+	// probably the article, such as "article-header"
+	maybeCandidates = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	// positiveHints and negativeHints adjust a candidate's score by its class and id
+	positiveHints = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|pagination|post|text|blog|story`)
+	negativeHints = regexp.MustCompile(`(?i)-ad-|hidden|^hid$| hid$| hid |^hid |banner|combx|comment|com-|contact|foot|footer|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
+	// bylineHints match class, id, rel and itemprop values of author credits
+	bylineHints = regexp.MustCompile(`(?i)byline|author|dateline|writtenby|p-author`)
+)
+
+// strippedTags are removed with their content before scoring
+var strippedTags = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Iframe: true,
+	atom.Form: true, atom.Button: true, atom.Input: true, atom.Select: true,
+	atom.Textarea: true, atom.Nav: true, atom.Aside: true, atom.Footer: true,
+	atom.Svg: true, atom.Object: true, atom.Embed: true, atom.Link: true,
+}
+
+// keptTags are the elements the extracted content is rebuilt from. Everything
+// else is unwrapped, keeping its text.
+var keptTags = map[atom.Atom]bool{
+	atom.P: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Ul: true, atom.Ol: true, atom.Li: true, atom.Blockquote: true, atom.Pre: true,
+	atom.Code: true, atom.Em: true, atom.Strong: true, atom.B: true, atom.I: true,
+	atom.A: true, atom.Br: true, atom.Img: true, atom.Figure: true, atom.Figcaption: true,
+}
+
+// Article is the readable part of a web page
+type Article struct {
+	Title     string
+	Byline    string
+	LeadImage string
+	// Content is sanitized HTML made of paragraphs, headings, lists, quotes,
+	// code blocks, links and images, with relative URLs resolved
+	Content string
+	// Text is the plain text of Content with paragraphs separated by blank lines
+	Text string
+}
+
+// ExtractArticle finds the main article of an HTML page with readability-style
+// heuristics: page furniture is dropped, every block is scored by the
+// paragraphs it contains, and the best block is kept along with related
+// siblings. The byline and lead image come from the page's metadata when it
+// has any. pageURL resolves relative links and images.
+func ExtractArticle(doc *html.Node, pageURL *url.URL) (*Article, error) {
+	article := &Article{
+		Title:     pageTitle(doc),
+		Byline:    metaContent(doc, "author", "article:author", "parsely-author", "dc.creator"),
+		LeadImage: resolveURL(pageURL, metaContent(doc, "og:image", "og:image:url", "twitter:image", "twitter:image:src")),
+	}
+
+	body := findFirst(doc, atom.Body)
+	if body == nil {
+		body = doc
+	}
+	// The credit is reported separately, so it is taken out of the text
+	if byline := findByline(body); byline != nil {
+		if article.Byline == "" {
+			article.Byline = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(normalizeSpace(textContent(byline)), "By "), "by "))
+		}
+		byline.Parent.RemoveChild(byline)
+	}
+	removeUnlikely(body)
+
+	top := topCandidate(body)
+	if top == nil {
+		return nil, ErrNoArticle
+	}
+
+	var content, text strings.Builder
+	for _, node := range articleNodes(top) {
+		writeClean(&content, node, pageURL)
+	}
+	article.Content = strings.TrimSpace(collapseBlankParagraphs(content.String()))
+
+	for _, block := range blockTexts(top) {
+		text.WriteString(block + "\n\n")
+	}
+	article.Text = strings.TrimSpace(text.String())
+	if len(article.Text) < readabilityMinText {
+		return nil, ErrNoArticle
+	}
+
+	if article.LeadImage == "" {
+		if img := findFirst(top, atom.Img); img != nil {
+			article.LeadImage = resolveURL(pageURL, attr(img, "src"))
+		}
+	}
+	return article, nil
+}
+
+// pageTitle returns the og:title, or the document title
+func pageTitle(doc *html.Node) string {
+	if title := metaContent(doc, "og:title", "twitter:title"); title != "" {
+		return title
+	}
+	if title := findFirst(doc, atom.Title); title != nil {
+		return normalizeSpace(textContent(title))
+	}
+	return ""
+}
+
+// metaContent returns the content of the first <meta> whose name or property
+// is one of keys, in the order of keys
+func metaContent(doc *html.Node, keys ...string) string {
+	found := map[string]string{}
+	walk(doc, func(n *html.Node) bool {
+		if n.DataAtom == atom.Meta {
+			key := strings.ToLower(attr(n, "property"))
+			if key == "" {
+				key = strings.ToLower(attr(n, "name"))
+			}
+			if _, ok := found[key]; !ok {
+				found[key] = strings.TrimSpace(attr(n, "content"))
+			}
+		}
+		return true
+	})
+	for _, key := range keys {
+		if value := found[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// findByline returns the first short element marked as an author credit
+func findByline(root *html.Node) *html.Node {
+	var byline *html.Node
+	walk(root, func(n *html.Node) bool {
+		if byline != nil {
+			return false
+		}
+		if n.Type != html.ElementNode {
+			return true
+		}
+		hints := attr(n, "class") + " " + attr(n, "id") + " " + attr(n, "rel") + " " + attr(n, "itemprop")
+		if !bylineHints.MatchString(hints) {
+			return true
+		}
+		text := normalizeSpace(textContent(n))
+		if text != "" && len(text) < 100 && n.Parent != nil {
+			byline = n
+			return false
+		}
+		return true
+	})
+	return byline
+}
+
+// removeUnlikely drops scripts, navigation and elements whose class or id
+// mark them as page furniture
+func removeUnlikely(root *html.Node) {
+	var remove []*html.Node
+	walk(root, func(n *html.Node) bool {
+		if n.Type == html.CommentNode {
+			remove = append(remove, n)
+			return false
+		}
+		if n.Type != html.ElementNode {
+			return true
+		}
+		if strippedTags[n.DataAtom] || hasAttr(n, "hidden") || attr(n, "aria-hidden") == "true" {
+			remove = append(remove, n)
+			return false
+		}
+		hints := attr(n, "class") + " " + attr(n, "id")
+		if n.DataAtom != atom.Body && n.DataAtom != atom.Article && n.DataAtom != atom.Main &&
+			unlikelyCandidates.MatchString(hints) && !maybeCandidates.MatchString(hints) {
+			remove = append(remove, n)
+			return false
+		}
+		return true
+	})
+	for _, n := range remove {
+		if n.Parent != nil {
+			n.Parent.RemoveChild(n)
+		}
+	}
+}
+
+// topCandidate scores the parents of every paragraph and returns the best
+func topCandidate(root *html.Node) *html.Node {
+	scores := map[*html.Node]float64{}
+	var order []*html.Node
+	addScore := func(n *html.Node, score float64) {
+		if n == nil || n.Type != html.ElementNode {
+			return
+		}
+		if _, ok := scores[n]; !ok {
+			scores[n] = initialScore(n)
+			order = append(order, n)
+		}
+		scores[n] += score
+	}
+
+	walk(root, func(n *html.Node) bool {
+		if n.DataAtom != atom.P && n.DataAtom != atom.Pre && n.DataAtom != atom.Td && !isTextDiv(n) {
+			return true
+		}
+		text := normalizeSpace(textContent(n))
+		if len(text) < readabilityMinParagraph {
+			return true
+		}
+
+		// Longer paragraphs with more clauses are more likely to be prose
+		score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(len(text))/100, 3)
+		addScore(n.Parent, score)
+		if n.Parent != nil {
+			addScore(n.Parent.Parent, score/2)
+		}
+		return n.DataAtom != atom.P
+	})
+
+	var top *html.Node
+	best := 0.0
+	for _, n := range order {
+		// Blocks that are mostly links are menus and lists of other articles
+		score := scores[n] * (1 - linkDensity(n))
+		if score > best {
+			top, best = n, score
+		}
+	}
+	if top == nil {
+		return nil
+	}
+	// Prefer a parent that is nearly as good, since articles are often split
+	// across several sibling blocks
+	for parent := top.Parent; parent != nil && parent.DataAtom != atom.Body; parent = parent.Parent {
+		score, ok := scores[parent]
+		if !ok || score*(1-linkDensity(parent)) < best*0.75 {
+			break
+		}
+		top = parent
+	}
+	return top
+}
+
+// isTextDiv reports whether n is a div holding text directly rather than
+// through paragraphs, as some sites write their articles
+func isTextDiv(n *html.Node) bool {
+	if n.DataAtom != atom.Div {
+		return false
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && (c.DataAtom == atom.Div || c.DataAtom == atom.P || c.DataAtom == atom.Table ||
+			c.DataAtom == atom.Ul || c.DataAtom == atom.Ol || c.DataAtom == atom.Section || c.DataAtom == atom.Article) {
+			return false
+		}
+	}
+	return true
+}
+
+// initialScore weighs a candidate by its tag and its class and id
+func initialScore(n *html.Node) float64 {
+	score := 0.0
+	switch n.DataAtom {
+	case atom.Article:
+		score = 10
+	case atom.Div, atom.Main, atom.Section:
+		score = 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		score = 3
+	case atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li, atom.Form:
+		score = -3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		score = -5
+	}
+
+	for _, hint := range []string{attr(n, "class"), attr(n, "id")} {
+		if hint == "" {
+			continue
+		}
+		if negativeHints.MatchString(hint) {
+			score -= 25
+		}
+		if positiveHints.MatchString(hint) {
+			score += 25
+		}
+	}
+	return score
+}
+
+// linkDensity is the share of n's text that is inside links
+func linkDensity(n *html.Node) float64 {
+	total := len(normalizeSpace(textContent(n)))
+	if total == 0 {
+		return 0
+	}
+	linked := 0
+	walk(n, func(c *html.Node) bool {
+		if c.DataAtom == atom.A {
+			linked += len(normalizeSpace(textContent(c)))
+			return false
+		}
+		return true
+	})
+	return float64(linked) / float64(total)
+}
+
+// articleNodes returns the top candidate along with siblings that look like
+// part of the same article, such as paragraphs split out of the main block
+func articleNodes(top *html.Node) []*html.Node {
+	if top.Parent == nil {
+		return []*html.Node{top}
+	}
+
+	var nodes []*html.Node
+	for sibling := top.Parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+		if sibling == top {
+			nodes = append(nodes, sibling)
+			continue
+		}
+		if sibling.Type != html.ElementNode || sibling.DataAtom != atom.P {
+			continue
+		}
+		text := normalizeSpace(textContent(sibling))
+		if len(text) > 80 && linkDensity(sibling) < 0.25 {
+			nodes = append(nodes, sibling)
+		}
+	}
+	return nodes
+}
+
+// writeClean writes n as HTML keeping only keptTags, with links and images
+// made absolute. Top-level headings repeat the title and are dropped.
+func writeClean(b *strings.Builder, n *html.Node, pageURL *url.URL) {
+	switch n.Type {
+	case html.TextNode:
+		b.WriteString(html.EscapeString(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			writeClean(b, c, pageURL)
+		}
+		return
+	}
+
+	tag := n.DataAtom
+	if tag == atom.H1 {
+		return
+	}
+	if !keptTags[tag] {
+		// Unwrapped blocks still separate their text from the next block's
+		block := n.DataAtom == atom.Div || n.DataAtom == atom.Section || n.DataAtom == atom.Article
+		if block {
+			b.WriteString("\n")
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			writeClean(b, c, pageURL)
+		}
+		if block {
+			b.WriteString("\n")
+		}
+		return
+	}
+
+	switch tag {
+	case atom.Br:
+		b.WriteString("<br>")
+		return
+	case atom.Img:
+		src := resolveURL(pageURL, attr(n, "src"))
+		if src == "" {
+			src = resolveURL(pageURL, attr(n, "data-src"))
+		}
+		if src != "" {
+			b.WriteString(`<img src="` + html.EscapeString(src) + `" alt="` + html.EscapeString(attr(n, "alt")) + `">`)
+		}
+		return
+	case atom.A:
+		href := resolveURL(pageURL, attr(n, "href"))
+		if href == "" || strings.HasPrefix(strings.ToLower(href), "javascript:") {
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				writeClean(b, c, pageURL)
+			}
+			return
+		}
+		b.WriteString(`<a href="` + html.EscapeString(href) + `">`)
+	default:
+		b.WriteString("<" + tag.String() + ">")
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		writeClean(b, c, pageURL)
+	}
+	b.WriteString("</" + tag.String() + ">")
+	if tag == atom.P || tag == atom.Ul || tag == atom.Ol || tag == atom.Blockquote || tag == atom.Pre ||
+		tag == atom.Figure || isHeading(tag) {
+		b.WriteString("\n")
+	}
+}
+
+var (
+	// emptyParagraph matches paragraphs left with nothing in them after cleaning
+	emptyParagraph = regexp.MustCompile(`<p>\s*</p>\n?`)
+	blankLines     = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// collapseBlankParagraphs drops empty paragraphs and runs of blank lines
+func collapseBlankParagraphs(content string) string {
+	content = emptyParagraph.ReplaceAllString(content, "")
+	return blankLines.ReplaceAllString(content, "\n")
+}
+
+// blockTexts returns the text of every paragraph, heading, list item and
+// other block in the article nodes, in document order
+func blockTexts(top *html.Node) []string {
+	var blocks []string
+	for _, node := range articleNodes(top) {
+		walk(node, func(n *html.Node) bool {
+			if n.Type != html.ElementNode {
+				return true
+			}
+			switch {
+			case n.DataAtom == atom.H1:
+				return false
+			case n.DataAtom == atom.P, n.DataAtom == atom.Li, n.DataAtom == atom.Pre,
+				n.DataAtom == atom.Blockquote, n.DataAtom == atom.Figcaption, isHeading(n.DataAtom), isTextDiv(n):
+				if text := normalizeSpace(textContent(n)); text != "" {
+					blocks = append(blocks, text)
+				}
+				return false
+			}
+			return true
+		})
+	}
+	return blocks
+}
+
+func isHeading(tag atom.Atom) bool {
+	switch tag {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		return true
+	}
+	return false
+}
+
+// walk calls fn for n and its descendants in document order, skipping the
+// children of nodes for which fn returns false
+func walk(n *html.Node, fn func(*html.Node) bool) {
+	if !fn(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; {
+		// fn may detach c, so find the next sibling first
+		next := c.NextSibling
+		walk(c, fn)
+		c = next
+	}
+}
+
+// findFirst returns the first element of type tag under n
+func findFirst(n *html.Node, tag atom.Atom) *html.Node {
+	var found *html.Node
+	walk(n, func(c *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if c.DataAtom == tag {
+			found = c
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// inlineTags don't separate the words on either side of them
+var inlineTags = map[atom.Atom]bool{
+	atom.A: true, atom.Abbr: true, atom.B: true, atom.Code: true, atom.Em: true, atom.I: true,
+	atom.Mark: true, atom.Small: true, atom.Span: true, atom.Strong: true, atom.Sub: true,
+	atom.Sup: true, atom.Time: true, atom.U: true,
+}
+
+// textContent concatenates the text under n, with a space wherever a block
+// element starts so words in adjacent blocks don't run together
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		switch {
+		case c.Type == html.TextNode:
+			b.WriteString(c.Data)
+		case c.Type == html.ElementNode && !inlineTags[c.DataAtom]:
+			b.WriteString(" ")
+		}
+		return true
+	})
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func hasAttr(n *html.Node, key string) bool {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// resolveURL makes ref absolute against base, returning "" for data URIs and
+// references that don't parse
+func resolveURL(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.HasPrefix(ref, "data:") {
+		return ""
+	}
+	parsed, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	if base == nil {
+		return parsed.String()
+	}
+	return base.ResolveReference(parsed).String()
+}
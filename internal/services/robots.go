@@ -0,0 +1,148 @@
+package services
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robotsRules are the rules of a robots.txt file that apply to one crawler
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// robotsRule is one Allow or Disallow line
+type robotsRule struct {
+	allow   bool
+	length  int
+	pattern *regexp.Regexp
+}
+
+// robotsGroup is a run of User-agent lines and the rules that follow them
+type robotsGroup struct {
+	agents     []string
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// allowAllRobots is used when a site has no robots.txt
+var allowAllRobots = &robotsRules{}
+
+// disallowAllRobots is used when a site's robots.txt can't be read, as
+// RFC 9309 asks crawlers to assume the whole site is off limits
+var disallowAllRobots = &robotsRules{rules: []robotsRule{{allow: false, length: 1, pattern: regexp.MustCompile(`^/`)}}}
+
+// parseRobots reads a robots.txt file and returns the rules of the group that
+// best matches userAgent: the group naming the longest product token found in
+// userAgent, or the * group when none does. Groups naming the same token are
+// merged.
+func parseRobots(r io.Reader, userAgent string) *robotsRules {
+	var groups []*robotsGroup
+	var current *robotsGroup
+	inAgents := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if !inAgents {
+				current = &robotsGroup{}
+				groups = append(groups, current)
+				inAgents = true
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+		case "allow", "disallow":
+			inAgents = false
+			// An empty Disallow allows everything, which is the default anyway
+			if current == nil || value == "" {
+				continue
+			}
+			current.rules = append(current.rules, robotsRule{
+				allow:   key == "allow",
+				length:  len(value),
+				pattern: robotsPattern(value),
+			})
+		case "crawl-delay":
+			inAgents = false
+			if current == nil {
+				continue
+			}
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		default:
+			// Sitemap and unknown lines don't end the list of agents
+		}
+	}
+
+	userAgent = strings.ToLower(userAgent)
+	best := ""
+	for _, group := range groups {
+		for _, agent := range group.agents {
+			if agent != "*" && len(agent) > len(best) && strings.Contains(userAgent, agent) {
+				best = agent
+			}
+		}
+	}
+	if best == "" {
+		best = "*"
+	}
+
+	rules := &robotsRules{}
+	for _, group := range groups {
+		for _, agent := range group.agents {
+			if agent == best {
+				rules.rules = append(rules.rules, group.rules...)
+				rules.crawlDelay = max(rules.crawlDelay, group.crawlDelay)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// Allowed reports whether path, with its query string, may be fetched. The
+// longest matching rule wins and Allow wins a tie.
+func (r *robotsRules) Allowed(path string) bool {
+	if path == "" {
+		path = "/"
+	}
+	allowed, longest := true, -1
+	for _, rule := range r.rules {
+		if !rule.pattern.MatchString(path) {
+			continue
+		}
+		if rule.length > longest || (rule.length == longest && rule.allow) {
+			allowed, longest = rule.allow, rule.length
+		}
+	}
+	return allowed
+}
+
+// robotsPattern compiles a robots.txt path pattern, where * matches any run
+// of characters and a trailing $ anchors the end of the path
+func robotsPattern(value string) *regexp.Regexp {
+	anchored := strings.HasSuffix(value, "$")
+	value = strings.TrimSuffix(value, "$")
+
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(value), `\*`, ".*")
+	if anchored {
+		expr += "$"
+	}
+	return regexp.MustCompile(expr)
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrRoleNotFound is returned when changing or assigning a role that doesn't exist
+	ErrRoleNotFound = errors.New("role not found")
+	// ErrRoleExists is returned when creating a role with a name already taken
+	ErrRoleExists = errors.New("role already exists")
+	// ErrRoleBuiltIn is returned when deleting a built-in role
+	ErrRoleBuiltIn = errors.New("built-in roles can't be deleted")
+	// ErrRoleLocked is returned when changing the permissions of the admin role
+	ErrRoleLocked = errors.New("the admin role can't be changed")
+	// ErrRoleInUse is returned when deleting a role users still have
+	ErrRoleInUse = errors.New("role is assigned to users")
+	// ErrInvalidRoleName is returned for names that aren't lowercase words
+	ErrInvalidRoleName = errors.New("role name must contain only lowercase letters, digits, hyphens and underscores")
+	// ErrUnknownPermission is returned for permissions that aren't actions
+	ErrUnknownPermission = errors.New("unknown permission")
+)
+
+// roleNamePattern matches role names such as "moderator" or "guest_writer"
+var roleNamePattern = regexp.MustCompile(`^[a-z0-9]+([_-][a-z0-9]+)*$`)
+
+// RoleService manages roles and keeps the policy's role permissions in step
+// with them
+type RoleService struct {
+	db *gorm.DB
+}
+
+// NewRoleService creates a new role service
+func NewRoleService(db *gorm.DB) *RoleService {
+	return &RoleService{db: db}
+}
+
+// Load reads every role's permissions into the policy. Until it runs, and for
+// built-in roles missing from the database, the policy uses its defaults.
+func (s *RoleService) Load() error {
+	var roles []models.Role
+	if err := s.db.Find(&roles).Error; err != nil {
+		return fmt.Errorf("failed to load roles: %w", err)
+	}
+
+	grants := make(map[string][]policy.Action, len(roles))
+	for _, role := range roles {
+		actions := make([]policy.Action, 0, len(role.Permissions))
+		for _, permission := range role.Permissions {
+			actions = append(actions, policy.Action(permission))
+		}
+		grants[role.Name] = actions
+	}
+	policy.SetRoles(grants)
+	return nil
+}
+
+// All returns every role with the number of users who have it, built-in
+// roles first
+func (s *RoleService) All() ([]models.Role, error) {
+	roles := []models.Role{}
+	if err := s.db.Order("built_in DESC, name ASC").Find(&roles).Error; err != nil {
+		return nil, fmt.Errorf("failed to load roles: %w", err)
+	}
+
+	var counts []struct {
+		Role  string
+		Count int64
+	}
+	if err := s.db.Model(&models.User{}).Select("role, COUNT(*) AS count").Group("role").Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count users per role: %w", err)
+	}
+	byRole := make(map[string]int64, len(counts))
+	for _, count := range counts {
+		byRole[count.Role] = count.Count
+	}
+	for i := range roles {
+		roles[i].UserCount = byRole[roles[i].Name]
+		if roles[i].Permissions == nil {
+			roles[i].Permissions = []string{}
+		}
+	}
+	return roles, nil
+}
+
+// Exists reports whether a role named name exists
+func (s *RoleService) Exists(name string) (bool, error) {
+	var count int64
+	if err := s.db.Model(&models.Role{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to look up role: %w", err)
+	}
+	return count > 0, nil
+}
+
+// Create adds a custom role
+func (s *RoleService) Create(req models.CreateRoleRequest) (*models.Role, error) {
+	if !roleNamePattern.MatchString(req.Name) {
+		return nil, ErrInvalidRoleName
+	}
+	if err := validatePermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+	exists, err := s.Exists(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrRoleExists
+	}
+
+	role := models.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: uniquePermissions(req.Permissions),
+	}
+	if err := s.db.Create(&role).Error; err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+	s.reload()
+	return &role, nil
+}
+
+// Update changes the description or permissions of a role
+func (s *RoleService) Update(id uint, req models.UpdateRoleRequest) (*models.Role, error) {
+	role, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Permissions != nil {
+		if role.Name == policy.RoleAdmin {
+			return nil, ErrRoleLocked
+		}
+		if err := validatePermissions(req.Permissions); err != nil {
+			return nil, err
+		}
+		role.Permissions = uniquePermissions(req.Permissions)
+	}
+	if req.Description != nil {
+		role.Description = *req.Description
+	}
+
+	if err := s.db.Save(role).Error; err != nil {
+		return nil, fmt.Errorf("failed to save role: %w", err)
+	}
+	s.reload()
+	return role, nil
+}
+
+// Delete removes a custom role nobody has
+func (s *RoleService) Delete(id uint) (*models.Role, error) {
+	role, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if role.BuiltIn {
+		return nil, ErrRoleBuiltIn
+	}
+
+	var users int64
+	if err := s.db.Model(&models.User{}).Where("role = ?", role.Name).Count(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to count users with role: %w", err)
+	}
+	if users > 0 {
+		return nil, ErrRoleInUse
+	}
+
+	if err := s.db.Delete(role).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete role: %w", err)
+	}
+	s.reload()
+	return role, nil
+}
+
+// Get returns the role with id
+func (s *RoleService) Get(id uint) (*models.Role, error) {
+	var role models.Role
+	if err := s.db.First(&role, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("failed to load role: %w", err)
+	}
+	return &role, nil
+}
+
+// reload refreshes the policy after a change. Other instances pick the change
+// up on their next periodic reload.
+func (s *RoleService) reload() {
+	if err := s.Load(); err != nil {
+		log.Error().Err(err).Msg("Failed to reload roles")
+	}
+}
+
+// validatePermissions checks that every permission is a known action
+func validatePermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if !policy.IsPermission(permission) {
+			return fmt.Errorf("%w: %s", ErrUnknownPermission, permission)
+		}
+	}
+	return nil
+}
+
+// uniquePermissions drops repeated permissions, keeping their order
+func uniquePermissions(permissions []string) []string {
+	seen := make(map[string]bool, len(permissions))
+	unique := []string{}
+	for _, permission := range permissions {
+		if !seen[permission] {
+			seen[permission] = true
+			unique = append(unique, permission)
+		}
+	}
+	return unique
+}
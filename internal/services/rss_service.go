@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"crypto/md5"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,35 +13,48 @@ import (
 
 	"github.com/gosimple/slug"
 	"github.com/mmcdole/gofeed"
-	"github.com/phanvantai/taiphanvan_backend/internal/config"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"github.com/rs/zerolog/log"
 )
 
+// ErrNoNewsSources is returned when there are no enabled RSS feeds to fetch
+var ErrNoNewsSources = errors.New("no RSS feeds configured")
+
 // RSSService handles fetching news from RSS feeds
 type RSSService struct {
-	cfg        config.RSSConfig
-	httpClient *http.Client
-	parser     *gofeed.Parser
+	sources        *NewsSourceService
+	taxonomy       *NewsTaxonomy
+	onHealthChange func(source models.NewsSource)
+	httpClient     *http.Client
+	parser         *gofeed.Parser
+	fetchRunIDs    []uint // Fetch runs recorded by FetchNews, completed by FinishFetchRuns
 }
 
-// NewRSSService creates a new RSS feed service
-func NewRSSService(cfg config.RSSConfig) (*RSSService, error) {
-	if len(cfg.Feeds) == 0 {
-		return nil, fmt.Errorf("no RSS feeds configured")
-	}
-
+// NewRSSService creates a new RSS feed service. Articles from feeds without a
+// known category are classified with the taxonomy. Each fetch is recorded in
+// the fetch history with sources, unless it is nil, and onHealthChange, if
+// set, is called when a feed becomes unhealthy or recovers.
+func NewRSSService(sources *NewsSourceService, taxonomy *NewsTaxonomy, onHealthChange func(source models.NewsSource)) *RSSService {
 	return &RSSService{
-		cfg: cfg,
+		sources:        sources,
+		taxonomy:       taxonomy,
+		onHealthChange: onHealthChange,
 		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   10 * time.Second,
+			Transport: tracing.Transport(nil),
 		},
 		parser: gofeed.NewParser(),
-	}, nil
+	}
 }
 
-// FetchNews fetches news articles from configured RSS feeds
-func (s *RSSService) FetchNews(ctx context.Context, limit int) ([]models.News, error) {
+// FetchNews fetches news articles from the given feeds.
+// Feeds that fail are skipped and reported in the returned source errors.
+func (s *RSSService) FetchNews(ctx context.Context, feeds []models.NewsSource, limit int) ([]models.News, []models.IngestionSourceError) {
+	if len(feeds) == 0 {
+		return nil, nil
+	}
+
 	if limit <= 0 {
 		limit = 10 // Default limit
 	}
@@ -51,29 +65,33 @@ func (s *RSSService) FetchNews(ctx context.Context, limit int) ([]models.News, e
 	}
 
 	var allNews []models.News
+	var sourceErrors []models.IngestionSourceError
 
 	// Distribute the limit across feeds
-	limitPerFeed := limit / len(s.cfg.Feeds)
+	limitPerFeed := limit / len(feeds)
 	if limitPerFeed < 1 {
 		limitPerFeed = 1
 	}
 
 	// Fetch from each feed
-	for _, feed := range s.cfg.Feeds {
+	for _, feed := range feeds {
+		startedAt := time.Now()
 		feedNews, err := s.fetchFromFeed(ctx, feed, limitPerFeed)
+		s.recordFetch(feed, startedAt, len(feedNews), err)
 		if err != nil {
 			log.Error().Err(err).Str("feed_url", feed.URL).Msg("Failed to fetch news from RSS feed")
+			sourceErrors = append(sourceErrors, models.IngestionSourceError{Source: feed.Name, Error: err.Error()})
 			continue // Continue with other feeds
 		}
 
 		allNews = append(allNews, feedNews...)
 	}
 
-	return allNews, nil
+	return allNews, sourceErrors
 }
 
 // fetchFromFeed fetches news articles from a single RSS feed
-func (s *RSSService) fetchFromFeed(ctx context.Context, feed config.RSSFeed, limit int) ([]models.News, error) {
+func (s *RSSService) fetchFromFeed(ctx context.Context, feed models.NewsSource, limit int) ([]models.News, error) {
 	// Create request with context
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.URL, nil)
 	if err != nil {
@@ -105,13 +123,27 @@ func (s *RSSService) fetchFromFeed(ctx context.Context, feed config.RSSFeed, lim
 
 	// Process items into news articles
 	var news []models.News
-	newsCategory := mapCategoryString(feed.Category)
+	feedCategory, feedHasCategory := s.taxonomy.Resolve(string(feed.Category))
+
+	// Feeds declare their language as a tag like "vi-VN"; others are taken as
+	// English. Ingestion prefers the language detected from each article.
+	language := models.DefaultContentLanguage
+	if lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(parsedFeed.Language)), "-"); len(lang) == 2 {
+		language = lang
+	}
 
 	// Cap the number of items to process
 	itemCount := min(len(parsedFeed.Items), limit)
 
 	for i := range itemCount {
 		item := parsedFeed.Items[i]
+
+		// Use the feed's category if it is enabled, otherwise classify the article
+		newsCategory := feedCategory
+		if !feedHasCategory {
+			newsCategory = s.taxonomy.Classify(item.Title, item.Description)
+		}
+
 		// Generate a unique slug
 		titleSlug := slug.Make(item.Title)
 
@@ -218,6 +250,7 @@ func (s *RSSService) fetchFromFeed(ctx context.Context, feed config.RSSFeed, lim
 			ImageURL:    imageURL,
 			Category:    newsCategory,
 			Status:      models.NewsStatusPublished,
+			Language:    language,
 			Published:   true,
 			PublishDate: publishDate,
 			ExternalID:  externalID,
@@ -232,22 +265,61 @@ func (s *RSSService) fetchFromFeed(ctx context.Context, feed config.RSSFeed, lim
 	return news, nil
 }
 
-// mapCategoryString maps a string category to the NewsCategory type
-func mapCategoryString(category string) models.NewsCategory {
-	// Default to technology if no category is specified
-	if category == "" {
-		return models.NewsCategoryTechnology
+// CheckFeed fetches a feed and verifies that it parses, without saving anything
+func (s *RSSService) CheckFeed(ctx context.Context, feed models.NewsSource) error {
+	_, err := s.fetchFromFeed(ctx, feed, 1)
+	return err
+}
+
+// FinishFetchRuns links the fetches recorded by FetchNews to the ingestion
+// run that stored their articles, so the fetch history shows what each feed
+// added
+func (s *RSSService) FinishFetchRuns(run models.IngestionRun) {
+	if s.sources == nil {
+		return
+	}
+	if err := s.sources.FinishFetchRuns(s.fetchRunIDs, run.ID); err != nil {
+		log.Error().Err(err).Uint("run_id", run.ID).Msg("Failed to update RSS fetch history")
+	}
+	s.fetchRunIDs = nil
+}
+
+// recordFetch adds a fetch of feed to the fetch history and updates the
+// feed's health
+func (s *RSSService) recordFetch(feed models.NewsSource, startedAt time.Time, count int, fetchErr error) {
+	if s.sources == nil {
+		return
+	}
+
+	run := models.FetchRun{
+		SourceID:   feed.ID,
+		SourceName: feed.Name,
+		Status:     models.NewsSourceFetchOK,
+		DurationMs: time.Since(startedAt).Milliseconds(),
+		ItemsFound: count,
+		StartedAt:  startedAt,
+	}
+	if fetchErr != nil {
+		run.Status = models.NewsSourceFetchFailed
+		run.Error = fetchErr.Error()
 	}
 
-	// Convert to lowercase for case-insensitive comparison
-	category = strings.ToLower(category)
+	source, healthChanged, err := s.sources.RecordFetch(feed, &run)
+	if err != nil {
+		log.Error().Err(err).Str("feed", feed.Name).Msg("Failed to record RSS feed fetch")
+		return
+	}
+	s.fetchRunIDs = append(s.fetchRunIDs, run.ID)
 
-	switch category {
-	case "technology", "tech":
-		return models.NewsCategoryTechnology
-	case "science":
-		return models.NewsCategoryScience
-	default:
-		return models.NewsCategory(category)
+	if !healthChanged {
+		return
+	}
+	if source.Healthy {
+		log.Info().Uint("source_id", source.ID).Str("feed", source.Name).Msg("RSS feed recovered")
+	} else {
+		log.Error().Uint("source_id", source.ID).Str("feed", source.Name).Int("consecutive_failures", source.ConsecutiveFailures).Str("error", source.LastFetchError).Msg("RSS feed is unhealthy")
+	}
+	if s.onHealthChange != nil {
+		s.onHealthChange(source)
 	}
 }
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// defaultScraperUserAgent is sent with scraper requests unless the
+// scraper.user_agent setting changes it
+const defaultScraperUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36"
+
+const (
+	// robotsCacheTTL is how long a site's robots.txt is trusted
+	robotsCacheTTL = 24 * time.Hour
+	// robotsErrorTTL is how long a robots.txt that couldn't be read keeps
+	// the site off limits before it's tried again
+	robotsErrorTTL = 10 * time.Minute
+	// maxRobotsSize limits how much of a robots.txt is read
+	maxRobotsSize = 512 << 10 // 512 KiB
+	// maxCrawlDelay caps the Crawl-delay a site can ask for, so one site
+	// can't stall a batch
+	maxCrawlDelay = 30 * time.Second
+)
+
+var (
+	// ErrDomainBlocked is returned when scraping a domain the admins blocked,
+	// or one missing from a non-empty allow list
+	ErrDomainBlocked = errors.New("domain is blocked for scraping")
+	// ErrRobotsDisallowed is returned when a site's robots.txt doesn't allow
+	// fetching a page
+	ErrRobotsDisallowed = errors.New("page is disallowed by robots.txt")
+)
+
+// robotsCache keeps the parsed robots.txt of each site in memory, keyed by
+// scheme and host
+var robotsCache struct {
+	sync.Mutex
+	entries map[string]robotsCacheEntry
+}
+
+type robotsCacheEntry struct {
+	rules     *robotsRules
+	expiresAt time.Time
+}
+
+// hostThrottle holds the earliest time each host may be fetched again. It is
+// shared by every scraper in the process.
+var hostThrottle struct {
+	sync.Mutex
+	next map[string]time.Time
+}
+
+// scraperPolicy is a snapshot of the scraper settings, read once per fetch
+type scraperPolicy struct {
+	userAgent     string
+	respectRobots bool
+	delay         time.Duration
+	jitter        time.Duration
+	blocked       []string
+	allowed       []string
+}
+
+// loadScraperPolicy reads the scraper settings
+func loadScraperPolicy(settings *SiteSettingsService) scraperPolicy {
+	return scraperPolicy{
+		userAgent:     settings.Get(SettingScraperUserAgent),
+		respectRobots: settings.Bool(SettingScraperRespectRobots),
+		delay:         settings.Duration(SettingScraperDomainDelay),
+		jitter:        settings.Duration(SettingScraperDomainJitter),
+		blocked:       settings.List(SettingScraperBlockedDomains),
+		allowed:       settings.List(SettingScraperAllowedDomains),
+	}
+}
+
+// domainAllowed reports whether host may be scraped. A domain in a list
+// covers its subdomains as well.
+func (p scraperPolicy) domainAllowed(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if matchesDomain(host, p.blocked) {
+		return false
+	}
+	return len(p.allowed) == 0 || matchesDomain(host, p.allowed)
+}
+
+// matchesDomain reports whether host is one of domains or a subdomain of one
+func matchesDomain(host string, domains []string) bool {
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// robots returns the robots.txt rules of the site serving target, fetching
+// the file when it isn't cached
+func (s *ContentScraper) robots(ctx context.Context, target *url.URL, userAgent string) *robotsRules {
+	key := target.Scheme + "://" + target.Host
+
+	robotsCache.Lock()
+	entry, ok := robotsCache.entries[key]
+	robotsCache.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.rules
+	}
+
+	rules, err := s.fetchRobots(ctx, key+"/robots.txt", userAgent)
+	ttl := robotsCacheTTL
+	if err != nil {
+		log.Warn().Err(err).Str("host", target.Host).Msg("Failed to read robots.txt, treating the site as disallowed")
+		rules, ttl = disallowAllRobots, robotsErrorTTL
+	}
+
+	robotsCache.Lock()
+	if robotsCache.entries == nil {
+		robotsCache.entries = make(map[string]robotsCacheEntry)
+	}
+	robotsCache.entries[key] = robotsCacheEntry{rules: rules, expiresAt: time.Now().Add(ttl)}
+	robotsCache.Unlock()
+
+	return rules
+}
+
+// fetchRobots downloads and parses a robots.txt. A missing file allows
+// everything; server errors are returned so the site is left alone.
+func (s *ContentScraper) fetchRobots(ctx context.Context, robotsURL, userAgent string) (*robotsRules, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create robots.txt request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch robots.txt: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return parseRobots(io.LimitReader(resp.Body, maxRobotsSize), userAgent), nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return allowAllRobots, nil
+	default:
+		return nil, fmt.Errorf("robots.txt returned status %d", resp.StatusCode)
+	}
+}
+
+// waitForHost blocks until host may be fetched again and books the next
+// slot, delay plus up to jitter later, so concurrent fetches of one host are
+// spaced out rather than sent together
+func waitForHost(ctx context.Context, host string, delay, jitter time.Duration) error {
+	now := time.Now()
+	if jitter > 0 {
+		delay += rand.N(jitter)
+	}
+
+	hostThrottle.Lock()
+	if hostThrottle.next == nil {
+		hostThrottle.next = make(map[string]time.Time)
+	}
+	slot := hostThrottle.next[host]
+	if slot.Before(now) {
+		slot = now
+	}
+	hostThrottle.next[host] = slot.Add(delay)
+
+	// Hosts whose slot has passed don't need remembering
+	for h, next := range hostThrottle.next {
+		if next.Before(now) {
+			delete(hostThrottle.next, h)
+		}
+	}
+	hostThrottle.Unlock()
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
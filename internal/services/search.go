@@ -0,0 +1,78 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"gorm.io/gorm"
+)
+
+// searchHeadlineOptions controls the snippets built around matched words
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" ... \""
+
+// SearchService searches posts, news and tags through the full-text search index
+type SearchService struct {
+	db *gorm.DB
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(db *gorm.DB) *SearchService {
+	return &SearchService{db: db}
+}
+
+// Search returns up to limit matches of query for each of types, most
+// relevant first. The query accepts web search syntax: quoted phrases, OR
+// and -word to exclude a word.
+func (s *SearchService) Search(query string, types []models.SearchResultType, limit int) (models.SearchResponse, error) {
+	response := models.SearchResponse{Query: query}
+	for _, resultType := range types {
+		group, err := s.searchType(query, resultType, limit)
+		if err != nil {
+			return models.SearchResponse{}, err
+		}
+		switch resultType {
+		case models.SearchResultPost:
+			response.Posts = group
+		case models.SearchResultNews:
+			response.News = group
+		case models.SearchResultTag:
+			response.Tags = group
+		}
+	}
+	return response, nil
+}
+
+// searchType finds the matches of a single type
+func (s *SearchService) searchType(query string, resultType models.SearchResultType, limit int) (*models.SearchGroup, error) {
+	matches := s.db.Table(database.SearchIndexView+", websearch_to_tsquery('simple', ?) AS query", query).
+		Where("type = ? AND document @@ query", resultType)
+	if siteID, ok := site.IDFromContext(s.db.Statement.Context); ok {
+		matches = matches.Where("site_id IS NULL OR site_id = ?", siteID)
+	}
+	matches = matches.Session(&gorm.Session{})
+
+	group := &models.SearchGroup{Results: []models.SearchResult{}}
+	if err := matches.Count(&group.Total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count %s results: %w", resultType, err)
+	}
+	if group.Total == 0 {
+		return group, nil
+	}
+
+	if err := matches.Select("type, id, uuid, title, slug, published_at, "+
+		"ts_rank_cd(document, query) AS score, "+
+		"ts_headline('simple', body, query, ?) AS snippet", searchHeadlineOptions).
+		Order("score DESC, published_at DESC NULLS LAST, id DESC").
+		Limit(limit).
+		Scan(&group.Results).Error; err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", resultType, err)
+	}
+	return group, nil
+}
+
+// Refresh rebuilds the search index from the current content
+func (s *SearchService) Refresh() error {
+	return database.RefreshSearchIndex(s.db)
+}
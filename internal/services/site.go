@@ -0,0 +1,244 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/site"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// siteCacheTTL is how long the sites are served from memory before they're
+// read again, which is how long other instances take to see a change
+const siteCacheTTL = time.Minute
+
+var (
+	// ErrSiteNotFound is returned when changing a site that doesn't exist, or
+	// resolving a host when no site is the default
+	ErrSiteNotFound = errors.New("site not found")
+	// ErrSiteDomainTaken is returned when a domain is already another site's
+	ErrSiteDomainTaken = errors.New("domain is already used by another site")
+	// ErrInvalidSiteDomain is returned for domains that aren't host names
+	ErrInvalidSiteDomain = errors.New("domain must be a host name without a scheme, port or path")
+	// ErrSiteIsDefault is returned when deleting the default site or making it
+	// not the default
+	ErrSiteIsDefault = errors.New("the default site can't be deleted or unset")
+	// ErrSiteHasContent is returned when deleting a site that still has posts,
+	// tags, pages or news sources
+	ErrSiteHasContent = errors.New("site still has content")
+)
+
+// siteCache keeps the sites in memory, keyed by domain, so resolving the site
+// of a request doesn't query the database
+var siteCache struct {
+	sync.Mutex
+	byDomain  map[string]models.Site
+	fallback  *models.Site
+	expiresAt time.Time
+}
+
+// SiteService manages the sites the deployment serves and resolves the site
+// of a request's host
+type SiteService struct {
+	db *gorm.DB
+}
+
+// NewSiteService creates a new site service
+func NewSiteService(db *gorm.DB) *SiteService {
+	return &SiteService{db: db}
+}
+
+// Resolve returns the site served on host, or the default site when no site
+// has the host's domain. A port in host is ignored.
+func (s *SiteService) Resolve(host string) (*models.Site, error) {
+	domain := NormalizeSiteDomain(host)
+
+	siteCache.Lock()
+	defer siteCache.Unlock()
+
+	if siteCache.byDomain == nil || time.Now().After(siteCache.expiresAt) {
+		var sites []models.Site
+		if err := s.db.Find(&sites).Error; err != nil {
+			if siteCache.byDomain == nil {
+				return nil, fmt.Errorf("failed to load sites: %w", err)
+			}
+			// Keep serving the sites read last rather than querying on
+			// every request while the database is unreachable
+			log.Warn().Err(err).Msg("Failed to reload sites, using the cached sites")
+		} else {
+			siteCache.byDomain = make(map[string]models.Site, len(sites))
+			siteCache.fallback = nil
+			for _, site := range sites {
+				if site.Domain != "" {
+					siteCache.byDomain[site.Domain] = site
+				}
+				if site.Default {
+					siteCache.fallback = &site
+				}
+			}
+		}
+		siteCache.expiresAt = time.Now().Add(siteCacheTTL)
+	}
+
+	if site, ok := siteCache.byDomain[domain]; ok {
+		return &site, nil
+	}
+	if siteCache.fallback == nil {
+		return nil, ErrSiteNotFound
+	}
+	fallback := *siteCache.fallback
+	return &fallback, nil
+}
+
+// All returns every site, the default first
+func (s *SiteService) All() ([]models.Site, error) {
+	sites := []models.Site{}
+	if err := s.db.Order("is_default DESC, name ASC").Find(&sites).Error; err != nil {
+		return nil, fmt.Errorf("failed to load sites: %w", err)
+	}
+	return sites, nil
+}
+
+// Get returns the site with id
+func (s *SiteService) Get(id uint) (*models.Site, error) {
+	var site models.Site
+	if err := s.db.First(&site, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSiteNotFound
+		}
+		return nil, fmt.Errorf("failed to load site: %w", err)
+	}
+	return &site, nil
+}
+
+// Create adds a site
+func (s *SiteService) Create(req models.CreateSiteRequest) (*models.Site, error) {
+	domain, err := s.availableDomain(req.Domain, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	site := &models.Site{Name: strings.TrimSpace(req.Name), Domain: domain, Default: req.Default}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if site.Default {
+			if err := tx.Model(&models.Site{}).Where("is_default").Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(site).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save site: %w", err)
+	}
+	invalidateSiteCache()
+	return site, nil
+}
+
+// Update changes a site. Making a site the default takes over from the
+// current default.
+func (s *SiteService) Update(id uint, req models.UpdateSiteRequest) (*models.Site, error) {
+	site, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		site.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Domain != nil {
+		if site.Domain, err = s.availableDomain(*req.Domain, id); err != nil {
+			return nil, err
+		}
+	}
+	if req.Default != nil {
+		if site.Default && !*req.Default {
+			return nil, ErrSiteIsDefault
+		}
+		site.Default = *req.Default
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if site.Default {
+			if err := tx.Model(&models.Site{}).Where("is_default AND id <> ?", id).Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Select("Name", "Domain", "Default", "UpdatedAt").Save(site).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save site: %w", err)
+	}
+	invalidateSiteCache()
+	return site, nil
+}
+
+// Delete removes a site without content. The default site can't be deleted.
+func (s *SiteService) Delete(id uint) (*models.Site, error) {
+	deleted, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if deleted.Default {
+		return nil, ErrSiteIsDefault
+	}
+
+	// Count across sites, not within the site of the admin's request
+	unscoped := s.db.WithContext(site.WithoutID(s.db.Statement.Context))
+	for _, model := range []any{&models.Post{}, &models.Tag{}, &models.Page{}, &models.NewsSource{}, &models.PostTemplate{}} {
+		var count int64
+		if err := unscoped.Model(model).Unscoped().Where("site_id = ?", id).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count site content: %w", err)
+		}
+		if count > 0 {
+			return nil, ErrSiteHasContent
+		}
+	}
+
+	if err := s.db.Delete(deleted).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete site: %w", err)
+	}
+	invalidateSiteCache()
+	return deleted, nil
+}
+
+// availableDomain normalizes domain and checks that no site other than the
+// one with id uses it
+func (s *SiteService) availableDomain(domain string, id uint) (string, error) {
+	domain = NormalizeSiteDomain(domain)
+	if domain == "" || strings.ContainsAny(domain, "/:@?# ") {
+		return "", ErrInvalidSiteDomain
+	}
+
+	var count int64
+	if err := s.db.Model(&models.Site{}).Where("domain = ? AND id <> ?", domain, id).Count(&count).Error; err != nil {
+		return "", fmt.Errorf("failed to look up site domain: %w", err)
+	}
+	if count > 0 {
+		return "", ErrSiteDomainTaken
+	}
+	return domain, nil
+}
+
+// NormalizeSiteDomain returns the domain of a Host header or configured
+// domain: lowercase, without a port or trailing dot
+func NormalizeSiteDomain(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// invalidateSiteCache makes the next request read the sites again. Other
+// instances pick the change up when their cache expires.
+func invalidateSiteCache() {
+	siteCache.Lock()
+	siteCache.byDomain = nil
+	siteCache.Unlock()
+}
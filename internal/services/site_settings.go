@@ -0,0 +1,269 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Site setting keys
+const (
+	SettingFeedRanker           = "feed.ranker"
+	SettingFeedRecencyWeight    = "feed.recency_weight"
+	SettingFeedPopularityWeight = "feed.popularity_weight"
+	SettingFeedEditorialWeight  = "feed.editorial_weight"
+	SettingFeedRecencyHalfLife  = "feed.recency_half_life"
+
+	SettingTrustNewDailyComments         = "trust.new_daily_comments"
+	SettingTrustNewDailyPosts            = "trust.new_daily_posts"
+	SettingTrustBasicDailyComments       = "trust.basic_daily_comments"
+	SettingTrustBasicDailyPosts          = "trust.basic_daily_posts"
+	SettingTrustBasicMinAccountAge       = "trust.basic_min_account_age"
+	SettingTrustBasicMinApproved         = "trust.basic_min_approved"
+	SettingTrustEstablishedMinAccountAge = "trust.established_min_account_age"
+	SettingTrustEstablishedMinApproved   = "trust.established_min_approved"
+
+	SettingSpamCommentCooldown = "spam.comment_cooldown"
+	SettingSpamMaxLinks        = "spam.max_links"
+
+	SettingNewsSourceUnhealthyAfter = "news.source_unhealthy_after"
+
+	SettingScraperUserAgent      = "scraper.user_agent"
+	SettingScraperRespectRobots  = "scraper.respect_robots"
+	SettingScraperDomainDelay    = "scraper.domain_delay"
+	SettingScraperDomainJitter   = "scraper.domain_jitter"
+	SettingScraperBlockedDomains = "scraper.blocked_domains"
+	SettingScraperAllowedDomains = "scraper.allowed_domains"
+)
+
+var (
+	// ErrUnknownSetting is returned when changing a setting that isn't defined
+	ErrUnknownSetting = errors.New("unknown setting")
+	// ErrInvalidSettingValue is returned when a new value fails validation
+	ErrInvalidSettingValue = errors.New("invalid setting value")
+)
+
+// siteSettingDefinition describes a known setting
+type siteSettingDefinition struct {
+	defaultValue string
+	validate     func(value string) error
+}
+
+// siteSettings lists every setting that can be changed at runtime
+var siteSettings = map[string]siteSettingDefinition{
+	SettingFeedRanker:           {"blend", validateRankerName},
+	SettingFeedRecencyWeight:    {"1.0", validateNonNegativeFloat},
+	SettingFeedPopularityWeight: {"0.5", validateNonNegativeFloat},
+	SettingFeedEditorialWeight:  {"1.0", validateNonNegativeFloat},
+	SettingFeedRecencyHalfLife:  {"48h", validatePositiveDuration},
+
+	SettingTrustNewDailyComments:         {"5", validateNonNegativeInt},
+	SettingTrustNewDailyPosts:            {"1", validateNonNegativeInt},
+	SettingTrustBasicDailyComments:       {"20", validateNonNegativeInt},
+	SettingTrustBasicDailyPosts:          {"5", validateNonNegativeInt},
+	SettingTrustBasicMinAccountAge:       {"72h", validatePositiveDuration},
+	SettingTrustBasicMinApproved:         {"3", validateNonNegativeInt},
+	SettingTrustEstablishedMinAccountAge: {"720h", validatePositiveDuration},
+	SettingTrustEstablishedMinApproved:   {"20", validateNonNegativeInt},
+
+	SettingSpamCommentCooldown: {"30s", validateNonNegativeDuration},
+	SettingSpamMaxLinks:        {"3", validateNonNegativeInt},
+
+	SettingNewsSourceUnhealthyAfter: {"3", validateNonNegativeInt},
+
+	SettingScraperUserAgent:      {defaultScraperUserAgent, validateNotBlank},
+	SettingScraperRespectRobots:  {"true", validateBool},
+	SettingScraperDomainDelay:    {"2s", validateNonNegativeDuration},
+	SettingScraperDomainJitter:   {"1s", validateNonNegativeDuration},
+	SettingScraperBlockedDomains: {"", validateDomainList},
+	SettingScraperAllowedDomains: {"", validateDomainList},
+}
+
+// SiteSettingsService reads and changes site settings
+type SiteSettingsService struct {
+	db *gorm.DB
+}
+
+// NewSiteSettingsService creates a new site settings service
+func NewSiteSettingsService(db *gorm.DB) *SiteSettingsService {
+	return &SiteSettingsService{db: db}
+}
+
+// All returns every known setting with its current value, sorted by key
+func (s *SiteSettingsService) All() ([]models.SiteSetting, error) {
+	var stored []models.SiteSetting
+	if err := s.db.Find(&stored).Error; err != nil {
+		return nil, fmt.Errorf("failed to load site settings: %w", err)
+	}
+
+	byKey := make(map[string]models.SiteSetting, len(stored))
+	for _, setting := range stored {
+		byKey[setting.Key] = setting
+	}
+
+	settings := make([]models.SiteSetting, 0, len(siteSettings))
+	for key, definition := range siteSettings {
+		setting, ok := byKey[key]
+		if !ok {
+			setting = models.SiteSetting{Key: key, Value: definition.defaultValue}
+		}
+		settings = append(settings, setting)
+	}
+	sort.Slice(settings, func(i, j int) bool { return settings[i].Key < settings[j].Key })
+
+	return settings, nil
+}
+
+// Get returns the current value of a setting, or its default if it hasn't been set
+func (s *SiteSettingsService) Get(key string) string {
+	definition := siteSettings[key]
+
+	var setting models.SiteSetting
+	if err := s.db.Where("key = ?", key).Limit(1).Find(&setting).Error; err != nil {
+		log.Error().Err(err).Str("key", key).Msg("Failed to load site setting, using default")
+		return definition.defaultValue
+	}
+	if setting.Key == "" {
+		return definition.defaultValue
+	}
+	return setting.Value
+}
+
+// Float returns a numeric setting, falling back to its default if the stored value is invalid
+func (s *SiteSettingsService) Float(key string) float64 {
+	value, err := strconv.ParseFloat(s.Get(key), 64)
+	if err != nil {
+		value, _ = strconv.ParseFloat(siteSettings[key].defaultValue, 64)
+	}
+	return value
+}
+
+// Int returns an integer setting, falling back to its default if the stored value is invalid
+func (s *SiteSettingsService) Int(key string) int {
+	value, err := strconv.Atoi(s.Get(key))
+	if err != nil {
+		value, _ = strconv.Atoi(siteSettings[key].defaultValue)
+	}
+	return value
+}
+
+// Duration returns a duration setting, falling back to its default if the stored value is invalid
+func (s *SiteSettingsService) Duration(key string) time.Duration {
+	value, err := time.ParseDuration(s.Get(key))
+	if err != nil {
+		value, _ = time.ParseDuration(siteSettings[key].defaultValue)
+	}
+	return value
+}
+
+// Bool returns a boolean setting, falling back to its default if the stored value is invalid
+func (s *SiteSettingsService) Bool(key string) bool {
+	value, err := strconv.ParseBool(s.Get(key))
+	if err != nil {
+		value, _ = strconv.ParseBool(siteSettings[key].defaultValue)
+	}
+	return value
+}
+
+// List returns a comma-separated setting as its trimmed, non-empty items
+func (s *SiteSettingsService) List(key string) []string {
+	var items []string
+	for _, item := range strings.Split(s.Get(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Set validates and stores a new value for a setting
+func (s *SiteSettingsService) Set(key, value string) (models.SiteSetting, error) {
+	definition, ok := siteSettings[key]
+	if !ok {
+		return models.SiteSetting{}, ErrUnknownSetting
+	}
+	if err := definition.validate(value); err != nil {
+		return models.SiteSetting{}, fmt.Errorf("%w: %v", ErrInvalidSettingValue, err)
+	}
+
+	setting := models.SiteSetting{Key: key, Value: value}
+	if err := s.db.Save(&setting).Error; err != nil {
+		return models.SiteSetting{}, fmt.Errorf("failed to save site setting: %w", err)
+	}
+	return setting, nil
+}
+
+func validateNonNegativeFloat(value string) error {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f < 0 {
+		return errors.New("value must be a non-negative number")
+	}
+	return nil
+}
+
+func validateNonNegativeInt(value string) error {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		return errors.New("value must be a non-negative whole number")
+	}
+	return nil
+}
+
+func validatePositiveDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return errors.New("value must be a positive duration such as 48h")
+	}
+	return nil
+}
+
+func validateNonNegativeDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return errors.New("value must be a duration such as 30s, or 0 to turn it off")
+	}
+	return nil
+}
+
+func validateNotBlank(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New("value must not be empty")
+	}
+	return nil
+}
+
+func validateBool(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return errors.New("value must be true or false")
+	}
+	return nil
+}
+
+// validateDomainList accepts a comma-separated list of bare host names such
+// as example.com, or an empty value
+func validateDomainList(value string) error {
+	for _, domain := range strings.Split(value, ",") {
+		domain = strings.TrimSpace(domain)
+		if domain == "" {
+			continue
+		}
+		if strings.ContainsAny(domain, "/:@ ") || !strings.Contains(domain, ".") {
+			return fmt.Errorf("%q is not a domain; use comma-separated host names such as example.com", domain)
+		}
+	}
+	return nil
+}
+
+func validateRankerName(value string) error {
+	if _, ok := rankers[value]; !ok {
+		return fmt.Errorf("value must be one of: %s", joinRankerNames())
+	}
+	return nil
+}
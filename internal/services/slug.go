@@ -0,0 +1,100 @@
+package services
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gosimple/slug"
+)
+
+const (
+	// slugMaxLength keeps slugs within the narrowest slug column (120)
+	// with room for a uniqueness suffix
+	slugMaxLength = 100
+	// slugMaxSuffix is the highest numbered suffix tried before falling
+	// back to a timestamp
+	slugMaxSuffix = 50
+	// untitledSlug is used for titles with nothing to transliterate
+	untitledSlug = "untitled"
+)
+
+// reservedSlugs are words the site's routes use next to slugs, such as
+// /posts/me or /news/categories, so content can't shadow them
+var reservedSlugs = map[string]struct{}{
+	"admin":      {},
+	"api":        {},
+	"categories": {},
+	"drafts":     {},
+	"edit":       {},
+	"feed":       {},
+	"me":         {},
+	"new":        {},
+	"preview":    {},
+	"rss":        {},
+	"search":     {},
+	"slug":       {},
+	"tags":       {},
+}
+
+// SlugService turns titles into URL slugs, the same way for every kind of
+// content. Text is transliterated to ASCII, so Vietnamese titles keep their
+// words ("Đà Nẵng" becomes "da-nang") instead of losing every accented
+// letter.
+type SlugService struct{}
+
+// NewSlugService creates a new slug service
+func NewSlugService() *SlugService {
+	return &SlugService{}
+}
+
+// Make returns the slug for text: lowercase ASCII words joined by hyphens,
+// cut at a word boundary to at most slugMaxLength characters
+func (s *SlugService) Make(text string) string {
+	result := slug.Make(text)
+	if len(result) > slugMaxLength {
+		result = result[:slugMaxLength]
+		if i := strings.LastIndexByte(result, '-'); i > 0 {
+			result = result[:i]
+		}
+		result = strings.Trim(result, "-")
+	}
+	if result == "" {
+		return untitledSlug
+	}
+	return result
+}
+
+// IsReserved reports whether slug is one of the words routes use next to
+// slugs
+func (s *SlugService) IsReserved(slug string) bool {
+	_, ok := reservedSlugs[slug]
+	return ok
+}
+
+// Unique returns the slug for text that exists reports as free. A slug that
+// is taken or reserved gets the first free numbered suffix ("my-post-2",
+// "my-post-3", ...), and after slugMaxSuffix of them a timestamp.
+func (s *SlugService) Unique(text string, exists func(slug string) (bool, error)) (string, error) {
+	base := s.Make(text)
+
+	for n := 1; n <= slugMaxSuffix; n++ {
+		candidate := base
+		if n > 1 {
+			candidate = base + "-" + strconv.Itoa(n)
+		}
+		if s.IsReserved(candidate) {
+			continue
+		}
+
+		taken, err := exists(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+
+	return base + "-" + strconv.FormatInt(time.Now().Unix(), 10), nil
+}
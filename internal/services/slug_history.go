@@ -0,0 +1,55 @@
+package services
+
+import (
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SlugHistoryService remembers the slugs posts and news articles had before,
+// so links to them keep working after a rename
+type SlugHistoryService struct {
+	db *gorm.DB
+}
+
+// NewSlugHistoryService creates a new slug history service
+func NewSlugHistoryService(db *gorm.DB) *SlugHistoryService {
+	return &SlugHistoryService{db: db}
+}
+
+// Record remembers that the resource's slug changed from oldSlug to newSlug.
+// An old slug last used by another resource now points at this one, and
+// newSlug stops redirecting since it is current again.
+func (s *SlugHistoryService) Record(resourceType string, resourceID uint, oldSlug, newSlug string) error {
+	if oldSlug == newSlug || oldSlug == "" {
+		return nil
+	}
+
+	if err := s.db.Where("resource_type = ? AND slug = ?", resourceType, newSlug).
+		Delete(&models.SlugHistory{}).Error; err != nil {
+		return err
+	}
+
+	return s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "resource_type"}, {Name: "slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"resource_id", "created_at"}),
+	}).Create(&models.SlugHistory{
+		ResourceType: resourceType,
+		Slug:         oldSlug,
+		ResourceID:   resourceID,
+		CreatedAt:    time.Now(),
+	}).Error
+}
+
+// Resolve returns the ID of the resource that last used slug. It returns
+// gorm.ErrRecordNotFound when no resource did.
+func (s *SlugHistoryService) Resolve(resourceType, slug string) (uint, error) {
+	var history models.SlugHistory
+	if err := s.db.Where("resource_type = ? AND slug = ?", resourceType, slug).
+		First(&history).Error; err != nil {
+		return 0, err
+	}
+	return history.ResourceID, nil
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+	"github.com/rs/zerolog/log"
+)
+
+// StorageService stores uploaded files and returns the public URLs they are
+// served from. The backend is chosen with STORAGE_BACKEND.
+type StorageService interface {
+	// UploadAvatar stores an avatar image and returns the URLs of the original
+	// and its resized variants
+	UploadAvatar(ctx context.Context, file *multipart.FileHeader, userID uint) (*models.ImageVariants, error)
+	// UploadPostCover stores a post cover image and returns the URLs of the
+	// original and its resized variants
+	UploadPostCover(ctx context.Context, file *multipart.FileHeader, postID uint) (*models.ImageVariants, error)
+	// UploadEditorFile stores a file for editor use and returns its URL
+	UploadEditorFile(ctx context.Context, file *multipart.FileHeader, userID uint) (string, error)
+	// UploadImportedImage stores an image fetched while importing content from
+	// another platform and returns its URL
+	UploadImportedImage(ctx context.Context, filename string, content io.Reader, size int64) (string, error)
+	// UploadOGImage stores a generated PNG share image for a post and returns
+	// its URL
+	UploadOGImage(ctx context.Context, content io.Reader, size int64, postID uint) (string, error)
+	// DeleteImage deletes a file by the URL it was returned under
+	DeleteImage(ctx context.Context, fileURL string) error
+	// Ping checks that the backend is reachable and accepts writes
+	Ping(ctx context.Context) error
+}
+
+// NewStorageService creates the storage backend selected in cfg
+func NewStorageService(cfg *config.Config) (StorageService, error) {
+	switch cfg.Storage.Backend {
+	case config.StorageBackendLocal:
+		store, err := newLocalStore(cfg.Storage.Local)
+		if err != nil {
+			return nil, err
+		}
+		return &blobStorage{store: store}, nil
+	case config.StorageBackendS3:
+		store, err := newS3Store(cfg.Storage.S3)
+		if err != nil {
+			return nil, err
+		}
+		return &blobStorage{store: store}, nil
+	default:
+		return NewCloudinaryService(cfg.Cloudinary)
+	}
+}
+
+// blobStore saves files unchanged under a key
+type blobStore interface {
+	// name identifies the backend in logs and spans
+	name() string
+	// put saves the content under key and returns its public URL
+	put(ctx context.Context, key, contentType string, content io.Reader, size int64) (string, error)
+	// remove deletes the file served at fileURL
+	remove(ctx context.Context, fileURL string) error
+	ping(ctx context.Context) error
+}
+
+// blobStorage implements StorageService on top of a blobStore. Backends other
+// than Cloudinary can't transform images, so every variant is the original.
+type blobStorage struct {
+	store blobStore
+}
+
+// UploadAvatar implements StorageService
+func (s *blobStorage) UploadAvatar(ctx context.Context, file *multipart.FileHeader, userID uint) (*models.ImageVariants, error) {
+	fileURL, err := s.upload(ctx, file, avatarFolder, fmt.Sprintf("user_%d", userID))
+	if err != nil {
+		return nil, err
+	}
+	return &models.ImageVariants{Original: fileURL, Medium: fileURL, Thumbnail: fileURL}, nil
+}
+
+// UploadPostCover implements StorageService
+func (s *blobStorage) UploadPostCover(ctx context.Context, file *multipart.FileHeader, postID uint) (*models.ImageVariants, error) {
+	fileURL, err := s.upload(ctx, file, postCoverFolder, fmt.Sprintf("post_%d", postID))
+	if err != nil {
+		return nil, err
+	}
+	return &models.ImageVariants{Original: fileURL, Medium: fileURL, Thumbnail: fileURL}, nil
+}
+
+// UploadEditorFile implements StorageService
+func (s *blobStorage) UploadEditorFile(ctx context.Context, file *multipart.FileHeader, userID uint) (string, error) {
+	return s.upload(ctx, file, editorFolder, fmt.Sprintf("editor_%d", userID))
+}
+
+// UploadImportedImage implements StorageService
+func (s *blobStorage) UploadImportedImage(ctx context.Context, filename string, content io.Reader, size int64) (string, error) {
+	return s.put(ctx, importedFolder, "import", filename, "", content, size)
+}
+
+// UploadOGImage implements StorageService
+func (s *blobStorage) UploadOGImage(ctx context.Context, content io.Reader, size int64, postID uint) (string, error) {
+	return s.put(ctx, ogImageFolder, fmt.Sprintf("post_%d", postID), "og.png", "image/png", content, size)
+}
+
+// DeleteImage implements StorageService
+func (s *blobStorage) DeleteImage(ctx context.Context, fileURL string) error {
+	if fileURL == "" {
+		return nil // Nothing to delete
+	}
+
+	ctx, span := tracing.Start(ctx, s.store.name()+".delete", tracing.SpanKindInternal)
+	defer span.End()
+
+	if err := s.store.remove(ctx, fileURL); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	log.Info().Str("backend", s.store.name()).Str("url", fileURL).Msg("File deleted successfully")
+	return nil
+}
+
+// Ping implements StorageService
+func (s *blobStorage) Ping(ctx context.Context) error {
+	return s.store.ping(ctx)
+}
+
+// upload saves file as <folder>/<prefix>_<timestamp><ext>
+func (s *blobStorage) upload(ctx context.Context, file *multipart.FileHeader, folder, prefix string) (string, error) {
+	src, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open uploaded file: %w", err)
+	}
+	defer src.Close()
+
+	return s.put(ctx, folder, prefix, file.Filename, file.Header.Get("Content-Type"), src, file.Size)
+}
+
+// put saves content as <folder>/<prefix>_<timestamp><ext>, taking the
+// extension from filename
+func (s *blobStorage) put(ctx context.Context, folder, prefix, filename, contentType string, content io.Reader, size int64) (string, error) {
+	ctx, span := tracing.Start(ctx, s.store.name()+".upload", tracing.SpanKindInternal)
+	defer span.End()
+	span.SetAttribute("storage.folder", folder)
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	key := fmt.Sprintf("%s/%s_%d%s", folder, prefix, time.Now().UnixNano(), ext)
+
+	if byExt := mime.TypeByExtension(ext); byExt != "" {
+		contentType = byExt
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	log.Info().
+		Str("backend", s.store.name()).
+		Str("key", key).
+		Str("filename", filename).
+		Msg("Uploading file")
+
+	fileURL, err := s.store.put(ctx, key, contentType, content, size)
+	if err != nil {
+		span.RecordError(err)
+		return "", err
+	}
+
+	log.Info().Str("backend", s.store.name()).Str("url", fileURL).Msg("File uploaded successfully")
+	return fileURL, nil
+}
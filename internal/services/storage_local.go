@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+)
+
+// localStore keeps uploads in a directory on disk. The API serves the
+// directory itself when the public URL is a path, see cmd/api.
+type localStore struct {
+	dir       string
+	publicURL string
+}
+
+func newLocalStore(cfg config.LocalStorageConfig) (*localStore, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("missing STORAGE_LOCAL_DIR")
+	}
+	dir, err := filepath.Abs(cfg.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid STORAGE_LOCAL_DIR: %w", err)
+	}
+	return &localStore{dir: dir, publicURL: cfg.PublicURL}, nil
+}
+
+func (s *localStore) name() string { return "local" }
+
+func (s *localStore) put(ctx context.Context, key, contentType string, content io.Reader, size int64) (string, error) {
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	// Write to a temporary file first so a failed upload leaves nothing behind
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return "", fmt.Errorf("failed to set file permissions: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to save file: %w", err)
+	}
+
+	return s.publicURL + "/" + key, nil
+}
+
+func (s *localStore) remove(ctx context.Context, fileURL string) error {
+	key, ok := strings.CutPrefix(fileURL, s.publicURL+"/")
+	if !ok {
+		return fmt.Errorf("file URL is not served from %s", s.publicURL)
+	}
+
+	// Refuse keys that would escape the upload directory
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.dir+string(filepath.Separator)) {
+		return fmt.Errorf("invalid file URL")
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+func (s *localStore) ping(ctx context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", s.dir, err)
+	}
+	probe, err := os.CreateTemp(s.dir, ".ping-*")
+	if err != nil {
+		return fmt.Errorf("%s is not writable: %w", s.dir, err)
+	}
+	probe.Close()
+	return os.Remove(probe.Name())
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+)
+
+// s3UnsignedPayload skips hashing the body when signing. The body is still
+// protected by TLS, and S3 and MinIO both accept it.
+const s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+// s3Store keeps uploads in a bucket of an S3-compatible object store. Requests
+// are signed with AWS Signature Version 4. Objects are not given an ACL, so the
+// bucket policy must allow public reads.
+type s3Store struct {
+	cfg        config.S3StorageConfig
+	bucketURL  *url.URL
+	publicURL  string
+	httpClient *http.Client
+}
+
+func newS3Store(cfg config.S3StorageConfig) (*s3Store, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("missing S3 configuration")
+	}
+
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3_ENDPOINT %q", cfg.Endpoint)
+	}
+
+	bucketURL := *endpoint
+	if cfg.PathStyle {
+		bucketURL.Path = strings.TrimSuffix(bucketURL.Path, "/") + "/" + cfg.Bucket
+	} else {
+		bucketURL.Host = cfg.Bucket + "." + bucketURL.Host
+	}
+
+	publicURL := cfg.PublicURL
+	if publicURL == "" {
+		publicURL = bucketURL.String()
+	}
+
+	return &s3Store{
+		cfg:       cfg,
+		bucketURL: &bucketURL,
+		publicURL: publicURL,
+		httpClient: &http.Client{
+			Timeout:   60 * time.Second,
+			Transport: tracing.Transport(nil),
+		},
+	}, nil
+}
+
+func (s *s3Store) name() string { return "s3" }
+
+func (s *s3Store) put(ctx context.Context, key, contentType string, content io.Reader, size int64) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), content)
+	if err != nil {
+		return "", fmt.Errorf("failed to create S3 request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	if err := s.do(req); err != nil {
+		return "", fmt.Errorf("failed to upload to S3: %w", err)
+	}
+	return s.publicURL + "/" + key, nil
+}
+
+func (s *s3Store) remove(ctx context.Context, fileURL string) error {
+	key, ok := strings.CutPrefix(fileURL, s.publicURL+"/")
+	if !ok || key == "" {
+		return fmt.Errorf("file URL is not served from %s", s.publicURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 request: %w", err)
+	}
+	if err := s.do(req); err != nil {
+		return fmt.Errorf("failed to delete from S3: %w", err)
+	}
+	return nil
+}
+
+// ping checks that the bucket exists and the credentials are accepted
+func (s *s3Store) ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.bucketURL.String()+"/", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 request: %w", err)
+	}
+	if err := s.do(req); err != nil {
+		return fmt.Errorf("failed to reach bucket %s: %w", s.cfg.Bucket, err)
+	}
+	return nil
+}
+
+func (s *s3Store) objectURL(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return s.bucketURL.String() + "/" + strings.Join(segments, "/")
+}
+
+// do signs and sends req, and turns non-2xx responses into errors
+func (s *s3Store) do(req *http.Request) error {
+	s.sign(req, time.Now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req. See
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *s3Store) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	// Only the headers we set are signed; proxies and tracing may add others
+	signed := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": s3UnsignedPayload,
+		"x-amz-date":           amzDate,
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		signed["content-type"] = contentType
+	}
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(signed[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex(canonicalRequest),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrTagNotFound is returned when changing a tag that doesn't exist
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagNameTaken is returned when renaming a tag to the name of another tag
+	ErrTagNameTaken = errors.New("tag name already taken")
+	// ErrTagMergeSelf is returned when merging a tag into itself
+	ErrTagMergeSelf = errors.New("tag can't be merged into itself")
+	// ErrTagInUse is returned when deleting a tag that posts or news still use
+	ErrTagInUse = errors.New("tag is in use")
+)
+
+// tagJoinTables are the tables that associate tags with content
+var tagJoinTables = []struct {
+	table  string
+	column string
+}{
+	{"post_tags", "post_id"},
+	{"news_tags", "news_id"},
+}
+
+// TagService curates the tags that posts and news are created with
+type TagService struct {
+	db *gorm.DB
+}
+
+// NewTagService creates a new tag service
+func NewTagService(db *gorm.DB) *TagService {
+	return &TagService{db: db}
+}
+
+// Find returns the tag with the given ID
+func (s *TagService) Find(id uint) (*models.Tag, error) {
+	var tag models.Tag
+	if err := s.db.First(&tag, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to load tag: %w", err)
+	}
+	return &tag, nil
+}
+
+// WithCount returns the tag with the number of posts using it
+func (s *TagService) WithCount(tag models.Tag) (models.TagWithCount, error) {
+	result := models.TagWithCount{ID: tag.ID, Name: tag.Name}
+	if err := s.db.Table("post_tags").Where("tag_id = ?", tag.ID).Count(&result.PostCount).Error; err != nil {
+		return result, fmt.Errorf("failed to count tag posts: %w", err)
+	}
+	return result, nil
+}
+
+// Resolve returns the tags named in names, in the order they are named,
+// creating the ones that don't exist yet. Names are trimmed, and blank and
+// repeated names are skipped. Existing tags are found with one query and the
+// missing ones inserted with another, however many names there are.
+func (s *TagService) Resolve(names []string) ([]models.Tag, error) {
+	unique := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		unique = append(unique, name)
+	}
+	if len(unique) == 0 {
+		return []models.Tag{}, nil
+	}
+
+	var existing []models.Tag
+	if err := s.db.Where("name IN ?", unique).Find(&existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to load tags: %w", err)
+	}
+	byName := make(map[string]models.Tag, len(unique))
+	for _, tag := range existing {
+		byName[tag.Name] = tag
+	}
+
+	var missing []string
+	for _, name := range unique {
+		if _, ok := byName[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		inserts := make([]models.Tag, len(missing))
+		for i, name := range missing {
+			inserts[i] = models.Tag{Name: name}
+		}
+		// Another request may create the same tags meanwhile, so conflicts
+		// are skipped and the tags read back rather than taken from RETURNING
+		if err := s.db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "site_id"}, {Name: "name"}}, DoNothing: true}).
+			Create(&inserts).Error; err != nil {
+			return nil, fmt.Errorf("failed to create tags: %w", err)
+		}
+		var created []models.Tag
+		if err := s.db.Where("name IN ?", missing).Find(&created).Error; err != nil {
+			return nil, fmt.Errorf("failed to load created tags: %w", err)
+		}
+		for _, tag := range created {
+			byName[tag.Name] = tag
+		}
+	}
+
+	tags := make([]models.Tag, 0, len(unique))
+	for _, name := range unique {
+		if tag, ok := byName[name]; ok {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+// SetPostTags replaces the tags of the post postID with the tags named in
+// names, creating missing tags
+func (s *TagService) SetPostTags(postID uint, names []string) ([]models.Tag, error) {
+	return s.setTags("post_tags", "post_id", postID, names)
+}
+
+// SetNewsTags replaces the tags of the news article newsID with the tags
+// named in names, creating missing tags
+func (s *TagService) SetNewsTags(newsID uint, names []string) ([]models.Tag, error) {
+	return s.setTags("news_tags", "news_id", newsID, names)
+}
+
+// setTags resolves names and replaces the rows of a tag join table for one
+// post or news article in a single statement: rows for tags no longer named
+// are deleted and rows for new ones inserted
+func (s *TagService) setTags(table, column string, id uint, names []string) ([]models.Tag, error) {
+	tags, err := s.Resolve(names)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		if err := s.db.Exec(fmt.Sprintf(`DELETE FROM %s WHERE %s = ?`, table, column), id).Error; err != nil {
+			return nil, fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+		return tags, nil
+	}
+
+	tagIDs := make([]uint, len(tags))
+	for i, tag := range tags {
+		tagIDs[i] = tag.ID
+	}
+	if err := s.db.Exec(fmt.Sprintf(
+		`WITH removed AS (DELETE FROM %[1]s WHERE %[2]s = ? AND tag_id NOT IN ?)
+		INSERT INTO %[1]s (%[2]s, tag_id) SELECT ?, id FROM tags WHERE id IN ? ON CONFLICT DO NOTHING`,
+		table, column), id, tagIDs, id, tagIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to replace %s: %w", table, err)
+	}
+	return tags, nil
+}
+
+// Rename changes the name of a tag. Posts and news refer to tags by ID, so
+// they follow the new name, and ?tag= filters use it from then on. A name
+// another tag already has, compared case-insensitively, is refused: those
+// tags should be merged. It returns the renamed tag and its previous name.
+func (s *TagService) Rename(id uint, name string) (*models.Tag, string, error) {
+	tag, err := s.Find(id)
+	if err != nil {
+		return nil, "", err
+	}
+	oldName := tag.Name
+
+	name = strings.TrimSpace(name)
+	var count int64
+	if err := s.db.Model(&models.Tag{}).
+		Where("LOWER(name) = LOWER(?) AND id <> ?", name, tag.ID).
+		Count(&count).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to check tag name: %w", err)
+	}
+	if count > 0 {
+		return nil, "", ErrTagNameTaken
+	}
+
+	if err := s.db.Model(tag).Update("name", name).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to rename tag: %w", err)
+	}
+	return tag, oldName, nil
+}
+
+// Merge moves every post and news article tagged with the tag sourceID to
+// the tag targetID and deletes the source tag. Content that already has both
+// tags keeps a single association. It returns the target tag.
+func (s *TagService) Merge(sourceID, targetID uint) (*models.Tag, error) {
+	if sourceID == targetID {
+		return nil, ErrTagMergeSelf
+	}
+	source, err := s.Find(sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.Find(targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		for _, join := range tagJoinTables {
+			if err := tx.Exec(fmt.Sprintf(
+				`INSERT INTO %[1]s (%[2]s, tag_id) SELECT %[2]s, ? FROM %[1]s WHERE tag_id = ? ON CONFLICT DO NOTHING`,
+				join.table, join.column), target.ID, source.ID).Error; err != nil {
+				return fmt.Errorf("failed to move %s: %w", join.table, err)
+			}
+			if err := tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE tag_id = ?`, join.table), source.ID).Error; err != nil {
+				return fmt.Errorf("failed to remove %s: %w", join.table, err)
+			}
+		}
+		if err := tx.Delete(source).Error; err != nil {
+			return fmt.Errorf("failed to delete merged tag: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// Delete removes a tag that no post or news article uses. Tags still in use
+// are refused with ErrTagInUse, so deleting can't silently untag content.
+func (s *TagService) Delete(id uint) (*models.Tag, error) {
+	tag, err := s.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		for _, join := range tagJoinTables {
+			var count int64
+			if err := tx.Table(join.table).Where("tag_id = ?", tag.ID).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check tag usage: %w", err)
+			}
+			if count > 0 {
+				return ErrTagInUse
+			}
+		}
+		if err := tx.Delete(tag).Error; err != nil {
+			return fmt.Errorf("failed to delete tag: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
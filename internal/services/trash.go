@@ -0,0 +1,152 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// trashPurgeBatchSize is how many posts or articles are purged per transaction
+const trashPurgeBatchSize = 200
+
+// TrashPurgeResult counts the posts and news articles removed by a purge
+type TrashPurgeResult struct {
+	Posts int64
+	News  int64
+}
+
+// TrashService permanently removes deleted posts and news articles. Deleting
+// content through the API only marks it deleted, so it can still be looked up
+// by slug history and restored by hand; purging drops it with the rows that
+// refer to it.
+type TrashService struct {
+	db *gorm.DB
+}
+
+// NewTrashService creates a new trash service
+func NewTrashService(db *gorm.DB) *TrashService {
+	return &TrashService{db: db}
+}
+
+// Purge removes the posts and news articles deleted before cutoff. News
+// articles a post still comments on are kept.
+func (s *TrashService) Purge(cutoff time.Time) (TrashPurgeResult, error) {
+	var result TrashPurgeResult
+
+	for {
+		var ids []uint
+		if err := s.db.Unscoped().Model(&models.Post{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Order("id").Limit(trashPurgeBatchSize).
+			Pluck("id", &ids).Error; err != nil {
+			return result, fmt.Errorf("failed to find deleted posts: %w", err)
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		count, err := s.purgePosts(ids)
+		result.Posts += count
+		if err != nil {
+			return result, err
+		}
+	}
+
+	for {
+		var ids []uint
+		if err := s.db.Unscoped().Model(&models.News{}).
+			Where("news.deleted_at IS NOT NULL AND news.deleted_at < ?", cutoff).
+			Where("NOT EXISTS (SELECT 1 FROM posts WHERE posts.news_id = news.id)").
+			Order("news.id").Limit(trashPurgeBatchSize).
+			Pluck("news.id", &ids).Error; err != nil {
+			return result, fmt.Errorf("failed to find deleted news: %w", err)
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		count, err := s.purgeNews(ids)
+		result.News += count
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// purgePosts removes the posts with their comments, tag and author links,
+// bookmarks, slug history, outbound links and homepage picks. Notifications,
+// analytics, cross-posts, reading progress and autosaves go with the post.
+func (s *TrashService) purgePosts(ids []uint) (int64, error) {
+	var purged int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("post_id IN ?", ids).Delete(&models.Comment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete comments: %w", err)
+		}
+		if err := tx.Exec("DELETE FROM post_tags WHERE post_id IN ?", ids).Error; err != nil {
+			return fmt.Errorf("failed to delete post tags: %w", err)
+		}
+		if err := tx.Where("post_id IN ?", ids).Delete(&models.PostAuthor{}).Error; err != nil {
+			return fmt.Errorf("failed to delete post authors: %w", err)
+		}
+		if err := tx.Where("post_id IN ?", ids).Delete(&models.Bookmark{}).Error; err != nil {
+			return fmt.Errorf("failed to delete bookmarks: %w", err)
+		}
+		if err := s.purgeReferences(tx, models.SlugResourcePost, ids); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&models.Post{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete posts: %w", result.Error)
+		}
+		purged = result.RowsAffected
+		return nil
+	})
+	return purged, err
+}
+
+// purgeNews removes the articles with their enriched content, tag links, slug
+// history, outbound links and homepage picks. Bookmarks go with the article;
+// category corrections are kept, as news retention keeps them.
+func (s *TrashService) purgeNews(ids []uint) (int64, error) {
+	var purged int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("news_id IN ?", ids).Delete(&models.EnrichedNewsContent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete enriched content: %w", err)
+		}
+		if err := tx.Exec("DELETE FROM news_tags WHERE news_id IN ?", ids).Error; err != nil {
+			return fmt.Errorf("failed to delete news tags: %w", err)
+		}
+		if err := s.purgeReferences(tx, models.SlugResourceNews, ids); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&models.News{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete news: %w", result.Error)
+		}
+		purged = result.RowsAffected
+		return nil
+	})
+	return purged, err
+}
+
+// purgeReferences deletes the slug history, outbound links and homepage picks
+// of the posts or news articles with ids. The three tables name the kind of
+// content the same way ("post" or "news").
+func (s *TrashService) purgeReferences(tx *gorm.DB, kind string, ids []uint) error {
+	if err := tx.Where("resource_type = ? AND resource_id IN ?", kind, ids).Delete(&models.SlugHistory{}).Error; err != nil {
+		return fmt.Errorf("failed to delete slug history: %w", err)
+	}
+	if err := tx.Where("source_type = ? AND source_id IN ?", kind, ids).Delete(&models.ContentLink{}).Error; err != nil {
+		return fmt.Errorf("failed to delete content links: %w", err)
+	}
+	if err := tx.Where("item_type = ? AND item_id IN ?", kind, ids).Delete(&models.EditorialPick{}).Error; err != nil {
+		return fmt.Errorf("failed to delete editorial picks: %w", err)
+	}
+	return nil
+}
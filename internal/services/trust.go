@@ -0,0 +1,150 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/policy"
+	"gorm.io/gorm"
+)
+
+// Actions limited by the daily quotas
+const (
+	TrustActionComment = "comment"
+	TrustActionPost    = "post"
+)
+
+// linkPattern matches URLs and bare www. hostnames in user content
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://|\bwww\.[a-z0-9-]+\.[a-z]`)
+
+// DailyLimitError is returned when an account has used up its daily quota
+type DailyLimitError struct {
+	Action string
+	Limit  int
+	Level  models.TrustLevel
+}
+
+// Error implements the error interface
+func (e *DailyLimitError) Error() string {
+	return fmt.Sprintf("daily %s limit of %d reached for %s accounts", e.Action, e.Limit, e.Level)
+}
+
+// TrustService assigns accounts a trust level and enforces the quotas that go
+// with it, so drive-by spam accounts can't flood the site. Levels rise
+// automatically with account age and approved content; the thresholds and
+// quotas are site settings.
+type TrustService struct {
+	db       *gorm.DB
+	settings *SiteSettingsService
+}
+
+// NewTrustService creates a new trust service
+func NewTrustService(db *gorm.DB) *TrustService {
+	return &TrustService{
+		db:       db,
+		settings: NewSiteSettingsService(db),
+	}
+}
+
+// Level returns the trust level of user
+func (s *TrustService) Level(user *models.User) (models.TrustLevel, error) {
+	// Users who skip moderation anyway are staff
+	if policy.Grants(user.Role, policy.ActionCommentSkipModeration) {
+		return models.TrustLevelStaff, nil
+	}
+
+	approved, err := s.approvedContent(user.ID)
+	if err != nil {
+		return "", err
+	}
+	age := time.Since(user.CreatedAt)
+
+	switch {
+	case age >= s.settings.Duration(SettingTrustEstablishedMinAccountAge) &&
+		approved >= int64(s.settings.Int(SettingTrustEstablishedMinApproved)):
+		return models.TrustLevelEstablished, nil
+	case age >= s.settings.Duration(SettingTrustBasicMinAccountAge) &&
+		approved >= int64(s.settings.Int(SettingTrustBasicMinApproved)):
+		return models.TrustLevelBasic, nil
+	default:
+		return models.TrustLevelNew, nil
+	}
+}
+
+// CheckDailyLimit returns the user's trust level, or a *DailyLimitError if
+// they have already used their quota for action in the last 24 hours
+func (s *TrustService) CheckDailyLimit(user *models.User, action string) (models.TrustLevel, error) {
+	level, err := s.Level(user)
+	if err != nil {
+		return "", err
+	}
+
+	limit, limited := s.dailyLimit(level, action)
+	if !limited {
+		return level, nil
+	}
+
+	// Deleted content still counts, so deleting and reposting doesn't reset the quota
+	var model interface{} = &models.Comment{}
+	if action == TrustActionPost {
+		model = &models.Post{}
+	}
+	var count int64
+	if err := s.db.Unscoped().Model(model).
+		Where("user_id = ? AND created_at > ?", user.ID, time.Now().Add(-24*time.Hour)).
+		Count(&count).Error; err != nil {
+		return "", fmt.Errorf("failed to count recent %ss: %w", action, err)
+	}
+
+	if count >= int64(limit) {
+		return level, &DailyLimitError{Action: action, Limit: limit, Level: level}
+	}
+	return level, nil
+}
+
+// NeedsModeration reports whether content written by an account at level must
+// be held for moderation, which is the case for links from new accounts
+func NeedsModeration(level models.TrustLevel, content string) bool {
+	return level == models.TrustLevelNew && linkPattern.MatchString(content)
+}
+
+// dailyLimit returns the quota for action at level, and false if there is none
+func (s *TrustService) dailyLimit(level models.TrustLevel, action string) (int, bool) {
+	keys := map[models.TrustLevel]map[string]string{
+		models.TrustLevelNew: {
+			TrustActionComment: SettingTrustNewDailyComments,
+			TrustActionPost:    SettingTrustNewDailyPosts,
+		},
+		models.TrustLevelBasic: {
+			TrustActionComment: SettingTrustBasicDailyComments,
+			TrustActionPost:    SettingTrustBasicDailyPosts,
+		},
+	}
+
+	key, ok := keys[level][action]
+	if !ok {
+		return 0, false
+	}
+	return s.settings.Int(key), true
+}
+
+// approvedContent counts the user's approved comments and published posts
+func (s *TrustService) approvedContent(userID uint) (int64, error) {
+	var comments int64
+	if err := s.db.Model(&models.Comment{}).
+		Where("user_id = ? AND status = ?", userID, models.CommentStatusApproved).
+		Count(&comments).Error; err != nil {
+		return 0, fmt.Errorf("failed to count approved comments: %w", err)
+	}
+
+	var posts int64
+	if err := s.db.Model(&models.Post{}).
+		Where("user_id = ? AND status = ?", userID, models.PostStatusPublished).
+		Count(&posts).Error; err != nil {
+		return 0, fmt.Errorf("failed to count published posts: %w", err)
+	}
+
+	return comments + posts, nil
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderDelivery  = "X-Webhook-Delivery"
+	WebhookHeaderTimestamp = "X-Webhook-Timestamp"
+	WebhookHeaderSignature = "X-Webhook-Signature"
+)
+
+// WebhookPayload is the JSON body sent to webhook receivers
+type WebhookPayload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookService delivers content events to registered webhooks
+type WebhookService struct {
+	db         *gorm.DB
+	cfg        config.WebhookConfig
+	httpClient *http.Client
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(db *gorm.DB, cfg config.WebhookConfig) *WebhookService {
+	return &WebhookService{
+		db:  db,
+		cfg: cfg,
+		httpClient: &http.Client{
+			Timeout: cfg.Timeout,
+		},
+	}
+}
+
+// GenerateWebhookSecret creates a random signing secret for a new webhook
+func GenerateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// SignWebhookPayload computes the signature receivers use to verify a delivery.
+// The signed message is "<timestamp>.<body>" so that old deliveries can't be replayed.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatch sends event to every active webhook subscribed to it.
+// Deliveries and their retries run in the background.
+func (s *WebhookService) Dispatch(event string, data interface{}) {
+	var webhooks []models.Webhook
+	if err := s.db.Where("active = ?", true).Find(&webhooks).Error; err != nil {
+		log.Error().Err(err).Str("event", event).Msg("Failed to load webhooks")
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event) {
+			continue
+		}
+
+		payload, err := newWebhookPayload(event, data)
+		if err != nil {
+			log.Error().Err(err).Str("event", event).Msg("Failed to encode webhook payload")
+			return
+		}
+
+		go s.deliverWithRetries(webhook, event, payload)
+	}
+}
+
+// Deliver makes a single delivery attempt of event to webhook and returns its log entry
+func (s *WebhookService) Deliver(webhook models.Webhook, event string, data interface{}) (models.WebhookDelivery, error) {
+	payload, err := newWebhookPayload(event, data)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	return s.attempt(webhook, event, payload, 1), nil
+}
+
+// deliverWithRetries delivers a payload, retrying with exponential backoff until it succeeds
+// or the attempts run out
+func (s *WebhookService) deliverWithRetries(webhook models.Webhook, event string, payload webhookPayload) {
+	backoff := s.cfg.RetryBackoff
+	for attempt := 1; attempt <= s.cfg.MaxAttempts; attempt++ {
+		delivery := s.attempt(webhook, event, payload, attempt)
+		if delivery.Success {
+			return
+		}
+
+		if attempt < s.cfg.MaxAttempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+
+	log.Warn().
+		Uint("webhook_id", webhook.ID).
+		Str("event", event).
+		Str("delivery_id", payload.id).
+		Int("attempts", s.cfg.MaxAttempts).
+		Msg("Webhook delivery failed after all attempts")
+}
+
+// attempt sends the payload once and records the outcome in the delivery log
+func (s *WebhookService) attempt(webhook models.Webhook, event string, payload webhookPayload, attempt int) models.WebhookDelivery {
+	delivery := models.WebhookDelivery{
+		WebhookID:  webhook.ID,
+		DeliveryID: payload.id,
+		Event:      event,
+		Attempt:    attempt,
+	}
+
+	start := time.Now()
+	statusCode, err := s.send(webhook, event, payload)
+	delivery.DurationMs = time.Since(start).Milliseconds()
+	delivery.StatusCode = statusCode
+
+	switch {
+	case err != nil:
+		delivery.Error = err.Error()
+	case statusCode < 200 || statusCode >= 300:
+		delivery.Error = fmt.Sprintf("receiver returned status %d", statusCode)
+	default:
+		delivery.Success = true
+	}
+
+	if err := s.db.Create(&delivery).Error; err != nil {
+		log.Error().Err(err).Uint("webhook_id", webhook.ID).Msg("Failed to record webhook delivery")
+	}
+
+	if !delivery.Success {
+		log.Warn().
+			Uint("webhook_id", webhook.ID).
+			Str("event", event).
+			Int("attempt", attempt).
+			Str("error", delivery.Error).
+			Msg("Webhook delivery attempt failed")
+	}
+
+	return delivery
+}
+
+// send performs the signed HTTP request
+func (s *WebhookService) send(webhook models.Webhook, event string, payload webhookPayload) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload.body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "TaiPhanVanBlog/1.0 Webhooks")
+	req.Header.Set(WebhookHeaderEvent, event)
+	req.Header.Set(WebhookHeaderDelivery, payload.id)
+	req.Header.Set(WebhookHeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(webhook.Secret, timestamp, payload.body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain a bounded amount of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	return resp.StatusCode, nil
+}
+
+// webhookPayload is an encoded payload together with its delivery ID
+type webhookPayload struct {
+	id   string
+	body []byte
+}
+
+// newWebhookPayload wraps data in the standard envelope and encodes it
+func newWebhookPayload(event string, data interface{}) (webhookPayload, error) {
+	id := uuid.NewString()
+	body, err := json.Marshal(WebhookPayload{
+		ID:        id,
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return webhookPayload{}, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	return webhookPayload{id: id, body: body}, nil
+}
@@ -0,0 +1,731 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
+	"github.com/phanvantai/taiphanvan_backend/internal/upload"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxImportImageSize bounds the size of an image downloaded during an import
+	maxImportImageSize = 10 << 20 // 10 MiB
+
+	// wxrDateLayout is the layout of dates in a WordPress export
+	wxrDateLayout = "2006-01-02 15:04:05"
+
+	// wxrPageTag tags imported WordPress pages
+	wxrPageTag = "page"
+
+	// wxrUncategorized is the WordPress default category, which maps to no category
+	wxrUncategorized = "uncategorized"
+)
+
+var (
+	// imageSourcePattern matches the src attribute of img tags
+	imageSourcePattern = regexp.MustCompile(`(?i)<img\b[^>]*?\bsrc\s*=\s*["']([^"']+)["']`)
+	// responsiveImagePattern matches the srcset and sizes attributes WordPress
+	// adds to images, which point at resized copies on the old site
+	responsiveImagePattern = regexp.MustCompile(`(?i)\s(?:srcset|sizes)\s*=\s*(?:"[^"]*"|'[^']*')`)
+)
+
+// WXRImportOptions controls a WordPress import
+type WXRImportOptions struct {
+	PostImportOptions
+	// DownloadImages copies images hosted on the exported site to storage and
+	// rewrites their URLs
+	DownloadImages bool
+}
+
+// The WordPress eXtended RSS (WXR) format. Elements are matched by local name,
+// so exports of every WXR version are read the same way.
+type wxrDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Channel wxrChannel `xml:"channel"`
+}
+
+type wxrChannel struct {
+	Version     string        `xml:"wxr_version"`
+	Links       []string      `xml:"link"`
+	BaseSiteURL string        `xml:"base_site_url"`
+	BaseBlogURL string        `xml:"base_blog_url"`
+	Authors     []wxrAuthor   `xml:"author"`
+	Categories  []wxrCategory `xml:"category"`
+	Items       []wxrItem     `xml:"item"`
+}
+
+type wxrAuthor struct {
+	ID          string `xml:"author_id"`
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+	FirstName   string `xml:"author_first_name"`
+	LastName    string `xml:"author_last_name"`
+}
+
+type wxrCategory struct {
+	Nicename    string `xml:"category_nicename"`
+	Parent      string `xml:"category_parent"`
+	Name        string `xml:"cat_name"`
+	Description string `xml:"category_description"`
+}
+
+type wxrItem struct {
+	Title         string            `xml:"title"`
+	Creator       string            `xml:"creator"`
+	Encoded       []wxrEncoded      `xml:"encoded"`
+	PostID        string            `xml:"post_id"`
+	Date          string            `xml:"post_date"`
+	DateGMT       string            `xml:"post_date_gmt"`
+	ModifiedGMT   string            `xml:"post_modified_gmt"`
+	Name          string            `xml:"post_name"`
+	Status        string            `xml:"status"`
+	Type          string            `xml:"post_type"`
+	AttachmentURL string            `xml:"attachment_url"`
+	Categories    []wxrItemCategory `xml:"category"`
+	Meta          []wxrMeta         `xml:"postmeta"`
+	Comments      []wxrComment      `xml:"comment"`
+}
+
+// wxrEncoded is content:encoded or excerpt:encoded, told apart by namespace
+type wxrEncoded struct {
+	XMLName xml.Name
+	Text    string `xml:",chardata"`
+}
+
+type wxrItemCategory struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type wxrMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+type wxrComment struct {
+	Author   string `xml:"comment_author"`
+	Email    string `xml:"comment_author_email"`
+	Date     string `xml:"comment_date"`
+	DateGMT  string `xml:"comment_date_gmt"`
+	Content  string `xml:"comment_content"`
+	Approved string `xml:"comment_approved"`
+	Type     string `xml:"comment_type"`
+	UserID   string `xml:"comment_user_id"`
+}
+
+// wxrPost is a post or page read from the export, with what is needed to
+// finish mapping it once authors and categories exist locally
+type wxrPost struct {
+	imported   ImportedPost
+	page       bool
+	creator    string
+	categories []string
+	comments   []wxrComment
+}
+
+// wxrImport is the state of one WordPress import
+type wxrImport struct {
+	channel *wxrChannel
+	opts    WXRImportOptions
+	result  *models.WXRImportResult
+	// categories lists the export's categories, parents usually first
+	categories []wxrCategory
+	// usernames and userIDs map WordPress logins and author IDs to local users
+	usernames map[string]string
+	userIDs   map[string]uint
+	// categorySlugs maps WordPress category nicenames to local slugs
+	categorySlugs map[string]string
+	// siteHosts are the hosts images may be downloaded from
+	siteHosts map[string]bool
+}
+
+// ImportWordPress imports the posts and pages of a WordPress export (WXR)
+// file with their authors, categories, tags and comments. Pages are imported
+// as posts tagged "page". Authors are matched to local users by email, then
+// username, and created if neither matches. With DownloadImages, images hosted
+// on the exported site are copied to storage before anything is saved.
+// Parse errors wrap ErrPostImportMalformed.
+func (s *PostTransferService) ImportWordPress(ctx context.Context, data []byte, storage StorageService, opts WXRImportOptions) (*models.WXRImportResult, error) {
+	channel, err := parseWXR(data)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &wxrImport{
+		channel:       channel,
+		opts:          opts,
+		result:        &models.WXRImportResult{DryRun: opts.DryRun},
+		usernames:     make(map[string]string),
+		userIDs:       make(map[string]uint),
+		categorySlugs: make(map[string]string),
+		siteHosts:     make(map[string]bool),
+	}
+	posts := run.collectPosts()
+	if len(posts) > maxImportPosts {
+		return nil, fmt.Errorf("%w: at most %d posts can be imported at once", ErrPostImportMalformed, maxImportPosts)
+	}
+
+	if opts.DownloadImages && !opts.DryRun {
+		if err := run.downloadImages(ctx, s.db, storage, posts); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := run.mapAuthors(tx); err != nil {
+			return err
+		}
+		if err := run.mapCategories(tx); err != nil {
+			return err
+		}
+
+		imported := make([]ImportedPost, len(posts))
+		for i, post := range posts {
+			imported[i] = run.finishPost(post)
+		}
+		run.result.Posts = *importPosts(tx, imported, opts.PostImportOptions)
+
+		if err := run.importComments(tx, posts); err != nil {
+			return err
+		}
+		if opts.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, fmt.Errorf("failed to import WordPress export: %w", err)
+	}
+	return run.result, nil
+}
+
+func parseWXR(data []byte) (*wxrChannel, error) {
+	var doc wxrDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPostImportMalformed, err)
+	}
+	if doc.Channel.Version == "" {
+		return nil, fmt.Errorf("%w: not a WordPress export (wp:wxr_version is missing)", ErrPostImportMalformed)
+	}
+	return &doc.Channel, nil
+}
+
+// collectPosts reads the posts and pages of the export, reporting the items
+// that won't be imported
+func (r *wxrImport) collectPosts() []*wxrPost {
+	for _, link := range append([]string{r.channel.BaseSiteURL, r.channel.BaseBlogURL}, r.channel.Links...) {
+		if u, err := url.Parse(strings.TrimSpace(link)); err == nil && u.Hostname() != "" {
+			r.siteHosts[siteHost(u)] = true
+		}
+	}
+
+	seenCategories := make(map[string]bool)
+	for _, category := range r.channel.Categories {
+		if category.Nicename != "" && !seenCategories[category.Nicename] {
+			seenCategories[category.Nicename] = true
+			r.categories = append(r.categories, category)
+		}
+	}
+
+	// Featured images are attachments referenced by the _thumbnail_id meta
+	attachments := make(map[string]string)
+	for _, item := range r.channel.Items {
+		if item.Type == "attachment" && item.AttachmentURL != "" {
+			attachments[item.PostID] = strings.TrimSpace(item.AttachmentURL)
+		}
+	}
+
+	var posts []*wxrPost
+	for _, item := range r.channel.Items {
+		title := strings.TrimSpace(item.Title)
+		switch item.Type {
+		case "post", "page":
+		case "attachment":
+			continue
+		default:
+			r.skip(title, item.Type, "post type is not supported")
+			continue
+		}
+
+		status, reason := wxrStatus(item.Status)
+		if reason != "" {
+			r.skip(title, item.Type, reason)
+			continue
+		}
+
+		post := &wxrPost{page: item.Type == "page", creator: item.Creator, comments: item.Comments}
+		portable := models.PortablePost{
+			Title:     title,
+			Slug:      wxrSlug(item.Name, title),
+			Status:    status,
+			CreatedAt: wxrTime(item.DateGMT, item.Date),
+			UpdatedAt: wxrTime(item.ModifiedGMT, ""),
+		}
+		if status == models.PostStatusScheduled {
+			portable.PublishAt = portable.CreatedAt
+		}
+
+		for _, encoded := range item.Encoded {
+			if strings.Contains(encoded.XMLName.Space, "excerpt") {
+				portable.Excerpt = strings.TrimSpace(encoded.Text)
+			} else {
+				portable.Content = encoded.Text
+			}
+		}
+
+		for _, meta := range item.Meta {
+			if meta.Key == "_thumbnail_id" {
+				portable.Cover = attachments[strings.TrimSpace(meta.Value)]
+			}
+		}
+
+		for _, category := range item.Categories {
+			name := strings.TrimSpace(category.Name)
+			switch category.Domain {
+			case "post_tag":
+				if name != "" {
+					portable.Tags = append(portable.Tags, name)
+				}
+			case "category":
+				if category.Nicename == "" || category.Nicename == wxrUncategorized {
+					continue
+				}
+				post.categories = append(post.categories, category.Nicename)
+				if !seenCategories[category.Nicename] {
+					seenCategories[category.Nicename] = true
+					r.categories = append(r.categories, wxrCategory{Nicename: category.Nicename, Name: name})
+				}
+			}
+		}
+		if post.page {
+			portable.Tags = append(portable.Tags, wxrPageTag)
+		}
+
+		post.imported = ImportedPost{Source: item.Type + " " + item.PostID, Post: portable}
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+func (r *wxrImport) skip(title, postType, reason string) {
+	r.result.SkippedItems = append(r.result.SkippedItems, models.WXRSkippedItem{Title: title, Type: postType, Reason: reason})
+}
+
+// mapAuthors matches every author of the export to a local user, creating
+// users for authors that don't match
+func (r *wxrImport) mapAuthors(tx *gorm.DB) error {
+	for _, author := range r.channel.Authors {
+		login := strings.TrimSpace(author.Login)
+		if login == "" {
+			continue
+		}
+
+		var user models.User
+		err := gorm.ErrRecordNotFound
+		if email := strings.TrimSpace(author.Email); email != "" {
+			err = tx.Select("id, username").Where("LOWER(email) = LOWER(?)", email).First(&user).Error
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = tx.Select("id, username").Where("username = ?", login).First(&user).Error
+		}
+
+		switch {
+		case err == nil:
+			r.result.AuthorsMatched++
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			created, err := createWXRAuthor(tx, author)
+			if err != nil {
+				return err
+			}
+			user = *created
+			r.result.AuthorsCreated++
+		default:
+			return fmt.Errorf("failed to look up author %q: %w", login, err)
+		}
+
+		r.usernames[login] = user.Username
+		if author.ID != "" {
+			r.userIDs[strings.TrimSpace(author.ID)] = user.ID
+		}
+	}
+	return nil
+}
+
+// createWXRAuthor creates a user for a WordPress author. The account has a
+// random password, so its owner signs in by resetting it.
+func createWXRAuthor(tx *gorm.DB, author wxrAuthor) (*models.User, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(uuid.NewString()), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash author password: %w", err)
+	}
+
+	login := strings.TrimSpace(author.Login)
+	email := strings.ToLower(strings.TrimSpace(author.Email))
+	if email == "" {
+		email = slug.Make(login) + "@users.invalid"
+	}
+	user := models.User{
+		Username:  truncate(login, 50),
+		Email:     truncate(email, 100),
+		Password:  string(hashedPassword),
+		FirstName: truncate(strings.TrimSpace(author.FirstName), 50),
+		LastName:  truncate(strings.TrimSpace(author.LastName), 50),
+		Role:      "user",
+	}
+	if user.FirstName == "" && user.LastName == "" {
+		user.FirstName = truncate(strings.TrimSpace(author.DisplayName), 50)
+	}
+	if err := tx.Create(&user).Error; err != nil {
+		return nil, fmt.Errorf("failed to create author %q: %w", login, err)
+	}
+	return &user, nil
+}
+
+// mapCategories finds or creates a local category for every category of the
+// export, then links new categories to their parents
+func (r *wxrImport) mapCategories(tx *gorm.DB) error {
+	ids := make(map[string]uint)
+	var created []wxrCategory
+	for _, wpCategory := range r.categories {
+		if wpCategory.Nicename == wxrUncategorized {
+			continue
+		}
+
+		name := strings.TrimSpace(html.UnescapeString(wpCategory.Name))
+		categorySlug := wxrSlug(wpCategory.Nicename, name)
+		if categorySlug == "" {
+			continue
+		}
+		if name == "" {
+			name = categorySlug
+		}
+
+		var category models.Category
+		err := tx.Select("id").Where("slug = ?", categorySlug).First(&category).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			category = models.Category{Name: truncate(name, 100), Slug: categorySlug, Description: strings.TrimSpace(wpCategory.Description)}
+			if err := tx.Create(&category).Error; err != nil {
+				return fmt.Errorf("failed to create category %q: %w", categorySlug, err)
+			}
+			created = append(created, wpCategory)
+			r.result.CategoriesCreated++
+		case err != nil:
+			return fmt.Errorf("failed to look up category %q: %w", categorySlug, err)
+		}
+
+		ids[wpCategory.Nicename] = category.ID
+		r.categorySlugs[wpCategory.Nicename] = categorySlug
+	}
+
+	for _, wpCategory := range created {
+		parentID, ok := ids[wpCategory.Parent]
+		if wpCategory.Parent == "" || !ok {
+			continue
+		}
+		if err := tx.Model(&models.Category{}).Where("id = ?", ids[wpCategory.Nicename]).Update("parent_id", parentID).Error; err != nil {
+			return fmt.Errorf("failed to set parent of category %q: %w", wpCategory.Nicename, err)
+		}
+	}
+	return nil
+}
+
+// finishPost sets the local author and category of a post. A post in several
+// categories gets the first, and the names of the others are added as tags.
+func (r *wxrImport) finishPost(post *wxrPost) ImportedPost {
+	imported := post.imported
+	imported.Post.Author = r.usernames[strings.TrimSpace(post.creator)]
+	imported.Post.Tags = append([]string(nil), imported.Post.Tags...)
+
+	for _, nicename := range post.categories {
+		categorySlug, ok := r.categorySlugs[nicename]
+		if !ok {
+			continue
+		}
+		if imported.Post.Category == "" {
+			imported.Post.Category = categorySlug
+			continue
+		}
+		for _, category := range r.categories {
+			if category.Nicename == nicename {
+				imported.Post.Tags = append(imported.Post.Tags, strings.TrimSpace(html.UnescapeString(category.Name)))
+			}
+		}
+	}
+	return imported
+}
+
+// importComments saves the comments of posts the import created. Comments of
+// existing posts are left alone so importing twice doesn't duplicate them.
+// Replies are imported as top-level comments.
+func (r *wxrImport) importComments(tx *gorm.DB, posts []*wxrPost) error {
+	var ghostID uint
+	usersByEmail := make(map[string]uint)
+
+	for i, item := range r.result.Posts.Items {
+		post := posts[i]
+		if item.Action == models.PostImportCreate || item.Action == models.PostImportUpdate {
+			if post.page {
+				r.result.Pages++
+			}
+		}
+		if item.Action != models.PostImportCreate || len(post.comments) == 0 {
+			continue
+		}
+
+		var local models.Post
+		if err := tx.Select("id").Where("slug = ?", item.Slug).First(&local).Error; err != nil {
+			return fmt.Errorf("failed to look up imported post %q: %w", item.Slug, err)
+		}
+
+		for _, wpComment := range post.comments {
+			var status models.CommentStatus
+			switch wpComment.Approved {
+			case "1":
+				status = models.CommentStatusApproved
+			case "0":
+				status = models.CommentStatusPending
+			}
+			content := strings.TrimSpace(wpComment.Content)
+			if status == "" || content == "" || wpComment.Type == "pingback" || wpComment.Type == "trackback" {
+				r.result.CommentsSkipped++
+				continue
+			}
+
+			userID, ok := r.userIDs[strings.TrimSpace(wpComment.UserID)]
+			if email := strings.ToLower(strings.TrimSpace(wpComment.Email)); !ok && email != "" {
+				if userID, ok = usersByEmail[email]; !ok {
+					var user models.User
+					err := tx.Select("id").Where("LOWER(email) = ?", email).First(&user).Error
+					if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+						return fmt.Errorf("failed to look up comment author: %w", err)
+					}
+					userID = user.ID
+					usersByEmail[email] = userID
+				}
+				ok = userID != 0
+			}
+			if !ok {
+				if ghostID == 0 {
+					ghost, err := database.GetOrCreateGhostUser(tx)
+					if err != nil {
+						return err
+					}
+					ghostID = ghost.ID
+				}
+				userID = ghostID
+				r.result.CommentsGuest++
+			}
+
+			comment := models.Comment{Content: content, Status: status, UserID: userID, PostID: local.ID}
+			if createdAt := wxrTime(wpComment.DateGMT, wpComment.Date); createdAt != nil {
+				comment.CreatedAt = *createdAt
+				comment.UpdatedAt = *createdAt
+			}
+			if err := tx.Omit("User", "Post").Create(&comment).Error; err != nil {
+				return fmt.Errorf("failed to save comment on %q: %w", item.Slug, err)
+			}
+			r.result.CommentsImported++
+		}
+	}
+	return nil
+}
+
+// downloadImages copies the images of posts hosted on the exported site to
+// storage and rewrites their URLs. Posts whose slug exists and won't be
+// overwritten are left alone. Images that fail keep their original URL.
+func (r *wxrImport) downloadImages(ctx context.Context, db *gorm.DB, storage StorageService, posts []*wxrPost) error {
+	existing := make(map[string]bool)
+	if !r.opts.Overwrite {
+		slugs := make([]string, 0, len(posts))
+		for _, post := range posts {
+			slugs = append(slugs, post.imported.Post.Slug)
+		}
+		var found []string
+		if err := db.Unscoped().Model(&models.Post{}).Where("slug IN ?", slugs).Pluck("slug", &found).Error; err != nil {
+			return fmt.Errorf("failed to look up existing posts: %w", err)
+		}
+		for _, s := range found {
+			existing[s] = true
+		}
+	}
+
+	client := &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: tracing.Transport(nil),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			if !r.siteHosts[siteHost(req.URL)] {
+				return fmt.Errorf("redirect to %s is not allowed", req.URL.Host)
+			}
+			return nil
+		},
+	}
+
+	copied := make(map[string]string)
+	failed := make(map[string]bool)
+	for _, post := range posts {
+		portable := &post.imported.Post
+		if existing[portable.Slug] {
+			continue
+		}
+
+		sources := []string{portable.Cover}
+		for _, match := range imageSourcePattern.FindAllStringSubmatch(portable.Content, -1) {
+			sources = append(sources, match[1])
+		}
+
+		rewritten := false
+		for _, source := range sources {
+			src := html.UnescapeString(strings.TrimSpace(source))
+			if src == "" || failed[src] {
+				continue
+			}
+			newURL, ok := copied[src]
+			if !ok {
+				u, err := url.Parse(src)
+				if err != nil || (u.Scheme != "http" && u.Scheme != "https") || !r.siteHosts[siteHost(u)] {
+					continue
+				}
+
+				newURL, err = copyImage(ctx, client, storage, u)
+				if err != nil {
+					log.Warn().Err(err).Str("url", src).Msg("Failed to copy imported image")
+					failed[src] = true
+					r.result.ImagesFailed = append(r.result.ImagesFailed, models.WXRImageFailure{URL: src, Error: err.Error()})
+					continue
+				}
+				copied[src] = newURL
+				r.result.ImagesDownloaded++
+			}
+
+			if source == portable.Cover {
+				portable.Cover = newURL
+			}
+			if source != "" && strings.Contains(portable.Content, source) {
+				portable.Content = strings.ReplaceAll(portable.Content, source, newURL)
+				rewritten = true
+			}
+		}
+		if rewritten {
+			portable.Content = responsiveImagePattern.ReplaceAllString(portable.Content, "")
+		}
+	}
+	return nil
+}
+
+// copyImage downloads an image and uploads it to storage
+func copyImage(ctx context.Context, client *http.Client, storage StorageService, u *url.URL) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if resp.ContentLength > maxImportImageSize {
+		return "", fmt.Errorf("image is larger than %d MiB", maxImportImageSize>>20)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxImportImageSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxImportImageSize {
+		return "", fmt.Errorf("image is larger than %d MiB", maxImportImageSize>>20)
+	}
+
+	// Check the content like an upload, since the export's site is untrusted
+	filename := path.Base(u.Path)
+	_, body, err = upload.Inspect(filename, body, upload.Policy{Types: upload.ImageTypes}, upload.Limits{})
+	if err != nil {
+		return "", err
+	}
+	return storage.UploadImportedImage(ctx, filename, bytes.NewReader(body), int64(len(body)))
+}
+
+// wxrStatus maps a WordPress post status, or returns why the post is skipped
+func wxrStatus(status string) (models.PostStatus, string) {
+	switch status {
+	case "publish":
+		return models.PostStatusPublished, ""
+	case "future":
+		return models.PostStatusScheduled, ""
+	case "draft", "pending", "private":
+		return models.PostStatusDraft, ""
+	case "trash":
+		return "", "post is in the trash"
+	case "auto-draft":
+		return "", "post is an unsaved draft"
+	default:
+		return "", fmt.Sprintf("status %q is not supported", status)
+	}
+}
+
+// wxrSlug returns a local slug for a WordPress slug, which may be
+// percent-encoded, falling back to a slug of name
+func wxrSlug(wpSlug, name string) string {
+	if unescaped, err := url.PathUnescape(wpSlug); err == nil {
+		wpSlug = unescaped
+	}
+	if slug.IsSlug(wpSlug) {
+		return wpSlug
+	}
+	if s := slug.Make(wpSlug); s != "" {
+		return s
+	}
+	return slug.Make(name)
+}
+
+// wxrTime parses a WordPress date, trying each value in turn. Local dates
+// are read as UTC. Unset dates are written as 0000-00-00 00:00:00 and fail
+// to parse.
+func wxrTime(values ...string) *time.Time {
+	for _, value := range values {
+		if t, err := time.Parse(wxrDateLayout, strings.TrimSpace(value)); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// siteHost is the host of u without a www. prefix
+func siteHost(u *url.URL) string {
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
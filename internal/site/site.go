@@ -0,0 +1,122 @@
+// Package site carries the site a request is for, so that one deployment can
+// serve several blogs from different domains. Posts, tags, pages, news
+// sources and post templates belong to a site; the GORM plugin scopes queries
+// on them to the site of the query's context.
+package site
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// field is the name of the model field that holds the site a row belongs to
+const field = "SiteID"
+
+type contextKey struct{}
+
+// WithID returns a copy of ctx for the site with id
+func WithID(ctx context.Context, id uint) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// IDFromContext returns the site ctx is for, if any
+func IDFromContext(ctx context.Context) (uint, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	id, ok := ctx.Value(contextKey{}).(uint)
+	return id, ok && id != 0
+}
+
+// WithoutID returns a copy of ctx that isn't scoped to a site, for queries
+// across sites such as counting the content of each
+func WithoutID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, uint(0))
+}
+
+// GormPlugin scopes queries on models with a SiteID field to the site of the
+// query's context, i.e. queries made with db.WithContext(ctx) while handling a
+// request: reads, updates and deletes only see the site's rows, and created
+// rows are assigned to it. Queries without a site, such as those of background
+// jobs, aren't scoped.
+type GormPlugin struct{}
+
+// Name implements gorm.Plugin
+func (GormPlugin) Name() string {
+	return "site"
+}
+
+// Initialize implements gorm.Plugin
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("site:assign", assignSite),
+		cb.Query().Before("gorm:query").Register("site:scope_query", scopeToSite),
+		cb.Update().Before("gorm:update").Register("site:scope_update", scopeToSite),
+		cb.Delete().Before("gorm:delete").Register("site:scope_delete", scopeToSite),
+		cb.Row().Before("gorm:row").Register("site:scope_row", scopeToSite),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// siteField returns the SiteID field of the statement's model and the site of
+// its context, or nil when the query isn't scoped
+func siteField(tx *gorm.DB) (*schema.Field, uint) {
+	if tx.Error != nil || tx.Statement == nil || tx.Statement.Schema == nil {
+		return nil, 0
+	}
+	id, ok := IDFromContext(tx.Statement.Context)
+	if !ok {
+		return nil, 0
+	}
+	return tx.Statement.Schema.LookUpField(field), id
+}
+
+func scopeToSite(tx *gorm.DB) {
+	siteField, id := siteField(tx)
+	if siteField == nil {
+		return
+	}
+
+	tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: siteField.DBName}, Value: id},
+	}})
+}
+
+// assignSite sets the site of created rows that don't have one yet
+func assignSite(tx *gorm.DB) {
+	siteField, id := siteField(tx)
+	if siteField == nil {
+		return
+	}
+
+	ctx := tx.Statement.Context
+	value := tx.Statement.ReflectValue
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			row := reflect.Indirect(value.Index(i))
+			if _, zero := siteField.ValueOf(ctx, row); zero {
+				if err := siteField.Set(ctx, row, id); err != nil {
+					_ = tx.AddError(err)
+					return
+				}
+			}
+		}
+	case reflect.Struct:
+		if _, zero := siteField.ValueOf(ctx, value); zero {
+			if err := siteField.Set(ctx, value, id); err != nil {
+				_ = tx.AddError(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// exporterQueueSize bounds the spans waiting for export. Spans are dropped when it is full.
+	exporterQueueSize = 2048
+	// exporterBatchSize is the most spans sent in one request
+	exporterBatchSize = 512
+	// instrumentationScope names this package in exported spans
+	instrumentationScope = "github.com/phanvantai/taiphanvan_backend/internal/tracing"
+)
+
+// exporter batches finished spans and sends them to an OTLP/HTTP collector
+// using the JSON encoding
+type exporter struct {
+	endpoint       string
+	headers        map[string]string
+	serviceName    string
+	serviceVersion string
+	batchDelay     time.Duration
+	httpClient     *http.Client
+
+	queue    chan *Span
+	stop     chan struct{}
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+func newExporter(cfg config.TracingConfig, serviceVersion string) *exporter {
+	e := &exporter{
+		endpoint:       cfg.Endpoint,
+		headers:        cfg.Headers,
+		serviceName:    cfg.ServiceName,
+		serviceVersion: serviceVersion,
+		batchDelay:     cfg.BatchDelay,
+		httpClient: &http.Client{
+			Timeout: cfg.ExportTimeout,
+		},
+		queue:   make(chan *Span, exporterQueueSize),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+
+	go e.run()
+
+	return e
+}
+
+// enqueue queues a finished span without blocking the caller
+func (e *exporter) enqueue(span *Span) {
+	select {
+	case e.queue <- span:
+	default:
+		log.Debug().Str("span", span.name).Msg("Trace export queue full, dropping span")
+	}
+}
+
+// shutdown exports the queued spans and waits for the exporter to stop
+func (e *exporter) shutdown(ctx context.Context) error {
+	e.stopOnce.Do(func() { close(e.stop) })
+
+	select {
+	case <-e.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (e *exporter) run() {
+	defer close(e.stopped)
+
+	ticker := time.NewTicker(e.batchDelay)
+	defer ticker.Stop()
+
+	batch := make([]*Span, 0, exporterBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.export(batch); err != nil {
+			log.Warn().Err(err).Int("spans", len(batch)).Msg("Failed to export traces")
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case span := <-e.queue:
+			batch = append(batch, span)
+			if len(batch) >= exporterBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.stop:
+			for {
+				select {
+				case span := <-e.queue:
+					batch = append(batch, span)
+					if len(batch) >= exporterBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// export sends one batch of spans
+func (e *exporter) export(spans []*Span) error {
+	body, err := json.Marshal(e.encode(spans))
+	if err != nil {
+		return fmt.Errorf("failed to encode spans: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create export request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range e.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("export request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("collector returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// OTLP/JSON request body. See
+// https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              SpanKind        `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"` // 0 unset, 2 error
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"` // int64 values are strings in OTLP/JSON
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func (e *exporter) encode(spans []*Span) otlpRequest {
+	encoded := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		span.mu.Lock()
+		s := otlpSpan{
+			TraceID:           span.context.TraceID.String(),
+			SpanID:            span.context.SpanID.String(),
+			Name:              span.name,
+			Kind:              span.kind,
+			StartTimeUnixNano: strconv.FormatInt(span.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.end.UnixNano(), 10),
+			Attributes:        encodeAttributes(span.attributes),
+		}
+		if span.parentID.IsValid() {
+			s.ParentSpanID = span.parentID.String()
+		}
+		if span.errMessage != "" {
+			s.Status = otlpStatus{Code: 2, Message: span.errMessage}
+		}
+		span.mu.Unlock()
+
+		encoded = append(encoded, s)
+	}
+
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: encodeAttributes(map[string]interface{}{
+					"service.name":    e.serviceName,
+					"service.version": e.serviceVersion,
+				}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: instrumentationScope},
+				Spans: encoded,
+			}},
+		}},
+	}
+}
+
+// encodeAttributes converts attributes to OTLP key-values, sorted by key
+func encodeAttributes(attributes map[string]interface{}) []otlpAttribute {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	encoded := make([]otlpAttribute, 0, len(keys))
+	for _, key := range keys {
+		var value otlpValue
+		switch v := attributes[key].(type) {
+		case string:
+			value.StringValue = &v
+		case bool:
+			value.BoolValue = &v
+		case int:
+			s := strconv.Itoa(v)
+			value.IntValue = &s
+		case int64:
+			s := strconv.FormatInt(v, 10)
+			value.IntValue = &s
+		case uint:
+			s := strconv.FormatUint(uint64(v), 10)
+			value.IntValue = &s
+		case float64:
+			value.DoubleValue = &v
+		default:
+			s := fmt.Sprint(v)
+			value.StringValue = &s
+		}
+		encoded = append(encoded, otlpAttribute{Key: key, Value: value})
+	}
+	return encoded
+}
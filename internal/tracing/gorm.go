@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+const gormSpanKey = "tracing:span"
+
+// GormPlugin records a client span for every GORM query run with a context that
+// carries a span, i.e. queries made with db.WithContext(ctx) while handling a
+// traced request or job. Queries without one aren't traced, to avoid filling the
+// collector with parentless spans.
+type GormPlugin struct{}
+
+// Name implements gorm.Plugin
+func (GormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize implements gorm.Plugin
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("tracing:before_create", startQuerySpan("create")),
+		cb.Create().After("gorm:create").Register("tracing:after_create", endQuerySpan),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", startQuerySpan("query")),
+		cb.Query().After("gorm:query").Register("tracing:after_query", endQuerySpan),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", startQuerySpan("update")),
+		cb.Update().After("gorm:update").Register("tracing:after_update", endQuerySpan),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", startQuerySpan("delete")),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", endQuerySpan),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", startQuerySpan("row")),
+		cb.Row().After("gorm:row").Register("tracing:after_row", endQuerySpan),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", startQuerySpan("raw")),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", endQuerySpan),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func startQuerySpan(operation string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		if tx.Statement == nil || SpanFromContext(tx.Statement.Context) == nil {
+			return
+		}
+
+		_, span := Start(tx.Statement.Context, "gorm."+operation, SpanKindClient)
+		span.SetAttribute("db.system", "postgresql")
+		span.SetAttribute("db.operation.name", operation)
+		tx.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func endQuerySpan(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(*Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if tx.Statement.Table != "" {
+		span.SetAttribute("db.collection.name", tx.Statement.Table)
+	}
+	// The SQL uses placeholders, so it doesn't include user data
+	span.SetAttribute("db.query.text", tx.Statement.SQL.String())
+	span.SetAttribute("db.response.rows_affected", tx.RowsAffected)
+	if tx.Error != nil && !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(tx.Error)
+	}
+}
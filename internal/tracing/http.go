@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Transport wraps base so every outbound request gets a client span, carries
+// the traceparent and X-Request-ID headers, and is recorded in the summary of
+// the request that made it. A nil base uses http.DefaultTransport.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := Start(req.Context(), "HTTP "+req.Method, SpanKindClient)
+	defer span.End()
+
+	// Leave out the query string, which can carry API keys
+	span.SetAttribute("http.request.method", req.Method)
+	span.SetAttribute("server.address", req.URL.Hostname())
+	span.SetAttribute("url.full", req.URL.Scheme+"://"+req.URL.Host+req.URL.Path)
+
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(ctx)
+	Inject(ctx, req.Header)
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		RecordOutbound(ctx, req.URL.Hostname(), req.Method, 0, time.Since(start), err)
+		span.RecordError(err)
+		return nil, err
+	}
+	RecordOutbound(ctx, req.URL.Hostname(), req.Method, resp.StatusCode, time.Since(start), nil)
+
+	span.SetAttribute("http.response.status_code", resp.StatusCode)
+	if resp.StatusCode >= 400 {
+		span.RecordError(fmt.Errorf("HTTP %d", resp.StatusCode))
+	}
+	return resp, nil
+}
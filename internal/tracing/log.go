@@ -0,0 +1,17 @@
+package tracing
+
+import "github.com/rs/zerolog"
+
+// LogHook adds trace_id and span_id to log events created with a traced
+// context, e.g. log.Info().Ctx(ctx), so logs can be matched to traces
+type LogHook struct{}
+
+// Run implements zerolog.Hook
+func (LogHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	span := SpanFromContext(e.GetCtx())
+	if span == nil {
+		return
+	}
+	e.Str("trace_id", span.context.TraceID.String()).
+		Str("span_id", span.context.SpanID.String())
+}
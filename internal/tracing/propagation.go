@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// TraceparentHeader is the W3C Trace Context header
+const TraceparentHeader = "traceparent"
+
+// Extract reads the traceparent header of an incoming request, so spans started
+// from the returned context continue the caller's trace
+func Extract(ctx context.Context, header http.Header) context.Context {
+	remote, ok := parseTraceparent(header.Get(TraceparentHeader))
+	if !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteParentKey{}, remote)
+}
+
+// Inject writes the traceparent header for the span in ctx to an outgoing request
+func Inject(ctx context.Context, header http.Header) {
+	span := SpanFromContext(ctx)
+	if span == nil {
+		return
+	}
+
+	flags := "00"
+	if span.context.Sampled {
+		flags = "01"
+	}
+	header.Set(TraceparentHeader, "00-"+span.context.TraceID.String()+"-"+span.context.SpanID.String()+"-"+flags)
+}
+
+// parseTraceparent parses a version 00 traceparent value:
+// 00-<32 hex trace id>-<16 hex parent id>-<2 hex flags>
+func parseTraceparent(value string) (SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) != 4 || parts[0] != "00" || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return SpanContext{}, false
+	}
+
+	var remote SpanContext
+	if _, err := hex.Decode(remote.TraceID[:], []byte(parts[1])); err != nil || !remote.TraceID.IsValid() {
+		return SpanContext{}, false
+	}
+	if _, err := hex.Decode(remote.SpanID[:], []byte(parts[2])); err != nil || !remote.SpanID.IsValid() {
+		return SpanContext{}, false
+	}
+
+	var flags [1]byte
+	if _, err := hex.Decode(flags[:], []byte(parts[3])); err != nil {
+		return SpanContext{}, false
+	}
+	remote.Sampled = flags[0]&0x01 == 0x01
+
+	return remote, true
+}
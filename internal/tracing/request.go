@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RequestIDHeader carries the request ID to and from other services
+const RequestIDHeader = "X-Request-ID"
+
+// OutboundCall summarizes one call made to an external service while handling
+// a request
+type OutboundCall struct {
+	Host       string `json:"host"`
+	Method     string `json:"method"`
+	Status     int    `json:"status,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+type requestKey struct{}
+
+// requestInfo is the per-request state stored in the context
+type requestInfo struct {
+	id string
+
+	mu    sync.Mutex
+	calls []OutboundCall
+}
+
+// WithRequestID stores the request ID in ctx, so outbound calls made with the
+// returned context carry it and are recorded for the request log
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestKey{}, &requestInfo{id: requestID})
+}
+
+// RequestIDFromContext returns the request ID in ctx, or "" if there is none
+func RequestIDFromContext(ctx context.Context) string {
+	info := requestInfoFromContext(ctx)
+	if info == nil {
+		return ""
+	}
+	return info.id
+}
+
+// RecordOutbound adds a call to the request in ctx. Calls made outside a
+// request are not recorded.
+func RecordOutbound(ctx context.Context, host, method string, status int, duration time.Duration, err error) {
+	info := requestInfoFromContext(ctx)
+	if info == nil {
+		return
+	}
+
+	call := OutboundCall{
+		Host:       host,
+		Method:     method,
+		Status:     status,
+		DurationMs: duration.Milliseconds(),
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.calls = append(info.calls, call)
+}
+
+// OutboundCalls returns the calls recorded for the request in ctx
+func OutboundCalls(ctx context.Context) []OutboundCall {
+	info := requestInfoFromContext(ctx)
+	if info == nil {
+		return nil
+	}
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	return append([]OutboundCall(nil), info.calls...)
+}
+
+func requestInfoFromContext(ctx context.Context) *requestInfo {
+	if ctx == nil {
+		return nil
+	}
+	info, _ := ctx.Value(requestKey{}).(*requestInfo)
+	return info
+}
@@ -0,0 +1,234 @@
+// Package tracing records OpenTelemetry spans for incoming requests, database
+// queries and outbound calls, and exports them to a collector over OTLP/HTTP.
+// Trace context is propagated with the W3C traceparent header, so traces join
+// up with those of other OpenTelemetry-instrumented services.
+//
+// Tracing is off until Setup is called with an endpoint. While it is off, Start
+// returns a nil span and every Span method is a no-op.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/version"
+	"github.com/rs/zerolog/log"
+)
+
+// TraceID identifies a trace
+type TraceID [16]byte
+
+// String returns the ID as lowercase hex
+func (t TraceID) String() string { return hex.EncodeToString(t[:]) }
+
+// IsValid reports whether the ID is non-zero
+func (t TraceID) IsValid() bool { return t != TraceID{} }
+
+// SpanID identifies a span within a trace
+type SpanID [8]byte
+
+// String returns the ID as lowercase hex
+func (s SpanID) String() string { return hex.EncodeToString(s[:]) }
+
+// IsValid reports whether the ID is non-zero
+func (s SpanID) IsValid() bool { return s != SpanID{} }
+
+// SpanKind describes the relationship between a span and its caller, using the
+// OTLP enum values
+type SpanKind int
+
+const (
+	// SpanKindInternal is an operation inside the application
+	SpanKindInternal SpanKind = 1
+	// SpanKindServer handles an incoming request
+	SpanKindServer SpanKind = 2
+	// SpanKindClient is an outgoing call to another service or the database
+	SpanKindClient SpanKind = 3
+)
+
+// SpanContext is the part of a span that is propagated to other services
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// Span is a timed operation within a trace
+type Span struct {
+	tracer   *Tracer
+	context  SpanContext
+	parentID SpanID
+	name     string
+	kind     SpanKind
+	start    time.Time
+
+	mu         sync.Mutex
+	end        time.Time
+	attributes map[string]interface{}
+	errMessage string
+	ended      bool
+}
+
+// Tracer creates spans and hands finished ones to the exporter
+type Tracer struct {
+	sampleRatio float64
+	exporter    *exporter
+}
+
+var tracer *Tracer
+
+type spanKey struct{}
+type remoteParentKey struct{}
+
+// Setup enables tracing when cfg has an endpoint
+func Setup(cfg config.TracingConfig) {
+	if cfg.Endpoint == "" {
+		log.Info().Msg("Tracing disabled: no OTLP endpoint configured")
+		return
+	}
+
+	tracer = &Tracer{
+		sampleRatio: cfg.SampleRatio,
+		exporter:    newExporter(cfg, version.GitSHA),
+	}
+
+	log.Info().
+		Str("endpoint", cfg.Endpoint).
+		Str("service", cfg.ServiceName).
+		Float64("sample_ratio", cfg.SampleRatio).
+		Msg("Tracing enabled")
+}
+
+// Shutdown exports any queued spans and stops the exporter
+func Shutdown(ctx context.Context) error {
+	if tracer == nil {
+		return nil
+	}
+	return tracer.exporter.shutdown(ctx)
+}
+
+// Enabled reports whether spans are being recorded
+func Enabled() bool {
+	return tracer != nil
+}
+
+// Start creates a span as a child of the span in ctx, or of a remote parent
+// extracted from an incoming request. The span must be ended with End.
+func Start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	if tracer == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		tracer: tracer,
+		name:   name,
+		kind:   kind,
+		start:  time.Now(),
+	}
+
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.context.TraceID = parent.context.TraceID
+		span.context.Sampled = parent.context.Sampled
+		span.parentID = parent.context.SpanID
+	} else if remote, ok := ctx.Value(remoteParentKey{}).(SpanContext); ok {
+		span.context.TraceID = remote.TraceID
+		span.context.Sampled = remote.Sampled
+		span.parentID = remote.SpanID
+	} else {
+		span.context.TraceID = newTraceID()
+		span.context.Sampled = tracer.sample(span.context.TraceID)
+	}
+	span.context.SpanID = newSpanID()
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// SpanFromContext returns the current span, or nil if there is none
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// SpanContext returns the IDs propagated to other services
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+// SetAttribute records a key-value pair on the span. Values should be strings,
+// bools, integers or floats; anything else is recorded as its string form.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// RecordError marks the span as failed
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errMessage = err.Error()
+}
+
+// End finishes the span and queues it for export if it was sampled
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = time.Now()
+	s.mu.Unlock()
+
+	if s.context.Sampled {
+		s.tracer.exporter.enqueue(s)
+	}
+}
+
+// sample decides whether a new trace is recorded. The decision is derived from
+// the trace ID so it is stable for a given trace.
+func (t *Tracer) sample(traceID TraceID) bool {
+	if t.sampleRatio >= 1 {
+		return true
+	}
+	if t.sampleRatio <= 0 {
+		return false
+	}
+	bound := uint64(t.sampleRatio * (1 << 63))
+	return binary.BigEndian.Uint64(traceID[8:])>>1 < bound
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	_, _ = rand.Read(id[:]) // Never fails since Go 1.24
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	_, _ = rand.Read(id[:])
+	return id
+}
@@ -0,0 +1,117 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamAVChunkSize is the size of the chunks a file is streamed to clamd in
+const clamAVChunkSize = 64 << 10
+
+// ClamAVScanner scans files with a clamd daemon over its INSTREAM command
+type ClamAVScanner struct {
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner creates a scanner for the clamd daemon at address, either
+// host:port or the path of a unix socket
+func NewClamAVScanner(address string, timeout time.Duration) *ClamAVScanner {
+	return &ClamAVScanner{address: address, timeout: timeout}
+}
+
+// Scan streams data to clamd. It returns an error wrapping ErrInfected when a
+// threat is found, or another error when the file couldn't be scanned.
+func (s *ClamAVScanner) Scan(ctx context.Context, data []byte) error {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return fmt.Errorf("failed to start clamd scan: %w", err)
+	}
+	reader := bytes.NewReader(data)
+	chunk := make([]byte, clamAVChunkSize)
+	size := make([]byte, 4)
+	for {
+		n, err := reader.Read(chunk)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n))
+			if _, err := conn.Write(append(size, chunk[:n]...)); err != nil {
+				return fmt.Errorf("failed to stream file to clamd: %w", err)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	// A zero-length chunk ends the stream
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return fmt.Errorf("failed to finish clamd scan: %w", err)
+	}
+
+	reply, err := io.ReadAll(conn)
+	if err != nil {
+		return fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+	// Replies look like "stream: OK" or "stream: Eicar-Signature FOUND"
+	result := strings.TrimSpace(strings.TrimRight(string(reply), "\x00"))
+	result = strings.TrimPrefix(result, "stream: ")
+	switch {
+	case result == "OK":
+		return nil
+	case strings.HasSuffix(result, " FOUND"):
+		return fmt.Errorf("%w: %s", ErrInfected, strings.TrimSuffix(result, " FOUND"))
+	default:
+		return fmt.Errorf("clamd scan failed: %s", result)
+	}
+}
+
+// Ping checks that clamd is reachable
+func (s *ClamAVScanner) Ping(ctx context.Context) error {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("zPING\x00")); err != nil {
+		return fmt.Errorf("failed to ping clamd: %w", err)
+	}
+	reply, err := io.ReadAll(conn)
+	if err != nil {
+		return fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+	if strings.TrimRight(string(reply), "\x00\n") != "PONG" {
+		return fmt.Errorf("unexpected clamd reply %q", reply)
+	}
+	return nil
+}
+
+func (s *ClamAVScanner) dial(ctx context.Context) (net.Conn, error) {
+	network := "tcp"
+	if strings.HasPrefix(s.address, "/") {
+		network = "unix"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to clamd at %s: %w", s.address, err)
+	}
+	if err := conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
@@ -0,0 +1,74 @@
+package upload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// sniffLength is how much of a file is examined to detect its type
+const sniffLength = 1024
+
+// svgPrologPattern matches the start of an SVG document: an optional XML
+// declaration, comments and doctype before the <svg> root element
+var svgPrologPattern = regexp.MustCompile(`^\s*(?:<\?xml[^>]*\?>\s*)?(?:(?:<!--(?s:.*?)-->|<!DOCTYPE[^>]*>)\s*)*<svg[\s>/]`)
+
+// Detect returns the content type of data from its first bytes, following
+// the WHATWG MIME sniffing algorithm and additionally recognizing SVG
+func Detect(data []byte) string {
+	head := data
+	if len(head) > sniffLength {
+		head = head[:sniffLength]
+	}
+
+	contentType := http.DetectContentType(head)
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+
+	// SVG is XML text, which the standard algorithm reports as text/xml or
+	// text/plain
+	if strings.HasPrefix(contentType, "text/") {
+		if svgPrologPattern.Match(bytes.TrimPrefix(head, []byte("\xEF\xBB\xBF"))) {
+			return TypeSVG
+		}
+	}
+	return contentType
+}
+
+// webpDimensions reads the canvas size from the header of a WebP image, which
+// the standard library can't decode
+func webpDimensions(data []byte) (int, int, error) {
+	if len(data) < 30 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0, errors.New("invalid WebP header")
+	}
+
+	chunk := data[12:]
+	switch string(chunk[:4]) {
+	case "VP8 ":
+		// Lossy: a 3-byte frame tag, a start code, then 14-bit dimensions
+		if chunk[11] != 0x9d || chunk[12] != 0x01 || chunk[13] != 0x2a {
+			return 0, 0, errors.New("invalid VP8 frame")
+		}
+		width := int(binary.LittleEndian.Uint16(chunk[14:16]) & 0x3fff)
+		height := int(binary.LittleEndian.Uint16(chunk[16:18]) & 0x3fff)
+		return width, height, nil
+	case "VP8L":
+		// Lossless: a signature byte, then 14-bit dimensions minus one
+		if chunk[8] != 0x2f {
+			return 0, 0, errors.New("invalid VP8L signature")
+		}
+		bits := binary.LittleEndian.Uint32(chunk[9:13])
+		return int(bits&0x3fff) + 1, int((bits>>14)&0x3fff) + 1, nil
+	case "VP8X":
+		// Extended: 24-bit canvas dimensions minus one
+		width := int(chunk[12]) | int(chunk[13])<<8 | int(chunk[14])<<16
+		height := int(chunk[15]) | int(chunk[16])<<8 | int(chunk[17])<<16
+		return width + 1, height + 1, nil
+	default:
+		return 0, 0, errors.New("unknown WebP chunk")
+	}
+}
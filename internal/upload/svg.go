@@ -0,0 +1,171 @@
+package upload
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// svgElements are the SVG elements kept by SanitizeSVG. Anything else,
+// including script, foreignObject, a and the animation elements that can
+// rewrite attributes, is removed with its children.
+var svgElements = map[string]bool{
+	"svg": true, "g": true, "defs": true, "symbol": true, "use": true,
+	"title": true, "desc": true, "style": true, "view": true,
+	"path": true, "rect": true, "circle": true, "ellipse": true,
+	"line": true, "polyline": true, "polygon": true,
+	"text": true, "tspan": true, "textPath": true,
+	"image": true, "marker": true, "pattern": true, "clipPath": true, "mask": true,
+	"linearGradient": true, "radialGradient": true, "stop": true,
+	"filter": true, "feBlend": true, "feColorMatrix": true, "feComponentTransfer": true,
+	"feComposite": true, "feConvolveMatrix": true, "feDiffuseLighting": true,
+	"feDisplacementMap": true, "feDistantLight": true, "feDropShadow": true,
+	"feFlood": true, "feFuncA": true, "feFuncB": true, "feFuncG": true, "feFuncR": true,
+	"feGaussianBlur": true, "feMerge": true, "feMergeNode": true, "feMorphology": true,
+	"feOffset": true, "fePointLight": true, "feSpecularLighting": true,
+	"feSpotLight": true, "feTile": true, "feTurbulence": true,
+}
+
+// svgPrefixedAttributes are the namespaced attributes kept by SanitizeSVG
+var svgPrefixedAttributes = map[string]bool{
+	"xlink:href": true, "xml:space": true, "xml:lang": true, "xmlns:xlink": true,
+}
+
+var (
+	// cssURLPattern matches url() references in CSS
+	cssURLPattern = regexp.MustCompile(`(?i)url\(\s*['"]?\s*([^'")\s]*)`)
+	// safeImageDataPattern matches embedded raster images, the only data URLs kept
+	safeImageDataPattern = regexp.MustCompile(`(?i)^data:image/(?:png|jpeg|gif|webp)[;,]`)
+)
+
+// SanitizeSVG removes everything from an SVG document that can run script
+// or load external resources: elements outside an allowlist, event handler
+// attributes, links that aren't fragment references or embedded raster
+// images, CSS that loads URLs, comments, processing instructions and the
+// doctype, which can declare entities.
+func SanitizeSVG(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var out bytes.Buffer
+	out.WriteString(xml.Header)
+
+	// skipDepth counts the open elements inside a removed element
+	skipDepth := 0
+	var open []string
+	rootSeen := false
+	for {
+		// RawToken leaves namespace prefixes as written, so the document can
+		// be written back unchanged
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 || !svgElements[t.Name.Local] || (t.Name.Space != "" && t.Name.Space != "svg") {
+				skipDepth++
+				continue
+			}
+			if !rootSeen && t.Name.Local != "svg" {
+				return nil, errors.New("root element is not svg")
+			}
+			rootSeen = true
+
+			name := qualifiedName(t.Name)
+			open = append(open, name)
+			out.WriteString("<" + name)
+			for _, attr := range t.Attr {
+				if value, ok := sanitizeSVGAttr(t.Name.Local, attr); ok {
+					out.WriteString(" " + qualifiedName(attr.Name) + `="`)
+					if err := xml.EscapeText(&out, []byte(value)); err != nil {
+						return nil, err
+					}
+					out.WriteString(`"`)
+				}
+			}
+			out.WriteString(">")
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			if len(open) == 0 {
+				return nil, errors.New("unbalanced end element")
+			}
+			out.WriteString("</" + open[len(open)-1] + ">")
+			open = open[:len(open)-1]
+		case xml.CharData:
+			if skipDepth > 0 || len(open) == 0 {
+				continue
+			}
+			text := []byte(t)
+			if open[len(open)-1] == "style" && !safeCSS(string(text)) {
+				continue
+			}
+			if err := xml.EscapeText(&out, text); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !rootSeen {
+		return nil, errors.New("document has no svg element")
+	}
+	if len(open) > 0 {
+		return nil, fmt.Errorf("element %s is not closed", open[len(open)-1])
+	}
+	return out.Bytes(), nil
+}
+
+// sanitizeSVGAttr returns the value to keep for an attribute of element, or
+// false to drop it
+func sanitizeSVGAttr(element string, attr xml.Attr) (string, bool) {
+	name := qualifiedName(attr.Name)
+	local := strings.ToLower(attr.Name.Local)
+	value := strings.TrimSpace(attr.Value)
+
+	switch {
+	case attr.Name.Space == "xmlns" || (attr.Name.Space == "" && local == "xmlns"):
+		return attr.Value, name == "xmlns" || svgPrefixedAttributes[name]
+	case attr.Name.Space != "" && !svgPrefixedAttributes[name]:
+		return "", false
+	case strings.HasPrefix(local, "on"):
+		return "", false
+	case local == "href":
+		if strings.HasPrefix(value, "#") || (element == "image" && safeImageDataPattern.MatchString(value)) {
+			return attr.Value, true
+		}
+		return "", false
+	case local == "style" || strings.Contains(strings.ToLower(value), "url("):
+		return attr.Value, safeCSS(value)
+	}
+	return attr.Value, true
+}
+
+// safeCSS reports whether CSS only references fragments of the document
+func safeCSS(css string) bool {
+	lower := strings.ToLower(css)
+	if strings.Contains(lower, "@import") || strings.Contains(lower, "expression(") || strings.Contains(lower, "javascript:") {
+		return false
+	}
+	for _, match := range cssURLPattern.FindAllStringSubmatch(css, -1) {
+		if !strings.HasPrefix(match[1], "#") {
+			return false
+		}
+	}
+	return true
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
@@ -0,0 +1,148 @@
+// Package upload checks the content of uploaded files before they are stored.
+// The type of a file is sniffed from its first bytes rather than trusted from
+// its name, images are held to dimension limits, SVG files are sanitized and
+// files can be scanned for viruses by a ClamAV daemon.
+package upload
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF decoder for image.DecodeConfig
+	_ "image/jpeg" // Register the JPEG decoder for image.DecodeConfig
+	_ "image/png"  // Register the PNG decoder for image.DecodeConfig
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+)
+
+// Content types that can be detected
+const (
+	TypeJPEG = "image/jpeg"
+	TypePNG  = "image/png"
+	TypeGIF  = "image/gif"
+	TypeWebP = "image/webp"
+	TypeSVG  = "image/svg+xml"
+	TypePDF  = "application/pdf"
+)
+
+// ImageTypes are the image types that can be detected
+var ImageTypes = []string{TypeJPEG, TypePNG, TypeGIF, TypeWebP, TypeSVG}
+
+var (
+	// ErrTypeNotAllowed is returned when the content is not one of the
+	// accepted types, or doesn't match the file extension
+	ErrTypeNotAllowed = errors.New("file content is not an allowed type")
+	// ErrImageTooLarge is returned when an image exceeds the dimension limits
+	ErrImageTooLarge = errors.New("image dimensions are too large")
+	// ErrInvalidFile is returned when the content can't be decoded as its type
+	ErrInvalidFile = errors.New("file is corrupt or invalid")
+	// ErrInfected is returned when the virus scanner finds a threat
+	ErrInfected = errors.New("file contains a virus")
+)
+
+// Policy describes the files a route accepts
+type Policy struct {
+	// Field is the multipart form field holding the file
+	Field string
+	// Types are the content types accepted
+	Types []string
+	// MaxSize is the largest file that is read for checking. Larger files are
+	// left to the handler, which rejects them with its own error.
+	MaxSize int64
+}
+
+// Limits are the dimension limits for images. Zero disables a limit.
+type Limits struct {
+	MaxWidth  int
+	MaxHeight int
+}
+
+// Inspect checks the content of a file named filename against the policy and
+// limits, returning its content type and the content to store, which is
+// sanitized for SVG files
+func Inspect(filename string, data []byte, policy Policy, limits Limits) (string, []byte, error) {
+	contentType := Detect(data)
+	allowed := false
+	for _, t := range policy.Types {
+		if t == contentType {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return "", nil, fmt.Errorf("%w: detected %s", ErrTypeNotAllowed, contentType)
+	}
+
+	// The extension decides the Content-Type files are served with, so it
+	// must agree with the content
+	if ext := strings.ToLower(filepath.Ext(filename)); ext != "" {
+		byExt, _, _ := mime.ParseMediaType(mime.TypeByExtension(ext))
+		if byExt != contentType {
+			return "", nil, fmt.Errorf("%w: %s content in a %s file", ErrTypeNotAllowed, contentType, ext)
+		}
+	}
+
+	if contentType == TypeSVG {
+		sanitized, err := SanitizeSVG(data)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+		return contentType, sanitized, nil
+	}
+
+	if strings.HasPrefix(contentType, "image/") {
+		width, height, err := dimensions(contentType, data)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+		if (limits.MaxWidth > 0 && width > limits.MaxWidth) || (limits.MaxHeight > 0 && height > limits.MaxHeight) {
+			return "", nil, fmt.Errorf("%w: %dx%d exceeds %dx%d", ErrImageTooLarge, width, height, limits.MaxWidth, limits.MaxHeight)
+		}
+	}
+	return contentType, data, nil
+}
+
+func dimensions(contentType string, data []byte) (int, int, error) {
+	if contentType == TypeWebP {
+		return webpDimensions(data)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, err
+	}
+	return config.Width, config.Height, nil
+}
+
+// FileHeader builds a multipart file header holding data, to replace an
+// uploaded file with its sanitized content
+func FileHeader(field, filename, contentType string, data []byte) (*multipart.FileHeader, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": field, "filename": filename}))
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(int64(len(data)) + 1024)
+	if err != nil {
+		return nil, err
+	}
+	files := form.File[field]
+	if len(files) == 0 {
+		return nil, errors.New("file missing from rebuilt form")
+	}
+	return files[0], nil
+}
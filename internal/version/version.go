@@ -0,0 +1,53 @@
+// Package version reports which build of the API is running. GitSHA and
+// BuildTime are injected at compile time:
+//
+//	go build -ldflags "-X github.com/phanvantai/taiphanvan_backend/internal/version.GitSHA=$(git rev-parse --short HEAD) \
+//	  -X github.com/phanvantai/taiphanvan_backend/internal/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/api
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+var (
+	// GitSHA is the commit the binary was built from
+	GitSHA = "unknown"
+	// BuildTime is when the binary was built, in RFC 3339 format
+	BuildTime = "unknown"
+)
+
+func init() {
+	// Fall back to the VCS stamp Go records in local builds without ldflags
+	if GitSHA != "unknown" {
+		return
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if len(setting.Value) > 12 {
+				GitSHA = setting.Value[:12]
+			} else {
+				GitSHA = setting.Value
+			}
+		case "vcs.time":
+			if BuildTime == "unknown" {
+				BuildTime = setting.Value
+			}
+		}
+	}
+}
+
+// Build returns the value of the X-API-Build header, e.g. "3f2a9c1@2025-05-09T10:00:00Z"
+func Build() string {
+	return GitSHA + "@" + BuildTime
+}
+
+// GoVersion returns the Go version the binary was built with
+func GoVersion() string {
+	return runtime.Version()
+}
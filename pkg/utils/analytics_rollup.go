@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// StartAnalyticsRollup starts the background process that sums reader events
+// into daily post analytics and deletes events past their retention period
+func StartAnalyticsRollup(cfg config.AnalyticsConfig) {
+	ticker := time.NewTicker(cfg.RollupInterval)
+	jobs.Register(services.HeartbeatJobAnalyticsRollup, cfg.RollupInterval)
+
+	go func() {
+		log.Info().
+			Dur("interval", cfg.RollupInterval).
+			Dur("retention", cfg.EventRetention).
+			Msg("Starting analytics rollup background process")
+
+		for range ticker.C {
+			RollupAnalytics(cfg)
+			jobs.Ran(services.HeartbeatJobAnalyticsRollup)
+		}
+	}()
+}
+
+// RollupAnalytics sums recent reader events into daily post analytics
+func RollupAnalytics(cfg config.AnalyticsConfig) {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping analytics rollup")
+		return
+	}
+
+	deleted, err := services.NewAnalyticsService(database.DB, cfg).Rollup(time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to roll up analytics events")
+		return
+	}
+
+	if deleted > 0 {
+		log.Info().Int64("deleted", deleted).Msg("Deleted old analytics events")
+	}
+	heartbeat.Ping(services.HeartbeatJobAnalyticsRollup)
+}
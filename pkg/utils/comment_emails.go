@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// StartCommentEmails starts the background process that emails users about
+// comments on their posts and replies to their comments. Nothing is started
+// when SMTP isn't configured.
+func StartCommentEmails(cfg *config.Config) {
+	if cfg.SMTP.Host == "" {
+		log.Info().Msg("SMTP not configured, comment emails disabled")
+		return
+	}
+
+	ticker := time.NewTicker(cfg.CommentEmails.Interval)
+	jobs.Register(services.HeartbeatJobCommentEmails, cfg.CommentEmails.Interval)
+
+	go func() {
+		log.Info().
+			Dur("interval", cfg.CommentEmails.Interval).
+			Msg("Starting comment email background process")
+
+		for range ticker.C {
+			SendCommentEmails(cfg)
+			jobs.Ran(services.HeartbeatJobCommentEmails)
+		}
+	}()
+}
+
+// SendCommentEmails emails the queued comment notifications that are due
+func SendCommentEmails(cfg *config.Config) {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping comment emails")
+		return
+	}
+
+	sent, err := services.NewCommentEmailService(database.DB, cfg).SendPending(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to send comment emails")
+		return
+	}
+
+	if sent > 0 {
+		log.Info().Int("emails", sent).Msg("Sent comment emails")
+	}
+	heartbeat.Ping(services.HeartbeatJobCommentEmails)
+}
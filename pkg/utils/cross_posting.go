@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// crossPosts shares posts published by background jobs on social networks
+var crossPosts *services.CrossPostService
+
+// SetCrossPostService sets the service that cross-posts published posts to
+// social networks, used by the scheduler and the cross-posting job
+func SetCrossPostService(service *services.CrossPostService) {
+	crossPosts = service
+}
+
+// StartCrossPosting starts the background process that shares queued posts on
+// the configured social networks and retries failed attempts. Nothing is
+// started when no network is configured.
+func StartCrossPosting(cfg config.SocialConfig) {
+	if crossPosts == nil || !crossPosts.Enabled() {
+		log.Info().Msg("No social networks configured, cross-posting disabled")
+		return
+	}
+
+	ticker := time.NewTicker(cfg.Interval)
+	jobs.Register(services.HeartbeatJobCrossPosting, cfg.Interval)
+
+	go func() {
+		log.Info().
+			Dur("interval", cfg.Interval).
+			Interface("targets", crossPosts.Targets()).
+			Msg("Starting cross-posting background process")
+
+		for range ticker.C {
+			SendCrossPosts()
+			jobs.Ran(services.HeartbeatJobCrossPosting)
+		}
+	}()
+}
+
+// SendCrossPosts shares the queued posts that are due on social networks
+func SendCrossPosts() {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping cross-posting")
+		return
+	}
+
+	sent, err := crossPosts.SendPending(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to send cross-posts")
+		return
+	}
+
+	if sent > 0 {
+		log.Info().Int("sent", sent).Msg("Cross-posted published posts")
+	}
+	heartbeat.Ping(services.HeartbeatJobCrossPosting)
+}
+
+// queueCrossPosts queues a post the scheduler published for cross-posting
+func queueCrossPosts(post *models.Post) {
+	if crossPosts == nil {
+		return
+	}
+	if err := crossPosts.Queue(post); err != nil {
+		log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to queue cross-posts")
+	}
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// StartLinkCheck starts the background process that checks the outbound
+// links in published posts and news articles, so editors can find and fix
+// dead ones
+func StartLinkCheck(cfg *config.Config) {
+	ticker := time.NewTicker(cfg.LinkCheck.Interval)
+	jobs.Register(services.HeartbeatJobLinkCheck, cfg.LinkCheck.Interval)
+
+	go func() {
+		log.Info().
+			Dur("interval", cfg.LinkCheck.Interval).
+			Dur("domain_delay", cfg.LinkCheck.DomainDelay).
+			Msg("Starting link check background process")
+
+		for range ticker.C {
+			CheckLinks(cfg)
+			jobs.Ran(services.HeartbeatJobLinkCheck)
+		}
+	}()
+}
+
+// CheckLinks checks the outbound links in published content that are due.
+// A run is skipped while a manual check is still going.
+func CheckLinks(cfg *config.Config) {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping link check")
+		return
+	}
+
+	result, err := services.NewLinkCheckService(database.DB, cfg).Run(context.Background())
+	if errors.Is(err, services.ErrLinkCheckRunning) {
+		log.Info().Msg("Link check already running, skipping this run")
+		heartbeat.Ping(services.HeartbeatJobLinkCheck)
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Link check failed")
+		return
+	}
+
+	log.Info().
+		Int("links", result.Links).
+		Int("checked", result.Checked).
+		Int("broken", result.Broken).
+		Msg("Checked links")
+	heartbeat.Ping(services.HeartbeatJobLinkCheck)
+}
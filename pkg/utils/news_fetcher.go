@@ -7,9 +7,43 @@ import (
 	"github.com/phanvantai/taiphanvan_backend/internal/database"
 	"github.com/phanvantai/taiphanvan_backend/internal/models"
 	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/phanvantai/taiphanvan_backend/internal/tracing"
 	"github.com/rs/zerolog/log"
 )
 
+// heartbeat reports successful background job runs to an external monitor
+var heartbeat *services.HeartbeatService
+
+// webhooks notifies registered webhooks about content changes made by background jobs
+var webhooks *services.WebhookService
+
+// ogImages generates share images for posts published by background jobs
+var ogImages *services.OGImageService
+
+// jobs records when each background job loop last ran, for the readiness probe
+var jobs *services.JobMonitor
+
+// SetJobMonitor sets the monitor the background job loops report their runs to
+func SetJobMonitor(monitor *services.JobMonitor) {
+	jobs = monitor
+}
+
+// SetHeartbeatService sets the heartbeat service used by the background jobs
+func SetHeartbeatService(service *services.HeartbeatService) {
+	heartbeat = service
+}
+
+// SetWebhookService sets the webhook service used by the background jobs
+func SetWebhookService(service *services.WebhookService) {
+	webhooks = service
+}
+
+// SetOGImageService sets the service that generates share images for posts
+// published by the scheduler
+func SetOGImageService(service *services.OGImageService) {
+	ogImages = service
+}
+
 // StartNewsFetcher starts the background process to automatically fetch news
 func StartNewsFetcher(newsConfig services.NewsConfig) {
 	log.Info().
@@ -36,6 +70,7 @@ func StartNewsFetcher(newsConfig services.NewsConfig) {
 // startAPIFetcher starts the background process to fetch news from the NewsAPI
 func startAPIFetcher(newsConfig services.NewsConfig) {
 	ticker := time.NewTicker(newsConfig.FetchInterval)
+	jobs.Register(services.HeartbeatJobNewsFetch, newsConfig.FetchInterval)
 
 	go func() {
 		log.Info().
@@ -44,17 +79,25 @@ func startAPIFetcher(newsConfig services.NewsConfig) {
 
 		// Run immediately on startup
 		fetchNewsFromAPI(newsConfig)
+		jobs.Ran(services.HeartbeatJobNewsFetch)
 
 		// Then run on the scheduled interval
 		for range ticker.C {
 			fetchNewsFromAPI(newsConfig)
+			jobs.Ran(services.HeartbeatJobNewsFetch)
 		}
 	}()
 }
 
+// rssSchedulerTick is how often the RSS fetcher looks for news sources that
+// are due, so per-source intervals are kept to within a minute
+const rssSchedulerTick = time.Minute
+
 // startRSSFetcher starts the background process to fetch news from RSS feeds
 func startRSSFetcher(newsConfig services.NewsConfig) {
-	ticker := time.NewTicker(newsConfig.RSSConfig.FetchInterval)
+	tick := min(rssSchedulerTick, newsConfig.RSSConfig.FetchInterval)
+	ticker := time.NewTicker(tick)
+	jobs.Register(services.HeartbeatJobRSSFetch, tick)
 
 	go func() {
 		log.Info().
@@ -63,10 +106,12 @@ func startRSSFetcher(newsConfig services.NewsConfig) {
 
 		// Run immediately on startup
 		fetchNewsFromRSS(newsConfig)
+		jobs.Ran(services.HeartbeatJobRSSFetch)
 
 		// Then run on the scheduled interval
 		for range ticker.C {
 			fetchNewsFromRSS(newsConfig)
+			jobs.Ran(services.HeartbeatJobRSSFetch)
 		}
 	}()
 }
@@ -76,126 +121,90 @@ func fetchNewsFromAPI(newsConfig services.NewsConfig) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Initialize news service
-	newsService, err := services.NewNewsService(newsConfig.APIConfig)
+	// Trace the scheduled run so its outbound calls share one trace
+	ctx, span := tracing.Start(ctx, "job.fetch_news_api", tracing.SpanKindInternal)
+	defer span.End()
+
+	taxonomy, err := services.NewNewsCategoryService(database.DB).Taxonomy()
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize news service for background fetching")
+		log.Error().Err(err).Msg("Failed to load news categories for background fetching")
 		return
 	}
 
-	// Define categories to fetch
-	categories := []models.NewsCategory{
-		models.NewsCategoryTechnology,
-		models.NewsCategoryScience,
-		// models.NewsCategoryBlockchain,
-		// models.NewsCategoryDevelopment,
-		// models.NewsCategoryWeb3,
-	}
-
-	// Fetch news from API
-	log.Info().Msg("Fetching news from external API")
-	news, err := newsService.FetchNews(ctx, categories, newsConfig.DefaultLimit)
+	// Initialize news service
+	newsService, err := services.NewNewsService(newsConfig.APIConfig, taxonomy)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to fetch news from external API")
+		log.Error().Err(err).Msg("Failed to initialize news service for background fetching")
 		return
 	}
 
-	if len(news) == 0 {
-		log.Info().Msg("No news articles found to import")
+	// Fetch and store news, recording the run
+	log.Info().Msg("Fetching news from external API")
+	run := newIngestionService(newsConfig).Ingest(models.IngestionSourceNewsAPI, models.IngestionTriggerScheduled, func() ([]models.News, []models.IngestionSourceError) {
+		// No categories means every enabled category
+		return newsService.FetchNews(ctx, nil, newsConfig.DefaultLimit)
+	})
+	if run.Status == models.IngestionFailed {
+		log.Error().Uint("run_id", run.ID).Msg("Failed to fetch news from external API")
 		return
 	}
 
-	// Store the fetched news articles
-	saveNewsArticles(news)
+	heartbeat.Ping(services.HeartbeatJobNewsFetch)
 }
 
-// fetchNewsFromRSS fetches news articles from RSS feeds and stores them in the database
+// fetchNewsFromRSS fetches news articles from the news sources that are due
+// and stores them in the database
 func fetchNewsFromRSS(newsConfig services.NewsConfig) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	// Initialize RSS service
-	rssService, err := services.NewRSSService(newsConfig.RSSConfig)
+	sourceService := services.NewNewsSourceService(database.DB)
+	feeds, err := sourceService.Due(newsConfig.RSSConfig.FetchInterval)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to initialize RSS service for background fetching")
+		log.Error().Err(err).Msg("Failed to load news sources for background fetching")
+		return
+	}
+	if len(feeds) == 0 {
 		return
 	}
 
-	// Fetch news from RSS feeds
-	log.Info().Msg("Fetching news from RSS feeds")
-	news, err := rssService.FetchNews(ctx, newsConfig.RSSConfig.DefaultLimit)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Trace the scheduled run so its outbound calls share one trace
+	ctx, span := tracing.Start(ctx, "job.fetch_rss", tracing.SpanKindInternal)
+	defer span.End()
+
+	taxonomy, err := services.NewNewsCategoryService(database.DB).Taxonomy()
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to fetch news from RSS feeds")
+		log.Error().Err(err).Msg("Failed to load news categories for background fetching")
 		return
 	}
 
-	if len(news) == 0 {
-		log.Info().Msg("No news articles found in RSS feeds to import")
+	rssService := services.NewRSSService(sourceService, taxonomy, func(source models.NewsSource) {
+		if webhooks != nil {
+			webhooks.Dispatch(source.HealthEvent(), source)
+		}
+	})
+
+	// Fetch and store news, recording the run. The limit applies per feed,
+	// since only some feeds are due on each run.
+	log.Info().Int("feeds", len(feeds)).Msg("Fetching news from RSS feeds")
+	run := newIngestionService(newsConfig).Ingest(models.IngestionSourceRSS, models.IngestionTriggerScheduled, func() ([]models.News, []models.IngestionSourceError) {
+		return rssService.FetchNews(ctx, feeds, newsConfig.RSSConfig.DefaultLimit*len(feeds))
+	})
+	rssService.FinishFetchRuns(run)
+	if run.Status == models.IngestionFailed {
+		log.Error().Uint("run_id", run.ID).Msg("Failed to fetch news from RSS feeds")
 		return
 	}
 
-	// Store the fetched news articles
-	saveNewsArticles(news)
+	heartbeat.Ping(services.HeartbeatJobRSSFetch)
 }
 
-// saveNewsArticles saves the news articles to the database
-func saveNewsArticles(news []models.News) {
-	// Don't use a single transaction for all articles to avoid
-	// aborting the entire batch on a single error
-
-	// Store each news article
-	var savedCount int
-	for _, article := range news {
-		// Use a separate transaction for each article
-		tx := database.DB.Begin()
-
-		// Check if article already exists by external ID
-		var existingCount int64
-		if err := tx.Model(&models.News{}).Where("external_id = ?", article.ExternalID).Count(&existingCount).Error; err != nil {
-			log.Error().Err(err).Str("external_id", article.ExternalID).Msg("Failed to check existing news")
-			tx.Rollback()
-			continue
+// newIngestionService creates the service that stores fetched articles and
+// notifies webhooks about the new ones
+func newIngestionService(newsConfig services.NewsConfig) *services.IngestionService {
+	return services.NewIngestionService(database.DB, newsConfig.Ingestion, func(article models.News) {
+		if webhooks != nil {
+			webhooks.Dispatch(models.WebhookEventNewsCreated, article.ToNewsWithoutContent())
 		}
-
-		if existingCount > 0 {
-			tx.Rollback() // Clean rollback for skipped articles
-			continue      // Skip existing articles
-		}
-
-		// Check if slug already exists
-		var slugCount int64
-		if err := tx.Model(&models.News{}).Where("slug = ?", article.Slug).Count(&slugCount).Error; err != nil {
-			log.Error().Err(err).Str("slug", article.Slug).Msg("Failed to check existing slug")
-			tx.Rollback()
-			continue
-		}
-
-		// If slug exists, skip this article
-		if slugCount > 0 {
-			log.Info().Str("slug", article.Slug).Msg("Skipping article with duplicate slug")
-			tx.Rollback() // Clean rollback for skipped articles
-			continue      // Skip articles with duplicate slugs
-		}
-
-		// Save article
-		if err := tx.Create(&article).Error; err != nil {
-			log.Error().Err(err).Str("title", article.Title).Msg("Failed to save news article")
-			tx.Rollback()
-			continue
-		}
-
-		// Commit the transaction
-		if err := tx.Commit().Error; err != nil {
-			log.Error().Err(err).Str("title", article.Title).Msg("Failed to commit transaction")
-			continue
-		}
-
-		savedCount++
-	}
-
-	log.Info().
-		Int("total_fetched", len(news)).
-		Int("saved", savedCount).
-		Time("fetch_time", time.Now()).
-		Msg("Successfully fetched and saved news articles")
+	})
 }
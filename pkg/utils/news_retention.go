@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// StartNewsRetention starts the background process that archives or deletes
+// fetched news older than the retention period. It does nothing when no
+// retention period is configured.
+func StartNewsRetention(cfg config.NewsRetentionConfig) {
+	if cfg.Days <= 0 {
+		log.Info().Msg("News retention disabled: NEWS_RETENTION_DAYS is not set")
+		return
+	}
+
+	ticker := time.NewTicker(cfg.Interval)
+	jobs.Register(services.HeartbeatJobNewsRetention, cfg.Interval)
+
+	go func() {
+		log.Info().
+			Int("days", cfg.Days).
+			Str("mode", cfg.Mode).
+			Dur("interval", cfg.Interval).
+			Msg("Starting news retention background process")
+
+		ApplyNewsRetention(cfg)
+		jobs.Ran(services.HeartbeatJobNewsRetention)
+		for range ticker.C {
+			ApplyNewsRetention(cfg)
+			jobs.Ran(services.HeartbeatJobNewsRetention)
+		}
+	}()
+}
+
+// ApplyNewsRetention expires fetched news older than the retention period
+func ApplyNewsRetention(cfg config.NewsRetentionConfig) {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping news retention")
+		return
+	}
+
+	start := time.Now()
+	result, err := services.NewNewsRetentionService(database.DB, cfg).Run(start)
+	if err != nil {
+		log.Error().Err(err).
+			Int64("archived", result.Archived).
+			Int64("deleted", result.Deleted).
+			Msg("News retention run failed")
+		return
+	}
+
+	if result.Archived > 0 || result.Deleted > 0 {
+		log.Info().
+			Int64("archived", result.Archived).
+			Int64("deleted", result.Deleted).
+			Dur("duration", time.Since(start)).
+			Msg("Expired old news")
+	}
+	heartbeat.Ping(services.HeartbeatJobNewsRetention)
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/models"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// StartPostScheduler starts the background process that publishes scheduled
+// posts when they are due and takes down posts whose expiry date has passed
+func StartPostScheduler(cfg config.SchedulerConfig) {
+	ticker := time.NewTicker(cfg.Interval)
+	jobs.Register(services.HeartbeatJobPostScheduler, cfg.Interval)
+
+	go func() {
+		log.Info().
+			Dur("interval", cfg.Interval).
+			Str("expired_status", cfg.ExpiredStatus).
+			Msg("Starting scheduled post publisher background process")
+
+		for range ticker.C {
+			PublishDuePosts()
+			ExpirePosts(models.PostStatus(cfg.ExpiredStatus))
+			jobs.Ran(services.HeartbeatJobPostScheduler)
+		}
+	}()
+}
+
+// PublishDuePosts publishes scheduled posts whose publish time has passed.
+// Nothing is published while a content freeze window is active; due posts stay
+// queued and go out on the first run after the window ends.
+func PublishDuePosts() {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping scheduled post publishing")
+		return
+	}
+
+	now := time.Now()
+	window, err := database.ActiveFreezeWindow(database.DB, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check content freeze, skipping scheduled post publishing")
+		return
+	}
+	if window != nil {
+		log.Debug().
+			Uint("freeze_window_id", window.ID).
+			Time("ends_at", window.EndsAt).
+			Msg("Content freeze in effect, scheduled publishing paused")
+		return
+	}
+
+	var duePosts []models.Post
+	if err := database.DB.Where("status = ? AND publish_at <= ?", models.PostStatusScheduled, now).
+		Find(&duePosts).Error; err != nil {
+		log.Error().Err(err).Msg("Failed to load scheduled posts")
+		return
+	}
+
+	var published int
+	for _, post := range duePosts {
+		// Guard on status so a post changed in the meantime isn't published
+		result := database.DB.Model(&models.Post{}).
+			Where("id = ? AND status = ?", post.ID, models.PostStatusScheduled).
+			Update("status", models.PostStatusPublished)
+		if result.Error != nil {
+			log.Error().Err(result.Error).Uint("post_id", post.ID).Msg("Failed to publish scheduled post")
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		published++
+		if ogImages != nil && ogImages.Enabled() {
+			if err := ogImages.Generate(context.Background(), &post); err != nil {
+				log.Error().Err(err).Uint("post_id", post.ID).Msg("Failed to generate share image")
+			}
+		}
+		queueCrossPosts(&post)
+		if webhooks != nil {
+			database.DB.Preload("Tags").Preload("User", func(db *gorm.DB) *gorm.DB {
+				return db.Select("id, username, first_name, last_name, profile_image")
+			}).First(&post, post.ID)
+			webhooks.Dispatch(models.WebhookEventPostPublished, post)
+		}
+	}
+
+	if published > 0 {
+		log.Info().Int("published", published).Msg("Published scheduled posts")
+	}
+	heartbeat.Ping(services.HeartbeatJobPostScheduler)
+}
+
+// ExpirePosts gives published posts whose expiry date has passed the status
+// status, archived or draft. Like publishing, expiry waits for a content
+// freeze window to end; public listings leave expired posts out meanwhile.
+func ExpirePosts(status models.PostStatus) {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping post expiry")
+		return
+	}
+
+	now := time.Now()
+	window, err := database.ActiveFreezeWindow(database.DB, now)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to check content freeze, skipping post expiry")
+		return
+	}
+	if window != nil {
+		return
+	}
+
+	var expiredPosts []models.Post
+	if err := database.DB.Where("status = ? AND expires_at <= ?", models.PostStatusPublished, now).
+		Find(&expiredPosts).Error; err != nil {
+		log.Error().Err(err).Msg("Failed to load expired posts")
+		return
+	}
+
+	var expired int
+	for _, post := range expiredPosts {
+		// Guard on status so a post changed in the meantime is left alone
+		result := database.DB.Model(&models.Post{}).
+			Where("id = ? AND status = ?", post.ID, models.PostStatusPublished).
+			Update("status", status)
+		if result.Error != nil {
+			log.Error().Err(result.Error).Uint("post_id", post.ID).Msg("Failed to expire post")
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		expired++
+		if webhooks != nil {
+			post.Status = status
+			webhooks.Dispatch(models.WebhookEventPostUnpublished, post)
+		}
+	}
+
+	if expired > 0 {
+		log.Info().Int("expired", expired).Str("status", string(status)).Msg("Took down expired posts")
+	}
+}
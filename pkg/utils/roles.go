@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// roleRefreshInterval is how often roles are reloaded, so changes made on
+// another instance apply here too
+const roleRefreshInterval = time.Minute
+
+// StartRoleRefresh loads the roles and starts the background process that
+// reloads them
+func StartRoleRefresh() {
+	RefreshRoles()
+
+	ticker := time.NewTicker(roleRefreshInterval)
+	go func() {
+		for range ticker.C {
+			RefreshRoles()
+		}
+	}()
+}
+
+// RefreshRoles reloads the permissions of every role. When they can't be
+// read, the previous permissions stay in effect.
+func RefreshRoles() {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping role refresh")
+		return
+	}
+
+	if err := services.NewRoleService(database.DB).Load(); err != nil {
+		log.Error().Err(err).Msg("Failed to refresh roles")
+	}
+}
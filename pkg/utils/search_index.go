@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/phanvantai/taiphanvan_backend/internal/config"
+	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
+	"github.com/rs/zerolog/log"
+)
+
+// StartSearchIndexRefresh starts the background process that rebuilds the
+// search index, so published, changed and removed content is reflected in
+// search results within one interval
+func StartSearchIndexRefresh(cfg config.SearchConfig) {
+	ticker := time.NewTicker(cfg.RefreshInterval)
+	jobs.Register(services.HeartbeatJobSearchIndex, cfg.RefreshInterval)
+
+	go func() {
+		log.Info().
+			Dur("interval", cfg.RefreshInterval).
+			Msg("Starting search index refresh background process")
+
+		for range ticker.C {
+			RefreshSearchIndex()
+			jobs.Ran(services.HeartbeatJobSearchIndex)
+		}
+	}()
+}
+
+// RefreshSearchIndex rebuilds the search index from the current content
+func RefreshSearchIndex() {
+	if database.DB == nil {
+		log.Warn().Msg("Database not initialized, skipping search index refresh")
+		return
+	}
+
+	start := time.Now()
+	if err := services.NewSearchService(database.DB).Refresh(); err != nil {
+		log.Error().Err(err).Msg("Search index refresh failed")
+		return
+	}
+
+	log.Debug().Dur("duration", time.Since(start)).Msg("Refreshed search index")
+	heartbeat.Ping(services.HeartbeatJobSearchIndex)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/phanvantai/taiphanvan_backend/internal/database"
+	"github.com/phanvantai/taiphanvan_backend/internal/services"
 )
 
 // FormatDate formats a time.Time to a human-readable date string
@@ -52,29 +53,37 @@ func ExtractExcerpt(content string, maxLength int) string {
 
 // StartTokenCleanup starts a background routine to clean up expired tokens
 func StartTokenCleanup() {
+	jobs.Register(services.HeartbeatJobTokenCleanup, time.Hour)
 	go func() {
 		for {
 			// Run cleanup every hour
 			time.Sleep(1 * time.Hour)
-			CleanupExpiredTokens()
+			if CleanupExpiredTokens() {
+				heartbeat.Ping(services.HeartbeatJobTokenCleanup)
+			}
+			PurgeExpiredUserDeletionSnapshots()
+			jobs.Ran(services.HeartbeatJobTokenCleanup)
 		}
 	}()
 	log.Println("Token cleanup routine started")
 }
 
-// CleanupExpiredTokens removes expired tokens from the database
-func CleanupExpiredTokens() {
+// CleanupExpiredTokens removes expired tokens from the database.
+// It reports whether the cleanup completed without errors.
+func CleanupExpiredTokens() bool {
 	// Ensure database is initialized
 	if database.DB == nil {
 		log.Println("Database not initialized, skipping token cleanup")
-		return
+		return false
 	}
 
 	now := time.Now()
+	ok := true
 
 	// Clean up blacklisted tokens
 	if result := database.DB.Exec("DELETE FROM blacklisted_tokens WHERE expires_at < ?", now); result.Error != nil {
 		log.Printf("Error cleaning up blacklisted tokens: %v", result.Error)
+		ok = false
 	} else {
 		if result.RowsAffected > 0 {
 			log.Printf("Cleaned up %d expired blacklisted tokens", result.RowsAffected)
@@ -84,9 +93,28 @@ func CleanupExpiredTokens() {
 	// Clean up refresh tokens
 	if result := database.DB.Exec("DELETE FROM refresh_tokens WHERE expires_at < ? OR revoked = true", now); result.Error != nil {
 		log.Printf("Error cleaning up refresh tokens: %v", result.Error)
+		ok = false
 	} else {
 		if result.RowsAffected > 0 {
 			log.Printf("Cleaned up %d expired refresh tokens", result.RowsAffected)
 		}
 	}
+
+	return ok
+}
+
+// PurgeExpiredUserDeletionSnapshots drops the saved profile data of deleted users
+// once their undo window has passed
+func PurgeExpiredUserDeletionSnapshots() {
+	if database.DB == nil {
+		log.Println("Database not initialized, skipping user deletion snapshot purge")
+		return
+	}
+
+	result := database.DB.Exec("UPDATE user_deletions SET snapshot = NULL WHERE snapshot IS NOT NULL AND undo_until < ?", time.Now())
+	if result.Error != nil {
+		log.Printf("Error purging user deletion snapshots: %v", result.Error)
+	} else if result.RowsAffected > 0 {
+		log.Printf("Purged %d expired user deletion snapshots", result.RowsAffected)
+	}
 }
@@ -69,4 +69,34 @@ $SCRIPT_DIR/test_news_api.sh $BASE_URL
 print_test "RSS Feed API"
 $SCRIPT_DIR/test_rss_feed_api.sh $BASE_URL
 
+# Post tests
+print_section "Posts"
+print_test "Preconditions"
+$SCRIPT_DIR/test_post_preconditions.sh $BASE_URL
+
+print_test "Freeze Windows"
+$SCRIPT_DIR/test_freeze_windows.sh $BASE_URL
+
+print_test "Preview Links"
+$SCRIPT_DIR/test_preview_tokens.sh $BASE_URL
+
+print_test "Content API"
+$SCRIPT_DIR/test_content_api.sh $BASE_URL
+
+print_test "GraphQL API"
+$SCRIPT_DIR/test_graphql_api.sh $BASE_URL
+
+# Account tests
+print_section "Account"
+print_test "Account API"
+$SCRIPT_DIR/test_account_api.sh $BASE_URL
+
+print_test "API Keys"
+$SCRIPT_DIR/test_api_keys.sh $BASE_URL
+
+# Admin tests
+print_section "Admin"
+print_test "Admin API"
+$SCRIPT_DIR/test_admin_api.sh $BASE_URL
+
 echo -e "\n${GREEN}All tests completed successfully!${NC}"
@@ -0,0 +1,126 @@
+#!/bin/bash
+# Test script for the signed-in user's endpoints: bookmarks, the reading
+# list and progress, notifications, sessions and the data export
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+
+# A published post to bookmark and read
+print_message "Creating a published post..."
+request POST /posts '{"title":"Account Test '"$(date +%s)"'","content":"Something worth saving for later","status":"published"}' -H "$AUTH"
+expect_status 201 "Create post"
+POST_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+
+# Bookmarks and the reading list
+print_message "Testing bookmarks..."
+request POST "/posts/${POST_ID}/bookmark" "" -H "$AUTH"
+expect_status 200 "Bookmark post"
+request GET /profile/bookmarks "" -H "$AUTH"
+expect_status 200 "List bookmarks"
+request GET /profile/reading-list "" -H "$AUTH"
+expect_status 200 "Reading list"
+if ! echo "$RESPONSE_BODY" | jq -e --argjson id "$POST_ID" '.. | objects | select(.id? == $id)' > /dev/null; then
+  print_warning "The bookmarked post isn't in the reading list"
+fi
+
+NEWS_ID=$(curl -s "${BASE_URL}/news?page=1&per_page=1" | jq -r '.news[0].id // empty')
+if [ -n "$NEWS_ID" ]; then
+  request POST "/news/${NEWS_ID}/bookmark" "" -H "$AUTH"
+  expect_status 200 "Bookmark news article"
+  request DELETE "/news/${NEWS_ID}/bookmark" "" -H "$AUTH"
+  expect_status 200 "Remove news bookmark"
+else
+  print_warning "No news articles to bookmark, skipping"
+fi
+
+# Reading progress
+print_message "Testing reading progress..."
+request PUT "/posts/${POST_ID}/progress" '{"percentage":40,"anchor":"p-3"}' -H "$AUTH"
+expect_status 200 "Save progress"
+request PUT "/posts/${POST_ID}/progress" '{"percentage":140}' -H "$AUTH"
+expect_status 400 "Save invalid progress"
+request GET /profile/progress "" -H "$AUTH"
+expect_status 200 "List progress"
+request GET "/profile/progress?finished=true" "" -H "$AUTH"
+expect_status 200 "List progress including finished posts"
+
+# Notifications and sessions
+print_message "Testing notifications and sessions..."
+request GET /notifications "" -H "$AUTH"
+expect_status 200 "List notifications"
+request GET /profile/sessions "" -H "$AUTH"
+expect_status 200 "List sessions"
+echo "$RESPONSE_BODY" | jq .
+
+# Data export
+print_message "Testing the account data export..."
+request GET /profile/export "" -H "$AUTH"
+expect_status 200 "Export account data"
+request GET /profile/export ""
+expect_status 401 "Export without logging in"
+
+# Clean up
+print_message "Cleaning up..."
+request DELETE "/posts/${POST_ID}/bookmark" "" -H "$AUTH"
+expect_status 200 "Remove bookmark"
+request DELETE "/posts/${POST_ID}" "" -H "$AUTH"
+expect_status 200 "Delete post"
+
+print_message "Account tests completed"
@@ -0,0 +1,145 @@
+#!/bin/bash
+# Test script for the admin endpoints: roles, sites, webhooks, the audit
+# log and the operational views
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+RUN=$(date +%s)
+
+# Everything here is admin only
+print_message "Testing that admin endpoints need the admin role..."
+request GET /admin/stats ""
+expect_status 401 "Admin stats without logging in"
+
+# Roles and permissions
+print_message "Testing roles..."
+request GET /admin/permissions "" -H "$AUTH"
+expect_status 200 "List permissions"
+request GET /admin/roles "" -H "$AUTH"
+expect_status 200 "List roles"
+request POST /admin/roles '{"name":"apitest_'"$RUN"'","description":"Created by the API tests","permissions":["comment.edit","comment.delete"]}' -H "$AUTH"
+expect_status 201 "Create role"
+ROLE_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+request POST /admin/roles '{"name":"bad_perm_'"$RUN"'","permissions":["no.such.permission"]}' -H "$AUTH"
+expect_status 400 "Create role with an unknown permission"
+request PUT "/admin/roles/${ROLE_ID}" '{"description":"Updated by the API tests"}' -H "$AUTH"
+expect_status 200 "Update role"
+request DELETE "/admin/roles/${ROLE_ID}" "" -H "$AUTH"
+expect_status 200 "Delete role"
+
+# Sites
+print_message "Testing sites..."
+request GET /admin/sites "" -H "$AUTH"
+expect_status 200 "List sites"
+request POST /admin/sites '{"name":"API Test '"$RUN"'","domain":"apitest-'"$RUN"'.example.com"}' -H "$AUTH"
+expect_status 201 "Create site"
+SITE_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+request PUT "/admin/sites/${SITE_ID}" '{"name":"API Test renamed"}' -H "$AUTH"
+expect_status 200 "Update site"
+request DELETE "/admin/sites/${SITE_ID}" "" -H "$AUTH"
+expect_status 200 "Delete site"
+
+# Webhooks
+print_message "Testing webhooks..."
+request POST /admin/webhooks '{"url":"https://example.com/webhooks/apitest","description":"API tests","events":["post.published"],"active":false}' -H "$AUTH"
+expect_status 201 "Create webhook"
+WEBHOOK_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+if [ "$(echo "$RESPONSE_BODY" | jq -r '.secret')" == "null" ]; then
+  print_error "The created webhook has no signing secret"
+  exit 1
+fi
+request GET /admin/webhooks "" -H "$AUTH"
+expect_status 200 "List webhooks"
+if echo "$RESPONSE_BODY" | jq -e '.[] | .secret // empty' > /dev/null; then
+  print_error "Listed webhooks include their secrets"
+  exit 1
+fi
+request POST /admin/webhooks '{"url":"not a url","events":["post.published"]}' -H "$AUTH"
+expect_status 400 "Create webhook with an invalid URL"
+request GET "/admin/webhooks/${WEBHOOK_ID}/deliveries" "" -H "$AUTH"
+expect_status 200 "List webhook deliveries"
+request DELETE "/admin/webhooks/${WEBHOOK_ID}" "" -H "$AUTH"
+expect_status 200 "Delete webhook"
+
+# Audit log of the changes above
+print_message "Testing the audit log..."
+request GET "/admin/audit-logs?resource_type=role" "" -H "$AUTH"
+expect_status 200 "List audit log entries"
+print_message "Role entries: $(echo "$RESPONSE_BODY" | jq -r '.total_items')"
+
+# Operations
+print_message "Testing stats, settings and diagnostics..."
+request GET /admin/stats "" -H "$AUTH"
+expect_status 200 "Admin stats"
+request GET /admin/settings "" -H "$AUTH"
+expect_status 200 "Site settings"
+request GET /admin/diagnostics "" -H "$AUTH"
+expect_status 200 "Diagnostics"
+echo "$RESPONSE_BODY" | jq '.migrations // .'
+request GET /admin/content/broken-links "" -H "$AUTH"
+expect_status 200 "Broken links"
+request GET /admin/home/picks "" -H "$AUTH"
+expect_status 200 "Editorial picks"
+request GET /admin/comments/pending "" -H "$AUTH"
+expect_status 200 "Pending comments"
+request GET /admin/news/ingestions "" -H "$AUTH"
+expect_status 200 "Ingestion runs"
+
+print_message "Admin tests completed"
@@ -0,0 +1,108 @@
+#!/bin/bash
+# Test script for API keys and JWT signing key rotation
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+
+# A read-only API key acts as the user for GET requests only
+print_message "Creating a read-only API key..."
+request POST /profile/api-keys '{"name":"API test '"$(date +%s)"'","scopes":["read"],"expires_in_days":1}' -H "$AUTH"
+expect_status 201 "Create API key"
+KEY_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+API_KEY=$(echo "$RESPONSE_BODY" | jq -r '.key')
+
+request GET /profile/api-keys "" -H "$AUTH"
+expect_status 200 "List API keys"
+if echo "$RESPONSE_BODY" | jq -e '.[] | .key // empty' > /dev/null; then
+  print_error "Listed keys include the key itself"
+  exit 1
+fi
+
+request GET /profile "" -H "X-API-Key: ${API_KEY}"
+expect_status 200 "GET with the read key"
+request POST /posts '{"title":"Written with a read key","content":"Should be rejected"}' -H "X-API-Key: ${API_KEY}"
+expect_status 403 "POST with the read key"
+request POST /profile/api-keys '{"name":"Nested","scopes":["read"]}' -H "X-API-Key: ${API_KEY}"
+expect_status 403 "Creating a key with a key"
+
+print_message "Revoking the API key..."
+request DELETE "/profile/api-keys/${KEY_ID}" "" -H "$AUTH"
+expect_status 200 "Revoke API key"
+request GET /profile "" -H "X-API-Key: ${API_KEY}"
+expect_status 401 "GET with the revoked key"
+
+# Rotating the signing key keeps tokens signed with the old one valid
+print_message "Rotating the JWT signing key..."
+request GET /admin/jwt-keys "" -H "$AUTH"
+expect_status 200 "List JWT signing keys"
+request POST /admin/jwt-keys/rotate "" -H "$AUTH"
+expect_status 201 "Rotate JWT signing key"
+print_message "New key: $(echo "$RESPONSE_BODY" | jq -r '.kid')"
+
+request GET /profile "" -H "$AUTH"
+expect_status 200 "Token signed with the previous key"
+
+NEW_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+request GET /profile "" -H "Authorization: Bearer ${NEW_TOKEN}"
+expect_status 200 "Token signed with the new key"
+
+print_message "API key and signing key tests completed"
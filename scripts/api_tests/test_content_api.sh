@@ -0,0 +1,166 @@
+#!/bin/bash
+# Test script for the content endpoints around posts: categories, series,
+# pages, post templates, comments and reactions, search and the home feed
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+RUN=$(date +%s)
+
+# A published post for the content to refer to
+print_message "Creating a published post..."
+request POST /posts '{"title":"Content Test '"$RUN"'","content":"Searchable content '"$RUN"'","status":"published","tags":["apitest"]}' -H "$AUTH"
+expect_status 201 "Create post"
+POST_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+
+# Categories
+print_message "Testing categories..."
+request POST /admin/categories '{"name":"API Test '"$RUN"'","description":"Created by the API tests"}' -H "$AUTH"
+expect_status 201 "Create category"
+CATEGORY_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+request GET /categories ""
+expect_status 200 "List categories"
+request PUT "/posts/${POST_ID}" '{"category_id":'"$CATEGORY_ID"'}' -H "$AUTH"
+expect_status 200 "Set the post's category"
+
+# Series
+print_message "Testing series..."
+request POST /series '{"title":"API Test Series '"$RUN"'","description":"Created by the API tests"}' -H "$AUTH"
+expect_status 201 "Create series"
+SERIES_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+SERIES_SLUG=$(echo "$RESPONSE_BODY" | jq -r '.slug')
+request POST "/series/${SERIES_ID}/posts" '{"post_id":'"$POST_ID"'}' -H "$AUTH"
+expect_status 200 "Add post to series"
+request GET "/series/${SERIES_SLUG}" ""
+expect_status 200 "Get series"
+request DELETE "/series/${SERIES_ID}/posts/${POST_ID}" "" -H "$AUTH"
+expect_status 200 "Remove post from series"
+
+# Pages
+print_message "Testing pages..."
+request POST /pages '{"title":"API Test Page '"$RUN"'","content":"About this page","status":"draft"}' -H "$AUTH"
+expect_status 201 "Create draft page"
+PAGE_SLUG=$(echo "$RESPONSE_BODY" | jq -r '.slug')
+request GET "/pages/${PAGE_SLUG}" ""
+expect_status 404 "Anonymous GET of a draft page"
+request PUT "/pages/${PAGE_SLUG}" '{"status":"published"}' -H "$AUTH"
+expect_status 200 "Publish page"
+request GET "/pages/${PAGE_SLUG}" ""
+expect_status 200 "Anonymous GET of a published page"
+
+# Post templates
+print_message "Testing post templates..."
+request POST /post-templates '{"name":"API Test '"$RUN"'","title_pattern":"Weekly notes {date}","content":"## Notes","tags":["weekly"]}' -H "$AUTH"
+expect_status 201 "Create post template"
+TEMPLATE_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+request POST /posts '{"template_id":'"$TEMPLATE_ID"',"status":"draft"}' -H "$AUTH"
+expect_status 201 "Create post from template"
+TEMPLATE_POST_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+print_message "Post title from template: $(echo "$RESPONSE_BODY" | jq -r '.title')"
+
+# Comments and reactions
+print_message "Testing comments and reactions..."
+request POST "/posts/${POST_ID}/comments" '{"content":"A comment from the API tests"}' -H "$AUTH"
+expect_status 201 "Create comment"
+COMMENT_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+request POST "/comments/${COMMENT_ID}/reactions/like" "" -H "$AUTH"
+expect_status 200 "React to comment"
+request POST "/comments/${COMMENT_ID}/reactions/shrug" "" -H "$AUTH"
+expect_status 400 "Unknown reaction"
+request DELETE "/comments/${COMMENT_ID}/reactions/like" "" -H "$AUTH"
+expect_status 200 "Remove reaction"
+request GET "/posts/${POST_ID}/comments" ""
+expect_status 200 "List comments"
+
+# Discovery
+print_message "Testing search, tags, feed and stats..."
+request GET "/search?q=Searchable" ""
+expect_status 200 "Search"
+print_message "Search index may lag behind by SEARCH_REFRESH_INTERVAL; posts found: $(echo "$RESPONSE_BODY" | jq -r '.posts.total // 0')"
+request GET "/search" ""
+expect_status 400 "Search without a query"
+request GET /tags/popular ""
+expect_status 200 "Popular tags"
+request GET /home/feed ""
+expect_status 200 "Home feed"
+request GET /stats/public ""
+expect_status 200 "Public stats"
+request GET /dashboard "" -H "$AUTH"
+expect_status 200 "Author dashboard"
+
+# Clean up
+print_message "Cleaning up..."
+request DELETE "/comments/${COMMENT_ID}" "" -H "$AUTH"
+expect_status 200 "Delete comment"
+request DELETE "/post-templates/${TEMPLATE_ID}" "" -H "$AUTH"
+expect_status 200 "Delete post template"
+request DELETE "/pages/${PAGE_SLUG}" "" -H "$AUTH"
+expect_status 200 "Delete page"
+request DELETE "/series/${SERIES_ID}" "" -H "$AUTH"
+expect_status 200 "Delete series"
+request PUT "/posts/${POST_ID}" '{"category_id":0}' -H "$AUTH"
+expect_status 200 "Clear the post's category"
+for id in $POST_ID $TEMPLATE_POST_ID; do
+  request DELETE "/posts/${id}" "" -H "$AUTH"
+  expect_status 200 "Delete post ${id}"
+done
+request DELETE "/admin/categories/${CATEGORY_ID}" "" -H "$AUTH"
+expect_status 200 "Delete category"
+
+print_message "Content tests completed"
@@ -0,0 +1,119 @@
+#!/bin/bash
+# Test script for content freeze windows: publishing during a freeze is
+# saved but queued until the window ends, with 201 on create and 202 on
+# publish and update
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+
+# RFC 3339 time MINUTES from now, with GNU or BSD date
+minutes_from_now() {
+  date -u -d "$1 minutes" +%Y-%m-%dT%H:%M:%SZ 2>/dev/null || date -u -v"$1"M +%Y-%m-%dT%H:%M:%SZ
+}
+
+# Freeze publishing from a minute ago for ten minutes
+print_message "Creating a freeze window..."
+request POST /admin/freeze-windows '{"reason":"API test","starts_at":"'"$(minutes_from_now -1)"'","ends_at":"'"$(minutes_from_now +10)"'"}' -H "$AUTH"
+expect_status 201 "Create freeze window"
+WINDOW_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+
+request GET /admin/freeze-windows "" -H "$AUTH"
+expect_status 200 "List freeze windows"
+
+# Publishing is queued until the window ends: the post is saved as scheduled
+print_message "Creating a published post during the freeze..."
+request POST /posts '{"title":"Freeze Test '"$(date +%s)"'","content":"Queued by the freeze","status":"published"}' -H "$AUTH"
+expect_status 201 "Create published post"
+QUEUED_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+QUEUED_SLUG=$(echo "$RESPONSE_BODY" | jq -r '.slug')
+if [ "$(echo "$RESPONSE_BODY" | jq -r '.queued')" != "true" ]; then
+  print_error "The response doesn't report the publish as queued"
+  exit 1
+fi
+print_message "Queued until $(echo "$RESPONSE_BODY" | jq -r '.frozen_until')"
+
+request GET "/posts/slug/${QUEUED_SLUG}" ""
+expect_status 404 "Anonymous GET of the queued post"
+
+print_message "Publishing a draft during the freeze..."
+request POST /posts '{"title":"Freeze Draft '"$(date +%s)"'","content":"Published during the freeze","status":"draft"}' -H "$AUTH"
+expect_status 201 "Create draft"
+DRAFT_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+request POST "/posts/${DRAFT_ID}/publish" "" -H "$AUTH"
+expect_status 202 "Publish draft"
+
+request PUT "/posts/${DRAFT_ID}" '{"status":"draft"}' -H "$AUTH"
+expect_status 200 "Move the queued post back to draft"
+
+# Ending the freeze publishes normally again
+print_message "Deleting the freeze window..."
+request DELETE "/admin/freeze-windows/${WINDOW_ID}" "" -H "$AUTH"
+expect_status 200 "Delete freeze window"
+request POST "/posts/${DRAFT_ID}/publish" "" -H "$AUTH"
+expect_status 200 "Publish after the freeze"
+
+# Clean up
+print_message "Deleting the test posts..."
+for id in $QUEUED_ID $DRAFT_ID; do
+  request DELETE "/posts/${id}" "" -H "$AUTH"
+  expect_status 200 "Delete post ${id}"
+done
+
+print_message "Freeze window tests completed"
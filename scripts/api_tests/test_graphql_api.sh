@@ -0,0 +1,122 @@
+#!/bin/bash
+# Test script for the GraphQL endpoint, whose resolvers call the REST
+# handlers with the caller's credentials
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+
+# Queries work without logging in, over GET and POST
+print_message "Querying posts over POST..."
+request POST /graphql '{"query":"{ posts(limit: 2) { posts { id uuid title slug readingTime user { username } tags { name } } meta { total nextCursor } } }"}'
+expect_status 200 "Posts query"
+echo "$RESPONSE_BODY" | jq .
+
+print_message "Querying tags over GET..."
+request GET "/graphql?query=%7B%20tags%20%7B%20name%20postCount%20%7D%20%7D" ""
+expect_status 200 "Tags query over GET"
+
+# Mutations and me need credentials; errors carry the REST code
+print_message "Testing queries that need authentication..."
+request POST /graphql '{"query":"{ me { username } }"}'
+CODE=$(echo "$RESPONSE_BODY" | jq -r '.errors[0].extensions.code')
+if [ "$CODE" == "null" ] || [ -z "$CODE" ]; then
+  print_error "Anonymous me query didn't fail with an error code"
+  echo "$RESPONSE_BODY" | jq .
+  exit 1
+fi
+print_message "Anonymous me query: $CODE"
+
+request GET "/graphql?query=mutation%20%7B%20deletePost(id%3A%20%220%22)%20%7D" "" -H "$AUTH"
+if [ "$HTTP_STATUS" == "200" ] && [ "$(echo "$RESPONSE_BODY" | jq -r '.errors')" == "null" ]; then
+  print_error "A mutation over GET was run"
+  exit 1
+fi
+print_message "Mutation over GET rejected: $HTTP_STATUS"
+
+request POST /graphql '{"query":"{ me { username role } }"}' -H "$AUTH"
+expect_status 200 "Authenticated me query"
+echo "$RESPONSE_BODY" | jq .
+
+# Mutations call the REST handlers, including version checks
+print_message "Creating, updating and deleting a post with mutations..."
+request POST /graphql '{"query":"mutation($input: CreatePostInput!) { createPost(input: $input) { id version status } }","variables":{"input":{"title":"GraphQL Test '"$(date +%s)"'","content":"Created over GraphQL","status":"draft","tags":["graphql"]}}}' -H "$AUTH"
+expect_status 200 "createPost"
+POST_ID=$(echo "$RESPONSE_BODY" | jq -r '.data.createPost.id')
+VERSION=$(echo "$RESPONSE_BODY" | jq -r '.data.createPost.version')
+if [ "$POST_ID" == "null" ] || [ -z "$POST_ID" ]; then
+  print_error "createPost failed"
+  echo "$RESPONSE_BODY" | jq .
+  exit 1
+fi
+
+request POST /graphql '{"query":"mutation($id: ID!, $input: UpdatePostInput!) { updatePost(id: $id, input: $input) { version } }","variables":{"id":"'"$POST_ID"'","input":{"content":"Updated over GraphQL","version":'"$VERSION"'}}}' -H "$AUTH"
+expect_status 200 "updatePost"
+request POST /graphql '{"query":"mutation($id: ID!, $input: UpdatePostInput!) { updatePost(id: $id, input: $input) { version } }","variables":{"id":"'"$POST_ID"'","input":{"content":"Stale update","version":'"$VERSION"'}}}' -H "$AUTH"
+if [ "$(echo "$RESPONSE_BODY" | jq -r '.errors[0].extensions.status')" != "409" ]; then
+  print_error "A stale updatePost wasn't rejected with 409"
+  echo "$RESPONSE_BODY" | jq .
+  exit 1
+fi
+print_message "Stale updatePost rejected with 409"
+
+request POST /graphql '{"query":"mutation($id: ID!) { deletePost(id: $id) }","variables":{"id":"'"$POST_ID"'"}}' -H "$AUTH"
+expect_status 200 "deletePost"
+
+print_message "GraphQL tests completed"
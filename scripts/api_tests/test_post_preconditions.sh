@@ -0,0 +1,123 @@
+#!/bin/bash
+# Test script for conditional requests and edit conflicts on posts:
+# ETag/If-None-Match on the public routes, drafts hidden from them, and
+# updates rejected with 409 when the version or If-Unmodified-Since is stale
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+
+# Create a draft
+print_message "Creating a draft post..."
+request POST /posts '{"title":"Precondition Test '"$(date +%s)"'","content":"First version","status":"draft"}' -H "$AUTH"
+expect_status 201 "Create draft"
+POST_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+SLUG=$(echo "$RESPONSE_BODY" | jq -r '.slug')
+VERSION=$(echo "$RESPONSE_BODY" | jq -r '.version')
+
+# Drafts are only visible to those who can edit them
+print_message "Testing that the draft is hidden from anonymous readers..."
+request GET "/posts/slug/${SLUG}" ""
+expect_status 404 "Anonymous draft by slug"
+request GET "/posts?status=draft" ""
+expect_status 200 "Anonymous posts list with status=draft"
+if echo "$RESPONSE_BODY" | jq -e --argjson id "$POST_ID" '.posts[] | select(.id == $id)' > /dev/null; then
+  print_error "The draft is listed to anonymous readers"
+  exit 1
+fi
+request GET "/posts/slug/${SLUG}" "" -H "$AUTH"
+expect_status 200 "Author draft by slug"
+
+# A stale version is rejected with the current post in the details
+print_message "Testing an update with a stale version..."
+request PUT "/posts/${POST_ID}" '{"content":"Second version","version":'"$VERSION"'}' -H "$AUTH"
+expect_status 200 "Update with the current version"
+request PUT "/posts/${POST_ID}" '{"content":"Lost edit","version":'"$VERSION"'}' -H "$AUTH"
+expect_status 409 "Update with a stale version"
+CURRENT_CONTENT=$(echo "$RESPONSE_BODY" | jq -r '.details.current.content')
+if [ "$CURRENT_CONTENT" != "Second version" ]; then
+  print_warning "Conflict details don't carry the current post: $CURRENT_CONTENT"
+fi
+
+# If-Unmodified-Since before the last update is rejected too
+print_message "Testing an update with a stale If-Unmodified-Since..."
+request PUT "/posts/${POST_ID}" '{"content":"Lost edit"}' -H "$AUTH" \
+  -H "If-Unmodified-Since: Mon, 01 Jan 2001 00:00:00 GMT"
+expect_status 409 "Update with a stale If-Unmodified-Since"
+
+# Published posts answer conditional requests
+print_message "Publishing the post and testing conditional GETs..."
+request POST "/posts/${POST_ID}/publish" "" -H "$AUTH"
+expect_status 200 "Publish"
+ETAG=$(curl -s -D - -o /dev/null "${BASE_URL}/posts/slug/${SLUG}" | grep -i '^etag:' | cut -d' ' -f2- | tr -d '\r')
+if [ -z "$ETAG" ]; then
+  print_error "No ETag on the published post"
+  exit 1
+fi
+request GET "/posts/slug/${SLUG}" "" -H "If-None-Match: ${ETAG}"
+expect_status 304 "GET with a matching If-None-Match"
+request GET "/posts/slug/${SLUG}" "" -H 'If-None-Match: W/"stale"'
+expect_status 200 "GET with another If-None-Match"
+
+# Clean up
+print_message "Deleting the test post..."
+request DELETE "/posts/${POST_ID}" "" -H "$AUTH"
+expect_status 200 "Delete"
+
+print_message "Precondition tests completed"
@@ -0,0 +1,109 @@
+#!/bin/bash
+# Test script for post preview links, which show a draft to anyone with
+# the link until it expires or is revoked
+
+set -e
+
+# Colors for output
+GREEN='\033[0;32m'
+RED='\033[0;31m'
+YELLOW='\033[0;33m'
+NC='\033[0m' # No Color
+
+# Print colored message
+print_message() {
+  echo -e "${GREEN}[INFO]${NC} $1"
+}
+
+print_warning() {
+  echo -e "${YELLOW}[WARN]${NC} $1"
+}
+
+print_error() {
+  echo -e "${RED}[ERROR]${NC} $1"
+}
+
+# Send a request and keep the status and body in HTTP_STATUS and
+# RESPONSE_BODY: request METHOD PATH [BODY] [CURL ARGS...]
+request() {
+  local method=$1 path=$2 body=$3
+  shift 3
+  RESPONSE=$(curl -s -w "\n%{http_code}" -X "$method" "${BASE_URL}${path}" \
+    -H "Content-Type: application/json" ${body:+-d "$body"} "$@")
+  HTTP_STATUS=$(echo "$RESPONSE" | tail -n 1)
+  RESPONSE_BODY=$(echo "$RESPONSE" | sed '$d')
+}
+
+# Fail unless the last response had the expected status
+expect_status() {
+  if [ "$HTTP_STATUS" != "$1" ]; then
+    print_error "$2: expected $1, got $HTTP_STATUS"
+    echo "$RESPONSE_BODY" | jq . 2>/dev/null || echo "$RESPONSE_BODY"
+    exit 1
+  fi
+  print_message "$2: $HTTP_STATUS"
+}
+
+# Base URL
+BASE_URL=${1:-http://localhost:9876/api}
+ADMIN_TOKEN=""
+
+# Login as admin to get token
+print_message "Logging in as admin to get token..."
+ADMIN_TOKEN=$(curl -s -X POST ${BASE_URL}/auth/login \
+  -H "Content-Type: application/json" \
+  -d '{"username":"admin", "password":"replace_with_secure_password"}' | jq -r '.access_token')
+
+if [ "$ADMIN_TOKEN" == "null" ] || [ -z "$ADMIN_TOKEN" ]; then
+  print_error "Failed to get admin token"
+  exit 1
+fi
+
+print_message "Successfully acquired admin token"
+AUTH="Authorization: Bearer ${ADMIN_TOKEN}"
+
+# Preview links work for drafts only
+print_message "Creating a draft post..."
+request POST /posts '{"title":"Preview Test '"$(date +%s)"'","content":"Not published yet","status":"draft"}' -H "$AUTH"
+expect_status 201 "Create draft"
+POST_ID=$(echo "$RESPONSE_BODY" | jq -r '.id')
+
+print_message "Creating a preview link..."
+request POST "/posts/${POST_ID}/preview-token" "" -H "$AUTH"
+expect_status 201 "Create preview link"
+PREVIEW_TOKEN=$(echo "$RESPONSE_BODY" | jq -r '.token')
+print_message "Preview URL: $(echo "$RESPONSE_BODY" | jq -r '.preview_url')"
+
+# The link shows the draft without logging in
+request GET "/posts/preview/${PREVIEW_TOKEN}" ""
+expect_status 200 "Anonymous preview"
+request GET "/posts/preview/not-a-token" ""
+expect_status 404 "Preview with an invalid token"
+
+# Editing the post keeps the link working; revoking it doesn't
+request PUT "/posts/${POST_ID}" '{"content":"Edited draft"}' -H "$AUTH"
+expect_status 200 "Edit draft"
+request GET "/posts/preview/${PREVIEW_TOKEN}" ""
+expect_status 200 "Preview after an edit"
+if [ "$(echo "$RESPONSE_BODY" | jq -r '.content')" != "Edited draft" ]; then
+  print_warning "The preview doesn't show the latest content"
+fi
+
+print_message "Revoking the preview links..."
+request DELETE "/posts/${POST_ID}/preview-token" "" -H "$AUTH"
+expect_status 200 "Revoke preview links"
+request GET "/posts/preview/${PREVIEW_TOKEN}" ""
+expect_status 404 "Preview after revoking"
+
+# Published posts don't need a preview
+request POST "/posts/${POST_ID}/publish" "" -H "$AUTH"
+expect_status 200 "Publish"
+request POST "/posts/${POST_ID}/preview-token" "" -H "$AUTH"
+expect_status 400 "Preview link for a published post"
+
+# Clean up
+print_message "Deleting the test post..."
+request DELETE "/posts/${POST_ID}" "" -H "$AUTH"
+expect_status 200 "Delete"
+
+print_message "Preview link tests completed"